@@ -413,6 +413,7 @@ func main() {
 	}
 
 	l.StartVirtqemud(stopChan)
+	l.StartVirtsecretd(stopChan)
 	// only single domain should be present
 	domainName := api.VMINamespaceKeyFunc(vmi)
 
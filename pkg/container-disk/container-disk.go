@@ -347,6 +347,12 @@ func CreateEphemeralImages(
 			} else if !exists {
 				return fmt.Errorf("no supported file disk found for volume found in: %s", backingFile)
 			}
+			if volume.ContainerDisk.PersistentOverlay != nil {
+				if err := preparePersistentOverlay(volume, backingFile, info.Format); err != nil {
+					return err
+				}
+				continue
+			}
 			if err := diskCreator.CreateBackedImageForVolume(volume, backingFile, info.Format); err != nil {
 				return err
 			}
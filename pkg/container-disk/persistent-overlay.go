@@ -0,0 +1,128 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package containerdisk
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/os/disk"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+var persistentOverlayBaseDir = filepath.Join(util.VirtPrivateDir, "containerdisk-overlays")
+
+var (
+	createPersistentOverlay  = createPersistentOverlayFunc
+	rebasePersistentOverlay  = rebasePersistentOverlayFunc
+	persistentOverlayBacking = persistentOverlayBackingFunc
+)
+
+// GetPersistentOverlayDir returns where the PVC holding the persistent overlay of a container disk
+// volume is mounted in the virt-launcher pod.
+func GetPersistentOverlayDir(volumeName string) string {
+	return filepath.Join(persistentOverlayBaseDir, volumeName)
+}
+
+// GetPersistentOverlayPath returns the path of the persistent overlay of a container disk volume
+// from the virt-launcher view.
+func GetPersistentOverlayPath(volumeName string) string {
+	return filepath.Join(GetPersistentOverlayDir(volumeName), "disk.qcow2")
+}
+
+// preparePersistentOverlay creates the overlay on its PVC on the first boot. On later boots the overlay
+// is kept, only its backing file reference is updated when the container disk moved to another path.
+func preparePersistentOverlay(volume v1.Volume, backingFile, backingFormat string) error {
+	overlayPath := GetPersistentOverlayPath(volume.Name)
+	exists, err := diskutils.FileExists(overlayPath)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		if output, err := createPersistentOverlay(backingFile, backingFormat, overlayPath); err != nil {
+			return fmt.Errorf("qemu-img failed with output '%s': %v", string(output), err)
+		}
+		// #nosec G302: Poor file permissions used with chmod. Safe permission setting for files shared between virt-launcher and qemu.
+		if err := os.Chmod(overlayPath, 0640); err != nil {
+			return fmt.Errorf("failed to change permissions on %s", overlayPath)
+		}
+		return diskutils.DefaultOwnershipManager.UnsafeSetFileOwnership(overlayPath)
+	}
+
+	currentBackingFile, err := persistentOverlayBacking(overlayPath)
+	if err != nil {
+		return err
+	}
+	if currentBackingFile == backingFile {
+		return nil
+	}
+	// The container disk path depends on the index of the volume, which can change between two boots.
+	// The image itself is the same, so only the reference has to be rewritten, without touching the data.
+	if output, err := rebasePersistentOverlay(backingFile, backingFormat, overlayPath); err != nil {
+		return fmt.Errorf("qemu-img failed with output '%s': %v", string(output), err)
+	}
+	return nil
+}
+
+func createPersistentOverlayFunc(backingFile, backingFormat, overlayPath string) ([]byte, error) {
+	// #nosec No risk for attacker injection. Parameters are predefined strings
+	cmd := exec.Command(disk.QEMUIMGPath,
+		"create",
+		"-f", "qcow2",
+		"-b", backingFile,
+		"-F", backingFormat,
+		overlayPath,
+	)
+	return cmd.CombinedOutput()
+}
+
+func rebasePersistentOverlayFunc(backingFile, backingFormat, overlayPath string) ([]byte, error) {
+	// #nosec No risk for attacker injection. Parameters are predefined strings
+	cmd := exec.Command(disk.QEMUIMGPath,
+		"rebase",
+		"-u",
+		"-f", "qcow2",
+		"-b", backingFile,
+		"-F", backingFormat,
+		overlayPath,
+	)
+	return cmd.CombinedOutput()
+}
+
+func persistentOverlayBackingFunc(overlayPath string) (string, error) {
+	// The overlay may be in use by the source of a migration, don't wait for its lock.
+	// #nosec No risk for attacker injection. Only get information about an image
+	cmd := exec.Command(disk.QEMUIMGPath, "info", "-U", "--output", "json", overlayPath)
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to invoke qemu-img on %s: %v", overlayPath, err)
+	}
+	info := &disk.DiskInfo{}
+	if err := json.Unmarshal(out, info); err != nil {
+		return "", fmt.Errorf("failed to parse disk info: %v", err)
+	}
+	return info.BackingFile, nil
+}
@@ -0,0 +1,110 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package containerdisk
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/os/disk"
+
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	ephemeraldiskfake "kubevirt.io/kubevirt/pkg/ephemeral-disk/fake"
+)
+
+var _ = Describe("Persistent overlay", func() {
+	var (
+		tmpDir       string
+		created      []string
+		rebased      []string
+		backingFiles map[string]string
+	)
+
+	BeforeEach(func() {
+		tmpDir = GinkgoT().TempDir()
+		Expect(SetLocalDirectory(filepath.Join(tmpDir, "container-disks"))).To(Succeed())
+		DeferCleanup(SetLocalDirectoryOnly, mountBaseDir)
+
+		origBaseDir := persistentOverlayBaseDir
+		origCreate, origRebase, origBacking := createPersistentOverlay, rebasePersistentOverlay, persistentOverlayBacking
+		DeferCleanup(func() {
+			persistentOverlayBaseDir = origBaseDir
+			createPersistentOverlay, rebasePersistentOverlay, persistentOverlayBacking = origCreate, origRebase, origBacking
+		})
+		persistentOverlayBaseDir = filepath.Join(tmpDir, "overlays")
+		diskutils.MockDefaultOwnershipManager()
+
+		created, rebased = nil, nil
+		backingFiles = map[string]string{}
+		createPersistentOverlay = func(backingFile, _, overlayPath string) ([]byte, error) {
+			created = append(created, overlayPath)
+			backingFiles[overlayPath] = backingFile
+			return nil, os.WriteFile(overlayPath, nil, 0600)
+		}
+		rebasePersistentOverlay = func(backingFile, _, overlayPath string) ([]byte, error) {
+			rebased = append(rebased, overlayPath)
+			backingFiles[overlayPath] = backingFile
+			return nil, nil
+		}
+		persistentOverlayBacking = func(overlayPath string) (string, error) {
+			return backingFiles[overlayPath], nil
+		}
+	})
+
+	createImages := func(options ...libvmi.Option) {
+		vmi := libvmi.New(options...)
+		disksInfo := map[string]*disk.DiskInfo{}
+		for i, volume := range vmi.Spec.Volumes {
+			disksInfo[volume.Name] = &disk.DiskInfo{Format: "raw"}
+			Expect(os.WriteFile(GetDiskTargetPathFromLauncherView(i), nil, 0600)).To(Succeed())
+			Expect(os.MkdirAll(GetPersistentOverlayDir(volume.Name), 0750)).To(Succeed())
+		}
+		Expect(CreateEphemeralImages(vmi, &ephemeraldiskfake.MockEphemeralDiskImageCreator{BaseDir: tmpDir}, disksInfo)).To(Succeed())
+	}
+
+	It("should create the overlay on the PVC on the first boot", func() {
+		createImages(libvmi.WithContainerDiskPersistentOverlay("disk0", "image", resource.MustParse("1Gi")))
+		Expect(created).To(ConsistOf(GetPersistentOverlayPath("disk0")))
+		Expect(rebased).To(BeEmpty())
+	})
+
+	It("should keep the overlay when the container disk path did not change", func() {
+		createImages(libvmi.WithContainerDiskPersistentOverlay("disk0", "image", resource.MustParse("1Gi")))
+		createImages(libvmi.WithContainerDiskPersistentOverlay("disk0", "image", resource.MustParse("1Gi")))
+		Expect(created).To(HaveLen(1))
+		Expect(rebased).To(BeEmpty())
+	})
+
+	It("should rebase the overlay when the container disk moved", func() {
+		createImages(libvmi.WithContainerDiskPersistentOverlay("disk0", "image", resource.MustParse("1Gi")))
+		createImages(
+			libvmi.WithContainerDisk("disk1", "image"),
+			libvmi.WithContainerDiskPersistentOverlay("disk0", "image", resource.MustParse("1Gi")),
+		)
+		Expect(created).To(HaveLen(1))
+		Expect(rebased).To(ConsistOf(GetPersistentOverlayPath("disk0")))
+		Expect(backingFiles).To(HaveKeyWithValue(GetPersistentOverlayPath("disk0"), GetDiskTargetPathFromLauncherView(1)))
+	})
+})
@@ -73,6 +73,9 @@ const (
 	FailedBackendStorageProbeReason = "FailedBackendStorageProbe"
 	// BackendStorageNotReadyReason is added when the backend storage PVC is pending.
 	BackendStorageNotReadyReason = "BackendStorageNotReady"
+	// ContainerDiskOverlayImageMismatchReason is added when the persistent overlay of a container disk
+	// was created on top of another image than the one currently requested.
+	ContainerDiskOverlayImageMismatchReason = "ContainerDiskOverlayImageMismatch"
 	// SuccessfulHandOverPodReason is added in an event
 	// when the pod ownership transfer from the controller to virt-hander succeeds.
 	SuccessfulHandOverPodReason = "SuccessfulHandOver"
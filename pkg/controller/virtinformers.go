@@ -138,6 +138,12 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineBackupTracker objects
 	VirtualMachineBackupTracker() cache.SharedIndexInformer
 
+	// Watches VirtualMachineBackupSchedule objects
+	VirtualMachineBackupSchedule() cache.SharedIndexInformer
+
+	// Watches VirtualMachineBackupRestore objects
+	VirtualMachineBackupRestore() cache.SharedIndexInformer
+
 	// Watches VirtualMachineExport objects
 	VirtualMachineExport() cache.SharedIndexInformer
 
@@ -150,6 +156,15 @@ type KubeInformerFactory interface {
 	// Watches VirtualMachineRestore objects
 	VirtualMachineRestore() cache.SharedIndexInformer
 
+	// Watches VirtualMachineSnapshotSchedule objects
+	VirtualMachineSnapshotSchedule() cache.SharedIndexInformer
+
+	// Watches VirtualMachineSnapshotGroup objects
+	VirtualMachineSnapshotGroup() cache.SharedIndexInformer
+
+	// Watches VirtualMachineSnapshotGroupRestore objects
+	VirtualMachineSnapshotGroupRestore() cache.SharedIndexInformer
+
 	// Watches MigrationPolicy objects
 	MigrationPolicy() cache.SharedIndexInformer
 
@@ -657,6 +672,30 @@ func GetVirtualMachineBackupInformerIndexers() cache.Indexers {
 				return []string{fmt.Sprintf("%s/%s", backup.Namespace, backup.Spec.Source.Name)}, nil
 			}
 
+			return nil, nil
+		},
+		"backupSchedule": func(obj interface{}) ([]string, error) {
+			backup, ok := obj.(*backupv1.VirtualMachineBackup)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if scheduleName, ok := backup.Labels[backupv1.BackupScheduleLabel]; ok {
+				return []string{fmt.Sprintf("%s/%s", backup.Namespace, scheduleName)}, nil
+			}
+
+			return nil, nil
+		},
+		"backupCheckpoint": func(obj interface{}) ([]string, error) {
+			backup, ok := obj.(*backupv1.VirtualMachineBackup)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if backup.Status != nil && backup.Status.CheckpointName != nil {
+				return []string{fmt.Sprintf("%s/%s", backup.Namespace, *backup.Status.CheckpointName)}, nil
+			}
+
 			return nil, nil
 		},
 	}
@@ -697,6 +736,34 @@ func (f *kubeInformerFactory) VirtualMachineBackupTracker() cache.SharedIndexInf
 	})
 }
 
+func GetVirtualMachineBackupScheduleInformerIndexers() cache.Indexers {
+	return cache.Indexers{
+		cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		"backupTracker": func(obj interface{}) ([]string, error) {
+			schedule, ok := obj.(*backupv1.VirtualMachineBackupSchedule)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			return []string{fmt.Sprintf("%s/%s", schedule.Namespace, schedule.Spec.TrackerName)}, nil
+		},
+	}
+}
+
+func (f *kubeInformerFactory) VirtualMachineBackupSchedule() cache.SharedIndexInformer {
+	return f.getInformer("vmBackupScheduleInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().BackupV1alpha1().RESTClient(), "virtualmachinebackupschedules", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &backupv1.VirtualMachineBackupSchedule{}, f.defaultResync, GetVirtualMachineBackupScheduleInformerIndexers())
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineBackupRestore() cache.SharedIndexInformer {
+	return f.getInformer("vmBackupRestoreInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().BackupV1alpha1().RESTClient(), "virtualmachinebackuprestores", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &backupv1.VirtualMachineBackupRestore{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
 func GetVirtualMachineExportInformerIndexers() cache.Indexers {
 	return cache.Indexers{
 		"pvc": func(obj interface{}) ([]string, error) {
@@ -727,6 +794,20 @@ func GetVirtualMachineExportInformerIndexers() cache.Indexers {
 
 			return nil, nil
 		},
+		"vmbackup": func(obj interface{}) ([]string, error) {
+			export, ok := obj.(*exportv1.VirtualMachineExport)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if export.Spec.Source.APIGroup != nil &&
+				*export.Spec.Source.APIGroup == backupv1.SchemeGroupVersion.Group &&
+				export.Spec.Source.Kind == "VirtualMachineBackup" {
+				return []string{fmt.Sprintf("%s/%s", export.Namespace, export.Spec.Source.Name)}, nil
+			}
+
+			return nil, nil
+		},
 		"vm": func(obj interface{}) ([]string, error) {
 			export, ok := obj.(*exportv1.VirtualMachineExport)
 			if !ok {
@@ -765,6 +846,18 @@ func GetVirtualMachineSnapshotInformerIndexers() cache.Indexers {
 				return []string{fmt.Sprintf("%s/%s", vms.Namespace, vms.Spec.Source.Name)}, nil
 			}
 
+			return nil, nil
+		},
+		"snapshotSchedule": func(obj interface{}) ([]string, error) {
+			vms, ok := obj.(*snapshotv1.VirtualMachineSnapshot)
+			if !ok {
+				return nil, unexpectedObjectError
+			}
+
+			if scheduleName, ok := vms.Labels[snapshotv1.SnapshotScheduleLabel]; ok {
+				return []string{fmt.Sprintf("%s/%s", vms.Namespace, scheduleName)}, nil
+			}
+
 			return nil, nil
 		},
 	}
@@ -830,6 +923,27 @@ func (f *kubeInformerFactory) VirtualMachineRestore() cache.SharedIndexInformer
 	})
 }
 
+func (f *kubeInformerFactory) VirtualMachineSnapshotSchedule() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotScheduleInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotschedules", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotSchedule{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineSnapshotGroup() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotGroupInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotgroups", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotGroup{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
+func (f *kubeInformerFactory) VirtualMachineSnapshotGroupRestore() cache.SharedIndexInformer {
+	return f.getInformer("vmSnapshotGroupRestoreInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().SnapshotV1beta1().RESTClient(), "virtualmachinesnapshotgrouprestores", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &snapshotv1.VirtualMachineSnapshotGroupRestore{}, f.defaultResync, cache.Indexers{})
+	})
+}
+
 func (f *kubeInformerFactory) MigrationPolicy() cache.SharedIndexInformer {
 	return f.getInformer("migrationPolicyInformer", func() cache.SharedIndexInformer {
 		lw := cache.NewListWatchFromClient(f.clientSet.GeneratedKubeVirtClient().MigrationsV1alpha1().RESTClient(), migrations.ResourceMigrationPolicies, k8sv1.NamespaceAll, fields.Everything())
@@ -866,6 +980,9 @@ func GetVirtualMachineCloneInformerIndexers() cache.Indexers {
 
 			target := vmClone.Spec.Target
 			if target != nil && target.APIGroup != nil && *target.APIGroup == core.GroupName && target.Kind == "VirtualMachine" {
+				if vmClone.Spec.TargetNamespace != "" {
+					return []string{fmt.Sprintf("%s/%s", vmClone.Spec.TargetNamespace, target.Name)}, nil
+				}
 				return []string{getkey(vmClone, target.Name)}, nil
 			}
 
@@ -44,6 +44,8 @@ It has these top-level messages:
 	DirtyRateStatsResponse
 	ScreenshotResponse
 	BackupRequest
+	ChangedBlocksRequest
+	ChangedBlocksResponse
 */
 package v1
 
@@ -842,6 +844,7 @@ func (m *FreezeRequest) GetUnfreezeTimeoutSeconds() int32 {
 type MemoryDumpRequest struct {
 	Vmi      *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
 	DumpPath string `protobuf:"bytes,2,opt,name=dumpPath" json:"dumpPath,omitempty"`
+	Format   string `protobuf:"bytes,3,opt,name=format" json:"format,omitempty"`
 }
 
 func (m *MemoryDumpRequest) Reset()                    { *m = MemoryDumpRequest{} }
@@ -863,6 +866,13 @@ func (m *MemoryDumpRequest) GetDumpPath() string {
 	return ""
 }
 
+func (m *MemoryDumpRequest) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
 type SEVInfoResponse struct {
 	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
 	SevInfo  []byte    `protobuf:"bytes,2,opt,name=sevInfo,proto3" json:"sevInfo,omitempty"`
@@ -1015,6 +1025,54 @@ func (m *BackupRequest) GetOptions() []byte {
 	return nil
 }
 
+type ChangedBlocksRequest struct {
+	Vmi     *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	Options []byte `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *ChangedBlocksRequest) Reset()                    { *m = ChangedBlocksRequest{} }
+func (m *ChangedBlocksRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChangedBlocksRequest) ProtoMessage()               {}
+func (*ChangedBlocksRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *ChangedBlocksRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *ChangedBlocksRequest) GetOptions() []byte {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+type ChangedBlocksResponse struct {
+	Response      *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	ChangedBlocks string    `protobuf:"bytes,2,opt,name=changedBlocks" json:"changedBlocks,omitempty"`
+}
+
+func (m *ChangedBlocksResponse) Reset()                    { *m = ChangedBlocksResponse{} }
+func (m *ChangedBlocksResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChangedBlocksResponse) ProtoMessage()               {}
+func (*ChangedBlocksResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *ChangedBlocksResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *ChangedBlocksResponse) GetChangedBlocks() string {
+	if m != nil {
+		return m.ChangedBlocks
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -1050,6 +1108,8 @@ func init() {
 	proto.RegisterType((*DirtyRateStatsResponse)(nil), "kubevirt.cmd.v1.DirtyRateStatsResponse")
 	proto.RegisterType((*ScreenshotResponse)(nil), "kubevirt.cmd.v1.ScreenshotResponse")
 	proto.RegisterType((*BackupRequest)(nil), "kubevirt.cmd.v1.BackupRequest")
+	proto.RegisterType((*ChangedBlocksRequest)(nil), "kubevirt.cmd.v1.ChangedBlocksRequest")
+	proto.RegisterType((*ChangedBlocksResponse)(nil), "kubevirt.cmd.v1.ChangedBlocksResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1097,6 +1157,7 @@ type CmdClient interface {
 	GetDomainDirtyRateStats(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DirtyRateStatsResponse, error)
 	GetScreenshot(ctx context.Context, in *VMIRequest, opts ...grpc.CallOption) (*ScreenshotResponse, error)
 	BackupVirtualMachine(ctx context.Context, in *BackupRequest, opts ...grpc.CallOption) (*Response, error)
+	GetChangedBlocks(ctx context.Context, in *ChangedBlocksRequest, opts ...grpc.CallOption) (*ChangedBlocksResponse, error)
 }
 
 type cmdClient struct {
@@ -1413,6 +1474,15 @@ func (c *cmdClient) BackupVirtualMachine(ctx context.Context, in *BackupRequest,
 	return out, nil
 }
 
+func (c *cmdClient) GetChangedBlocks(ctx context.Context, in *ChangedBlocksRequest, opts ...grpc.CallOption) (*ChangedBlocksResponse, error) {
+	out := new(ChangedBlocksResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GetChangedBlocks", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1450,6 +1520,7 @@ type CmdServer interface {
 	GetDomainDirtyRateStats(context.Context, *EmptyRequest) (*DirtyRateStatsResponse, error)
 	GetScreenshot(context.Context, *VMIRequest) (*ScreenshotResponse, error)
 	BackupVirtualMachine(context.Context, *BackupRequest) (*Response, error)
+	GetChangedBlocks(context.Context, *ChangedBlocksRequest) (*ChangedBlocksResponse, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -2068,6 +2139,24 @@ func _Cmd_BackupVirtualMachine_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_GetChangedBlocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangedBlocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GetChangedBlocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GetChangedBlocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GetChangedBlocks(ctx, req.(*ChangedBlocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -2208,6 +2297,10 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BackupVirtualMachine",
 			Handler:    _Cmd_BackupVirtualMachine_Handler,
 		},
+		{
+			MethodName: "GetChangedBlocks",
+			Handler:    _Cmd_GetChangedBlocks_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -2216,125 +2309,129 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1920 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x73, 0xdb, 0xc6,
-	0x11, 0x17, 0x45, 0x4a, 0x22, 0x57, 0x7f, 0x62, 0x9f, 0x25, 0x19, 0x52, 0x6b, 0x5b, 0xbd, 0x76,
-	0x5c, 0xa5, 0x93, 0x48, 0xb5, 0xe3, 0x64, 0x3a, 0x9e, 0x4e, 0xc6, 0x11, 0x45, 0x29, 0x4a, 0x4c,
-	0x9b, 0x06, 0x25, 0x79, 0x9a, 0x36, 0x93, 0x39, 0x01, 0x27, 0xf2, 0x2a, 0xe0, 0x8e, 0xc1, 0x1d,
-	0x58, 0x33, 0x4f, 0x9d, 0x71, 0xa7, 0x0f, 0x9d, 0xe9, 0xe7, 0x6b, 0x9f, 0xfa, 0x2d, 0xfa, 0x9e,
-	0xb9, 0x03, 0x40, 0x81, 0x04, 0x20, 0x5a, 0x43, 0x3e, 0x11, 0x77, 0xb7, 0xfb, 0xdb, 0xbd, 0xbd,
-	0xdd, 0xbd, 0x1f, 0x40, 0xf8, 0xb8, 0x77, 0xd5, 0xd9, 0xef, 0x12, 0xee, 0x7a, 0x34, 0xf8, 0xd4,
-	0x23, 0x21, 0x77, 0xba, 0x34, 0xf8, 0xd4, 0x11, 0xfe, 0xbe, 0xe3, 0xbb, 0xfb, 0xfd, 0x27, 0xfa,
-	0x67, 0xaf, 0x17, 0x08, 0x25, 0xd0, 0x47, 0x57, 0xe1, 0x05, 0xed, 0xb3, 0x40, 0xed, 0xe9, 0xb9,
-	0xfe, 0x13, 0x7c, 0x09, 0xf7, 0xde, 0x50, 0x3f, 0x3c, 0xa7, 0x81, 0x64, 0x82, 0xdb, 0x54, 0xf6,
-	0x04, 0x97, 0x14, 0x7d, 0x0e, 0xd5, 0x20, 0x7e, 0xb6, 0x4a, 0x3b, 0xa5, 0xdd, 0xe5, 0xa7, 0x5b,
-	0x7b, 0x63, 0xaa, 0x7b, 0x89, 0xb0, 0x3d, 0x14, 0x45, 0x16, 0x2c, 0xf5, 0x23, 0x24, 0x6b, 0x7e,
-	0xa7, 0xb4, 0x5b, 0xb3, 0x93, 0x21, 0x7e, 0x04, 0xe5, 0xf3, 0xe6, 0x89, 0x11, 0xf0, 0xd9, 0x37,
-	0x52, 0x70, 0x03, 0xbb, 0x62, 0x27, 0x43, 0xfc, 0x04, 0xca, 0xf5, 0xd6, 0x19, 0x5a, 0x83, 0x79,
-	0xe6, 0x9a, 0xb5, 0x55, 0x7b, 0x9e, 0xb9, 0x68, 0x1b, 0xaa, 0x92, 0x5d, 0x78, 0x8c, 0x77, 0xa4,
-	0x35, 0xbf, 0x53, 0xde, 0x5d, 0xb5, 0x87, 0x63, 0xbc, 0x0f, 0x4b, 0xed, 0xe8, 0x39, 0xa3, 0xb6,
-	0x0e, 0x0b, 0x7d, 0xe2, 0x85, 0xd4, 0xb8, 0x51, 0xb1, 0xa3, 0x01, 0x6e, 0xc0, 0x42, 0x8b, 0x74,
-	0xa8, 0xd4, 0xcb, 0x8e, 0x08, 0xb9, 0x32, 0x1a, 0x15, 0x3b, 0x1a, 0x20, 0x04, 0x95, 0x90, 0x33,
-	0x15, 0xbb, 0x6e, 0x9e, 0xf5, 0x9c, 0x64, 0x3f, 0x51, 0xab, 0x6c, 0xa0, 0xcd, 0x33, 0x7e, 0x06,
-	0x8b, 0x4d, 0xea, 0x8b, 0x60, 0x80, 0x36, 0x61, 0x91, 0xf8, 0x29, 0xa0, 0x78, 0x94, 0x87, 0x84,
-	0xff, 0x53, 0x82, 0x4a, 0x9d, 0x7a, 0x5e, 0xc6, 0xd7, 0x7d, 0x58, 0xf4, 0x0d, 0x9c, 0x11, 0x5f,
-	0x7e, 0x7a, 0x3f, 0x13, 0xe9, 0xc8, 0x9a, 0x1d, 0x8b, 0xa1, 0x4f, 0x60, 0xa1, 0xa7, 0xb7, 0x61,
-	0x95, 0x77, 0xca, 0xbb, 0xcb, 0x4f, 0x37, 0x33, 0xf2, 0x66, 0x93, 0x76, 0x24, 0x84, 0xbe, 0x80,
-	0x9a, 0xcb, 0xa4, 0x22, 0xdc, 0xa1, 0xd2, 0xaa, 0x18, 0x0d, 0x2b, 0xa3, 0x11, 0xc7, 0xd1, 0xbe,
-	0x16, 0x45, 0xbb, 0x50, 0x71, 0x7a, 0xa1, 0xb4, 0x16, 0x8c, 0xca, 0x7a, 0x46, 0xa5, 0xde, 0x3a,
-	0xb3, 0x8d, 0x04, 0x7e, 0x01, 0xd5, 0x53, 0xd1, 0x13, 0x9e, 0xe8, 0x0c, 0xd0, 0x33, 0x00, 0x1e,
-	0xfa, 0xe4, 0x07, 0x87, 0x7a, 0x9e, 0xb4, 0x4a, 0x46, 0x77, 0x23, 0xab, 0x4b, 0x3d, 0xcf, 0xae,
-	0x69, 0x41, 0xfd, 0x24, 0xf1, 0xbf, 0x4a, 0xb0, 0xd8, 0x6e, 0x1e, 0x30, 0x21, 0x11, 0x86, 0x15,
-	0x9f, 0xf0, 0xf0, 0x92, 0x38, 0x2a, 0x0c, 0x68, 0x60, 0xe2, 0x54, 0xb3, 0x47, 0xe6, 0x74, 0x16,
-	0xf5, 0x02, 0xe1, 0x86, 0x4e, 0x12, 0xe1, 0x64, 0x98, 0x4e, 0xc0, 0xf2, 0x48, 0x02, 0xa2, 0x3b,
-	0x50, 0x96, 0x57, 0xa1, 0x55, 0x31, 0xb3, 0xfa, 0x51, 0x1f, 0xde, 0x25, 0xf1, 0x99, 0x37, 0xb0,
-	0x16, 0xcc, 0x64, 0x3c, 0xc2, 0xff, 0x2c, 0x41, 0xf5, 0x90, 0xc9, 0xab, 0x13, 0x7e, 0x29, 0x8c,
-	0x90, 0x08, 0x7c, 0xa2, 0x62, 0x47, 0xe2, 0x11, 0xda, 0x81, 0xe5, 0x0b, 0xe2, 0x5c, 0x31, 0xde,
-	0x39, 0x62, 0x1e, 0x8d, 0xdd, 0x48, 0x4f, 0xa1, 0x87, 0x00, 0xda, 0x5f, 0xe2, 0xb5, 0x93, 0xfc,
-	0xa9, 0xd8, 0xa9, 0x19, 0x8d, 0xa0, 0x43, 0x92, 0x08, 0x54, 0x8c, 0x40, 0x7a, 0x0a, 0xff, 0xbf,
-	0x04, 0xab, 0x75, 0x2f, 0x94, 0x8a, 0x06, 0x75, 0xc1, 0x2f, 0x59, 0x07, 0xed, 0x01, 0x6a, 0xbc,
-	0xeb, 0x11, 0xee, 0x6a, 0xff, 0x64, 0x83, 0x93, 0x0b, 0x8f, 0x46, 0xa9, 0x54, 0xb5, 0x73, 0x56,
-	0xd0, 0x1f, 0x61, 0xeb, 0x28, 0xa0, 0x54, 0xe7, 0x83, 0x4d, 0x7b, 0x22, 0x50, 0x8c, 0x77, 0x0e,
-	0x99, 0x8c, 0xd4, 0xe6, 0x8d, 0x5a, 0xb1, 0x00, 0x7a, 0x0e, 0xd6, 0x81, 0x70, 0xba, 0xf2, 0x90,
-	0xc9, 0x9e, 0x47, 0x06, 0x47, 0x22, 0x68, 0x1c, 0x9d, 0x1c, 0x87, 0x54, 0x2a, 0x69, 0xf6, 0x53,
-	0xb5, 0x0b, 0xd7, 0xb5, 0x6e, 0x9b, 0x06, 0x8c, 0x78, 0x75, 0xc1, 0xa5, 0xf0, 0xe8, 0x4b, 0x71,
-	0x6d, 0xb8, 0x12, 0xe9, 0x16, 0xad, 0xe3, 0xcf, 0x60, 0xeb, 0x84, 0x2b, 0x1a, 0x5c, 0x12, 0x87,
-	0x1e, 0x30, 0xee, 0x32, 0xde, 0x69, 0xb2, 0x4e, 0x40, 0x94, 0x3e, 0xc7, 0x4d, 0x5d, 0x7c, 0xaa,
-	0x2b, 0xdc, 0xe4, 0x40, 0xa2, 0x11, 0xfe, 0xdf, 0x12, 0x6c, 0x9c, 0x47, 0xc1, 0x6b, 0x12, 0xa7,
-	0xcb, 0x38, 0x7d, 0xdd, 0xd3, 0x0a, 0x12, 0x7d, 0x0b, 0xeb, 0xa3, 0x0b, 0x51, 0xa6, 0xc5, 0x7d,
-	0x2d, 0x5b, 0x6d, 0xd1, 0xb2, 0x9d, 0xab, 0x84, 0x9e, 0xc1, 0x46, 0x93, 0xfa, 0x07, 0xc4, 0xf3,
-	0x84, 0xe0, 0x6d, 0x45, 0x94, 0x6c, 0xd1, 0x80, 0x89, 0x28, 0x9a, 0xab, 0x76, 0xfe, 0x22, 0xfa,
-	0x3d, 0xdc, 0x6b, 0x05, 0x54, 0xcf, 0x3b, 0x44, 0x51, 0xf7, 0x5c, 0x78, 0xa1, 0x1f, 0xd7, 0x6f,
-	0xcd, 0xce, 0x5b, 0xd2, 0x0d, 0x58, 0xc5, 0x35, 0x65, 0xe2, 0x95, 0xd7, 0x80, 0x93, 0xa2, 0xb3,
-	0x87, 0xa2, 0xa8, 0x0d, 0x35, 0x93, 0x00, 0x3a, 0x77, 0xe3, 0xca, 0xfd, 0x3c, 0xa3, 0x97, 0x1b,
-	0xa6, 0xbd, 0xa1, 0x5e, 0x83, 0xab, 0x60, 0x60, 0x5f, 0xe3, 0x14, 0x64, 0xdd, 0x62, 0x61, 0xd6,
-	0x1d, 0xc2, 0xaa, 0x93, 0x4e, 0x5b, 0x6b, 0xc9, 0x6c, 0xe0, 0x61, 0xb6, 0x0d, 0xa4, 0xa5, 0xec,
-	0x51, 0x25, 0xf4, 0xbe, 0x04, 0x5b, 0x2c, 0x49, 0x83, 0x43, 0xe1, 0x13, 0xc6, 0xbf, 0x52, 0x8a,
-	0x38, 0x5d, 0x9f, 0x72, 0x65, 0x55, 0xcd, 0xde, 0x1a, 0x1f, 0xb8, 0xb7, 0x93, 0x22, 0x9c, 0x68,
-	0xaf, 0xc5, 0x76, 0x10, 0x07, 0x34, 0x5c, 0x1c, 0x26, 0xa1, 0x55, 0x33, 0xd6, 0xbf, 0xbc, 0xad,
-	0xf5, 0x21, 0x40, 0x64, 0x36, 0x07, 0x79, 0xfb, 0x2d, 0xac, 0x8d, 0x1e, 0x84, 0x6e, 0x5c, 0x57,
-	0x74, 0x10, 0x67, 0xbb, 0x7e, 0x44, 0xfb, 0xe9, 0xcb, 0x2d, 0x2f, 0x31, 0x92, 0xee, 0x15, 0xdf,
-	0x7b, 0xcf, 0xe7, 0xff, 0x50, 0xda, 0x7e, 0x09, 0x0f, 0x6f, 0x8e, 0x42, 0x8e, 0xa1, 0x91, 0x5b,
-	0xb4, 0x96, 0x46, 0xfb, 0x11, 0xee, 0x17, 0xec, 0x2a, 0x07, 0xe6, 0xc5, 0xa8, 0xbf, 0xbf, 0xcb,
-	0xf8, 0x5b, 0x58, 0xed, 0x29, 0x93, 0xb8, 0x0f, 0x70, 0xde, 0x3c, 0xb1, 0xe9, 0x8f, 0xba, 0xc1,
-	0xa0, 0xc7, 0x50, 0xee, 0xfb, 0x2c, 0xae, 0xe1, 0xec, 0xe5, 0xa4, 0x25, 0xb5, 0x00, 0x7a, 0x01,
-	0x4b, 0x22, 0x3a, 0x86, 0xd8, 0xfa, 0xe3, 0x0f, 0x3b, 0x34, 0x3b, 0x51, 0xc3, 0xa7, 0x70, 0xe7,
-	0xda, 0x9f, 0x5b, 0x5a, 0xb7, 0x46, 0xad, 0xaf, 0x5c, 0xa3, 0xbe, 0x2f, 0xc1, 0x72, 0xe3, 0x1d,
-	0x75, 0x12, 0xc4, 0x87, 0x00, 0xae, 0x39, 0x95, 0x57, 0xc4, 0xa7, 0x71, 0xf0, 0x52, 0x33, 0x1a,
-	0xa9, 0x2e, 0x7c, 0x9f, 0x70, 0x37, 0xb9, 0xf2, 0xe2, 0xa1, 0xe6, 0x1a, 0x5f, 0x05, 0x9d, 0xa4,
-	0x99, 0x98, 0x67, 0xf4, 0x18, 0xd6, 0x14, 0xf3, 0xa9, 0x08, 0x55, 0x9b, 0x3a, 0x82, 0xbb, 0xd2,
-	0xf4, 0x90, 0x05, 0x7b, 0x6c, 0x16, 0xaf, 0xc1, 0x4a, 0xc3, 0xef, 0xa9, 0x41, 0xec, 0x05, 0xfe,
-	0x12, 0xaa, 0x76, 0x8a, 0xcb, 0xc9, 0xd0, 0x71, 0xa8, 0x94, 0xf1, 0x05, 0x93, 0x0c, 0xf5, 0x8a,
-	0x4f, 0xa5, 0x24, 0x9d, 0x24, 0x31, 0x92, 0x21, 0xfe, 0x01, 0xd6, 0xa2, 0xdc, 0x9a, 0x96, 0x48,
-	0x6e, 0xc2, 0x62, 0xb4, 0xf9, 0xd8, 0x42, 0x3c, 0xc2, 0x1c, 0xee, 0x45, 0x06, 0x4c, 0x77, 0x9d,
-	0xd6, 0xca, 0x0e, 0x2c, 0xbb, 0xd7, 0x68, 0xc9, 0x25, 0x9e, 0x9a, 0xc2, 0xef, 0xe0, 0xae, 0xb9,
-	0xd0, 0x4c, 0x35, 0x4d, 0x69, 0xed, 0x13, 0xb8, 0xdb, 0x19, 0xc7, 0x8a, 0x6d, 0x66, 0x17, 0xf0,
-	0x3f, 0x4a, 0xb0, 0x61, 0x4c, 0x9f, 0x49, 0x1a, 0xbc, 0x64, 0x52, 0x4d, 0x6b, 0xfe, 0x19, 0x6c,
-	0x74, 0xf2, 0xf0, 0x62, 0x17, 0xf2, 0x17, 0xf1, 0xbf, 0x4b, 0x60, 0x19, 0x37, 0x34, 0xa7, 0x91,
-	0x03, 0xa9, 0xa8, 0x3f, 0x75, 0xd8, 0x9f, 0x83, 0xd5, 0x29, 0x80, 0x8c, 0x9d, 0x29, 0x5c, 0xc7,
-	0x03, 0x58, 0x89, 0xca, 0x66, 0x3a, 0x17, 0xb6, 0xa1, 0x4a, 0xdf, 0x31, 0x55, 0x17, 0x6e, 0x64,
-	0x72, 0xc1, 0x1e, 0x8e, 0x75, 0xee, 0x49, 0xe5, 0xbe, 0x0e, 0x55, 0x4c, 0x21, 0xe3, 0x11, 0xfe,
-	0x0e, 0xee, 0x98, 0x48, 0xb4, 0x34, 0x51, 0xfe, 0xc0, 0xb2, 0xcd, 0x16, 0xe2, 0x7c, 0x6e, 0x21,
-	0x7e, 0x13, 0xe7, 0x59, 0x84, 0x3d, 0xd5, 0xde, 0xb0, 0x80, 0x55, 0xcd, 0xe9, 0x7e, 0xa2, 0xb7,
-	0xed, 0x56, 0x5f, 0xc0, 0x66, 0xc8, 0x2f, 0x8d, 0xea, 0x69, 0x9e, 0xd3, 0x05, 0xab, 0xf8, 0x2d,
-	0xdc, 0x8d, 0xde, 0x50, 0x0e, 0x43, 0xbf, 0x77, 0x5b, 0xa3, 0xdb, 0x50, 0x75, 0x43, 0xbf, 0xd7,
-	0x22, 0xaa, 0x1b, 0x1f, 0xfe, 0x70, 0x8c, 0x2f, 0xe0, 0xa3, 0x76, 0xe3, 0x7c, 0x16, 0xb5, 0xa7,
-	0x9b, 0x19, 0xed, 0x1b, 0x56, 0x14, 0x37, 0xe2, 0x78, 0x88, 0xff, 0x5e, 0x82, 0xad, 0x97, 0xe6,
-	0x9d, 0xb9, 0x49, 0x89, 0x0c, 0x03, 0xaa, 0x2f, 0xc4, 0x19, 0x94, 0xba, 0x37, 0x8e, 0x19, 0x1b,
-	0xce, 0x2e, 0xe0, 0xef, 0x35, 0xdf, 0xfd, 0x2b, 0x75, 0x54, 0xe4, 0x47, 0x9b, 0x3a, 0x01, 0x55,
-	0xb3, 0xbb, 0x6a, 0x24, 0x6c, 0x1e, 0xb2, 0x40, 0x0d, 0x6c, 0xa2, 0xe8, 0x4c, 0xda, 0x26, 0x86,
-	0x15, 0x37, 0x01, 0x6c, 0x5e, 0x44, 0xf6, 0xca, 0xf6, 0xc8, 0x1c, 0x96, 0x80, 0xda, 0x4e, 0x40,
-	0x29, 0x97, 0x5d, 0x31, 0x75, 0x38, 0x11, 0x54, 0x7c, 0xe6, 0x27, 0xcd, 0xc1, 0x3c, 0xeb, 0x39,
-	0x97, 0x28, 0x62, 0x6a, 0x74, 0xc5, 0x36, 0xcf, 0xf8, 0x0d, 0xac, 0x1e, 0x10, 0xe7, 0x2a, 0xec,
-	0xcd, 0x2c, 0x78, 0x4f, 0xff, 0xbb, 0x09, 0xe5, 0xba, 0xef, 0xa2, 0x57, 0x80, 0xda, 0x03, 0xee,
-	0x8c, 0x72, 0x05, 0xf4, 0x8b, 0x5c, 0xc8, 0xc8, 0xf8, 0x76, 0xf1, 0xd6, 0xf0, 0x1c, 0x7a, 0x0d,
-	0xf7, 0x5a, 0x24, 0x94, 0x74, 0x66, 0x80, 0x6f, 0x60, 0xe3, 0x8c, 0xf7, 0x66, 0x0a, 0xd9, 0x86,
-	0xf5, 0xa8, 0x91, 0x8c, 0x21, 0x66, 0x89, 0xfc, 0x48, 0xbf, 0xb9, 0x19, 0xd4, 0x86, 0xcd, 0xb3,
-	0xb8, 0x8d, 0xcc, 0x32, 0x98, 0x36, 0x95, 0x54, 0xcd, 0x0c, 0xf0, 0x14, 0xac, 0xb6, 0xb8, 0x54,
-	0x36, 0xbd, 0x10, 0x62, 0x76, 0xa8, 0x36, 0x6c, 0xb6, 0xbb, 0xa1, 0x72, 0xc5, 0xdf, 0xf8, 0xcc,
-	0x30, 0x5f, 0x01, 0xfa, 0x96, 0x79, 0xde, 0xcc, 0xf0, 0x5a, 0xb0, 0x7e, 0x48, 0x3d, 0xaa, 0x66,
-	0x77, 0x38, 0x6f, 0x61, 0x23, 0xe2, 0xcf, 0xe3, 0x90, 0xbf, 0xca, 0x7e, 0xe7, 0x1a, 0xe3, 0xd9,
-	0x13, 0x4f, 0x5d, 0x97, 0xe4, 0x50, 0xe9, 0x94, 0x04, 0x1d, 0xaa, 0xa6, 0xf0, 0xf4, 0x4f, 0xf0,
-	0xa0, 0x4e, 0xb8, 0x43, 0xc7, 0xa2, 0x79, 0xfd, 0xed, 0x61, 0xba, 0xa3, 0x67, 0x1d, 0x4e, 0xbc,
-	0xc8, 0xc9, 0x96, 0x70, 0xeb, 0x1e, 0x25, 0x3c, 0xec, 0x4d, 0x81, 0xf9, 0x67, 0x78, 0x74, 0xc4,
-	0x38, 0xf1, 0xd8, 0x78, 0x25, 0xcd, 0xc2, 0xe1, 0x57, 0x80, 0xbe, 0x16, 0xaa, 0xe7, 0x85, 0x9d,
-	0xaf, 0x85, 0x54, 0x87, 0xb4, 0xcf, 0x1c, 0x2a, 0xa7, 0xc0, 0x6b, 0x42, 0xed, 0x98, 0xaa, 0x88,
-	0xbb, 0xa3, 0x07, 0x19, 0xc9, 0xf4, 0x5b, 0xc8, 0xf6, 0xa3, 0xec, 0x0b, 0xed, 0xc8, 0x4b, 0x85,
-	0x49, 0xaa, 0xb5, 0x21, 0x9c, 0xb9, 0xd3, 0x26, 0x61, 0xfe, 0xa6, 0x00, 0x73, 0xe4, 0x42, 0x34,
-	0x3d, 0x6f, 0xe5, 0x98, 0xaa, 0x21, 0xe7, 0x9f, 0x04, 0x8b, 0x33, 0xcb, 0x99, 0xd7, 0x05, 0x03,
-	0x5a, 0x3d, 0xa6, 0x86, 0x5b, 0x4f, 0xf4, 0xf3, 0x71, 0x3e, 0x60, 0x86, 0x97, 0xcf, 0xa1, 0xbf,
-	0x98, 0x10, 0xa4, 0x38, 0xf2, 0x24, 0xe8, 0x8f, 0xf3, 0xa1, 0xf3, 0x58, 0xf6, 0x1c, 0x3a, 0x80,
-	0x8a, 0xe6, 0xa2, 0x93, 0x30, 0x6f, 0x3c, 0xf3, 0x06, 0x54, 0x34, 0x57, 0x47, 0xbf, 0xcc, 0x62,
-	0x5c, 0xbf, 0xf9, 0x6e, 0x3f, 0x28, 0x58, 0x4d, 0x35, 0xe3, 0xda, 0x90, 0x1b, 0xe7, 0x34, 0x8d,
-	0x71, 0x4e, 0x5e, 0x74, 0x26, 0x69, 0x6a, 0x6d, 0xaa, 0xc7, 0x1a, 0xab, 0x9a, 0x21, 0x85, 0x45,
-	0xb8, 0xe0, 0x0b, 0x7c, 0x8a, 0xdf, 0x4e, 0xea, 0x79, 0xfa, 0x6c, 0x52, 0x7f, 0xac, 0xdc, 0x3e,
-	0x3d, 0x73, 0xfe, 0x95, 0x89, 0xfb, 0x48, 0x86, 0x86, 0xd4, 0x5b, 0x67, 0x72, 0xca, 0xcb, 0x2e,
-	0x83, 0x19, 0xff, 0xc1, 0x31, 0xcd, 0x9d, 0x0c, 0xc7, 0x54, 0xc5, 0xf4, 0x7d, 0xd2, 0xf6, 0x77,
-	0xb2, 0x1f, 0x61, 0x47, 0x79, 0x3f, 0x9e, 0x43, 0x04, 0xd6, 0x8f, 0xa9, 0xca, 0x50, 0xf5, 0x9b,
-	0x5d, 0xcc, 0x7e, 0x6b, 0x2a, 0xe4, 0xfa, 0x78, 0x0e, 0x7d, 0x0f, 0x28, 0x4b, 0xc4, 0x51, 0xde,
-	0xf7, 0xaa, 0x02, 0xb6, 0x7e, 0x73, 0x48, 0x1c, 0xb8, 0x3f, 0x6c, 0x5a, 0xa3, 0x8c, 0x7c, 0x52,
-	0x7c, 0x7e, 0x9b, 0xf3, 0x89, 0x2f, 0x8f, 0xd1, 0x9b, 0x5e, 0xb3, 0xaa, 0xe3, 0x3e, 0xe4, 0xde,
-	0x37, 0xc7, 0xe7, 0xd7, 0xd9, 0xc0, 0x67, 0x58, 0x7b, 0xc4, 0x04, 0x23, 0x62, 0x3d, 0x91, 0x09,
-	0x8e, 0xf0, 0xef, 0x1b, 0xc3, 0x71, 0x50, 0xf9, 0x6e, 0xbe, 0xff, 0xe4, 0x62, 0xd1, 0xfc, 0x31,
-	0xf9, 0xd9, 0xcf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x83, 0xa6, 0x93, 0x1a, 0xc5, 0x1c, 0x00, 0x00,
+	// 1971 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x5f, 0x73, 0x1b, 0xb7,
+	0x11, 0x37, 0x45, 0x4a, 0x26, 0x57, 0x7f, 0x62, 0xc3, 0x92, 0x7c, 0x66, 0x6b, 0x5b, 0x45, 0x53,
+	0x55, 0xe9, 0x24, 0x52, 0xed, 0x38, 0x99, 0x8e, 0xa7, 0x93, 0x71, 0x44, 0x51, 0x8a, 0x12, 0xd3,
+	0xa6, 0x8f, 0x92, 0xd2, 0xa6, 0xcd, 0x64, 0xa0, 0x3b, 0x88, 0x44, 0x75, 0x07, 0x30, 0x07, 0x1c,
+	0x6b, 0xfa, 0xa9, 0x33, 0xe9, 0xf4, 0xa1, 0x33, 0xfd, 0x48, 0xfd, 0x1c, 0x7d, 0xeb, 0xb7, 0xe8,
+	0x7b, 0x07, 0xb8, 0x3b, 0xea, 0xc8, 0xbb, 0x13, 0xad, 0x21, 0x9f, 0x08, 0x60, 0x77, 0x7f, 0xbb,
+	0x58, 0x2c, 0x16, 0xbb, 0x47, 0xf8, 0xa8, 0x7f, 0xd9, 0xdd, 0xeb, 0x11, 0xee, 0x7a, 0x34, 0xf8,
+	0xc4, 0x23, 0x21, 0x77, 0x7a, 0x34, 0xf8, 0xc4, 0x11, 0xfe, 0x9e, 0xe3, 0xbb, 0x7b, 0x83, 0x27,
+	0xfa, 0x67, 0xb7, 0x1f, 0x08, 0x25, 0xd0, 0x07, 0x97, 0xe1, 0x39, 0x1d, 0xb0, 0x40, 0xed, 0xea,
+	0xb5, 0xc1, 0x13, 0x7c, 0x01, 0xf7, 0xde, 0x50, 0x3f, 0x3c, 0xa3, 0x81, 0x64, 0x82, 0xdb, 0x54,
+	0xf6, 0x05, 0x97, 0x14, 0x7d, 0x06, 0xd5, 0x20, 0x1e, 0x5b, 0xa5, 0xad, 0xd2, 0xce, 0xf2, 0xd3,
+	0x07, 0xbb, 0x13, 0xa2, 0xbb, 0x09, 0xb3, 0x3d, 0x62, 0x45, 0x16, 0xdc, 0x1e, 0x44, 0x48, 0xd6,
+	0xc2, 0x56, 0x69, 0xa7, 0x66, 0x27, 0x53, 0xfc, 0x18, 0xca, 0x67, 0xad, 0x63, 0xc3, 0xe0, 0xb3,
+	0xaf, 0xa5, 0xe0, 0x06, 0x76, 0xc5, 0x4e, 0xa6, 0xf8, 0x09, 0x94, 0x1b, 0xed, 0x53, 0xb4, 0x06,
+	0x0b, 0xcc, 0x35, 0xb4, 0x55, 0x7b, 0x81, 0xb9, 0xa8, 0x0e, 0x55, 0xc9, 0xce, 0x3d, 0xc6, 0xbb,
+	0xd2, 0x5a, 0xd8, 0x2a, 0xef, 0xac, 0xda, 0xa3, 0x39, 0xde, 0x83, 0xdb, 0x9d, 0x68, 0x9c, 0x11,
+	0x5b, 0x87, 0xc5, 0x01, 0xf1, 0x42, 0x6a, 0xcc, 0xa8, 0xd8, 0xd1, 0x04, 0x37, 0x61, 0xb1, 0x4d,
+	0xba, 0x54, 0x6a, 0xb2, 0x23, 0x42, 0xae, 0x8c, 0x44, 0xc5, 0x8e, 0x26, 0x08, 0x41, 0x25, 0xe4,
+	0x4c, 0xc5, 0xa6, 0x9b, 0xb1, 0x5e, 0x93, 0xec, 0x1d, 0xb5, 0xca, 0x06, 0xda, 0x8c, 0xf1, 0x33,
+	0x58, 0x6a, 0x51, 0x5f, 0x04, 0x43, 0xb4, 0x09, 0x4b, 0xc4, 0x4f, 0x01, 0xc5, 0xb3, 0x3c, 0x24,
+	0xfc, 0x9f, 0x12, 0x54, 0x1a, 0xd4, 0xf3, 0x32, 0xb6, 0xee, 0xc1, 0x92, 0x6f, 0xe0, 0x0c, 0xfb,
+	0xf2, 0xd3, 0xfb, 0x19, 0x4f, 0x47, 0xda, 0xec, 0x98, 0x0d, 0x7d, 0x0c, 0x8b, 0x7d, 0xbd, 0x0d,
+	0xab, 0xbc, 0x55, 0xde, 0x59, 0x7e, 0xba, 0x99, 0xe1, 0x37, 0x9b, 0xb4, 0x23, 0x26, 0xf4, 0x39,
+	0xd4, 0x5c, 0x26, 0x15, 0xe1, 0x0e, 0x95, 0x56, 0xc5, 0x48, 0x58, 0x19, 0x89, 0xd8, 0x8f, 0xf6,
+	0x15, 0x2b, 0xda, 0x81, 0x8a, 0xd3, 0x0f, 0xa5, 0xb5, 0x68, 0x44, 0xd6, 0x33, 0x22, 0x8d, 0xf6,
+	0xa9, 0x6d, 0x38, 0xf0, 0x0b, 0xa8, 0x9e, 0x88, 0xbe, 0xf0, 0x44, 0x77, 0x88, 0x9e, 0x01, 0xf0,
+	0xd0, 0x27, 0x3f, 0x38, 0xd4, 0xf3, 0xa4, 0x55, 0x32, 0xb2, 0x1b, 0x59, 0x59, 0xea, 0x79, 0x76,
+	0x4d, 0x33, 0xea, 0x91, 0xc4, 0xff, 0x2c, 0xc1, 0x52, 0xa7, 0xb5, 0xcf, 0x84, 0x44, 0x18, 0x56,
+	0x7c, 0xc2, 0xc3, 0x0b, 0xe2, 0xa8, 0x30, 0xa0, 0x81, 0xf1, 0x53, 0xcd, 0x1e, 0x5b, 0xd3, 0x51,
+	0xd4, 0x0f, 0x84, 0x1b, 0x3a, 0x89, 0x87, 0x93, 0x69, 0x3a, 0x00, 0xcb, 0x63, 0x01, 0x88, 0xee,
+	0x40, 0x59, 0x5e, 0x86, 0x56, 0xc5, 0xac, 0xea, 0xa1, 0x3e, 0xbc, 0x0b, 0xe2, 0x33, 0x6f, 0x68,
+	0x2d, 0x9a, 0xc5, 0x78, 0x86, 0xff, 0x51, 0x82, 0xea, 0x01, 0x93, 0x97, 0xc7, 0xfc, 0x42, 0x18,
+	0x26, 0x11, 0xf8, 0x44, 0xc5, 0x86, 0xc4, 0x33, 0xb4, 0x05, 0xcb, 0xe7, 0xc4, 0xb9, 0x64, 0xbc,
+	0x7b, 0xc8, 0x3c, 0x1a, 0x9b, 0x91, 0x5e, 0x42, 0x8f, 0x00, 0xb4, 0xbd, 0xc4, 0xeb, 0x24, 0xf1,
+	0x53, 0xb1, 0x53, 0x2b, 0x1a, 0x41, 0xbb, 0x24, 0x61, 0xa8, 0x18, 0x86, 0xf4, 0x12, 0xfe, 0x5f,
+	0x09, 0x56, 0x1b, 0x5e, 0x28, 0x15, 0x0d, 0x1a, 0x82, 0x5f, 0xb0, 0x2e, 0xda, 0x05, 0xd4, 0x7c,
+	0xdb, 0x27, 0xdc, 0xd5, 0xf6, 0xc9, 0x26, 0x27, 0xe7, 0x1e, 0x8d, 0x42, 0xa9, 0x6a, 0xe7, 0x50,
+	0xd0, 0xef, 0xe1, 0xc1, 0x61, 0x40, 0xa9, 0x8e, 0x07, 0x9b, 0xf6, 0x45, 0xa0, 0x18, 0xef, 0x1e,
+	0x30, 0x19, 0x89, 0x2d, 0x18, 0xb1, 0x62, 0x06, 0xf4, 0x1c, 0xac, 0x7d, 0xe1, 0xf4, 0xe4, 0x01,
+	0x93, 0x7d, 0x8f, 0x0c, 0x0f, 0x45, 0xd0, 0x3c, 0x3c, 0x3e, 0x0a, 0xa9, 0x54, 0xd2, 0xec, 0xa7,
+	0x6a, 0x17, 0xd2, 0xb5, 0x6c, 0x87, 0x06, 0x8c, 0x78, 0x0d, 0xc1, 0xa5, 0xf0, 0xe8, 0x4b, 0x71,
+	0xa5, 0xb8, 0x12, 0xc9, 0x16, 0xd1, 0xf1, 0xa7, 0xf0, 0xe0, 0x98, 0x2b, 0x1a, 0x5c, 0x10, 0x87,
+	0xee, 0x33, 0xee, 0x32, 0xde, 0x6d, 0xb1, 0x6e, 0x40, 0x94, 0x3e, 0xc7, 0x4d, 0x7d, 0xf9, 0x54,
+	0x4f, 0xb8, 0xc9, 0x81, 0x44, 0x33, 0xfc, 0xdf, 0xdb, 0xb0, 0x71, 0x16, 0x39, 0xaf, 0x45, 0x9c,
+	0x1e, 0xe3, 0xf4, 0x75, 0x5f, 0x0b, 0x48, 0xf4, 0x0d, 0xac, 0x8f, 0x13, 0xa2, 0x48, 0xb3, 0x4a,
+	0x05, 0xb7, 0x2d, 0x22, 0xdb, 0xb9, 0x42, 0xe8, 0x19, 0x6c, 0xb4, 0xa8, 0xbf, 0x4f, 0x3c, 0x4f,
+	0x08, 0xde, 0x51, 0x44, 0xc9, 0x36, 0x0d, 0x98, 0x88, 0xbc, 0xb9, 0x6a, 0xe7, 0x13, 0xd1, 0x6f,
+	0xe1, 0x5e, 0x3b, 0xa0, 0x7a, 0xdd, 0x21, 0x8a, 0xba, 0x67, 0xc2, 0x0b, 0xfd, 0xf8, 0xfe, 0xd6,
+	0xec, 0x3c, 0x92, 0x4e, 0xc0, 0x2a, 0xbe, 0x53, 0x56, 0xa5, 0x20, 0x01, 0x27, 0x97, 0xce, 0x1e,
+	0xb1, 0xa2, 0x0e, 0xd4, 0x4c, 0x00, 0xe8, 0xd8, 0x8d, 0x6f, 0xee, 0x67, 0x19, 0xb9, 0x5c, 0x37,
+	0xed, 0x8e, 0xe4, 0x9a, 0x5c, 0x05, 0x43, 0xfb, 0x0a, 0xa7, 0x20, 0xea, 0x96, 0x0a, 0xa3, 0xee,
+	0x00, 0x56, 0x9d, 0x74, 0xd8, 0x5a, 0xb7, 0xcd, 0x06, 0x1e, 0x65, 0xd3, 0x40, 0x9a, 0xcb, 0x1e,
+	0x17, 0x42, 0x3f, 0x95, 0xe0, 0x01, 0x4b, 0xc2, 0xe0, 0x40, 0xf8, 0x84, 0xf1, 0x2f, 0x95, 0x22,
+	0x4e, 0xcf, 0xa7, 0x5c, 0x59, 0x55, 0xb3, 0xb7, 0xe6, 0x7b, 0xee, 0xed, 0xb8, 0x08, 0x27, 0xda,
+	0x6b, 0xb1, 0x1e, 0xc4, 0x01, 0x8d, 0x88, 0xa3, 0x20, 0xb4, 0x6a, 0x46, 0xfb, 0x17, 0x37, 0xd5,
+	0x3e, 0x02, 0x88, 0xd4, 0xe6, 0x20, 0xd7, 0xbf, 0x85, 0xb5, 0xf1, 0x83, 0xd0, 0x89, 0xeb, 0x92,
+	0x0e, 0xe3, 0x68, 0xd7, 0x43, 0xb4, 0x97, 0x7e, 0xdc, 0xf2, 0x02, 0x23, 0xc9, 0x5e, 0xf1, 0xbb,
+	0xf7, 0x7c, 0xe1, 0x77, 0xa5, 0xfa, 0x4b, 0x78, 0x74, 0xbd, 0x17, 0x72, 0x14, 0x8d, 0xbd, 0xa2,
+	0xb5, 0x34, 0xda, 0x8f, 0x70, 0xbf, 0x60, 0x57, 0x39, 0x30, 0x2f, 0xc6, 0xed, 0xfd, 0x4d, 0xc6,
+	0xde, 0xc2, 0xdb, 0x9e, 0x52, 0x89, 0x07, 0x00, 0x67, 0xad, 0x63, 0x9b, 0xfe, 0xa8, 0x13, 0x0c,
+	0xda, 0x86, 0xf2, 0xc0, 0x67, 0xf1, 0x1d, 0xce, 0x3e, 0x4e, 0x9a, 0x53, 0x33, 0xa0, 0x17, 0x70,
+	0x5b, 0x44, 0xc7, 0x10, 0x6b, 0xdf, 0x7e, 0xbf, 0x43, 0xb3, 0x13, 0x31, 0x7c, 0x02, 0x77, 0xae,
+	0xec, 0xb9, 0xa1, 0x76, 0x6b, 0x5c, 0xfb, 0xca, 0x15, 0xea, 0x4f, 0x25, 0x58, 0x6e, 0xbe, 0xa5,
+	0x4e, 0x82, 0xf8, 0x08, 0xc0, 0x35, 0xa7, 0xf2, 0x8a, 0xf8, 0x34, 0x76, 0x5e, 0x6a, 0x45, 0x23,
+	0x35, 0x84, 0xef, 0x13, 0xee, 0x26, 0x4f, 0x5e, 0x3c, 0xd5, 0xb5, 0xc6, 0x97, 0x41, 0x37, 0x49,
+	0x26, 0x66, 0x8c, 0xb6, 0x61, 0x4d, 0x31, 0x9f, 0x8a, 0x50, 0x75, 0xa8, 0x23, 0xb8, 0x2b, 0x4d,
+	0x0e, 0x59, 0xb4, 0x27, 0x56, 0xf1, 0x1a, 0xac, 0x34, 0xfd, 0xbe, 0x1a, 0xc6, 0x56, 0xe0, 0x2f,
+	0xa0, 0x6a, 0xa7, 0x6a, 0x39, 0x19, 0x3a, 0x0e, 0x95, 0x32, 0x7e, 0x60, 0x92, 0xa9, 0xa6, 0xf8,
+	0x54, 0x4a, 0xd2, 0x4d, 0x02, 0x23, 0x99, 0xe2, 0x1f, 0x60, 0x2d, 0x8a, 0xad, 0x59, 0x0b, 0xc9,
+	0x4d, 0x58, 0x8a, 0x36, 0x1f, 0x6b, 0x88, 0x67, 0x98, 0xc3, 0xbd, 0x48, 0x81, 0xc9, 0xae, 0xb3,
+	0x6a, 0xd9, 0x82, 0x65, 0xf7, 0x0a, 0x2d, 0x79, 0xc4, 0x53, 0x4b, 0xf8, 0x2d, 0xdc, 0x35, 0x0f,
+	0x9a, 0xb9, 0x4d, 0x33, 0x6a, 0xfb, 0x18, 0xee, 0x76, 0x27, 0xb1, 0x62, 0x9d, 0x59, 0x02, 0xfe,
+	0x7b, 0x09, 0x36, 0x8c, 0xea, 0x53, 0x49, 0x83, 0x97, 0x4c, 0xaa, 0x59, 0xd5, 0x3f, 0x83, 0x8d,
+	0x6e, 0x1e, 0x5e, 0x6c, 0x42, 0x3e, 0x11, 0xff, 0xab, 0x04, 0x96, 0x31, 0x43, 0xd7, 0x34, 0x72,
+	0x28, 0x15, 0xf5, 0x67, 0x76, 0xfb, 0x73, 0xb0, 0xba, 0x05, 0x90, 0xb1, 0x31, 0x85, 0x74, 0x3c,
+	0x84, 0x95, 0xe8, 0xda, 0xcc, 0x66, 0x42, 0x1d, 0xaa, 0xf4, 0x2d, 0x53, 0x0d, 0xe1, 0x46, 0x2a,
+	0x17, 0xed, 0xd1, 0x5c, 0xc7, 0x9e, 0x54, 0xee, 0xeb, 0x50, 0xc5, 0x25, 0x64, 0x3c, 0xc3, 0xdf,
+	0xc1, 0x1d, 0xe3, 0x89, 0xb6, 0x2e, 0x94, 0xdf, 0xf3, 0xda, 0x66, 0x2f, 0xe2, 0x42, 0xee, 0x45,
+	0xfc, 0x1a, 0xee, 0xa6, 0xb0, 0x67, 0xda, 0x1b, 0x16, 0xb0, 0xaa, 0x6b, 0xba, 0x77, 0xf4, 0xa6,
+	0xd9, 0xea, 0x73, 0xd8, 0x0c, 0xf9, 0x85, 0x11, 0x3d, 0xc9, 0x33, 0xba, 0x80, 0x8a, 0x05, 0xdc,
+	0x8d, 0x3a, 0x94, 0x83, 0xd0, 0xef, 0xdf, 0x54, 0x69, 0x1d, 0xaa, 0x6e, 0xe8, 0xf7, 0xdb, 0x44,
+	0xf5, 0xe2, 0xc3, 0x1f, 0xcd, 0x53, 0xc5, 0x77, 0x39, 0x5d, 0x7c, 0xe3, 0x73, 0xf8, 0xa0, 0xd3,
+	0x3c, 0x9b, 0xc7, 0x9d, 0xd4, 0x49, 0x8e, 0x0e, 0x4c, 0xb5, 0x14, 0x27, 0xe8, 0x78, 0x8a, 0xff,
+	0x56, 0x82, 0x07, 0x2f, 0x4d, 0x2f, 0xdd, 0xa2, 0x44, 0x86, 0x01, 0xd5, 0x0f, 0xe5, 0x1c, 0x52,
+	0x80, 0x37, 0x89, 0x19, 0x2b, 0xce, 0x12, 0xf0, 0xf7, 0xba, 0x0e, 0xfe, 0x0b, 0x75, 0x54, 0x64,
+	0x47, 0x87, 0x3a, 0x01, 0x55, 0xf3, 0x7b, 0x82, 0x24, 0x6c, 0x1e, 0xb0, 0x40, 0x0d, 0x6d, 0xa2,
+	0xe8, 0x5c, 0xd2, 0x29, 0x86, 0x15, 0x37, 0x01, 0x6c, 0x9d, 0x47, 0xfa, 0xca, 0xf6, 0xd8, 0x1a,
+	0x96, 0x80, 0x3a, 0x4e, 0x40, 0x29, 0x97, 0x3d, 0x31, 0xb3, 0x3b, 0x11, 0x54, 0x7c, 0xe6, 0x27,
+	0x49, 0xc3, 0x8c, 0xf5, 0x9a, 0x4b, 0x14, 0x31, 0x11, 0xb3, 0x62, 0x9b, 0x31, 0x7e, 0x03, 0xab,
+	0xfb, 0xc4, 0xb9, 0x0c, 0xfb, 0xf3, 0x73, 0xde, 0x1f, 0x60, 0xbd, 0xd1, 0x23, 0xbc, 0x4b, 0xdd,
+	0x7d, 0x4f, 0x38, 0x97, 0x72, 0x7e, 0xc8, 0x0a, 0x36, 0x26, 0x90, 0x67, 0x73, 0xd2, 0x87, 0xb0,
+	0xea, 0xa4, 0xf1, 0x62, 0x6f, 0x8d, 0x2f, 0x3e, 0xfd, 0xf7, 0x7d, 0x28, 0x37, 0x7c, 0x17, 0xbd,
+	0x02, 0xd4, 0x19, 0x72, 0x67, 0xbc, 0x26, 0x42, 0x3f, 0xcb, 0xdd, 0x48, 0xb4, 0xe5, 0x7a, 0xb1,
+	0x15, 0xf8, 0x16, 0x7a, 0x0d, 0xf7, 0xda, 0x24, 0x94, 0x74, 0x6e, 0x80, 0x6f, 0x60, 0xe3, 0x94,
+	0xf7, 0xe7, 0x0a, 0xd9, 0x81, 0xf5, 0x28, 0x61, 0x4e, 0x20, 0x66, 0x1b, 0x96, 0xb1, 0xbc, 0x7a,
+	0x3d, 0xa8, 0x0d, 0x9b, 0xa7, 0xfc, 0x22, 0x0f, 0x76, 0x26, 0x67, 0xda, 0x54, 0x52, 0x35, 0x37,
+	0xc0, 0x13, 0xb0, 0x3a, 0xe2, 0x42, 0xd9, 0xf4, 0x5c, 0x88, 0xf9, 0xa1, 0xda, 0xb0, 0xd9, 0xe9,
+	0x85, 0xca, 0x15, 0x7f, 0xe5, 0x73, 0xc3, 0x7c, 0x05, 0xe8, 0x1b, 0xe6, 0x79, 0x73, 0xc3, 0x6b,
+	0xc3, 0xfa, 0x01, 0xf5, 0xa8, 0x9a, 0xdf, 0xe1, 0x7c, 0x0b, 0x1b, 0x51, 0x9f, 0x30, 0x09, 0xf9,
+	0x8b, 0x8c, 0xd4, 0x64, 0x3f, 0x31, 0xf5, 0xd4, 0xf5, 0x95, 0x1c, 0x09, 0x9d, 0x90, 0xa0, 0x4b,
+	0xd5, 0x0c, 0x96, 0xfe, 0x11, 0x1e, 0x36, 0xf4, 0x37, 0xbe, 0x09, 0x6f, 0x8e, 0x14, 0xcc, 0x78,
+	0xf4, 0xac, 0xcb, 0x89, 0x17, 0x19, 0xd9, 0x16, 0x6e, 0xc3, 0xa3, 0x84, 0x87, 0xfd, 0x19, 0x30,
+	0xff, 0x04, 0x8f, 0x0f, 0x19, 0x27, 0x1e, 0x7b, 0x47, 0xe7, 0x6f, 0xf0, 0x2b, 0x40, 0x5f, 0x09,
+	0xd5, 0xf7, 0xc2, 0xee, 0x57, 0x42, 0xaa, 0x03, 0x3a, 0x60, 0x0e, 0x95, 0x33, 0xe0, 0xb5, 0xa0,
+	0x76, 0x44, 0x55, 0xd4, 0xa3, 0xa0, 0x87, 0x19, 0xce, 0x74, 0xb7, 0x55, 0x7f, 0x9c, 0x6d, 0xdc,
+	0xc7, 0x9a, 0x27, 0x13, 0x54, 0x6b, 0x23, 0x38, 0xf3, 0x46, 0x4f, 0xc3, 0xfc, 0xb0, 0x00, 0x73,
+	0xec, 0x81, 0x37, 0x39, 0x6f, 0xe5, 0x88, 0xaa, 0x51, 0x6f, 0x33, 0x0d, 0x16, 0x67, 0xc8, 0x99,
+	0xb6, 0xc8, 0x80, 0x56, 0x8f, 0xa8, 0xe9, 0x21, 0xa6, 0xda, 0xb9, 0x9d, 0x0f, 0x98, 0xe9, 0x3f,
+	0x6e, 0xa1, 0x3f, 0x1b, 0x17, 0xa4, 0x7a, 0x81, 0x69, 0xd0, 0x1f, 0xe5, 0x43, 0xe7, 0x75, 0x13,
+	0xb7, 0xd0, 0x3e, 0x54, 0x74, 0xcd, 0x3d, 0x0d, 0xf3, 0xda, 0x33, 0x6f, 0x42, 0x45, 0xf7, 0x24,
+	0xe8, 0xe7, 0x59, 0x8c, 0xab, 0x0e, 0xbf, 0xfe, 0xb0, 0x80, 0x9a, 0x4a, 0xc6, 0xb5, 0x51, 0x0f,
+	0x90, 0x93, 0x34, 0x26, 0x7b, 0x8f, 0x3a, 0xbe, 0x8e, 0x25, 0x75, 0x7b, 0xac, 0x89, 0x5b, 0x33,
+	0x2a, 0xd5, 0x11, 0x2e, 0xf8, 0xa7, 0x21, 0x55, 0xc7, 0x4f, 0xcb, 0x79, 0xfa, 0x6c, 0x52, 0x7f,
+	0x20, 0xdd, 0x3c, 0x3c, 0x73, 0xfe, 0x7d, 0x8a, 0xf3, 0x48, 0xa6, 0x0c, 0x69, 0xb4, 0x4f, 0xe5,
+	0x8c, 0x8f, 0x5d, 0x06, 0x33, 0xda, 0xf0, 0x4c, 0x6f, 0x32, 0x1c, 0x51, 0x15, 0xb7, 0x23, 0xd3,
+	0xb6, 0xbf, 0x95, 0x21, 0x4f, 0xf4, 0x31, 0xf8, 0x16, 0x22, 0xb0, 0x7e, 0x44, 0x55, 0xa6, 0xf5,
+	0xb8, 0xde, 0xc4, 0xec, 0x37, 0xb5, 0xc2, 0xde, 0x05, 0xdf, 0x42, 0xdf, 0x03, 0xca, 0x36, 0x16,
+	0x28, 0xef, 0xbb, 0x5c, 0x41, 0xf7, 0x71, 0xbd, 0x4b, 0x1c, 0xb8, 0x3f, 0x4a, 0x5a, 0xe3, 0x1d,
+	0xc6, 0x34, 0xff, 0xfc, 0x3a, 0xe7, 0x53, 0x66, 0x5e, 0x87, 0x62, 0x72, 0xcd, 0xaa, 0xf6, 0xfb,
+	0xa8, 0x97, 0xb8, 0xde, 0x3f, 0xbf, 0xcc, 0x3a, 0x3e, 0xd3, 0x85, 0x44, 0x95, 0x60, 0xd4, 0x28,
+	0x4c, 0xad, 0x04, 0xc7, 0xfa, 0x89, 0x69, 0xee, 0xb8, 0x73, 0x44, 0xd5, 0x58, 0x4d, 0x8f, 0x7e,
+	0x95, 0xfd, 0x16, 0x9e, 0xd3, 0x4d, 0xd4, 0xb7, 0xa7, 0xb1, 0x25, 0x4a, 0xf6, 0x2b, 0xdf, 0x2d,
+	0x0c, 0x9e, 0x9c, 0x2f, 0x99, 0x7f, 0x79, 0x3f, 0xfd, 0xff, 0x00, 0xbe, 0x56, 0xb9, 0x97, 0x12,
+	0x1e, 0x00, 0x00,
 }
@@ -161,6 +161,26 @@ func (mr *MockCmdClientMockRecorder) FreezeVirtualMachine(ctx, in any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeVirtualMachine", reflect.TypeOf((*MockCmdClient)(nil).FreezeVirtualMachine), varargs...)
 }
 
+// GetChangedBlocks mocks base method.
+func (m *MockCmdClient) GetChangedBlocks(ctx context.Context, in *ChangedBlocksRequest, opts ...grpc.CallOption) (*ChangedBlocksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetChangedBlocks", varargs...)
+	ret0, _ := ret[0].(*ChangedBlocksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangedBlocks indicates an expected call of GetChangedBlocks.
+func (mr *MockCmdClientMockRecorder) GetChangedBlocks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangedBlocks", reflect.TypeOf((*MockCmdClient)(nil).GetChangedBlocks), varargs...)
+}
+
 // GetDomain mocks base method.
 func (m *MockCmdClient) GetDomain(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*DomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -835,6 +855,21 @@ func (mr *MockCmdServerMockRecorder) FreezeVirtualMachine(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeVirtualMachine", reflect.TypeOf((*MockCmdServer)(nil).FreezeVirtualMachine), arg0, arg1)
 }
 
+// GetChangedBlocks mocks base method.
+func (m *MockCmdServer) GetChangedBlocks(arg0 context.Context, arg1 *ChangedBlocksRequest) (*ChangedBlocksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangedBlocks", arg0, arg1)
+	ret0, _ := ret[0].(*ChangedBlocksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangedBlocks indicates an expected call of GetChangedBlocks.
+func (mr *MockCmdServerMockRecorder) GetChangedBlocks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangedBlocks", reflect.TypeOf((*MockCmdServer)(nil).GetChangedBlocks), arg0, arg1)
+}
+
 // GetDomain mocks base method.
 func (m *MockCmdServer) GetDomain(arg0 context.Context, arg1 *EmptyRequest) (*DomainResponse, error) {
 	m.ctrl.T.Helper()
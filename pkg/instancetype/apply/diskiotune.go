@@ -0,0 +1,52 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package apply
+
+import (
+	virtv1 "kubevirt.io/api/core/v1"
+	v1beta1 "kubevirt.io/api/instancetype/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/instancetype/conflict"
+)
+
+func applyDiskIOTune(
+	baseConflict *conflict.Conflict,
+	instancetypeSpec *v1beta1.VirtualMachineInstancetypeSpec,
+	vmiSpec *virtv1.VirtualMachineInstanceSpec,
+) conflict.Conflicts {
+	if instancetypeSpec.DiskIOTune == nil {
+		return nil
+	}
+
+	var conflicts conflict.Conflicts
+	for diskIndex := range vmiSpec.Domain.Devices.Disks {
+		vmiDisk := &vmiSpec.Domain.Devices.Disks[diskIndex]
+		if vmiDisk.DiskDevice.CDRom != nil {
+			continue
+		}
+		if vmiDisk.IOTune != nil {
+			conflicts = append(conflicts, conflict.NewFromPath(
+				baseConflict.Child("domain", "devices", "disks").Index(diskIndex).Child("ioTune")))
+			continue
+		}
+		vmiDisk.IOTune = instancetypeSpec.DiskIOTune.DeepCopy()
+	}
+
+	return conflicts
+}
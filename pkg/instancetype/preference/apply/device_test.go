@@ -259,6 +259,30 @@ var _ = Describe("Preference.Devices", func() {
 		})
 	})
 
+	Context("PreferredDiskIOTune", func() {
+		BeforeEach(func() {
+			preferenceSpec.Devices.PreferredDiskIOTune = &virtv1.DiskIOTune{
+				TotalIOPS: 1000,
+			}
+		})
+
+		It("should apply to disks without I/O throttling", func() {
+			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+			Expect(vmi.Spec.Domain.Devices.Disks[1].IOTune).To(HaveValue(Equal(*preferenceSpec.Devices.PreferredDiskIOTune)))
+			Expect(vmi.Spec.Domain.Devices.Disks[2].IOTune).To(BeNil())
+			Expect(vmi.Spec.Domain.Devices.Disks[4].IOTune).To(BeNil())
+		})
+
+		It("should not override the I/O throttling of a disk", func() {
+			userDefinedIOTune := &virtv1.DiskIOTune{
+				ReadBytesPerSec: 1024,
+			}
+			vmi.Spec.Domain.Devices.Disks[1].IOTune = userDefinedIOTune
+			Expect(vmiApplier.ApplyToVMI(field, instancetypeSpec, preferenceSpec, &vmi.Spec, &vmi.ObjectMeta)).To(Succeed())
+			Expect(vmi.Spec.Domain.Devices.Disks[1].IOTune).To(Equal(userDefinedIOTune))
+		})
+	})
+
 	Context("PreferredTPM", func() {
 		DescribeTable("should",
 			func(vmiTPM, preferenceTPM, expectedTPM *virtv1.TPMDevice) {
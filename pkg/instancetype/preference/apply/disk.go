@@ -56,6 +56,10 @@ func applyDiskPreferences(preferenceSpec *v1beta1.VirtualMachinePreferenceSpec,
 				vmiDisk.DiskDevice.Disk.Bus == virtv1.DiskBusVirtio {
 				vmiDisk.DedicatedIOThread = pointer.P(*preferenceSpec.Devices.PreferredDiskDedicatedIoThread)
 			}
+
+			if preferenceSpec.Devices.PreferredDiskIOTune != nil && vmiDisk.IOTune == nil {
+				vmiDisk.IOTune = preferenceSpec.Devices.PreferredDiskIOTune.DeepCopy()
+			}
 		} else if vmiDisk.DiskDevice.CDRom != nil {
 			if preferenceSpec.Devices.PreferredCdromBus != "" && vmiDisk.DiskDevice.CDRom.Bus == "" {
 				vmiDisk.DiskDevice.CDRom.Bus = preferenceSpec.Devices.PreferredCdromBus
@@ -58,6 +58,17 @@ func WithContainerSATADisk(diskName, imageName string, diskOpts ...DiskOption) O
 	}
 }
 
+// WithContainerDiskPersistentOverlay specifies the disk name, the name of the container image and the size
+// of the PVC keeping the writable overlay of the container disk.
+func WithContainerDiskPersistentOverlay(diskName, imageName string, size resource.Quantity, diskOpts ...DiskOption) Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		addDisk(vmi, newDisk(diskName, v1.DiskBusVirtio, diskOpts...))
+		volume := newContainerVolume(diskName, imageName, "")
+		volume.ContainerDisk.PersistentOverlay = &v1.ContainerDiskPersistentOverlay{Size: size}
+		addVolume(vmi, volume)
+	}
+}
+
 // WithPersistentVolumeClaim specifies the name of the PersistentVolumeClaim to be used.
 func WithPersistentVolumeClaim(diskName, pvcName string, diskOpts ...DiskOption) Option {
 	return func(vmi *v1.VirtualMachineInstance) {
@@ -273,6 +284,14 @@ func WithDedicatedIOThreads(enabled bool) DiskOption {
 	}
 }
 
+func WithDiskEncryption(secretName string) DiskOption {
+	return func(d *v1.Disk) {
+		d.Encryption = &v1.DiskEncryption{
+			SecretRef: k8sv1.LocalObjectReference{Name: secretName},
+		}
+	}
+}
+
 func newCDRom(name string, bus v1.DiskBus) v1.Disk {
 	return v1.Disk{
 		Name: name,
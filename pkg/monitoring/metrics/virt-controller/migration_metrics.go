@@ -25,10 +25,14 @@ import (
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations/v1alpha1"
 	"kubevirt.io/client-go/log"
 )
 
 const (
+	migrationResultSucceeded = "succeeded"
+	migrationResultFailed    = "failed"
+
 	migrationTransTimeErrFmt = "Error encountered during VMI migration transition time histogram calculation: %v"
 	migrationTransTimeFail   = "Failed to get a histogram for a VMI migration lifecycle transition times"
 )
@@ -36,6 +40,10 @@ const (
 var (
 	migrationMetrics = []operatormetrics.Metric{
 		vmiMigrationPhaseTransitionTimeFromCreation,
+		migrationPolicyMatchedVMIs,
+		migrationPolicyAppliedVMIs,
+		migrationPolicyMigrations,
+		migrationPolicyMigrationAverageDuration,
 	}
 
 	vmiMigrationPhaseTransitionTimeFromCreation = operatormetrics.NewHistogramVec(
@@ -51,12 +59,76 @@ var (
 			"phase",
 		},
 	)
+
+	migrationPolicyMatchedVMIs = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_migration_policy_matched_vmis",
+			Help: "Number of VMIs selected by the migration policy.",
+		},
+		[]string{"policy"},
+	)
+
+	migrationPolicyAppliedVMIs = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_migration_policy_applied_vmis",
+			Help: "Number of VMIs the migration policy applies to, excluding the VMIs taken over by a more specific policy.",
+		},
+		[]string{"policy"},
+	)
+
+	migrationPolicyMigrations = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_migration_policy_migrations_total",
+			Help: "Number of migrations completed under the migration policy by result.",
+		},
+		[]string{"policy", "result"},
+	)
+
+	migrationPolicyMigrationAverageDuration = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_migration_policy_migration_average_duration_seconds",
+			Help: "Average duration in seconds of the recent migrations performed under the migration policy by result.",
+		},
+		[]string{"policy", "result"},
+	)
 )
 
+// SetMigrationPolicyStatus exposes the status of a migration policy
+func SetMigrationPolicyStatus(name string, status *v1alpha1.MigrationPolicyStatus) {
+	migrationPolicyMatchedVMIs.WithLabelValues(name).Set(float64(status.MatchedVirtualMachineInstances))
+	migrationPolicyAppliedVMIs.WithLabelValues(name).Set(float64(status.AppliedVirtualMachineInstances))
+
+	stats := status.Migrations
+	if stats == nil {
+		stats = &v1alpha1.MigrationPolicyMigrationStatistics{}
+	}
+	setMigrationPolicyAverageDuration(name, migrationResultSucceeded, stats.AverageSucceededDuration)
+	setMigrationPolicyAverageDuration(name, migrationResultFailed, stats.AverageFailedDuration)
+}
+
+func setMigrationPolicyAverageDuration(name, result string, duration *metav1.Duration) {
+	if duration == nil {
+		migrationPolicyMigrationAverageDuration.DeleteLabelValues(name, result)
+		return
+	}
+	migrationPolicyMigrationAverageDuration.WithLabelValues(name, result).Set(duration.Seconds())
+}
+
+// DeleteMigrationPolicyStatus removes the metrics of a deleted migration policy
+func DeleteMigrationPolicyStatus(name string) {
+	migrationPolicyMatchedVMIs.DeleteLabelValues(name)
+	migrationPolicyAppliedVMIs.DeleteLabelValues(name)
+	for _, result := range []string{migrationResultSucceeded, migrationResultFailed} {
+		migrationPolicyMigrations.DeleteLabelValues(name, result)
+		migrationPolicyMigrationAverageDuration.DeleteLabelValues(name, result)
+	}
+}
+
 func CreateVMIMigrationHandler(informer cache.SharedIndexInformer) error {
 	_, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		UpdateFunc: func(oldVMIMigration, newVMIMigration interface{}) {
 			updateVMIMigrationPhaseTransitionTimeFromCreationTime(oldVMIMigration.(*v1.VirtualMachineInstanceMigration), newVMIMigration.(*v1.VirtualMachineInstanceMigration))
+			updateMigrationPolicyMigrations(oldVMIMigration.(*v1.VirtualMachineInstanceMigration), newVMIMigration.(*v1.VirtualMachineInstanceMigration))
 		},
 	})
 
@@ -84,6 +156,23 @@ func updateVMIMigrationPhaseTransitionTimeFromCreationTime(oldVMIMigration *v1.V
 	histogram.Observe(diffSeconds)
 }
 
+// updateMigrationPolicyMigrations counts the migration under its migration policy once it reaches a final phase
+func updateMigrationPolicyMigrations(oldVMIMigration *v1.VirtualMachineInstanceMigration, newVMIMigration *v1.VirtualMachineInstanceMigration) {
+	if oldVMIMigration == nil || oldVMIMigration.IsFinal() || !newVMIMigration.IsFinal() {
+		return
+	}
+	state := newVMIMigration.Status.MigrationState
+	if state == nil || state.MigrationPolicyName == nil {
+		return
+	}
+
+	result := migrationResultFailed
+	if newVMIMigration.Status.Phase == v1.MigrationSucceeded {
+		result = migrationResultSucceeded
+	}
+	migrationPolicyMigrations.WithLabelValues(*state.MigrationPolicyName, result).Inc()
+}
+
 func getVMIMigrationTransitionTimeSeconds(newVMIMigration *v1.VirtualMachineInstanceMigration) (float64, error) {
 	var oldTime *metav1.Time
 	var newTime *metav1.Time
@@ -26,8 +26,13 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	io_prometheus_client "github.com/prometheus/client_model/go"
+	"github.com/rhobs/operator-observability-toolkit/pkg/operatormetrics"
 
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations/v1alpha1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
 )
 
 var _ = Describe("VMI migration phase transition time histogram", func() {
@@ -55,6 +60,81 @@ var _ = Describe("VMI migration phase transition time histogram", func() {
 	})
 })
 
+var _ = Describe("Migration policy metrics", func() {
+	const policyName = "test-policy"
+
+	gaugeValue := func(gauge *operatormetrics.GaugeVec, labels ...string) float64 {
+		dto := &io_prometheus_client.Metric{}
+		Expect(gauge.WithLabelValues(labels...).Write(dto)).To(Succeed())
+		return dto.GetGauge().GetValue()
+	}
+
+	counterValue := func(counter *operatormetrics.CounterVec, labels ...string) float64 {
+		dto := &io_prometheus_client.Metric{}
+		Expect(counter.WithLabelValues(labels...).Write(dto)).To(Succeed())
+		return dto.GetCounter().GetValue()
+	}
+
+	newMigration := func(phase v1.VirtualMachineInstanceMigrationPhase) *v1.VirtualMachineInstanceMigration {
+		return &v1.VirtualMachineInstanceMigration{
+			Status: v1.VirtualMachineInstanceMigrationStatus{
+				Phase:          phase,
+				MigrationState: &v1.VirtualMachineInstanceMigrationState{MigrationPolicyName: pointer.P(policyName)},
+			},
+		}
+	}
+
+	AfterEach(func() {
+		DeleteMigrationPolicyStatus(policyName)
+	})
+
+	It("should expose the status of a migration policy", func() {
+		SetMigrationPolicyStatus(policyName, &v1alpha1.MigrationPolicyStatus{
+			MatchedVirtualMachineInstances: 5,
+			AppliedVirtualMachineInstances: 3,
+			Migrations: &v1alpha1.MigrationPolicyMigrationStatistics{
+				Succeeded:                4,
+				Failed:                   1,
+				AverageSucceededDuration: &metav1.Duration{Duration: 90 * time.Second},
+			},
+		})
+
+		Expect(gaugeValue(migrationPolicyMatchedVMIs, policyName)).To(Equal(5.0))
+		Expect(gaugeValue(migrationPolicyAppliedVMIs, policyName)).To(Equal(3.0))
+		Expect(gaugeValue(migrationPolicyMigrationAverageDuration, policyName, migrationResultSucceeded)).To(Equal(90.0))
+		Expect(migrationPolicyMigrationAverageDuration.DeleteLabelValues(policyName, migrationResultFailed)).To(BeFalse())
+	})
+
+	DescribeTable("should count the migrations reaching a final phase", func(oldPhase, newPhase v1.VirtualMachineInstanceMigrationPhase, expectedSucceeded, expectedFailed float64) {
+		updateMigrationPolicyMigrations(newMigration(oldPhase), newMigration(newPhase))
+
+		Expect(counterValue(migrationPolicyMigrations, policyName, migrationResultSucceeded)).To(Equal(expectedSucceeded))
+		Expect(counterValue(migrationPolicyMigrations, policyName, migrationResultFailed)).To(Equal(expectedFailed))
+	},
+		Entry("when it succeeds", v1.MigrationRunning, v1.MigrationSucceeded, 1.0, 0.0),
+		Entry("when it fails", v1.MigrationRunning, v1.MigrationFailed, 0.0, 1.0),
+		Entry("but not when it is still running", v1.MigrationScheduled, v1.MigrationRunning, 0.0, 0.0),
+		Entry("but not when it was already final", v1.MigrationSucceeded, v1.MigrationSucceeded, 0.0, 0.0),
+	)
+
+	It("should not count a migration performed without a migration policy", func() {
+		migration := newMigration(v1.MigrationSucceeded)
+		migration.Status.MigrationState.MigrationPolicyName = nil
+		updateMigrationPolicyMigrations(newMigration(v1.MigrationRunning), migration)
+
+		Expect(migrationPolicyMigrations.DeleteLabelValues(policyName, migrationResultSucceeded)).To(BeFalse())
+	})
+
+	It("should remove the metrics of a deleted migration policy", func() {
+		SetMigrationPolicyStatus(policyName, &v1alpha1.MigrationPolicyStatus{MatchedVirtualMachineInstances: 1})
+		updateMigrationPolicyMigrations(newMigration(v1.MigrationRunning), newMigration(v1.MigrationSucceeded))
+		DeleteMigrationPolicyStatus(policyName)
+
+		Expect(migrationPolicyMatchedVMIs.DeleteLabelValues(policyName)).To(BeFalse())
+		Expect(migrationPolicyMigrations.DeleteLabelValues(policyName, migrationResultSucceeded)).To(BeFalse())
+	})
+})
+
 func createVMIMigrationSForPhaseTransitionTime(phase v1.VirtualMachineInstanceMigrationPhase, offset float64) *v1.VirtualMachineInstanceMigration {
 	now := metav1.NewTime(time.Now())
 	old := metav1.NewTime(now.Time.Add(-time.Duration(int64(offset)) * time.Millisecond))
@@ -29,6 +29,7 @@ import (
 var (
 	vmSnapshotMetrics = []operatormetrics.Metric{
 		VmSnapshotSucceededTimestamp,
+		VmSnapshotScheduleOverdue,
 	}
 
 	VmSnapshotSucceededTimestamp = operatormetrics.NewGaugeVec(
@@ -38,6 +39,14 @@ var (
 		},
 		[]string{"name", "snapshot_name", "namespace"},
 	)
+
+	VmSnapshotScheduleOverdue = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_vmsnapshot_schedule_overdue",
+			Help: "Indicates whether the most recent run of a virtual machine snapshot schedule did not succeed within its failure deadline. 1 if overdue, 0 otherwise.",
+		},
+		[]string{"name", "namespace"},
+	)
 )
 
 func HandleSucceededVMSnapshot(snapshot *snapshotv1.VirtualMachineSnapshot) {
@@ -57,3 +66,23 @@ func GetVmSnapshotSucceededTimestamp(vm, snapshot, namespace string) (float64, e
 	}
 	return *dto.Gauge.Value, nil
 }
+
+func SetVMSnapshotScheduleOverdue(schedule *snapshotv1.VirtualMachineSnapshotSchedule, overdue bool) {
+	value := 0.0
+	if overdue {
+		value = 1.0
+	}
+	VmSnapshotScheduleOverdue.WithLabelValues(schedule.Name, schedule.Namespace).Set(value)
+}
+
+func DeleteVMSnapshotScheduleOverdue(name, namespace string) {
+	VmSnapshotScheduleOverdue.DeleteLabelValues(name, namespace)
+}
+
+func GetVMSnapshotScheduleOverdue(name, namespace string) (float64, error) {
+	dto := &io_prometheus_client.Metric{}
+	if err := VmSnapshotScheduleOverdue.WithLabelValues(name, namespace).Write(dto); err != nil {
+		return 0, err
+	}
+	return *dto.Gauge.Value, nil
+}
@@ -66,4 +66,31 @@ var _ = Describe("VMSnapshot Metrics Collector", func() {
 			Expect(metricTime).To(Equal(float64(vmSnapshot.Status.CreationTime.Unix())))
 		})
 	})
+
+	Context("VMSnapshotSchedule overdue", func() {
+		schedule := &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "schedule-name",
+				Namespace: "namespace",
+			},
+		}
+
+		It("should report whether the schedule is overdue", func() {
+			metrics.SetVMSnapshotScheduleOverdue(schedule, true)
+			value, err := metrics.GetVMSnapshotScheduleOverdue("schedule-name", "namespace")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal(1.0))
+
+			metrics.SetVMSnapshotScheduleOverdue(schedule, false)
+			value, err = metrics.GetVMSnapshotScheduleOverdue("schedule-name", "namespace")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal(0.0))
+		})
+
+		It("should drop the metric of a deleted schedule", func() {
+			metrics.SetVMSnapshotScheduleOverdue(schedule, true)
+			metrics.DeleteVMSnapshotScheduleOverdue("schedule-name", "namespace")
+			Expect(metrics.VmSnapshotScheduleOverdue.DeleteLabelValues("schedule-name", "namespace")).To(BeFalse())
+		})
+	})
 })
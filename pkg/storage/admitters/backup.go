@@ -35,6 +35,8 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	backup "kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/cronschedule"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -143,3 +145,81 @@ func (admitter *VMBackupTrackerAdmitter) Admit(ctx context.Context, ar *admissio
 
 	return &admissionv1.AdmissionResponse{Allowed: true}
 }
+
+// VMBackupScheduleAdmitter validates VirtualMachineBackupSchedules
+type VMBackupScheduleAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMBackupScheduleAdmitter creates a VMBackupScheduleAdmitter
+func NewVMBackupScheduleAdmitter(config *virtconfig.ClusterConfig) *VMBackupScheduleAdmitter {
+	return &VMBackupScheduleAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview for VirtualMachineBackupSchedule
+func (admitter *VMBackupScheduleAdmitter) Admit(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != backupv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinebackupschedules" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.IncrementalBackupEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("IncrementalBackup feature gate not enabled"))
+	}
+
+	schedule := &backupv1.VirtualMachineBackupSchedule{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, schedule); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+	specField := k8sfield.NewPath("spec")
+	if _, err := cronschedule.Parse(schedule.Spec.Schedule); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid schedule %q: %v", schedule.Spec.Schedule, err),
+			Field:   specField.Child("schedule").String(),
+		})
+	}
+	if storagetypes.IsPVCBlock(schedule.Spec.PvcSpec.VolumeMode) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "backup PVCs must be filesystem PVCs",
+			Field:   specField.Child("pvcSpec", "volumeMode").String(),
+		})
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
+
+// VMBackupRestoreAdmitter validates VirtualMachineBackupRestores
+type VMBackupRestoreAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMBackupRestoreAdmitter creates a VMBackupRestoreAdmitter
+func NewVMBackupRestoreAdmitter(config *virtconfig.ClusterConfig) *VMBackupRestoreAdmitter {
+	return &VMBackupRestoreAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview for VirtualMachineBackupRestore
+func (admitter *VMBackupRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != backupv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinebackuprestores" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.IncrementalBackupEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("IncrementalBackup feature gate not enabled"))
+	}
+
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
@@ -497,3 +497,173 @@ func createBackupTrackerUpdateAdmissionReview(old, current *backupv1.VirtualMach
 
 	return ar
 }
+
+var _ = Describe("Validating VirtualMachineBackupSchedule Admitter", func() {
+	var (
+		config   *virtconfig.ClusterConfig
+		kvStore  cache.Store
+		admitter *VMBackupScheduleAdmitter
+	)
+
+	createSchedule := func() *backupv1.VirtualMachineBackupSchedule {
+		return &backupv1.VirtualMachineBackupSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-schedule",
+				Namespace: "default",
+			},
+			Spec: backupv1.VirtualMachineBackupScheduleSpec{
+				Schedule:    "0 2 * * *",
+				TrackerName: "test-tracker",
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		config, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		enableFeatureGate(kvStore, "IncrementalBackup")
+		admitter = NewVMBackupScheduleAdmitter(config)
+	})
+
+	It("should reject invalid resource name", func() {
+		ar := createBackupScheduleAdmissionReview(createSchedule())
+		ar.Request.Resource.Resource = "invalidresource"
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+	})
+
+	It("should reject Create operation when IncrementalBackup feature gate is not enabled", func() {
+		ar := createBackupScheduleAdmissionReview(createSchedule())
+		disableFeatureGate(kvStore, "IncrementalBackup")
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(Equal("IncrementalBackup feature gate not enabled"))
+	})
+
+	It("should allow a valid schedule", func() {
+		resp := admitter.Admit(context.Background(), createBackupScheduleAdmissionReview(createSchedule()))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	DescribeTable("should reject an invalid cron expression", func(cronSchedule string) {
+		schedule := createSchedule()
+		schedule.Spec.Schedule = cronSchedule
+
+		resp := admitter.Admit(context.Background(), createBackupScheduleAdmissionReview(schedule))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.schedule"))
+	},
+		Entry("with too few fields", "0 2 * *"),
+		Entry("with an out of range value", "0 25 * * *"),
+		Entry("with free text", "every night"),
+	)
+
+	It("should reject block backup PVCs", func() {
+		schedule := createSchedule()
+		schedule.Spec.PvcSpec.VolumeMode = pointer.P(corev1.PersistentVolumeBlock)
+
+		resp := admitter.Admit(context.Background(), createBackupScheduleAdmissionReview(schedule))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.pvcSpec.volumeMode"))
+	})
+})
+
+func createBackupScheduleAdmissionReview(schedule *backupv1.VirtualMachineBackupSchedule) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(schedule)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "default",
+			Resource: metav1.GroupVersionResource{
+				Group:    backupv1.SchemeGroupVersion.Group,
+				Resource: "virtualmachinebackupschedules",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+
+	return ar
+}
+
+var _ = Describe("Validating VirtualMachineBackupRestore Admitter", func() {
+	var (
+		config   *virtconfig.ClusterConfig
+		kvStore  cache.Store
+		admitter *VMBackupRestoreAdmitter
+	)
+
+	createRestore := func() *backupv1.VirtualMachineBackupRestore {
+		return &backupv1.VirtualMachineBackupRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-restore",
+				Namespace: "default",
+			},
+			Spec: backupv1.VirtualMachineBackupRestoreSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(backupv1.SchemeGroupVersion.Group),
+					Kind:     "VirtualMachineBackup",
+					Name:     "test-backup",
+				},
+				Target: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(v1.SchemeGroupVersion.Group),
+					Kind:     "VirtualMachine",
+					Name:     "restored-vm",
+				},
+			},
+		}
+	}
+
+	createBackupRestoreAdmissionReview := func(restore *backupv1.VirtualMachineBackupRestore) *admissionv1.AdmissionReview {
+		bytes, _ := json.Marshal(restore)
+
+		return &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Create,
+				Namespace: "default",
+				Resource: metav1.GroupVersionResource{
+					Group:    backupv1.SchemeGroupVersion.Group,
+					Resource: "virtualmachinebackuprestores",
+				},
+				Object: runtime.RawExtension{
+					Raw: bytes,
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		config, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		enableFeatureGate(kvStore, "IncrementalBackup")
+		admitter = NewVMBackupRestoreAdmitter(config)
+	})
+
+	It("should reject invalid resource name", func() {
+		ar := createBackupRestoreAdmissionReview(createRestore())
+		ar.Request.Resource.Resource = "invalidresource"
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+	})
+
+	It("should reject Create operation when IncrementalBackup feature gate is not enabled", func() {
+		ar := createBackupRestoreAdmissionReview(createRestore())
+		disableFeatureGate(kvStore, "IncrementalBackup")
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(Equal("IncrementalBackup feature gate not enabled"))
+	})
+
+	It("should allow a valid restore", func() {
+		resp := admitter.Admit(context.Background(), createBackupRestoreAdmissionReview(createRestore()))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+})
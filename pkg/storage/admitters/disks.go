@@ -31,7 +31,9 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 const (
@@ -61,6 +63,7 @@ func ValidateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 		// name can become a container name which will fail to schedule if invalid
 		causes = append(causes, validateDiskNameAsContainerName(field, idx, disk)...)
 		causes = append(causes, validateBlockSize(field, idx, disk)...)
+		causes = append(causes, validateIOTune(field, idx, disk)...)
 	}
 	return causes
 }
@@ -395,3 +398,170 @@ func ValidatePath(field *k8sfield.Path, path string) []metav1.StatusCause {
 
 	return causes
 }
+
+func validateIOTune(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	ioTune := disk.IOTune
+	if ioTune == nil {
+		return causes
+	}
+	ioTuneField := field.Index(idx).Child("ioTune")
+
+	nonNegative := func(value int64, field *k8sfield.Path) {
+		if value < 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be negative", field.String()),
+				Field:   field.String(),
+			})
+		}
+	}
+	nonNegative(ioTune.TotalBytesPerSec, ioTuneField.Child("totalBytesPerSec"))
+	nonNegative(ioTune.ReadBytesPerSec, ioTuneField.Child("readBytesPerSec"))
+	nonNegative(ioTune.WriteBytesPerSec, ioTuneField.Child("writeBytesPerSec"))
+	nonNegative(ioTune.TotalIOPS, ioTuneField.Child("totalIOPS"))
+	nonNegative(ioTune.ReadIOPS, ioTuneField.Child("readIOPS"))
+	nonNegative(ioTune.WriteIOPS, ioTuneField.Child("writeIOPS"))
+
+	exclusiveTotal := func(total, read, write int64, field *k8sfield.Path, totalName, readName, writeName string) {
+		if total != 0 && (read != 0 || write != 0) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s cannot be combined with %s or %s", field.Child(totalName).String(), readName, writeName),
+				Field:   field.Child(totalName).String(),
+			})
+		}
+	}
+	exclusiveTotal(ioTune.TotalBytesPerSec, ioTune.ReadBytesPerSec, ioTune.WriteBytesPerSec, ioTuneField, "totalBytesPerSec", "readBytesPerSec", "writeBytesPerSec")
+	exclusiveTotal(ioTune.TotalIOPS, ioTune.ReadIOPS, ioTune.WriteIOPS, ioTuneField, "totalIOPS", "readIOPS", "writeIOPS")
+
+	burst := ioTune.Burst
+	if burst == nil {
+		return causes
+	}
+	burstField := ioTuneField.Child("burst")
+	nonNegative(burst.TotalBytesPerSec, burstField.Child("totalBytesPerSec"))
+	nonNegative(burst.ReadBytesPerSec, burstField.Child("readBytesPerSec"))
+	nonNegative(burst.WriteBytesPerSec, burstField.Child("writeBytesPerSec"))
+	nonNegative(burst.TotalIOPS, burstField.Child("totalIOPS"))
+	nonNegative(burst.ReadIOPS, burstField.Child("readIOPS"))
+	nonNegative(burst.WriteIOPS, burstField.Child("writeIOPS"))
+	nonNegative(burst.LengthSeconds, burstField.Child("lengthSeconds"))
+	exclusiveTotal(burst.TotalBytesPerSec, burst.ReadBytesPerSec, burst.WriteBytesPerSec, burstField, "totalBytesPerSec", "readBytesPerSec", "writeBytesPerSec")
+	exclusiveTotal(burst.TotalIOPS, burst.ReadIOPS, burst.WriteIOPS, burstField, "totalIOPS", "readIOPS", "writeIOPS")
+
+	// every burst limit requires a lower or equal limit of the same kind
+	limits := []struct {
+		name         string
+		limit, burst int64
+	}{
+		{"totalBytesPerSec", ioTune.TotalBytesPerSec, burst.TotalBytesPerSec},
+		{"readBytesPerSec", ioTune.ReadBytesPerSec, burst.ReadBytesPerSec},
+		{"writeBytesPerSec", ioTune.WriteBytesPerSec, burst.WriteBytesPerSec},
+		{"totalIOPS", ioTune.TotalIOPS, burst.TotalIOPS},
+		{"readIOPS", ioTune.ReadIOPS, burst.ReadIOPS},
+		{"writeIOPS", ioTune.WriteIOPS, burst.WriteIOPS},
+	}
+	hasBurst := false
+	for _, l := range limits {
+		if l.burst <= 0 {
+			continue
+		}
+		hasBurst = true
+		if l.limit == 0 || l.burst < l.limit {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s requires %s to be set to a lower or equal value", burstField.Child(l.name).String(), ioTuneField.Child(l.name).String()),
+				Field:   burstField.Child(l.name).String(),
+			})
+		}
+	}
+	if burst.LengthSeconds > 0 && !hasBurst {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires at least one burst limit", burstField.Child("lengthSeconds").String()),
+			Field:   burstField.Child("lengthSeconds").String(),
+		})
+	}
+	return causes
+}
+
+// ValidateDiskEncryption ensures that encrypted disks are enabled and backed by a PVC or DataVolume
+func ValidateDiskEncryption(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	volumes := storagetypes.GetVolumesByName(spec)
+	for idx, disk := range spec.Domain.Devices.Disks {
+		if disk.Encryption == nil {
+			continue
+		}
+		encryptionField := field.Child("domain", "devices", "disks").Index(idx).Child("encryption")
+		if !config.DiskEncryptionEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "DiskEncryption feature gate is not enabled in kubevirt-config",
+				Field:   encryptionField.String(),
+			})
+			continue
+		}
+		if disk.Encryption.SecretRef.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s is required", encryptionField.Child("secretRef", "name").String()),
+				Field:   encryptionField.Child("secretRef", "name").String(),
+			})
+		}
+		if disk.CDRom != nil || disk.LUN != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is only supported for disks of type disk", encryptionField.String()),
+				Field:   encryptionField.String(),
+			})
+		}
+		if volume, ok := volumes[disk.Name]; ok && volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is only supported for PersistentVolumeClaim and DataVolume volumes", encryptionField.String()),
+				Field:   encryptionField.String(),
+			})
+		}
+	}
+	return causes
+}
+
+// ValidateContainerDiskPersistentOverlays validates container disks whose overlay is kept on a PVC.
+func ValidateContainerDiskPersistentOverlays(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	disks := make(map[string]v1.Disk, len(spec.Domain.Devices.Disks))
+	for _, disk := range spec.Domain.Devices.Disks {
+		disks[disk.Name] = disk
+	}
+	for idx, volume := range spec.Volumes {
+		if volume.ContainerDisk == nil || volume.ContainerDisk.PersistentOverlay == nil {
+			continue
+		}
+		overlayField := field.Child("volumes").Index(idx).Child("containerDisk", "persistentOverlay")
+		if !config.ContainerDiskPersistentOverlayEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "ContainerDiskPersistentOverlay feature gate is not enabled in kubevirt-config",
+				Field:   overlayField.String(),
+			})
+			continue
+		}
+		if volume.ContainerDisk.PersistentOverlay.Size.Sign() <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than zero", overlayField.Child("size").String()),
+				Field:   overlayField.Child("size").String(),
+			})
+		}
+		if disk, ok := disks[volume.Name]; ok && (disk.CDRom != nil || disk.LUN != nil) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is only supported for disks of type disk", overlayField.String()),
+				Field:   overlayField.String(),
+			})
+		}
+	}
+	return causes
+}
@@ -26,12 +26,17 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/api"
 
+	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 )
 
 var _ = Describe("Disk Validation", func() {
@@ -564,5 +569,142 @@ var _ = Describe("Disk Validation", func() {
 				Expect(causes[0].Field).To(Equal("fake[0].blockSize.custom.discardGranularity"))
 			})
 		})
+
+		Context("With I/O throttling", func() {
+			It("should accept a disk with valid limits", func() {
+				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+					Name: "testdisk",
+					IOTune: &v1.DiskIOTune{
+						ReadBytesPerSec:  100 * 1024 * 1024,
+						WriteBytesPerSec: 50 * 1024 * 1024,
+						TotalIOPS:        1000,
+						Burst: &v1.DiskIOTuneBurst{
+							ReadBytesPerSec: 200 * 1024 * 1024,
+							TotalIOPS:       2000,
+							LengthSeconds:   10,
+						},
+						GroupName: "group",
+					},
+				})
+				causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+				Expect(causes).To(BeEmpty())
+			})
+
+			DescribeTable("should reject a disk when", func(ioTune *v1.DiskIOTune, expectedField string) {
+				vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+					Name:   "testdisk",
+					IOTune: ioTune,
+				})
+				causes := ValidateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+				Expect(causes).To(HaveLen(1))
+				Expect(string(causes[0].Type)).To(Equal("FieldValueInvalid"))
+				Expect(causes[0].Field).To(Equal(expectedField))
+			},
+				Entry("total bytes are combined with read bytes",
+					&v1.DiskIOTune{TotalBytesPerSec: 100, ReadBytesPerSec: 100},
+					"fake[0].ioTune.totalBytesPerSec"),
+				Entry("total IOPS are combined with write IOPS",
+					&v1.DiskIOTune{TotalIOPS: 100, WriteIOPS: 100},
+					"fake[0].ioTune.totalIOPS"),
+				Entry("a burst limit has no matching limit",
+					&v1.DiskIOTune{ReadIOPS: 100, Burst: &v1.DiskIOTuneBurst{WriteIOPS: 200}},
+					"fake[0].ioTune.burst.writeIOPS"),
+				Entry("a burst limit is lower than its limit",
+					&v1.DiskIOTune{TotalBytesPerSec: 200, Burst: &v1.DiskIOTuneBurst{TotalBytesPerSec: 100}},
+					"fake[0].ioTune.burst.totalBytesPerSec"),
+				Entry("the burst length is set without burst limits",
+					&v1.DiskIOTune{TotalIOPS: 100, Burst: &v1.DiskIOTuneBurst{LengthSeconds: 10}},
+					"fake[0].ioTune.burst.lengthSeconds"),
+				Entry("a limit is negative",
+					&v1.DiskIOTune{ReadBytesPerSec: -1},
+					"fake[0].ioTune.readBytesPerSec"),
+				Entry("a burst limit is negative",
+					&v1.DiskIOTune{ReadIOPS: 100, Burst: &v1.DiskIOTuneBurst{ReadIOPS: -1}},
+					"fake[0].ioTune.burst.readIOPS"),
+			)
+		})
+	})
+
+	Context("with ValidateDiskEncryption", func() {
+		newVMI := func(gates ...string) (*v1.VirtualMachineInstance, *virtconfig.ClusterConfig) {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: gates},
+			})
+			return api.NewMinimalVMI("testvmi"), config
+		}
+
+		It("should accept an encrypted PVC disk", func() {
+			vmi, config := newVMI(featuregate.DiskEncryptionGate)
+			libvmi.WithPersistentVolumeClaim("disk0", "pvc", libvmi.WithDiskEncryption("secret"))(vmi)
+			Expect(ValidateDiskEncryption(k8sfield.NewPath("spec"), &vmi.Spec, config)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject an encrypted disk", func(gate bool, option libvmi.Option, expectedField string) {
+			var gates []string
+			if gate {
+				gates = append(gates, featuregate.DiskEncryptionGate)
+			}
+			vmi, config := newVMI(gates...)
+			option(vmi)
+			causes := ValidateDiskEncryption(k8sfield.NewPath("spec"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("when the feature gate is disabled", false,
+				libvmi.WithPersistentVolumeClaim("disk0", "pvc", libvmi.WithDiskEncryption("secret")),
+				"spec.domain.devices.disks[0].encryption"),
+			Entry("without a secret name", true,
+				libvmi.WithPersistentVolumeClaim("disk0", "pvc", libvmi.WithDiskEncryption("")),
+				"spec.domain.devices.disks[0].encryption.secretRef.name"),
+			Entry("backed by a containerDisk", true,
+				libvmi.WithContainerDisk("disk0", "image", libvmi.WithDiskEncryption("secret")),
+				"spec.domain.devices.disks[0].encryption"),
+			Entry("of type cdrom", true,
+				func(vmi *v1.VirtualMachineInstance) {
+					libvmi.WithPersistentVolumeClaim("disk0", "pvc", libvmi.WithDiskEncryption("secret"))(vmi)
+					vmi.Spec.Domain.Devices.Disks[0].DiskDevice = v1.DiskDevice{CDRom: &v1.CDRomTarget{}}
+				},
+				"spec.domain.devices.disks[0].encryption"),
+		)
+	})
+
+	Context("with ValidateContainerDiskPersistentOverlays", func() {
+		newVMI := func(gates ...string) (*v1.VirtualMachineInstance, *virtconfig.ClusterConfig) {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: gates},
+			})
+			return api.NewMinimalVMI("testvmi"), config
+		}
+
+		It("should accept a container disk with a persistent overlay", func() {
+			vmi, config := newVMI(featuregate.ContainerDiskPersistentOverlayGate)
+			libvmi.WithContainerDiskPersistentOverlay("disk0", "image", resource.MustParse("1Gi"))(vmi)
+			Expect(ValidateContainerDiskPersistentOverlays(k8sfield.NewPath("spec"), &vmi.Spec, config)).To(BeEmpty())
+		})
+
+		DescribeTable("should reject a persistent overlay", func(gate bool, option libvmi.Option, expectedField string) {
+			var gates []string
+			if gate {
+				gates = append(gates, featuregate.ContainerDiskPersistentOverlayGate)
+			}
+			vmi, config := newVMI(gates...)
+			option(vmi)
+			causes := ValidateContainerDiskPersistentOverlays(k8sfield.NewPath("spec"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("when the feature gate is disabled", false,
+				libvmi.WithContainerDiskPersistentOverlay("disk0", "image", resource.MustParse("1Gi")),
+				"spec.volumes[0].containerDisk.persistentOverlay"),
+			Entry("without a size", true,
+				libvmi.WithContainerDiskPersistentOverlay("disk0", "image", resource.Quantity{}),
+				"spec.volumes[0].containerDisk.persistentOverlay.size"),
+			Entry("of type cdrom", true,
+				func(vmi *v1.VirtualMachineInstance) {
+					libvmi.WithContainerDiskPersistentOverlay("disk0", "image", resource.MustParse("1Gi"))(vmi)
+					vmi.Spec.Domain.Devices.Disks[0].DiskDevice = v1.DiskDevice{CDRom: &v1.CDRomTarget{}}
+				},
+				"spec.volumes[0].containerDisk.persistentOverlay"),
+		)
 	})
 })
@@ -62,6 +62,10 @@ func AdmitHotplugStorage(newVolumes, oldVolumes []v1.Volume, newDisks, oldDisks
 		return hotplugAr
 	}
 
+	if causes := verifyHotplugDiskEncryption(newHotplugVolumeMap, oldHotplugVolumeMap, oldPermanentVolumeMap, newDiskMap, oldDiskMap, config); len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
 	return nil
 }
 
@@ -155,7 +159,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 		if _, ok := oldHotplugVolumeMap[k]; ok {
 			_, okMigVol := migratedVols[k]
 			// New and old have same volume, ensure they are the same
-			if !equality.Semantic.DeepEqual(v, oldHotplugVolumeMap[k]) && !okMigVol {
+			if !equalVolumesIgnoringMemoryDumpFormat(v, oldHotplugVolumeMap[k]) && !okMigVol {
 				return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 					{
 						Type:    metav1.CauseTypeFieldValueInvalid,
@@ -172,7 +176,7 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 						},
 					})
 				}
-				if !equality.Semantic.DeepEqual(newDisks[k], oldDisks[k]) {
+				if !equalDisksIgnoringIOTune(newDisks[k], oldDisks[k]) {
 					return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 						{
 							Type:    metav1.CauseTypeFieldValueInvalid,
@@ -214,6 +218,41 @@ func verifyHotplugVolumes(newHotplugVolumeMap, oldHotplugVolumeMap map[string]v1
 	return nil
 }
 
+// verifyHotplugDiskEncryption ensures that new encrypted hotplug disks reuse the Secret of a disk
+// which was present when the VMI started, as Secrets cannot be added to a running virt-launcher pod.
+func verifyHotplugDiskEncryption(newHotplugVolumeMap, oldHotplugVolumeMap, oldPermanentVolumeMap map[string]v1.Volume,
+	newDisks, oldDisks map[string]v1.Disk, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	mountedSecrets := map[string]struct{}{}
+	for name := range oldPermanentVolumeMap {
+		if disk, ok := oldDisks[name]; ok && disk.Encryption != nil {
+			mountedSecrets[disk.Encryption.SecretRef.Name] = struct{}{}
+		}
+	}
+
+	var causes []metav1.StatusCause
+	for name := range newHotplugVolumeMap {
+		disk, ok := newDisks[name]
+		if _, exists := oldHotplugVolumeMap[name]; exists || !ok || disk.Encryption == nil {
+			continue
+		}
+		if !config.DiskEncryptionEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "DiskEncryption feature gate is not enabled in kubevirt-config",
+			})
+			continue
+		}
+		if _, ok := mountedSecrets[disk.Encryption.SecretRef.Name]; !ok {
+			causes = append(causes, metav1.StatusCause{
+				Type: metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("hotplug disk %s must use the encryption secret of a disk present when the VMI was started, %s is not",
+					name, disk.Encryption.SecretRef.Name),
+			})
+		}
+	}
+	return causes
+}
+
 func isMigratedVolume(newVol, oldVol *v1.Volume, migratedVolumeMap map[string]bool) bool {
 	if newVol.Name != oldVol.Name {
 		return false
@@ -256,7 +295,7 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 				},
 			})
 		}
-		if !equality.Semantic.DeepEqual(newDisks[k], oldDisks[k]) {
+		if !equalDisksIgnoringIOTune(newDisks[k], oldDisks[k]) {
 			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 				{
 					Type:    metav1.CauseTypeFieldValueInvalid,
@@ -268,6 +307,25 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 	return nil
 }
 
+// equalDisksIgnoringIOTune compares two disks without their I/O throttling,
+// which can be changed on a running VMI
+func equalDisksIgnoringIOTune(newDisk, oldDisk v1.Disk) bool {
+	newDisk.IOTune = nil
+	oldDisk.IOTune = nil
+	return equality.Semantic.DeepEqual(newDisk, oldDisk)
+}
+
+// equalVolumesIgnoringMemoryDumpFormat allows a new memory dump request to change the format of its volume
+func equalVolumesIgnoringMemoryDumpFormat(newVolume, oldVolume v1.Volume) bool {
+	if newVolume.MemoryDump != nil && oldVolume.MemoryDump != nil {
+		newVolume.MemoryDump = newVolume.MemoryDump.DeepCopy()
+		oldVolume.MemoryDump = oldVolume.MemoryDump.DeepCopy()
+		newVolume.MemoryDump.Format = ""
+		oldVolume.MemoryDump.Format = ""
+	}
+	return equality.Semantic.DeepEqual(newVolume, oldVolume)
+}
+
 func getDiskMap(disks []v1.Disk) map[string]v1.Disk {
 	newDiskMap := make(map[string]v1.Disk, 0)
 	for _, disk := range disks {
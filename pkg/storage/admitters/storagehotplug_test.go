@@ -248,6 +248,22 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 		return res
 	}
 
+	makeDisksWithIOTune := func(indexes ...int) []v1.Disk {
+		res := makeDisks(indexes...)
+		for i := range res {
+			res[i].IOTune = &v1.DiskIOTune{TotalIOPS: 1000}
+		}
+		return res
+	}
+
+	makeEncryptedDisks := func(secretName string, indexes ...int) []v1.Disk {
+		res := makeDisks(indexes...)
+		for i := range res {
+			res[i].Encryption = &v1.DiskEncryption{SecretRef: k8sv1.LocalObjectReference{Name: secretName}}
+		}
+		return res
+	}
+
 	makeDisksInvalidBusLastDisk := func(indexes ...int) []v1.Disk {
 		res := makeDisks(indexes...)
 		if len(res) > 0 {
@@ -384,6 +400,40 @@ var _ = Describe("Storage Hotplug Admitter", func() {
 			makeFilesystems(),
 			makeStatus(1, 0),
 			makeExpected("permanent disk volume-name-0, changed", "")),
+		Entry("Should accept if the I/O throttling of permanent and hotplug disks changed",
+			makeVolumes(0, 1),
+			makeVolumes(0, 1),
+			makeDisksWithIOTune(0, 1),
+			makeDisks(0, 1),
+			makeFilesystems(),
+			makeStatus(2, 1),
+			nil),
+		Entry("Should accept an encrypted hotplug disk using the secret of a permanent disk",
+			makeVolumes(0, 1),
+			makeVolumes(0),
+			makeEncryptedDisks("secret", 0, 1),
+			makeEncryptedDisks("secret", 0),
+			makeFilesystems(),
+			makeStatus(2, 1),
+			nil,
+			featuregate.DiskEncryptionGate),
+		Entry("Should reject an encrypted hotplug disk using a secret not present at start",
+			makeVolumes(0, 1),
+			makeVolumes(0),
+			append(makeEncryptedDisks("secret", 0), makeEncryptedDisks("other-secret", 1)...),
+			makeEncryptedDisks("secret", 0),
+			makeFilesystems(),
+			makeStatus(2, 1),
+			makeExpected("hotplug disk volume-name-1 must use the encryption secret of a disk present when the VMI was started, other-secret is not", ""),
+			featuregate.DiskEncryptionGate),
+		Entry("Should reject an encrypted hotplug disk if the feature gate is disabled",
+			makeVolumes(0, 1),
+			makeVolumes(0),
+			makeEncryptedDisks("secret", 0, 1),
+			makeEncryptedDisks("secret", 0),
+			makeFilesystems(),
+			makeStatus(2, 1),
+			makeExpected("DiskEncryption feature gate is not enabled in kubevirt-config", "")),
 		Entry("Should reject if a hotplug volume changed",
 			makeInvalidVolumes(2, 1),
 			makeVolumes(0, 1),
@@ -29,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
+	"kubevirt.io/api/backup"
 	virt "kubevirt.io/api/core"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	"kubevirt.io/api/snapshot"
@@ -41,6 +42,7 @@ const (
 	pvc            = "PersistentVolumeClaim"
 	vmSnapshotKind = "VirtualMachineSnapshot"
 	vmKind         = "VirtualMachine"
+	vmBackupKind   = "VirtualMachineBackup"
 )
 
 // VMExportAdmitter validates VirtualMachineExports
@@ -89,6 +91,9 @@ func (admitter *VMExportAdmitter) Admit(_ context.Context, ar *admissionv1.Admis
 		case vmKind:
 			causes = append(causes, admitter.validateVMName(sourceField.Child("name"), vmExport.Spec.Source.Name)...)
 			causes = append(causes, admitter.validateVMApiGroup(sourceField.Child("APIGroup"), vmExport.Spec.Source.APIGroup)...)
+		case vmBackupKind:
+			causes = append(causes, admitter.validateVMBackupName(sourceField.Child("name"), vmExport.Spec.Source.Name)...)
+			causes = append(causes, admitter.validateVMBackupApiGroup(sourceField.Child("APIGroup"), vmExport.Spec.Source.APIGroup)...)
 		default:
 			causes = []metav1.StatusCause{
 				{
@@ -212,3 +217,31 @@ func (admitter *VMExportAdmitter) validateVMApiGroup(field *k8sfield.Path, apigr
 
 	return []metav1.StatusCause{}
 }
+
+func (admitter *VMExportAdmitter) validateVMBackupName(field *k8sfield.Path, name string) []metav1.StatusCause {
+	if name == "" {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "VMBackup name must not be empty",
+				Field:   field.String(),
+			},
+		}
+	}
+
+	return []metav1.StatusCause{}
+}
+
+func (admitter *VMExportAdmitter) validateVMBackupApiGroup(field *k8sfield.Path, apigroup *string) []metav1.StatusCause {
+	if apigroup == nil || *apigroup != backup.GroupName {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "VMBackup API group must be " + backup.GroupName,
+				Field:   field.String(),
+			},
+		}
+	}
+
+	return []metav1.StatusCause{}
+}
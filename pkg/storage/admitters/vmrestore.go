@@ -134,6 +134,14 @@ func (admitter *VMRestoreAdmitter) Admit(ctx context.Context, ar *admissionv1.Ad
 			}
 		}
 
+		if vmRestore.Spec.ResumeFromMemory != nil && *vmRestore.Spec.ResumeFromMemory && !admitter.Config.MemorySnapshotEnabled() {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "MemorySnapshot feature gate not enabled",
+				Field:   k8sfield.NewPath("spec", "resumeFromMemory").String(),
+			})
+		}
+
 		objects, err := admitter.VMRestoreInformer.GetIndexer().ByIndex(cache.NamespaceIndex, ar.Request.Namespace)
 		if err != nil {
 			return webhookutils.ToAdmissionResponseError(err)
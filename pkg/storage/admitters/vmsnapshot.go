@@ -34,6 +34,7 @@ import (
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/storage/cronschedule"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
@@ -108,6 +109,8 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 			}
 		}
 
+		causes = append(causes, admitter.validateIncludeMemory(vmSnapshot)...)
+
 	case admissionv1.Update:
 		prevObj := &snapshotv1.VirtualMachineSnapshot{}
 		err = json.Unmarshal(ar.Request.OldObject.Raw, prevObj)
@@ -137,3 +140,97 @@ func (admitter *VMSnapshotAdmitter) Admit(ctx context.Context, ar *admissionv1.A
 	}
 	return &reviewResponse
 }
+
+func (admitter *VMSnapshotAdmitter) validateIncludeMemory(vmSnapshot *snapshotv1.VirtualMachineSnapshot) []metav1.StatusCause {
+	if vmSnapshot.Spec.IncludeMemory == nil || !*vmSnapshot.Spec.IncludeMemory {
+		return nil
+	}
+
+	field := k8sfield.NewPath("spec", "includeMemory")
+	if !admitter.Config.MemorySnapshotEnabled() {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "MemorySnapshot feature gate not enabled",
+				Field:   field.String(),
+			},
+		}
+	}
+	// the memory state is saved to a utility volume hotplugged into the source
+	if !admitter.Config.UtilityVolumesEnabled() {
+		return []metav1.StatusCause{
+			{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "UtilityVolumes feature gate is required to include memory",
+				Field:   field.String(),
+			},
+		}
+	}
+	return nil
+}
+
+// VMSnapshotScheduleAdmitter validates VirtualMachineSnapshotSchedules
+type VMSnapshotScheduleAdmitter struct {
+	Config *virtconfig.ClusterConfig
+}
+
+// NewVMSnapshotScheduleAdmitter creates a VMSnapshotScheduleAdmitter
+func NewVMSnapshotScheduleAdmitter(config *virtconfig.ClusterConfig) *VMSnapshotScheduleAdmitter {
+	return &VMSnapshotScheduleAdmitter{
+		Config: config,
+	}
+}
+
+// Admit validates an AdmissionReview for VirtualMachineSnapshotSchedule
+func (admitter *VMSnapshotScheduleAdmitter) Admit(ctx context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
+	if ar.Request.Resource.Group != snapshotv1.SchemeGroupVersion.Group ||
+		ar.Request.Resource.Resource != "virtualmachinesnapshotschedules" {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("unexpected resource %+v", ar.Request.Resource))
+	}
+
+	if ar.Request.Operation == admissionv1.Create && !admitter.Config.SnapshotEnabled() {
+		return webhookutils.ToAdmissionResponseError(fmt.Errorf("snapshot feature gate not enabled"))
+	}
+
+	schedule := &snapshotv1.VirtualMachineSnapshotSchedule{}
+	if err := json.Unmarshal(ar.Request.Object.Raw, schedule); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
+	var causes []metav1.StatusCause
+	specField := k8sfield.NewPath("spec")
+	if _, err := cronschedule.Parse(schedule.Spec.Schedule); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid schedule %q: %v", schedule.Spec.Schedule, err),
+			Field:   specField.Child("schedule").String(),
+		})
+	}
+	if _, err := metav1.LabelSelectorAsSelector(&schedule.Spec.Selector); err != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("invalid selector: %v", err),
+			Field:   specField.Child("selector").String(),
+		})
+	}
+	if schedule.Spec.FailureDeadline != nil && schedule.Spec.FailureDeadline.Duration < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "failureDeadline must not be negative",
+			Field:   specField.Child("failureDeadline").String(),
+		})
+	}
+	if retention := schedule.Spec.Retention; retention != nil && retention.MaxAge != nil && retention.MaxAge.Duration <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "maxAge must be positive",
+			Field:   specField.Child("retention", "maxAge").String(),
+		})
+	}
+
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+
+	return &admissionv1.AdmissionResponse{Allowed: true}
+}
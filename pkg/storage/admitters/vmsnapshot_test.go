@@ -22,6 +22,7 @@ package admitters
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -33,6 +34,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
@@ -135,6 +137,46 @@ var _ = Describe("Validating VirtualMachineSnapshot Admitter", func() {
 			Expect(resp.Allowed).To(BeTrue())
 		})
 
+		DescribeTable("should validate includeMemory against feature gates", func(featureGates []string, expectedMessage string) {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: featureGates,
+						},
+					},
+				},
+			})
+			snapshot := &snapshotv1.VirtualMachineSnapshot{
+				Spec: snapshotv1.VirtualMachineSnapshotSpec{
+					Source: corev1.TypedLocalObjectReference{
+						APIGroup: &apiGroup,
+						Kind:     "VirtualMachine",
+						Name:     vmName,
+					},
+					IncludeMemory: pointer.P(true),
+				},
+			}
+
+			ar := createSnapshotAdmissionReview(snapshot)
+			resp := createTestVMSnapshotAdmitter(config, nil).Admit(context.Background(), ar)
+			if expectedMessage == "" {
+				Expect(resp.Allowed).To(BeTrue())
+				return
+			}
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.includeMemory"))
+			Expect(resp.Result.Details.Causes[0].Message).To(Equal(expectedMessage))
+		},
+			Entry("reject without the MemorySnapshot feature gate",
+				[]string{"Snapshot", "UtilityVolumes"}, "MemorySnapshot feature gate not enabled"),
+			Entry("reject without the UtilityVolumes feature gate",
+				[]string{"Snapshot", "MemorySnapshot"}, "UtilityVolumes feature gate is required to include memory"),
+			Entry("accept with both feature gates",
+				[]string{"Snapshot", "MemorySnapshot", "UtilityVolumes"}, ""),
+		)
+
 		It("should reject spec update", func() {
 			snapshot := &snapshotv1.VirtualMachineSnapshot{
 				Spec: snapshotv1.VirtualMachineSnapshotSpec{
@@ -371,3 +413,110 @@ func createTestVMSnapshotAdmitter(config *virtconfig.ClusterConfig, vm *v1.Virtu
 	}
 	return &VMSnapshotAdmitter{Config: config, Client: virtClient}
 }
+
+var _ = Describe("Validating VirtualMachineSnapshotSchedule Admitter", func() {
+	var (
+		config   *virtconfig.ClusterConfig
+		kvStore  cache.Store
+		admitter *VMSnapshotScheduleAdmitter
+	)
+
+	createSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-schedule",
+				Namespace: "default",
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Schedule: "0 2 * * *",
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"snapshot": "nightly"},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		config, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		enableFeatureGate(kvStore, "Snapshot")
+		admitter = NewVMSnapshotScheduleAdmitter(config)
+	})
+
+	It("should reject invalid resource name", func() {
+		ar := createSnapshotScheduleAdmissionReview(createSchedule())
+		ar.Request.Resource.Resource = "invalidresource"
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+	})
+
+	It("should reject Create operation when Snapshot feature gate is not enabled", func() {
+		ar := createSnapshotScheduleAdmissionReview(createSchedule())
+		disableFeatureGate(kvStore, "Snapshot")
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+	})
+
+	It("should allow a valid schedule", func() {
+		schedule := createSchedule()
+		schedule.Spec.FailureDeadline = &metav1.Duration{Duration: time.Hour}
+		schedule.Spec.Retention = &snapshotv1.SnapshotRetention{
+			MaxCount: pointer.P(int32(7)),
+			MaxAge:   &metav1.Duration{Duration: 7 * 24 * time.Hour},
+		}
+
+		resp := admitter.Admit(context.Background(), createSnapshotScheduleAdmissionReview(schedule))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	DescribeTable("should reject", func(modify func(*snapshotv1.VirtualMachineSnapshotSchedule), field string) {
+		schedule := createSchedule()
+		modify(schedule)
+
+		resp := admitter.Admit(context.Background(), createSnapshotScheduleAdmissionReview(schedule))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("a cron expression with too few fields", func(schedule *snapshotv1.VirtualMachineSnapshotSchedule) {
+			schedule.Spec.Schedule = "0 2 * *"
+		}, "spec.schedule"),
+		Entry("a cron expression with free text", func(schedule *snapshotv1.VirtualMachineSnapshotSchedule) {
+			schedule.Spec.Schedule = "every night"
+		}, "spec.schedule"),
+		Entry("an invalid selector", func(schedule *snapshotv1.VirtualMachineSnapshotSchedule) {
+			schedule.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{
+				{Key: "snapshot", Operator: "Unknown"},
+			}
+		}, "spec.selector"),
+		Entry("a negative failure deadline", func(schedule *snapshotv1.VirtualMachineSnapshotSchedule) {
+			schedule.Spec.FailureDeadline = &metav1.Duration{Duration: -time.Minute}
+		}, "spec.failureDeadline"),
+		Entry("a zero maxAge", func(schedule *snapshotv1.VirtualMachineSnapshotSchedule) {
+			schedule.Spec.Retention = &snapshotv1.SnapshotRetention{MaxAge: &metav1.Duration{}}
+		}, "spec.retention.maxAge"),
+	)
+})
+
+func createSnapshotScheduleAdmissionReview(schedule *snapshotv1.VirtualMachineSnapshotSchedule) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(schedule)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "default",
+			Resource: metav1.GroupVersionResource{
+				Group:    snapshotv1.SchemeGroupVersion.Group,
+				Resource: "virtualmachinesnapshotschedules",
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+
+	return ar
+}
@@ -0,0 +1,218 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Validating VirtualMachineSnapshotGroup Admitter", func() {
+	var (
+		config   *virtconfig.ClusterConfig
+		kvStore  cache.Store
+		admitter *VMSnapshotGroupAdmitter
+	)
+
+	createGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		return &snapshotv1.VirtualMachineSnapshotGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-group",
+				Namespace: "default",
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotGroupSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "shop"},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		config, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		enableFeatureGate(kvStore, "Snapshot")
+		admitter = NewVMSnapshotGroupAdmitter(config)
+	})
+
+	It("should reject invalid resource name", func() {
+		ar := createGroupAdmissionReview(createGroup(), "invalidresource", nil)
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(ContainSubstring("unexpected resource"))
+	})
+
+	It("should reject Create operation when Snapshot feature gate is not enabled", func() {
+		disableFeatureGate(kvStore, "Snapshot")
+
+		resp := admitter.Admit(context.Background(), createGroupAdmissionReview(createGroup(), "virtualmachinesnapshotgroups", nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+	})
+
+	It("should allow a valid group", func() {
+		group := createGroup()
+		group.Spec.FailureDeadline = &metav1.Duration{Duration: time.Minute}
+
+		resp := admitter.Admit(context.Background(), createGroupAdmissionReview(group, "virtualmachinesnapshotgroups", nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	DescribeTable("should reject", func(modify func(*snapshotv1.VirtualMachineSnapshotGroup), field string) {
+		group := createGroup()
+		modify(group)
+
+		resp := admitter.Admit(context.Background(), createGroupAdmissionReview(group, "virtualmachinesnapshotgroups", nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal(field))
+	},
+		Entry("an invalid selector", func(group *snapshotv1.VirtualMachineSnapshotGroup) {
+			group.Spec.Selector.MatchExpressions = []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: "Unknown"},
+			}
+		}, "spec.selector"),
+		Entry("a negative failure deadline", func(group *snapshotv1.VirtualMachineSnapshotGroup) {
+			group.Spec.FailureDeadline = &metav1.Duration{Duration: -time.Minute}
+		}, "spec.failureDeadline"),
+	)
+
+	It("should reject a spec update", func() {
+		oldGroup := createGroup()
+		group := createGroup()
+		group.Spec.Selector.MatchLabels["app"] = "other"
+
+		resp := admitter.Admit(context.Background(), createGroupAdmissionReview(group, "virtualmachinesnapshotgroups", oldGroup))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+	})
+
+	It("should allow a metadata update", func() {
+		oldGroup := createGroup()
+		group := createGroup()
+		group.Labels = map[string]string{"team": "shop"}
+
+		resp := admitter.Admit(context.Background(), createGroupAdmissionReview(group, "virtualmachinesnapshotgroups", oldGroup))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+})
+
+var _ = Describe("Validating VirtualMachineSnapshotGroupRestore Admitter", func() {
+	var (
+		config   *virtconfig.ClusterConfig
+		kvStore  cache.Store
+		admitter *VMSnapshotGroupRestoreAdmitter
+	)
+
+	createGroupRestore := func() *snapshotv1.VirtualMachineSnapshotGroupRestore {
+		return &snapshotv1.VirtualMachineSnapshotGroupRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-group-restore",
+				Namespace: "default",
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotGroupRestoreSpec{
+				VirtualMachineSnapshotGroupName: "test-group",
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		config, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		enableFeatureGate(kvStore, "Snapshot")
+		admitter = NewVMSnapshotGroupRestoreAdmitter(config)
+	})
+
+	It("should reject Create operation when Snapshot feature gate is not enabled", func() {
+		disableFeatureGate(kvStore, "Snapshot")
+
+		resp := admitter.Admit(context.Background(), createGroupAdmissionReview(createGroupRestore(), "virtualmachinesnapshotgrouprestores", nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).Should(Equal("snapshot feature gate not enabled"))
+	})
+
+	It("should allow a valid group restore", func() {
+		resp := admitter.Admit(context.Background(), createGroupAdmissionReview(createGroupRestore(), "virtualmachinesnapshotgrouprestores", nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should reject a group restore without a group name", func() {
+		groupRestore := createGroupRestore()
+		groupRestore.Spec.VirtualMachineSnapshotGroupName = ""
+
+		resp := admitter.Admit(context.Background(), createGroupAdmissionReview(groupRestore, "virtualmachinesnapshotgrouprestores", nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.virtualMachineSnapshotGroupName"))
+	})
+
+	It("should reject a spec update", func() {
+		oldGroupRestore := createGroupRestore()
+		groupRestore := createGroupRestore()
+		groupRestore.Spec.VirtualMachineSnapshotGroupName = "other-group"
+
+		resp := admitter.Admit(context.Background(), createGroupAdmissionReview(groupRestore, "virtualmachinesnapshotgrouprestores", oldGroupRestore))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec"))
+	})
+})
+
+// createGroupAdmissionReview creates a review of a snapshot group resource, an update when oldObj is set
+func createGroupAdmissionReview(obj interface{}, resource string, oldObj interface{}) *admissionv1.AdmissionReview {
+	bytes, _ := json.Marshal(obj)
+
+	ar := &admissionv1.AdmissionReview{
+		Request: &admissionv1.AdmissionRequest{
+			Operation: admissionv1.Create,
+			Namespace: "default",
+			Resource: metav1.GroupVersionResource{
+				Group:    snapshotv1.SchemeGroupVersion.Group,
+				Resource: resource,
+			},
+			Object: runtime.RawExtension{
+				Raw: bytes,
+			},
+		},
+	}
+	if oldObj != nil {
+		oldBytes, _ := json.Marshal(oldObj)
+		ar.Request.Operation = admissionv1.Update
+		ar.Request.OldObject = runtime.RawExtension{
+			Raw: oldBytes,
+		}
+	}
+
+	return ar
+}
@@ -0,0 +1,101 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	v1 "kubevirt.io/api/core/v1"
+
+	migrationutil "kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+// ValidateVolumeMigration validates the options of the volume migration of a VM
+func ValidateVolumeMigration(field *k8sfield.Path, spec *v1.VirtualMachineSpec) (causes []metav1.StatusCause) {
+	if spec.VolumeMigration == nil {
+		return nil
+	}
+	field = field.Child("volumeMigration")
+
+	if bandwidth := spec.VolumeMigration.Bandwidth; bandwidth != nil && bandwidth.Sign() <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than zero", field.Child("bandwidth").String()),
+			Field:   field.Child("bandwidth").String(),
+		})
+	}
+
+	if storageClass := spec.VolumeMigration.BackendStorageClassName; storageClass != nil && *storageClass == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be empty", field.Child("backendStorageClassName").String()),
+			Field:   field.Child("backendStorageClassName").String(),
+		})
+	}
+
+	policy := spec.VolumeMigration.CutoverPolicy
+	if policy == nil {
+		return causes
+	}
+	field = field.Child("cutoverPolicy")
+
+	switch policy.Mode {
+	case v1.VolumeMigrationCutoverImmediate, v1.VolumeMigrationCutoverManual:
+		if policy.Window != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s is only supported with the %s mode", field.Child("window").String(), v1.VolumeMigrationCutoverWindow),
+				Field:   field.Child("window").String(),
+			})
+		}
+	case v1.VolumeMigrationCutoverWindow:
+		causes = append(causes, validateVolumeMigrationCutoverWindow(field.Child("window"), policy.Window)...)
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s is not a supported cut-over mode", policy.Mode),
+			Field:   field.Child("mode").String(),
+		})
+	}
+
+	return causes
+}
+
+func validateVolumeMigrationCutoverWindow(field *k8sfield.Path, window *v1.MaintenanceWindow) []metav1.StatusCause {
+	if window == nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s is required with the %s mode", field.String(), v1.VolumeMigrationCutoverWindow),
+			Field:   field.String(),
+		}}
+	}
+
+	if _, err := migrationutil.ParseMaintenanceWindow(*window); err != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: err.Error(),
+			Field:   field.String(),
+		}}
+	}
+
+	return nil
+}
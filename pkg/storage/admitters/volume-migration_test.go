@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package admitters
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Volume migration validation", func() {
+	window := func(schedule string, timeZone *string) *v1.MaintenanceWindow {
+		return &v1.MaintenanceWindow{Schedule: schedule, TimeZone: timeZone}
+	}
+
+	DescribeTable("should accept valid options", func(opts *v1.VolumeMigrationOptions) {
+		spec := &v1.VirtualMachineSpec{VolumeMigration: opts}
+		Expect(ValidateVolumeMigration(k8sfield.NewPath("spec"), spec)).To(BeEmpty())
+	},
+		Entry("without options", nil),
+		Entry("with a bandwidth", &v1.VolumeMigrationOptions{Bandwidth: pointer.P(resource.MustParse("64Mi"))}),
+		Entry("with the immediate mode", &v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{Mode: v1.VolumeMigrationCutoverImmediate},
+		}),
+		Entry("with the manual mode", &v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{Mode: v1.VolumeMigrationCutoverManual},
+		}),
+		Entry("with a window", &v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{
+				Mode:   v1.VolumeMigrationCutoverWindow,
+				Window: window("* 22-23 * * *", pointer.P("Europe/Paris")),
+			},
+		}),
+		Entry("with a backend storage class", &v1.VolumeMigrationOptions{BackendStorageClassName: pointer.P("fast")}),
+	)
+
+	DescribeTable("should reject invalid options", func(opts *v1.VolumeMigrationOptions, field string) {
+		spec := &v1.VirtualMachineSpec{VolumeMigration: opts}
+		causes := ValidateVolumeMigration(k8sfield.NewPath("spec"), spec)
+		Expect(causes).To(HaveLen(1))
+		Expect(causes[0].Field).To(Equal(field))
+	},
+		Entry("with a zero bandwidth", &v1.VolumeMigrationOptions{Bandwidth: pointer.P(resource.MustParse("0"))},
+			"spec.volumeMigration.bandwidth"),
+		Entry("with an empty backend storage class", &v1.VolumeMigrationOptions{BackendStorageClassName: pointer.P("")},
+			"spec.volumeMigration.backendStorageClassName"),
+		Entry("with an unknown mode", &v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{Mode: "Later"},
+		}, "spec.volumeMigration.cutoverPolicy.mode"),
+		Entry("with a window and the manual mode", &v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{
+				Mode:   v1.VolumeMigrationCutoverManual,
+				Window: window("* 22-23 * * *", nil),
+			},
+		}, "spec.volumeMigration.cutoverPolicy.window"),
+		Entry("with the window mode and no window", &v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{Mode: v1.VolumeMigrationCutoverWindow},
+		}, "spec.volumeMigration.cutoverPolicy.window"),
+		Entry("with an invalid window schedule", &v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{
+				Mode:   v1.VolumeMigrationCutoverWindow,
+				Window: window("* 25 * * *", nil),
+			},
+		}, "spec.volumeMigration.cutoverPolicy.window"),
+		Entry("with an invalid window time zone", &v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{
+				Mode:   v1.VolumeMigrationCutoverWindow,
+				Window: window("* 22-23 * * *", pointer.P("Mars/Olympus")),
+			},
+		}, "spec.volumeMigration.cutoverPolicy.window"),
+	)
+})
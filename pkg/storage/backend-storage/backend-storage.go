@@ -55,6 +55,8 @@ const (
 	// LabelApplyStorageProfile is a label used by the CDI mutating webhook
 	// to modify the PVC according to the storage profile.
 	LabelApplyStorageProfile = "cdi.kubevirt.io/applyStorageProfile"
+
+	dataVolumeByVMIIndex = "backendStorageDataVolumeByVMI"
 )
 
 func basePVC(vmi *corev1.VirtualMachineInstance) string {
@@ -85,6 +87,39 @@ func PVCForVMI(pvcStore cache.Store, vmi *corev1.VirtualMachineInstance) *v1.Per
 	return legacyPVC
 }
 
+// AddDataVolumeIndexer indexes the DataVolumes populating backend-storage PVCs by the VMI they belong to
+func AddDataVolumeIndexer(dvIndexer cache.Indexer) error {
+	return dvIndexer.AddIndexers(cache.Indexers{
+		dataVolumeByVMIIndex: func(obj any) ([]string, error) {
+			dv, ok := obj.(*cdiv1.DataVolume)
+			if !ok {
+				return nil, nil
+			}
+			if vmName, found := dv.Labels[PVCPrefix]; found {
+				return []string{controller.NamespacedKey(dv.Namespace, vmName)}, nil
+			}
+			return nil, nil
+		},
+	})
+}
+
+// DataVolumeForVMI returns the DataVolume populating the backend-storage PVC of the VMI, if any.
+// Such a DataVolume is created when importing a VM from the manifests of a VirtualMachineExport.
+// The indexer must have been set up with AddDataVolumeIndexer.
+func DataVolumeForVMI(dvIndexer cache.Indexer, vmi *corev1.VirtualMachineInstance) (*cdiv1.DataVolume, error) {
+	objs, err := dvIndexer.ByIndex(dataVolumeByVMIIndex, controller.NamespacedKey(vmi.Namespace, vmi.Name))
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		if dv := obj.(*cdiv1.DataVolume); dv.DeletionTimestamp == nil {
+			return dv, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func pvcForMigrationTargetFromStore(pvcStore cache.Store, migration *corev1.VirtualMachineInstanceMigration) *v1.PersistentVolumeClaim {
 	objs := pvcStore.List()
 	for _, obj := range objs {
@@ -505,23 +540,31 @@ func (bs *BackendStorage) UpdateVolumeStatus(vmi *corev1.VirtualMachineInstance,
 	})
 }
 
-func (bs *BackendStorage) createPVC(vmi *corev1.VirtualMachineInstance, labels map[string]string) (*v1.PersistentVolumeClaim, error) {
-	storageClass, err := bs.getStorageClass()
-	if err != nil {
-		return nil, err
-	}
-	mode := v1.PersistentVolumeFilesystem
-	accessMode := bs.getAccessMode(storageClass, mode)
-	ownerReferences := vmi.OwnerReferences
+func pvcOwnerReferences(vmi *corev1.VirtualMachineInstance) []metav1.OwnerReference {
 	if len(vmi.OwnerReferences) == 0 {
 		// If the VMI has no owner, then it did not originate from a VM.
 		// In that case, we tie the PVC to the VMI, rendering it quite useless since it won't actually persist.
-		// The alternative is to remove this `if` block, allowing the PVC to persist after the VMI is deleted.
+		// The alternative is to not set any owner, allowing the PVC to persist after the VMI is deleted.
 		// However, that would pose security and littering concerns.
-		ownerReferences = []metav1.OwnerReference{
+		return []metav1.OwnerReference{
 			*metav1.NewControllerRef(vmi, corev1.VirtualMachineInstanceGroupVersionKind),
 		}
 	}
+	return vmi.OwnerReferences
+}
+
+func (bs *BackendStorage) createPVC(vmi *corev1.VirtualMachineInstance, labels map[string]string) (*v1.PersistentVolumeClaim, error) {
+	storageClass, err := bs.getStorageClass()
+	if err != nil {
+		return nil, err
+	}
+	return bs.createPVCWithStorageClass(vmi, storageClass, labels)
+}
+
+func (bs *BackendStorage) createPVCWithStorageClass(vmi *corev1.VirtualMachineInstance, storageClass string, labels map[string]string) (*v1.PersistentVolumeClaim, error) {
+	mode := v1.PersistentVolumeFilesystem
+	accessMode := bs.getAccessMode(storageClass, mode)
+	ownerReferences := pvcOwnerReferences(vmi)
 
 	// Adding this label to allow the PVC to be processed by the CDI WebhookPvcRendering mutating webhook,
 	// which must be enabled in the CDI CR via feature gate.
@@ -547,7 +590,7 @@ func (bs *BackendStorage) createPVC(vmi *corev1.VirtualMachineInstance, labels m
 		},
 	}
 
-	pvc, err = bs.client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	pvc, err := bs.client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -573,15 +616,48 @@ func (bs *BackendStorage) CreatePVCForVMI(vmi *corev1.VirtualMachineInstance) (*
 }
 
 func (bs *BackendStorage) CreatePVCForMigrationTarget(vmi *corev1.VirtualMachineInstance, migrationName string) (*v1.PersistentVolumeClaim, error) {
+	storageClass := MigrationTargetStorageClass(vmi)
 	pvc := PVCForVMI(bs.pvcStore, vmi)
-	if pvc != nil {
+	if pvc != nil && !storageClassChanged(pvc, storageClass) {
 		if len(pvc.Status.AccessModes) > 0 && pvc.Status.AccessModes[0] == v1.ReadWriteMany {
 			// The source PVC is RWX, so it can be used for the target too
 			return pvc, nil
 		}
 	}
 
-	return bs.createPVC(vmi, map[string]string{corev1.MigrationNameLabel: migrationName})
+	labels := map[string]string{corev1.MigrationNameLabel: migrationName}
+	if storageClass != "" {
+		return bs.createPVCWithStorageClass(vmi, storageClass, labels)
+	}
+	return bs.createPVC(vmi, labels)
+}
+
+func storageClassChanged(pvc *v1.PersistentVolumeClaim, storageClass string) bool {
+	if storageClass == "" {
+		return false
+	}
+	return pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName != storageClass
+}
+
+// MigrationTargetStorageClass returns the storage class requested for the backend-storage PVC
+// of the migration target through the volume migration options, empty if none was requested
+func MigrationTargetStorageClass(vmi *corev1.VirtualMachineInstance) string {
+	if vmi.Status.VolumeMigration == nil || vmi.Status.VolumeMigration.BackendStorageClassName == nil {
+		return ""
+	}
+	return *vmi.Status.VolumeMigration.BackendStorageClassName
+}
+
+// StorageClassChangeRequested returns true if the volume migration options of the VMI request a storage class
+// for the backend-storage PVC that differs from the class of the current PVC. The VMI then needs to be migrated
+// to move the state to a PVC of the requested class.
+func StorageClassChangeRequested(pvcStore cache.Store, vmi *corev1.VirtualMachineInstance) bool {
+	storageClass := MigrationTargetStorageClass(vmi)
+	if storageClass == "" || !IsBackendStorageNeeded(vmi) {
+		return false
+	}
+	pvc := PVCForVMI(pvcStore, vmi)
+	return pvc != nil && storageClassChanged(pvc, storageClass)
 }
 
 // IsPVCReady returns true if either:
@@ -593,12 +669,16 @@ func (bs *BackendStorage) IsPVCReady(vmi *corev1.VirtualMachineInstance, pvcName
 		return true, nil
 	}
 
-	obj, exists, err := bs.pvcStore.GetByKey(controller.NamespacedKey(vmi.Namespace, pvcName))
+	return bs.isPVCReady(vmi.Namespace, pvcName)
+}
+
+func (bs *BackendStorage) isPVCReady(namespace, pvcName string) (bool, error) {
+	obj, exists, err := bs.pvcStore.GetByKey(controller.NamespacedKey(namespace, pvcName))
 	if err != nil {
 		return false, err
 	}
 	if !exists {
-		return false, fmt.Errorf("pvc %s not found in namespace %s", pvcName, vmi.Namespace)
+		return false, fmt.Errorf("pvc %s not found in namespace %s", pvcName, namespace)
 	}
 	pvc := obj.(*v1.PersistentVolumeClaim)
 
@@ -606,7 +686,7 @@ func (bs *BackendStorage) IsPVCReady(vmi *corev1.VirtualMachineInstance, pvcName
 	case v1.ClaimBound:
 		return true, nil
 	case v1.ClaimLost:
-		return false, fmt.Errorf("backend storage PVC lost")
+		return false, fmt.Errorf("PVC %s lost", pvcName)
 	case v1.ClaimPending:
 		if pvc.Spec.StorageClassName == nil {
 			return false, fmt.Errorf("no storage class name")
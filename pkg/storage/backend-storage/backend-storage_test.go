@@ -25,7 +25,9 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/errors"
 
+	"k8s.io/apimachinery/pkg/runtime"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -294,6 +296,156 @@ var _ = Describe("Backend Storage", func() {
 		})
 	})
 
+	Context("Migration target PVC", func() {
+		var k8sClient *k8sfake.Clientset
+		var vmi *virtv1.VirtualMachineInstance
+		const (
+			nsName        = "testns"
+			vmiName       = "testvmi"
+			sourcePVCName = "persistent-state-for-" + vmiName + "-abcde"
+			migrationName = "migration"
+		)
+
+		BeforeEach(func() {
+			k8sClient = k8sfake.NewSimpleClientset()
+			virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+			Expect(storageClassStore.Add(&storagev1.StorageClass{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:        "sc",
+					Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": "true"},
+				},
+			})).To(Succeed())
+			Expect(pvcStore.Add(&v1.PersistentVolumeClaim{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:      sourcePVCName,
+					Namespace: nsName,
+					Labels:    map[string]string{PVCPrefix: vmiName},
+				},
+				Spec: v1.PersistentVolumeClaimSpec{
+					StorageClassName: pointer.P("sc"),
+				},
+				Status: v1.PersistentVolumeClaimStatus{
+					AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteMany},
+				},
+			})).To(Succeed())
+			vmi = &virtv1.VirtualMachineInstance{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:      vmiName,
+					Namespace: nsName,
+				},
+			}
+		})
+
+		It("Should share a RWX source PVC", func() {
+			pvc, err := backendStorage.CreatePVCForMigrationTarget(vmi, migrationName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pvc.Name).To(Equal(sourcePVCName))
+		})
+
+		It("Should share a RWX source PVC already using the requested storage class", func() {
+			vmi.Status.VolumeMigration = &virtv1.VolumeMigrationOptions{BackendStorageClassName: pointer.P("sc")}
+			pvc, err := backendStorage.CreatePVCForMigrationTarget(vmi, migrationName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pvc.Name).To(Equal(sourcePVCName))
+		})
+
+		It("Should create the target PVC with the requested storage class", func() {
+			vmi.Status.VolumeMigration = &virtv1.VolumeMigrationOptions{BackendStorageClassName: pointer.P("fast")}
+			pvc, err := backendStorage.CreatePVCForMigrationTarget(vmi, migrationName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pvc.Name).NotTo(Equal(sourcePVCName))
+			Expect(pvc.Spec.StorageClassName).To(HaveValue(Equal("fast")))
+			Expect(pvc.Labels).To(HaveKeyWithValue(virtv1.MigrationNameLabel, migrationName))
+			Expect(pvc.Labels).NotTo(HaveKey(PVCPrefix))
+		})
+
+		It("Should end the migration with the backend-storage PVC on the requested storage class", func() {
+			const targetPVCName = "persistent-state-for-" + vmiName + "-fghij"
+			obj, exists, err := pvcStore.GetByKey(nsName + "/" + sourcePVCName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			_, err = k8sClient.CoreV1().PersistentVolumeClaims(nsName).Create(context.TODO(), obj.(*v1.PersistentVolumeClaim), k8smetav1.CreateOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			// The fake client does not generate the name of the target PVC
+			k8sClient.PrependReactor("create", "persistentvolumeclaims", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				pvc := action.(k8stesting.CreateAction).GetObject().(*v1.PersistentVolumeClaim)
+				pvc.Name = targetPVCName
+				return false, nil, nil
+			})
+
+			vmi.Spec.Domain.Devices.TPM = &virtv1.TPMDevice{Persistent: pointer.P(true)}
+			vmi.Status.VolumeMigration = &virtv1.VolumeMigrationOptions{BackendStorageClassName: pointer.P("fast")}
+			Expect(StorageClassChangeRequested(pvcStore, vmi)).To(BeTrue())
+
+			target, err := backendStorage.CreatePVCForMigrationTarget(vmi, migrationName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pvcStore.Add(target)).To(Succeed())
+			migration := &virtv1.VirtualMachineInstanceMigration{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: migrationName, Namespace: nsName},
+				Spec:       virtv1.VirtualMachineInstanceMigrationSpec{VMIName: vmiName},
+				Status: virtv1.VirtualMachineInstanceMigrationStatus{
+					MigrationState: &virtv1.VirtualMachineInstanceMigrationState{
+						SourcePersistentStatePVCName: sourcePVCName,
+						TargetPersistentStatePVCName: target.Name,
+					},
+				},
+			}
+			Expect(MigrationHandoff(virtClient, pvcStore, migration)).To(Succeed())
+
+			_, err = k8sClient.CoreV1().PersistentVolumeClaims(nsName).Get(context.TODO(), sourcePVCName, k8smetav1.GetOptions{})
+			Expect(err).To(MatchError(errors.IsNotFound, "k8serrors.IsNotFound"))
+			pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(nsName).Get(context.TODO(), targetPVCName, k8smetav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pvc.Spec.StorageClassName).To(HaveValue(Equal("fast")))
+			Expect(pvc.Labels).To(HaveKeyWithValue(PVCPrefix, vmiName))
+			Expect(pvc.Labels).NotTo(HaveKey(virtv1.MigrationNameLabel))
+
+			By("not requesting another migration once the PVC is on the requested storage class")
+			Expect(pvcStore.Delete(obj)).To(Succeed())
+			Expect(pvcStore.Update(pvc)).To(Succeed())
+			Expect(PVCForVMI(pvcStore, vmi).Name).To(Equal(targetPVCName))
+			Expect(StorageClassChangeRequested(pvcStore, vmi)).To(BeFalse())
+		})
+	})
+
+	Context("DataVolumeForVMI", func() {
+		It("Should return the DataVolume importing the backend storage of the VMI", func() {
+			dvInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+			dvIndexer := dvInformer.GetIndexer()
+			Expect(AddDataVolumeIndexer(dvIndexer)).To(Succeed())
+			vmi := &virtv1.VirtualMachineInstance{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: "testvmi", Namespace: "testns"},
+			}
+			Expect(DataVolumeForVMI(dvIndexer, vmi)).To(BeNil())
+			Expect(dvIndexer.Add(&cdiv1.DataVolume{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:      "persistent-state-for-testvmi-abcde",
+					Namespace: "othertestns",
+					Labels:    map[string]string{PVCPrefix: "testvmi"},
+				},
+			})).To(Succeed())
+			Expect(DataVolumeForVMI(dvIndexer, vmi)).To(BeNil())
+			Expect(dvIndexer.Add(&cdiv1.DataVolume{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:              "persistent-state-for-testvmi-fghij",
+					Namespace:         "testns",
+					Labels:            map[string]string{PVCPrefix: "testvmi"},
+					DeletionTimestamp: pointer.P(k8smetav1.Now()),
+				},
+			})).To(Succeed())
+			Expect(DataVolumeForVMI(dvIndexer, vmi)).To(BeNil())
+			dv := &cdiv1.DataVolume{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:      "persistent-state-for-testvmi-abcde",
+					Namespace: "testns",
+					Labels:    map[string]string{PVCPrefix: "testvmi"},
+				},
+			}
+			Expect(dvIndexer.Add(dv)).To(Succeed())
+			Expect(DataVolumeForVMI(dvIndexer, vmi)).To(Equal(dv))
+		})
+	})
+
 	Context("Legacy PVCs", func() {
 		var k8sClient *k8sfake.Clientset
 
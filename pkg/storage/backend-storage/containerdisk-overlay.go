@@ -0,0 +1,183 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package backendstorage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	corev1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	// OverlayPVCPrefix prefixes the name of the PVCs backing persistent container disk overlays.
+	// It is also used as label key, with the name of the VM as value.
+	OverlayPVCPrefix = "containerdisk-overlay-for"
+	// OverlayVolumeLabel holds the name of the container disk volume an overlay PVC belongs to.
+	OverlayVolumeLabel = "kubevirt.io/containerdisk-overlay-volume"
+	// OverlayImageAnnotation records the container disk image the overlay was created on top of.
+	OverlayImageAnnotation = "kubevirt.io/containerdisk-overlay-image"
+	// OverlayImageDigestAnnotation records the image, pinned by digest, the overlay was first used on top of.
+	OverlayImageDigestAnnotation = "kubevirt.io/containerdisk-overlay-image-digest"
+
+	digestSeparator = "@sha256:"
+)
+
+// HasPersistentOverlay returns true if the volume is a container disk with its overlay stored on a PVC.
+func HasPersistentOverlay(volume *corev1.Volume) bool {
+	return volume.ContainerDisk != nil && volume.ContainerDisk.PersistentOverlay != nil
+}
+
+// PersistentOverlayVolumes returns the container disk volumes of the spec which have a persistent overlay.
+func PersistentOverlayVolumes(vmiSpec *corev1.VirtualMachineInstanceSpec) []corev1.Volume {
+	var volumes []corev1.Volume
+	for _, volume := range vmiSpec.Volumes {
+		if HasPersistentOverlay(&volume) {
+			volumes = append(volumes, volume)
+		}
+	}
+	return volumes
+}
+
+// OverlayPVCName returns the name of the PVC backing the overlay of a container disk volume.
+// The name is stable for a given VM, which is what keeps the overlay across restarts.
+func OverlayPVCName(vmName, volumeName string) string {
+	return fmt.Sprintf("%s-%s-%s", OverlayPVCPrefix, vmName, volumeName)
+}
+
+// OverlayPVCForVolume returns the PVC backing the overlay of a container disk volume, if it exists.
+func OverlayPVCForVolume(pvcStore cache.Store, namespace, vmName, volumeName string) *v1.PersistentVolumeClaim {
+	obj, exists, err := pvcStore.GetByKey(controller.NamespacedKey(namespace, OverlayPVCName(vmName, volumeName)))
+	if err != nil || !exists {
+		return nil
+	}
+	return obj.(*v1.PersistentVolumeClaim)
+}
+
+// IsOverlayImageMismatch returns true if the overlay PVC was created for another image than the one
+// the volume currently refers to. Such an overlay can't be reused safely and has to be reset first.
+// Once the digest of the image is recorded, a volume referring to a digest is compared by digest.
+func IsOverlayImageMismatch(pvc *v1.PersistentVolumeClaim, volume *corev1.Volume) bool {
+	if digest, recorded := pvc.Annotations[OverlayImageDigestAnnotation]; recorded && strings.Contains(volume.ContainerDisk.Image, digestSeparator) {
+		return imageDigest(digest) != imageDigest(volume.ContainerDisk.Image)
+	}
+	image, exists := pvc.Annotations[OverlayImageAnnotation]
+	return exists && image != volume.ContainerDisk.Image
+}
+
+// OverlayImageIDs returns the images, pinned by digest, the persistent overlays of the VMI were first used on top of.
+// A tag may have moved since, the launcher pod has to use the same images to keep the overlays consistent.
+func OverlayImageIDs(pvcStore cache.Store, vmi *corev1.VirtualMachineInstance) map[string]string {
+	imageIDs := map[string]string{}
+	for _, volume := range PersistentOverlayVolumes(&vmi.Spec) {
+		pvc := OverlayPVCForVolume(pvcStore, vmi.Namespace, vmi.Name, volume.Name)
+		if pvc == nil || IsOverlayImageMismatch(pvc, &volume) {
+			continue
+		}
+		if digest, recorded := pvc.Annotations[OverlayImageDigestAnnotation]; recorded {
+			imageIDs[volume.Name] = digest
+		}
+	}
+	return imageIDs
+}
+
+// NeedsOverlayImageDigest returns true if the digest of the image the overlay runs on top of is not recorded yet
+func NeedsOverlayImageDigest(pvc *v1.PersistentVolumeClaim, imageID string) bool {
+	_, recorded := pvc.Annotations[OverlayImageDigestAnnotation]
+	return !recorded && strings.Contains(imageID, digestSeparator)
+}
+
+// RecordOverlayImageDigest records the image, pinned by digest, the overlay runs on top of
+func (bs *BackendStorage) RecordOverlayImageDigest(pvc *v1.PersistentVolumeClaim, imageID string) error {
+	patchBytes, err := patch.New(
+		patch.WithAdd(fmt.Sprintf("/metadata/annotations/%s", patch.EscapeJSONPointer(OverlayImageDigestAnnotation)), imageID),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = bs.client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+func imageDigest(image string) string {
+	if i := strings.LastIndex(image, digestSeparator); i != -1 {
+		return image[i+len(digestSeparator):]
+	}
+	return ""
+}
+
+// CreateOverlayPVCForVolume creates the PVC backing the overlay of a container disk volume.
+func (bs *BackendStorage) CreateOverlayPVCForVolume(vmi *corev1.VirtualMachineInstance, volume *corev1.Volume) (*v1.PersistentVolumeClaim, error) {
+	if !HasPersistentOverlay(volume) {
+		return nil, fmt.Errorf("volume %s is not a container disk with a persistent overlay", volume.Name)
+	}
+	storageClass, err := bs.getStorageClass()
+	if err != nil {
+		return nil, err
+	}
+	mode := v1.PersistentVolumeFilesystem
+	accessMode := bs.getAccessMode(storageClass, mode)
+
+	pvc := &v1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            OverlayPVCName(vmi.Name, volume.Name),
+			OwnerReferences: pvcOwnerReferences(vmi),
+			Labels: map[string]string{
+				OverlayPVCPrefix:         vmi.Name,
+				OverlayVolumeLabel:       volume.Name,
+				LabelApplyStorageProfile: "true",
+			},
+			Annotations: map[string]string{
+				OverlayImageAnnotation: volume.ContainerDisk.Image,
+			},
+		},
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes: []v1.PersistentVolumeAccessMode{accessMode},
+			Resources: v1.VolumeResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceStorage: volume.ContainerDisk.PersistentOverlay.Size},
+			},
+			StorageClassName: &storageClass,
+			VolumeMode:       &mode,
+		},
+	}
+
+	return bs.client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+}
+
+// AreOverlayPVCsReady returns true if the PVCs backing all persistent container disk overlays of the VMI
+// are ready to be consumed by the virt-launcher pod.
+func (bs *BackendStorage) AreOverlayPVCsReady(vmi *corev1.VirtualMachineInstance) (bool, error) {
+	for _, volume := range PersistentOverlayVolumes(&vmi.Spec) {
+		ready, err := bs.isPVCReady(vmi.Namespace, OverlayPVCName(vmi.Name, volume.Name))
+		if err != nil || !ready {
+			return false, err
+		}
+	}
+	return true, nil
+}
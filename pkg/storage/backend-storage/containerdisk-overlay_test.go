@@ -0,0 +1,191 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package backendstorage
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Container disk persistent overlay", func() {
+	const (
+		nsName  = "testns"
+		vmiName = "testvmi"
+	)
+
+	var (
+		backendStorage    *BackendStorage
+		storageClassStore cache.Store
+		pvcStore          cache.Store
+		k8sClient         *k8sfake.Clientset
+		vmi               *virtv1.VirtualMachineInstance
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		k8sClient = k8sfake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{})
+		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
+		storageProfileInformer, _ := testutils.NewFakeInformerFor(&cdiv1.StorageProfile{})
+		pvcInformer, _ := testutils.NewFakeInformerFor(&v1.PersistentVolumeClaim{})
+		storageClassStore = storageClassInformer.GetStore()
+		pvcStore = pvcInformer.GetStore()
+		backendStorage = NewBackendStorage(virtClient, config, storageClassStore, storageProfileInformer.GetStore(), pvcStore)
+
+		Expect(storageClassStore.Add(&storagev1.StorageClass{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				Name:        "sc",
+				Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": "true"},
+			},
+		})).To(Succeed())
+
+		vmi = libvmi.New(
+			libvmi.WithName(vmiName),
+			libvmi.WithNamespace(nsName),
+			libvmi.WithContainerDisk("ephemeral", "image"),
+			libvmi.WithContainerDiskPersistentOverlay("persistent", "image", resource.MustParse("1Gi")),
+		)
+	})
+
+	It("should only list container disks with a persistent overlay", func() {
+		volumes := PersistentOverlayVolumes(&vmi.Spec)
+		Expect(volumes).To(HaveLen(1))
+		Expect(volumes[0].Name).To(Equal("persistent"))
+	})
+
+	It("should create the overlay PVC with a stable name, the requested size and the image", func() {
+		volume := PersistentOverlayVolumes(&vmi.Spec)[0]
+		pvc, err := backendStorage.CreateOverlayPVCForVolume(vmi, &volume)
+		Expect(err).NotTo(HaveOccurred())
+
+		pvc, err = k8sClient.CoreV1().PersistentVolumeClaims(nsName).Get(context.Background(), pvc.Name, k8smetav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pvc.Name).To(Equal("containerdisk-overlay-for-testvmi-persistent"))
+		Expect(pvc.Labels).To(HaveKeyWithValue(OverlayPVCPrefix, vmiName))
+		Expect(pvc.Labels).To(HaveKeyWithValue(OverlayVolumeLabel, "persistent"))
+		Expect(pvc.Labels).To(HaveKeyWithValue(LabelApplyStorageProfile, "true"))
+		Expect(pvc.Annotations).To(HaveKeyWithValue(OverlayImageAnnotation, "image"))
+		Expect(pvc.Spec.Resources.Requests).To(HaveKeyWithValue(v1.ResourceStorage, resource.MustParse("1Gi")))
+		Expect(pvc.Spec.StorageClassName).To(Equal(pointer.P("sc")))
+		Expect(pvc.OwnerReferences).To(HaveLen(1))
+		Expect(pvc.OwnerReferences[0].Name).To(Equal(vmiName))
+	})
+
+	It("should refuse to create an overlay PVC for an ephemeral container disk", func() {
+		_, err := backendStorage.CreateOverlayPVCForVolume(vmi, &vmi.Spec.Volumes[0])
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should detect an overlay created for another image", func() {
+		volume := PersistentOverlayVolumes(&vmi.Spec)[0]
+		pvc, err := backendStorage.CreateOverlayPVCForVolume(vmi, &volume)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(IsOverlayImageMismatch(pvc, &volume)).To(BeFalse())
+
+		volume.ContainerDisk.Image = "other-image"
+		Expect(IsOverlayImageMismatch(pvc, &volume)).To(BeTrue())
+	})
+
+	It("should compare the overlay by digest once the digest of its image is recorded", func() {
+		const imageID = "registry/image@sha256:1111"
+		volume := PersistentOverlayVolumes(&vmi.Spec)[0]
+		pvc, err := backendStorage.CreateOverlayPVCForVolume(vmi, &volume)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(NeedsOverlayImageDigest(pvc, imageID)).To(BeTrue())
+		Expect(NeedsOverlayImageDigest(pvc, "image")).To(BeFalse())
+
+		Expect(backendStorage.RecordOverlayImageDigest(pvc, imageID)).To(Succeed())
+		pvc, err = k8sClient.CoreV1().PersistentVolumeClaims(nsName).Get(context.Background(), pvc.Name, k8smetav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pvc.Annotations).To(HaveKeyWithValue(OverlayImageDigestAnnotation, imageID))
+		Expect(NeedsOverlayImageDigest(pvc, imageID)).To(BeFalse())
+
+		Expect(IsOverlayImageMismatch(pvc, &volume)).To(BeFalse())
+		volume.ContainerDisk.Image = "other-registry/image@sha256:1111"
+		Expect(IsOverlayImageMismatch(pvc, &volume)).To(BeFalse())
+		volume.ContainerDisk.Image = "registry/image@sha256:2222"
+		Expect(IsOverlayImageMismatch(pvc, &volume)).To(BeTrue())
+	})
+
+	It("should pin the launcher to the recorded overlay images", func() {
+		volume := PersistentOverlayVolumes(&vmi.Spec)[0]
+		pvc, err := backendStorage.CreateOverlayPVCForVolume(vmi, &volume)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pvcStore.Add(pvc)).To(Succeed())
+		Expect(OverlayImageIDs(pvcStore, vmi)).To(BeEmpty())
+
+		pvc = pvc.DeepCopy()
+		pvc.Annotations[OverlayImageDigestAnnotation] = "registry/image@sha256:1111"
+		Expect(pvcStore.Update(pvc)).To(Succeed())
+		Expect(OverlayImageIDs(pvcStore, vmi)).To(Equal(map[string]string{"persistent": "registry/image@sha256:1111"}))
+
+		vmi.Spec.Volumes[1].ContainerDisk.Image = "other-image"
+		Expect(OverlayImageIDs(pvcStore, vmi)).To(BeEmpty())
+	})
+
+	DescribeTable("should report the overlay PVCs readiness", func(phase v1.PersistentVolumeClaimPhase, expectReady, expectError bool) {
+		Expect(pvcStore.Add(&v1.PersistentVolumeClaim{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				Name:      OverlayPVCName(vmiName, "persistent"),
+				Namespace: nsName,
+			},
+			Spec:   v1.PersistentVolumeClaimSpec{StorageClassName: pointer.P("sc")},
+			Status: v1.PersistentVolumeClaimStatus{Phase: phase},
+		})).To(Succeed())
+
+		ready, err := backendStorage.AreOverlayPVCsReady(vmi)
+		Expect(ready).To(Equal(expectReady))
+		if expectError {
+			Expect(err).To(HaveOccurred())
+		} else {
+			Expect(err).NotTo(HaveOccurred())
+		}
+	},
+		Entry("when bound", v1.ClaimBound, true, false),
+		Entry("when pending with an immediate storage class", v1.ClaimPending, false, false),
+		Entry("when lost", v1.ClaimLost, false, true),
+	)
+
+	It("should report an error when an overlay PVC is missing", func() {
+		ready, err := backendStorage.AreOverlayPVCsReady(vmi)
+		Expect(err).To(HaveOccurred())
+		Expect(ready).To(BeFalse())
+	})
+})
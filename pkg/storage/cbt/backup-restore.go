@@ -0,0 +1,799 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	backupRestoreCompletedEvent = "VirtualMachineBackupRestoreCompleted"
+	backupRestoreFailedEvent    = "VirtualMachineBackupRestoreFailed"
+	backupCheckpointIndex       = "backupCheckpoint"
+	restoreJobPollInterval      = 10 * time.Second
+	restoreBackupsDir           = "/backups"
+	restoreVolumesDir           = "/restore"
+	restoreDiskImage            = "disk.img"
+
+	restoreInProgressMsg          = "Restore is in progress"
+	restoreCompletedMsg           = "Successfully restored VM %s"
+	restoreBackupNotFoundMsg      = "backup %s does not exist"
+	restoreBackupNotDoneMsg       = "waiting for backup %s to complete"
+	restoreTrackerNoCheckpointMsg = "BackupTracker %s has no checkpoint"
+	restoreCheckpointNotFoundMsg  = "no backup holds checkpoint %s"
+	restoreNotPushBackupMsg       = "backup %s is not a push mode backup"
+	restoreBackupNotCapturedMsg   = "backup %s did not capture the VM"
+	restoreVolumeMissingMsg       = "volume %s is missing from backup %s"
+	restoreVolumeNoCapacityMsg    = "the capacity of volume %s is unknown"
+	restoreBrokenChainMsg         = "backup %s does not lead back to a full backup"
+	restoreTargetExistsMsg        = "target VM %s already exists"
+	restoreJobFailedMsg           = "restore job %s failed: %s"
+)
+
+type VMBackupRestoreController struct {
+	client             kubecli.KubevirtClient
+	restoreInformer    cache.SharedIndexInformer
+	backupInformer     cache.SharedIndexInformer
+	backupTrackerStore cache.Store
+	vmStore            cache.Store
+	pvcStore           cache.Store
+	launcherImage      string
+	recorder           record.EventRecorder
+	restoreQueue       workqueue.TypedRateLimitingInterface[string]
+	hasSynced          func() bool
+}
+
+func NewVMBackupRestoreController(client kubecli.KubevirtClient,
+	restoreInformer cache.SharedIndexInformer,
+	backupInformer cache.SharedIndexInformer,
+	backupTrackerInformer cache.SharedIndexInformer,
+	vmInformer cache.SharedIndexInformer,
+	pvcInformer cache.SharedIndexInformer,
+	launcherImage string,
+	recorder record.EventRecorder,
+) (*VMBackupRestoreController, error) {
+	c := &VMBackupRestoreController{
+		restoreQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vmbackuprestore"},
+		),
+		restoreInformer:    restoreInformer,
+		backupInformer:     backupInformer,
+		backupTrackerStore: backupTrackerInformer.GetStore(),
+		vmStore:            vmInformer.GetStore(),
+		pvcStore:           pvcInformer.GetStore(),
+		launcherImage:      launcherImage,
+		recorder:           recorder,
+		client:             client,
+	}
+
+	c.hasSynced = func() bool {
+		return restoreInformer.HasSynced() && backupInformer.HasSynced() && backupTrackerInformer.HasSynced() &&
+			vmInformer.HasSynced() && pvcInformer.HasSynced()
+	}
+
+	_, err := restoreInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleRestore,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleRestore(newObj) },
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = backupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleRestoredBackup,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleRestoredBackup(newObj) },
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (ctrl *VMBackupRestoreController) handleRestore(obj interface{}) {
+	if restore, ok := obj.(*backupv1.VirtualMachineBackupRestore); ok {
+		objName, err := cache.MetaNamespaceKeyFunc(restore)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, restore)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.restoreQueue.Add(objName)
+	}
+}
+
+// handleRestoredBackup enqueues the restores of the namespace, which may be
+// waiting for the backup to complete
+func (ctrl *VMBackupRestoreController) handleRestoredBackup(obj interface{}) {
+	backup, ok := obj.(*backupv1.VirtualMachineBackup)
+	if !ok || !IsBackupDone(backup.Status) {
+		return
+	}
+
+	keys, err := ctrl.restoreInformer.GetIndexer().IndexKeys(cache.NamespaceIndex, backup.Namespace)
+	if err != nil {
+		return
+	}
+	for _, key := range keys {
+		ctrl.restoreQueue.Add(key)
+	}
+}
+
+func (ctrl *VMBackupRestoreController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.restoreQueue.ShutDown()
+
+	log.Log.Info("Starting backup restore controller.")
+	defer log.Log.Info("Shutting down backup restore controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.hasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for range threadiness {
+		go wait.Until(ctrl.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMBackupRestoreController) runWorker() {
+	for ctrl.Execute() {
+	}
+}
+
+func (ctrl *VMBackupRestoreController) Execute() bool {
+	key, quit := ctrl.restoreQueue.Get()
+	if quit {
+		return false
+	}
+	defer ctrl.restoreQueue.Done(key)
+
+	err := ctrl.execute(key)
+	if err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachineBackupRestore %v", key)
+		ctrl.restoreQueue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachineBackupRestore %v", key)
+		ctrl.restoreQueue.Forget(key)
+	}
+	return true
+}
+
+func (ctrl *VMBackupRestoreController) execute(key string) error {
+	logger := log.Log.With("VirtualMachineBackupRestore", key)
+	storeObj, exists, err := ctrl.restoreInformer.GetStore().GetByKey(key)
+	if err != nil {
+		logger.Errorf("Error getting backup restore from store: %v", err)
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	restore, ok := storeObj.(*backupv1.VirtualMachineBackupRestore)
+	if !ok {
+		logger.Errorf("Unexpected resource type: %T", storeObj)
+		return fmt.Errorf("unexpected resource %+v", storeObj)
+	}
+	if restore.DeletionTimestamp != nil || isRestoreFinished(restore.Status) {
+		return nil
+	}
+
+	status, requeue, syncErr := ctrl.sync(restore)
+	if err := ctrl.updateRestoreStatus(restore, status); err != nil {
+		logger.Reason(err).Errorf("Updating the VirtualMachineBackupRestore status failed")
+		return err
+	}
+	if syncErr != nil {
+		return syncErr
+	}
+
+	if requeue {
+		ctrl.restoreQueue.AddAfter(key, restoreJobPollInterval)
+	}
+	return nil
+}
+
+func isRestoreFinished(status *backupv1.VirtualMachineBackupRestoreStatus) bool {
+	return status != nil &&
+		(hasCondition(status.Conditions, backupv1.ConditionDone) || hasCondition(status.Conditions, backupv1.ConditionFailure))
+}
+
+// restoreFailure marks problems which retrying the restore can not resolve
+type restoreFailure struct {
+	msg string
+}
+
+func (f *restoreFailure) Error() string {
+	return f.msg
+}
+
+func newRestoreFailure(format string, a ...interface{}) error {
+	return &restoreFailure{msg: fmt.Sprintf(format, a...)}
+}
+
+// sync rebuilds the backed up volumes into new PVCs with a job applying the
+// backups of the chain in order, and creates the VM once the job completed.
+// It reports whether the job has to be polled again.
+func (ctrl *VMBackupRestoreController) sync(restore *backupv1.VirtualMachineBackupRestore) (*backupv1.VirtualMachineBackupRestoreStatus, bool, error) {
+	status := &backupv1.VirtualMachineBackupRestoreStatus{}
+	if restore.Status != nil {
+		status = restore.Status.DeepCopy()
+	}
+
+	chain, waiting, err := ctrl.backupChain(restore)
+	if err != nil {
+		return ctrl.failRestore(restore, status, err)
+	}
+	if waiting != "" {
+		status.Conditions = updateCondition(status.Conditions, newCondition(backupv1.ConditionProgressing, corev1.ConditionFalse, waiting))
+		return status, false, nil
+	}
+
+	status.Backups = make([]string, 0, len(chain))
+	for _, backup := range chain {
+		status.Backups = append(status.Backups, backup.Name)
+	}
+	status.Restores = restoredVolumes(restore, chain[len(chain)-1])
+
+	targetVM, err := ctrl.getTargetVM(restore)
+	if err != nil {
+		return ctrl.failRestore(restore, status, err)
+	}
+	if targetVM == nil {
+		if err := ctrl.ensureRestorePVCs(restore, chain[len(chain)-1], status.Restores); err != nil {
+			return ctrl.failRestore(restore, status, err)
+		}
+
+		completed, err := ctrl.reconcileRestoreJob(restore, chain, status.Restores)
+		if err != nil {
+			return ctrl.failRestore(restore, status, err)
+		}
+		if !completed {
+			status.Conditions = updateCondition(status.Conditions, newCondition(backupv1.ConditionProgressing, corev1.ConditionTrue, restoreInProgressMsg))
+			status.Conditions = updateCondition(status.Conditions, newCondition(backupv1.ConditionDone, corev1.ConditionFalse, restoreInProgressMsg))
+			return status, true, nil
+		}
+
+		targetVM = restoredVM(restore, chain[len(chain)-1].Status.VirtualMachine, status.Restores)
+		_, err = ctrl.client.VirtualMachine(restore.Namespace).Create(context.Background(), targetVM, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return status, false, err
+		}
+	}
+
+	if err := ctrl.releaseRestorePVCs(restore, status.Restores); err != nil {
+		return status, false, err
+	}
+
+	err = ctrl.client.BatchV1().Jobs(restore.Namespace).Delete(context.Background(), restoreJobName(restore), metav1.DeleteOptions{
+		PropagationPolicy: pointer.P(metav1.DeletePropagationBackground),
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		return status, false, err
+	}
+
+	reason := fmt.Sprintf(restoreCompletedMsg, restore.Spec.Target.Name)
+	ctrl.recorder.Event(restore, corev1.EventTypeNormal, backupRestoreCompletedEvent, reason)
+	status.RestoreTime = currentTime()
+	status.Conditions = updateCondition(status.Conditions, newCondition(backupv1.ConditionProgressing, corev1.ConditionFalse, reason))
+	status.Conditions = updateCondition(status.Conditions, newCondition(backupv1.ConditionDone, corev1.ConditionTrue, reason))
+	return status, false, nil
+}
+
+func (ctrl *VMBackupRestoreController) failRestore(restore *backupv1.VirtualMachineBackupRestore, status *backupv1.VirtualMachineBackupRestoreStatus, err error) (*backupv1.VirtualMachineBackupRestoreStatus, bool, error) {
+	if _, ok := err.(*restoreFailure); !ok {
+		return status, false, err
+	}
+
+	log.Log.Object(restore).Error(err.Error())
+	ctrl.recorder.Event(restore, corev1.EventTypeWarning, backupRestoreFailedEvent, err.Error())
+	status.Conditions = updateCondition(status.Conditions, newCondition(backupv1.ConditionProgressing, corev1.ConditionFalse, err.Error()))
+	status.Conditions = updateCondition(status.Conditions, newCondition(backupv1.ConditionFailure, corev1.ConditionTrue, err.Error()))
+	return status, false, nil
+}
+
+// backupChain returns the backups to apply, the full backup first. When a backup
+// of the chain is missing or still in progress the reason to wait is returned.
+func (ctrl *VMBackupRestoreController) backupChain(restore *backupv1.VirtualMachineBackupRestore) ([]*backupv1.VirtualMachineBackup, string, error) {
+	var backup *backupv1.VirtualMachineBackup
+	switch restore.Spec.Source.Kind {
+	case backupv1.VirtualMachineBackupTrackerGroupVersionKind.Kind:
+		obj, exists, err := ctrl.backupTrackerStore.GetByKey(cacheKeyFunc(restore.Namespace, restore.Spec.Source.Name))
+		if err != nil {
+			return nil, "", err
+		}
+		if !exists {
+			return nil, fmt.Sprintf(backupTrackerNotFoundMsg, restore.Spec.Source.Name), nil
+		}
+		tracker := obj.(*backupv1.VirtualMachineBackupTracker)
+		if tracker.Status == nil || tracker.Status.LatestCheckpoint == nil {
+			return nil, fmt.Sprintf(restoreTrackerNoCheckpointMsg, tracker.Name), nil
+		}
+		backup, err = ctrl.backupByCheckpoint(restore.Namespace, tracker.Status.LatestCheckpoint.Name)
+		if err != nil {
+			return nil, "", err
+		}
+	default:
+		obj, exists, err := ctrl.backupInformer.GetStore().GetByKey(cacheKeyFunc(restore.Namespace, restore.Spec.Source.Name))
+		if err != nil {
+			return nil, "", err
+		}
+		if !exists {
+			return nil, fmt.Sprintf(restoreBackupNotFoundMsg, restore.Spec.Source.Name), nil
+		}
+		backup = obj.(*backupv1.VirtualMachineBackup)
+	}
+
+	var chain []*backupv1.VirtualMachineBackup
+	visited := map[string]bool{}
+	for {
+		if !IsBackupDone(backup.Status) {
+			return nil, fmt.Sprintf(restoreBackupNotDoneMsg, backup.Name), nil
+		}
+		if backup.Spec.Mode != nil && *backup.Spec.Mode != backupv1.PushMode {
+			return nil, "", newRestoreFailure(restoreNotPushBackupMsg, backup.Name)
+		}
+		if backup.Status.VirtualMachine == nil || len(backup.Status.Volumes) == 0 {
+			return nil, "", newRestoreFailure(restoreBackupNotCapturedMsg, backup.Name)
+		}
+		visited[backup.Name] = true
+		chain = append([]*backupv1.VirtualMachineBackup{backup}, chain...)
+
+		if backup.Status.Type == backupv1.Full {
+			return chain, "", nil
+		}
+		if backup.Status.Type != backupv1.Incremental || backup.Status.BaseCheckpointName == nil {
+			return nil, "", newRestoreFailure(restoreBrokenChainMsg, chain[len(chain)-1].Name)
+		}
+
+		base, err := ctrl.backupByCheckpoint(restore.Namespace, *backup.Status.BaseCheckpointName)
+		if err != nil {
+			return nil, "", err
+		}
+		if visited[base.Name] {
+			return nil, "", newRestoreFailure(restoreBrokenChainMsg, chain[len(chain)-1].Name)
+		}
+		backup = base
+	}
+}
+
+func (ctrl *VMBackupRestoreController) backupByCheckpoint(namespace, checkpointName string) (*backupv1.VirtualMachineBackup, error) {
+	objs, err := ctrl.backupInformer.GetIndexer().ByIndex(backupCheckpointIndex, cacheKeyFunc(namespace, checkpointName))
+	if err != nil {
+		return nil, err
+	}
+	if len(objs) == 0 {
+		return nil, newRestoreFailure(restoreCheckpointNotFoundMsg, checkpointName)
+	}
+	return objs[0].(*backupv1.VirtualMachineBackup), nil
+}
+
+func restorePVCName(restore *backupv1.VirtualMachineBackupRestore, volumeName string) string {
+	return fmt.Sprintf("restore-%s-%s", restore.UID, volumeName)
+}
+
+func restoreJobName(restore *backupv1.VirtualMachineBackupRestore) string {
+	return naming.GetName(restore.Name, "restore", validation.DNS1035LabelMaxLength)
+}
+
+func restoredVolumes(restore *backupv1.VirtualMachineBackupRestore, backup *backupv1.VirtualMachineBackup) []backupv1.VolumeRestore {
+	restores := make([]backupv1.VolumeRestore, 0, len(backup.Status.Volumes))
+	for _, volume := range backup.Status.Volumes {
+		restores = append(restores, backupv1.VolumeRestore{
+			VolumeName:                volume.VolumeName,
+			PersistentVolumeClaimName: restorePVCName(restore, volume.VolumeName),
+		})
+	}
+	return restores
+}
+
+// getTargetVM returns the target VM when it was created by the restore,
+// any other VM with the target name fails the restore
+func (ctrl *VMBackupRestoreController) getTargetVM(restore *backupv1.VirtualMachineBackupRestore) (*v1.VirtualMachine, error) {
+	obj, exists, err := ctrl.vmStore.GetByKey(cacheKeyFunc(restore.Namespace, restore.Spec.Target.Name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	vm := obj.(*v1.VirtualMachine)
+	if vm.Labels[backupv1.BackupRestoreLabel] != restore.Name {
+		return nil, newRestoreFailure(restoreTargetExistsMsg, restore.Spec.Target.Name)
+	}
+	return vm, nil
+}
+
+func (ctrl *VMBackupRestoreController) ensureRestorePVCs(restore *backupv1.VirtualMachineBackupRestore, backup *backupv1.VirtualMachineBackup, restores []backupv1.VolumeRestore) error {
+	for i, volume := range backup.Status.Volumes {
+		pvcName := restores[i].PersistentVolumeClaimName
+		_, exists, err := ctrl.pvcStore.GetByKey(cacheKeyFunc(restore.Namespace, pvcName))
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+		if volume.Capacity == nil {
+			return newRestoreFailure(restoreVolumeNoCapacityMsg, volume.VolumeName)
+		}
+
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      pvcName,
+				Namespace: restore.Namespace,
+				Labels: map[string]string{
+					backupv1.BackupRestoreLabel: restore.Name,
+				},
+				// the restore owns the PVCs until the restored VM uses them,
+				// so they are collected when the restore fails or is deleted
+				OwnerReferences: []metav1.OwnerReference{restoreOwnerReference(restore)},
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				AccessModes: volume.AccessModes,
+				VolumeMode:  volume.VolumeMode,
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceStorage: *volume.Capacity,
+					},
+				},
+				StorageClassName: volume.StorageClassName,
+			},
+		}
+		if len(pvc.Spec.AccessModes) == 0 {
+			pvc.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce}
+		}
+		if restore.Spec.StorageClassName != nil {
+			pvc.Spec.StorageClassName = restore.Spec.StorageClassName
+		}
+
+		_, err = ctrl.client.CoreV1().PersistentVolumeClaims(restore.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// releaseRestorePVCs drops the ownership of the restore from the restored PVCs,
+// so they outlive the restore once the restored VM uses them
+func (ctrl *VMBackupRestoreController) releaseRestorePVCs(restore *backupv1.VirtualMachineBackupRestore, restores []backupv1.VolumeRestore) error {
+	for _, volumeRestore := range restores {
+		obj, exists, err := ctrl.pvcStore.GetByKey(cacheKeyFunc(restore.Namespace, volumeRestore.PersistentVolumeClaimName))
+		if err != nil {
+			return err
+		}
+		if !exists {
+			continue
+		}
+		pvc := obj.(*corev1.PersistentVolumeClaim)
+
+		owners := []metav1.OwnerReference{}
+		for _, owner := range pvc.OwnerReferences {
+			if owner.UID != restore.UID {
+				owners = append(owners, owner)
+			}
+		}
+		if len(owners) == len(pvc.OwnerReferences) {
+			continue
+		}
+
+		patchBytes, err := patch.New(
+			patch.WithTest("/metadata/ownerReferences", pvc.OwnerReferences),
+			patch.WithReplace("/metadata/ownerReferences", owners),
+		).GeneratePayload()
+		if err != nil {
+			return err
+		}
+		_, err = ctrl.client.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(context.Background(), pvc.Name, k8stypes.JSONPatchType, patchBytes, metav1.PatchOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func restoreOwnerReference(restore *backupv1.VirtualMachineBackupRestore) metav1.OwnerReference {
+	return *metav1.NewControllerRef(restore, backupv1.VirtualMachineBackupRestoreGroupVersionKind)
+}
+
+// reconcileRestoreJob creates the restore job and reports whether it completed.
+// There is no job informer, so the job is polled.
+func (ctrl *VMBackupRestoreController) reconcileRestoreJob(restore *backupv1.VirtualMachineBackupRestore, chain []*backupv1.VirtualMachineBackup, restores []backupv1.VolumeRestore) (bool, error) {
+	jobName := restoreJobName(restore)
+	job, err := ctrl.client.BatchV1().Jobs(restore.Namespace).Get(context.Background(), jobName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		job, err = buildRestoreJob(jobName, ctrl.launcherImage, restore, chain, restores)
+		if err != nil {
+			return false, err
+		}
+		_, err = ctrl.client.BatchV1().Jobs(restore.Namespace).Create(context.Background(), job, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			return false, err
+		}
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			return true, nil
+		case batchv1.JobFailed:
+			return false, newRestoreFailure(restoreJobFailedMsg, job.Name, c.Message)
+		}
+	}
+	return false, nil
+}
+
+// qcow2Chain returns a qemu-img json: filename stacking the backups of the
+// volume on top of each other, the full backup at the bottom. The backup
+// images are opened without their own backing chain.
+func qcow2Chain(files []string) (string, error) {
+	var image map[string]interface{}
+	for _, file := range files {
+		image = map[string]interface{}{
+			"driver": "qcow2",
+			"file": map[string]interface{}{
+				"driver":   "file",
+				"filename": file,
+			},
+			"backing": image,
+		}
+	}
+
+	raw, err := json.Marshal(image)
+	if err != nil {
+		return "", err
+	}
+	return "json:" + string(raw), nil
+}
+
+func buildRestoreJob(jobName, launcherImage string, restore *backupv1.VirtualMachineBackupRestore, chain []*backupv1.VirtualMachineBackup, restores []backupv1.VolumeRestore) (*batchv1.Job, error) {
+	var volumes []corev1.Volume
+	var backupMounts []corev1.VolumeMount
+	mounted := map[string]bool{}
+	for _, backup := range chain {
+		pvcName := *backup.Spec.PvcName
+		if mounted[pvcName] {
+			continue
+		}
+		mounted[pvcName] = true
+		name := fmt.Sprintf("backup-%d", len(backupMounts))
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: pvcName,
+					ReadOnly:  true,
+				},
+			},
+		})
+		backupMounts = append(backupMounts, corev1.VolumeMount{
+			Name:      name,
+			MountPath: filepath.Join(restoreBackupsDir, pvcName),
+			ReadOnly:  true,
+		})
+	}
+
+	var containers []corev1.Container
+	latest := chain[len(chain)-1]
+	for i, volume := range latest.Status.Volumes {
+		var files []string
+		for _, backup := range chain {
+			backupVolume := findBackupVolume(backup.Status.Volumes, volume.VolumeName)
+			if backupVolume == nil || backupVolume.Path == "" {
+				return nil, newRestoreFailure(restoreVolumeMissingMsg, volume.VolumeName, backup.Name)
+			}
+			files = append(files, filepath.Join(restoreBackupsDir, *backup.Spec.PvcName, backupVolume.Path))
+		}
+		source, err := qcow2Chain(files)
+		if err != nil {
+			return nil, err
+		}
+
+		name := fmt.Sprintf("volume-%d", i)
+		target := filepath.Join(restoreVolumesDir, name)
+		volumes = append(volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: restores[i].PersistentVolumeClaimName,
+				},
+			},
+		})
+		container := corev1.Container{
+			Name: name,
+			SecurityContext: &corev1.SecurityContext{
+				AllowPrivilegeEscalation: pointer.P(false),
+				Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+			},
+			Image:        launcherImage,
+			Command:      []string{"qemu-img"},
+			VolumeMounts: backupMounts,
+		}
+		if types.IsPVCBlock(volume.VolumeMode) {
+			container.Args = []string{"convert", "-n", "-O", "raw", source, target}
+			container.VolumeDevices = []corev1.VolumeDevice{{Name: name, DevicePath: target}}
+		} else {
+			container.Args = []string{"convert", "-O", "raw", source, filepath.Join(target, restoreDiskImage)}
+			container.VolumeMounts = append(append([]corev1.VolumeMount{}, backupMounts...), corev1.VolumeMount{Name: name, MountPath: target})
+		}
+		containers = append(containers, container)
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: restore.Namespace,
+			Labels: map[string]string{
+				backupv1.BackupRestoreLabel: restore.Name,
+			},
+			OwnerReferences: []metav1.OwnerReference{restoreOwnerReference(restore)},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit: pointer.P(int32(0)),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					GenerateName: jobName + "-",
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot: pointer.P(true),
+						RunAsUser:    pointer.P(int64(util.NonRootUID)),
+						RunAsGroup:   pointer.P(int64(util.NonRootUID)),
+						FSGroup:      pointer.P(int64(util.NonRootUID)),
+						SeccompProfile: &corev1.SeccompProfile{
+							Type: corev1.SeccompProfileTypeRuntimeDefault,
+						},
+					},
+					Containers: containers,
+					Volumes:    volumes,
+				},
+			},
+		},
+	}, nil
+}
+
+func findBackupVolume(volumes []backupv1.BackupVolume, volumeName string) *backupv1.BackupVolume {
+	for i := range volumes {
+		if volumes[i].VolumeName == volumeName {
+			return &volumes[i]
+		}
+	}
+	return nil
+}
+
+// restoredVM builds the target VM from the captured manifest. The restored
+// volumes are switched to the restored PVCs and the VM is created halted.
+func restoredVM(restore *backupv1.VirtualMachineBackupRestore, captured *backupv1.VirtualMachine, restores []backupv1.VolumeRestore) *v1.VirtualMachine {
+	vm := &v1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        restore.Spec.Target.Name,
+			Namespace:   restore.Namespace,
+			Labels:      map[string]string{},
+			Annotations: captured.Annotations,
+		},
+		Spec: *captured.Spec.DeepCopy(),
+	}
+	for k, v := range captured.Labels {
+		vm.Labels[k] = v
+	}
+	vm.Labels[backupv1.BackupRestoreLabel] = restore.Name
+
+	if vm.Spec.Running != nil {
+		vm.Spec.Running = pointer.P(false)
+	} else {
+		vm.Spec.RunStrategy = pointer.P(v1.RunStrategyHalted)
+	}
+	// the revisions are not part of the backup, they are recreated from the
+	// instancetype and preference
+	if vm.Spec.Instancetype != nil {
+		vm.Spec.Instancetype.RevisionName = ""
+	}
+	if vm.Spec.Preference != nil {
+		vm.Spec.Preference.RevisionName = ""
+	}
+
+	restoredDataVolumes := map[string]bool{}
+	volumes := vm.Spec.Template.Spec.Volumes
+	for i := range volumes {
+		for _, r := range restores {
+			if r.VolumeName != volumes[i].Name {
+				continue
+			}
+			if volumes[i].DataVolume != nil {
+				restoredDataVolumes[volumes[i].DataVolume.Name] = true
+			}
+			volumes[i].VolumeSource = v1.VolumeSource{
+				PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: r.PersistentVolumeClaimName,
+					},
+				},
+			}
+		}
+	}
+
+	var templates []v1.DataVolumeTemplateSpec
+	for _, template := range vm.Spec.DataVolumeTemplates {
+		if !restoredDataVolumes[template.Name] {
+			templates = append(templates, template)
+		}
+	}
+	vm.Spec.DataVolumeTemplates = templates
+
+	return vm
+}
+
+func (ctrl *VMBackupRestoreController) updateRestoreStatus(restore *backupv1.VirtualMachineBackupRestore, status *backupv1.VirtualMachineBackupRestoreStatus) error {
+	if status == nil || equality.Semantic.DeepEqual(restore.Status, status) {
+		return nil
+	}
+
+	restoreCopy := restore.DeepCopy()
+	restoreCopy.Status = status
+	_, err := ctrl.client.VirtualMachineBackupRestore(restoreCopy.Namespace).UpdateStatus(context.Background(), restoreCopy, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,465 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const (
+	backupRestoreName = "test-restore"
+	restoredVMName    = "restored-vm"
+	restoreUID        = "restore-uid"
+)
+
+var _ = Describe("Backup Restore Controller", func() {
+	var (
+		ctrl                  *gomock.Controller
+		virtClient            *kubecli.MockKubevirtClient
+		restoreInformer       cache.SharedIndexInformer
+		backupInformer        cache.SharedIndexInformer
+		backupTrackerInformer cache.SharedIndexInformer
+		vmInformer            cache.SharedIndexInformer
+		pvcInformer           cache.SharedIndexInformer
+		controller            *VMBackupRestoreController
+		recorder              *record.FakeRecorder
+
+		kubevirtClient *kubevirtfake.Clientset
+		k8sClient      *fake.Clientset
+	)
+
+	restoreKey := cacheKeyFunc(testNamespace, backupRestoreName)
+	jobName := restoreJobName(&backupv1.VirtualMachineBackupRestore{ObjectMeta: metav1.ObjectMeta{Name: backupRestoreName}})
+
+	capturedVM := func() *backupv1.VirtualMachine {
+		return &backupv1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      vmName,
+				Namespace: testNamespace,
+				Labels:    map[string]string{"app": "test"},
+			},
+			Spec: v1.VirtualMachineSpec{
+				RunStrategy: pointer.P(v1.RunStrategyAlways),
+				DataVolumeTemplates: []v1.DataVolumeTemplateSpec{
+					{ObjectMeta: metav1.ObjectMeta{Name: "test-dv"}},
+				},
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: v1.VirtualMachineInstanceSpec{
+						Volumes: []v1.Volume{
+							{
+								Name: "rootdisk",
+								VolumeSource: v1.VolumeSource{
+									DataVolume: &v1.DataVolumeSource{Name: "test-dv"},
+								},
+							},
+							{
+								Name: "datadisk",
+								VolumeSource: v1.VolumeSource{
+									PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+										PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+											ClaimName: "test-pvc",
+										},
+									},
+								},
+							},
+							{
+								Name: "cloudinit",
+								VolumeSource: v1.VolumeSource{
+									CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "#cloud-config"},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	createBackup := func(name string, backupType backupv1.BackupType, baseCheckpoint string) *backupv1.VirtualMachineBackup {
+		backup := &backupv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(time.Date(2025, time.March, 12, 12, 0, 0, 0, time.UTC)),
+			},
+			Spec: backupv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(backupv1.SchemeGroupVersion.Group),
+					Kind:     backupv1.VirtualMachineBackupTrackerGroupVersionKind.Kind,
+					Name:     backupTrackerName,
+				},
+				PvcName: pointer.P(name + "-pvc"),
+				Mode:    pointer.P(backupv1.PushMode),
+			},
+			Status: &backupv1.VirtualMachineBackupStatus{
+				Type:           backupType,
+				CheckpointName: pointer.P(name + "-checkpoint"),
+				Conditions:     []backupv1.Condition{newDoneCondition(corev1.ConditionTrue, backupCompleted)},
+				VirtualMachine: capturedVM(),
+				Volumes: []backupv1.BackupVolume{
+					{
+						VolumeName:       "rootdisk",
+						Path:             "test-vm/" + name + "/" + name + "-rootdisk.qcow2",
+						Capacity:         pointer.P(resource.MustParse("10Gi")),
+						VolumeMode:       pointer.P(corev1.PersistentVolumeBlock),
+						AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+						StorageClassName: pointer.P("fast"),
+					},
+					{
+						VolumeName:       "datadisk",
+						Path:             "test-vm/" + name + "/" + name + "-datadisk.qcow2",
+						Capacity:         pointer.P(resource.MustParse("1Gi")),
+						VolumeMode:       pointer.P(corev1.PersistentVolumeFilesystem),
+						AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+						StorageClassName: pointer.P("fast"),
+					},
+				},
+			},
+		}
+		if baseCheckpoint != "" {
+			backup.Status.BaseCheckpointName = pointer.P(baseCheckpoint)
+		}
+		return backup
+	}
+
+	createRestore := func(kind, name string) *backupv1.VirtualMachineBackupRestore {
+		return &backupv1.VirtualMachineBackupRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      backupRestoreName,
+				Namespace: testNamespace,
+				UID:       restoreUID,
+			},
+			Spec: backupv1.VirtualMachineBackupRestoreSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(backupv1.SchemeGroupVersion.Group),
+					Kind:     kind,
+					Name:     name,
+				},
+				Target: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(v1.SchemeGroupVersion.Group),
+					Kind:     "VirtualMachine",
+					Name:     restoredVMName,
+				},
+			},
+		}
+	}
+
+	addRestore := func(restore *backupv1.VirtualMachineBackupRestore) {
+		Expect(restoreInformer.GetStore().Add(restore)).To(Succeed())
+		_, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackupRestores(testNamespace).Create(context.Background(), restore, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addBackups := func(backups ...*backupv1.VirtualMachineBackup) {
+		for _, backup := range backups {
+			Expect(backupInformer.GetStore().Add(backup)).To(Succeed())
+		}
+	}
+
+	getRestore := func() *backupv1.VirtualMachineBackupRestore {
+		restore, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackupRestores(testNamespace).Get(context.Background(), backupRestoreName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return restore
+	}
+
+	// syncRestoreStore mirrors the status written through the client into the informer
+	syncRestoreStore := func() {
+		Expect(restoreInformer.GetStore().Update(getRestore())).To(Succeed())
+	}
+
+	getJob := func() *batchv1.Job {
+		job, err := k8sClient.BatchV1().Jobs(testNamespace).Get(context.Background(), jobName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return job
+	}
+
+	setJobCondition := func(condType batchv1.JobConditionType, message string) {
+		job := getJob()
+		job.Status.Conditions = append(job.Status.Conditions, batchv1.JobCondition{
+			Type:    condType,
+			Status:  corev1.ConditionTrue,
+			Message: message,
+		})
+		_, err := k8sClient.BatchV1().Jobs(testNamespace).UpdateStatus(context.Background(), job, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	expectCondition := func(restore *backupv1.VirtualMachineBackupRestore, condType backupv1.ConditionType, status corev1.ConditionStatus) {
+		ExpectWithOffset(1, restore.Status).ToNot(BeNil())
+		for _, c := range restore.Status.Conditions {
+			if c.Type == condType {
+				ExpectWithOffset(1, c.Status).To(Equal(status))
+				return
+			}
+		}
+		Fail("condition " + string(condType) + " not found")
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+
+		restoreInformer, _ = testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupRestore{})
+		backupInformer, _ = testutils.NewFakeInformerWithIndexersFor(&backupv1.VirtualMachineBackup{}, virtcontroller.GetVirtualMachineBackupInformerIndexers())
+		backupTrackerInformer, _ = testutils.NewFakeInformerWithIndexersFor(&backupv1.VirtualMachineBackupTracker{}, virtcontroller.GetVirtualMachineBackupTrackerInformerIndexers())
+		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
+		pvcInformer, _ = testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
+
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		controller = &VMBackupRestoreController{
+			client:             virtClient,
+			restoreInformer:    restoreInformer,
+			backupInformer:     backupInformer,
+			backupTrackerStore: backupTrackerInformer.GetStore(),
+			vmStore:            vmInformer.GetStore(),
+			pvcStore:           pvcInformer.GetStore(),
+			launcherImage:      "virt-launcher",
+			recorder:           recorder,
+			restoreQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+				workqueue.DefaultTypedControllerRateLimiter[string](),
+				workqueue.TypedRateLimitingQueueConfig[string]{Name: "test-backup-restore-queue"},
+			),
+		}
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineBackupRestore(testNamespace).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackupRestores(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(testNamespace).
+			Return(kubevirtClient.KubevirtV1().VirtualMachines(testNamespace)).AnyTimes()
+
+		k8sClient = fake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().BatchV1().Return(k8sClient.BatchV1()).AnyTimes()
+	})
+
+	It("should create the PVCs and the restore job of a full backup", func() {
+		addBackups(createBackup("full", backupv1.Full, ""))
+		restore := createRestore("VirtualMachineBackup", "full")
+		restore.Spec.StorageClassName = pointer.P("restored")
+		addRestore(restore)
+
+		Expect(controller.execute(restoreKey)).To(Succeed())
+
+		restore = getRestore()
+		Expect(restore.Status.Backups).To(Equal([]string{"full"}))
+		Expect(restore.Status.Restores).To(Equal([]backupv1.VolumeRestore{
+			{VolumeName: "rootdisk", PersistentVolumeClaimName: "restore-restore-uid-rootdisk"},
+			{VolumeName: "datadisk", PersistentVolumeClaimName: "restore-restore-uid-datadisk"},
+		}))
+		expectCondition(restore, backupv1.ConditionProgressing, corev1.ConditionTrue)
+		expectCondition(restore, backupv1.ConditionDone, corev1.ConditionFalse)
+
+		pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Get(context.Background(), "restore-restore-uid-rootdisk", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pvc.Labels).To(HaveKeyWithValue(backupv1.BackupRestoreLabel, backupRestoreName))
+		Expect(pvc.OwnerReferences).To(HaveLen(1))
+		Expect(pvc.OwnerReferences[0].Kind).To(Equal("VirtualMachineBackupRestore"))
+		Expect(pvc.Spec.VolumeMode).To(HaveValue(Equal(corev1.PersistentVolumeBlock)))
+		Expect(pvc.Spec.AccessModes).To(ConsistOf(corev1.ReadWriteMany))
+		Expect(pvc.Spec.StorageClassName).To(HaveValue(Equal("restored")))
+		Expect(pvc.Spec.Resources.Requests).To(HaveKeyWithValue(corev1.ResourceStorage, resource.MustParse("10Gi")))
+
+		job := getJob()
+		Expect(job.OwnerReferences).To(HaveLen(1))
+		Expect(job.OwnerReferences[0].Kind).To(Equal("VirtualMachineBackupRestore"))
+		Expect(job.Spec.BackoffLimit).To(HaveValue(BeEquivalentTo(0)))
+		podSpec := job.Spec.Template.Spec
+		Expect(podSpec.SecurityContext.RunAsNonRoot).To(HaveValue(BeTrue()))
+		Expect(podSpec.Volumes).To(HaveLen(3))
+		Expect(podSpec.Volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("full-pvc"))
+		Expect(podSpec.Volumes[0].PersistentVolumeClaim.ReadOnly).To(BeTrue())
+		Expect(podSpec.Containers).To(HaveLen(2))
+
+		rootdisk := podSpec.Containers[0]
+		Expect(rootdisk.Image).To(Equal("virt-launcher"))
+		Expect(rootdisk.Command).To(Equal([]string{"qemu-img"}))
+		Expect(rootdisk.Args).To(Equal([]string{"convert", "-n", "-O", "raw",
+			`json:{"backing":null,"driver":"qcow2","file":{"driver":"file","filename":"/backups/full-pvc/test-vm/full/full-rootdisk.qcow2"}}`,
+			"/restore/volume-0",
+		}))
+		Expect(rootdisk.VolumeDevices).To(ConsistOf(corev1.VolumeDevice{Name: "volume-0", DevicePath: "/restore/volume-0"}))
+
+		datadisk := podSpec.Containers[1]
+		Expect(datadisk.Args).To(HaveExactElements("convert", "-O", "raw", ContainSubstring("full-datadisk.qcow2"), "/restore/volume-1/disk.img"))
+		Expect(datadisk.VolumeMounts).To(ContainElement(corev1.VolumeMount{Name: "volume-1", MountPath: "/restore/volume-1"}))
+	})
+
+	It("should apply the incremental backups of a tracker on top of the full backup", func() {
+		addBackups(
+			createBackup("full", backupv1.Full, ""),
+			createBackup("inc1", backupv1.Incremental, "full-checkpoint"),
+			createBackup("inc2", backupv1.Incremental, "inc1-checkpoint"),
+		)
+		Expect(backupTrackerInformer.GetStore().Add(&backupv1.VirtualMachineBackupTracker{
+			ObjectMeta: metav1.ObjectMeta{Name: backupTrackerName, Namespace: testNamespace},
+			Status: &backupv1.VirtualMachineBackupTrackerStatus{
+				LatestCheckpoint: &backupv1.BackupCheckpoint{Name: "inc2-checkpoint"},
+			},
+		})).To(Succeed())
+		addRestore(createRestore("VirtualMachineBackupTracker", backupTrackerName))
+
+		Expect(controller.execute(restoreKey)).To(Succeed())
+
+		Expect(getRestore().Status.Backups).To(Equal([]string{"full", "inc1", "inc2"}))
+		podSpec := getJob().Spec.Template.Spec
+		Expect(podSpec.Volumes).To(HaveLen(5))
+		Expect(podSpec.Containers[1].Args[3]).To(Equal(`json:{"backing":{"backing":{"backing":null,"driver":"qcow2","file":{"driver":"file","filename":"/backups/full-pvc/test-vm/full/full-datadisk.qcow2"}},` +
+			`"driver":"qcow2","file":{"driver":"file","filename":"/backups/inc1-pvc/test-vm/inc1/inc1-datadisk.qcow2"}},` +
+			`"driver":"qcow2","file":{"driver":"file","filename":"/backups/inc2-pvc/test-vm/inc2/inc2-datadisk.qcow2"}}`))
+	})
+
+	It("should create the halted VM once the restore job completed", func() {
+		addBackups(createBackup("full", backupv1.Full, ""))
+		addRestore(createRestore("VirtualMachineBackup", "full"))
+		Expect(controller.execute(restoreKey)).To(Succeed())
+		syncRestoreStore()
+
+		pvcs, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		for i := range pvcs.Items {
+			Expect(pvcInformer.GetStore().Add(&pvcs.Items[i])).To(Succeed())
+		}
+
+		setJobCondition(batchv1.JobComplete, "")
+		Expect(controller.execute(restoreKey)).To(Succeed())
+
+		restore := getRestore()
+		expectCondition(restore, backupv1.ConditionDone, corev1.ConditionTrue)
+		expectCondition(restore, backupv1.ConditionProgressing, corev1.ConditionFalse)
+		Expect(restore.Status.RestoreTime).ToNot(BeNil())
+		testutils.ExpectEvent(recorder, backupRestoreCompletedEvent)
+
+		vm, err := kubevirtClient.KubevirtV1().VirtualMachines(testNamespace).Get(context.Background(), restoredVMName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.Labels).To(HaveKeyWithValue("app", "test"))
+		Expect(vm.Labels).To(HaveKeyWithValue(backupv1.BackupRestoreLabel, backupRestoreName))
+		Expect(vm.Spec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyHalted)))
+		Expect(vm.Spec.DataVolumeTemplates).To(BeEmpty())
+		volumes := vm.Spec.Template.Spec.Volumes
+		Expect(volumes).To(HaveLen(3))
+		Expect(volumes[0].PersistentVolumeClaim.ClaimName).To(Equal("restore-restore-uid-rootdisk"))
+		Expect(volumes[0].DataVolume).To(BeNil())
+		Expect(volumes[1].PersistentVolumeClaim.ClaimName).To(Equal("restore-restore-uid-datadisk"))
+		Expect(volumes[2].CloudInitNoCloud).ToNot(BeNil())
+
+		_, err = k8sClient.BatchV1().Jobs(testNamespace).Get(context.Background(), jobName, metav1.GetOptions{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+
+		pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Get(context.Background(), "restore-restore-uid-rootdisk", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pvc.OwnerReferences).To(BeEmpty())
+	})
+
+	It("should fail when the restore job failed", func() {
+		addBackups(createBackup("full", backupv1.Full, ""))
+		addRestore(createRestore("VirtualMachineBackup", "full"))
+		Expect(controller.execute(restoreKey)).To(Succeed())
+		syncRestoreStore()
+
+		setJobCondition(batchv1.JobFailed, "BackoffLimitExceeded")
+		Expect(controller.execute(restoreKey)).To(Succeed())
+
+		expectCondition(getRestore(), backupv1.ConditionFailure, corev1.ConditionTrue)
+		testutils.ExpectEvent(recorder, backupRestoreFailedEvent)
+		_, err := kubevirtClient.KubevirtV1().VirtualMachines(testNamespace).Get(context.Background(), restoredVMName, metav1.GetOptions{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should wait for the backup to complete", func() {
+		backup := createBackup("full", backupv1.Full, "")
+		backup.Status.Conditions = nil
+		addBackups(backup)
+		addRestore(createRestore("VirtualMachineBackup", "full"))
+
+		Expect(controller.execute(restoreKey)).To(Succeed())
+
+		restore := getRestore()
+		expectCondition(restore, backupv1.ConditionProgressing, corev1.ConditionFalse)
+		Expect(restore.Status.Conditions[0].Reason).To(Equal("waiting for backup full to complete"))
+		pvcs, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pvcs.Items).To(BeEmpty())
+	})
+
+	DescribeTable("should fail", func(modify func(*backupv1.VirtualMachineBackup), reason string) {
+		backup := createBackup("inc", backupv1.Incremental, "missing-checkpoint")
+		modify(backup)
+		addBackups(backup)
+		addRestore(createRestore("VirtualMachineBackup", "inc"))
+
+		Expect(controller.execute(restoreKey)).To(Succeed())
+
+		restore := getRestore()
+		expectCondition(restore, backupv1.ConditionFailure, corev1.ConditionTrue)
+		Expect(restore.Status.Conditions).To(ContainElement(HaveField("Reason", reason)))
+		testutils.ExpectEvent(recorder, backupRestoreFailedEvent)
+	},
+		Entry("when a backup of the chain is missing", func(*backupv1.VirtualMachineBackup) {}, "no backup holds checkpoint missing-checkpoint"),
+		Entry("with a pull mode backup", func(backup *backupv1.VirtualMachineBackup) {
+			backup.Spec.Mode = pointer.P(backupv1.PullMode)
+		}, "backup inc is not a push mode backup"),
+		Entry("when the backup did not capture the VM", func(backup *backupv1.VirtualMachineBackup) {
+			backup.Status.VirtualMachine = nil
+		}, "backup inc did not capture the VM"),
+	)
+
+	It("should fail when the target VM exists", func() {
+		addBackups(createBackup("full", backupv1.Full, ""))
+		addRestore(createRestore("VirtualMachineBackup", "full"))
+		Expect(vmInformer.GetStore().Add(&v1.VirtualMachine{
+			ObjectMeta: metav1.ObjectMeta{Name: restoredVMName, Namespace: testNamespace, UID: k8stypes.UID("vm-uid")},
+		})).To(Succeed())
+
+		Expect(controller.execute(restoreKey)).To(Succeed())
+
+		restore := getRestore()
+		expectCondition(restore, backupv1.ConditionFailure, corev1.ConditionTrue)
+		Expect(restore.Status.Conditions).To(ContainElement(HaveField("Reason", "target VM restored-vm already exists")))
+		_, err := k8sClient.BatchV1().Jobs(testNamespace).Get(context.Background(), jobName, metav1.GetOptions{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,600 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/cronschedule"
+)
+
+const (
+	backupScheduledEvent        = "VirtualMachineBackupScheduled"
+	backupScheduleSkippedEvent  = "VirtualMachineBackupScheduleSkipped"
+	backupScheduleFailedEvent   = "VirtualMachineBackupScheduleFailed"
+	backupPrunedEvent           = "VirtualMachineBackupPruned"
+	backupTrackerResetEvent     = "VirtualMachineBackupTrackerReset"
+	backupScheduleIndex         = "backupSchedule"
+	backupScheduleTrackerIndex  = "backupTracker"
+	invalidBackupScheduleMsg    = "invalid schedule %q: %v"
+	backupScheduledMsg          = "created %s backup %s"
+	backupScheduleSkippedMsg    = "skipped the backup scheduled at %s, backup %s is still in progress"
+	backupPrunedMsg             = "deleted backup %s past its retention"
+	backupTrackerResetMsg       = "reset BackupTracker %s, the backup holding its latest checkpoint %s was deleted"
+	failedBackupPVCCreateMsg    = "failed to create PVC for backup %s: %v"
+	failedScheduledBackupMsg    = "failed to create backup: %v"
+	failedBackupPruneMsg        = "failed to delete backup %s: %v"
+	failedBackupTrackerResetMsg = "failed to reset BackupTracker %s: %v"
+)
+
+var currentTime = func() *metav1.Time {
+	t := metav1.Now()
+	return &t
+}
+
+type VMBackupScheduleController struct {
+	client             kubecli.KubevirtClient
+	scheduleInformer   cache.SharedIndexInformer
+	backupInformer     cache.SharedIndexInformer
+	backupTrackerStore cache.Store
+	pvcStore           cache.Store
+	recorder           record.EventRecorder
+	scheduleQueue      workqueue.TypedRateLimitingInterface[string]
+	hasSynced          func() bool
+}
+
+func NewVMBackupScheduleController(client kubecli.KubevirtClient,
+	scheduleInformer cache.SharedIndexInformer,
+	backupInformer cache.SharedIndexInformer,
+	backupTrackerInformer cache.SharedIndexInformer,
+	pvcInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+) (*VMBackupScheduleController, error) {
+	c := &VMBackupScheduleController{
+		scheduleQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vmbackupschedule"},
+		),
+		scheduleInformer:   scheduleInformer,
+		backupInformer:     backupInformer,
+		backupTrackerStore: backupTrackerInformer.GetStore(),
+		pvcStore:           pvcInformer.GetStore(),
+		recorder:           recorder,
+		client:             client,
+	}
+
+	c.hasSynced = func() bool {
+		return scheduleInformer.HasSynced() && backupInformer.HasSynced() && backupTrackerInformer.HasSynced() && pvcInformer.HasSynced()
+	}
+
+	_, err := scheduleInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleSchedule,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleSchedule(newObj) },
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = backupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleScheduledBackup,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleScheduledBackup(newObj) },
+			DeleteFunc: c.handleScheduledBackup,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = backupTrackerInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.handleScheduleTracker,
+			UpdateFunc: func(oldObj, newObj interface{}) { c.handleScheduleTracker(newObj) },
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (ctrl *VMBackupScheduleController) handleSchedule(obj interface{}) {
+	if schedule, ok := obj.(*backupv1.VirtualMachineBackupSchedule); ok {
+		objName, err := cache.MetaNamespaceKeyFunc(schedule)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, schedule)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.scheduleQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMBackupScheduleController) handleScheduledBackup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	backup, ok := obj.(*backupv1.VirtualMachineBackup)
+	if !ok {
+		return
+	}
+	if scheduleName, ok := backup.Labels[backupv1.BackupScheduleLabel]; ok {
+		ctrl.scheduleQueue.Add(cacheKeyFunc(backup.Namespace, scheduleName))
+	}
+}
+
+func (ctrl *VMBackupScheduleController) handleScheduleTracker(obj interface{}) {
+	tracker, ok := obj.(*backupv1.VirtualMachineBackupTracker)
+	if !ok {
+		return
+	}
+
+	keys, err := ctrl.scheduleInformer.GetIndexer().IndexKeys(backupScheduleTrackerIndex, cacheKeyFunc(tracker.Namespace, tracker.Name))
+	if err != nil {
+		return
+	}
+	for _, key := range keys {
+		ctrl.scheduleQueue.Add(key)
+	}
+}
+
+func (ctrl *VMBackupScheduleController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.scheduleQueue.ShutDown()
+
+	log.Log.Info("Starting backup schedule controller.")
+	defer log.Log.Info("Shutting down backup schedule controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.hasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for range threadiness {
+		go wait.Until(ctrl.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMBackupScheduleController) runWorker() {
+	for ctrl.Execute() {
+	}
+}
+
+func (ctrl *VMBackupScheduleController) Execute() bool {
+	key, quit := ctrl.scheduleQueue.Get()
+	if quit {
+		return false
+	}
+	defer ctrl.scheduleQueue.Done(key)
+
+	err := ctrl.execute(key)
+	if err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachineBackupSchedule %v", key)
+		ctrl.scheduleQueue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachineBackupSchedule %v", key)
+		ctrl.scheduleQueue.Forget(key)
+	}
+	return true
+}
+
+func (ctrl *VMBackupScheduleController) execute(key string) error {
+	logger := log.Log.With("VirtualMachineBackupSchedule", key)
+	storeObj, exists, err := ctrl.scheduleInformer.GetStore().GetByKey(key)
+	if err != nil {
+		logger.Errorf("Error getting backup schedule from store: %v", err)
+		return err
+	}
+	if !exists {
+		return nil
+	}
+
+	schedule, ok := storeObj.(*backupv1.VirtualMachineBackupSchedule)
+	if !ok {
+		logger.Errorf("Unexpected resource type: %T", storeObj)
+		return fmt.Errorf("unexpected resource %+v", storeObj)
+	}
+	if schedule.DeletionTimestamp != nil {
+		return nil
+	}
+
+	status, syncErr := ctrl.sync(schedule)
+	if err := ctrl.updateScheduleStatus(schedule, status); err != nil {
+		logger.Reason(err).Errorf("Updating the VirtualMachineBackupSchedule status failed")
+		return err
+	}
+	if syncErr != nil {
+		return syncErr
+	}
+
+	if status.NextScheduleTime != nil {
+		ctrl.scheduleQueue.AddAfter(key, status.NextScheduleTime.Sub(currentTime().Time))
+	}
+	return nil
+}
+
+// sync takes the backup of the most recent missed schedule slot and prunes the
+// backups past their retention. Slots passing while a backup of the schedule is
+// still in progress are skipped.
+func (ctrl *VMBackupScheduleController) sync(schedule *backupv1.VirtualMachineBackupSchedule) (*backupv1.VirtualMachineBackupScheduleStatus, error) {
+	status := &backupv1.VirtualMachineBackupScheduleStatus{}
+	if schedule.Status != nil {
+		status = schedule.Status.DeepCopy()
+	}
+
+	cronSchedule, err := cronschedule.Parse(schedule.Spec.Schedule)
+	if err != nil {
+		reason := fmt.Sprintf(invalidBackupScheduleMsg, schedule.Spec.Schedule, err)
+		ctrl.recorder.Event(schedule, corev1.EventTypeWarning, backupScheduleFailedEvent, reason)
+		status.NextScheduleTime = nil
+		status.Conditions = updateCondition(status.Conditions, newCondition(backupv1.ConditionFailure, corev1.ConditionTrue, reason))
+		return status, nil
+	}
+
+	backups, err := ctrl.scheduledBackups(schedule)
+	if err != nil {
+		return status, err
+	}
+	tracker, err := ctrl.getScheduleTracker(schedule)
+	if err != nil {
+		return status, err
+	}
+
+	now := currentTime().Time
+	var failure string
+	switch {
+	case tracker == nil:
+		failure = fmt.Sprintf(backupTrackerNotFoundMsg, schedule.Spec.TrackerName)
+	case schedule.Spec.Suspend:
+	default:
+		lastSchedule := schedule.CreationTimestamp.Time
+		if status.LastScheduleTime != nil {
+			lastSchedule = status.LastScheduleTime.Time
+		}
+		if slot, due := cronschedule.MostRecentSlot(cronSchedule, lastSchedule, now); due {
+			backup, err := ctrl.scheduleBackup(schedule, backups, slot)
+			if err != nil {
+				reason := fmt.Sprintf(failedScheduledBackupMsg, err)
+				ctrl.recorder.Event(schedule, corev1.EventTypeWarning, backupScheduleFailedEvent, reason)
+				status.Conditions = updateCondition(status.Conditions, newCondition(backupv1.ConditionFailure, corev1.ConditionTrue, reason))
+				return status, err
+			}
+			status.LastScheduleTime = pointer.P(metav1.NewTime(slot))
+			if backup != nil {
+				status.LastBackupName = pointer.P(backup.Name)
+				backups = append(backups, backup)
+			}
+		}
+	}
+
+	if err := ctrl.ensureScheduledBackupPVCs(schedule, backups); err != nil {
+		return status, err
+	}
+	if err := ctrl.pruneBackups(schedule, backups, tracker); err != nil {
+		return status, err
+	}
+
+	if schedule.Spec.Suspend || tracker == nil {
+		status.NextScheduleTime = nil
+	} else {
+		status.NextScheduleTime = pointer.P(metav1.NewTime(cronSchedule.Next(now)))
+	}
+	if failure != "" {
+		status.Conditions = updateCondition(status.Conditions, newCondition(backupv1.ConditionFailure, corev1.ConditionTrue, failure))
+	} else {
+		status.Conditions = updateCondition(status.Conditions, newCondition(backupv1.ConditionFailure, corev1.ConditionFalse, ""))
+	}
+	return status, nil
+}
+
+func (ctrl *VMBackupScheduleController) getScheduleTracker(schedule *backupv1.VirtualMachineBackupSchedule) (*backupv1.VirtualMachineBackupTracker, error) {
+	obj, exists, err := ctrl.backupTrackerStore.GetByKey(cacheKeyFunc(schedule.Namespace, schedule.Spec.TrackerName))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*backupv1.VirtualMachineBackupTracker), nil
+}
+
+// scheduledBackups returns the backups created by the schedule, oldest first
+func (ctrl *VMBackupScheduleController) scheduledBackups(schedule *backupv1.VirtualMachineBackupSchedule) ([]*backupv1.VirtualMachineBackup, error) {
+	objs, err := ctrl.backupInformer.GetIndexer().ByIndex(backupScheduleIndex, cacheKeyFunc(schedule.Namespace, schedule.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	backups := make([]*backupv1.VirtualMachineBackup, 0, len(objs))
+	for _, obj := range objs {
+		backups = append(backups, obj.(*backupv1.VirtualMachineBackup))
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		ti, tj := backups[i].CreationTimestamp, backups[j].CreationTimestamp
+		if ti.Equal(&tj) {
+			return backups[i].Name < backups[j].Name
+		}
+		return ti.Before(&tj)
+	})
+	return backups, nil
+}
+
+func scheduledBackupName(scheduleName string, slot time.Time) string {
+	// slots are at most one per minute, like the jobs of a CronJob
+	return naming.GetName(scheduleName, strconv.FormatInt(slot.Unix()/60, 10), validation.DNS1035LabelMaxLength)
+}
+
+// needsFullBackup reports whether FullBackupInterval incremental backups
+// were taken since the last full backup of the schedule
+func needsFullBackup(schedule *backupv1.VirtualMachineBackupSchedule, backups []*backupv1.VirtualMachineBackup) bool {
+	if schedule.Spec.FullBackupInterval == nil {
+		return false
+	}
+
+	incrementals := int32(0)
+	for i := len(backups) - 1; i >= 0; i-- {
+		if backups[i].Status == nil {
+			continue
+		}
+		if backups[i].Status.Type == backupv1.Full {
+			break
+		}
+		if backups[i].Status.Type == backupv1.Incremental {
+			incrementals++
+		}
+	}
+	return incrementals >= *schedule.Spec.FullBackupInterval
+}
+
+func (ctrl *VMBackupScheduleController) scheduleBackup(schedule *backupv1.VirtualMachineBackupSchedule, backups []*backupv1.VirtualMachineBackup, slot time.Time) (*backupv1.VirtualMachineBackup, error) {
+	for _, backup := range backups {
+		if !IsBackupDone(backup.Status) {
+			ctrl.recorder.Eventf(schedule, corev1.EventTypeNormal, backupScheduleSkippedEvent, backupScheduleSkippedMsg, slot.UTC().Format(time.RFC3339), backup.Name)
+			return nil, nil
+		}
+	}
+
+	forceFull := needsFullBackup(schedule, backups)
+	backup := &backupv1.VirtualMachineBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      scheduledBackupName(schedule.Name, slot),
+			Namespace: schedule.Namespace,
+			Labels: map[string]string{
+				backupv1.BackupScheduleLabel: schedule.Name,
+			},
+		},
+		Spec: backupv1.VirtualMachineBackupSpec{
+			Source: corev1.TypedLocalObjectReference{
+				APIGroup: pointer.P(backupv1.SchemeGroupVersion.Group),
+				Kind:     backupv1.VirtualMachineBackupTrackerGroupVersionKind.Kind,
+				Name:     schedule.Spec.TrackerName,
+			},
+			Mode:            pointer.P(backupv1.PushMode),
+			SkipQuiesce:     schedule.Spec.SkipQuiesce,
+			ForceFullBackup: forceFull,
+		},
+	}
+	backup.Spec.PvcName = pointer.P(backup.Name)
+
+	created, err := ctrl.client.VirtualMachineBackup(schedule.Namespace).Create(context.Background(), backup, metav1.CreateOptions{})
+	if errors.IsAlreadyExists(err) {
+		// created by an earlier sync which failed to record it
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	backupType := backupv1.Incremental
+	if forceFull {
+		backupType = backupv1.Full
+	}
+	ctrl.recorder.Eventf(schedule, corev1.EventTypeNormal, backupScheduledEvent, backupScheduledMsg, backupType, created.Name)
+	return created, nil
+}
+
+// ensureScheduledBackupPVCs creates the push target PVCs of backups which have
+// not completed yet. The PVCs are owned by their backup, so they are garbage
+// collected together.
+func (ctrl *VMBackupScheduleController) ensureScheduledBackupPVCs(schedule *backupv1.VirtualMachineBackupSchedule, backups []*backupv1.VirtualMachineBackup) error {
+	for _, backup := range backups {
+		if IsBackupDone(backup.Status) || isBackupDeleting(backup) || backup.Spec.PvcName == nil {
+			continue
+		}
+		_, exists, err := ctrl.pvcStore.GetByKey(cacheKeyFunc(backup.Namespace, *backup.Spec.PvcName))
+		if err != nil {
+			return err
+		}
+		if exists {
+			continue
+		}
+
+		pvc := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      *backup.Spec.PvcName,
+				Namespace: backup.Namespace,
+				Labels: map[string]string{
+					backupv1.BackupScheduleLabel: schedule.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{backupOwnerReference(backup)},
+			},
+			Spec: *schedule.Spec.PvcSpec.DeepCopy(),
+		}
+		_, err = ctrl.client.CoreV1().PersistentVolumeClaims(backup.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+		if err != nil && !errors.IsAlreadyExists(err) {
+			ctrl.recorder.Eventf(schedule, corev1.EventTypeWarning, backupScheduleFailedEvent, failedBackupPVCCreateMsg, backup.Name, err)
+			return err
+		}
+	}
+	return nil
+}
+
+// backupsToPrune returns the completed backups the retention does not keep.
+// Expired backups are never kept. backups is ordered oldest first.
+func backupsToPrune(backups []*backupv1.VirtualMachineBackup, retention *backupv1.BackupRetention) []*backupv1.VirtualMachineBackup {
+	if retention == nil {
+		return nil
+	}
+
+	var done []*backupv1.VirtualMachineBackup
+	for i := len(backups) - 1; i >= 0; i-- {
+		if IsBackupDone(backups[i].Status) && !isBackupExpired(backups[i].Status) {
+			done = append(done, backups[i])
+		}
+	}
+
+	keep := map[string]bool{}
+	if retention.KeepLast != nil {
+		for i := 0; i < len(done) && i < int(*retention.KeepLast); i++ {
+			keep[done[i].Name] = true
+		}
+	}
+	keepPerPeriod(done, retention.KeepDaily, keep, func(t time.Time) string {
+		return t.UTC().Format(time.DateOnly)
+	})
+	keepPerPeriod(done, retention.KeepWeekly, keep, func(t time.Time) string {
+		year, week := t.UTC().ISOWeek()
+		return fmt.Sprintf("%d-%d", year, week)
+	})
+
+	// An incremental backup can only be restored together with the backups
+	// it is based on, back to the last full backup. Backups still in progress
+	// may be incremental and are kept as well.
+	var prune []*backupv1.VirtualMachineBackup
+	neededAsBase := false
+	for i := len(backups) - 1; i >= 0; i-- {
+		backup := backups[i]
+		if !keep[backup.Name] && !neededAsBase && IsBackupDone(backup.Status) {
+			prune = append(prune, backup)
+			continue
+		}
+		neededAsBase = backup.Status == nil || backup.Status.Type != backupv1.Full
+	}
+	return prune
+}
+
+// keepPerPeriod keeps the most recent backup of each of the count most recent
+// periods which have a backup. done is ordered newest first.
+func keepPerPeriod(done []*backupv1.VirtualMachineBackup, count *int32, keep map[string]bool, period func(time.Time) string) {
+	if count == nil {
+		return
+	}
+
+	periods := map[string]bool{}
+	for _, backup := range done {
+		p := period(backup.CreationTimestamp.Time)
+		if periods[p] {
+			continue
+		}
+		if len(periods) == int(*count) {
+			return
+		}
+		periods[p] = true
+		keep[backup.Name] = true
+	}
+}
+
+func (ctrl *VMBackupScheduleController) pruneBackups(schedule *backupv1.VirtualMachineBackupSchedule, backups []*backupv1.VirtualMachineBackup, tracker *backupv1.VirtualMachineBackupTracker) error {
+	for _, backup := range backupsToPrune(backups, schedule.Spec.Retention) {
+		if isBackupDeleting(backup) {
+			continue
+		}
+		// the next backup would be incremental to data which no longer exists
+		if err := ctrl.resetBackupTracker(schedule, tracker, backup); err != nil {
+			return err
+		}
+
+		err := ctrl.client.VirtualMachineBackup(backup.Namespace).Delete(context.Background(), backup.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			ctrl.recorder.Eventf(schedule, corev1.EventTypeWarning, backupScheduleFailedEvent, failedBackupPruneMsg, backup.Name, err)
+			return err
+		}
+		log.Log.Object(schedule).Infof(backupPrunedMsg, backup.Name)
+		ctrl.recorder.Eventf(schedule, corev1.EventTypeNormal, backupPrunedEvent, backupPrunedMsg, backup.Name)
+	}
+	return nil
+}
+
+// resetBackupTracker drops the latest checkpoint of the tracker when it belongs
+// to the deleted backup, so that the next backup is a full backup
+func (ctrl *VMBackupScheduleController) resetBackupTracker(schedule *backupv1.VirtualMachineBackupSchedule, tracker *backupv1.VirtualMachineBackupTracker, backup *backupv1.VirtualMachineBackup) error {
+	if tracker == nil || tracker.Status == nil || tracker.Status.LatestCheckpoint == nil ||
+		backup.Status == nil || backup.Status.CheckpointName == nil ||
+		tracker.Status.LatestCheckpoint.Name != *backup.Status.CheckpointName {
+		return nil
+	}
+
+	patchBytes, err := patch.New(
+		patch.WithTest("/status/latestCheckpoint/name", tracker.Status.LatestCheckpoint.Name),
+		patch.WithRemove("/status/latestCheckpoint"),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = ctrl.client.VirtualMachineBackupTracker(tracker.Namespace).Patch(context.Background(), tracker.Name, k8stypes.JSONPatchType, patchBytes, metav1.PatchOptions{}, "status")
+	if err != nil {
+		ctrl.recorder.Eventf(schedule, corev1.EventTypeWarning, backupScheduleFailedEvent, failedBackupTrackerResetMsg, tracker.Name, err)
+		return err
+	}
+	ctrl.recorder.Eventf(schedule, corev1.EventTypeNormal, backupTrackerResetEvent, backupTrackerResetMsg, tracker.Name, *backup.Status.CheckpointName)
+	return nil
+}
+
+func (ctrl *VMBackupScheduleController) updateScheduleStatus(schedule *backupv1.VirtualMachineBackupSchedule, status *backupv1.VirtualMachineBackupScheduleStatus) error {
+	if status == nil || equality.Semantic.DeepEqual(schedule.Status, status) {
+		return nil
+	}
+
+	scheduleCopy := schedule.DeepCopy()
+	scheduleCopy.Status = status
+	_, err := ctrl.client.VirtualMachineBackupSchedule(scheduleCopy.Namespace).UpdateStatus(context.Background(), scheduleCopy, metav1.UpdateOptions{})
+	return err
+}
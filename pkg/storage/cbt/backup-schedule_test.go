@@ -0,0 +1,439 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+const backupScheduleName = "test-schedule"
+
+var _ = Describe("Backup Schedule Controller", func() {
+	var (
+		ctrl                  *gomock.Controller
+		virtClient            *kubecli.MockKubevirtClient
+		scheduleInformer      cache.SharedIndexInformer
+		backupInformer        cache.SharedIndexInformer
+		backupTrackerInformer cache.SharedIndexInformer
+		pvcInformer           cache.SharedIndexInformer
+		controller            *VMBackupScheduleController
+		recorder              *record.FakeRecorder
+
+		kubevirtClient *kubevirtfake.Clientset
+		k8sClient      *fake.Clientset
+
+		now             time.Time
+		origCurrentTime func() *metav1.Time
+	)
+
+	scheduleKey := cacheKeyFunc(testNamespace, backupScheduleName)
+
+	createSchedule := func() *backupv1.VirtualMachineBackupSchedule {
+		return &backupv1.VirtualMachineBackupSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              backupScheduleName,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+			},
+			Spec: backupv1.VirtualMachineBackupScheduleSpec{
+				Schedule:    "0 * * * *",
+				TrackerName: backupTrackerName,
+				PvcSpec: corev1.PersistentVolumeClaimSpec{
+					AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+				},
+			},
+		}
+	}
+
+	createScheduledBackup := func(name string, created time.Time, backupType backupv1.BackupType, done bool) *backupv1.VirtualMachineBackup {
+		backup := &backupv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(created),
+				Labels: map[string]string{
+					backupv1.BackupScheduleLabel: backupScheduleName,
+				},
+			},
+			Spec: backupv1.VirtualMachineBackupSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(backupv1.SchemeGroupVersion.Group),
+					Kind:     backupv1.VirtualMachineBackupTrackerGroupVersionKind.Kind,
+					Name:     backupTrackerName,
+				},
+				PvcName: pointer.P(name),
+				Mode:    pointer.P(backupv1.PushMode),
+			},
+			Status: &backupv1.VirtualMachineBackupStatus{
+				Type:           backupType,
+				CheckpointName: pointer.P(name + "-checkpoint"),
+			},
+		}
+		if done {
+			backup.Status.Conditions = []backupv1.Condition{newDoneCondition(corev1.ConditionTrue, backupCompleted)}
+		}
+		return backup
+	}
+
+	createTracker := func(checkpointName string) *backupv1.VirtualMachineBackupTracker {
+		tracker := &backupv1.VirtualMachineBackupTracker{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      backupTrackerName,
+				Namespace: testNamespace,
+			},
+			Spec: backupv1.VirtualMachineBackupTrackerSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P("kubevirt.io"),
+					Kind:     "VirtualMachine",
+					Name:     vmName,
+				},
+			},
+			Status: &backupv1.VirtualMachineBackupTrackerStatus{},
+		}
+		if checkpointName != "" {
+			tracker.Status.LatestCheckpoint = &backupv1.BackupCheckpoint{Name: checkpointName}
+		}
+		return tracker
+	}
+
+	addSchedule := func(schedule *backupv1.VirtualMachineBackupSchedule) {
+		Expect(scheduleInformer.GetStore().Add(schedule)).To(Succeed())
+		_, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackupSchedules(testNamespace).Create(context.Background(), schedule, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addBackups := func(backups ...*backupv1.VirtualMachineBackup) {
+		for _, backup := range backups {
+			Expect(backupInformer.GetStore().Add(backup)).To(Succeed())
+			_, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Create(context.Background(), backup, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	}
+
+	addTracker := func(tracker *backupv1.VirtualMachineBackupTracker) {
+		Expect(backupTrackerInformer.GetStore().Add(tracker)).To(Succeed())
+		_, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackupTrackers(testNamespace).Create(context.Background(), tracker, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getSchedule := func() *backupv1.VirtualMachineBackupSchedule {
+		schedule, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackupSchedules(testNamespace).Get(context.Background(), backupScheduleName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return schedule
+	}
+
+	listBackups := func() []backupv1.VirtualMachineBackup {
+		list, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return list.Items
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2025, time.March, 12, 12, 15, 0, 0, time.UTC)
+		origCurrentTime = currentTime
+		currentTime = func() *metav1.Time {
+			return pointer.P(metav1.NewTime(now))
+		}
+
+		ctrl = gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+
+		scheduleInformer, _ = testutils.NewFakeInformerWithIndexersFor(&backupv1.VirtualMachineBackupSchedule{}, virtcontroller.GetVirtualMachineBackupScheduleInformerIndexers())
+		backupInformer, _ = testutils.NewFakeInformerWithIndexersFor(&backupv1.VirtualMachineBackup{}, virtcontroller.GetVirtualMachineBackupInformerIndexers())
+		backupTrackerInformer, _ = testutils.NewFakeInformerWithIndexersFor(&backupv1.VirtualMachineBackupTracker{}, virtcontroller.GetVirtualMachineBackupTrackerInformerIndexers())
+		pvcInformer, _ = testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
+
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		controller = &VMBackupScheduleController{
+			client:             virtClient,
+			scheduleInformer:   scheduleInformer,
+			backupInformer:     backupInformer,
+			backupTrackerStore: backupTrackerInformer.GetStore(),
+			pvcStore:           pvcInformer.GetStore(),
+			recorder:           recorder,
+			scheduleQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
+				workqueue.DefaultTypedControllerRateLimiter[string](),
+				workqueue.TypedRateLimitingQueueConfig[string]{Name: "test-backup-schedule-queue"},
+			),
+		}
+
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineBackup(testNamespace).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineBackupTracker(testNamespace).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackupTrackers(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineBackupSchedule(testNamespace).
+			Return(kubevirtClient.BackupV1alpha1().VirtualMachineBackupSchedules(testNamespace)).AnyTimes()
+
+		k8sClient = fake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+	})
+
+	AfterEach(func() {
+		currentTime = origCurrentTime
+	})
+
+	Context("scheduling", func() {
+		BeforeEach(func() {
+			addTracker(createTracker(""))
+		})
+
+		It("should create a backup for the most recent missed slot", func() {
+			addSchedule(createSchedule())
+
+			Expect(controller.execute(scheduleKey)).To(Succeed())
+
+			slot := time.Date(2025, time.March, 12, 12, 0, 0, 0, time.UTC)
+			backups := listBackups()
+			Expect(backups).To(HaveLen(1))
+			backup := backups[0]
+			Expect(backup.Name).To(Equal(scheduledBackupName(backupScheduleName, slot)))
+			Expect(backup.Labels).To(HaveKeyWithValue(backupv1.BackupScheduleLabel, backupScheduleName))
+			Expect(backup.Spec.Source.Kind).To(Equal(backupv1.VirtualMachineBackupTrackerGroupVersionKind.Kind))
+			Expect(backup.Spec.Source.Name).To(Equal(backupTrackerName))
+			Expect(backup.Spec.PvcName).To(HaveValue(Equal(backup.Name)))
+			Expect(backup.Spec.ForceFullBackup).To(BeFalse())
+			testutils.ExpectEvent(recorder, backupScheduledEvent)
+
+			pvc, err := k8sClient.CoreV1().PersistentVolumeClaims(testNamespace).Get(context.Background(), backup.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc.Spec.AccessModes).To(ConsistOf(corev1.ReadWriteOnce))
+			Expect(pvc.OwnerReferences).To(HaveLen(1))
+			Expect(pvc.OwnerReferences[0].Kind).To(Equal(backupv1.VirtualMachineBackupGroupVersionKind.Kind))
+			Expect(pvc.OwnerReferences[0].Name).To(Equal(backup.Name))
+
+			status := getSchedule().Status
+			Expect(status).ToNot(BeNil())
+			Expect(status.LastScheduleTime.Time).To(BeTemporally("==", slot))
+			Expect(status.NextScheduleTime.Time).To(BeTemporally("==", slot.Add(time.Hour)))
+			Expect(status.LastBackupName).To(HaveValue(Equal(backup.Name)))
+			Expect(hasCondition(status.Conditions, backupv1.ConditionFailure)).To(BeFalse())
+		})
+
+		It("should not create a backup before the next slot", func() {
+			schedule := createSchedule()
+			schedule.Status = &backupv1.VirtualMachineBackupScheduleStatus{
+				LastScheduleTime: pointer.P(metav1.NewTime(time.Date(2025, time.March, 12, 12, 0, 0, 0, time.UTC))),
+			}
+			addSchedule(schedule)
+
+			Expect(controller.execute(scheduleKey)).To(Succeed())
+			Expect(listBackups()).To(BeEmpty())
+		})
+
+		It("should skip the slot while a backup is in progress", func() {
+			addSchedule(createSchedule())
+			inProgress := createScheduledBackup("in-progress", now.Add(-3*time.Hour), backupv1.Full, false)
+			addBackups(inProgress)
+
+			Expect(controller.execute(scheduleKey)).To(Succeed())
+
+			Expect(listBackups()).To(HaveLen(1))
+			testutils.ExpectEvent(recorder, backupScheduleSkippedEvent)
+			status := getSchedule().Status
+			Expect(status.LastScheduleTime.Time).To(BeTemporally("==", time.Date(2025, time.March, 12, 12, 0, 0, 0, time.UTC)))
+			Expect(status.LastBackupName).To(BeNil())
+		})
+
+		It("should force a full backup after fullBackupInterval incremental backups", func() {
+			schedule := createSchedule()
+			schedule.Spec.FullBackupInterval = pointer.P(int32(2))
+			addSchedule(schedule)
+			addBackups(
+				createScheduledBackup("backup-1", now.Add(-5*time.Hour), backupv1.Full, true),
+				createScheduledBackup("backup-2", now.Add(-4*time.Hour), backupv1.Incremental, true),
+				createScheduledBackup("backup-3", now.Add(-3*time.Hour), backupv1.Incremental, true),
+			)
+
+			Expect(controller.execute(scheduleKey)).To(Succeed())
+
+			backup, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Get(context.Background(), *getSchedule().Status.LastBackupName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(backup.Spec.ForceFullBackup).To(BeTrue())
+		})
+
+		It("should not create backups while suspended", func() {
+			schedule := createSchedule()
+			schedule.Spec.Suspend = true
+			addSchedule(schedule)
+
+			Expect(controller.execute(scheduleKey)).To(Succeed())
+
+			Expect(listBackups()).To(BeEmpty())
+			Expect(getSchedule().Status.NextScheduleTime).To(BeNil())
+		})
+	})
+
+	Context("failures", func() {
+		It("should report an invalid schedule", func() {
+			addTracker(createTracker(""))
+			schedule := createSchedule()
+			schedule.Spec.Schedule = "every hour"
+			addSchedule(schedule)
+
+			Expect(controller.execute(scheduleKey)).To(Succeed())
+
+			Expect(listBackups()).To(BeEmpty())
+			testutils.ExpectEvent(recorder, backupScheduleFailedEvent)
+			Expect(hasCondition(getSchedule().Status.Conditions, backupv1.ConditionFailure)).To(BeTrue())
+		})
+
+		It("should report a missing BackupTracker", func() {
+			addSchedule(createSchedule())
+
+			Expect(controller.execute(scheduleKey)).To(Succeed())
+
+			Expect(listBackups()).To(BeEmpty())
+			status := getSchedule().Status
+			Expect(status.NextScheduleTime).To(BeNil())
+			Expect(hasCondition(status.Conditions, backupv1.ConditionFailure)).To(BeTrue())
+		})
+	})
+
+	Context("retention", func() {
+		day := func(d int) time.Time {
+			return time.Date(2025, time.March, d, 1, 0, 0, 0, time.UTC)
+		}
+
+		prunedNames := func(backups []*backupv1.VirtualMachineBackup) []string {
+			var names []string
+			for _, backup := range backups {
+				names = append(names, backup.Name)
+			}
+			return names
+		}
+
+		It("should keep the last backups", func() {
+			backups := []*backupv1.VirtualMachineBackup{
+				createScheduledBackup("backup-1", day(1), backupv1.Full, true),
+				createScheduledBackup("backup-2", day(2), backupv1.Incremental, true),
+				createScheduledBackup("backup-3", day(3), backupv1.Full, true),
+				createScheduledBackup("backup-4", day(4), backupv1.Incremental, true),
+			}
+			pruned := backupsToPrune(backups, &backupv1.BackupRetention{KeepLast: pointer.P(int32(2))})
+			Expect(prunedNames(pruned)).To(ConsistOf("backup-1", "backup-2"))
+		})
+
+		It("should keep the backups a retained incremental backup is based on", func() {
+			backups := []*backupv1.VirtualMachineBackup{
+				createScheduledBackup("backup-1", day(1), backupv1.Full, true),
+				createScheduledBackup("backup-2", day(2), backupv1.Incremental, true),
+				createScheduledBackup("backup-3", day(3), backupv1.Incremental, true),
+			}
+			pruned := backupsToPrune(backups, &backupv1.BackupRetention{KeepLast: pointer.P(int32(1))})
+			Expect(pruned).To(BeEmpty())
+		})
+
+		It("should keep the base of a backup in progress", func() {
+			backups := []*backupv1.VirtualMachineBackup{
+				createScheduledBackup("backup-1", day(1), backupv1.Full, true),
+				createScheduledBackup("backup-2", day(2), "", false),
+			}
+			pruned := backupsToPrune(backups, &backupv1.BackupRetention{KeepLast: pointer.P(int32(0))})
+			Expect(pruned).To(BeEmpty())
+		})
+
+		It("should keep the most recent backup of each day", func() {
+			backups := []*backupv1.VirtualMachineBackup{
+				createScheduledBackup("backup-1", day(1), backupv1.Full, true),
+				createScheduledBackup("backup-2", day(2), backupv1.Full, true),
+				createScheduledBackup("backup-3", day(2).Add(time.Hour), backupv1.Full, true),
+				createScheduledBackup("backup-4", day(3), backupv1.Full, true),
+			}
+			pruned := backupsToPrune(backups, &backupv1.BackupRetention{KeepDaily: pointer.P(int32(2))})
+			Expect(prunedNames(pruned)).To(ConsistOf("backup-1", "backup-2"))
+		})
+
+		It("should keep the most recent backup of each week", func() {
+			backups := []*backupv1.VirtualMachineBackup{
+				// March 3rd 2025 is a Monday
+				createScheduledBackup("backup-1", day(3), backupv1.Full, true),
+				createScheduledBackup("backup-2", day(9), backupv1.Full, true),
+				createScheduledBackup("backup-3", day(10), backupv1.Full, true),
+				createScheduledBackup("backup-4", day(11), backupv1.Full, true),
+			}
+			pruned := backupsToPrune(backups, &backupv1.BackupRetention{
+				KeepLast:   pointer.P(int32(1)),
+				KeepWeekly: pointer.P(int32(2)),
+			})
+			Expect(prunedNames(pruned)).To(ConsistOf("backup-1", "backup-3"))
+		})
+
+		It("should not prune without a retention", func() {
+			backups := []*backupv1.VirtualMachineBackup{
+				createScheduledBackup("backup-1", day(1), backupv1.Full, true),
+			}
+			Expect(backupsToPrune(backups, nil)).To(BeEmpty())
+		})
+
+		It("should delete pruned backups and reset the tracker holding their checkpoint", func() {
+			schedule := createSchedule()
+			schedule.Spec.Suspend = true
+			schedule.Spec.Retention = &backupv1.BackupRetention{KeepLast: pointer.P(int32(1))}
+			addSchedule(schedule)
+			oldest := createScheduledBackup("backup-1", day(1), backupv1.Full, true)
+			latest := createScheduledBackup("backup-2", day(2), backupv1.Full, true)
+			addBackups(oldest, latest)
+			// the latest checkpoint was taken by the pruned backup
+			addTracker(createTracker(*oldest.Status.CheckpointName))
+
+			trackerPatched := false
+			kubevirtClient.Fake.PrependReactor("patch", "virtualmachinebackuptrackers", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				patchAction := action.(testing.PatchAction)
+				Expect(patchAction.GetSubresource()).To(Equal("status"))
+				Expect(string(patchAction.GetPatch())).To(ContainSubstring(`"op":"remove","path":"/status/latestCheckpoint"`))
+				trackerPatched = true
+				return true, createTracker(""), nil
+			})
+
+			Expect(controller.execute(scheduleKey)).To(Succeed())
+
+			Expect(trackerPatched).To(BeTrue())
+			backups := listBackups()
+			Expect(backups).To(HaveLen(1))
+			Expect(backups[0].Name).To(Equal(latest.Name))
+			testutils.ExpectEvents(recorder, backupTrackerResetEvent, backupPrunedEvent)
+		})
+	})
+})
@@ -22,6 +22,7 @@ package cbt
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -81,6 +82,8 @@ type VMBackupController struct {
 	vmStore               cache.Store
 	vmiStore              cache.Store
 	pvcStore              cache.Store
+	caConfigMapStore      cache.Store
+	kubevirtNamespace     string
 	recorder              record.EventRecorder
 	backupQueue           workqueue.TypedRateLimitingInterface[string]
 	hasSynced             func() bool
@@ -92,6 +95,8 @@ func NewVMBackupController(client kubecli.KubevirtClient,
 	vmInformer cache.SharedIndexInformer,
 	vmiInformer cache.SharedIndexInformer,
 	pvcInformer cache.SharedIndexInformer,
+	caConfigMapInformer cache.SharedIndexInformer,
+	kubevirtNamespace string,
 	recorder record.EventRecorder,
 ) (*VMBackupController, error) {
 	c := &VMBackupController{
@@ -104,12 +109,14 @@ func NewVMBackupController(client kubecli.KubevirtClient,
 		vmStore:               vmInformer.GetStore(),
 		vmiStore:              vmiInformer.GetStore(),
 		pvcStore:              pvcInformer.GetStore(),
+		caConfigMapStore:      caConfigMapInformer.GetStore(),
+		kubevirtNamespace:     kubevirtNamespace,
 		recorder:              recorder,
 		client:                client,
 	}
 
 	c.hasSynced = func() bool {
-		return backupInformer.HasSynced() && backupTrackerInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && pvcInformer.HasSynced()
+		return backupInformer.HasSynced() && backupTrackerInformer.HasSynced() && vmInformer.HasSynced() && vmiInformer.HasSynced() && pvcInformer.HasSynced() && caConfigMapInformer.HasSynced()
 	}
 
 	_, err := backupInformer.AddEventHandler(
@@ -282,6 +289,10 @@ type SyncInfo struct {
 	event          string
 	checkpointName string
 	backupType     backupv1.BackupType
+	endpoint       *backupv1.BackupEndpoint
+	baseCheckpoint string
+	vm             *backupv1.VirtualMachine
+	volumes        []backupv1.BackupVolume
 }
 
 func syncInfoError(err error) *SyncInfo {
@@ -400,6 +411,30 @@ func (ctrl *VMBackupController) sync(backup *backupv1.VirtualMachineBackup) *Syn
 		}
 		backupOptions.Mode = backupv1.PushMode
 		backupOptions.PushPath = pointer.P(hotplugdisk.GetVolumeMountDir(volumeName))
+	case backupv1.PullMode:
+		// the scratch space for the blocks the guest overwrites during the export
+		// defaults to the virt-launcher private directory
+		if pvcName := backup.Spec.PvcName; pvcName != nil {
+			syncInfo = ctrl.verifyBackupTargetPVC(pvcName, backup.Namespace)
+			if syncInfo != nil {
+				return syncInfo
+			}
+
+			volumeName := backupTargetVolumeName(backup.Name)
+			attached := ctrl.backupTargetPVCAttached(vmi, volumeName)
+			if !attached {
+				return ctrl.attachBackupTargetPVC(vmi, *pvcName, volumeName)
+			}
+			backupOptions.ScratchPath = pointer.P(hotplugdisk.GetVolumeMountDir(volumeName))
+		}
+
+		backup, err = ctrl.ensureBackupToken(backup)
+		if err != nil {
+			logger.Error(err.Error())
+			return syncInfoError(err)
+		}
+		backupOptions.Mode = backupv1.PullMode
+		backupOptions.TokenHash = pointer.P(backup.Annotations[backupTokenHashAnnotation])
 	default:
 		logger.Errorf(invalidBackupModeMsg, *backup.Spec.Mode)
 		return syncInfoError(fmt.Errorf(invalidBackupModeMsg, *backup.Spec.Mode))
@@ -413,6 +448,13 @@ func (ctrl *VMBackupController) sync(backup *backupv1.VirtualMachineBackup) *Syn
 		logger.Infof("Setting incremental backup from checkpoint: %s", backupTracker.Status.LatestCheckpoint.Name)
 	}
 
+	vm, volumes, err := ctrl.captureBackupSource(backup, vmi)
+	if err != nil {
+		err = fmt.Errorf("failed to capture the backup source: %w", err)
+		logger.Error(err.Error())
+		return syncInfoError(err)
+	}
+
 	err = ctrl.client.VirtualMachineInstance(vmi.Namespace).Backup(context.Background(), vmi.Name, &backupOptions)
 	if err != nil {
 		err = fmt.Errorf("failed to send Start backup command: %w", err)
@@ -421,11 +463,89 @@ func (ctrl *VMBackupController) sync(backup *backupv1.VirtualMachineBackup) *Syn
 	}
 	logger.Infof("Started backup for VMI %s successfully", vmi.Name)
 
-	return &SyncInfo{
+	syncInfo = &SyncInfo{
 		event:      backupInitiatedEvent,
 		reason:     backupInProgress,
 		backupType: backupType,
+		vm:         vm,
+		volumes:    volumes,
+	}
+	if backupOptions.Incremental != nil {
+		syncInfo.baseCheckpoint = *backupOptions.Incremental
 	}
+	return syncInfo
+}
+
+// captureBackupSource records the VM manifest and the storage of the backed up
+// volumes, so the backup can be restored after the VM and its PVCs are gone
+func (ctrl *VMBackupController) captureBackupSource(backup *backupv1.VirtualMachineBackup, vmi *v1.VirtualMachineInstance) (*backupv1.VirtualMachine, []backupv1.BackupVolume, error) {
+	obj, exists, err := ctrl.vmStore.GetByKey(cacheKeyFunc(vmi.Namespace, vmi.Name))
+	if err != nil {
+		return nil, nil, err
+	}
+	if !exists {
+		return nil, nil, fmt.Errorf(vmNotFoundMsg, vmi.Namespace, vmi.Name)
+	}
+	sourceVM := obj.(*v1.VirtualMachine)
+
+	vm := &backupv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        sourceVM.Name,
+			Namespace:   sourceVM.Namespace,
+			Labels:      sourceVM.Labels,
+			Annotations: sourceVM.Annotations,
+		},
+		Spec: *sourceVM.Spec.DeepCopy(),
+	}
+
+	var volumes []backupv1.BackupVolume
+	for _, volume := range vmi.Spec.Volumes {
+		if !IsCBTEligibleVolume(&volume) {
+			continue
+		}
+
+		backupVolume := backupv1.BackupVolume{
+			VolumeName: volume.Name,
+		}
+		if backup.Spec.Mode == nil || *backup.Spec.Mode == backupv1.PushMode {
+			backupVolume.Path = filepath.Join(
+				BackupDir(vmi.Name, backup.Name, &backup.CreationTimestamp),
+				BackupVolumeFileName(backup.Name, volume.Name),
+			)
+		}
+
+		var claimName string
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			claimName = volume.PersistentVolumeClaim.ClaimName
+		case volume.DataVolume != nil:
+			claimName = volume.DataVolume.Name
+		case volume.HostDisk != nil:
+			backupVolume.Capacity = pointer.P(volume.HostDisk.Capacity.DeepCopy())
+		}
+
+		if claimName != "" {
+			obj, exists, err := ctrl.pvcStore.GetByKey(cacheKeyFunc(vmi.Namespace, claimName))
+			if err != nil {
+				return nil, nil, err
+			}
+			if exists {
+				pvc := obj.(*corev1.PersistentVolumeClaim)
+				backupVolume.VolumeMode = pvc.Spec.VolumeMode
+				backupVolume.AccessModes = pvc.Spec.AccessModes
+				backupVolume.StorageClassName = pvc.Spec.StorageClassName
+				if capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
+					backupVolume.Capacity = pointer.P(capacity.DeepCopy())
+				} else if request, ok := pvc.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+					backupVolume.Capacity = pointer.P(request.DeepCopy())
+				}
+			}
+		}
+
+		volumes = append(volumes, backupVolume)
+	}
+
+	return vm, volumes, nil
 }
 
 func (ctrl *VMBackupController) updateStatus(backup *backupv1.VirtualMachineBackup, syncInfo *SyncInfo, logger *log.FilteredLogger) error {
@@ -450,6 +570,32 @@ func (ctrl *VMBackupController) updateStatus(backup *backupv1.VirtualMachineBack
 			if syncInfo.backupType != "" {
 				backupOut.Status.Type = syncInfo.backupType
 			}
+			if syncInfo.baseCheckpoint != "" {
+				backupOut.Status.BaseCheckpointName = pointer.P(syncInfo.baseCheckpoint)
+			}
+			if syncInfo.vm != nil {
+				backupOut.Status.VirtualMachine = syncInfo.vm
+				backupOut.Status.Volumes = syncInfo.volumes
+			}
+		case backupExportReadyEvent:
+			if backupOut.Status.Endpoint == nil {
+				ctrl.recorder.Eventf(backupOut, corev1.EventTypeNormal, backupExportReadyEvent, syncInfo.reason)
+			}
+			updateBackupCondition(backupOut, newProgressingCondition(corev1.ConditionTrue, syncInfo.reason))
+			backupOut.Status.Endpoint = syncInfo.endpoint
+			if syncInfo.checkpointName != "" {
+				backupOut.Status.CheckpointName = pointer.P(syncInfo.checkpointName)
+			}
+		case backupTTLExpiredEvent:
+			if !isBackupExpired(backupOut.Status) {
+				ctrl.recorder.Eventf(backupOut, corev1.EventTypeWarning, backupTTLExpiredEvent, backupTTLExpiredMsg)
+			}
+			updateBackupCondition(backupOut, newCondition(backupv1.ConditionFailure, corev1.ConditionTrue, syncInfo.reason))
+		case backupExpiredEvent:
+			ctrl.recorder.Eventf(backupOut, corev1.EventTypeWarning, backupExpiredEvent, syncInfo.reason)
+			updateBackupCondition(backupOut, newProgressingCondition(corev1.ConditionFalse, syncInfo.reason))
+			updateBackupCondition(backupOut, newDoneCondition(corev1.ConditionTrue, syncInfo.reason))
+			backupOut.Status.Endpoint = nil
 		case backupCompletedEvent, backupCompletedWithWarningEvent:
 			if syncInfo.event == backupCompletedWithWarningEvent {
 				ctrl.recorder.Eventf(backupOut, corev1.EventTypeWarning, backupCompletedWithWarningEvent, syncInfo.reason)
@@ -458,6 +604,7 @@ func (ctrl *VMBackupController) updateStatus(backup *backupv1.VirtualMachineBack
 			}
 			updateBackupCondition(backupOut, newProgressingCondition(corev1.ConditionFalse, syncInfo.reason))
 			updateBackupCondition(backupOut, newDoneCondition(corev1.ConditionTrue, syncInfo.reason))
+			backupOut.Status.Endpoint = nil
 			if syncInfo.checkpointName != "" {
 				backupOut.Status.CheckpointName = pointer.P(syncInfo.checkpointName)
 			}
@@ -693,11 +840,18 @@ func (ctrl *VMBackupController) checkBackupCompletion(backup *backupv1.VirtualMa
 
 	backupStatus := vmi.Status.ChangedBlockTracking.BackupStatus
 	if !backupStatus.Completed {
+		if isPullMode(backup) {
+			return ctrl.syncPullBackupExport(backup, vmi)
+		}
 		return nil
 	}
 
+	// The checkpoint of an expired backup must not become the base of the next
+	// incremental backup, the changes since the previous one were never pulled
+	expired := isBackupExpired(backup.Status)
+
 	// Update BackupTracker with the new checkpoint if applicable
-	if backupTracker != nil && backupStatus.CheckpointName != nil {
+	if !expired && backupTracker != nil && backupStatus.CheckpointName != nil {
 		if err := ctrl.updateBackupTracker(backup.Namespace, backupTracker, backupStatus); err != nil {
 			log.Log.Object(backup).Reason(err).Error("Failed to update BackupTracker")
 			return syncInfoError(err)
@@ -715,6 +869,14 @@ func (ctrl *VMBackupController) checkBackupCompletion(backup *backupv1.VirtualMa
 
 	// TODO: Handle backup failure (backupStatus.Failed) and abort status (backupStatus.AbortStatus)
 
+	if expired {
+		log.Log.Object(backup).Info(backupExpiredMsg)
+		return &SyncInfo{
+			event:  backupExpiredEvent,
+			reason: backupExpiredMsg,
+		}
+	}
+
 	// Check if backup completed with a warning message
 	if backupStatus.BackupMsg != nil {
 		log.Log.Object(backup).Infof(backupCompletedWithWarningMsg, *backupStatus.BackupMsg)
@@ -798,7 +960,14 @@ func (ctrl *VMBackupController) deletionCleanup(backup *backupv1.VirtualMachineB
 
 	if vmiBackupInProgress {
 		log.Log.With("VirtualMachineBackup", backup.Name).V(3).Info(backupDeletingBeforeVMICompletionMsg)
-		// TODO: abort running backup on deletion instead of waiting for completion
+		// A pull mode backup only ends when its client is done, stop the export instead of waiting for it
+		if isPullMode(backup) {
+			if err := ctrl.stopBackup(backup, vmi); err != nil {
+				log.Log.With("VirtualMachineBackup", backup.Name).Error(err.Error())
+				return syncInfoError(err)
+			}
+		}
+		// TODO: abort running push mode backup on deletion instead of waiting for completion
 		return nil
 	}
 
@@ -816,8 +985,20 @@ func isPushMode(backup *backupv1.VirtualMachineBackup) bool {
 	return backup.Spec.Mode == nil || *backup.Spec.Mode == backupv1.PushMode
 }
 
+// usesBackupPVC reports whether a PVC was attached to the VMI for the backup,
+// the push target or the optional pull mode scratch space
+func usesBackupPVC(backup *backupv1.VirtualMachineBackup) bool {
+	return isPushMode(backup) || backup.Spec.PvcName != nil
+}
+
 func (ctrl *VMBackupController) cleanup(backup *backupv1.VirtualMachineBackup, vmi *v1.VirtualMachineInstance) (bool, *SyncInfo) {
-	if isPushMode(backup) {
+	if isPullMode(backup) {
+		if syncInfo := ctrl.cleanupPullBackupExport(backup); syncInfo != nil {
+			return false, syncInfo
+		}
+	}
+
+	if usesBackupPVC(backup) {
 		volumeName := backupTargetVolumeName(backup.Name)
 		detached := ctrl.backupTargetPVCDetached(vmi, volumeName)
 		if !detached {
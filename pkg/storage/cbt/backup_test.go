@@ -22,12 +22,14 @@ package cbt
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8stypes "k8s.io/apimachinery/pkg/types"
@@ -74,6 +76,7 @@ var _ = Describe("Backup Controller", func() {
 		vmInformer            cache.SharedIndexInformer
 		vmiInformer           cache.SharedIndexInformer
 		pvcInformer           cache.SharedIndexInformer
+		caConfigMapInformer   cache.SharedIndexInformer
 		controller            *VMBackupController
 		recorder              *record.FakeRecorder
 		mockBackupQueue       *testutils.MockWorkQueue[string]
@@ -260,6 +263,7 @@ var _ = Describe("Backup Controller", func() {
 		vmInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachine{})
 		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
 		pvcInformer, _ = testutils.NewFakeInformerFor(&corev1.PersistentVolumeClaim{})
+		caConfigMapInformer, _ = testutils.NewFakeInformerFor(&corev1.ConfigMap{})
 
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
@@ -271,6 +275,8 @@ var _ = Describe("Backup Controller", func() {
 			vmStore:               vmInformer.GetStore(),
 			vmiStore:              vmiInformer.GetStore(),
 			pvcStore:              pvcInformer.GetStore(),
+			caConfigMapStore:      caConfigMapInformer.GetStore(),
+			kubevirtNamespace:     "kubevirt",
 			recorder:              recorder,
 			backupQueue: workqueue.NewTypedRateLimitingQueueWithConfig(
 				workqueue.DefaultTypedControllerRateLimiter[string](),
@@ -1021,6 +1027,52 @@ var _ = Describe("Backup Controller", func() {
 		Expect(backupCalled).To(BeTrue())
 	})
 
+	It("should capture the VM and the backed up volumes when initiating an incremental backup", func() {
+		backupTracker := createBackupTracker(backupTrackerName, vmName, checkpointName)
+		controller.backupTrackerInformer.GetStore().Add(backupTracker)
+
+		backup := createBackupWithTracker(backupName, vmName, pvcName)
+		backup.Finalizers = []string{vmBackupFinalizer}
+		backup.CreationTimestamp = metav1.NewTime(time.Date(2025, time.March, 12, 12, 0, 0, 0, time.UTC))
+		addBackup(backup)
+
+		vm := createVM(vmName)
+		vm.Labels = map[string]string{"app": "test"}
+		vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
+		controller.vmStore.Add(vm)
+		controller.vmiStore.Add(createInitializedVMI())
+		controller.pvcStore.Add(createPVC(pvcName))
+
+		disk := createPVC("test-disk")
+		disk.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}
+		disk.Spec.StorageClassName = pointer.P("fast")
+		disk.Status.Capacity = corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("10Gi")}
+		controller.pvcStore.Add(disk)
+
+		vmiInterface.EXPECT().Backup(gomock.Any(), vmName, gomock.Any()).Return(nil)
+
+		syncInfo := controller.sync(backup)
+		Expect(syncInfo).ToNot(BeNil())
+		Expect(syncInfo.err).ToNot(HaveOccurred())
+		Expect(controller.updateStatus(backup, syncInfo, log.Log)).To(Succeed())
+
+		updated, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Get(context.Background(), backupName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updated.Status.BaseCheckpointName).To(HaveValue(Equal(checkpointName)))
+		Expect(updated.Status.VirtualMachine).ToNot(BeNil())
+		Expect(updated.Status.VirtualMachine.Name).To(Equal(vmName))
+		Expect(updated.Status.VirtualMachine.Labels).To(HaveKeyWithValue("app", "test"))
+		Expect(updated.Status.VirtualMachine.Spec.RunStrategy).To(HaveValue(Equal(v1.RunStrategyAlways)))
+		Expect(updated.Status.Volumes).To(ConsistOf(backupv1.BackupVolume{
+			VolumeName:       "disk0",
+			Path:             "test-vm/test-backup-2025-03-12_12-00-00/test-backup-disk0.qcow2",
+			Capacity:         pointer.P(resource.MustParse("10Gi")),
+			VolumeMode:       pointer.P(corev1.PersistentVolumeFilesystem),
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+			StorageClassName: pointer.P("fast"),
+		}))
+	})
+
 	It("should initiate full backup with ForceFullBackup even with LatestCheckpoint", func() {
 		backupTracker := createBackupTracker(backupTrackerName, vmName, checkpointName)
 		controller.backupTrackerInformer.GetStore().Add(backupTracker)
@@ -1264,4 +1316,272 @@ var _ = Describe("Backup Controller", func() {
 		// But backupTracker was still updated before cleanup
 		Expect(trackerPatched).To(BeTrue())
 	})
+
+	Context("pull mode", func() {
+		const pullEndpoint = "10.0.0.1:10809"
+
+		createPullBackup := func() *backupv1.VirtualMachineBackup {
+			backup := createBackup(backupName, vmName, pvcName)
+			backup.Finalizers = []string{vmBackupFinalizer}
+			backup.Spec.Mode = pointer.P(backupv1.PullMode)
+			backup.Spec.PvcName = nil
+			return backup
+		}
+
+		createPullVMI := func() *v1.VirtualMachineInstance {
+			vmi := createVMI()
+			vmi.Status.ChangedBlockTracking.BackupStatus = &v1.VirtualMachineInstanceBackupStatus{
+				BackupName:     backupName,
+				StartTimestamp: pointer.P(metav1.Now()),
+				CheckpointName: pointer.P(checkpointName),
+				PullEndpoint:   pointer.P(pullEndpoint),
+				Exports: []v1.VirtualMachineInstanceBackupExport{
+					{VolumeName: "disk0", ExportName: "disk0", DirtyBitmap: pointer.P("qemu:dirty-bitmap:backup-disk0")},
+				},
+			}
+			return vmi
+		}
+
+		expectStop := func() *bool {
+			stopCalled := false
+			vmiInterface.EXPECT().
+				Backup(gomock.Any(), vmName, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, name string, options *backupv1.BackupOptions) error {
+					stopCalled = true
+					Expect(options.BackupName).To(Equal(backupName))
+					Expect(options.Cmd).To(Equal(backupv1.Stop))
+					return nil
+				})
+			return &stopCalled
+		}
+
+		BeforeEach(func() {
+			controller.vmStore.Add(createVM(vmName))
+			caConfigMapInformer.GetStore().Add(&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: kubevirtCAConfigMapName, Namespace: "kubevirt"},
+				Data:       map[string]string{caBundleKey: "test-ca\n"},
+			})
+			k8sClient.Fake.PrependReactor("create", "secrets", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				secret := action.(testing.CreateAction).GetObject().(*corev1.Secret)
+				if secret.Name == "" {
+					secret.Name = secret.GenerateName + "abcde"
+				}
+				return false, nil, nil
+			})
+		})
+
+		It("should start the backup with the hash of a newly created token", func() {
+			backup := createPullBackup()
+			addBackup(backup)
+
+			vmi := createVMI()
+			vmi.Status.ChangedBlockTracking.BackupStatus = &v1.VirtualMachineInstanceBackupStatus{BackupName: backupName}
+			controller.vmiStore.Add(vmi)
+
+			var tokenHash string
+			vmiInterface.EXPECT().
+				Backup(gomock.Any(), vmName, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, name string, options *backupv1.BackupOptions) error {
+					Expect(options.Cmd).To(Equal(backupv1.Start))
+					Expect(options.Mode).To(Equal(backupv1.PullMode))
+					Expect(options.PushPath).To(BeNil())
+					Expect(options.ScratchPath).To(BeNil())
+					Expect(options.TokenHash).ToNot(BeNil())
+					tokenHash = *options.TokenHash
+					return nil
+				})
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.err).ToNot(HaveOccurred())
+			Expect(syncInfo.event).To(Equal(backupInitiatedEvent))
+
+			secrets, err := k8sClient.CoreV1().Secrets(testNamespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secrets.Items).To(HaveLen(1))
+			secret := secrets.Items[0]
+			Expect(secret.OwnerReferences).To(HaveLen(1))
+			Expect(secret.OwnerReferences[0].UID).To(Equal(backupUID))
+			Expect(BackupTokenHash(string(secret.Data[backupTokenKey]))).To(Equal(tokenHash))
+
+			updated, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Get(context.Background(), backupName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Annotations).To(HaveKeyWithValue(backupTokenHashAnnotation, tokenHash))
+			Expect(updated.Annotations).To(HaveKeyWithValue(backupTokenSecretAnnotation, secret.Name))
+		})
+
+		It("should attach the scratch PVC when one is requested", func() {
+			backup := createPullBackup()
+			backup.Spec.PvcName = pointer.P(pvcName)
+			addBackup(backup)
+
+			vmi := createVMI()
+			vmi.Status.ChangedBlockTracking.BackupStatus = &v1.VirtualMachineInstanceBackupStatus{BackupName: backupName}
+			controller.vmiStore.Add(vmi)
+			controller.pvcStore.Add(createPVC(pvcName))
+
+			vmiInterface.EXPECT().
+				Patch(gomock.Any(), vmName, k8stypes.JSONPatchType, gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, name string, patchType k8stypes.PatchType, patchBytes []byte, opts metav1.PatchOptions, subresources ...string) (*v1.VirtualMachineInstance, error) {
+					Expect(string(patchBytes)).To(ContainSubstring("utilityVolumes"))
+					return vmi, nil
+				})
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.err).ToNot(HaveOccurred())
+			Expect(syncInfo.reason).To(ContainSubstring("attaching"))
+		})
+
+		It("should publish the export once virt-handler serves it", func() {
+			backup := createPullBackup()
+			backup.Annotations = map[string]string{
+				backupTokenHashAnnotation:   BackupTokenHash("token"),
+				backupTokenSecretAnnotation: "test-backup-backup-token-abcde",
+			}
+			backup.Status = &backupv1.VirtualMachineBackupStatus{
+				Conditions: []backupv1.Condition{
+					{Type: backupv1.ConditionProgressing, Status: corev1.ConditionTrue},
+				},
+			}
+			addBackup(backup)
+			controller.vmiStore.Add(createPullVMI())
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.err).ToNot(HaveOccurred())
+			Expect(syncInfo.event).To(Equal(backupExportReadyEvent))
+			Expect(syncInfo.checkpointName).To(Equal(checkpointName))
+			Expect(syncInfo.endpoint).To(Equal(&backupv1.BackupEndpoint{
+				ServiceName:    backupServiceName(backupName),
+				Port:           BackupNBDPort,
+				TLSServerName:  "virt-handler.kubevirt.svc",
+				CACert:         "test-ca",
+				TokenSecretRef: "test-backup-backup-token-abcde",
+				Exports: []backupv1.BackupExport{
+					{VolumeName: "disk0", ExportName: "disk0", DirtyBitmap: pointer.P("qemu:dirty-bitmap:backup-disk0")},
+				},
+			}))
+			Expect(mockBackupQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+
+			service, err := k8sClient.CoreV1().Services(testNamespace).Get(context.Background(), backupServiceName(backupName), metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(service.Spec.Selector).To(BeEmpty())
+			Expect(service.Spec.Ports[0].TargetPort.IntValue()).To(Equal(BackupNBDPort))
+			endpoints, err := k8sClient.CoreV1().Endpoints(testNamespace).Get(context.Background(), backupServiceName(backupName), metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(endpoints.Subsets[0].Addresses[0].IP).To(Equal("10.0.0.1"))
+
+			Expect(controller.updateStatus(backup, syncInfo, log.Log)).To(Succeed())
+			updated, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Get(context.Background(), backupName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Status.Endpoint).To(Equal(syncInfo.endpoint))
+			Expect(updated.Status.CheckpointName).To(HaveValue(Equal(checkpointName)))
+			testutils.ExpectEvent(recorder, backupExportReadyEvent)
+		})
+
+		It("should stop the backup once the TTL expired", func() {
+			backup := createPullBackup()
+			backup.Spec.TTLDuration = &metav1.Duration{Duration: time.Minute}
+			backup.Status = &backupv1.VirtualMachineBackupStatus{
+				Conditions: []backupv1.Condition{
+					{Type: backupv1.ConditionProgressing, Status: corev1.ConditionTrue},
+				},
+			}
+			addBackup(backup)
+
+			vmi := createPullVMI()
+			vmi.Status.ChangedBlockTracking.BackupStatus.StartTimestamp = pointer.P(metav1.NewTime(time.Now().Add(-time.Hour)))
+			controller.vmiStore.Add(vmi)
+			stopCalled := expectStop()
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.event).To(Equal(backupTTLExpiredEvent))
+			Expect(*stopCalled).To(BeTrue())
+
+			Expect(controller.updateStatus(backup, syncInfo, log.Log)).To(Succeed())
+			updated, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Get(context.Background(), backupName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(isBackupExpired(updated.Status)).To(BeTrue())
+			Expect(IsBackupDone(updated.Status)).To(BeFalse())
+			testutils.ExpectEvent(recorder, backupTTLExpiredEvent)
+		})
+
+		It("should mark the backup as expired instead of completed once the expired backup stopped", func() {
+			backup := createPullBackup()
+			backup.Status = &backupv1.VirtualMachineBackupStatus{
+				Conditions: []backupv1.Condition{
+					{Type: backupv1.ConditionProgressing, Status: corev1.ConditionTrue},
+					{Type: backupv1.ConditionFailure, Status: corev1.ConditionTrue, Reason: backupExpiredMsg},
+				},
+			}
+			addBackup(backup)
+
+			vmi := createPullVMI()
+			vmi.Status.ChangedBlockTracking.BackupStatus.Completed = true
+			controller.vmiStore.Add(vmi)
+			vmiInterface.EXPECT().
+				Patch(gomock.Any(), vmName, k8stypes.JSONPatchType, gomock.Any(), gomock.Any()).
+				Return(vmi, nil)
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.event).To(Equal(backupExpiredEvent))
+			Expect(syncInfo.checkpointName).To(BeEmpty())
+
+			Expect(controller.updateStatus(backup, syncInfo, log.Log)).To(Succeed())
+			updated, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Get(context.Background(), backupName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(IsBackupDone(updated.Status)).To(BeTrue())
+			Expect(isBackupExpired(updated.Status)).To(BeTrue())
+			Expect(updated.Status.CheckpointName).To(BeNil())
+			testutils.ExpectEvent(recorder, backupExpiredEvent)
+		})
+
+		It("should stop the backup when it is deleted during the export", func() {
+			backup := createPullBackup()
+			backup.DeletionTimestamp = pointer.P(metav1.Now())
+			addBackup(backup)
+			controller.vmiStore.Add(createPullVMI())
+			stopCalled := expectStop()
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).To(BeNil())
+			Expect(*stopCalled).To(BeTrue())
+		})
+
+		It("should remove the published export once the backup completed", func() {
+			backup := createPullBackup()
+			backup.Status = &backupv1.VirtualMachineBackupStatus{
+				Conditions: []backupv1.Condition{
+					{Type: backupv1.ConditionProgressing, Status: corev1.ConditionTrue},
+				},
+				Endpoint: &backupv1.BackupEndpoint{ServiceName: backupServiceName(backupName)},
+			}
+			addBackup(backup)
+			_, err := k8sClient.CoreV1().Services(testNamespace).Create(context.Background(), &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: backupServiceName(backupName), Namespace: testNamespace},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			vmi := createPullVMI()
+			vmi.Status.ChangedBlockTracking.BackupStatus.Completed = true
+			controller.vmiStore.Add(vmi)
+			vmiInterface.EXPECT().
+				Patch(gomock.Any(), vmName, k8stypes.JSONPatchType, gomock.Any(), gomock.Any()).
+				Return(vmi, nil)
+
+			syncInfo := controller.sync(backup)
+			Expect(syncInfo).ToNot(BeNil())
+			Expect(syncInfo.event).To(Equal(backupCompletedEvent))
+			_, err = k8sClient.CoreV1().Services(testNamespace).Get(context.Background(), backupServiceName(backupName), metav1.GetOptions{})
+			Expect(err).To(MatchError(ContainSubstring("not found")))
+
+			Expect(controller.updateStatus(backup, syncInfo, log.Log)).To(Succeed())
+			updated, err := kubevirtClient.BackupV1alpha1().VirtualMachineBackups(testNamespace).Get(context.Background(), backupName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.Status.Endpoint).To(BeNil())
+		})
+	})
 })
@@ -0,0 +1,342 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cbt
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	kutil "kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	// BackupNBDSocketName is the unix socket in the virt-launcher private
+	// directory qemu serves the exports of a pull mode backup on
+	BackupNBDSocketName = "virt-backup-nbd"
+	// BackupNBDPort is the port pull mode backup exports are published on
+	BackupNBDPort = 10809
+
+	backupTokenKey              = "token"
+	backupTokenHashAnnotation   = "backup.kubevirt.io/token-hash"
+	backupTokenSecretAnnotation = "backup.kubevirt.io/token-secret"
+	backupServicePrefix         = "backup-nbd"
+	backupServiceLabel          = "backup.kubevirt.io/virt-backup-service"
+	backupNBDPortName           = "nbd"
+
+	kubevirtCAConfigMapName = "kubevirt-ca"
+	caBundleKey             = "ca-bundle"
+	virtHandlerServiceName  = "virt-handler"
+
+	defaultPullBackupTTL = 2 * time.Hour
+
+	backupExportReadyEvent = "VirtualMachineBackupExportReady"
+	backupTTLExpiredEvent  = "VirtualMachineBackupTTLExpired"
+	backupExpiredEvent     = "VirtualMachineBackupExpired"
+
+	backupExportReady    = "Backup export is ready"
+	backupTTLExpiredMsg  = "Backup export TTL expired, stopping the backup"
+	backupExpiredMsg     = "Backup export TTL expired before the backup was stopped"
+	failedBackupStopMsg  = "failed to send Stop backup command: %w"
+	failedBackupTokenMsg = "failed to create the backup token: %w"
+)
+
+// BackupTokenHash is how the backup token is handed to the components serving
+// the export, so they can authenticate clients without learning the token itself
+func BackupTokenHash(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func isPullMode(backup *backupv1.VirtualMachineBackup) bool {
+	return backup.Spec.Mode != nil && *backup.Spec.Mode == backupv1.PullMode
+}
+
+func backupServiceName(backupName string) string {
+	// get rid of dots which are not allowed in DNS1035 labels
+	return naming.GetName(backupServicePrefix, strings.ReplaceAll(backupName, ".", "-"), validation.DNS1035LabelMaxLength)
+}
+
+func backupOwnerReference(backup *backupv1.VirtualMachineBackup) metav1.OwnerReference {
+	return *metav1.NewControllerRef(backup, backupv1.SchemeGroupVersion.WithKind("VirtualMachineBackup"))
+}
+
+// ensureBackupToken creates the secret holding the token clients authenticate with.
+// virt-controller cannot read secrets back, so the token hash and the secret
+// name are recorded on the backup when the secret is created.
+func (ctrl *VMBackupController) ensureBackupToken(backup *backupv1.VirtualMachineBackup) (*backupv1.VirtualMachineBackup, error) {
+	if backup.Annotations[backupTokenHashAnnotation] != "" {
+		return backup, nil
+	}
+
+	token, err := kutil.GenerateVMExportToken()
+	if err != nil {
+		return backup, fmt.Errorf(failedBackupTokenMsg, err)
+	}
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName:    fmt.Sprintf("%s-backup-token-", backup.Name),
+			Namespace:       backup.Namespace,
+			OwnerReferences: []metav1.OwnerReference{backupOwnerReference(backup)},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			backupTokenKey: []byte(token),
+		},
+	}
+	secret, err = ctrl.client.CoreV1().Secrets(backup.Namespace).Create(context.Background(), secret, metav1.CreateOptions{})
+	if err != nil {
+		return backup, fmt.Errorf(failedBackupTokenMsg, err)
+	}
+
+	annotations := map[string]string{}
+	for key, value := range backup.Annotations {
+		annotations[key] = value
+	}
+	annotations[backupTokenHashAnnotation] = BackupTokenHash(token)
+	annotations[backupTokenSecretAnnotation] = secret.Name
+
+	patchSet := patch.New()
+	if backup.Annotations == nil {
+		patchSet.AddOption(patch.WithAdd("/metadata/annotations", annotations))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/metadata/annotations", backup.Annotations),
+			patch.WithReplace("/metadata/annotations", annotations),
+		)
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return backup, err
+	}
+
+	return ctrl.client.VirtualMachineBackup(backup.Namespace).Patch(context.Background(), backup.Name, k8stypes.JSONPatchType, patchBytes, metav1.PatchOptions{})
+}
+
+// ensureBackupService publishes the virt-handler endpoint serving the export
+// through a selectorless Service in the namespace of the backup
+func (ctrl *VMBackupController) ensureBackupService(backup *backupv1.VirtualMachineBackup, pullEndpoint string) (*corev1.Service, error) {
+	host, portStr, err := net.SplitHostPort(pullEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup pull endpoint %q: %w", pullEndpoint, err)
+	}
+	port, err := strconv.ParseInt(portStr, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup pull endpoint %q: %w", pullEndpoint, err)
+	}
+
+	name := backupServiceName(backup.Name)
+	objectMeta := metav1.ObjectMeta{
+		Name:            name,
+		Namespace:       backup.Namespace,
+		Labels:          map[string]string{backupServiceLabel: backup.Name},
+		OwnerReferences: []metav1.OwnerReference{backupOwnerReference(backup)},
+	}
+
+	service, err := ctrl.client.CoreV1().Services(backup.Namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		service, err = ctrl.client.CoreV1().Services(backup.Namespace).Create(context.Background(), &corev1.Service{
+			ObjectMeta: objectMeta,
+			Spec: corev1.ServiceSpec{
+				Ports: []corev1.ServicePort{
+					{
+						Name:       backupNBDPortName,
+						Protocol:   corev1.ProtocolTCP,
+						Port:       BackupNBDPort,
+						TargetPort: intstr.FromInt32(int32(port)),
+					},
+				},
+			},
+		}, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: objectMeta,
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses: []corev1.EndpointAddress{{IP: host}},
+				Ports: []corev1.EndpointPort{
+					{
+						Name:     backupNBDPortName,
+						Protocol: corev1.ProtocolTCP,
+						Port:     int32(port),
+					},
+				},
+			},
+		},
+	}
+	current, err := ctrl.client.CoreV1().Endpoints(backup.Namespace).Get(context.Background(), name, metav1.GetOptions{})
+	switch {
+	case errors.IsNotFound(err):
+		_, err = ctrl.client.CoreV1().Endpoints(backup.Namespace).Create(context.Background(), endpoints, metav1.CreateOptions{})
+	case err == nil && !equality.Semantic.DeepEqual(current.Subsets, endpoints.Subsets):
+		current = current.DeepCopy()
+		current.Subsets = endpoints.Subsets
+		_, err = ctrl.client.CoreV1().Endpoints(backup.Namespace).Update(context.Background(), current, metav1.UpdateOptions{})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func (ctrl *VMBackupController) deleteBackupService(backup *backupv1.VirtualMachineBackup) error {
+	name := backupServiceName(backup.Name)
+	err := ctrl.client.CoreV1().Services(backup.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	err = ctrl.client.CoreV1().Endpoints(backup.Namespace).Delete(context.Background(), name, metav1.DeleteOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (ctrl *VMBackupController) kubevirtCA() string {
+	if ctrl.caConfigMapStore == nil {
+		return ""
+	}
+	obj, exists, err := ctrl.caConfigMapStore.GetByKey(cacheKeyFunc(ctrl.kubevirtNamespace, kubevirtCAConfigMapName))
+	if err != nil || !exists {
+		return ""
+	}
+	return strings.TrimSpace(obj.(*corev1.ConfigMap).Data[caBundleKey])
+}
+
+func (ctrl *VMBackupController) backupEndpoint(backup *backupv1.VirtualMachineBackup, service *corev1.Service, backupStatus *v1.VirtualMachineInstanceBackupStatus) *backupv1.BackupEndpoint {
+	endpoint := &backupv1.BackupEndpoint{
+		ServiceName:    service.Name,
+		Port:           BackupNBDPort,
+		TLSServerName:  fmt.Sprintf("%s.%s.svc", virtHandlerServiceName, ctrl.kubevirtNamespace),
+		CACert:         ctrl.kubevirtCA(),
+		TokenSecretRef: backup.Annotations[backupTokenSecretAnnotation],
+	}
+	for _, export := range backupStatus.Exports {
+		endpoint.Exports = append(endpoint.Exports, backupv1.BackupExport{
+			VolumeName:  export.VolumeName,
+			ExportName:  export.ExportName,
+			DirtyBitmap: export.DirtyBitmap,
+		})
+	}
+	return endpoint
+}
+
+func pullBackupDeadline(backup *backupv1.VirtualMachineBackup, backupStatus *v1.VirtualMachineInstanceBackupStatus) time.Time {
+	start := backup.CreationTimestamp.Time
+	if backupStatus.StartTimestamp != nil {
+		start = backupStatus.StartTimestamp.Time
+	}
+	ttl := defaultPullBackupTTL
+	if backup.Spec.TTLDuration != nil {
+		ttl = backup.Spec.TTLDuration.Duration
+	}
+	return start.Add(ttl)
+}
+
+func (ctrl *VMBackupController) stopBackup(backup *backupv1.VirtualMachineBackup, vmi *v1.VirtualMachineInstance) error {
+	backupOptions := &backupv1.BackupOptions{
+		BackupName: backup.Name,
+		Cmd:        backupv1.Stop,
+	}
+	if err := ctrl.client.VirtualMachineInstance(vmi.Namespace).Backup(context.Background(), vmi.Name, backupOptions); err != nil {
+		return fmt.Errorf(failedBackupStopMsg, err)
+	}
+	return nil
+}
+
+// syncPullBackupExport publishes the export of a running pull mode backup
+// and stops the backup once its TTL expired. A backup stopped by its TTL was
+// not necessarily pulled, so it is marked as failed instead of completed.
+func (ctrl *VMBackupController) syncPullBackupExport(backup *backupv1.VirtualMachineBackup, vmi *v1.VirtualMachineInstance) *SyncInfo {
+	logger := log.Log.With("VirtualMachineBackup", backup.Name)
+	backupStatus := vmi.Status.ChangedBlockTracking.BackupStatus
+
+	if remaining := time.Until(pullBackupDeadline(backup, backupStatus)); remaining > 0 {
+		ctrl.backupQueue.AddAfter(cacheKeyFunc(backup.Namespace, backup.Name), remaining)
+	} else {
+		logger.Info(backupTTLExpiredMsg)
+		if err := ctrl.stopBackup(backup, vmi); err != nil {
+			logger.Error(err.Error())
+			return syncInfoError(err)
+		}
+		return &SyncInfo{
+			event:  backupTTLExpiredEvent,
+			reason: backupExpiredMsg,
+		}
+	}
+
+	if backupStatus.PullEndpoint == nil {
+		return nil
+	}
+
+	service, err := ctrl.ensureBackupService(backup, *backupStatus.PullEndpoint)
+	if err != nil {
+		err = fmt.Errorf("failed to publish backup export: %w", err)
+		logger.Error(err.Error())
+		return syncInfoError(err)
+	}
+
+	syncInfo := &SyncInfo{
+		event:    backupExportReadyEvent,
+		reason:   backupExportReady,
+		endpoint: ctrl.backupEndpoint(backup, service, backupStatus),
+	}
+	if backupStatus.CheckpointName != nil {
+		syncInfo.checkpointName = *backupStatus.CheckpointName
+	}
+	return syncInfo
+}
+
+func isBackupExpired(status *backupv1.VirtualMachineBackupStatus) bool {
+	return status != nil && hasCondition(status.Conditions, backupv1.ConditionFailure)
+}
+
+func (ctrl *VMBackupController) cleanupPullBackupExport(backup *backupv1.VirtualMachineBackup) *SyncInfo {
+	if err := ctrl.deleteBackupService(backup); err != nil {
+		err = fmt.Errorf("failed to delete backup service: %w", err)
+		log.Log.With("VirtualMachineBackup", backup.Name).Error(err.Error())
+		return syncInfoError(err)
+	}
+	return nil
+}
@@ -22,6 +22,7 @@ package cbt
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,12 +38,24 @@ import (
 
 const (
 	backupTargetPVCPrefix = "backup-target-pvc"
+	backupDirTimeFormat   = "2006-01-02_15-04-05"
 )
 
 func backupTargetVolumeName(backupName string) string {
 	return fmt.Sprintf("%s-%s", backupName, backupTargetPVCPrefix)
 }
 
+// BackupDir returns the directory a backup of the VMI writes its volumes to,
+// relative to the root of the backup target PVC
+func BackupDir(vmiName, backupName string, startTime *metav1.Time) string {
+	return filepath.Join(vmiName, fmt.Sprintf("%s-%s", backupName, startTime.UTC().Format(backupDirTimeFormat)))
+}
+
+// BackupVolumeFileName returns the name of the qcow2 file a push mode backup writes the volume to
+func BackupVolumeFileName(backupName, volumeName string) string {
+	return fmt.Sprintf("%s-%s.qcow2", backupName, volumeName)
+}
+
 var (
 	failedTargetPVCAttach       = "failed to attach target backup pvc: %s"
 	failedTargetPVCDetach       = "failed to detach target backup pvc: %s"
@@ -0,0 +1,45 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package cronschedule holds the cron handling shared by the snapshot and backup schedules
+package cronschedule
+
+import (
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Parse parses the standard cron expression of a schedule
+func Parse(schedule string) (cron.Schedule, error) {
+	return cron.ParseStandard(schedule)
+}
+
+// MostRecentSlot returns the latest time the schedule fired at since lastSchedule,
+// missed slots are collapsed into a single run
+func MostRecentSlot(cronSchedule cron.Schedule, lastSchedule, now time.Time) (time.Time, bool) {
+	slot := cronSchedule.Next(lastSchedule)
+	if slot.IsZero() || slot.After(now) {
+		return time.Time{}, false
+	}
+	for next := cronSchedule.Next(slot); !next.IsZero() && !next.After(now); next = cronSchedule.Next(next) {
+		slot = next
+	}
+	return slot, true
+}
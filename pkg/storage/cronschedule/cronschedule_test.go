@@ -0,0 +1,52 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package cronschedule
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("cron schedule", func() {
+	It("should reject an invalid expression", func() {
+		_, err := Parse("every hour")
+		Expect(err).To(HaveOccurred())
+	})
+
+	DescribeTable("should return the most recent slot", func(lastSchedule, now time.Time, expectedSlot time.Time, expectedDue bool) {
+		cronSchedule, err := Parse("0 * * * *")
+		Expect(err).ToNot(HaveOccurred())
+		slot, due := MostRecentSlot(cronSchedule, lastSchedule, now)
+		Expect(due).To(Equal(expectedDue))
+		Expect(slot).To(Equal(expectedSlot))
+	},
+		Entry("when no slot passed",
+			time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 10, 59, 0, 0, time.UTC),
+			time.Time{}, false),
+		Entry("when a slot passed",
+			time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 11, 30, 0, 0, time.UTC),
+			time.Date(2026, 1, 1, 11, 0, 0, 0, time.UTC), true),
+		Entry("collapsing the missed slots",
+			time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 14, 10, 0, 0, time.UTC),
+			time.Date(2026, 1, 1, 14, 0, 0, 0, time.UTC), true),
+	)
+})
@@ -0,0 +1,82 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package encryption
+
+import (
+	"path/filepath"
+	"sort"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/config"
+)
+
+const (
+	// PassphraseKey is the key of the Secret data holding the LUKS passphrase
+	PassphraseKey = "passphrase"
+
+	secretVolumeSuffix = "-disk-encryption"
+)
+
+var secretUUIDns = uuid.MustParse("3c3f4a4e-1e44-4d5a-9a55-2f8d5b0c7d21")
+
+// IsEncrypted returns true if the disk is encrypted with LUKS
+func IsEncrypted(disk *v1.Disk) bool {
+	return disk.Encryption != nil && disk.Encryption.SecretRef.Name != ""
+}
+
+// SecretNames returns the sorted names of the Secrets referenced by the encrypted disks of the VMI
+func SecretNames(vmi *v1.VirtualMachineInstance) []string {
+	seen := map[string]struct{}{}
+	var names []string
+	for i := range vmi.Spec.Domain.Devices.Disks {
+		disk := &vmi.Spec.Domain.Devices.Disks[i]
+		if !IsEncrypted(disk) {
+			continue
+		}
+		if _, ok := seen[disk.Encryption.SecretRef.Name]; ok {
+			continue
+		}
+		seen[disk.Encryption.SecretRef.Name] = struct{}{}
+		names = append(names, disk.Encryption.SecretRef.Name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// SecretVolumeName returns the name of the pod volume projecting the Secret into virt-launcher
+func SecretVolumeName(secretName string) string {
+	return secretName + secretVolumeSuffix
+}
+
+// PassphrasePath returns the path of the passphrase inside virt-launcher
+func PassphrasePath(secretName string) string {
+	return filepath.Join(config.SecretSourceDir, SecretVolumeName(secretName), PassphraseKey)
+}
+
+// SecretUUID returns the UUID of the libvirt secret of an encrypted disk.
+// It only depends on the VMI and the disk, so that source and target of a
+// migration agree on it.
+func SecretUUID(vmiUID types.UID, diskName string) string {
+	return uuid.NewSHA1(secretUUIDns, []byte(string(vmiUID)+"/"+diskName)).String()
+}
@@ -0,0 +1,55 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package encryption
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Disk encryption", func() {
+	It("should return the unique secrets of the encrypted disks", func() {
+		vmi := libvmi.New(
+			libvmi.WithPersistentVolumeClaim("plain", "plain-pvc"),
+			libvmi.WithPersistentVolumeClaim("disk1", "pvc1", libvmi.WithDiskEncryption("secret-b")),
+			libvmi.WithPersistentVolumeClaim("disk2", "pvc2", libvmi.WithDiskEncryption("secret-a")),
+			libvmi.WithDataVolume("disk3", "dv3", libvmi.WithDiskEncryption("secret-b")),
+		)
+		Expect(SecretNames(vmi)).To(Equal([]string{"secret-a", "secret-b"}))
+	})
+
+	It("should not consider a disk without secret as encrypted", func() {
+		Expect(IsEncrypted(&v1.Disk{Encryption: &v1.DiskEncryption{}})).To(BeFalse())
+	})
+
+	It("should return the passphrase path of a secret", func() {
+		Expect(PassphrasePath("my-secret")).To(Equal("/var/run/kubevirt-private/secret/my-secret-disk-encryption/passphrase"))
+	})
+
+	It("should return a stable secret UUID per VMI and disk", func() {
+		Expect(SecretUUID("uid", "disk1")).To(Equal(SecretUUID("uid", "disk1")))
+		Expect(SecretUUID("uid", "disk1")).ToNot(Equal(SecretUUID("uid", "disk2")))
+		Expect(SecretUUID("uid", "disk1")).ToNot(Equal(SecretUUID("other", "disk1")))
+	})
+})
@@ -0,0 +1,381 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/cache"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/cbt"
+)
+
+const (
+	vmBackupNotFoundReason  = "VMBackupNotFound"
+	vmBackupNotDoneReason   = "VMBackupNotDone"
+	vmBackupNotPushReason   = "VMBackupNotPushMode"
+	vmBackupNoVolumesReason = "VMBackupNoVolumes"
+
+	backupCheckpointKey       = "backup-checkpoint"
+	backupCheckpointData      = "backup-checkpoint-data"
+	backupCheckpointMountPath = "/backup_data"
+	backupCheckpointPath      = "/manifests/backup-checkpoint"
+)
+
+// BackupCheckpoint is the checkpoint metadata of an exported VirtualMachineBackup
+type BackupCheckpoint struct {
+	// BackupName is the name of the exported VirtualMachineBackup
+	BackupName string `json:"backupName"`
+	// Type indicates if the backup is full or incremental
+	Type backupv1.BackupType `json:"type,omitempty"`
+	// CheckpointName is the name of the checkpoint created by the backup
+	CheckpointName string `json:"checkpointName,omitempty"`
+	// BaseCheckpointName is the checkpoint an incremental backup is based on
+	BaseCheckpointName string `json:"baseCheckpointName,omitempty"`
+	// VirtualMachine is the manifest of the VM captured when the backup started
+	VirtualMachine *backupv1.VirtualMachine `json:"virtualMachine,omitempty"`
+	// Volumes describes the exported volumes
+	Volumes []backupv1.BackupVolume `json:"volumes,omitempty"`
+}
+
+func backupQcow2URI(volumeName string) string {
+	return path.Join(urlBasePath, volumeName, "backup.qcow2")
+}
+
+func changedExtentsURI(volumeName string) string {
+	return path.Join(urlBasePath, volumeName, "changed-extents")
+}
+
+type BackupSource struct {
+	sourceVolumes *sourceVolumes
+	backup        *backupv1.VirtualMachineBackup
+}
+
+func NewBackupSource(sourceVolumes *sourceVolumes, backup *backupv1.VirtualMachineBackup) *BackupSource {
+	return &BackupSource{
+		sourceVolumes: sourceVolumes,
+		backup:        backup,
+	}
+}
+
+func (s *BackupSource) IsSourceAvailable() bool {
+	return s.sourceVolumes.isSourceAvailable()
+}
+
+func (s *BackupSource) HasContent() bool {
+	return s.sourceVolumes.hasContent()
+}
+
+func (s *BackupSource) SourceCondition() exportv1.Condition {
+	return s.sourceVolumes.sourceCondition
+}
+
+func (s *BackupSource) ReadyCondition() exportv1.Condition {
+	return s.sourceVolumes.readyCondition
+}
+
+func (s *BackupSource) ServicePorts() []corev1.ServicePort {
+	return []corev1.ServicePort{exportPort()}
+}
+
+// mountPoint returns where the backup PVC is mounted in the exporter pod
+func (s *BackupSource) mountPoint() string {
+	return fmt.Sprintf("%s/%s", fileSystemMountPath, getExportPodVolumeName(s.sourceVolumes.volumes[0].pvc))
+}
+
+// ConfigurePod mounts the backup PVC, each backed up volume is exported from its QCOW2 file
+func (s *BackupSource) ConfigurePod(pod *corev1.Pod) {
+	if !s.HasContent() {
+		return
+	}
+	pvc := s.sourceVolumes.volumes[0].pvc
+	volumeName := getExportPodVolumeName(pvc)
+	mountPoint := s.mountPoint()
+	container := &pod.Spec.Containers[0]
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      volumeName,
+		ReadOnly:  true,
+		MountPath: mountPoint,
+	})
+	pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: pvc.Name,
+				ReadOnly:  true,
+			},
+		},
+	})
+	for i, volume := range backedUpVolumes(s.backup) {
+		container.Env = append(container.Env, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_PATH", i),
+			Value: path.Join(mountPoint, volume.Path),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_BACKUP_QCOW2_URI", i),
+			Value: backupQcow2URI(volume.VolumeName),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_CHANGED_EXTENTS_URI", i),
+			Value: changedExtentsURI(volume.VolumeName),
+		})
+	}
+}
+
+func (s *BackupSource) ConfigureExportLink(exportLink *exportv1.VirtualMachineExportLink, paths *ServerPaths, vmExport *exportv1.VirtualMachineExport, pod *corev1.Pod, hostAndBase, scheme string) {
+	if pod.Status.Phase != corev1.PodRunning || !s.HasContent() {
+		return
+	}
+
+	mountPoint := s.mountPoint()
+	for _, volume := range backedUpVolumes(s.backup) {
+		volumeInfo := paths.getVolumeInfoByPath(path.Join(mountPoint, volume.Path))
+		if volumeInfo == nil || volumeInfo.BackupQcow2URI == "" {
+			log.Log.Warningf("Backup of volume %s not found in paths", volume.VolumeName)
+			continue
+		}
+
+		ev := exportv1.VirtualMachineExportVolume{
+			Name: volume.VolumeName,
+			Formats: []exportv1.VirtualMachineExportVolumeFormat{
+				{
+					Format: exportv1.BackupQcow2,
+					Url:    scheme + path.Join(hostAndBase, volumeInfo.BackupQcow2URI),
+				},
+			},
+		}
+		if volumeInfo.ChangedExtentsURI != "" {
+			ev.ChangedExtentsUrl = scheme + path.Join(hostAndBase, volumeInfo.ChangedExtentsURI)
+		}
+		exportLink.Volumes = append(exportLink.Volumes, ev)
+	}
+}
+
+func (s *BackupSource) UpdateStatus(vmExport *exportv1.VirtualMachineExport, pod *corev1.Pod, svc *corev1.Service) (time.Duration, error) {
+	var requeue time.Duration
+
+	if vmName := backupVMName(s.backup); vmName != "" {
+		vmExport.Status.VirtualMachineName = pointer.P(vmName)
+	}
+
+	vmExport.Status.Conditions = updateCondition(vmExport.Status.Conditions, s.SourceCondition())
+	if !s.HasContent() {
+		switch s.SourceCondition().Reason {
+		case vmBackupNotFoundReason, vmBackupNotPushReason, vmBackupNoVolumesReason:
+			vmExport.Status.Phase = exportv1.Skipped
+		}
+	} else if !s.IsSourceAvailable() {
+		log.Log.V(4).Infof("Source is not available %s, requeuing", s.SourceCondition().Message)
+		requeue = requeueTime
+	}
+
+	return requeue, nil
+}
+
+// backedUpVolumes returns the volumes of a push mode backup which were written to the backup PVC
+func backedUpVolumes(backup *backupv1.VirtualMachineBackup) []backupv1.BackupVolume {
+	var volumes []backupv1.BackupVolume
+	if backup == nil || backup.Status == nil {
+		return nil
+	}
+	for _, volume := range backup.Status.Volumes {
+		if volume.Path != "" {
+			volumes = append(volumes, volume)
+		}
+	}
+	return volumes
+}
+
+func backupVMName(backup *backupv1.VirtualMachineBackup) string {
+	if backup == nil {
+		return ""
+	}
+	if backup.Status != nil && backup.Status.VirtualMachine != nil && backup.Status.VirtualMachine.Name != "" {
+		return backup.Status.VirtualMachine.Name
+	}
+	if backup.Spec.Source.Kind == "VirtualMachine" {
+		return backup.Spec.Source.Name
+	}
+	return ""
+}
+
+func newBackupCheckpoint(backup *backupv1.VirtualMachineBackup) *BackupCheckpoint {
+	checkpoint := &BackupCheckpoint{
+		BackupName: backup.Name,
+		Volumes:    backedUpVolumes(backup),
+	}
+	if backup.Status != nil {
+		checkpoint.Type = backup.Status.Type
+		checkpoint.VirtualMachine = backup.Status.VirtualMachine
+		if backup.Status.CheckpointName != nil {
+			checkpoint.CheckpointName = *backup.Status.CheckpointName
+		}
+		if backup.Status.BaseCheckpointName != nil {
+			checkpoint.BaseCheckpointName = *backup.Status.BaseCheckpointName
+		}
+	}
+	return checkpoint
+}
+
+func (ctrl *VMExportController) handleVMBackup(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if backup, ok := obj.(*backupv1.VirtualMachineBackup); ok {
+		backupKey, _ := cache.MetaNamespaceKeyFunc(backup)
+		keys, err := ctrl.VMExportInformer.GetIndexer().IndexKeys("vmbackup", backupKey)
+		if err != nil {
+			utilruntime.HandleError(err)
+			return
+		}
+		for _, key := range keys {
+			log.Log.V(3).Infof("Adding VMExport due to VMBackup %s", backupKey)
+			ctrl.vmExportQueue.Add(key)
+		}
+	}
+}
+
+func (ctrl *VMExportController) isSourceVMBackup(source *exportv1.VirtualMachineExportSpec) bool {
+	return source != nil && source.Source.APIGroup != nil && *source.Source.APIGroup == backupv1.SchemeGroupVersion.Group && source.Source.Kind == "VirtualMachineBackup"
+}
+
+func (ctrl *VMExportController) getVMBackup(namespace, name string) (*backupv1.VirtualMachineBackup, bool, error) {
+	key := controller.NamespacedKey(namespace, name)
+	obj, exists, err := ctrl.VMBackupInformer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return nil, exists, err
+	}
+	return obj.(*backupv1.VirtualMachineBackup).DeepCopy(), true, nil
+}
+
+func (ctrl *VMExportController) getPVCFromSourceVMBackup(vmExport *exportv1.VirtualMachineExport) (*sourceVolumes, *backupv1.VirtualMachineBackup, error) {
+	backupName := fmt.Sprintf("%s/%s", vmExport.Namespace, vmExport.Spec.Source.Name)
+	notReady := func(reason, message string) *sourceVolumes {
+		return &sourceVolumes{
+			volumes:         nil,
+			inUse:           false,
+			isPopulated:     false,
+			readyCondition:  newReadyCondition(corev1.ConditionFalse, reason, message),
+			sourceCondition: newPvcCondition(corev1.ConditionFalse, reason, message),
+		}
+	}
+
+	backup, exists, err := ctrl.getVMBackup(vmExport.Namespace, vmExport.Spec.Source.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !exists {
+		return notReady(vmBackupNotFoundReason, fmt.Sprintf("VirtualMachineBackup %s not found", backupName)), nil, nil
+	}
+	if (backup.Spec.Mode != nil && *backup.Spec.Mode != backupv1.PushMode) || backup.Spec.PvcName == nil {
+		return notReady(vmBackupNotPushReason, fmt.Sprintf("VirtualMachineBackup %s is not a push mode backup", backupName)), backup, nil
+	}
+	if !cbt.IsBackupDone(backup.Status) {
+		return notReady(vmBackupNotDoneReason, fmt.Sprintf("VirtualMachineBackup %s is not done", backupName)), backup, nil
+	}
+	if len(backedUpVolumes(backup)) == 0 {
+		return notReady(vmBackupNoVolumesReason, fmt.Sprintf("VirtualMachineBackup %s does not contain any volumes", backupName)), backup, nil
+	}
+
+	pvc, exists, err := ctrl.getPvc(vmExport.Namespace, *backup.Spec.PvcName)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !exists {
+		return notReady(pvcNotFoundReason, fmt.Sprintf("PersistentVolumeClaim %s/%s not found", vmExport.Namespace, *backup.Spec.PvcName)), backup, nil
+	}
+
+	sourceVolumes, err := ctrl.isSourceAvailablePVC(vmExport, pvc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sourceVolumes, backup, nil
+}
+
+func (ctrl *VMExportController) getBackupCheckpointConfigMapName(vmExport *exportv1.VirtualMachineExport) string {
+	return fmt.Sprintf("exporter-backup-checkpoint-%s", vmExport.Name)
+}
+
+// createBackupCheckpointAndAddToPod stores the checkpoint metadata of the exported backup in a
+// config map which is mounted in the exporter pod
+func (ctrl *VMExportController) createBackupCheckpointAndAddToPod(vmExport *exportv1.VirtualMachineExport, podManifest *corev1.Pod) error {
+	backup, exists, err := ctrl.getVMBackup(vmExport.Namespace, vmExport.Spec.Source.Name)
+	if err != nil || !exists {
+		return err
+	}
+	checkpointBytes, err := json.Marshal(newBackupCheckpoint(backup))
+	if err != nil {
+		return err
+	}
+	checkpointConfigMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: vmExport.Namespace,
+			Name:      ctrl.getBackupCheckpointConfigMapName(vmExport),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vmExport, exportGVK),
+			},
+		},
+		Data: map[string]string{
+			backupCheckpointKey: string(checkpointBytes),
+		},
+	}
+	cm, err := ctrl.Client.CoreV1().ConfigMaps(vmExport.Namespace).Create(context.Background(), checkpointConfigMap, metav1.CreateOptions{})
+	if err != nil {
+		if !errors.IsAlreadyExists(err) {
+			return err
+		}
+	} else {
+		ctrl.Recorder.Eventf(vmExport, corev1.EventTypeNormal, exporterManifestConfigMapCreatedEvent, "Created exporter backup checkpoint %s/%s", cm.Namespace, cm.Name)
+	}
+
+	container := &podManifest.Spec.Containers[0]
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  "EXPORT_BACKUP_CHECKPOINT_URI",
+		Value: backupCheckpointPath,
+	})
+	container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+		Name:      backupCheckpointData,
+		MountPath: backupCheckpointMountPath,
+	})
+	podManifest.Spec.Volumes = append(podManifest.Spec.Volumes, corev1.Volume{
+		Name: backupCheckpointData,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{
+					Name: checkpointConfigMap.Name,
+				},
+			},
+		},
+	})
+	return nil
+}
@@ -0,0 +1,482 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	k8sv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	virtv1 "kubevirt.io/api/core/v1"
+	exportv1 "kubevirt.io/api/export/v1beta1"
+	instancetypev1beta1 "kubevirt.io/api/instancetype/v1beta1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+)
+
+const (
+	testVMBackupName  = "test-vmbackup"
+	testBackupPVCName = "backup-target"
+)
+
+var _ = Describe("VMBackup source", func() {
+	var (
+		ctrl                        *gomock.Controller
+		controller                  *VMExportController
+		recorder                    *record.FakeRecorder
+		pvcInformer                 cache.SharedIndexInformer
+		podInformer                 cache.SharedIndexInformer
+		cmInformer                  cache.SharedIndexInformer
+		vmExportInformer            cache.SharedIndexInformer
+		serviceInformer             cache.SharedIndexInformer
+		dvInformer                  cache.SharedIndexInformer
+		vmSnapshotInformer          cache.SharedIndexInformer
+		vmSnapshotContentInformer   cache.SharedIndexInformer
+		vmBackupInformer            cache.SharedIndexInformer
+		secretInformer              cache.SharedIndexInformer
+		vmInformer                  cache.SharedIndexInformer
+		vmiInformer                 cache.SharedIndexInformer
+		kvInformer                  cache.SharedIndexInformer
+		crdInformer                 cache.SharedIndexInformer
+		instancetypeInformer        cache.SharedIndexInformer
+		clusterInstancetypeInformer cache.SharedIndexInformer
+		preferenceInformer          cache.SharedIndexInformer
+		clusterPreferenceInformer   cache.SharedIndexInformer
+		controllerRevisionInformer  cache.SharedIndexInformer
+		rqInformer                  cache.SharedIndexInformer
+		nsInformer                  cache.SharedIndexInformer
+		k8sClient                   *k8sfake.Clientset
+		vmExportClient              *kubevirtfake.Clientset
+		fakeVolumeSnapshotProvider  *MockVolumeSnapshotProvider
+		fakeCertManager             *MockCertManager
+		mockVMExportQueue           *testutils.MockWorkQueue[string]
+		routeCache                  cache.Store
+		ingressCache                cache.Store
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		var err error
+		Expect(err).ToNot(HaveOccurred())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		pvcInformer, _ = testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		cmInformer, _ = testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
+		serviceInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Service{})
+		vmExportInformer, _ = testutils.NewFakeInformerWithIndexersFor(&exportv1.VirtualMachineExport{}, virtcontroller.GetVirtualMachineExportInformerIndexers())
+		dvInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
+		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
+		vmSnapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		vmBackupInformer, _ = testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackup{})
+		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
+		routeInformer, _ := testutils.NewFakeInformerFor(&routev1.Route{})
+		routeCache = routeInformer.GetStore()
+		ingressInformer, _ := testutils.NewFakeInformerFor(&networkingv1.Ingress{})
+		ingressCache = ingressInformer.GetStore()
+		secretInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Secret{})
+		kvInformer, _ = testutils.NewFakeInformerFor(&virtv1.KubeVirt{})
+		crdInformer, _ = testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
+		instancetypeInformer, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineInstancetype{})
+		clusterInstancetypeInformer, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
+		preferenceInformer, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachinePreference{})
+		clusterPreferenceInformer, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterPreference{})
+		controllerRevisionInformer, _ = testutils.NewFakeInformerFor(&appsv1.ControllerRevision{})
+		rqInformer, _ = testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
+		nsInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		fakeVolumeSnapshotProvider = &MockVolumeSnapshotProvider{
+			volumeSnapshots: []*vsv1.VolumeSnapshot{},
+		}
+		fakeCertManager = &MockCertManager{}
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{})
+		k8sClient = k8sfake.NewSimpleClientset()
+		vmExportClient = kubevirtfake.NewSimpleClientset()
+		recorder = record.NewFakeRecorder(100)
+
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachineExport(testNamespace).
+			Return(vmExportClient.ExportV1beta1().VirtualMachineExports(testNamespace)).AnyTimes()
+
+		controller = &VMExportController{
+			Client:                      virtClient,
+			Recorder:                    recorder,
+			PVCInformer:                 pvcInformer,
+			PodInformer:                 podInformer,
+			ConfigMapInformer:           cmInformer,
+			VMExportInformer:            vmExportInformer,
+			ServiceInformer:             serviceInformer,
+			DataVolumeInformer:          dvInformer,
+			KubevirtNamespace:           "kubevirt",
+			ManifestRenderer:            services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", rqInformer.GetStore(), nsInformer.GetStore()),
+			caCertManager:               fakeCertManager,
+			RouteCache:                  routeCache,
+			IngressCache:                ingressCache,
+			RouteConfigMapInformer:      cmInformer,
+			SecretInformer:              secretInformer,
+			VMSnapshotInformer:          vmSnapshotInformer,
+			VMSnapshotContentInformer:   vmSnapshotContentInformer,
+			VMBackupInformer:            vmBackupInformer,
+			VolumeSnapshotProvider:      fakeVolumeSnapshotProvider,
+			VMInformer:                  vmInformer,
+			VMIInformer:                 vmiInformer,
+			CRDInformer:                 crdInformer,
+			KubeVirtInformer:            kvInformer,
+			InstancetypeInformer:        instancetypeInformer,
+			ClusterInstancetypeInformer: clusterInstancetypeInformer,
+			PreferenceInformer:          preferenceInformer,
+			ClusterPreferenceInformer:   clusterPreferenceInformer,
+			ControllerRevisionInformer:  controllerRevisionInformer,
+		}
+		initCert = func(ctrl *VMExportController) {
+			ctrl.caCertManager.Start()
+			Expect(ctrl.caCertManager.Current()).ToNot(BeNil())
+		}
+
+		controller.Init()
+		mockVMExportQueue = testutils.NewMockWorkQueue(controller.vmExportQueue)
+		controller.vmExportQueue = mockVMExportQueue
+
+		Expect(
+			cmInformer.GetStore().Add(&k8sv1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: controller.KubevirtNamespace,
+					Name:      components.KubeVirtExportCASecretName,
+				},
+				Data: map[string]string{
+					"ca-bundle": "replace me with ca cert",
+				},
+			}),
+		).To(Succeed())
+
+		Expect(
+			kvInformer.GetStore().Add(&virtv1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: controller.KubevirtNamespace,
+					Name:      "kv",
+				},
+				Spec: virtv1.KubeVirtSpec{
+					CertificateRotationStrategy: virtv1.KubeVirtCertificateRotateStrategy{
+						SelfSigned: &virtv1.KubeVirtSelfSignConfiguration{
+							CA: &virtv1.CertConfig{
+								Duration:    &metav1.Duration{Duration: 24 * time.Hour},
+								RenewBefore: &metav1.Duration{Duration: 3 * time.Hour},
+							},
+							Server: &virtv1.CertConfig{
+								Duration:    &metav1.Duration{Duration: 2 * time.Hour},
+								RenewBefore: &metav1.Duration{Duration: 1 * time.Hour},
+							},
+						},
+					},
+				},
+				Status: virtv1.KubeVirtStatus{
+					Phase: virtv1.KubeVirtPhaseDeployed,
+				},
+			}),
+		).To(Succeed())
+	})
+
+	createBackupVMExport := func() *exportv1.VirtualMachineExport {
+		return &exportv1.VirtualMachineExport{
+			ObjectMeta: createVMExportMeta(vmExportName),
+			Spec: exportv1.VirtualMachineExportSpec{
+				Source: k8sv1.TypedLocalObjectReference{
+					APIGroup: &backupv1.SchemeGroupVersion.Group,
+					Kind:     "VirtualMachineBackup",
+					Name:     testVMBackupName,
+				},
+				TokenSecretRef: &tokenSecretName,
+			},
+		}
+	}
+
+	createTestVMBackup := func(done bool) *backupv1.VirtualMachineBackup {
+		doneStatus := k8sv1.ConditionFalse
+		if done {
+			doneStatus = k8sv1.ConditionTrue
+		}
+		return &backupv1.VirtualMachineBackup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      testVMBackupName,
+				Namespace: testNamespace,
+			},
+			Spec: backupv1.VirtualMachineBackupSpec{
+				Source: k8sv1.TypedLocalObjectReference{
+					APIGroup: &virtv1.SchemeGroupVersion.Group,
+					Kind:     "VirtualMachine",
+					Name:     testVmName,
+				},
+				Mode:    pointer.P(backupv1.PushMode),
+				PvcName: pointer.P(testBackupPVCName),
+			},
+			Status: &backupv1.VirtualMachineBackupStatus{
+				Type:               backupv1.Incremental,
+				CheckpointName:     pointer.P("checkpoint-2"),
+				BaseCheckpointName: pointer.P("checkpoint-1"),
+				Conditions: []backupv1.Condition{
+					{
+						Type:   backupv1.ConditionDone,
+						Status: doneStatus,
+					},
+				},
+				Volumes: []backupv1.BackupVolume{
+					{
+						VolumeName: "rootdisk",
+						Path:       "test-vmbackup/rootdisk.qcow2",
+					},
+					{
+						VolumeName: "datadisk",
+						Path:       "test-vmbackup/datadisk.qcow2",
+					},
+					{
+						// not written to the backup PVC
+						VolumeName: "cloudinit",
+					},
+				},
+			},
+		}
+	}
+
+	expectSkipped := func(reason, message string) {
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			verifyLinksEmpty(vmExport)
+			Expect(vmExport.Status.Phase).To(Equal(exportv1.Skipped))
+			Expect(vmExport.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", exportv1.ConditionPVC),
+				HaveField("Status", k8sv1.ConditionFalse),
+				HaveField("Reason", reason),
+				HaveField("Message", message),
+			)))
+			return true, vmExport, nil
+		})
+	}
+
+	It("should skip the export when the VMBackup does not exist", func() {
+		testVMExport := createBackupVMExport()
+		expectSkipped(vmBackupNotFoundReason, fmt.Sprintf("VirtualMachineBackup %s/%s not found", testNamespace, testVMBackupName))
+
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+	})
+
+	It("should skip the export of a pull mode VMBackup", func() {
+		testVMExport := createBackupVMExport()
+		backup := createTestVMBackup(true)
+		backup.Spec.Mode = pointer.P(backupv1.PullMode)
+		Expect(vmBackupInformer.GetStore().Add(backup)).To(Succeed())
+		expectSkipped(vmBackupNotPushReason, fmt.Sprintf("VirtualMachineBackup %s/%s is not a push mode backup", testNamespace, testVMBackupName))
+
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+	})
+
+	It("should skip the export of a VMBackup without backed up volumes", func() {
+		testVMExport := createBackupVMExport()
+		backup := createTestVMBackup(true)
+		backup.Status.Volumes = nil
+		Expect(vmBackupInformer.GetStore().Add(backup)).To(Succeed())
+		expectSkipped(vmBackupNoVolumesReason, fmt.Sprintf("VirtualMachineBackup %s/%s does not contain any volumes", testNamespace, testVMBackupName))
+
+		retry, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(retry).To(BeEquivalentTo(0))
+	})
+
+	It("should wait for the VMBackup to be done", func() {
+		testVMExport := createBackupVMExport()
+		Expect(vmBackupInformer.GetStore().Add(createTestVMBackup(false))).To(Succeed())
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			verifyLinksEmpty(vmExport)
+			Expect(vmExport.Status.Phase).To(Equal(exportv1.Pending))
+			Expect(vmExport.Status.VirtualMachineName).To(HaveValue(Equal(testVmName)))
+			Expect(vmExport.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", exportv1.ConditionPVC),
+				HaveField("Reason", vmBackupNotDoneReason),
+			)))
+			return true, vmExport, nil
+		})
+		k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			Fail("the exporter pod should not be created")
+			return true, nil, nil
+		})
+
+		_, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should create the exporter pod with the backed up volumes and the checkpoint", func() {
+		testVMExport := createBackupVMExport()
+		Expect(vmBackupInformer.GetStore().Add(createTestVMBackup(true))).To(Succeed())
+		Expect(pvcInformer.GetStore().Add(createPVC(testBackupPVCName, string(cdiv1.DataVolumeArchive)))).To(Succeed())
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			Expect(vmExport.Status.VirtualMachineName).To(HaveValue(Equal(testVmName)))
+			return true, vmExport, nil
+		})
+		var checkpointConfigMap *k8sv1.ConfigMap
+		k8sClient.Fake.PrependReactor("create", "configmaps", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			create, ok := action.(testing.CreateAction)
+			Expect(ok).To(BeTrue())
+			cm, ok := create.GetObject().(*k8sv1.ConfigMap)
+			Expect(ok).To(BeTrue())
+			if cm.Name == controller.getBackupCheckpointConfigMapName(testVMExport) {
+				checkpointConfigMap = cm
+			}
+			return true, cm, nil
+		})
+		var exporterPod *k8sv1.Pod
+		k8sClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			create, ok := action.(testing.CreateAction)
+			Expect(ok).To(BeTrue())
+			exporterPod, ok = create.GetObject().(*k8sv1.Pod)
+			Expect(ok).To(BeTrue())
+			return true, exporterPod, nil
+		})
+
+		_, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(exporterPod).ToNot(BeNil())
+		podVolumeName := getExportPodVolumeName(createPVC(testBackupPVCName, string(cdiv1.DataVolumeArchive)))
+		mountPoint := fmt.Sprintf("%s/%s", fileSystemMountPath, podVolumeName)
+		Expect(exporterPod.Spec.Volumes).To(ContainElement(k8sv1.Volume{
+			Name: podVolumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: testBackupPVCName,
+					ReadOnly:  true,
+				},
+			},
+		}))
+		container := exporterPod.Spec.Containers[0]
+		Expect(container.VolumeMounts).To(ContainElements(
+			k8sv1.VolumeMount{
+				Name:      podVolumeName,
+				ReadOnly:  true,
+				MountPath: mountPoint,
+			},
+			k8sv1.VolumeMount{
+				Name:      backupCheckpointData,
+				MountPath: backupCheckpointMountPath,
+			},
+		))
+		Expect(container.Env).To(ContainElements(
+			k8sv1.EnvVar{Name: "VOLUME0_EXPORT_PATH", Value: mountPoint + "/test-vmbackup/rootdisk.qcow2"},
+			k8sv1.EnvVar{Name: "VOLUME0_EXPORT_BACKUP_QCOW2_URI", Value: "/volumes/rootdisk/backup.qcow2"},
+			k8sv1.EnvVar{Name: "VOLUME0_EXPORT_CHANGED_EXTENTS_URI", Value: "/volumes/rootdisk/changed-extents"},
+			k8sv1.EnvVar{Name: "VOLUME1_EXPORT_PATH", Value: mountPoint + "/test-vmbackup/datadisk.qcow2"},
+			k8sv1.EnvVar{Name: "VOLUME1_EXPORT_BACKUP_QCOW2_URI", Value: "/volumes/datadisk/backup.qcow2"},
+			k8sv1.EnvVar{Name: "VOLUME1_EXPORT_CHANGED_EXTENTS_URI", Value: "/volumes/datadisk/changed-extents"},
+			k8sv1.EnvVar{Name: "EXPORT_BACKUP_CHECKPOINT_URI", Value: backupCheckpointPath},
+		))
+		Expect(container.Env).ToNot(ContainElement(HaveField("Name", "VOLUME2_EXPORT_PATH")))
+
+		Expect(checkpointConfigMap).ToNot(BeNil())
+		Expect(checkpointConfigMap.OwnerReferences).To(HaveLen(1))
+		Expect(checkpointConfigMap.OwnerReferences[0].UID).To(Equal(testVMExport.UID))
+		checkpoint := &BackupCheckpoint{}
+		Expect(json.Unmarshal([]byte(checkpointConfigMap.Data[backupCheckpointKey]), checkpoint)).To(Succeed())
+		Expect(checkpoint.BackupName).To(Equal(testVMBackupName))
+		Expect(checkpoint.Type).To(Equal(backupv1.Incremental))
+		Expect(checkpoint.CheckpointName).To(Equal("checkpoint-2"))
+		Expect(checkpoint.BaseCheckpointName).To(Equal("checkpoint-1"))
+		Expect(checkpoint.Volumes).To(HaveLen(2))
+	})
+
+	It("should publish the backed up volumes and the checkpoint once the exporter is running", func() {
+		testVMExport := createBackupVMExport()
+		Expect(vmBackupInformer.GetStore().Add(createTestVMBackup(true))).To(Succeed())
+		Expect(pvcInformer.GetStore().Add(createPVC(testBackupPVCName, string(cdiv1.DataVolumeArchive)))).To(Succeed())
+		vmExportClient.Fake.PrependReactor("update", "virtualmachineexports", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+			update, ok := action.(testing.UpdateAction)
+			Expect(ok).To(BeTrue())
+			vmExport, ok := update.GetObject().(*exportv1.VirtualMachineExport)
+			Expect(ok).To(BeTrue())
+			Expect(vmExport.Status.Links).ToNot(BeNil())
+			Expect(vmExport.Status.Links.Internal).ToNot(BeNil())
+			base := fmt.Sprintf("https://%s-%s.%s.svc", exportPrefix, testVMExport.Name, testNamespace)
+			Expect(vmExport.Status.Links.Internal.Manifests).To(ContainElement(exportv1.VirtualMachineExportManifest{
+				Type: exportv1.BackupCheckpoint,
+				Url:  base + "/internal" + backupCheckpointPath,
+			}))
+			Expect(vmExport.Status.Links.Internal.Volumes).To(ConsistOf(
+				exportv1.VirtualMachineExportVolume{
+					Name: "rootdisk",
+					Formats: []exportv1.VirtualMachineExportVolumeFormat{
+						{Format: exportv1.BackupQcow2, Url: base + "/volumes/rootdisk/backup.qcow2"},
+					},
+					ChangedExtentsUrl: base + "/volumes/rootdisk/changed-extents",
+				},
+				exportv1.VirtualMachineExportVolume{
+					Name: "datadisk",
+					Formats: []exportv1.VirtualMachineExportVolumeFormat{
+						{Format: exportv1.BackupQcow2, Url: base + "/volumes/datadisk/backup.qcow2"},
+					},
+					ChangedExtentsUrl: base + "/volumes/datadisk/changed-extents",
+				},
+			))
+			return true, vmExport, nil
+		})
+		expectExporterCreate(k8sClient, k8sv1.PodRunning)
+
+		_, err := controller.updateVMExport(testVMExport)
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
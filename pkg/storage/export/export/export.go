@@ -57,6 +57,7 @@ import (
 	instancetypefind "kubevirt.io/kubevirt/pkg/instancetype/find"
 	preferencefind "kubevirt.io/kubevirt/pkg/instancetype/preference/find"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
@@ -126,10 +127,13 @@ const (
 	requeueTime = time.Second * 3
 
 	vmManifest             = "virtualmachine-manifest"
+	backendStorageManifest = "backend-storage-dv"
 	exportNameKey          = "export-name"
 	manifestData           = "manifest-data"
 	manifestsPath          = "/manifests/all"
 	secretManifestPath     = "/manifests/secret"
+	ovfManifestPath        = "/manifests/ovf"
+	ovaPath                = "/manifests/ova"
 	externalHostKey        = "external_host"
 	internalHostKey        = "internal_host"
 	externalCaConfigMapKey = "external_ca_cm"
@@ -165,6 +169,18 @@ func rawGzipURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.img.gz", urlBasePath, pvc.Name))
 }
 
+func qcow2URI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.qcow2", urlBasePath, pvc.Name))
+}
+
+func qcow2ZstdURI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/disk.qcow2.zst", urlBasePath, pvc.Name))
+}
+
+func extentsURI(pvc *corev1.PersistentVolumeClaim) string {
+	return path.Join(fmt.Sprintf("%s/%s/extents", urlBasePath, pvc.Name))
+}
+
 func archiveURI(pvc *corev1.PersistentVolumeClaim) string {
 	return path.Join(fmt.Sprintf("%s/%s/disk.tar.gz", urlBasePath, pvc.Name))
 }
@@ -281,6 +297,21 @@ func (sv *sourceVolumes) populateLink(
 				Url:    scheme + path.Join(hostAndBase, volumeInfo.RawGzURI),
 			})
 		}
+		if volumeInfo.Qcow2URI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.Qcow2URI),
+			})
+		}
+		if volumeInfo.Qcow2ZstdURI != "" {
+			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
+				Format: exportv1.KubeVirtQcow2Zstd,
+				Url:    scheme + path.Join(hostAndBase, volumeInfo.Qcow2ZstdURI),
+			})
+		}
+		if volumeInfo.ExtentsURI != "" {
+			ev.ExtentMapUrl = scheme + path.Join(hostAndBase, volumeInfo.ExtentsURI)
+		}
 		if volumeInfo.DirURI != "" {
 			ev.Formats = append(ev.Formats, exportv1.VirtualMachineExportVolumeFormat{
 				Format: exportv1.Dir,
@@ -321,6 +352,7 @@ type VMExportController struct {
 	PVCInformer                 cache.SharedIndexInformer
 	VMSnapshotInformer          cache.SharedIndexInformer
 	VMSnapshotContentInformer   cache.SharedIndexInformer
+	VMBackupInformer            cache.SharedIndexInformer
 	PodInformer                 cache.SharedIndexInformer
 	DataVolumeInformer          cache.SharedIndexInformer
 	ConfigMapInformer           cache.SharedIndexInformer
@@ -441,6 +473,16 @@ func (ctrl *VMExportController) Init() error {
 	if err != nil {
 		return err
 	}
+	_, err = ctrl.VMBackupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMBackup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMBackup(newObj) },
+			DeleteFunc: ctrl.handleVMBackup,
+		},
+	)
+	if err != nil {
+		return err
+	}
 	_, err = ctrl.VMIInformer.AddEventHandler(
 		cache.ResourceEventHandlerFuncs{
 			AddFunc:    ctrl.handleVMI,
@@ -509,6 +551,7 @@ func (ctrl *VMExportController) Run(threadiness int, stopCh <-chan struct{}) err
 		ctrl.SecretInformer.HasSynced,
 		ctrl.VMSnapshotInformer.HasSynced,
 		ctrl.VMSnapshotContentInformer.HasSynced,
+		ctrl.VMBackupInformer.HasSynced,
 		ctrl.VMInformer.HasSynced,
 		ctrl.VMIInformer.HasSynced,
 		ctrl.CRDInformer.HasSynced,
@@ -673,6 +716,16 @@ func (ctrl *VMExportController) updateVMExport(vmExport *exportv1.VirtualMachine
 		}
 		return ctrl.handleSource(vmExport, NewVMSource(sourceVolumes))
 	}
+	if ctrl.isSourceVMBackup(&vmExport.Spec) {
+		sourceVolumes, backup, err := ctrl.getPVCFromSourceVMBackup(vmExport)
+		if err != nil {
+			return 0, err
+		}
+		if sourceVolumes == nil {
+			return 0, fmt.Errorf("unexpected nil sourceVolumes")
+		}
+		return ctrl.handleSource(vmExport, NewBackupSource(sourceVolumes, backup))
+	}
 
 	return 0, nil
 }
@@ -1096,6 +1149,12 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 	}, corev1.EnvVar{
 		Name:  "EXPORT_SECRET_DEF_URI",
 		Value: secretManifestPath,
+	}, corev1.EnvVar{
+		Name:  "EXPORT_VM_OVF_URI",
+		Value: ovfManifestPath,
+	}, corev1.EnvVar{
+		Name:  "EXPORT_VM_OVA_URI",
+		Value: ovaPath,
 	})
 
 	tokenSecretRef := ""
@@ -1145,6 +1204,12 @@ func (ctrl *VMExportController) createExporterPodManifest(vmExport *exportv1.Vir
 
 	source.ConfigurePod(podManifest)
 
+	if ctrl.isSourceVMBackup(&vmExport.Spec) {
+		if err := ctrl.createBackupCheckpointAndAddToPod(vmExport, podManifest); err != nil {
+			return nil, err
+		}
+	}
+
 	if vm, err := ctrl.getVmFromExport(vmExport); err != nil {
 		return nil, err
 	} else {
@@ -1234,6 +1299,17 @@ func (ctrl *VMExportController) createDataManifestConfigMap(vmExport *exportv1.V
 			data[fmt.Sprintf("dv-%s", datavolume.Name)] = string(dvBytes)
 		}
 	}
+	backendStorageDv, err := ctrl.generateBackendStorageDataVolumeFromVm(vm)
+	if err != nil {
+		return nil, err
+	}
+	if backendStorageDv != nil {
+		dvBytes, err := json.Marshal(backendStorageDv)
+		if err != nil {
+			return nil, err
+		}
+		data[backendStorageManifest] = string(dvBytes)
+	}
 	data[exportNameKey] = vmExport.Name
 	res := &corev1.ConfigMap{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1289,32 +1365,31 @@ func addVolumeEnvironmentVariables(exportContainer *corev1.Container, pvc *corev
 		Name:  fmt.Sprintf("VOLUME%d_EXPORT_PATH", index),
 		Value: mountPoint,
 	})
-	if types.IsPVCBlock(pvc.Spec.VolumeMode) {
+	if types.IsPVCBlock(pvc.Spec.VolumeMode) || isKubevirt {
 		exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
 			Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_URI", index),
 			Value: rawURI(pvc),
 		}, corev1.EnvVar{
 			Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
 			Value: rawGzipURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_URI", index),
+			Value: qcow2URI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_QCOW2_ZSTD_URI", index),
+			Value: qcow2ZstdURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_EXTENTS_URI", index),
+			Value: extentsURI(pvc),
 		})
 	} else {
-		if isKubevirt {
-			exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
-				Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_URI", index),
-				Value: rawURI(pvc),
-			}, corev1.EnvVar{
-				Name:  fmt.Sprintf("VOLUME%d_EXPORT_RAW_GZIP_URI", index),
-				Value: rawGzipURI(pvc),
-			})
-		} else {
-			exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
-				Name:  fmt.Sprintf("VOLUME%d_EXPORT_ARCHIVE_URI", index),
-				Value: archiveURI(pvc),
-			}, corev1.EnvVar{
-				Name:  fmt.Sprintf("VOLUME%d_EXPORT_DIR_URI", index),
-				Value: dirURI(pvc),
-			})
-		}
+		exportContainer.Env = append(exportContainer.Env, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_ARCHIVE_URI", index),
+			Value: archiveURI(pvc),
+		}, corev1.EnvVar{
+			Name:  fmt.Sprintf("VOLUME%d_EXPORT_DIR_URI", index),
+			Value: dirURI(pvc),
+		})
 	}
 }
 
@@ -1635,6 +1710,34 @@ func (ctrl *VMExportController) generateDataVolumesFromVm(vm *virtv1.VirtualMach
 	return res, nil
 }
 
+// generateBackendStorageDataVolumeFromVm generates a DataVolume recreating the backend-storage PVC of the VM,
+// which holds its persistent TPM and EFI state. The PVC is imported from its archive and labeled so that the
+// imported VM adopts it instead of creating an empty one.
+func (ctrl *VMExportController) generateBackendStorageDataVolumeFromVm(vm *virtv1.VirtualMachine) (*cdiv1.DataVolume, error) {
+	volumes, err := storageutils.GetVolumes(vm, ctrl.Client, storageutils.WithBackendVolume)
+	if err != nil {
+		if storageutils.IsErrNoBackendPVC(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	for _, volume := range volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		dv, err := ctrl.createExportHttpDvFromPVC(vm.Namespace, volume.PersistentVolumeClaim.ClaimName)
+		if err != nil || dv == nil {
+			return nil, err
+		}
+		dv.Labels = map[string]string{
+			backendstorage.PVCPrefix: vm.Name,
+		}
+		dv.Spec.ContentType = cdiv1.DataVolumeArchive
+		return dv, nil
+	}
+	return nil, nil
+}
+
 func (ctrl *VMExportController) createExportHttpDvFromPVC(namespace, name string) (*cdiv1.DataVolume, error) {
 	pvc, err := ctrl.getPVCsFromName(namespace, name)
 	if err != nil {
@@ -54,6 +54,7 @@ import (
 
 	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	framework "k8s.io/client-go/tools/cache/testing"
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
 	virtv1 "kubevirt.io/api/core/v1"
 	exportv1 "kubevirt.io/api/export/v1beta1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
@@ -91,6 +92,12 @@ var (
 		{
 			Name:  "EXPORT_VM_DEF_URI",
 			Value: manifestsPath,
+		}, {
+			Name:  "EXPORT_VM_OVF_URI",
+			Value: ovfManifestPath,
+		}, {
+			Name:  "EXPORT_VM_OVA_URI",
+			Value: ovaPath,
 		}, {
 			Name:  "CERT_FILE",
 			Value: "/cert/tls.crt",
@@ -118,6 +125,7 @@ var _ = Describe("Export controller", func() {
 		dvInformer                  cache.SharedIndexInformer
 		vmSnapshotInformer          cache.SharedIndexInformer
 		vmSnapshotContentInformer   cache.SharedIndexInformer
+		vmBackupInformer            cache.SharedIndexInformer
 		secretInformer              cache.SharedIndexInformer
 		vmInformer                  cache.SharedIndexInformer
 		vmiInformer                 cache.SharedIndexInformer
@@ -151,6 +159,7 @@ var _ = Describe("Export controller", func() {
 		go secretInformer.Run(stop)
 		go vmSnapshotInformer.Run(stop)
 		go vmSnapshotContentInformer.Run(stop)
+		go vmBackupInformer.Run(stop)
 		go vmInformer.Run(stop)
 		go vmiInformer.Run(stop)
 		go crdInformer.Run(stop)
@@ -173,6 +182,7 @@ var _ = Describe("Export controller", func() {
 			secretInformer.HasSynced,
 			vmSnapshotInformer.HasSynced,
 			vmSnapshotContentInformer.HasSynced,
+			vmBackupInformer.HasSynced,
 			vmInformer.HasSynced,
 			vmiInformer.HasSynced,
 			crdInformer.HasSynced,
@@ -199,6 +209,7 @@ var _ = Describe("Export controller", func() {
 		dvInformer, _ = testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
 		vmSnapshotInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshot{})
 		vmSnapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		vmBackupInformer, _ = testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackup{})
 		vmInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachine{})
 		vmiInformer, _ = testutils.NewFakeInformerFor(&virtv1.VirtualMachineInstance{})
 		routeInformer, _ := testutils.NewFakeInformerFor(&routev1.Route{})
@@ -247,6 +258,7 @@ var _ = Describe("Export controller", func() {
 			SecretInformer:              secretInformer,
 			VMSnapshotInformer:          vmSnapshotInformer,
 			VMSnapshotContentInformer:   vmSnapshotContentInformer,
+			VMBackupInformer:            vmBackupInformer,
 			VolumeSnapshotProvider:      fakeVolumeSnapshotProvider,
 			VMInformer:                  vmInformer,
 			VMIInformer:                 vmiInformer,
@@ -1556,6 +1568,42 @@ var _ = Describe("Export controller", func() {
 			}),
 		)
 	})
+
+	Context("backend storage", func() {
+		It("Should generate an archive DataVolume adopted by the imported VM", func() {
+			vm := createVM()
+			vm.Spec.Template.Spec.Domain.Devices.TPM = &virtv1.TPMDevice{Persistent: pointer.P(true)}
+			backendPVC := createBackendPVC(vm.Name)
+			backendPVC.Spec.AccessModes = []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce}
+			Expect(pvcInformer.GetStore().Add(backendPVC)).To(Succeed())
+			k8sClient.Fake.PrependReactor("list", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				return true, &k8sv1.PersistentVolumeClaimList{Items: []k8sv1.PersistentVolumeClaim{*backendPVC}}, nil
+			})
+
+			dv, err := controller.generateBackendStorageDataVolumeFromVm(vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dv).ToNot(BeNil())
+			Expect(dv.Name).To(Equal(backendPVC.Name))
+			Expect(dv.Labels).To(HaveKeyWithValue(backendstorage.PVCPrefix, vm.Name))
+			Expect(dv.Spec.ContentType).To(Equal(cdiv1.DataVolumeArchive))
+			Expect(dv.Spec.Source.HTTP).ToNot(BeNil())
+			Expect(dv.Spec.Storage.AccessModes).To(HaveExactElements(k8sv1.ReadWriteOnce))
+		})
+
+		It("Should not generate a DataVolume without backend storage", func() {
+			dv, err := controller.generateBackendStorageDataVolumeFromVm(createVM())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dv).To(BeNil())
+		})
+
+		It("Should not generate a DataVolume while the backend PVC doesn't exist", func() {
+			vm := createVM()
+			vm.Spec.Template.Spec.Domain.Devices.TPM = &virtv1.TPMDevice{Persistent: pointer.P(true)}
+			dv, err := controller.generateBackendStorageDataVolumeFromVm(vm)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dv).To(BeNil())
+		})
+	})
 })
 
 func verifyLinksEmpty(vmExport *exportv1.VirtualMachineExport) {
@@ -1570,48 +1618,65 @@ func verifyLinksInternal(vmExport *exportv1.VirtualMachineExport, expectedVolume
 	Expect(vmExport.Status.Links).ToNot(BeNil())
 	Expect(vmExport.Status.Links.Internal).NotTo(BeNil())
 	Expect(vmExport.Status.Links.Internal.Cert).NotTo(BeEmpty())
-	Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(len(expectedVolumeFormats) / 2))
+	Expect(vmExport.Status.Links.Internal.Volumes).ToNot(BeEmpty())
+	formatCount := 0
 	for _, volume := range vmExport.Status.Links.Internal.Volumes {
-		Expect(volume.Formats).To(HaveLen(2))
+		formatCount += len(volume.Formats)
 		Expect(expectedVolumeFormats).To(ContainElements(volume.Formats))
 	}
+	Expect(formatCount).To(Equal(len(expectedVolumeFormats)))
 }
 
-func verifyLinksExternal(vmExport *exportv1.VirtualMachineExport, link1Format exportv1.ExportVolumeFormat, link1Url string, link2Format exportv1.ExportVolumeFormat, link2Url string) {
+func verifyLinksExternal(vmExport *exportv1.VirtualMachineExport, expectedVolumeFormats ...exportv1.VirtualMachineExportVolumeFormat) {
 	Expect(vmExport.Status.Links.External).ToNot(BeNil())
 	Expect(vmExport.Status.Links.External.Cert).To(BeEmpty())
 	Expect(vmExport.Status.Links.External.Volumes).To(HaveLen(1))
-	Expect(vmExport.Status.Links.External.Volumes[0].Formats).To(HaveLen(2))
-	Expect(vmExport.Status.Links.External.Volumes[0].Formats).To(ContainElements(exportv1.VirtualMachineExportVolumeFormat{
-		Format: link1Format,
-		Url:    link1Url,
-	}, exportv1.VirtualMachineExportVolumeFormat{
-		Format: link2Format,
-		Url:    link2Url,
-	}))
+	Expect(vmExport.Status.Links.External.Volumes[0].Formats).To(ConsistOf(expectedVolumeFormats))
+}
+
+func kubevirtVolumeFormats(baseUrl string) []exportv1.VirtualMachineExportVolumeFormat {
+	return []exportv1.VirtualMachineExportVolumeFormat{
+		{
+			Format: exportv1.KubeVirtRaw,
+			Url:    baseUrl + "/disk.img",
+		},
+		{
+			Format: exportv1.KubeVirtGz,
+			Url:    baseUrl + "/disk.img.gz",
+		},
+		{
+			Format: exportv1.KubeVirtQcow2,
+			Url:    baseUrl + "/disk.qcow2",
+		},
+		{
+			Format: exportv1.KubeVirtQcow2Zstd,
+			Url:    baseUrl + "/disk.qcow2.zst",
+		},
+	}
+}
+
+func verifyExtentMapUrlInternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
+	for _, volume := range vmExport.Status.Links.Internal.Volumes {
+		if volume.Name == volumeName {
+			Expect(volume.ExtentMapUrl).To(Equal(fmt.Sprintf("https://%s.%s.svc/volumes/%s/extents", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName)))
+		}
+	}
 }
 
 func verifyKubevirtInternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace string, volumeNames ...string) {
 	exportVolumeFormats := make([]exportv1.VirtualMachineExportVolumeFormat, 0)
 	for _, volumeName := range volumeNames {
-		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.KubeVirtRaw,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
-		})
-		exportVolumeFormats = append(exportVolumeFormats, exportv1.VirtualMachineExportVolumeFormat{
-			Format: exportv1.KubeVirtGz,
-			Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
-		})
+		exportVolumeFormats = append(exportVolumeFormats, kubevirtVolumeFormats(fmt.Sprintf("https://%s.%s.svc/volumes/%s", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName))...)
 	}
 	verifyLinksInternal(vmExport, exportVolumeFormats...)
+	for _, volumeName := range volumeNames {
+		verifyExtentMapUrlInternal(vmExport, exportName, namespace, volumeName)
+	}
 }
 
 func verifyKubevirtExternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
-	verifyLinksExternal(vmExport,
-		exportv1.KubeVirtRaw,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.img", currentVersion, namespace, exportName, volumeName),
-		exportv1.KubeVirtGz,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.img.gz", currentVersion, namespace, exportName, volumeName))
+	verifyLinksExternal(vmExport, kubevirtVolumeFormats(fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s", currentVersion, namespace, exportName, volumeName))...)
+	Expect(vmExport.Status.Links.External.Volumes[0].ExtentMapUrl).To(Equal(fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/extents", currentVersion, namespace, exportName, volumeName)))
 }
 
 func verifyArchiveInternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
@@ -1687,10 +1752,13 @@ func ingressToHost() *networkingv1.Ingress {
 
 func verifyArchiveExternal(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
 	verifyLinksExternal(vmExport,
-		exportv1.Dir,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/dir", currentVersion, namespace, exportName, volumeName),
-		exportv1.ArchiveGz,
-		fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.tar.gz", currentVersion, namespace, exportName, volumeName))
+		exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.Dir,
+			Url:    fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/dir", currentVersion, namespace, exportName, volumeName),
+		}, exportv1.VirtualMachineExportVolumeFormat{
+			Format: exportv1.ArchiveGz,
+			Url:    fmt.Sprintf("https://virt-exportproxy-kubevirt.apps-crc.testing/api/export.kubevirt.io/%s/namespaces/%s/virtualmachineexports/%s/volumes/%s/disk.tar.gz", currentVersion, namespace, exportName, volumeName),
+		})
 }
 
 func createVMExportMeta(name string) metav1.ObjectMeta {
@@ -96,6 +96,24 @@ func (ctrl *VMExportController) getLinks(exporterPod *corev1.Pod, export *export
 			Url:  scheme + path.Join(hostAndBase, linkType, paths.SecretURI),
 		})
 	}
+	if paths.OvfURI != "" {
+		exportLink.Manifests = append(exportLink.Manifests, exportv1.VirtualMachineExportManifest{
+			Type: exportv1.OVF,
+			Url:  scheme + path.Join(hostAndBase, linkType, paths.OvfURI),
+		})
+	}
+	if paths.OvaURI != "" {
+		exportLink.Manifests = append(exportLink.Manifests, exportv1.VirtualMachineExportManifest{
+			Type: exportv1.OVA,
+			Url:  scheme + path.Join(hostAndBase, linkType, paths.OvaURI),
+		})
+	}
+	if paths.BackupCheckpointURI != "" {
+		exportLink.Manifests = append(exportLink.Manifests, exportv1.VirtualMachineExportManifest{
+			Type: exportv1.BackupCheckpoint,
+			Url:  scheme + path.Join(hostAndBase, linkType, paths.BackupCheckpointURI),
+		})
+	}
 
 	source.ConfigureExportLink(exportLink, paths, export, exporterPod, hostAndBase, scheme)
 
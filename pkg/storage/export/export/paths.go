@@ -29,18 +29,29 @@ import (
 
 // VolumeInfo contains paths for a volume
 type VolumeInfo struct {
-	Path       string
-	ArchiveURI string
-	DirURI     string
-	RawURI     string
-	RawGzURI   string
+	Path         string
+	ArchiveURI   string
+	DirURI       string
+	RawURI       string
+	RawGzURI     string
+	Qcow2URI     string
+	Qcow2ZstdURI string
+	ExtentsURI   string
+	// BackupQcow2URI and ChangedExtentsURI are set for the volumes of a VirtualMachineBackup,
+	// in this case Path is the QCOW2 file of the backed up volume
+	BackupQcow2URI    string
+	ChangedExtentsURI string
 }
 
 // ServerPaths contains static paths and per-volume paths
 type ServerPaths struct {
 	VMURI     string
 	SecretURI string
-	Volumes   []VolumeInfo
+	OvfURI    string
+	OvaURI    string
+	// BackupCheckpointURI is set when the source is a VirtualMachineBackup
+	BackupCheckpointURI string
+	Volumes             []VolumeInfo
 }
 
 // EnvironToMap converts the environment variables to a map
@@ -67,16 +78,26 @@ func CreateServerPaths(env map[string]string) *ServerPaths {
 	result := &ServerPaths{
 		VMURI:     env["EXPORT_VM_DEF_URI"],
 		SecretURI: env["EXPORT_SECRET_DEF_URI"],
+		OvfURI:    env["EXPORT_VM_OVF_URI"],
+		OvaURI:    env["EXPORT_VM_OVA_URI"],
+
+		BackupCheckpointURI: env["EXPORT_BACKUP_CHECKPOINT_URI"],
 	}
 	for k, v := range env {
 		if strings.HasSuffix(k, "_EXPORT_PATH") {
 			envPrefix := strings.TrimSuffix(k, "_EXPORT_PATH")
 			vi := VolumeInfo{
-				Path:       v,
-				ArchiveURI: env[envPrefix+"_EXPORT_ARCHIVE_URI"],
-				DirURI:     env[envPrefix+"_EXPORT_DIR_URI"],
-				RawURI:     env[envPrefix+"_EXPORT_RAW_URI"],
-				RawGzURI:   env[envPrefix+"_EXPORT_RAW_GZIP_URI"],
+				Path:         v,
+				ArchiveURI:   env[envPrefix+"_EXPORT_ARCHIVE_URI"],
+				DirURI:       env[envPrefix+"_EXPORT_DIR_URI"],
+				RawURI:       env[envPrefix+"_EXPORT_RAW_URI"],
+				RawGzURI:     env[envPrefix+"_EXPORT_RAW_GZIP_URI"],
+				Qcow2URI:     env[envPrefix+"_EXPORT_QCOW2_URI"],
+				Qcow2ZstdURI: env[envPrefix+"_EXPORT_QCOW2_ZSTD_URI"],
+				ExtentsURI:   env[envPrefix+"_EXPORT_EXTENTS_URI"],
+
+				BackupQcow2URI:    env[envPrefix+"_EXPORT_BACKUP_QCOW2_URI"],
+				ChangedExtentsURI: env[envPrefix+"_EXPORT_CHANGED_EXTENTS_URI"],
 			}
 			result.Volumes = append(result.Volumes, vi)
 		}
@@ -94,3 +115,13 @@ func (sp *ServerPaths) GetVolumeInfo(pvcName string) *VolumeInfo {
 	}
 	return nil
 }
+
+// getVolumeInfoByPath returns the VolumeInfo exported from the given path
+func (sp *ServerPaths) getVolumeInfoByPath(volumePath string) *VolumeInfo {
+	for _, v := range sp.Volumes {
+		if filepath.Clean(v.Path) == filepath.Clean(volumePath) {
+			return &v
+		}
+	}
+	return nil
+}
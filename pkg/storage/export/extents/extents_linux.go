@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package extents
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"os"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// zeroScanChunkSize is the granularity zeroed ranges are detected with on
+// volumes which don't report their holes
+const zeroScanChunkSize = 64 * 1024
+
+// Map returns the extents covering the whole file. Block devices always report
+// a single data extent through SEEK_DATA and SEEK_HOLE, so they and files
+// which don't support holes are scanned for zeroed ranges instead.
+func Map(f *os.File) ([]Extent, error) {
+	size, err := f.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	if info.Mode()&os.ModeDevice != 0 {
+		return scanZeroes(f, size)
+	}
+
+	var extents []Extent
+	for offset := int64(0); offset < size; {
+		dataStart, err := f.Seek(offset, unix.SEEK_DATA)
+		switch {
+		case errors.Is(err, syscall.ENXIO):
+			// no more data until the end of the file
+			dataStart = size
+		case errors.Is(err, syscall.EINVAL):
+			// holes are not supported
+			return scanZeroes(f, size)
+		case err != nil:
+			return nil, err
+		}
+
+		if dataStart > offset {
+			extents = append(extents, Extent{Start: offset, Length: dataStart - offset})
+		}
+		if dataStart >= size {
+			break
+		}
+
+		holeStart, err := f.Seek(dataStart, unix.SEEK_HOLE)
+		if err != nil {
+			return nil, err
+		}
+		extents = append(extents, Extent{Start: dataStart, Length: holeStart - dataStart, Data: true})
+		offset = holeStart
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return extents, nil
+}
+
+// scanZeroes reads the whole volume and maps the chunks reading as zeros as holes
+func scanZeroes(f *os.File, size int64) ([]Extent, error) {
+	var extents []Extent
+	buf := make([]byte, zeroScanChunkSize)
+	zeroes := make([]byte, zeroScanChunkSize)
+	for offset := int64(0); offset < size; {
+		n, err := f.ReadAt(buf[:min(int64(len(buf)), size-offset)], offset)
+		if err != nil && !(errors.Is(err, io.EOF) && n > 0) {
+			return nil, err
+		}
+
+		data := !bytes.Equal(buf[:n], zeroes[:n])
+		if last := len(extents) - 1; last >= 0 && extents[last].Data == data {
+			extents[last].Length += int64(n)
+		} else {
+			extents = append(extents, Extent{Start: offset, Length: int64(n), Data: data})
+		}
+		offset += int64(n)
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return extents, nil
+}
@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package extents
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Extents", func() {
+	const size = 10 * 1024 * 1024
+
+	var f *os.File
+
+	BeforeEach(func() {
+		var err error
+		f, err = os.Create(filepath.Join(GinkgoT().TempDir(), "disk.img"))
+		Expect(err).ToNot(HaveOccurred())
+		DeferCleanup(f.Close)
+		Expect(f.Truncate(size)).To(Succeed())
+	})
+
+	isData := func(extents []Extent, offset int64) bool {
+		for _, extent := range extents {
+			if offset >= extent.Start && offset < extent.Start+extent.Length {
+				return extent.Data
+			}
+		}
+		return false
+	}
+
+	It("should map the whole file", func() {
+		written := []int64{0, 5 * 1024 * 1024}
+		for _, offset := range written {
+			_, err := f.WriteAt([]byte("data"), offset)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		extents, err := Map(f)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(Size(extents)).To(BeEquivalentTo(size))
+
+		next := int64(0)
+		for _, extent := range extents {
+			Expect(extent.Start).To(Equal(next))
+			Expect(extent.Length).To(BeNumerically(">", 0))
+			next = extent.Start + extent.Length
+		}
+		for _, offset := range written {
+			Expect(isData(extents, offset)).To(BeTrue(), "offset %d should be data", offset)
+		}
+	})
+
+	It("should map the zeroed ranges of volumes which don't report their holes", func() {
+		_, err := f.WriteAt([]byte("data"), zeroScanChunkSize+1)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = f.WriteAt([]byte("d"), size-1)
+		Expect(err).ToNot(HaveOccurred())
+
+		extents, err := scanZeroes(f, size)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(extents).To(Equal([]Extent{
+			{Start: 0, Length: zeroScanChunkSize},
+			{Start: zeroScanChunkSize, Length: zeroScanChunkSize, Data: true},
+			{Start: 2 * zeroScanChunkSize, Length: size - 3*zeroScanChunkSize},
+			{Start: size - zeroScanChunkSize, Length: zeroScanChunkSize, Data: true},
+		}))
+	})
+
+	It("should map an empty file", func() {
+		Expect(f.Truncate(0)).To(Succeed())
+
+		extents, err := Map(f)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(extents).To(BeEmpty())
+		Expect(Size(extents)).To(BeZero())
+	})
+})
@@ -0,0 +1,636 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package ovf translates a VirtualMachine into an OVF descriptor and packages
+// it along with its disk images into an OVA.
+//
+// The CPU, memory, firmware, disks and network interfaces of the VirtualMachine
+// are mapped to the virtual hardware section of the descriptor. The fields
+// which have no OVF equivalent are listed in the annotation section, so that
+// the consumer of the package knows what was lost in the translation.
+package ovf
+
+import (
+	"archive/tar"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// FormatStreamOptimizedVMDK is the OVF format URI of streamOptimized VMDK disk images
+	FormatStreamOptimizedVMDK = "http://www.vmware.com/interfaces/specifications/vmdk.html#streamOptimized"
+
+	envelopeNamespace = "http://schemas.dmtf.org/ovf/envelope/1"
+	rasdNamespace     = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData"
+	vssdNamespace     = "http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_VirtualSystemSettingData"
+	vmwNamespace      = "http://www.vmware.com/schema/ovf"
+
+	// virtualSystemType is the oldest VMware hardware version supporting
+	// EFI secure boot, so that the package can be imported by common consumers
+	virtualSystemType = "vmx-13"
+	mib               = 1024 * 1024
+)
+
+// CIM resource types of the virtual hardware items
+const (
+	resourceTypeCPU           = 3
+	resourceTypeMemory        = 4
+	resourceTypeSCSI          = 6
+	resourceTypeEthernet      = 10
+	resourceTypeCDDrive       = 15
+	resourceTypeDiskDrive     = 17
+	resourceTypeOtherStorage  = 20
+	resourceTypeUSBController = 23
+)
+
+// Image is the content of a disk image in an OVA
+type Image interface {
+	io.WriterTo
+	Size() int64
+}
+
+// Disk is a disk image of an exported volume of the VirtualMachine
+type Disk struct {
+	// VolumeName is the name of the exported volume, as referenced by the VirtualMachine
+	VolumeName string
+	// FileName is the name of the image in the OVA
+	FileName string
+	// Format is the OVF format URI of the image
+	Format string
+	// Capacity is the size of the disk as seen by the guest
+	Capacity int64
+	Image    Image
+}
+
+type envelope struct {
+	XMLName        xml.Name        `xml:"Envelope"`
+	Xmlns          string          `xml:"xmlns,attr"`
+	XmlnsOvf       string          `xml:"xmlns:ovf,attr"`
+	XmlnsRasd      string          `xml:"xmlns:rasd,attr"`
+	XmlnsVssd      string          `xml:"xmlns:vssd,attr"`
+	XmlnsVmw       string          `xml:"xmlns:vmw,attr"`
+	References     references      `xml:"References"`
+	DiskSection    *diskSection    `xml:"DiskSection,omitempty"`
+	NetworkSection *networkSection `xml:"NetworkSection,omitempty"`
+	VirtualSystem  virtualSystem   `xml:"VirtualSystem"`
+}
+
+type references struct {
+	Files []file `xml:"File"`
+}
+
+type file struct {
+	ID   string `xml:"ovf:id,attr"`
+	Href string `xml:"ovf:href,attr"`
+	Size int64  `xml:"ovf:size,attr"`
+}
+
+type diskSection struct {
+	Info  string `xml:"Info"`
+	Disks []disk `xml:"Disk"`
+}
+
+type disk struct {
+	DiskID                  string `xml:"ovf:diskId,attr"`
+	FileRef                 string `xml:"ovf:fileRef,attr"`
+	Capacity                int64  `xml:"ovf:capacity,attr"`
+	CapacityAllocationUnits string `xml:"ovf:capacityAllocationUnits,attr"`
+	Format                  string `xml:"ovf:format,attr"`
+}
+
+type networkSection struct {
+	Info     string    `xml:"Info"`
+	Networks []network `xml:"Network"`
+}
+
+type network struct {
+	Name        string `xml:"ovf:name,attr"`
+	Description string `xml:"Description"`
+}
+
+type virtualSystem struct {
+	ID                     string                 `xml:"ovf:id,attr"`
+	Info                   string                 `xml:"Info"`
+	Name                   string                 `xml:"Name"`
+	AnnotationSection      *annotationSection     `xml:"AnnotationSection,omitempty"`
+	VirtualHardwareSection virtualHardwareSection `xml:"VirtualHardwareSection"`
+}
+
+type annotationSection struct {
+	Info       string `xml:"Info"`
+	Annotation string `xml:"Annotation"`
+}
+
+type virtualHardwareSection struct {
+	Info    string      `xml:"Info"`
+	System  system      `xml:"System"`
+	Items   []item      `xml:"Item"`
+	Configs []vmwConfig `xml:"vmw:Config"`
+}
+
+type system struct {
+	ElementName             string `xml:"vssd:ElementName"`
+	InstanceID              int    `xml:"vssd:InstanceID"`
+	VirtualSystemIdentifier string `xml:"vssd:VirtualSystemIdentifier,omitempty"`
+	VirtualSystemType       string `xml:"vssd:VirtualSystemType"`
+}
+
+// item is a CIM_ResourceAllocationSettingData, its elements are ordered alphabetically as required by the schema
+type item struct {
+	Address             string          `xml:"rasd:Address,omitempty"`
+	AddressOnParent     string          `xml:"rasd:AddressOnParent,omitempty"`
+	AllocationUnits     string          `xml:"rasd:AllocationUnits,omitempty"`
+	AutomaticAllocation *bool           `xml:"rasd:AutomaticAllocation,omitempty"`
+	Connection          string          `xml:"rasd:Connection,omitempty"`
+	ElementName         string          `xml:"rasd:ElementName"`
+	HostResource        string          `xml:"rasd:HostResource,omitempty"`
+	InstanceID          int             `xml:"rasd:InstanceID"`
+	Parent              int             `xml:"rasd:Parent,omitempty"`
+	ResourceSubType     string          `xml:"rasd:ResourceSubType,omitempty"`
+	ResourceType        int             `xml:"rasd:ResourceType"`
+	VirtualQuantity     int64           `xml:"rasd:VirtualQuantity,omitempty"`
+	CoresPerSocket      *coresPerSocket `xml:"vmw:CoresPerSocket,omitempty"`
+}
+
+type coresPerSocket struct {
+	Required bool   `xml:"ovf:required,attr"`
+	Value    uint32 `xml:",chardata"`
+}
+
+type vmwConfig struct {
+	Required bool   `xml:"ovf:required,attr"`
+	Key      string `xml:"vmw:key,attr"`
+	Value    string `xml:"vmw:value,attr"`
+}
+
+// builder accumulates the descriptor of a VirtualMachine
+type builder struct {
+	spec     *virtv1.VirtualMachineInstanceSpec
+	envelope *envelope
+	disks    map[string]Disk
+	// disks referenced by the descriptor, in the order of the references
+	used        []Disk
+	controllers map[string]int
+	lossy       []string
+}
+
+// Descriptor returns the OVF descriptor of the VirtualMachine and the disks it references
+func Descriptor(vm *virtv1.VirtualMachine, disks []Disk) ([]byte, []Disk, error) {
+	if vm.Spec.Template == nil {
+		return nil, nil, fmt.Errorf("VirtualMachine %s/%s has no template", vm.Namespace, vm.Name)
+	}
+	b := &builder{
+		spec: &vm.Spec.Template.Spec,
+		envelope: &envelope{
+			Xmlns:     envelopeNamespace,
+			XmlnsOvf:  envelopeNamespace,
+			XmlnsRasd: rasdNamespace,
+			XmlnsVssd: vssdNamespace,
+			XmlnsVmw:  vmwNamespace,
+			VirtualSystem: virtualSystem{
+				ID:   vm.Name,
+				Info: "A KubeVirt virtual machine",
+				Name: vm.Name,
+				VirtualHardwareSection: virtualHardwareSection{
+					Info: "Virtual hardware requirements",
+					System: system{
+						ElementName:       "Virtual Hardware Family",
+						VirtualSystemType: virtualSystemType,
+					},
+				},
+			},
+		},
+		disks:       make(map[string]Disk, len(disks)),
+		controllers: make(map[string]int),
+	}
+	for _, d := range disks {
+		b.disks[d.VolumeName] = d
+	}
+
+	b.addCPU()
+	b.addMemory()
+	b.addFirmware()
+	b.addDisks()
+	b.addInterfaces()
+	b.addUnmappedFields()
+
+	if len(b.lossy) > 0 {
+		b.envelope.VirtualSystem.AnnotationSection = &annotationSection{
+			Info:       "Fields of the VirtualMachine which could not be mapped to OVF",
+			Annotation: strings.Join(b.lossy, "\n"),
+		}
+	}
+
+	data, err := xml.MarshalIndent(b.envelope, "", "  ")
+	if err != nil {
+		return nil, nil, err
+	}
+	return append([]byte(xml.Header), data...), b.used, nil
+}
+
+// WriteOVA writes a tar containing the OVF descriptor of the VirtualMachine
+// followed by the disk images it references
+func WriteOVA(w io.Writer, vm *virtv1.VirtualMachine, disks []Disk) error {
+	descriptor, used, err := Descriptor(vm, disks)
+	if err != nil {
+		return err
+	}
+	tw := tar.NewWriter(w)
+	if err := writeTarFile(tw, vm.Name+".ovf", int64(len(descriptor)), writerTo(descriptor)); err != nil {
+		return err
+	}
+	for _, d := range used {
+		if err := writeTarFile(tw, d.FileName, d.Image.Size(), d.Image); err != nil {
+			return err
+		}
+	}
+	return tw.Close()
+}
+
+func writeTarFile(tw *tar.Writer, name string, size int64, content io.WriterTo) error {
+	if err := tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0644,
+		Format:   tar.FormatPAX,
+	}); err != nil {
+		return err
+	}
+	_, err := content.WriteTo(tw)
+	return err
+}
+
+type writerTo []byte
+
+func (b writerTo) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(b)
+	return int64(n), err
+}
+
+func (b *builder) addItem(i item) int {
+	hw := &b.envelope.VirtualSystem.VirtualHardwareSection
+	i.InstanceID = len(hw.Items) + 1
+	hw.Items = append(hw.Items, i)
+	return i.InstanceID
+}
+
+func (b *builder) addLossy(format string, a ...interface{}) {
+	b.lossy = append(b.lossy, fmt.Sprintf(format, a...))
+}
+
+func (b *builder) addCPU() {
+	var sockets, cores, threads uint32 = 1, 1, 1
+	cpu := b.spec.Domain.CPU
+	if cpu != nil {
+		sockets, cores, threads = max(cpu.Sockets, 1), max(cpu.Cores, 1), max(cpu.Threads, 1)
+	}
+	vcpus := int64(sockets * cores * threads)
+	if cpu == nil || cpu.Sockets+cpu.Cores+cpu.Threads == 0 {
+		// Without a topology the number of vCPUs is derived from the resources
+		if cpus, ok := b.cpuResource(); ok {
+			vcpus = cpus
+			cores = uint32(cpus)
+		}
+	}
+
+	i := item{
+		AllocationUnits: "hertz * 10^6",
+		ElementName:     fmt.Sprintf("%d virtual CPU(s)", vcpus),
+		ResourceType:    resourceTypeCPU,
+		VirtualQuantity: vcpus,
+	}
+	if cores*threads > 1 {
+		i.CoresPerSocket = &coresPerSocket{Value: cores * threads}
+	}
+	b.addItem(i)
+
+	if threads > 1 {
+		b.addLossy("spec.template.spec.domain.cpu.threads: %d threads per core are exported as cores", threads)
+	}
+	if cpu == nil {
+		return
+	}
+	if cpu.Model != "" {
+		b.addLossy("spec.template.spec.domain.cpu.model: %s", cpu.Model)
+	}
+	if len(cpu.Features) > 0 {
+		b.addLossy("spec.template.spec.domain.cpu.features")
+	}
+	if cpu.DedicatedCPUPlacement {
+		b.addLossy("spec.template.spec.domain.cpu.dedicatedCpuPlacement")
+	}
+	if cpu.NUMA != nil {
+		b.addLossy("spec.template.spec.domain.cpu.numa")
+	}
+}
+
+// cpuResource returns the number of CPUs of the resources, rounded up
+func (b *builder) cpuResource() (int64, bool) {
+	resources := b.spec.Domain.Resources
+	for _, list := range []k8sv1.ResourceList{resources.Limits, resources.Requests} {
+		if q, ok := list[k8sv1.ResourceCPU]; ok {
+			return max((q.MilliValue()+999)/1000, 1), true
+		}
+	}
+	return 0, false
+}
+
+func (b *builder) addMemory() {
+	domain := &b.spec.Domain
+	var bytes int64
+	if domain.Memory != nil && domain.Memory.Guest != nil {
+		bytes = domain.Memory.Guest.Value()
+	} else if q, ok := domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		bytes = q.Value()
+	} else if q, ok := domain.Resources.Limits[k8sv1.ResourceMemory]; ok {
+		bytes = q.Value()
+	}
+	if domain.Memory != nil {
+		if domain.Memory.Hugepages != nil {
+			b.addLossy("spec.template.spec.domain.memory.hugepages")
+		}
+		if domain.Memory.MaxGuest != nil {
+			b.addLossy("spec.template.spec.domain.memory.maxGuest")
+		}
+	}
+	if bytes == 0 {
+		b.addLossy("spec.template.spec.domain.memory: the guest memory is not set")
+		return
+	}
+
+	megabytes := (bytes + mib - 1) / mib
+	b.addItem(item{
+		AllocationUnits: "byte * 2^20",
+		ElementName:     fmt.Sprintf("%dMB of memory", megabytes),
+		ResourceType:    resourceTypeMemory,
+		VirtualQuantity: megabytes,
+	})
+}
+
+func (b *builder) addFirmware() {
+	hw := &b.envelope.VirtualSystem.VirtualHardwareSection
+	firmware := b.spec.Domain.Firmware
+	if firmware == nil {
+		hw.Configs = append(hw.Configs, vmwConfig{Key: "firmware", Value: "bios"})
+		return
+	}
+
+	hw.System.VirtualSystemIdentifier = string(firmware.UUID)
+	if firmware.Bootloader != nil && firmware.Bootloader.EFI != nil {
+		hw.Configs = append(hw.Configs, vmwConfig{Key: "firmware", Value: "efi"})
+		// Secure boot is enabled unless explicitly disabled
+		secureBoot := firmware.Bootloader.EFI.SecureBoot == nil || *firmware.Bootloader.EFI.SecureBoot
+		hw.Configs = append(hw.Configs, vmwConfig{Key: "uefi.secureBoot.enabled", Value: fmt.Sprintf("%t", secureBoot)})
+		if firmware.Bootloader.EFI.Persistent != nil && *firmware.Bootloader.EFI.Persistent {
+			b.addLossy("spec.template.spec.domain.firmware.bootloader.efi.persistent: the EFI variables are not exported")
+		}
+	} else {
+		hw.Configs = append(hw.Configs, vmwConfig{Key: "firmware", Value: "bios"})
+	}
+
+	if firmware.Serial != "" {
+		b.addLossy("spec.template.spec.domain.firmware.serial")
+	}
+	if firmware.KernelBoot != nil {
+		b.addLossy("spec.template.spec.domain.firmware.kernelBoot")
+	}
+}
+
+// controller returns the instance ID of the controller of the bus, adding it if needed.
+// The buses are mapped to the controllers of the VMware virtual hardware,
+// virtio disks are attached to the SCSI controller.
+func (b *builder) controller(bus virtv1.DiskBus) int {
+	var i item
+	switch bus {
+	case virtv1.DiskBusSATA:
+		i = item{ElementName: "SATA controller", ResourceType: resourceTypeOtherStorage, ResourceSubType: "vmware.sata.ahci"}
+	case virtv1.DiskBusUSB:
+		i = item{ElementName: "USB controller", ResourceType: resourceTypeUSBController, ResourceSubType: "vmware.usb.ehci"}
+	default:
+		i = item{ElementName: "SCSI controller", ResourceType: resourceTypeSCSI, ResourceSubType: "lsilogic"}
+	}
+	if id, ok := b.controllers[i.ResourceSubType]; ok {
+		return id
+	}
+	id := b.addItem(i)
+	b.controllers[i.ResourceSubType] = id
+	return id
+}
+
+func (b *builder) volumeName(name string) (string, string) {
+	for _, volume := range b.spec.Volumes {
+		if volume.Name != name {
+			continue
+		}
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			return volume.PersistentVolumeClaim.ClaimName, ""
+		case volume.DataVolume != nil:
+			return volume.DataVolume.Name, ""
+		case volume.ContainerDisk != nil:
+			return "", "containerDisk"
+		case volume.CloudInitNoCloud != nil:
+			return "", "cloudInitNoCloud"
+		case volume.CloudInitConfigDrive != nil:
+			return "", "cloudInitConfigDrive"
+		case volume.EmptyDisk != nil:
+			return "", "emptyDisk"
+		case volume.Ephemeral != nil:
+			return "", "ephemeral"
+		default:
+			return "", "non persistent"
+		}
+	}
+	return "", "missing"
+}
+
+func (b *builder) addDisks() {
+	diskSection := &diskSection{Info: "Virtual disks"}
+	addresses := make(map[int]int)
+
+	for _, d := range b.spec.Domain.Devices.Disks {
+		volumeName, source := b.volumeName(d.Name)
+		image, exported := b.disks[volumeName]
+		if volumeName == "" || !exported {
+			if source == "" {
+				source = "non exported"
+			}
+			b.addLossy("spec.template.spec.domain.devices.disks[%s]: the %s volume is not part of the package", d.Name, source)
+			continue
+		}
+
+		var bus virtv1.DiskBus
+		resourceType := resourceTypeDiskDrive
+		switch {
+		case d.CDRom != nil:
+			bus = d.CDRom.Bus
+			if bus == "" {
+				bus = virtv1.DiskBusSATA
+			}
+			resourceType = resourceTypeCDDrive
+		case d.LUN != nil:
+			bus = d.LUN.Bus
+			if bus == "" {
+				bus = virtv1.DiskBusSCSI
+			}
+			b.addLossy("spec.template.spec.domain.devices.disks[%s].lun: exported as a disk", d.Name)
+		case d.Disk != nil:
+			bus = d.Disk.Bus
+		}
+		if bus == "" {
+			bus = virtv1.DiskBusVirtio
+		}
+		if bus == virtv1.DiskBusVirtio {
+			b.addLossy("spec.template.spec.domain.devices.disks[%s]: the virtio disk is attached to a SCSI controller", d.Name)
+		}
+		if d.IOTune != nil {
+			b.addLossy("spec.template.spec.domain.devices.disks[%s].ioTune", d.Name)
+		}
+
+		parent := b.controller(bus)
+		fileID := "file-" + image.VolumeName
+		b.envelope.References.Files = append(b.envelope.References.Files, file{
+			ID:   fileID,
+			Href: image.FileName,
+			Size: image.Image.Size(),
+		})
+		diskSection.Disks = append(diskSection.Disks, disk{
+			DiskID:                  image.VolumeName,
+			FileRef:                 fileID,
+			Capacity:                image.Capacity,
+			CapacityAllocationUnits: "byte",
+			Format:                  image.Format,
+		})
+		b.addItem(item{
+			AddressOnParent: fmt.Sprintf("%d", addresses[parent]),
+			ElementName:     d.Name,
+			HostResource:    "ovf:/disk/" + image.VolumeName,
+			Parent:          parent,
+			ResourceType:    resourceType,
+		})
+		addresses[parent]++
+		b.used = append(b.used, image)
+	}
+
+	if len(diskSection.Disks) > 0 {
+		b.envelope.DiskSection = diskSection
+	}
+}
+
+func (b *builder) networkName(name string) string {
+	for _, n := range b.spec.Networks {
+		if n.Name != name {
+			continue
+		}
+		if n.Multus != nil {
+			return n.Multus.NetworkName
+		}
+		return n.Name
+	}
+	return name
+}
+
+func (b *builder) addInterfaces() {
+	networks := &networkSection{Info: "Logical networks"}
+	seen := make(map[string]bool)
+	automatic := true
+
+	for _, iface := range b.spec.Domain.Devices.Interfaces {
+		networkName := b.networkName(iface.Name)
+		if !seen[networkName] {
+			seen[networkName] = true
+			networks.Networks = append(networks.Networks, network{
+				Name:        networkName,
+				Description: fmt.Sprintf("The %s network", networkName),
+			})
+		}
+
+		b.addItem(item{
+			Address:             iface.MacAddress,
+			AutomaticAllocation: &automatic,
+			Connection:          networkName,
+			ElementName:         iface.Name,
+			ResourceSubType:     b.nicType(iface),
+			ResourceType:        resourceTypeEthernet,
+		})
+
+		switch {
+		case iface.SRIOV != nil:
+			b.addLossy("spec.template.spec.domain.devices.interfaces[%s].sriov", iface.Name)
+		case iface.Binding != nil:
+			b.addLossy("spec.template.spec.domain.devices.interfaces[%s].binding: %s", iface.Name, iface.Binding.Name)
+		}
+	}
+
+	if len(networks.Networks) > 0 {
+		b.envelope.NetworkSection = networks
+	}
+}
+
+// nicType returns the VMware adapter type of the interface model
+func (b *builder) nicType(iface virtv1.Interface) string {
+	switch iface.Model {
+	case "e1000":
+		return "E1000"
+	case "e1000e":
+		return "E1000e"
+	case "pcnet":
+		return "PCNet32"
+	case "", virtv1.VirtIO:
+		b.addLossy("spec.template.spec.domain.devices.interfaces[%s]: the virtio interface is exported as VmxNet3", iface.Name)
+		return "VmxNet3"
+	default:
+		b.addLossy("spec.template.spec.domain.devices.interfaces[%s].model: %s is exported as E1000", iface.Name, iface.Model)
+		return "E1000"
+	}
+}
+
+// addUnmappedFields lists the fields which have no equivalent in OVF
+func (b *builder) addUnmappedFields() {
+	spec := b.spec
+	devices := &spec.Domain.Devices
+	unmapped := []struct {
+		field string
+		set   bool
+	}{
+		{"spec.template.spec.domain.devices.gpus", len(devices.GPUs) > 0},
+		{"spec.template.spec.domain.devices.hostDevices", len(devices.HostDevices) > 0},
+		{"spec.template.spec.domain.devices.filesystems", len(devices.Filesystems) > 0},
+		{"spec.template.spec.domain.devices.watchdog", devices.Watchdog != nil},
+		{"spec.template.spec.domain.devices.tpm", devices.TPM != nil},
+		{"spec.template.spec.nodeSelector", len(spec.NodeSelector) > 0},
+		{"spec.template.spec.affinity", spec.Affinity != nil},
+		{"spec.template.spec.tolerations", len(spec.Tolerations) > 0},
+		{"spec.template.spec.accessCredentials", len(spec.AccessCredentials) > 0},
+		{"spec.template.spec.readinessProbe", spec.ReadinessProbe != nil},
+		{"spec.template.spec.livenessProbe", spec.LivenessProbe != nil},
+	}
+	for _, u := range unmapped {
+		if u.set {
+			b.addLossy("%s", u.field)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package ovf
+
+import (
+	"archive/tar"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+type fakeImage []byte
+
+func (i fakeImage) Size() int64 {
+	return int64(len(i))
+}
+
+func (i fakeImage) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(i)
+	return int64(n), err
+}
+
+// node is a generic XML element, matched by local name
+type node struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Content string     `xml:",chardata"`
+	Nodes   []node     `xml:",any"`
+}
+
+func (n node) attr(name string) string {
+	for _, attr := range n.Attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+func (n node) child(name string) string {
+	for _, c := range n.Nodes {
+		if c.XMLName.Local == name {
+			return c.Content
+		}
+	}
+	return ""
+}
+
+// find returns the elements at the path of local names below n
+func (n node) find(path ...string) []node {
+	if len(path) == 0 {
+		return []node{n}
+	}
+	var result []node
+	for _, c := range n.Nodes {
+		if c.XMLName.Local == path[0] {
+			result = append(result, c.find(path[1:]...)...)
+		}
+	}
+	return result
+}
+
+func (n node) items(resourceType string) []node {
+	var result []node
+	for _, i := range n.find("VirtualSystem", "VirtualHardwareSection", "Item") {
+		if i.child("ResourceType") == resourceType {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+func (n node) annotation() string {
+	annotations := n.find("VirtualSystem", "AnnotationSection", "Annotation")
+	if len(annotations) == 0 {
+		return ""
+	}
+	return annotations[0].Content
+}
+
+func parseDescriptor(data []byte) node {
+	Expect(string(data)).To(HavePrefix(xml.Header))
+	root := node{}
+	Expect(xml.Unmarshal(data, &root)).To(Succeed())
+	Expect(root.XMLName.Space).To(Equal(envelopeNamespace))
+	Expect(root.XMLName.Local).To(Equal("Envelope"))
+	return root
+}
+
+func newDisk(volumeName string, image fakeImage) Disk {
+	return Disk{
+		VolumeName: volumeName,
+		FileName:   volumeName + ".vmdk",
+		Format:     FormatStreamOptimizedVMDK,
+		Capacity:   1024 * 1024,
+		Image:      image,
+	}
+}
+
+var _ = Describe("OVF", func() {
+	descriptor := func(vmi *virtv1.VirtualMachineInstance, disks ...Disk) (node, []Disk) {
+		vm := libvmi.NewVirtualMachine(vmi)
+		data, used, err := Descriptor(vm, disks)
+		Expect(err).ToNot(HaveOccurred())
+		return parseDescriptor(data), used
+	}
+
+	It("should fail without a template", func() {
+		_, _, err := Descriptor(&virtv1.VirtualMachine{}, nil)
+		Expect(err).To(MatchError(ContainSubstring("has no template")))
+	})
+
+	It("should map the CPU topology and the guest memory", func() {
+		root, _ := descriptor(libvmi.New(
+			libvmi.WithCPUCount(2, 1, 2),
+			libvmi.WithGuestMemory("1Gi"),
+		))
+
+		cpus := root.items("3")
+		Expect(cpus).To(HaveLen(1))
+		Expect(cpus[0].child("VirtualQuantity")).To(Equal("4"))
+		Expect(cpus[0].child("CoresPerSocket")).To(Equal("2"))
+		memory := root.items("4")
+		Expect(memory).To(HaveLen(1))
+		Expect(memory[0].child("AllocationUnits")).To(Equal("byte * 2^20"))
+		Expect(memory[0].child("VirtualQuantity")).To(Equal("1024"))
+		Expect(root.annotation()).To(BeEmpty())
+	})
+
+	It("should derive the number of CPUs from the resources without a topology", func() {
+		root, _ := descriptor(libvmi.New(
+			libvmi.WithCPULimit("1500m"),
+			libvmi.WithMemoryRequest("512Mi"),
+		))
+
+		Expect(root.items("3")[0].child("VirtualQuantity")).To(Equal("2"))
+		Expect(root.items("4")[0].child("VirtualQuantity")).To(Equal("512"))
+	})
+
+	DescribeTable("should map the firmware", func(vmi *virtv1.VirtualMachineInstance, expected map[string]string) {
+		root, _ := descriptor(vmi)
+
+		configs := make(map[string]string)
+		for _, config := range root.find("VirtualSystem", "VirtualHardwareSection", "Config") {
+			configs[config.attr("key")] = config.attr("value")
+		}
+		Expect(configs).To(Equal(expected))
+	},
+		Entry("BIOS by default", libvmi.New(), map[string]string{"firmware": "bios"}),
+		Entry("EFI with secure boot", libvmi.New(libvmi.WithUefi(true)), map[string]string{"firmware": "efi", "uefi.secureBoot.enabled": "true"}),
+		Entry("EFI without secure boot", libvmi.New(libvmi.WithUefi(false)), map[string]string{"firmware": "efi", "uefi.secureBoot.enabled": "false"}),
+	)
+
+	It("should use the firmware UUID as virtual system identifier", func() {
+		root, _ := descriptor(libvmi.New(libvmi.WithFirmwareUUID("2d0d0a61-43d3-4cbb-9f2f-0e1e09b56e1b")))
+
+		system := root.find("VirtualSystem", "VirtualHardwareSection", "System")
+		Expect(system).To(HaveLen(1))
+		Expect(system[0].child("VirtualSystemIdentifier")).To(Equal("2d0d0a61-43d3-4cbb-9f2f-0e1e09b56e1b"))
+		Expect(system[0].child("VirtualSystemType")).To(Equal("vmx-13"))
+	})
+
+	It("should reference the disk images of the exported volumes", func() {
+		vmi := libvmi.New(
+			libvmi.WithPersistentVolumeClaim("rootdisk", "root-pvc"),
+			libvmi.WithDataVolume("datadisk", "data-dv"),
+			libvmi.WithContainerDisk("containerdisk", "image"),
+		)
+		vmi.Spec.Domain.Devices.Disks[1].Disk.Bus = virtv1.DiskBusSATA
+		root, used := descriptor(vmi,
+			newDisk("root-pvc", fakeImage("root")),
+			newDisk("data-dv", fakeImage("data")),
+			newDisk("not-referenced", fakeImage("other")),
+		)
+
+		Expect(used).To(HaveLen(2))
+		Expect(used[0].VolumeName).To(Equal("root-pvc"))
+		Expect(used[1].VolumeName).To(Equal("data-dv"))
+
+		files := root.find("References", "File")
+		Expect(files).To(HaveLen(2))
+		Expect(files[0].attr("href")).To(Equal("root-pvc.vmdk"))
+		Expect(files[0].attr("size")).To(Equal("4"))
+		disks := root.find("DiskSection", "Disk")
+		Expect(disks).To(HaveLen(2))
+		Expect(disks[0].attr("diskId")).To(Equal("root-pvc"))
+		Expect(disks[0].attr("fileRef")).To(Equal(files[0].attr("id")))
+		Expect(disks[0].attr("capacity")).To(Equal("1048576"))
+		Expect(disks[0].attr("format")).To(Equal(FormatStreamOptimizedVMDK))
+
+		diskItems := root.items("17")
+		Expect(diskItems).To(HaveLen(2))
+		Expect(diskItems[0].child("HostResource")).To(Equal("ovf:/disk/root-pvc"))
+		Expect(diskItems[1].child("HostResource")).To(Equal("ovf:/disk/data-dv"))
+		// each bus has its own controller
+		Expect(diskItems[0].child("Parent")).ToNot(Equal(diskItems[1].child("Parent")))
+		scsiControllers := root.items("6")
+		Expect(scsiControllers).To(HaveLen(1))
+		Expect(scsiControllers[0].child("ResourceSubType")).To(Equal("lsilogic"))
+		sataControllers := root.items("20")
+		Expect(sataControllers).To(HaveLen(1))
+		Expect(sataControllers[0].child("ResourceSubType")).To(Equal("vmware.sata.ahci"))
+
+		Expect(root.annotation()).To(ContainSubstring("disks[containerdisk]: the containerDisk volume is not part of the package"))
+		Expect(root.annotation()).To(ContainSubstring("disks[rootdisk]: the virtio disk is attached to a SCSI controller"))
+	})
+
+	It("should map the network interfaces", func() {
+		iface := libvmi.InterfaceDeviceWithBridgeBinding("secondary")
+		iface.MacAddress = "02:00:00:00:00:01"
+		iface.Model = "e1000"
+		root, _ := descriptor(libvmi.New(
+			libvmi.WithGuestMemory("1Gi"),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithMasqueradeBinding()),
+			libvmi.WithNetwork(virtv1.DefaultPodNetwork()),
+			libvmi.WithInterface(iface),
+			libvmi.WithNetwork(libvmi.MultusNetwork("secondary", "ns/nad")),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithSRIOVBinding("sriov")),
+			libvmi.WithNetwork(libvmi.MultusNetwork("sriov", "ns/nad")),
+		))
+
+		networks := root.find("NetworkSection", "Network")
+		Expect(networks).To(HaveLen(2))
+		Expect(networks[0].attr("name")).To(Equal("default"))
+		Expect(networks[1].attr("name")).To(Equal("ns/nad"))
+
+		nics := root.items("10")
+		Expect(nics).To(HaveLen(3))
+		Expect(nics[0].child("Connection")).To(Equal("default"))
+		Expect(nics[0].child("ResourceSubType")).To(Equal("VmxNet3"))
+		Expect(nics[1].child("Connection")).To(Equal("ns/nad"))
+		Expect(nics[1].child("Address")).To(Equal("02:00:00:00:00:01"))
+		Expect(nics[1].child("ResourceSubType")).To(Equal("E1000"))
+
+		Expect(strings.Split(root.annotation(), "\n")).To(ConsistOf(
+			"spec.template.spec.domain.devices.interfaces[default]: the virtio interface is exported as VmxNet3",
+			"spec.template.spec.domain.devices.interfaces[sriov]: the virtio interface is exported as VmxNet3",
+			"spec.template.spec.domain.devices.interfaces[sriov].sriov",
+		))
+	})
+
+	It("should annotate the fields which cannot be mapped", func() {
+		vmi := libvmi.New(
+			libvmi.WithCPUModel("host-passthrough"),
+			libvmi.WithHugepages("2Mi"),
+			libvmi.WithNodeSelectorFor("node01"),
+		)
+		root, _ := descriptor(vmi)
+
+		annotation := root.annotation()
+		Expect(strings.Split(annotation, "\n")).To(ConsistOf(
+			"spec.template.spec.domain.cpu.model: host-passthrough",
+			"spec.template.spec.domain.memory.hugepages",
+			"spec.template.spec.domain.memory: the guest memory is not set",
+			"spec.template.spec.nodeSelector",
+		))
+	})
+
+	It("should write an OVA with the descriptor followed by the referenced disk images", func() {
+		vm := libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithPersistentVolumeClaim("rootdisk", "root-pvc"),
+		))
+		vm.Name = "testvm"
+		buf := &bytes.Buffer{}
+		Expect(WriteOVA(buf, vm, []Disk{
+			newDisk("root-pvc", fakeImage("root disk content")),
+			newDisk("not-referenced", fakeImage("other")),
+		})).To(Succeed())
+
+		tr := tar.NewReader(buf)
+		header, err := tr.Next()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(header.Name).To(Equal("testvm.ovf"))
+		descriptor, err := io.ReadAll(tr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parseDescriptor(descriptor).find("References", "File")).To(HaveLen(1))
+
+		header, err = tr.Next()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(header.Name).To(Equal("root-pvc.vmdk"))
+		content, err := io.ReadAll(tr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("root disk content"))
+
+		_, err = tr.Next()
+		Expect(err).To(MatchError(io.EOF))
+	})
+})
@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package qcow2
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/extents"
+)
+
+const (
+	minClusterBits = 9
+	maxClusterBits = 21
+
+	incompatExtendedL2 = uint64(1) << 4
+
+	tableOffsetMask  = uint64(0x00fffffffffffe00)
+	l2FlagCompressed = uint64(1) << 62
+	l2FlagZero       = uint64(1) << 0
+)
+
+// Map returns the ranges of the guest volume which are stored in the QCOW2 image read from r,
+// ranges reading as zeros are returned with Data unset. The ranges the image leaves unallocated
+// are not part of the result, for an image without backing file they read as zeros and for an
+// incremental backup they are the ranges which did not change since the base checkpoint.
+func Map(r io.ReaderAt) ([]extents.Extent, error) {
+	header := make([]byte, headerLength)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil, fmt.Errorf("error reading the QCOW2 header: %w", err)
+	}
+	be := binary.BigEndian
+	if be.Uint32(header[0:]) != magic {
+		return nil, fmt.Errorf("not a QCOW2 image")
+	}
+	imageVersion := be.Uint32(header[4:])
+	if imageVersion != 2 && imageVersion != version {
+		return nil, fmt.Errorf("unsupported QCOW2 version %d", imageVersion)
+	}
+	bits := be.Uint32(header[20:])
+	if bits < minClusterBits || bits > maxClusterBits {
+		return nil, fmt.Errorf("invalid QCOW2 cluster bits %d", bits)
+	}
+	if imageVersion == version && be.Uint64(header[72:])&incompatExtendedL2 != 0 {
+		return nil, fmt.Errorf("QCOW2 images with extended L2 entries are not supported")
+	}
+	clusterSize := int64(1) << bits
+	virtualSize := int64(be.Uint64(header[24:]))
+	l1Size := int64(be.Uint32(header[36:]))
+	l1Offset := int64(be.Uint64(header[40:]))
+
+	l1Table := make([]byte, l1Size*tableEntrySize)
+	if _, err := r.ReadAt(l1Table, l1Offset); err != nil {
+		return nil, fmt.Errorf("error reading the L1 table: %w", err)
+	}
+
+	var result []extents.Extent
+	add := func(start int64, data bool) {
+		length := min(clusterSize, virtualSize-start)
+		if length <= 0 {
+			return
+		}
+		if n := len(result); n > 0 && result[n-1].Data == data && result[n-1].Start+result[n-1].Length == start {
+			result[n-1].Length += length
+			return
+		}
+		result = append(result, extents.Extent{Start: start, Length: length, Data: data})
+	}
+
+	entriesPerTable := clusterSize / tableEntrySize
+	l2Table := make([]byte, clusterSize)
+	for l1Index := int64(0); l1Index < l1Size; l1Index++ {
+		l2Offset := int64(be.Uint64(l1Table[l1Index*tableEntrySize:]) & tableOffsetMask)
+		if l2Offset == 0 {
+			continue
+		}
+		if _, err := r.ReadAt(l2Table, l2Offset); err != nil {
+			return nil, fmt.Errorf("error reading the L2 table at %d: %w", l2Offset, err)
+		}
+		for l2Index := int64(0); l2Index < entriesPerTable; l2Index++ {
+			entry := be.Uint64(l2Table[l2Index*tableEntrySize:])
+			start := (l1Index*entriesPerTable + l2Index) * clusterSize
+			switch {
+			case entry&l2FlagCompressed != 0:
+				add(start, true)
+			case imageVersion == version && entry&l2FlagZero != 0:
+				add(start, false)
+			case entry&tableOffsetMask != 0:
+				add(start, true)
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package qcow2
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/extents"
+)
+
+var _ = Describe("QCOW2 map", func() {
+	// setL2Entry overwrites the L2 entry of a guest cluster of an image written by NewImage
+	setL2Entry := func(reader imageReader, cluster int64, entry uint64) {
+		l1Entry := reader.uint64At(int64(reader.uint64At(40)) + cluster/l2EntriesPerTable*tableEntrySize)
+		Expect(l1Entry).ToNot(BeZero())
+		l2Offset := int64(l1Entry &^ oflagCopied)
+		binary.BigEndian.PutUint64(reader.data[l2Offset+cluster%l2EntriesPerTable*tableEntrySize:], entry)
+	}
+
+	It("should return the stored clusters", func() {
+		volume := patternVolume{size: gib + 70000}
+		_, reader := writeImage(volume, []extents.Extent{
+			{Start: 0, Length: 100000, Data: true},
+			{Start: 100000, Length: 600*mib - 100000},
+			{Start: 600 * mib, Length: 32 * mib, Data: true},
+			{Start: 632 * mib, Length: 392 * mib},
+			{Start: gib, Length: 70000, Data: true},
+		})
+
+		result, err := Map(bytes.NewReader(reader.data))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal([]extents.Extent{
+			{Start: 0, Length: 2 * ClusterSize, Data: true},
+			{Start: 600 * mib, Length: 32 * mib, Data: true},
+			// the last cluster is cut at the virtual size
+			{Start: gib, Length: 70000, Data: true},
+		}))
+	})
+
+	It("should return nothing for an image without stored clusters", func() {
+		_, reader := writeImage(patternVolume{size: 10 * gib}, []extents.Extent{{Start: 0, Length: 10 * gib}})
+
+		result, err := Map(bytes.NewReader(reader.data))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(BeEmpty())
+	})
+
+	It("should return zero and compressed clusters", func() {
+		volume := patternVolume{size: 4 * ClusterSize}
+		_, reader := writeImage(volume, []extents.Extent{{Start: 0, Length: volume.size, Data: true}})
+		setL2Entry(reader, 1, l2FlagZero)
+		setL2Entry(reader, 2, l2FlagCompressed|0x1000)
+
+		result, err := Map(bytes.NewReader(reader.data))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal([]extents.Extent{
+			{Start: 0, Length: ClusterSize, Data: true},
+			{Start: ClusterSize, Length: ClusterSize, Data: false},
+			{Start: 2 * ClusterSize, Length: 2 * ClusterSize, Data: true},
+		}))
+	})
+
+	DescribeTable("should reject", func(modify func(data []byte), expectedError string) {
+		_, reader := writeImage(patternVolume{size: mib}, []extents.Extent{{Start: 0, Length: mib, Data: true}})
+		modify(reader.data)
+
+		_, err := Map(bytes.NewReader(reader.data))
+		Expect(err).To(MatchError(ContainSubstring(expectedError)))
+	},
+		Entry("a file which is not a QCOW2 image", func(data []byte) {
+			binary.BigEndian.PutUint32(data[0:], 0)
+		}, "not a QCOW2 image"),
+		Entry("an unknown version", func(data []byte) {
+			binary.BigEndian.PutUint32(data[4:], 4)
+		}, "unsupported QCOW2 version 4"),
+		Entry("an invalid cluster size", func(data []byte) {
+			binary.BigEndian.PutUint32(data[20:], 30)
+		}, "invalid QCOW2 cluster bits 30"),
+		Entry("extended L2 entries", func(data []byte) {
+			binary.BigEndian.PutUint64(data[72:], incompatExtendedL2)
+		}, "extended L2 entries are not supported"),
+	)
+})
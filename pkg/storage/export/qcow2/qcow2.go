@@ -0,0 +1,296 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package qcow2 streams a RAW volume as a QCOW2 image.
+//
+// The image layout is computed upfront from the extents of the volume, so the
+// image can be written sequentially without seeking:
+// header | refcount table | refcount blocks | L1 table | L2 tables | data clusters
+// Only the clusters overlapping data extents are stored, holes are left unallocated.
+//
+// Map reads back the stored ranges of an existing QCOW2 image, like the ones
+// written by a push mode VirtualMachineBackup.
+package qcow2
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/extents"
+)
+
+const (
+	clusterBits = 16
+	ClusterSize = 1 << clusterBits
+
+	magic         = 0x514649fb
+	version       = 3
+	headerLength  = 104
+	refcountOrder = 4
+
+	tableEntrySize    = 8
+	l2EntriesPerTable = ClusterSize / tableEntrySize
+	refcountsPerBlock = ClusterSize / 2
+	oflagCopied       = uint64(1) << 63
+)
+
+// clusterRange is a range of guest clusters stored in the image
+type clusterRange struct {
+	first, last int64
+	// index of the first cluster of the range among all stored clusters
+	base int64
+}
+
+// Image is the layout of a QCOW2 image of a RAW volume
+type Image struct {
+	source      io.ReaderAt
+	virtualSize int64
+	ranges      []clusterRange
+	l2Indexes   []int64
+
+	l1Size                int64
+	refcountTableClusters int64
+	refcountBlocks        int64
+	hostClusters          int64
+
+	refcountTableOffset int64
+	refcountBlockOffset int64
+	l1Offset            int64
+	l2Offset            int64
+	dataOffset          int64
+}
+
+// NewImage lays out the QCOW2 image of the volume read from source
+func NewImage(source io.ReaderAt, volumeExtents []extents.Extent) *Image {
+	img := &Image{
+		source:      source,
+		virtualSize: extents.Size(volumeExtents),
+	}
+
+	var dataClusters int64
+	for _, extent := range volumeExtents {
+		if !extent.Data || extent.Length == 0 {
+			continue
+		}
+		first := extent.Start / ClusterSize
+		last := divRoundUp(extent.Start+extent.Length, ClusterSize)
+		if n := len(img.ranges); n > 0 && img.ranges[n-1].last >= first {
+			if last > img.ranges[n-1].last {
+				dataClusters += last - img.ranges[n-1].last
+				img.ranges[n-1].last = last
+			}
+			continue
+		}
+		img.ranges = append(img.ranges, clusterRange{first: first, last: last, base: dataClusters})
+		dataClusters += last - first
+	}
+
+	for _, r := range img.ranges {
+		for index := r.first / l2EntriesPerTable; index <= (r.last-1)/l2EntriesPerTable; index++ {
+			if n := len(img.l2Indexes); n == 0 || img.l2Indexes[n-1] < index {
+				img.l2Indexes = append(img.l2Indexes, index)
+			}
+		}
+	}
+
+	img.l1Size = max(1, divRoundUp(divRoundUp(img.virtualSize, ClusterSize), l2EntriesPerTable))
+	l1Clusters := divRoundUp(img.l1Size*tableEntrySize, ClusterSize)
+	fixedClusters := 1 + l1Clusters + int64(len(img.l2Indexes)) + dataClusters
+
+	// the refcount structures have to account for themselves
+	img.refcountTableClusters = 1
+	for {
+		img.hostClusters = fixedClusters + img.refcountTableClusters + img.refcountBlocks
+		refcountBlocks := divRoundUp(img.hostClusters, refcountsPerBlock)
+		refcountTableClusters := max(1, divRoundUp(refcountBlocks*tableEntrySize, ClusterSize))
+		if refcountBlocks == img.refcountBlocks && refcountTableClusters == img.refcountTableClusters {
+			break
+		}
+		img.refcountBlocks = refcountBlocks
+		img.refcountTableClusters = refcountTableClusters
+	}
+
+	img.refcountTableOffset = ClusterSize
+	img.refcountBlockOffset = img.refcountTableOffset + img.refcountTableClusters*ClusterSize
+	img.l1Offset = img.refcountBlockOffset + img.refcountBlocks*ClusterSize
+	img.l2Offset = img.l1Offset + l1Clusters*ClusterSize
+	img.dataOffset = img.l2Offset + int64(len(img.l2Indexes))*ClusterSize
+
+	return img
+}
+
+// Size returns the size of the QCOW2 image
+func (img *Image) Size() int64 {
+	return img.hostClusters * ClusterSize
+}
+
+// VirtualSize returns the size of the volume in the image
+func (img *Image) VirtualSize() int64 {
+	return img.virtualSize
+}
+
+// WriteTo writes the QCOW2 image to w
+func (img *Image) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	buf := make([]byte, ClusterSize)
+
+	writers := []func(io.Writer, []byte) error{
+		img.writeHeader,
+		img.writeRefcountTable,
+		img.writeRefcountBlocks,
+		img.writeL1Table,
+		img.writeL2Tables,
+		img.writeData,
+	}
+	for _, write := range writers {
+		if err := write(cw, buf); err != nil {
+			return cw.n, err
+		}
+	}
+
+	return cw.n, nil
+}
+
+func (img *Image) writeHeader(w io.Writer, buf []byte) error {
+	clear(buf)
+	be := binary.BigEndian
+	be.PutUint32(buf[0:], magic)
+	be.PutUint32(buf[4:], version)
+	be.PutUint32(buf[20:], clusterBits)
+	be.PutUint64(buf[24:], uint64(img.virtualSize))
+	be.PutUint32(buf[36:], uint32(img.l1Size))
+	be.PutUint64(buf[40:], uint64(img.l1Offset))
+	be.PutUint64(buf[48:], uint64(img.refcountTableOffset))
+	be.PutUint32(buf[56:], uint32(img.refcountTableClusters))
+	be.PutUint32(buf[96:], refcountOrder)
+	be.PutUint32(buf[100:], headerLength)
+	// the zeroed remainder terminates the (empty) header extension area
+	_, err := w.Write(buf)
+	return err
+}
+
+func (img *Image) writeRefcountTable(w io.Writer, buf []byte) error {
+	return writeTable(w, buf, img.refcountTableClusters, func(index int64) uint64 {
+		if index >= img.refcountBlocks {
+			return 0
+		}
+		return uint64(img.refcountBlockOffset + index*ClusterSize)
+	})
+}
+
+func (img *Image) writeRefcountBlocks(w io.Writer, buf []byte) error {
+	for block := int64(0); block < img.refcountBlocks; block++ {
+		clear(buf)
+		for i := int64(0); i < refcountsPerBlock; i++ {
+			if block*refcountsPerBlock+i >= img.hostClusters {
+				break
+			}
+			binary.BigEndian.PutUint16(buf[i*2:], 1)
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (img *Image) writeL1Table(w io.Writer, buf []byte) error {
+	l2Tables := make(map[int64]int64, len(img.l2Indexes))
+	for i, index := range img.l2Indexes {
+		l2Tables[index] = int64(i)
+	}
+	l1Clusters := (img.l2Offset - img.l1Offset) / ClusterSize
+	return writeTable(w, buf, l1Clusters, func(index int64) uint64 {
+		table, ok := l2Tables[index]
+		if !ok {
+			return 0
+		}
+		return uint64(img.l2Offset+table*ClusterSize) | oflagCopied
+	})
+}
+
+func (img *Image) writeL2Tables(w io.Writer, buf []byte) error {
+	rangeIndex := 0
+	for _, index := range img.l2Indexes {
+		clear(buf)
+		tableFirst := index * l2EntriesPerTable
+		tableLast := tableFirst + l2EntriesPerTable
+		// skip the ranges ending before the table
+		for rangeIndex < len(img.ranges) && img.ranges[rangeIndex].last <= tableFirst {
+			rangeIndex++
+		}
+		for i := rangeIndex; i < len(img.ranges) && img.ranges[i].first < tableLast; i++ {
+			r := img.ranges[i]
+			for cluster := max(r.first, tableFirst); cluster < min(r.last, tableLast); cluster++ {
+				hostOffset := img.dataOffset + (r.base+cluster-r.first)*ClusterSize
+				binary.BigEndian.PutUint64(buf[(cluster-tableFirst)*tableEntrySize:], uint64(hostOffset)|oflagCopied)
+			}
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (img *Image) writeData(w io.Writer, buf []byte) error {
+	for _, r := range img.ranges {
+		for cluster := r.first; cluster < r.last; cluster++ {
+			n, err := img.source.ReadAt(buf, cluster*ClusterSize)
+			if err != nil && !errors.Is(err, io.EOF) {
+				return err
+			}
+			// the last cluster of the volume may be partial
+			clear(buf[n:])
+			if _, err := w.Write(buf); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// writeTable writes clusters filled with the big endian entries returned by entry
+func writeTable(w io.Writer, buf []byte, clusters int64, entry func(index int64) uint64) error {
+	for cluster := int64(0); cluster < clusters; cluster++ {
+		for i := int64(0); i < ClusterSize/tableEntrySize; i++ {
+			binary.BigEndian.PutUint64(buf[i*tableEntrySize:], entry(cluster*ClusterSize/tableEntrySize+i))
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func divRoundUp(n, d int64) int64 {
+	return (n + d - 1) / d
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
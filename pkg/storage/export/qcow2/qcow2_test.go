@@ -0,0 +1,185 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package qcow2
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/extents"
+)
+
+const (
+	mib = int64(1024 * 1024)
+	gib = 1024 * mib
+)
+
+// patternVolume is a volume whose bytes are derived from their offset
+type patternVolume struct {
+	size int64
+}
+
+func (v patternVolume) byteAt(offset int64) byte {
+	return byte(offset%251) + 1
+}
+
+func (v patternVolume) ReadAt(p []byte, off int64) (int, error) {
+	n := 0
+	for ; n < len(p) && off+int64(n) < v.size; n++ {
+		p[n] = v.byteAt(off + int64(n))
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// imageReader decodes the guest clusters of a QCOW2 image
+type imageReader struct {
+	data []byte
+}
+
+func (r imageReader) uint32At(offset int64) uint32 {
+	return binary.BigEndian.Uint32(r.data[offset:])
+}
+
+func (r imageReader) uint64At(offset int64) uint64 {
+	return binary.BigEndian.Uint64(r.data[offset:])
+}
+
+// hostOffset returns the offset of a guest cluster in the image, 0 if it is unallocated
+func (r imageReader) hostOffset(cluster int64) int64 {
+	l1Offset := int64(r.uint64At(40))
+	l1Entry := r.uint64At(l1Offset + cluster/l2EntriesPerTable*tableEntrySize)
+	if l1Entry == 0 {
+		return 0
+	}
+	Expect(l1Entry & oflagCopied).ToNot(BeZero())
+	l2Offset := int64(l1Entry &^ oflagCopied)
+	l2Entry := r.uint64At(l2Offset + cluster%l2EntriesPerTable*tableEntrySize)
+	if l2Entry == 0 {
+		return 0
+	}
+	Expect(l2Entry & oflagCopied).ToNot(BeZero())
+	return int64(l2Entry &^ oflagCopied)
+}
+
+func (r imageReader) refcount(hostCluster int64) uint16 {
+	refcountTableOffset := int64(r.uint64At(48))
+	blockOffset := int64(r.uint64At(refcountTableOffset + hostCluster/refcountsPerBlock*tableEntrySize))
+	Expect(blockOffset).ToNot(BeZero())
+	return binary.BigEndian.Uint16(r.data[blockOffset+hostCluster%refcountsPerBlock*2:])
+}
+
+func writeImage(volume patternVolume, volumeExtents []extents.Extent) (*Image, imageReader) {
+	img := NewImage(volume, volumeExtents)
+	buf := &bytes.Buffer{}
+	n, err := img.WriteTo(buf)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(n).To(Equal(img.Size()))
+	Expect(int64(buf.Len())).To(Equal(img.Size()))
+	return img, imageReader{data: buf.Bytes()}
+}
+
+var _ = Describe("QCOW2 image", func() {
+	It("should write a valid header", func() {
+		volume := patternVolume{size: 10 * mib}
+		img, reader := writeImage(volume, []extents.Extent{{Start: 0, Length: volume.size, Data: true}})
+
+		Expect(reader.uint32At(0)).To(BeEquivalentTo(magic))
+		Expect(reader.uint32At(4)).To(BeEquivalentTo(3))
+		Expect(reader.uint32At(20)).To(BeEquivalentTo(clusterBits))
+		Expect(reader.uint64At(24)).To(BeEquivalentTo(volume.size))
+		Expect(reader.uint32At(36)).To(BeEquivalentTo(1))
+		Expect(reader.uint32At(96)).To(BeEquivalentTo(refcountOrder))
+		Expect(reader.uint32At(100)).To(BeEquivalentTo(headerLength))
+		Expect(img.VirtualSize()).To(Equal(volume.size))
+	})
+
+	It("should only store the clusters of data extents", func() {
+		volume := patternVolume{size: gib + 70000}
+		volumeExtents := []extents.Extent{
+			{Start: 0, Length: 100000, Data: true},
+			{Start: 100000, Length: 600*mib - 100000},
+			// spans the boundary of the first L2 table
+			{Start: 600 * mib, Length: 32 * mib, Data: true},
+			{Start: 632 * mib, Length: 392 * mib},
+			// ends with a partial cluster
+			{Start: gib, Length: 70000, Data: true},
+		}
+		_, reader := writeImage(volume, volumeExtents)
+
+		isData := func(cluster int64) bool {
+			offset := cluster * ClusterSize
+			for _, extent := range volumeExtents {
+				if extent.Data && offset < extent.Start+extent.Length && offset+ClusterSize > extent.Start {
+					return true
+				}
+			}
+			return false
+		}
+
+		guestClusters := divRoundUp(volume.size, ClusterSize)
+		for cluster := int64(0); cluster < guestClusters; cluster++ {
+			hostOffset := reader.hostOffset(cluster)
+			if !isData(cluster) {
+				Expect(hostOffset).To(BeZero(), "cluster %d should be unallocated", cluster)
+				continue
+			}
+			Expect(hostOffset).ToNot(BeZero(), "cluster %d should be allocated", cluster)
+			Expect(reader.refcount(hostOffset / ClusterSize)).To(BeEquivalentTo(1))
+
+			expected := make([]byte, ClusterSize)
+			n, _ := volume.ReadAt(expected, cluster*ClusterSize)
+			Expect(n).To(BeNumerically(">", 0))
+			Expect(bytes.Equal(reader.data[hostOffset:hostOffset+ClusterSize], expected)).To(BeTrue(), "cluster %d differs", cluster)
+		}
+	})
+
+	It("should reference count all clusters of the image", func() {
+		volume := patternVolume{size: 64 * mib}
+		img, reader := writeImage(volume, []extents.Extent{{Start: 0, Length: volume.size, Data: true}})
+
+		for hostCluster := int64(0); hostCluster < img.Size()/ClusterSize; hostCluster++ {
+			Expect(reader.refcount(hostCluster)).To(BeEquivalentTo(1), "host cluster %d", hostCluster)
+		}
+	})
+
+	It("should not store any cluster of an unallocated volume", func() {
+		volume := patternVolume{size: 10 * gib}
+		img, reader := writeImage(volume, []extents.Extent{{Start: 0, Length: volume.size}})
+
+		Expect(reader.uint64At(24)).To(BeEquivalentTo(volume.size))
+		// header, refcount table and block, L1 table
+		Expect(img.Size()).To(Equal(4 * int64(ClusterSize)))
+		Expect(reader.hostOffset(0)).To(BeZero())
+	})
+
+	It("should write an empty volume", func() {
+		img, reader := writeImage(patternVolume{}, nil)
+
+		Expect(reader.uint64At(24)).To(BeZero())
+		Expect(img.Size()).To(Equal(4 * int64(ClusterSize)))
+	})
+})
@@ -0,0 +1,81 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/qcow2"
+)
+
+var getBackupCheckpoint = func() ([]byte, error) {
+	return os.ReadFile(backupCheckpointPath)
+}
+
+// changedExtentsHandler returns the ranges stored in the QCOW2 file of a backed up volume,
+// for an incremental backup these are the ranges which changed since the base checkpoint
+func changedExtentsHandler(filePath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f, err := os.Open(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		changedExtents, err := qcow2.Map(f)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error mapping %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(changedExtents); err != nil {
+			log.Log.Reason(err).Error("error writing changed extents")
+		}
+	})
+}
+
+func backupCheckpointHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		data, err := getBackupCheckpoint()
+		if err != nil {
+			log.Log.Reason(err).Error("error reading backup checkpoint")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if _, err := w.Write(data); err != nil {
+			log.Log.Reason(err).Error("error writing backup checkpoint")
+		}
+	})
+}
@@ -34,9 +34,11 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/klauspost/compress/zstd"
 	gzip "github.com/klauspost/pgzip"
 	flag "github.com/spf13/pflag"
 	corev1 "k8s.io/api/core/v1"
@@ -51,6 +53,8 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/service"
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
+	"kubevirt.io/kubevirt/pkg/storage/export/extents"
+	"kubevirt.io/kubevirt/pkg/storage/export/qcow2"
 	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 )
 
@@ -58,11 +62,13 @@ const (
 	authHeader              = "x-kubevirt-export-token"
 	manifestCmBasePath      = "/manifest_data/"
 	vmManifestPath          = manifestCmBasePath + "virtualmachine-manifest"
+	backendStorageDvPath    = manifestCmBasePath + "backend-storage-dv"
 	internalLinkPath        = manifestCmBasePath + "internal_host"
 	internalCaConfigMapPath = manifestCmBasePath + "internal_ca_cm"
 	externalLinkPath        = manifestCmBasePath + "external_host"
 	externalCaConfigMapPath = manifestCmBasePath + "external_ca_cm"
 	exportNamePath          = manifestCmBasePath + "export-name"
+	backupCheckpointPath    = "/backup_data/backup-checkpoint"
 
 	external = "/external"
 	internal = "/internal"
@@ -90,8 +96,16 @@ type ExportServerConfig struct {
 	DirHandler         func(string, string) http.Handler
 	FileHandler        func(string) http.Handler
 	GzipHandler        func(string) http.Handler
+	Qcow2Handler       func(string) http.Handler
+	Qcow2ZstdHandler   func(string) http.Handler
+	ExtentsHandler     func(string) http.Handler
 	VmHandler          func([]export.VolumeInfo, func() (string, error), func() (*corev1.ConfigMap, error)) http.Handler
 	TokenSecretHandler func(TokenGetterFunc) http.Handler
+	OvfHandler         func([]export.VolumeInfo) http.Handler
+	OvaHandler         func([]export.VolumeInfo) http.Handler
+
+	ChangedExtentsHandler   func(string) http.Handler
+	BackupCheckpointHandler func() http.Handler
 
 	PermissionChecker func(string) bool
 
@@ -144,6 +158,18 @@ func (s *exportServer) initHandler() {
 		mux.Handle(filepath.Join(internal, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 		mux.Handle(filepath.Join(external, s.Paths.SecretURI), tokenChecker(s.TokenGetter, s.TokenSecretHandler(s.TokenGetter)))
 	}
+	if s.Paths.OvfURI != "" {
+		mux.Handle(filepath.Join(internal, s.Paths.OvfURI), tokenChecker(s.TokenGetter, s.OvfHandler(s.Paths.Volumes)))
+		mux.Handle(filepath.Join(external, s.Paths.OvfURI), tokenChecker(s.TokenGetter, s.OvfHandler(s.Paths.Volumes)))
+	}
+	if s.Paths.OvaURI != "" {
+		mux.Handle(filepath.Join(internal, s.Paths.OvaURI), tokenChecker(s.TokenGetter, s.OvaHandler(s.Paths.Volumes)))
+		mux.Handle(filepath.Join(external, s.Paths.OvaURI), tokenChecker(s.TokenGetter, s.OvaHandler(s.Paths.Volumes)))
+	}
+	if s.Paths.BackupCheckpointURI != "" {
+		mux.Handle(filepath.Join(internal, s.Paths.BackupCheckpointURI), tokenChecker(s.TokenGetter, s.BackupCheckpointHandler()))
+		mux.Handle(filepath.Join(external, s.Paths.BackupCheckpointURI), tokenChecker(s.TokenGetter, s.BackupCheckpointHandler()))
+	}
 	// Readiness probe
 	mux.HandleFunc(export.ReadinessPath, s.readyHandler)
 
@@ -188,6 +214,26 @@ func (s *exportServer) getHandlerMap(vi export.VolumeInfo) map[string]http.Handl
 		result[vi.RawGzURI] = s.GzipHandler(p)
 	}
 
+	if vi.Qcow2URI != "" {
+		result[vi.Qcow2URI] = s.Qcow2Handler(p)
+	}
+
+	if vi.Qcow2ZstdURI != "" {
+		result[vi.Qcow2ZstdURI] = s.Qcow2ZstdHandler(p)
+	}
+
+	if vi.ExtentsURI != "" {
+		result[vi.ExtentsURI] = s.ExtentsHandler(p)
+	}
+
+	if vi.BackupQcow2URI != "" {
+		result[vi.BackupQcow2URI] = s.FileHandler(p)
+	}
+
+	if vi.ChangedExtentsURI != "" {
+		result[vi.ChangedExtentsURI] = s.ChangedExtentsHandler(p)
+	}
+
 	return result
 }
 
@@ -247,6 +293,18 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.GzipHandler = gzipHandler
 	}
 
+	if es.Qcow2Handler == nil {
+		es.Qcow2Handler = qcow2Handler
+	}
+
+	if es.Qcow2ZstdHandler == nil {
+		es.Qcow2ZstdHandler = qcow2ZstdHandler
+	}
+
+	if es.ExtentsHandler == nil {
+		es.ExtentsHandler = extentsHandler
+	}
+
 	if es.VmHandler == nil {
 		es.VmHandler = vmHandler
 	}
@@ -255,6 +313,22 @@ func NewExportServer(config ExportServerConfig) service.Service {
 		es.TokenSecretHandler = secretHandler
 	}
 
+	if es.OvfHandler == nil {
+		es.OvfHandler = ovfHandler
+	}
+
+	if es.OvaHandler == nil {
+		es.OvaHandler = ovaHandler
+	}
+
+	if es.ChangedExtentsHandler == nil {
+		es.ChangedExtentsHandler = changedExtentsHandler
+	}
+
+	if es.BackupCheckpointHandler == nil {
+		es.BackupCheckpointHandler = backupCheckpointHandler
+	}
+
 	if es.TokenGetter == nil {
 		es.TokenGetter = func() (string, error) {
 			return getToken(es.TokenFile)
@@ -402,6 +476,23 @@ var getDataVolumes = func(vm *virtv1.VirtualMachine) ([]*cdiv1.DataVolume, error
 	return res, nil
 }
 
+// getBackendStorageDataVolume returns the DataVolume recreating the backend-storage PVC, holding
+// the persistent TPM and EFI state of the VM, nil if the VM has no backend storage
+var getBackendStorageDataVolume = func() (*cdiv1.DataVolume, error) {
+	buf, err := os.ReadFile(backendStorageDvPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	dv := &cdiv1.DataVolume{}
+	if err := json.Unmarshal(buf, dv); err != nil {
+		return nil, err
+	}
+	return dv, nil
+}
+
 func newTarReader(mountPoint string) (io.ReadCloser, error) {
 	var excludeArgs []string
 	for name := range excludeMap {
@@ -582,6 +673,99 @@ func gzipHandler(filePath string) http.Handler {
 	})
 }
 
+// openQcow2Image lays out the QCOW2 image of a RAW volume, the image is
+// generated on the fly and only contains the allocated ranges of the volume
+func openQcow2Image(filePath string) (*os.File, *qcow2.Image, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	volumeExtents, err := extents.Map(f)
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	return f, qcow2.NewImage(f, volumeExtents), nil
+}
+
+func qcow2Handler(filePath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f, img, err := openQcow2Image(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error mapping %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		w.Header().Set("Content-Length", strconv.FormatInt(img.Size(), 10))
+		n, err := img.WriteTo(w)
+		if err != nil {
+			log.Log.Reason(err).Error("error writing response body")
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
+func qcow2ZstdHandler(filePath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f, img, err := openQcow2Image(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error mapping %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		zw, err := zstd.NewWriter(w)
+		if err != nil {
+			log.Log.Reason(err).Error("error creating zstd writer")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		n, err := img.WriteTo(zw)
+		if err != nil {
+			log.Log.Reason(err).Error("error piping to zstd")
+		}
+		if err = zw.Close(); err != nil {
+			log.Log.Reason(err).Error("error closing zstd writer")
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
+func extentsHandler(filePath string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f, err := os.Open(filePath)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error opening %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer f.Close()
+		volumeExtents, err := extents.Map(f)
+		if err != nil {
+			log.Log.Reason(err).Errorf("error mapping %s", filePath)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(volumeExtents); err != nil {
+			log.Log.Reason(err).Error("error writing extent map")
+		}
+	})
+}
+
 func vmHandler(vi []export.VolumeInfo, getBasePath func() (string, error), getCmFunc func() (*corev1.ConfigMap, error)) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
 		if req.Method != http.MethodGet {
@@ -623,6 +807,16 @@ func vmHandler(vi []export.VolumeInfo, getBasePath func() (string, error), getCm
 			APIVersion: "v1",
 		}
 		resources = append(resources, certCm)
+		// The backend storage goes before the VM, the VM adopts it instead of creating an empty one
+		backendStorageDv, err := getBackendStorageDataVolume()
+		if err != nil {
+			log.Log.Reason(err).Error("error reading backend storage datavolume information")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if backendStorageDv != nil {
+			resources = append(resources, exportDataVolume(backendStorageDv, vi, path, certCm.Name, headerSecretName))
+		}
 		expandedVm := getExpandedVM()
 		if expandedVm == nil {
 			log.Log.Reason(err).Error("error getting VM definition")
@@ -646,18 +840,7 @@ func vmHandler(vi []export.VolumeInfo, getBasePath func() (string, error), getCm
 			return
 		}
 		for _, dv := range datavolumes {
-			dv.TypeMeta = metav1.TypeMeta{
-				Kind:       "DataVolume",
-				APIVersion: "cdi.kubevirt.io/v1beta1",
-			}
-			for _, info := range vi {
-				if strings.Contains(info.RawGzURI, dv.Name) {
-					dv.Spec.Source.HTTP.URL = fmt.Sprintf("https://%s", filepath.Join(path, info.RawGzURI))
-				}
-			}
-			dv.Spec.Source.HTTP.CertConfigMap = certCm.Name
-			dv.Spec.Source.HTTP.SecretExtraHeaders = []string{headerSecretName}
-			resources = append(resources, dv)
+			resources = append(resources, exportDataVolume(dv, vi, path, certCm.Name, headerSecretName))
 		}
 		data, err := outputFunc(resources)
 		if err != nil {
@@ -674,6 +857,26 @@ func vmHandler(vi []export.VolumeInfo, getBasePath func() (string, error), getCm
 	})
 }
 
+// exportDataVolume points the HTTP source of the DataVolume to the matching volume of the export
+func exportDataVolume(dv *cdiv1.DataVolume, vi []export.VolumeInfo, path, certCmName, headerSecretName string) *cdiv1.DataVolume {
+	dv.TypeMeta = metav1.TypeMeta{
+		Kind:       "DataVolume",
+		APIVersion: "cdi.kubevirt.io/v1beta1",
+	}
+	for _, info := range vi {
+		uri := info.RawGzURI
+		if dv.Spec.ContentType == cdiv1.DataVolumeArchive {
+			uri = info.ArchiveURI
+		}
+		if uri != "" && strings.Contains(uri, dv.Name) {
+			dv.Spec.Source.HTTP.URL = fmt.Sprintf("https://%s", filepath.Join(path, uri))
+		}
+	}
+	dv.Spec.Source.HTTP.CertConfigMap = certCmName
+	dv.Spec.Source.HTTP.SecretExtraHeaders = []string{headerSecretName}
+	return dv
+}
+
 func resourceToBytesJson(resources []runtime.Object) ([]byte, error) {
 	list := corev1.List{
 		TypeMeta: metav1.TypeMeta{
@@ -20,13 +20,20 @@
 package virtexportserver
 
 import (
+	"archive/tar"
+	"bytes"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 
+	"github.com/klauspost/compress/zstd"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	v1 "k8s.io/api/core/v1"
@@ -39,6 +46,8 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"kubevirt.io/kubevirt/pkg/storage/export/export"
+	"kubevirt.io/kubevirt/pkg/storage/export/extents"
+	"kubevirt.io/kubevirt/pkg/storage/export/qcow2"
 )
 
 const (
@@ -63,12 +72,33 @@ func newTestServer(token string) *exportServer {
 		GzipHandler: func(string) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		Qcow2Handler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		Qcow2ZstdHandler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		ExtentsHandler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		VmHandler: func([]export.VolumeInfo, func() (string, error), func() (*v1.ConfigMap, error)) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
 		TokenSecretHandler: func(tgf TokenGetterFunc) http.Handler {
 			return http.HandlerFunc(successHandler)
 		},
+		OvfHandler: func([]export.VolumeInfo) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		OvaHandler: func([]export.VolumeInfo) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		ChangedExtentsHandler: func(string) http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
+		BackupCheckpointHandler: func() http.Handler {
+			return http.HandlerFunc(successHandler)
+		},
 		TokenGetter: func() (string, error) {
 			return token, nil
 		},
@@ -125,6 +155,31 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("qcow2 zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2ZstdURI: "/volume/v1/disk.qcow2.zst"},
+			"/volume/v1/disk.qcow2.zst",
+		),
+		Entry("extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", ExtentsURI: "/volume/v1/extents"},
+			"/volume/v1/extents",
+		),
+		Entry("backup qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", BackupQcow2URI: "/volume/v1/backup.qcow2"},
+			"/volume/v1/backup.qcow2",
+		),
+		Entry("changed extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", ChangedExtentsURI: "/volume/v1/changed-extents"},
+			"/volume/v1/changed-extents",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -180,6 +235,21 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("qcow2 zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2ZstdURI: "/volume/v1/disk.qcow2.zst"},
+			"/volume/v1/disk.qcow2.zst",
+		),
+		Entry("extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", ExtentsURI: "/volume/v1/extents"},
+			"/volume/v1/extents",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -232,6 +302,21 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("qcow2 zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2ZstdURI: "/volume/v1/disk.qcow2.zst"},
+			"/volume/v1/disk.qcow2.zst",
+		),
+		Entry("extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", ExtentsURI: "/volume/v1/extents"},
+			"/volume/v1/extents",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -283,6 +368,21 @@ var _ = Describe("exportserver", func() {
 			&export.VolumeInfo{Path: "/tmp", RawGzURI: "/volume/v1/disk.img.gz"},
 			"/volume/v1/disk.img.gz",
 		),
+		Entry("qcow2 URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2URI: "/volume/v1/disk.qcow2"},
+			"/volume/v1/disk.qcow2",
+		),
+		Entry("qcow2 zstd URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", Qcow2ZstdURI: "/volume/v1/disk.qcow2.zst"},
+			"/volume/v1/disk.qcow2.zst",
+		),
+		Entry("extents URI",
+			"",
+			&export.VolumeInfo{Path: "/tmp", ExtentsURI: "/volume/v1/extents"},
+			"/volume/v1/extents",
+		),
 		Entry("VM definition URI",
 			"/manifest",
 			nil,
@@ -295,6 +395,288 @@ var _ = Describe("exportserver", func() {
 		),
 	)
 
+	DescribeTable("should handle OVF and OVA", func(token string, uri string, expectedStatus int) {
+		es := newTestServer("foo")
+		es.Paths = &export.ServerPaths{OvfURI: "/manifests/ovf", OvaURI: "/manifests/ova"}
+		es.initHandler()
+
+		httpServer := httptest.NewServer(es.handler)
+		defer httpServer.Close()
+
+		client := http.Client{}
+		req, err := http.NewRequest("GET", httpServer.URL+uri, nil)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("x-kubevirt-export-token", token)
+		res, err := client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(expectedStatus))
+	},
+		Entry("internal OVF URI", "foo", "/internal/manifests/ovf", http.StatusOK),
+		Entry("external OVF URI", "foo", "/external/manifests/ovf", http.StatusOK),
+		Entry("internal OVA URI", "foo", "/internal/manifests/ova", http.StatusOK),
+		Entry("external OVA URI", "foo", "/external/manifests/ova", http.StatusOK),
+		Entry("OVF URI with bad token", "bar", "/internal/manifests/ovf", http.StatusUnauthorized),
+		Entry("OVA URI with bad token", "bar", "/internal/manifests/ova", http.StatusUnauthorized),
+	)
+
+	DescribeTable("should handle the backup checkpoint", func(token string, uri string, expectedStatus int) {
+		es := newTestServer("foo")
+		es.Paths = &export.ServerPaths{BackupCheckpointURI: "/manifests/backup-checkpoint"}
+		es.initHandler()
+
+		httpServer := httptest.NewServer(es.handler)
+		defer httpServer.Close()
+
+		client := http.Client{}
+		req, err := http.NewRequest("GET", httpServer.URL+uri, nil)
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("x-kubevirt-export-token", token)
+		res, err := client.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer res.Body.Close()
+		Expect(res.StatusCode).To(Equal(expectedStatus))
+	},
+		Entry("internal URI", "foo", "/internal/manifests/backup-checkpoint", http.StatusOK),
+		Entry("external URI", "foo", "/external/manifests/backup-checkpoint", http.StatusOK),
+		Entry("bad token", "bar", "/internal/manifests/backup-checkpoint", http.StatusUnauthorized),
+	)
+
+	Context("Sparse handlers", func() {
+		const size = 4 * qcow2.ClusterSize
+
+		var (
+			filePath string
+			data     []byte
+		)
+
+		BeforeEach(func() {
+			filePath = filepath.Join(GinkgoT().TempDir(), "disk.img")
+			f, err := os.Create(filePath)
+			Expect(err).ToNot(HaveOccurred())
+			defer f.Close()
+			Expect(f.Truncate(size)).To(Succeed())
+			data = []byte(strings.Repeat("data", 1024))
+			_, err = f.WriteAt(data, 0)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		get := func(handler http.Handler) *httptest.ResponseRecorder {
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			return rr
+		}
+
+		DescribeTable("should return error on non GET", func(handler func(string) http.Handler) {
+			rr := httptest.NewRecorder()
+			handler(filePath).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/", nil))
+			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+		},
+			Entry("qcow2", qcow2Handler),
+			Entry("qcow2 zstd", qcow2ZstdHandler),
+			Entry("extents", extentsHandler),
+			Entry("changed extents", changedExtentsHandler),
+		)
+
+		It("should return the extent map of the volume", func() {
+			rr := get(extentsHandler(filePath))
+			Expect(rr.Header().Get("Content-Type")).To(Equal("application/json"))
+			var volumeExtents []extents.Extent
+			Expect(json.Unmarshal(rr.Body.Bytes(), &volumeExtents)).To(Succeed())
+			Expect(extents.Size(volumeExtents)).To(BeEquivalentTo(size))
+			Expect(volumeExtents[0].Start).To(BeZero())
+			Expect(volumeExtents[0].Data).To(BeTrue())
+		})
+
+		It("should return the volume as QCOW2", func() {
+			rr := get(qcow2Handler(filePath))
+			body := rr.Body.Bytes()
+			Expect(rr.Header().Get("Content-Length")).To(Equal(strconv.Itoa(len(body))))
+			Expect(binary.BigEndian.Uint32(body)).To(BeEquivalentTo(0x514649fb))
+			Expect(binary.BigEndian.Uint64(body[24:])).To(BeEquivalentTo(size))
+			Expect(bytes.Contains(body, data)).To(BeTrue())
+		})
+
+		It("should return the volume as zstd compressed QCOW2", func() {
+			expected := get(qcow2Handler(filePath)).Body.Bytes()
+			rr := get(qcow2ZstdHandler(filePath))
+			zr, err := zstd.NewReader(rr.Body)
+			Expect(err).ToNot(HaveOccurred())
+			defer zr.Close()
+			body, err := io.ReadAll(zr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(Equal(expected))
+		})
+
+		It("should return the clusters stored in a QCOW2 backup", func() {
+			backupPath := filepath.Join(GinkgoT().TempDir(), "backup.qcow2")
+			Expect(os.WriteFile(backupPath, get(qcow2Handler(filePath)).Body.Bytes(), 0644)).To(Succeed())
+			rr := get(changedExtentsHandler(backupPath))
+			Expect(rr.Header().Get("Content-Type")).To(Equal("application/json"))
+			var changedExtents []extents.Extent
+			Expect(json.Unmarshal(rr.Body.Bytes(), &changedExtents)).To(Succeed())
+			Expect(changedExtents).To(Equal([]extents.Extent{{Start: 0, Length: qcow2.ClusterSize, Data: true}}))
+		})
+
+		It("should return 500 if the backup is not a QCOW2 image", func() {
+			rr := httptest.NewRecorder()
+			changedExtentsHandler(filePath).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+			Expect(rr.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Context("Backup checkpoint handler", func() {
+		var orgGetBackupCheckpoint = getBackupCheckpoint
+
+		AfterEach(func() {
+			getBackupCheckpoint = orgGetBackupCheckpoint
+		})
+
+		It("should return error on non GET", func() {
+			rr := httptest.NewRecorder()
+			backupCheckpointHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/", nil))
+			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return the checkpoint metadata", func() {
+			getBackupCheckpoint = func() ([]byte, error) {
+				return []byte(`{"backupName":"backup"}`), nil
+			}
+			rr := httptest.NewRecorder()
+			backupCheckpointHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(rr.Header().Get("Content-Type")).To(Equal("application/json"))
+			Expect(rr.Body.String()).To(Equal(`{"backupName":"backup"}`))
+		})
+
+		It("should return 500 if the checkpoint cannot be read", func() {
+			getBackupCheckpoint = func() ([]byte, error) {
+				return nil, fmt.Errorf("not found")
+			}
+			rr := httptest.NewRecorder()
+			backupCheckpointHandler().ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+			Expect(rr.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+
+	Context("OVF handlers", func() {
+		const size = 4 * qcow2.ClusterSize
+
+		var (
+			orgGetExpandedVM = getExpandedVM
+			volumePath       string
+			volumes          []export.VolumeInfo
+		)
+
+		BeforeEach(func() {
+			volumePath = filepath.Join(GinkgoT().TempDir(), "root-pvc")
+			Expect(os.Mkdir(volumePath, 0755)).To(Succeed())
+			f, err := os.Create(filepath.Join(volumePath, "disk.img"))
+			Expect(err).ToNot(HaveOccurred())
+			defer f.Close()
+			Expect(f.Truncate(size)).To(Succeed())
+			_, err = f.WriteAt([]byte("data"), 0)
+			Expect(err).ToNot(HaveOccurred())
+			volumes = []export.VolumeInfo{
+				{Path: volumePath, RawURI: "/volumes/root-pvc/disk.img"},
+				{Path: GinkgoT().TempDir(), ArchiveURI: "/volumes/archive/disk.tar.gz"},
+			}
+
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				return &virtv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "testvm",
+						Namespace: testNamespace,
+					},
+					Spec: virtv1.VirtualMachineSpec{
+						Template: &virtv1.VirtualMachineInstanceTemplateSpec{
+							Spec: virtv1.VirtualMachineInstanceSpec{
+								Domain: virtv1.DomainSpec{
+									Devices: virtv1.Devices{
+										Disks: []virtv1.Disk{{Name: "rootdisk"}},
+									},
+								},
+								Volumes: []virtv1.Volume{{
+									Name: "rootdisk",
+									VolumeSource: virtv1.VolumeSource{
+										PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+											PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+												ClaimName: "root-pvc",
+											},
+										},
+									},
+								}},
+							},
+						},
+					},
+				}
+			}
+		})
+
+		AfterEach(func() {
+			getExpandedVM = orgGetExpandedVM
+		})
+
+		DescribeTable("should return error on non GET", func(handler func([]export.VolumeInfo) http.Handler) {
+			rr := httptest.NewRecorder()
+			handler(volumes).ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/", nil))
+			Expect(rr.Code).To(Equal(http.StatusBadRequest))
+		},
+			Entry("OVF", ovfHandler),
+			Entry("OVA", ovaHandler),
+		)
+
+		DescribeTable("should return 500 if getExpandedVM returns nil", func(handler func([]export.VolumeInfo) http.Handler) {
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				return nil
+			}
+			rr := httptest.NewRecorder()
+			handler(volumes).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+			Expect(rr.Code).To(Equal(http.StatusInternalServerError))
+		},
+			Entry("OVF", ovfHandler),
+			Entry("OVA", ovaHandler),
+		)
+
+		It("should return the OVF descriptor referencing the disk images", func() {
+			rr := httptest.NewRecorder()
+			ovfHandler(volumes).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(rr.Header().Get("Content-Type")).To(Equal("application/xml"))
+			body := rr.Body.String()
+			Expect(body).To(ContainSubstring(`ovf:href="root-pvc.vmdk"`))
+			Expect(body).To(ContainSubstring(fmt.Sprintf(`ovf:capacity="%d"`, size)))
+			Expect(body).ToNot(ContainSubstring("archive"))
+		})
+
+		It("should return an OVA containing the descriptor and the streamOptimized VMDK disk images", func() {
+			f, img, err := openVMDKImage(filepath.Join(volumePath, "disk.img"), "root-pvc.vmdk")
+			Expect(err).ToNot(HaveOccurred())
+			defer f.Close()
+			expected := &bytes.Buffer{}
+			_, err = img.WriteTo(expected)
+			Expect(err).ToNot(HaveOccurred())
+			rr := httptest.NewRecorder()
+			ovaHandler(volumes).ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/", nil))
+			Expect(rr.Code).To(Equal(http.StatusOK))
+			Expect(rr.Header().Get("Content-Type")).To(Equal("application/x-tar"))
+
+			tr := tar.NewReader(rr.Body)
+			header, err := tr.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(header.Name).To(Equal("testvm.ovf"))
+			header, err = tr.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(header.Name).To(Equal("root-pvc.vmdk"))
+			image, err := io.ReadAll(tr)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(image).To(Equal(expected.Bytes()))
+			_, err = tr.Next()
+			Expect(err).To(MatchError(io.EOF))
+		})
+	})
+
 	Context("Vm handler", func() {
 		var (
 			orgGetExportName       = getExportName
@@ -302,6 +684,7 @@ var _ = Describe("exportserver", func() {
 			orgGetExpandedVM       = getExpandedVM
 			orgGetDataVolumes      = getDataVolumes
 			orgGetExternalBasePath = getExternalBasePath
+			orgGetBackendStorageDV = getBackendStorageDataVolume
 		)
 
 		verifyCmYaml := func(yamlString string) {
@@ -353,6 +736,9 @@ var _ = Describe("exportserver", func() {
 			getDataVolumes = func(vm *virtv1.VirtualMachine) ([]*cdiv1.DataVolume, error) {
 				return nil, nil
 			}
+			getBackendStorageDataVolume = func() (*cdiv1.DataVolume, error) {
+				return nil, nil
+			}
 		})
 
 		AfterEach(func() {
@@ -361,6 +747,7 @@ var _ = Describe("exportserver", func() {
 			getExpandedVM = orgGetExpandedVM
 			getDataVolumes = orgGetDataVolumes
 			getExternalBasePath = orgGetExternalBasePath
+			getBackendStorageDataVolume = orgGetBackendStorageDV
 		})
 
 		DescribeTable("Secret handler should return error on non GET", func(verb string) {
@@ -675,6 +1062,55 @@ var _ = Describe("exportserver", func() {
 			Expect(resDv.Spec.Source.HTTP).ToNot(BeNil())
 			Expect(resDv.Spec.Source.HTTP.URL).To(Equal("https://base_path/test-dv-volume0"))
 		})
+
+		It("Should return the backend storage DataVolume before the VM", func() {
+			getExpandedVM = func() *virtv1.VirtualMachine {
+				return &virtv1.VirtualMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-vm",
+						Namespace: testNamespace,
+					},
+				}
+			}
+			getBackendStorageDataVolume = func() (*cdiv1.DataVolume, error) {
+				return &cdiv1.DataVolume{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:   "persistent-state-for-test-vm-abcde",
+						Labels: map[string]string{"persistent-state-for": "test-vm"},
+					},
+					Spec: cdiv1.DataVolumeSpec{
+						Source: &cdiv1.DataVolumeSource{
+							HTTP: &cdiv1.DataVolumeSourceHTTP{},
+						},
+						ContentType: cdiv1.DataVolumeArchive,
+					},
+				}, nil
+			}
+
+			req, err := http.NewRequest("GET", "https://test.blah.invalid/internal/manifest?x-kubevirt-export-token=bar", nil)
+			req.Header.Set("Accept", runtime.ContentTypeYAML)
+			resp := httptest.NewRecorder()
+			Expect(err).ToNot(HaveOccurred())
+			handler := vmHandler([]export.VolumeInfo{
+				{
+					ArchiveURI: "volumes/persistent-state-for-test-vm-abcde/disk.tar.gz",
+					DirURI:     "volumes/persistent-state-for-test-vm-abcde/dir/",
+				},
+			}, getBasePath, getCaConfigMap)
+			handler.ServeHTTP(resp, req)
+			Expect(resp.Code).To(BeEquivalentTo(http.StatusOK))
+			out := strings.Split(resp.Body.String(), "---\n")
+			Expect(out).To(HaveLen(4))
+			verifyCmYaml(out[0])
+			resDv := &cdiv1.DataVolume{}
+			Expect(yaml.Unmarshal([]byte(out[1]), resDv)).To(Succeed())
+			Expect(resDv.Labels).To(HaveKeyWithValue("persistent-state-for", "test-vm"))
+			Expect(resDv.Spec.Source.HTTP.URL).To(Equal("https://base_path/volumes/persistent-state-for-test-vm-abcde/disk.tar.gz"))
+			Expect(resDv.Spec.Source.HTTP.CertConfigMap).To(Equal("test-ca-configmap"))
+			resVm := &virtv1.VirtualMachine{}
+			Expect(yaml.Unmarshal([]byte(out[2]), resVm)).To(Succeed())
+			Expect(resVm.Name).To(Equal("test-vm"))
+		})
 	})
 
 	Context("Secret handler", func() {
@@ -0,0 +1,163 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtexportserver
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/export"
+	"kubevirt.io/kubevirt/pkg/storage/export/extents"
+	"kubevirt.io/kubevirt/pkg/storage/export/ovf"
+	"kubevirt.io/kubevirt/pkg/storage/export/vmdk"
+)
+
+// openOvfDisks opens the streamOptimized VMDK images of the volumes which contain a disk image,
+// the returned files have to be closed by the caller
+func openOvfDisks(vi []export.VolumeInfo) ([]*os.File, []ovf.Disk, error) {
+	var files []*os.File
+	var disks []ovf.Disk
+	for _, info := range vi {
+		if info.RawURI == "" {
+			continue
+		}
+		filePath := info.Path
+		fi, err := os.Stat(filePath)
+		if err != nil {
+			closeFiles(files)
+			return nil, nil, err
+		}
+		if fi.IsDir() {
+			filePath = path.Join(filePath, "disk.img")
+		}
+		volumeName := filepath.Base(filepath.Clean(info.Path))
+		f, img, err := openVMDKImage(filePath, volumeName+".vmdk")
+		if err != nil {
+			closeFiles(files)
+			return nil, nil, fmt.Errorf("error mapping %s: %w", filePath, err)
+		}
+		files = append(files, f)
+		disks = append(disks, ovf.Disk{
+			VolumeName: volumeName,
+			FileName:   volumeName + ".vmdk",
+			Format:     ovf.FormatStreamOptimizedVMDK,
+			Capacity:   img.VirtualSize(),
+			Image:      img,
+		})
+	}
+	return files, disks, nil
+}
+
+func openVMDKImage(filePath, fileName string) (*os.File, *vmdk.Image, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	volumeExtents, err := extents.Map(f)
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	img, err := vmdk.NewImage(f, volumeExtents, fileName)
+	if err != nil {
+		f.Close()
+		return nil, nil, err
+	}
+	return f, img, nil
+}
+
+func closeFiles(files []*os.File) {
+	for _, f := range files {
+		f.Close()
+	}
+}
+
+func ovfHandler(vi []export.VolumeInfo) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		expandedVm := getExpandedVM()
+		if expandedVm == nil {
+			log.Log.Error("error getting VM definition")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		files, disks, err := openOvfDisks(vi)
+		if err != nil {
+			log.Log.Reason(err).Error("error opening disk images")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer closeFiles(files)
+		data, _, err := ovf.Descriptor(expandedVm, disks)
+		if err != nil {
+			log.Log.Reason(err).Error("error generating OVF descriptor")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		n, err := w.Write(data)
+		if err != nil {
+			log.Log.Reason(err).Error("error writing OVF descriptor")
+			return
+		}
+		log.Log.Infof("Wrote %d bytes\n", n)
+	})
+}
+
+func ovaHandler(vi []export.VolumeInfo) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		expandedVm := getExpandedVM()
+		if expandedVm == nil {
+			log.Log.Error("error getting VM definition")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		files, disks, err := openOvfDisks(vi)
+		if err != nil {
+			log.Log.Reason(err).Error("error opening disk images")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		defer closeFiles(files)
+		// Validate the descriptor before the response is committed
+		if _, _, err := ovf.Descriptor(expandedVm, disks); err != nil {
+			log.Log.Reason(err).Error("error generating OVF descriptor")
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-tar")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", expandedVm.Name+".ova"))
+		if err := ovf.WriteOVA(w, expandedVm, disks); err != nil {
+			log.Log.Reason(err).Error("error writing OVA")
+		}
+	})
+}
@@ -0,0 +1,342 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+// Package vmdk streams a RAW volume as a streamOptimized VMDK image, the disk
+// format of OVA packages.
+//
+// The image is written sequentially:
+// header | descriptor | grains | grain tables | grain directory | footer | end of stream
+// Every grain is preceded by a marker with its guest sector and compressed size.
+// Only the grains overlapping data extents are stored, holes are left unallocated.
+// The grains are deflated with stored blocks, so the size of the image is known
+// before it is written, as required by the tar headers of an OVA.
+package vmdk
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/extents"
+)
+
+const (
+	SectorSize = 512
+	// GrainSize is the size of the guest ranges the image is allocated in
+	GrainSize = grainSectors * SectorSize
+
+	grainSectors    = 128
+	gtesPerGT       = 512
+	gteSize         = 4
+	gtSectors       = gtesPerGT * gteSize / SectorSize
+	markerSize      = 12
+	magic           = 0x564d444b
+	version         = 3
+	compressDeflate = 1
+	gdAtEnd         = ^uint64(0)
+
+	flagValidNewlineDetection = 1 << 0
+	flagCompressed            = 1 << 16
+	flagMarkers               = 1 << 17
+
+	markerEOS    = 0
+	markerGT     = 1
+	markerGD     = 2
+	markerFooter = 3
+)
+
+// Image is the layout of a streamOptimized VMDK image of a RAW volume
+type Image struct {
+	source      io.ReaderAt
+	virtualSize int64
+	capacity    int64
+	grains      []int64
+	descriptor  []byte
+
+	compressedGrainSize int64
+	grainOffsets        []int64
+	gtOffsets           []int64
+	gdOffset            int64
+	size                int64
+}
+
+// NewImage lays out the streamOptimized VMDK image of the volume read from source
+func NewImage(source io.ReaderAt, volumeExtents []extents.Extent, fileName string) (*Image, error) {
+	img := &Image{
+		source:      source,
+		virtualSize: extents.Size(volumeExtents),
+	}
+	img.capacity = divRoundUp(img.virtualSize, SectorSize)
+	img.descriptor = descriptor(img.capacity, fileName)
+
+	for _, extent := range volumeExtents {
+		if !extent.Data || extent.Length == 0 {
+			continue
+		}
+		first := extent.Start / GrainSize
+		last := divRoundUp(extent.Start+extent.Length, GrainSize)
+		for grain := first; grain < last; grain++ {
+			if n := len(img.grains); n == 0 || img.grains[n-1] < grain {
+				img.grains = append(img.grains, grain)
+			}
+		}
+	}
+
+	compressedGrainSize, err := compressedSize(GrainSize)
+	if err != nil {
+		return nil, err
+	}
+	img.compressedGrainSize = compressedGrainSize
+
+	sector := 1 + divRoundUp(int64(len(img.descriptor)), SectorSize)
+	grainSectorCount := divRoundUp(markerSize+img.compressedGrainSize, SectorSize)
+	for range img.grains {
+		img.grainOffsets = append(img.grainOffsets, sector)
+		sector += grainSectorCount
+	}
+	for range img.numGTs() {
+		// every grain table is preceded by its marker
+		img.gtOffsets = append(img.gtOffsets, sector+1)
+		sector += 1 + gtSectors
+	}
+	img.gdOffset = sector + 1
+	sector += 1 + img.gdSectors()
+	// footer marker and footer
+	sector += 2
+	// end of stream marker
+	sector++
+	img.size = sector * SectorSize
+
+	return img, nil
+}
+
+// Size returns the size of the VMDK image
+func (img *Image) Size() int64 {
+	return img.size
+}
+
+// VirtualSize returns the size of the volume in the image
+func (img *Image) VirtualSize() int64 {
+	return img.virtualSize
+}
+
+func (img *Image) numGTs() int64 {
+	return max(1, divRoundUp(divRoundUp(img.capacity, grainSectors), gtesPerGT))
+}
+
+func (img *Image) gdSectors() int64 {
+	return divRoundUp(img.numGTs()*gteSize, SectorSize)
+}
+
+// WriteTo writes the VMDK image to w
+func (img *Image) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	writers := []func(io.Writer) error{
+		func(w io.Writer) error { return img.writeHeader(w, gdAtEnd) },
+		img.writeDescriptor,
+		img.writeGrains,
+		img.writeGrainTables,
+		img.writeGrainDirectory,
+		img.writeFooter,
+		func(w io.Writer) error { return writeMarker(w, 0, markerEOS) },
+	}
+	for _, write := range writers {
+		if err := write(cw); err != nil {
+			return cw.n, err
+		}
+	}
+	if cw.n != img.size {
+		return cw.n, fmt.Errorf("wrote %d bytes instead of the %d bytes of the image", cw.n, img.size)
+	}
+	return cw.n, nil
+}
+
+func (img *Image) writeHeader(w io.Writer, gdOffset uint64) error {
+	buf := make([]byte, SectorSize)
+	le := binary.LittleEndian
+	le.PutUint32(buf[0:], magic)
+	le.PutUint32(buf[4:], version)
+	le.PutUint32(buf[8:], flagValidNewlineDetection|flagCompressed|flagMarkers)
+	le.PutUint64(buf[12:], uint64(img.capacity))
+	le.PutUint64(buf[20:], grainSectors)
+	le.PutUint64(buf[28:], 1)
+	le.PutUint64(buf[36:], uint64(divRoundUp(int64(len(img.descriptor)), SectorSize)))
+	le.PutUint32(buf[44:], gtesPerGT)
+	le.PutUint64(buf[56:], gdOffset)
+	le.PutUint64(buf[64:], uint64(1+divRoundUp(int64(len(img.descriptor)), SectorSize)))
+	copy(buf[73:], "\n \r\n")
+	le.PutUint16(buf[77:], compressDeflate)
+	_, err := w.Write(buf)
+	return err
+}
+
+func (img *Image) writeDescriptor(w io.Writer) error {
+	return writePadded(w, img.descriptor)
+}
+
+func (img *Image) writeGrains(w io.Writer) error {
+	grain := make([]byte, GrainSize)
+	compressed := &bytes.Buffer{}
+	for _, index := range img.grains {
+		n, err := img.source.ReadAt(grain, index*GrainSize)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		// the last grain of the volume may be partial
+		clear(grain[n:])
+
+		compressed.Reset()
+		compressed.Write(make([]byte, markerSize))
+		if err := deflate(compressed, grain); err != nil {
+			return err
+		}
+		if int64(compressed.Len()-markerSize) != img.compressedGrainSize {
+			return fmt.Errorf("grain %d compressed to %d bytes instead of %d", index, compressed.Len()-markerSize, img.compressedGrainSize)
+		}
+		marker := compressed.Bytes()
+		binary.LittleEndian.PutUint64(marker[0:], uint64(index*grainSectors))
+		binary.LittleEndian.PutUint32(marker[8:], uint32(img.compressedGrainSize))
+		if err := writePadded(w, marker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (img *Image) writeGrainTables(w io.Writer) error {
+	grainIndex := 0
+	buf := make([]byte, gtSectors*SectorSize)
+	for gt := range img.numGTs() {
+		if err := writeMarker(w, gtSectors, markerGT); err != nil {
+			return err
+		}
+		clear(buf)
+		for ; grainIndex < len(img.grains) && img.grains[grainIndex] < (gt+1)*gtesPerGT; grainIndex++ {
+			entry := img.grains[grainIndex] - gt*gtesPerGT
+			binary.LittleEndian.PutUint32(buf[entry*gteSize:], uint32(img.grainOffsets[grainIndex]))
+		}
+		if _, err := w.Write(buf); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (img *Image) writeGrainDirectory(w io.Writer) error {
+	if err := writeMarker(w, uint64(img.gdSectors()), markerGD); err != nil {
+		return err
+	}
+	buf := make([]byte, img.gdSectors()*SectorSize)
+	for i, offset := range img.gtOffsets {
+		binary.LittleEndian.PutUint32(buf[i*gteSize:], uint32(offset))
+	}
+	_, err := w.Write(buf)
+	return err
+}
+
+func (img *Image) writeFooter(w io.Writer) error {
+	if err := writeMarker(w, 1, markerFooter); err != nil {
+		return err
+	}
+	return img.writeHeader(w, uint64(img.gdOffset))
+}
+
+// descriptor returns the embedded descriptor of a monolithic streamOptimized image
+func descriptor(capacity int64, fileName string) []byte {
+	const heads, sectors = 16, 63
+	cylinders := min(max(capacity/(heads*sectors), 1), 16383)
+	return []byte(fmt.Sprintf(`# Disk DescriptorFile
+version=1
+CID=fffffffe
+parentCID=ffffffff
+createType="streamOptimized"
+
+# Extent description
+RW %d SPARSE "%s"
+
+# The Disk Data Base
+#DDB
+
+ddb.virtualHWVersion = "4"
+ddb.geometry.cylinders = "%d"
+ddb.geometry.heads = "%d"
+ddb.geometry.sectors = "%d"
+ddb.adapterType = "lsilogic"
+`, capacity, fileName, cylinders, heads, sectors))
+}
+
+// writeMarker writes a metadata marker, which takes a whole sector
+func writeMarker(w io.Writer, sectors uint64, markerType uint32) error {
+	buf := make([]byte, SectorSize)
+	binary.LittleEndian.PutUint64(buf[0:], sectors)
+	binary.LittleEndian.PutUint32(buf[12:], markerType)
+	_, err := w.Write(buf)
+	return err
+}
+
+// writePadded writes data followed by zeros up to the next sector boundary
+func writePadded(w io.Writer, data []byte) error {
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if rem := len(data) % SectorSize; rem != 0 {
+		_, err := w.Write(make([]byte, SectorSize-rem))
+		return err
+	}
+	return nil
+}
+
+// deflate writes data as a zlib stream of stored blocks, whose size only
+// depends on the size of data
+func deflate(w io.Writer, data []byte) error {
+	zw, err := zlib.NewWriterLevel(w, zlib.NoCompression)
+	if err != nil {
+		return err
+	}
+	if _, err := zw.Write(data); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func compressedSize(size int) (int64, error) {
+	cw := &countingWriter{w: io.Discard}
+	if err := deflate(cw, make([]byte, size)); err != nil {
+		return 0, err
+	}
+	return cw.n, nil
+}
+
+func divRoundUp(n, d int64) int64 {
+	return (n + d - 1) / d
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
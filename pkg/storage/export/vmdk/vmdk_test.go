@@ -0,0 +1,192 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmdk
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/storage/export/extents"
+)
+
+const (
+	mib = int64(1024 * 1024)
+	gib = 1024 * mib
+)
+
+// patternVolume is a volume whose bytes are derived from their offset
+type patternVolume struct {
+	size int64
+}
+
+func (v patternVolume) byteAt(offset int64) byte {
+	return byte(offset%251) + 1
+}
+
+func (v patternVolume) ReadAt(p []byte, off int64) (int, error) {
+	n := 0
+	for ; n < len(p) && off+int64(n) < v.size; n++ {
+		p[n] = v.byteAt(off + int64(n))
+	}
+	if n < len(p) {
+		return n, io.EOF
+	}
+	return n, nil
+}
+
+// imageReader decodes the grains of a streamOptimized VMDK image
+type imageReader struct {
+	data []byte
+}
+
+func (r imageReader) uint32At(offset int64) uint32 {
+	return binary.LittleEndian.Uint32(r.data[offset:])
+}
+
+func (r imageReader) uint64At(offset int64) uint64 {
+	return binary.LittleEndian.Uint64(r.data[offset:])
+}
+
+// footer returns the offset of the footer, which precedes the end of stream marker
+func (r imageReader) footer() int64 {
+	footer := int64(len(r.data)) - 2*SectorSize
+	Expect(r.uint32At(footer - SectorSize + 12)).To(BeEquivalentTo(markerFooter))
+	Expect(r.uint32At(footer)).To(BeEquivalentTo(magic))
+	return footer
+}
+
+// grainOffset returns the offset of the marker of a guest grain, 0 if it is unallocated
+func (r imageReader) grainOffset(grain int64) int64 {
+	gdOffset := int64(r.uint64At(r.footer()+56)) * SectorSize
+	Expect(r.uint32At(gdOffset - SectorSize + 12)).To(BeEquivalentTo(markerGD))
+	gtOffset := int64(r.uint32At(gdOffset+grain/gtesPerGT*gteSize)) * SectorSize
+	Expect(gtOffset).ToNot(BeZero())
+	Expect(r.uint32At(gtOffset - SectorSize + 12)).To(BeEquivalentTo(markerGT))
+	return int64(r.uint32At(gtOffset+grain%gtesPerGT*gteSize)) * SectorSize
+}
+
+// grain inflates the grain whose marker is at offset
+func (r imageReader) grain(offset int64) (uint64, []byte) {
+	lba := r.uint64At(offset)
+	size := int64(r.uint32At(offset + 8))
+	zr, err := zlib.NewReader(bytes.NewReader(r.data[offset+markerSize : offset+markerSize+size]))
+	Expect(err).ToNot(HaveOccurred())
+	data, err := io.ReadAll(zr)
+	Expect(err).ToNot(HaveOccurred())
+	return lba, data
+}
+
+func writeImage(volume patternVolume, volumeExtents []extents.Extent) (*Image, imageReader) {
+	img, err := NewImage(volume, volumeExtents, "disk.vmdk")
+	Expect(err).ToNot(HaveOccurred())
+	buf := &bytes.Buffer{}
+	n, err := img.WriteTo(buf)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(n).To(Equal(img.Size()))
+	Expect(int64(buf.Len())).To(Equal(img.Size()))
+	return img, imageReader{data: buf.Bytes()}
+}
+
+var _ = Describe("streamOptimized VMDK image", func() {
+	It("should write a valid header and descriptor", func() {
+		volume := patternVolume{size: 10 * mib}
+		img, reader := writeImage(volume, []extents.Extent{{Start: 0, Length: volume.size, Data: true}})
+
+		Expect(reader.uint32At(0)).To(BeEquivalentTo(magic))
+		Expect(reader.uint32At(4)).To(BeEquivalentTo(version))
+		Expect(reader.uint32At(8)).To(BeEquivalentTo(flagValidNewlineDetection | flagCompressed | flagMarkers))
+		Expect(reader.uint64At(12)).To(BeEquivalentTo(volume.size / SectorSize))
+		Expect(reader.uint64At(20)).To(BeEquivalentTo(grainSectors))
+		Expect(reader.uint64At(56)).To(Equal(gdAtEnd))
+		Expect(binary.LittleEndian.Uint16(reader.data[77:])).To(BeEquivalentTo(compressDeflate))
+		Expect(img.VirtualSize()).To(Equal(volume.size))
+
+		descriptorOffset := int64(reader.uint64At(28)) * SectorSize
+		descriptorSize := int64(reader.uint64At(36)) * SectorSize
+		descriptor := string(reader.data[descriptorOffset : descriptorOffset+descriptorSize])
+		Expect(descriptor).To(ContainSubstring(`createType="streamOptimized"`))
+		Expect(descriptor).To(ContainSubstring(`RW 20480 SPARSE "disk.vmdk"`))
+
+		footer := reader.footer()
+		Expect(reader.uint64At(footer + 12)).To(Equal(reader.uint64At(12)))
+		Expect(reader.uint64At(footer + 56)).ToNot(Equal(gdAtEnd))
+	})
+
+	It("should only store the grains of data extents", func() {
+		volume := patternVolume{size: gib + 70000}
+		volumeExtents := []extents.Extent{
+			{Start: 0, Length: 100000, Data: true},
+			{Start: 100000, Length: 600*mib - 100000},
+			// spans the boundary of the first grain table
+			{Start: 600 * mib, Length: 32 * mib, Data: true},
+			{Start: 632 * mib, Length: 392 * mib},
+			// ends with a partial grain
+			{Start: gib, Length: 70000, Data: true},
+		}
+		_, reader := writeImage(volume, volumeExtents)
+
+		isData := func(grain int64) bool {
+			offset := grain * GrainSize
+			for _, extent := range volumeExtents {
+				if extent.Data && offset < extent.Start+extent.Length && offset+GrainSize > extent.Start {
+					return true
+				}
+			}
+			return false
+		}
+
+		guestGrains := divRoundUp(volume.size, GrainSize)
+		for grain := int64(0); grain < guestGrains; grain++ {
+			offset := reader.grainOffset(grain)
+			if !isData(grain) {
+				Expect(offset).To(BeZero(), "grain %d should be unallocated", grain)
+				continue
+			}
+			Expect(offset).ToNot(BeZero(), "grain %d should be allocated", grain)
+
+			expected := make([]byte, GrainSize)
+			n, _ := volume.ReadAt(expected, grain*GrainSize)
+			Expect(n).To(BeNumerically(">", 0))
+			lba, data := reader.grain(offset)
+			Expect(lba).To(BeEquivalentTo(grain * grainSectors))
+			Expect(bytes.Equal(data, expected)).To(BeTrue(), "grain %d differs", grain)
+		}
+	})
+
+	It("should not store any grain of an unallocated volume", func() {
+		volume := patternVolume{size: 10 * gib}
+		_, reader := writeImage(volume, []extents.Extent{{Start: 0, Length: volume.size}})
+
+		Expect(reader.uint64At(12)).To(BeEquivalentTo(volume.size / SectorSize))
+		Expect(reader.grainOffset(0)).To(BeZero())
+	})
+
+	It("should write an empty volume", func() {
+		_, reader := writeImage(patternVolume{}, nil)
+
+		Expect(reader.uint64At(12)).To(BeZero())
+		reader.footer()
+	})
+})
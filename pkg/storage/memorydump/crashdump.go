@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package memorydump
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	// CrashMemoryDumpVolumeName is the name of the virt-launcher pod volume
+	// the memory of a panicked guest is dumped to
+	CrashMemoryDumpVolumeName = "crash-memory-dump"
+
+	// CrashMemoryDumpStartedReason is the event reason set when the memory of a panicked guest is being dumped
+	CrashMemoryDumpStartedReason = "CrashMemoryDumpStarted"
+	// CrashMemoryDumpCompletedReason is the event reason set when the memory of a panicked guest was dumped
+	CrashMemoryDumpCompletedReason = "CrashMemoryDumpCompleted"
+	// CrashMemoryDumpFailedReason is the event reason set when the memory of a panicked guest could not be dumped
+	CrashMemoryDumpFailedReason = "CrashMemoryDumpFailed"
+
+	crashMemoryDumpName        = "crash"
+	defaultMaxCrashMemoryDumps = 1
+)
+
+// CrashMemoryDumpDir is where the pvc of the crash policy is mounted
+var CrashMemoryDumpDir = filepath.Join(util.VirtPrivateDir, CrashMemoryDumpVolumeName)
+
+// CrashMemoryDump returns the memory dump the crash policy of the vmi asks for, if any
+func CrashMemoryDump(vmi *v1.VirtualMachineInstance) *v1.CrashMemoryDump {
+	if vmi.Spec.CrashPolicy == nil {
+		return nil
+	}
+	return vmi.Spec.CrashPolicy.MemoryDump
+}
+
+// MaxCrashMemoryDumps returns the number of crash memory dumps retained in the pvc
+func MaxCrashMemoryDumps(crashMemoryDump *v1.CrashMemoryDump) int {
+	if crashMemoryDump == nil || crashMemoryDump.MaxDumps == nil {
+		return defaultMaxCrashMemoryDumps
+	}
+	return int(*crashMemoryDump.MaxDumps)
+}
+
+// CrashMemoryDumpFileName returns the name of the file the memory of the panicked guest is dumped to
+func CrashMemoryDumpFileName(vmiName string, format v1.MemoryDumpFormat, now time.Time) string {
+	return DumpFileName(vmiName, crashMemoryDumpName, format, now)
+}
+
+// IsCrashMemoryDumpFile returns true if the file is a crash memory dump of the vmi
+func IsCrashMemoryDumpFile(vmiName, fileName string) bool {
+	timestamp, found := strings.CutPrefix(fileName, fmt.Sprintf("%s-%s-", vmiName, crashMemoryDumpName))
+	// the name of the dump continues with its timestamp, not with the name of another vmi
+	return found && timestamp != "" && unicode.IsDigit(rune(timestamp[0])) &&
+		strings.HasSuffix(fileName, MemoryDumpFileSuffix)
+}
+
+// AppendCrashMemoryDump records a crash memory dump and keeps only the most recent
+// maxDumps of them, the same ones retained in the pvc
+func AppendCrashMemoryDump(dumps []v1.DomainMemoryDumpInfo, dump v1.DomainMemoryDumpInfo, maxDumps int) []v1.DomainMemoryDumpInfo {
+	dumps = append(dumps, dump)
+	if len(dumps) > maxDumps {
+		dumps = dumps[len(dumps)-maxDumps:]
+	}
+	return dumps
+}
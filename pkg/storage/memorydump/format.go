@@ -0,0 +1,70 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+package memorydump
+
+import (
+	"fmt"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// MemoryDumpFileSuffix marks a memory dump target as a core dump of the guest memory
+const MemoryDumpFileSuffix = ".memory.dump"
+
+var compressedFormats = []v1.MemoryDumpFormat{
+	v1.MemoryDumpFormatKdumpZlib,
+	v1.MemoryDumpFormatKdumpLzo,
+	v1.MemoryDumpFormatKdumpSnappy,
+}
+
+// IsValidFormat returns true if the memory can be dumped in the given format
+func IsValidFormat(format v1.MemoryDumpFormat) bool {
+	return format == "" || format == v1.MemoryDumpFormatELF || IsCompressedFormat(format)
+}
+
+// IsCompressedFormat returns true if the pages of the dump are compressed in the given format
+func IsCompressedFormat(format v1.MemoryDumpFormat) bool {
+	for _, compressedFormat := range compressedFormats {
+		if format == compressedFormat {
+			return true
+		}
+	}
+	return false
+}
+
+// DumpFileName returns the name of the file the memory of the vmi is dumped to,
+// compressed dumps carry their format in the name
+func DumpFileName(vmiName, volumeName string, format v1.MemoryDumpFormat, now time.Time) string {
+	suffix := MemoryDumpFileSuffix
+	if IsCompressedFormat(format) {
+		suffix = fmt.Sprintf(".%s%s", format, MemoryDumpFileSuffix)
+	}
+	return fmt.Sprintf("%s-%s-%s%s", vmiName, volumeName, now.Format("20060102-150405"), suffix)
+}
+
+// VolumeDumpFormat returns the format requested for the memory dump volume of the vmi
+func VolumeDumpFormat(vmi *v1.VirtualMachineInstance, volumeName string) v1.MemoryDumpFormat {
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name == volumeName && volume.MemoryDump != nil {
+			return volume.MemoryDump.Format
+		}
+	}
+	return ""
+}
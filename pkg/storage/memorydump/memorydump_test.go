@@ -165,6 +165,51 @@ var _ = Describe("MemoryDump", func() {
 		})
 	})
 
+	It("should add the memory dump volume with the requested format", func() {
+		vm, vmi := createVirtualMachineWithMemoryDump(v1.MemoryDumpAssociating)
+		vm.Status.MemoryDumpRequest.Format = v1.MemoryDumpFormatKdumpZlib
+
+		vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(HandleRequest(virtClient, vm, vmi, pvcStore)).To(Succeed())
+		Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(1))
+		Expect(vm.Spec.Template.Spec.Volumes[0].MemoryDump.Format).To(Equal(v1.MemoryDumpFormatKdumpZlib))
+
+		vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vm.Name, metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(vmi.Spec.Volumes).To(HaveLen(1))
+		Expect(vmi.Spec.Volumes[0].MemoryDump.Format).To(Equal(v1.MemoryDumpFormatKdumpZlib))
+		Expect(VolumeDumpFormat(vmi, testPVCName)).To(Equal(v1.MemoryDumpFormatKdumpZlib))
+	})
+
+	DescribeTable("should keep the compressed format in the target file name", func(format v1.MemoryDumpFormat, expectedSuffix string) {
+		Expect(DumpFileName(vmName, testPVCName, format, now.Time)).To(HaveSuffix(expectedSuffix))
+	},
+		Entry("default", v1.MemoryDumpFormat(""), "-"+now.Format("20060102-150405")+".memory.dump"),
+		Entry("elf", v1.MemoryDumpFormatELF, "-"+now.Format("20060102-150405")+".memory.dump"),
+		Entry("kdump-zlib", v1.MemoryDumpFormatKdumpZlib, ".kdump-zlib.memory.dump"),
+		Entry("kdump-lzo", v1.MemoryDumpFormatKdumpLzo, ".kdump-lzo.memory.dump"),
+		Entry("kdump-snappy", v1.MemoryDumpFormatKdumpSnappy, ".kdump-snappy.memory.dump"),
+	)
+
+	DescribeTable("should recognize the crash memory dumps of the vmi", func(fileName string, expected bool) {
+		Expect(IsCrashMemoryDumpFile(vmName, fileName)).To(Equal(expected))
+	},
+		Entry("elf dump", CrashMemoryDumpFileName(vmName, "", now.Time), true),
+		Entry("kdump dump", CrashMemoryDumpFileName(vmName, v1.MemoryDumpFormatKdumpLzo, now.Time), true),
+		Entry("dump of another vmi", CrashMemoryDumpFileName(vmName+"-crash", "", now.Time), false),
+		Entry("memory dump of a volume", DumpFileName(vmName, testPVCName, "", now.Time), false),
+	)
+
+	It("should retain only the most recent crash memory dumps", func() {
+		dumps := []v1.DomainMemoryDumpInfo{{TargetFileName: "dump1"}, {TargetFileName: "dump2"}}
+
+		dumps = AppendCrashMemoryDump(dumps, v1.DomainMemoryDumpInfo{TargetFileName: "dump3"}, 2)
+		Expect(dumps).To(Equal([]v1.DomainMemoryDumpInfo{{TargetFileName: "dump2"}, {TargetFileName: "dump3"}}))
+		Expect(MaxCrashMemoryDumps(&v1.CrashMemoryDump{})).To(Equal(1))
+	})
+
 	DescribeTable("should remove memory dump volume from vmi volumes and update pvc annotation", func(phase v1.MemoryDumpPhase, expectedAnnotation string) {
 		vm, vmi := createVirtualMachineWithMemoryDump(phase)
 
@@ -0,0 +1,84 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package memorydump
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	// MemoryStateFileSuffix marks a memory dump target as a restorable
+	// memory and device state instead of a core dump
+	MemoryStateFileSuffix = ".memory.state"
+
+	// MemoryStateRestoreVolumeName is the name of the virt-launcher pod volume
+	// holding the memory state a vmi is resumed from
+	MemoryStateRestoreVolumeName = "memory-state"
+)
+
+// MemoryStateRestoreDir is where the memory state a vmi is resumed from is mounted
+var MemoryStateRestoreDir = filepath.Join(util.VirtPrivateDir, MemoryStateRestoreVolumeName)
+
+func MemoryStateFileName(vmiName, volumeName string) string {
+	return fmt.Sprintf("%s-%s%s", vmiName, volumeName, MemoryStateFileSuffix)
+}
+
+func IsMemoryStateFile(path string) bool {
+	return strings.HasSuffix(path, MemoryStateFileSuffix)
+}
+
+// IsMemoryStateVolume returns true if the named volume is a utility volume
+// the memory state of the vmi is saved to
+func IsMemoryStateVolume(vmi *v1.VirtualMachineInstance, volumeName string) bool {
+	for _, utilityVolume := range vmi.Spec.UtilityVolumes {
+		if utilityVolume.Name == volumeName {
+			return utilityVolume.Type != nil && *utilityVolume.Type == v1.MemoryState
+		}
+	}
+	return false
+}
+
+// MemoryStateRestoreClaimName returns the pvc holding the memory state
+// the vmi should be resumed from, if any
+func MemoryStateRestoreClaimName(vmi *v1.VirtualMachineInstance) (string, bool) {
+	claimName, ok := vmi.Annotations[v1.MemoryStateRestoreAnnotation]
+	return claimName, ok && claimName != ""
+}
+
+// FindMemoryStateFile returns the memory state file saved in dir
+func FindMemoryStateFile(dir string) (string, error) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	for _, file := range files {
+		if !file.IsDir() && IsMemoryStateFile(file.Name()) {
+			return filepath.Join(dir, file.Name()), nil
+		}
+	}
+	return "", fmt.Errorf("no memory state found in %s", dir)
+}
@@ -0,0 +1,266 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nbd
+
+import (
+	"cmp"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"slices"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const maxBlockStatusLength = 1 << 30
+
+// Client queries the status of an NBD export in a single metadata context
+type Client struct {
+	conn      io.ReadWriter
+	contextID uint32
+	size      int64
+	cookie    uint64
+}
+
+func NewClient(conn io.ReadWriter, exportName, metaContext string) (*Client, error) {
+	c := &Client{conn: conn}
+	if err := c.handshake(exportName, metaContext); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Size is the size of the export in bytes
+func (c *Client) Size() int64 {
+	return c.size
+}
+
+func (c *Client) handshake(exportName, metaContext string) error {
+	serverFlags, err := ReadGreeting(c.conn)
+	if err != nil {
+		return err
+	}
+	if serverFlags&FlagFixedNewstyle == 0 {
+		return fmt.Errorf("NBD server does not support the fixed newstyle handshake")
+	}
+	flags := FlagCFixedNewstyle
+	if serverFlags&FlagNoZeroes != 0 {
+		flags |= FlagCNoZeroes
+	}
+	if err := WriteClientFlags(c.conn, flags); err != nil {
+		return err
+	}
+
+	if _, err := c.option(OptStructuredReply, nil); err != nil {
+		return err
+	}
+
+	data := nbdString32(exportName)
+	data = binary.BigEndian.AppendUint32(data, 1)
+	data = append(data, nbdString32(metaContext)...)
+	replies, err := c.option(OptSetMetaContext, data)
+	if err != nil {
+		return err
+	}
+	found := false
+	for _, reply := range replies {
+		if reply.ReplyType == RepMetaContext && len(reply.Data) >= 4 && string(reply.Data[4:]) == metaContext {
+			c.contextID = binary.BigEndian.Uint32(reply.Data)
+			found = true
+		}
+	}
+	if !found {
+		return fmt.Errorf("NBD export %s does not provide %s", exportName, metaContext)
+	}
+
+	data = nbdString32(exportName)
+	data = binary.BigEndian.AppendUint16(data, 0)
+	if replies, err = c.option(OptGo, data); err != nil {
+		return err
+	}
+	for _, reply := range replies {
+		if reply.ReplyType == RepInfo && len(reply.Data) >= 10 && binary.BigEndian.Uint16(reply.Data) == InfoExport {
+			c.size = int64(binary.BigEndian.Uint64(reply.Data[2:]))
+		}
+	}
+	return nil
+}
+
+func nbdString32(s string) []byte {
+	return append(binary.BigEndian.AppendUint32(nil, uint32(len(s))), s...)
+}
+
+// option sends an option and collects its replies up to the final acknowledgement
+func (c *Client) option(code uint32, data []byte) ([]*OptionReply, error) {
+	if err := WriteOption(c.conn, &Option{Code: code, Data: data}); err != nil {
+		return nil, err
+	}
+	var replies []*OptionReply
+	for {
+		reply, err := ReadOptionReply(c.conn)
+		if err != nil {
+			return nil, err
+		}
+		if reply.Option != code {
+			return nil, fmt.Errorf("unexpected NBD reply to option %d", code)
+		}
+		switch {
+		case reply.ReplyType&RepFlagError != 0:
+			return nil, fmt.Errorf("NBD server refused option %d: %s", code, string(reply.Data))
+		case reply.ReplyType == RepAck:
+			return replies, nil
+		}
+		replies = append(replies, reply)
+	}
+}
+
+func (c *Client) sendRequest(command uint16, offset int64, length uint32) error {
+	c.cookie++
+	buf := binary.BigEndian.AppendUint32(nil, RequestMagic)
+	buf = binary.BigEndian.AppendUint16(buf, 0)
+	buf = binary.BigEndian.AppendUint16(buf, command)
+	buf = binary.BigEndian.AppendUint64(buf, c.cookie)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(offset))
+	buf = binary.BigEndian.AppendUint32(buf, length)
+	_, err := c.conn.Write(buf)
+	return err
+}
+
+// DirtyExtents returns the ranges of the export flagged dirty in the
+// metadata context, adjacent ranges are merged
+func (c *Client) DirtyExtents() ([]v1.ChangedBlockExtent, error) {
+	extents := []v1.ChangedBlockExtent{}
+	for offset := int64(0); offset < c.size; {
+		length := min(c.size-offset, maxBlockStatusLength)
+		if err := c.sendRequest(CmdBlockStatus, offset, uint32(length)); err != nil {
+			return nil, err
+		}
+		next, err := c.readBlockStatus(offset, &extents)
+		if err != nil {
+			return nil, err
+		}
+		if next <= offset {
+			return nil, fmt.Errorf("NBD server returned no block status at offset %d", offset)
+		}
+		offset = next
+	}
+	return extents, nil
+}
+
+// readBlockStatus reads the reply chunks of a block status request
+// starting at offset and returns the offset following the last described range
+func (c *Client) readBlockStatus(offset int64, extents *[]v1.ChangedBlockExtent) (int64, error) {
+	for {
+		var magic uint32
+		if err := binary.Read(c.conn, binary.BigEndian, &magic); err != nil {
+			return 0, err
+		}
+		if magic == SimpleReplyMagic {
+			var reply struct {
+				Error  uint32
+				Cookie uint64
+			}
+			if err := binary.Read(c.conn, binary.BigEndian, &reply); err != nil {
+				return 0, err
+			}
+			return 0, fmt.Errorf("NBD block status failed with error %d", reply.Error)
+		}
+		if magic != StructuredReplyMagic {
+			return 0, fmt.Errorf("unexpected NBD reply magic %#x", magic)
+		}
+		var header struct {
+			Flags  uint16
+			Type   uint16
+			Cookie uint64
+			Length uint32
+		}
+		if err := binary.Read(c.conn, binary.BigEndian, &header); err != nil {
+			return 0, err
+		}
+		if header.Cookie != c.cookie {
+			return 0, fmt.Errorf("unexpected NBD reply cookie %d", header.Cookie)
+		}
+		payload := make([]byte, header.Length)
+		if _, err := io.ReadFull(c.conn, payload); err != nil {
+			return 0, err
+		}
+
+		switch {
+		case header.Type&ReplyTypeFlagError != 0:
+			message := ""
+			if len(payload) >= 6 {
+				messageLength := int(binary.BigEndian.Uint16(payload[4:]))
+				message = string(payload[6:min(6+messageLength, len(payload))])
+			}
+			return 0, fmt.Errorf("NBD block status failed: %s", message)
+		case header.Type == ReplyTypeBlockStatus:
+			if len(payload) < 4 || binary.BigEndian.Uint32(payload) != c.contextID {
+				break
+			}
+			for descriptor := payload[4:]; len(descriptor) >= 8; descriptor = descriptor[8:] {
+				length := int64(binary.BigEndian.Uint32(descriptor))
+				if binary.BigEndian.Uint32(descriptor[4:])&StateDirty != 0 {
+					*extents = appendExtent(*extents, offset, length)
+				}
+				offset += length
+			}
+		}
+
+		if header.Flags&ReplyFlagDone != 0 {
+			return offset, nil
+		}
+	}
+}
+
+func appendExtent(extents []v1.ChangedBlockExtent, offset, length int64) []v1.ChangedBlockExtent {
+	if last := len(extents) - 1; last >= 0 && extents[last].Offset+extents[last].Length == offset {
+		extents[last].Length += length
+		return extents
+	}
+	return append(extents, v1.ChangedBlockExtent{Offset: offset, Length: length})
+}
+
+// MergeExtents returns the union of several lists of extents, ordered by
+// offset with overlapping and adjacent extents merged
+func MergeExtents(lists ...[]v1.ChangedBlockExtent) []v1.ChangedBlockExtent {
+	var all []v1.ChangedBlockExtent
+	for _, extents := range lists {
+		all = append(all, extents...)
+	}
+	slices.SortFunc(all, func(a, b v1.ChangedBlockExtent) int {
+		return cmp.Compare(a.Offset, b.Offset)
+	})
+
+	merged := []v1.ChangedBlockExtent{}
+	for _, extent := range all {
+		last := len(merged) - 1
+		if last >= 0 && extent.Offset <= merged[last].Offset+merged[last].Length {
+			merged[last].Length = max(merged[last].Length, extent.Offset+extent.Length-merged[last].Offset)
+			continue
+		}
+		merged = append(merged, extent)
+	}
+	return merged
+}
+
+// Disconnect asks the server to end the transmission phase
+func (c *Client) Disconnect() error {
+	return c.sendRequest(CmdDisc, 0, 0)
+}
@@ -0,0 +1,274 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nbd
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	fakeNBDExport    = "disk0"
+	fakeNBDContext   = "qemu:dirty-bitmap:backup-disk0"
+	fakeNBDContextID = uint32(7)
+)
+
+type fakeNBDExtent struct {
+	length uint32
+	dirty  bool
+}
+
+// fakeNBDServer serves the block status of a single export, answering
+// every block status request with at most maxDescriptors descriptors
+type fakeNBDServer struct {
+	conn           net.Conn
+	extents        []fakeNBDExtent
+	maxDescriptors int
+	metaContexts   []string
+}
+
+func (s *fakeNBDServer) size() uint64 {
+	size := uint64(0)
+	for _, extent := range s.extents {
+		size += uint64(extent.length)
+	}
+	return size
+}
+
+func (s *fakeNBDServer) serve() error {
+	defer s.conn.Close()
+	greeting := binary.BigEndian.AppendUint64(nil, Magic)
+	greeting = binary.BigEndian.AppendUint64(greeting, OptMagic)
+	greeting = binary.BigEndian.AppendUint16(greeting, FlagFixedNewstyle|FlagNoZeroes)
+	if _, err := s.conn.Write(greeting); err != nil {
+		return err
+	}
+	var clientFlags uint32
+	if err := binary.Read(s.conn, binary.BigEndian, &clientFlags); err != nil {
+		return err
+	}
+
+	for done := false; !done; {
+		var header struct {
+			Magic  uint64
+			Option uint32
+			Length uint32
+		}
+		if err := binary.Read(s.conn, binary.BigEndian, &header); err != nil {
+			return err
+		}
+		if _, err := io.CopyN(io.Discard, s.conn, int64(header.Length)); err != nil {
+			return err
+		}
+		switch header.Option {
+		case OptSetMetaContext:
+			for _, metaContext := range s.metaContexts {
+				data := binary.BigEndian.AppendUint32(nil, fakeNBDContextID)
+				if err := s.optionReply(header.Option, RepMetaContext, append(data, metaContext...)); err != nil {
+					return err
+				}
+			}
+		case OptGo:
+			data := binary.BigEndian.AppendUint16(nil, InfoExport)
+			data = binary.BigEndian.AppendUint64(data, s.size())
+			data = binary.BigEndian.AppendUint16(data, 0)
+			if err := s.optionReply(header.Option, RepInfo, data); err != nil {
+				return err
+			}
+			done = true
+		}
+		if err := s.optionReply(header.Option, RepAck, nil); err != nil {
+			return err
+		}
+	}
+
+	for {
+		var request struct {
+			Magic   uint32
+			Flags   uint16
+			Command uint16
+			Cookie  uint64
+			Offset  uint64
+			Length  uint32
+		}
+		if err := binary.Read(s.conn, binary.BigEndian, &request); err != nil {
+			return err
+		}
+		if request.Command == CmdDisc {
+			return nil
+		}
+		if request.Command != CmdBlockStatus {
+			return fmt.Errorf("unexpected command %d", request.Command)
+		}
+		if err := s.blockStatusReply(request.Cookie, request.Offset); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *fakeNBDServer) optionReply(option, replyType uint32, data []byte) error {
+	buf := binary.BigEndian.AppendUint64(nil, OptRepMagic)
+	buf = binary.BigEndian.AppendUint32(buf, option)
+	buf = binary.BigEndian.AppendUint32(buf, replyType)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(data)))
+	_, err := s.conn.Write(append(buf, data...))
+	return err
+}
+
+func (s *fakeNBDServer) blockStatusReply(cookie, offset uint64) error {
+	payload := binary.BigEndian.AppendUint32(nil, fakeNBDContextID)
+	descriptors := 0
+	position := uint64(0)
+	for _, extent := range s.extents {
+		position += uint64(extent.length)
+		if position <= offset || descriptors == s.maxDescriptors {
+			continue
+		}
+		flags := uint32(0)
+		if extent.dirty {
+			flags = StateDirty
+		}
+		payload = binary.BigEndian.AppendUint32(payload, extent.length)
+		payload = binary.BigEndian.AppendUint32(payload, flags)
+		descriptors++
+	}
+	buf := binary.BigEndian.AppendUint32(nil, StructuredReplyMagic)
+	buf = binary.BigEndian.AppendUint16(buf, ReplyFlagDone)
+	buf = binary.BigEndian.AppendUint16(buf, ReplyTypeBlockStatus)
+	buf = binary.BigEndian.AppendUint64(buf, cookie)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(payload)))
+	_, err := s.conn.Write(append(buf, payload...))
+	return err
+}
+
+var _ = Describe("NBD client", func() {
+	var (
+		server     *fakeNBDServer
+		clientConn net.Conn
+		serverErr  chan error
+	)
+
+	BeforeEach(func() {
+		var serverConn net.Conn
+		clientConn, serverConn = net.Pipe()
+		server = &fakeNBDServer{
+			conn:           serverConn,
+			maxDescriptors: 2,
+			metaContexts:   []string{fakeNBDContext},
+		}
+		serverErr = make(chan error, 1)
+	})
+
+	AfterEach(func() {
+		clientConn.Close()
+	})
+
+	startServer := func() {
+		go func() {
+			serverErr <- server.serve()
+		}()
+	}
+
+	It("should report the dirty extents of the export", func() {
+		server.extents = []fakeNBDExtent{
+			{length: 65536, dirty: true},
+			{length: 65536, dirty: true},
+			{length: 131072, dirty: false},
+			{length: 65536, dirty: true},
+			{length: 65536, dirty: false},
+		}
+		startServer()
+
+		client, err := NewClient(clientConn, fakeNBDExport, fakeNBDContext)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.Size()).To(Equal(int64(393216)))
+
+		extents, err := client.DirtyExtents()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(extents).To(Equal([]v1.ChangedBlockExtent{
+			{Offset: 0, Length: 131072},
+			{Offset: 262144, Length: 65536},
+		}))
+
+		Expect(client.Disconnect()).To(Succeed())
+		Expect(<-serverErr).ToNot(HaveOccurred())
+	})
+
+	It("should report no extents when nothing changed", func() {
+		server.extents = []fakeNBDExtent{
+			{length: 65536, dirty: false},
+		}
+		startServer()
+
+		client, err := NewClient(clientConn, fakeNBDExport, fakeNBDContext)
+		Expect(err).ToNot(HaveOccurred())
+		extents, err := client.DirtyExtents()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(extents).To(BeEmpty())
+	})
+
+	It("should fail when the export does not provide the dirty bitmap", func() {
+		server.metaContexts = nil
+		startServer()
+
+		_, err := NewClient(clientConn, fakeNBDExport, fakeNBDContext)
+		Expect(err).To(MatchError(ContainSubstring("does not provide")))
+	})
+
+	It("should fail when the server does not support the fixed newstyle handshake", func() {
+		go func() {
+			defer server.conn.Close()
+			greeting := binary.BigEndian.AppendUint64(nil, Magic)
+			greeting = binary.BigEndian.AppendUint64(greeting, OptMagic)
+			greeting = binary.BigEndian.AppendUint16(greeting, 0)
+			_, err := server.conn.Write(greeting)
+			serverErr <- err
+		}()
+
+		_, err := NewClient(clientConn, fakeNBDExport, fakeNBDContext)
+		Expect(err).To(MatchError(ContainSubstring("fixed newstyle")))
+	})
+})
+
+var _ = Describe("MergeExtents", func() {
+	It("should merge overlapping and adjacent extents of several lists", func() {
+		merged := MergeExtents(
+			[]v1.ChangedBlockExtent{{Offset: 0, Length: 4096}, {Offset: 65536, Length: 4096}},
+			[]v1.ChangedBlockExtent{{Offset: 4096, Length: 4096}, {Offset: 61440, Length: 16384}},
+			[]v1.ChangedBlockExtent{{Offset: 131072, Length: 4096}},
+		)
+		Expect(merged).To(Equal([]v1.ChangedBlockExtent{
+			{Offset: 0, Length: 8192},
+			{Offset: 61440, Length: 16384},
+			{Offset: 131072, Length: 4096},
+		}))
+	})
+
+	It("should return no extents when no list has any", func() {
+		Expect(MergeExtents(nil, []v1.ChangedBlockExtent{})).To(BeEmpty())
+	})
+})
@@ -0,0 +1,232 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package nbd
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// Subset of the NBD fixed newstyle protocol, see
+// https://github.com/NetworkBlockDevice/nbd/blob/master/doc/proto.md
+const (
+	Magic                uint64 = 0x4e42444d41474943 // "NBDMAGIC"
+	OptMagic             uint64 = 0x49484156454f5054 // "IHAVEOPT"
+	OptRepMagic          uint64 = 0x0003e889045565a9
+	RequestMagic         uint32 = 0x25609513
+	SimpleReplyMagic     uint32 = 0x67446698
+	StructuredReplyMagic uint32 = 0x668e33ef
+
+	FlagFixedNewstyle uint16 = 1 << 0
+	FlagNoZeroes      uint16 = 1 << 1
+
+	FlagCFixedNewstyle uint32 = 1 << 0
+	FlagCNoZeroes      uint32 = 1 << 1
+
+	OptExportName      uint32 = 1
+	OptAbort           uint32 = 2
+	OptList            uint32 = 3
+	OptStartTLS        uint32 = 5
+	OptInfo            uint32 = 6
+	OptGo              uint32 = 7
+	OptStructuredReply uint32 = 8
+	OptListMetaContext uint32 = 9
+	OptSetMetaContext  uint32 = 10
+
+	RepAck         uint32 = 1
+	RepInfo        uint32 = 3
+	RepMetaContext uint32 = 4
+	RepFlagError   uint32 = 1 << 31
+	RepErrUnsup    uint32 = RepFlagError | 1
+	RepErrPolicy   uint32 = RepFlagError | 2
+	RepErrInvalid  uint32 = RepFlagError | 3
+	RepErrTLSReqd  uint32 = RepFlagError | 5
+
+	InfoExport uint16 = 0
+
+	CmdDisc        uint16 = 2
+	CmdBlockStatus uint16 = 7
+
+	ReplyFlagDone        uint16 = 1 << 0
+	ReplyTypeBlockStatus uint16 = 5
+	ReplyTypeFlagError   uint16 = 1 << 15
+
+	// StateDirty is set on the extents of a "qemu:dirty-bitmap:" context which changed
+	StateDirty uint32 = 1 << 0
+
+	// option payloads are tiny, anything larger is a broken or hostile peer
+	maxOptionLength = 64 * 1024
+)
+
+type Option struct {
+	Code uint32
+	Data []byte
+}
+
+type OptionReply struct {
+	Option    uint32
+	ReplyType uint32
+	Data      []byte
+}
+
+// IsFinal reports whether no more replies follow for the option
+func (r *OptionReply) IsFinal() bool {
+	return r.ReplyType == RepAck || r.ReplyType&RepFlagError != 0
+}
+
+func WriteGreeting(w io.Writer) error {
+	return binary.Write(w, binary.BigEndian, struct {
+		Magic    uint64
+		OptMagic uint64
+		Flags    uint16
+	}{Magic, OptMagic, FlagFixedNewstyle | FlagNoZeroes})
+}
+
+func ReadGreeting(r io.Reader) (uint16, error) {
+	var greeting struct {
+		Magic    uint64
+		OptMagic uint64
+		Flags    uint16
+	}
+	if err := binary.Read(r, binary.BigEndian, &greeting); err != nil {
+		return 0, err
+	}
+	if greeting.Magic != Magic || greeting.OptMagic != OptMagic {
+		return 0, fmt.Errorf("unexpected NBD greeting")
+	}
+	return greeting.Flags, nil
+}
+
+func ReadClientFlags(r io.Reader) (uint32, error) {
+	var flags uint32
+	err := binary.Read(r, binary.BigEndian, &flags)
+	return flags, err
+}
+
+func WriteClientFlags(w io.Writer, flags uint32) error {
+	return binary.Write(w, binary.BigEndian, flags)
+}
+
+func ReadOption(r io.Reader) (*Option, error) {
+	var header struct {
+		Magic  uint64
+		Code   uint32
+		Length uint32
+	}
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		return nil, err
+	}
+	if header.Magic != OptMagic {
+		return nil, fmt.Errorf("unexpected NBD option magic %#x", header.Magic)
+	}
+	if header.Length > maxOptionLength {
+		return nil, fmt.Errorf("NBD option %d exceeds the maximum length", header.Code)
+	}
+	opt := &Option{Code: header.Code, Data: make([]byte, header.Length)}
+	if _, err := io.ReadFull(r, opt.Data); err != nil {
+		return nil, err
+	}
+	return opt, nil
+}
+
+func WriteOption(w io.Writer, opt *Option) error {
+	buf := make([]byte, 16, 16+len(opt.Data))
+	binary.BigEndian.PutUint64(buf[0:], OptMagic)
+	binary.BigEndian.PutUint32(buf[8:], opt.Code)
+	binary.BigEndian.PutUint32(buf[12:], uint32(len(opt.Data)))
+	_, err := w.Write(append(buf, opt.Data...))
+	return err
+}
+
+func ReadOptionReply(r io.Reader) (*OptionReply, error) {
+	var header struct {
+		Magic     uint64
+		Option    uint32
+		ReplyType uint32
+		Length    uint32
+	}
+	if err := binary.Read(r, binary.BigEndian, &header); err != nil {
+		return nil, err
+	}
+	if header.Magic != OptRepMagic {
+		return nil, fmt.Errorf("unexpected NBD option reply magic %#x", header.Magic)
+	}
+	if header.Length > maxOptionLength {
+		return nil, fmt.Errorf("NBD reply to option %d exceeds the maximum length", header.Option)
+	}
+	reply := &OptionReply{Option: header.Option, ReplyType: header.ReplyType, Data: make([]byte, header.Length)}
+	if _, err := io.ReadFull(r, reply.Data); err != nil {
+		return nil, err
+	}
+	return reply, nil
+}
+
+func WriteOptionReply(w io.Writer, reply *OptionReply) error {
+	buf := make([]byte, 20, 20+len(reply.Data))
+	binary.BigEndian.PutUint64(buf[0:], OptRepMagic)
+	binary.BigEndian.PutUint32(buf[8:], reply.Option)
+	binary.BigEndian.PutUint32(buf[12:], reply.ReplyType)
+	binary.BigEndian.PutUint32(buf[16:], uint32(len(reply.Data)))
+	_, err := w.Write(append(buf, reply.Data...))
+	return err
+}
+
+func ReplyTo(w io.Writer, opt *Option, replyType uint32) error {
+	return WriteOptionReply(w, &OptionReply{Option: opt.Code, ReplyType: replyType})
+}
+
+// CarriesExportName reports whether the option payload starts with an export name
+func CarriesExportName(code uint32) bool {
+	switch code {
+	case OptExportName, OptInfo, OptGo, OptListMetaContext, OptSetMetaContext:
+		return true
+	}
+	return false
+}
+
+// ExportName extracts the export name of an option which carries one
+func (o *Option) ExportName() (string, error) {
+	if o.Code == OptExportName {
+		return string(o.Data), nil
+	}
+	if len(o.Data) < 4 {
+		return "", fmt.Errorf("NBD option %d is too short", o.Code)
+	}
+	nameLength := binary.BigEndian.Uint32(o.Data)
+	if uint64(nameLength) > uint64(len(o.Data)-4) {
+		return "", fmt.Errorf("NBD option %d has an invalid export name length", o.Code)
+	}
+	return string(o.Data[4 : 4+nameLength]), nil
+}
+
+// WithExportName returns a copy of the option with its export name replaced
+func (o *Option) WithExportName(name string) *Option {
+	if o.Code == OptExportName {
+		return &Option{Code: o.Code, Data: []byte(name)}
+	}
+	oldLength := binary.BigEndian.Uint32(o.Data)
+	rest := o.Data[4+oldLength:]
+	data := make([]byte, 4, 4+len(name)+len(rest))
+	binary.BigEndian.PutUint32(data, uint32(len(name)))
+	data = append(data, name...)
+	data = append(data, rest...)
+	return &Option{Code: o.Code, Data: data}
+}
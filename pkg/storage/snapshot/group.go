@@ -0,0 +1,657 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+	launcherapi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	// SnapshotGroupFrozenAnnotation is set on the contents of the snapshots of a
+	// VirtualMachineSnapshotGroup to the time all VMs of the group were frozen at.
+	// The volumes of a content are only snapshotted once it is set.
+	SnapshotGroupFrozenAnnotation = "snapshot.kubevirt.io/snapshot-group-frozen"
+
+	vmSnapshotGroupFrozenEvent        = "VirtualMachineSnapshotGroupFrozen"
+	vmSnapshotGroupThawedEvent        = "VirtualMachineSnapshotGroupThawed"
+	vmSnapshotGroupFailedEvent        = "VirtualMachineSnapshotGroupFailed"
+	vmSnapshotGroupFreezeSkippedEvent = "VirtualMachineSnapshotGroupFreezeSkipped"
+
+	noVMsSelectedMsg               = "no VirtualMachine matches the selector"
+	vmSnapshotGroupFrozenMsg       = "froze the VirtualMachines of the group"
+	vmSnapshotGroupThawedMsg       = "thawed the VirtualMachines of the group"
+	failedGroupMemberMsg           = "VirtualMachineSnapshot %s failed"
+	failedGroupFreezeMsg           = "failed freezing the VirtualMachines of the group: %v"
+	vmSnapshotGroupDeadlineMsg     = "snapshot group deadline exceeded"
+	vmSnapshotGroupInProgressMsg   = "Snapshotting the VirtualMachines of the group"
+	vmSnapshotGroupWaitContentsMsg = "Waiting for the snapshot contents of the group"
+)
+
+// VMSnapshotGroupController snapshots the VMs of a VirtualMachineSnapshotGroup
+// at one point in time and restores them with a VirtualMachineSnapshotGroupRestore
+type VMSnapshotGroupController struct {
+	Client kubecli.KubevirtClient
+
+	VMSnapshotGroupInformer        cache.SharedIndexInformer
+	VMSnapshotGroupRestoreInformer cache.SharedIndexInformer
+	VMSnapshotInformer             cache.SharedIndexInformer
+	VMSnapshotContentInformer      cache.SharedIndexInformer
+	VMRestoreInformer              cache.SharedIndexInformer
+	VMInformer                     cache.SharedIndexInformer
+	VMIInformer                    cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	groupQueue        workqueue.TypedRateLimitingInterface[string]
+	groupRestoreQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the snapshot group controller
+func (ctrl *VMSnapshotGroupController) Init() error {
+	ctrl.groupQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-group"},
+	)
+	ctrl.groupRestoreQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-group-restore"},
+	)
+
+	_, err := ctrl.VMSnapshotGroupInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotGroup,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotGroup(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleGroupMember,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleGroupMember(newObj) },
+			DeleteFunc: ctrl.handleGroupMember,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotContentInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleGroupMember,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleGroupMember(newObj) },
+			DeleteFunc: ctrl.handleGroupMember,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotGroupRestoreInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotGroupRestore,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotGroupRestore(newObj) },
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMRestoreInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleGroupRestoreMember,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleGroupRestoreMember(newObj) },
+			DeleteFunc: ctrl.handleGroupRestoreMember,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run the controller
+func (ctrl *VMSnapshotGroupController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.groupQueue.ShutDown()
+	defer ctrl.groupRestoreQueue.ShutDown()
+
+	log.Log.Info("Starting snapshot group controller.")
+	defer log.Log.Info("Shutting down snapshot group controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMSnapshotGroupInformer.HasSynced,
+		ctrl.VMSnapshotGroupRestoreInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMSnapshotContentInformer.HasSynced,
+		ctrl.VMRestoreInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+		ctrl.VMIInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmSnapshotGroupWorker, time.Second, stopCh)
+		go wait.Until(ctrl.vmSnapshotGroupRestoreWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMSnapshotGroupController) vmSnapshotGroupWorker() {
+	for ctrl.processVMSnapshotGroupWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) processVMSnapshotGroupWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.groupQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotGroup worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotGroupInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		group, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotGroup)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+		if group.DeletionTimestamp != nil {
+			// the snapshots of the group are garbage collected and
+			// thaw their VMs when they are deleted
+			return 0, nil
+		}
+
+		return ctrl.updateVMSnapshotGroup(group.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotGroupController) handleVMSnapshotGroup(obj interface{}) {
+	if group, ok := obj.(*snapshotv1.VirtualMachineSnapshotGroup); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(group)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, group)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.groupQueue.Add(objName)
+	}
+}
+
+// handleGroupMember enqueues the group of a snapshot or snapshot content
+func (ctrl *VMSnapshotGroupController) handleGroupMember(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if o, ok := obj.(metav1.Object); ok {
+		if groupName, ok := o.GetLabels()[snapshotv1.SnapshotGroupLabel]; ok {
+			ctrl.groupQueue.Add(cacheKeyFunc(o.GetNamespace(), groupName))
+		}
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) updateVMSnapshotGroup(group *snapshotv1.VirtualMachineSnapshotGroup) (time.Duration, error) {
+	status, retry, syncErr := ctrl.syncGroup(group)
+	if !equality.Semantic.DeepEqual(group.Status, status) {
+		groupCopy := group.DeepCopy()
+		groupCopy.Status = status
+		_, err := ctrl.Client.VirtualMachineSnapshotGroup(groupCopy.Namespace).UpdateStatus(context.Background(), groupCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return retry, syncErr
+}
+
+// syncGroup drives a group through its steps:
+//  1. record the VMs matching the selector and snapshot each of them
+//  2. once the content of every snapshot exists, freeze all VMs in parallel
+//     and release the contents to take their volume snapshots
+//  3. once all volume snapshots are taken, thaw all VMs
+//  4. succeed once all snapshots succeeded
+//
+// It returns the time until the group needs to be synced again.
+func (ctrl *VMSnapshotGroupController) syncGroup(group *snapshotv1.VirtualMachineSnapshotGroup) (*snapshotv1.VirtualMachineSnapshotGroupStatus, time.Duration, error) {
+	status := &snapshotv1.VirtualMachineSnapshotGroupStatus{}
+	if group.Status != nil {
+		status = group.Status.DeepCopy()
+	}
+
+	switch status.Phase {
+	case snapshotv1.Succeeded:
+		members, err := ctrl.groupMembers(group, status)
+		if err != nil {
+			return status, 0, err
+		}
+		status.ReadyToUse = pointer.P(allReady(members))
+		return status, 0, nil
+	case snapshotv1.Failed:
+		return status, 0, nil
+	case snapshotv1.PhaseUnset:
+		if err := ctrl.initGroup(group, status); err != nil {
+			ctrl.failGroup(group, status, nil, err.Error())
+		}
+		// the snapshots are created once the members are recorded
+		return status, 0, nil
+	}
+
+	members, err := ctrl.groupMembers(group, status)
+	if err != nil {
+		return status, 0, err
+	}
+	if err := ctrl.createMissingMembers(group, status, members); err != nil {
+		return status, 0, err
+	}
+
+	for _, member := range members {
+		if member != nil && vmSnapshotFailed(member) {
+			ctrl.failGroup(group, status, members, fmt.Sprintf(failedGroupMemberMsg, member.Name))
+			return status, 0, nil
+		}
+	}
+	if groupDeadlineExceeded(group) {
+		ctrl.failGroup(group, status, members, vmSnapshotGroupDeadlineMsg)
+		return status, 0, nil
+	}
+
+	contents, created := ctrl.memberContents(members)
+	if !created {
+		updateGroupCondition(status, newProgressingCondition(corev1.ConditionTrue, vmSnapshotGroupWaitContentsMsg))
+		return status, timeUntilGroupDeadline(group), nil
+	}
+
+	if status.CreationTime == nil {
+		frozenAt, released := contentsReleased(contents)
+		if !released {
+			if err := ctrl.freezeVMs(group, members); err != nil {
+				ctrl.failGroup(group, status, members, fmt.Sprintf(failedGroupFreezeMsg, err))
+				return status, 0, nil
+			}
+			frozenAt = currentTime()
+			ctrl.Recorder.Event(group, corev1.EventTypeNormal, vmSnapshotGroupFrozenEvent, vmSnapshotGroupFrozenMsg)
+			if err := ctrl.releaseContents(contents, frozenAt); err != nil {
+				return status, 0, err
+			}
+		}
+		status.CreationTime = frozenAt
+	}
+
+	if status.ThawTime == nil {
+		for _, content := range contents {
+			if content.Status == nil || content.Status.CreationTime == nil {
+				updateGroupCondition(status, newProgressingCondition(corev1.ConditionTrue, vmSnapshotGroupInProgressMsg))
+				return status, timeUntilGroupDeadline(group), nil
+			}
+		}
+		if err := ctrl.thawVMs(members); err != nil {
+			return status, 0, err
+		}
+		status.ThawTime = currentTime()
+		ctrl.Recorder.Event(group, corev1.EventTypeNormal, vmSnapshotGroupThawedEvent, vmSnapshotGroupThawedMsg)
+	}
+
+	for _, member := range members {
+		if !vmSnapshotSucceeded(member) {
+			updateGroupCondition(status, newProgressingCondition(corev1.ConditionTrue, vmSnapshotGroupInProgressMsg))
+			return status, timeUntilGroupDeadline(group), nil
+		}
+	}
+
+	status.Phase = snapshotv1.Succeeded
+	status.ReadyToUse = pointer.P(allReady(members))
+	updateGroupCondition(status, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+	updateGroupCondition(status, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+	return status, 0, nil
+}
+
+// initGroup records the VMs matching the selector and the names of their snapshots
+func (ctrl *VMSnapshotGroupController) initGroup(group *snapshotv1.VirtualMachineSnapshotGroup, status *snapshotv1.VirtualMachineSnapshotGroupStatus) error {
+	selector, err := metav1.LabelSelectorAsSelector(&group.Spec.Selector)
+	if err != nil {
+		return fmt.Errorf(invalidSnapshotSelectorMsg, err)
+	}
+
+	var vmNames []string
+	err = cache.ListAllByNamespace(ctrl.VMInformer.GetIndexer(), group.Namespace, selector, func(obj interface{}) {
+		if vm := obj.(*kubevirtv1.VirtualMachine); vm.DeletionTimestamp == nil {
+			vmNames = append(vmNames, vm.Name)
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if len(vmNames) == 0 {
+		return errors.New(noVMsSelectedMsg)
+	}
+	sort.Strings(vmNames)
+
+	status.Phase = snapshotv1.InProgress
+	status.ReadyToUse = pointer.P(false)
+	for _, vmName := range vmNames {
+		status.Snapshots = append(status.Snapshots, snapshotv1.SnapshotGroupMember{
+			VirtualMachineName:         vmName,
+			VirtualMachineSnapshotName: naming.GetName(group.Name, vmName, validation.DNS1035LabelMaxLength),
+		})
+	}
+	updateGroupCondition(status, newProgressingCondition(corev1.ConditionTrue, vmSnapshotGroupInProgressMsg))
+	updateGroupCondition(status, newReadyCondition(corev1.ConditionFalse, "Not ready"))
+	return nil
+}
+
+// groupMembers returns the snapshots of the group in the order of the status,
+// a snapshot which does not exist yet is nil
+func (ctrl *VMSnapshotGroupController) groupMembers(group *snapshotv1.VirtualMachineSnapshotGroup, status *snapshotv1.VirtualMachineSnapshotGroupStatus) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	members := make([]*snapshotv1.VirtualMachineSnapshot, len(status.Snapshots))
+	for i, member := range status.Snapshots {
+		obj, exists, err := ctrl.VMSnapshotInformer.GetStore().GetByKey(cacheKeyFunc(group.Namespace, member.VirtualMachineSnapshotName))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			members[i] = obj.(*snapshotv1.VirtualMachineSnapshot)
+		}
+	}
+	return members, nil
+}
+
+func (ctrl *VMSnapshotGroupController) createMissingMembers(group *snapshotv1.VirtualMachineSnapshotGroup, status *snapshotv1.VirtualMachineSnapshotGroupStatus, members []*snapshotv1.VirtualMachineSnapshot) error {
+	for i, member := range status.Snapshots {
+		if members[i] != nil {
+			continue
+		}
+
+		vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      member.VirtualMachineSnapshotName,
+				Namespace: group.Namespace,
+				Labels: map[string]string{
+					snapshotv1.SnapshotGroupLabel: group.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(group, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshotGroup")),
+				},
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(kubevirtv1.GroupVersion.Group),
+					Kind:     kubevirtv1.VirtualMachineGroupVersionKind.Kind,
+					Name:     member.VirtualMachineName,
+				},
+				DeletionPolicy:  group.Spec.DeletionPolicy,
+				FailureDeadline: group.Spec.FailureDeadline,
+			},
+		}
+
+		vmSnapshot, err := ctrl.Client.VirtualMachineSnapshot(group.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			// created by an earlier sync, not in the cache yet
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		members[i] = vmSnapshot
+	}
+	return nil
+}
+
+// memberContents returns the contents of the snapshots of the group,
+// it reports false until the content of every snapshot exists
+func (ctrl *VMSnapshotGroupController) memberContents(members []*snapshotv1.VirtualMachineSnapshot) ([]*snapshotv1.VirtualMachineSnapshotContent, bool) {
+	var contents []*snapshotv1.VirtualMachineSnapshotContent
+	for _, member := range members {
+		if member == nil || member.Status == nil || member.Status.VirtualMachineSnapshotContentName == nil {
+			return nil, false
+		}
+		obj, exists, err := ctrl.VMSnapshotContentInformer.GetStore().GetByKey(cacheKeyFunc(member.Namespace, *member.Status.VirtualMachineSnapshotContentName))
+		if err != nil || !exists {
+			return nil, false
+		}
+		contents = append(contents, obj.(*snapshotv1.VirtualMachineSnapshotContent))
+	}
+	return contents, true
+}
+
+// contentsReleased returns the time the VMs were frozen at if all contents were released
+func contentsReleased(contents []*snapshotv1.VirtualMachineSnapshotContent) (*metav1.Time, bool) {
+	var frozenAt *metav1.Time
+	for _, content := range contents {
+		t, err := time.Parse(time.RFC3339, content.Annotations[SnapshotGroupFrozenAnnotation])
+		if err != nil {
+			return nil, false
+		}
+		frozenAt = pointer.P(metav1.NewTime(t))
+	}
+	return frozenAt, frozenAt != nil
+}
+
+func (ctrl *VMSnapshotGroupController) releaseContents(contents []*snapshotv1.VirtualMachineSnapshotContent, frozenAt *metav1.Time) error {
+	value := frozenAt.UTC().Format(time.RFC3339)
+	for _, content := range contents {
+		if snapshotGroupFrozen(content) {
+			continue
+		}
+
+		patchSet := patch.New()
+		if content.Annotations == nil {
+			patchSet.AddOption(patch.WithAdd("/metadata/annotations", map[string]string{SnapshotGroupFrozenAnnotation: value}))
+		} else {
+			patchSet.AddOption(patch.WithAdd("/metadata/annotations/"+patch.EscapeJSONPointer(SnapshotGroupFrozenAnnotation), value))
+		}
+		payload, err := patchSet.GeneratePayload()
+		if err != nil {
+			return err
+		}
+
+		_, err = ctrl.Client.VirtualMachineSnapshotContent(content.Namespace).Patch(context.Background(), content.Name, types.JSONPatchType, payload, metav1.PatchOptions{})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// freezableVMI returns the VMI of the source of a snapshot if its filesystems can
+// be frozen. Like for a single snapshot, VMs which are paused or have no guest
+// agent are snapshotted without freezing.
+func (ctrl *VMSnapshotGroupController) freezableVMI(member *snapshotv1.VirtualMachineSnapshot) (*kubevirtv1.VirtualMachineInstance, error) {
+	obj, exists, err := ctrl.VMIInformer.GetStore().GetByKey(cacheKeyFunc(member.Namespace, member.Spec.Source.Name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	vmi := obj.(*kubevirtv1.VirtualMachineInstance)
+
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if condManager.HasConditionWithStatus(vmi, kubevirtv1.VirtualMachineInstancePaused, corev1.ConditionTrue) {
+		log.Log.Object(member).Warningf("VM %s is paused - taking snapshot without filesystem freeze", vmi.Name)
+		return nil, nil
+	}
+	if !condManager.HasCondition(vmi, kubevirtv1.VirtualMachineInstanceAgentConnected) {
+		log.Log.Object(member).Warningf("Guest agent does not exist and VM %s is running. Snapshoting without freezing FS. This can result in inconsistent snapshot!", vmi.Name)
+		return nil, nil
+	}
+	return vmi, nil
+}
+
+// freezeVMs freezes the filesystems of all VMs of the group in parallel. The guest
+// agent thaws a VM by itself once the failure deadline of the group passed.
+func (ctrl *VMSnapshotGroupController) freezeVMs(group *snapshotv1.VirtualMachineSnapshotGroup, members []*snapshotv1.VirtualMachineSnapshot) error {
+	unfreezeTimeout := groupFailureDeadline(group)
+	err := ctrl.forEachFreezableVMI(members, func(vmi *kubevirtv1.VirtualMachineInstance) error {
+		if vmi.Status.FSFreezeStatus == launcherapi.FSFrozen {
+			return nil
+		}
+		defer timeTrack(time.Now(), fmt.Sprintf("Freezing vmi %s", vmi.Name))
+		err := ctrl.Client.VirtualMachineInstance(vmi.Namespace).Freeze(context.Background(), vmi.Name, unfreezeTimeout)
+		switch {
+		case err != nil && strings.Contains(err.Error(), storagetypes.PreFreezeHookFailedMsg):
+			// like single snapshots and backups, the VM is snapshotted without freezing
+			ctrl.Recorder.Eventf(group, corev1.EventTypeWarning, vmSnapshotGroupFreezeSkippedEvent, "snapshotting VirtualMachine %s without freezing: %v", vmi.Name, err)
+		case err != nil:
+			return fmt.Errorf("%s %s: %v", failedFreezeMsg, vmi.Name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		// do not keep the VMs which were frozen waiting for the unfreeze timeout
+		if thawErr := ctrl.thawVMs(members); thawErr != nil {
+			log.Log.Object(group).Reason(thawErr).Error("Failed to thaw the VMs of the snapshot group")
+		}
+	}
+	return err
+}
+
+// thawVMs thaws the filesystems of all VMs of the group in parallel
+func (ctrl *VMSnapshotGroupController) thawVMs(members []*snapshotv1.VirtualMachineSnapshot) error {
+	return ctrl.forEachFreezableVMI(members, func(vmi *kubevirtv1.VirtualMachineInstance) error {
+		defer timeTrack(time.Now(), fmt.Sprintf("Unfreezing vmi %s", vmi.Name))
+		return ctrl.Client.VirtualMachineInstance(vmi.Namespace).Unfreeze(context.Background(), vmi.Name)
+	})
+}
+
+func (ctrl *VMSnapshotGroupController) forEachFreezableVMI(members []*snapshotv1.VirtualMachineSnapshot, f func(vmi *kubevirtv1.VirtualMachineInstance) error) error {
+	var wg sync.WaitGroup
+	errs := make([]error, len(members))
+	for i, member := range members {
+		if member == nil {
+			continue
+		}
+		vmi, err := ctrl.freezableVMI(member)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		if vmi == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, vmi *kubevirtv1.VirtualMachineInstance) {
+			defer wg.Done()
+			errs[i] = f(vmi)
+		}(i, vmi)
+	}
+	wg.Wait()
+	return errors.Join(errs...)
+}
+
+func (ctrl *VMSnapshotGroupController) failGroup(group *snapshotv1.VirtualMachineSnapshotGroup, status *snapshotv1.VirtualMachineSnapshotGroupStatus, members []*snapshotv1.VirtualMachineSnapshot, reason string) {
+	if status.CreationTime != nil && status.ThawTime == nil {
+		if err := ctrl.thawVMs(members); err != nil {
+			log.Log.Object(group).Reason(err).Error("Failed to thaw the VMs of the snapshot group")
+		}
+		status.ThawTime = currentTime()
+	}
+
+	ctrl.Recorder.Event(group, corev1.EventTypeWarning, vmSnapshotGroupFailedEvent, reason)
+	status.Phase = snapshotv1.Failed
+	status.ReadyToUse = pointer.P(false)
+	status.Error = &snapshotv1.Error{
+		Time:    currentTime(),
+		Message: pointer.P(reason),
+	}
+	updateGroupCondition(status, newFailureCondition(corev1.ConditionTrue, reason))
+	updateGroupCondition(status, newProgressingCondition(corev1.ConditionFalse, "Operation failed"))
+	updateGroupCondition(status, newReadyCondition(corev1.ConditionFalse, "Operation failed"))
+}
+
+func updateGroupCondition(status *snapshotv1.VirtualMachineSnapshotGroupStatus, c snapshotv1.Condition) {
+	status.Conditions = updateCondition(status.Conditions, c)
+}
+
+func allReady(members []*snapshotv1.VirtualMachineSnapshot) bool {
+	for _, member := range members {
+		if member == nil || !VmSnapshotReady(member) {
+			return false
+		}
+	}
+	return true
+}
+
+func groupFailureDeadline(group *snapshotv1.VirtualMachineSnapshotGroup) time.Duration {
+	if group.Spec.FailureDeadline != nil {
+		return group.Spec.FailureDeadline.Duration
+	}
+	return snapshotv1.DefaultFailureDeadline
+}
+
+func timeUntilGroupDeadline(group *snapshotv1.VirtualMachineSnapshotGroup) time.Duration {
+	failureDeadline := groupFailureDeadline(group)
+	// No Deadline set by user
+	if failureDeadline == 0 {
+		return 0
+	}
+	return time.Until(group.CreationTimestamp.Add(failureDeadline))
+}
+
+func groupDeadlineExceeded(group *snapshotv1.VirtualMachineSnapshotGroup) bool {
+	failureDeadline := groupFailureDeadline(group)
+	return failureDeadline != 0 && !currentTime().Time.Before(group.CreationTimestamp.Add(failureDeadline))
+}
+
+func snapshotGroupMember(content *snapshotv1.VirtualMachineSnapshotContent) bool {
+	_, ok := content.Labels[snapshotv1.SnapshotGroupLabel]
+	return ok
+}
+
+func snapshotGroupFrozen(content *snapshotv1.VirtualMachineSnapshotContent) bool {
+	_, ok := content.Annotations[SnapshotGroupFrozenAnnotation]
+	return ok
+}
@@ -0,0 +1,285 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/cache"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	vmSnapshotGroupRestoreFailedEvent = "VirtualMachineSnapshotGroupRestoreFailed"
+
+	vmSnapshotGroupMissingMsg  = "VirtualMachineSnapshotGroup %s does not exist"
+	vmSnapshotGroupNotReadyMsg = "Waiting for VirtualMachineSnapshotGroup %s to be ready"
+	failedGroupRestoreMsg      = "VirtualMachineRestore %s failed: %s"
+	groupRestoreInProgressMsg  = "Restoring the VirtualMachines of the group"
+
+	groupRestoreRetryInterval = 5 * time.Second
+)
+
+func (ctrl *VMSnapshotGroupController) vmSnapshotGroupRestoreWorker() {
+	for ctrl.processVMSnapshotGroupRestoreWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) processVMSnapshotGroupRestoreWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.groupRestoreQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotGroupRestore worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotGroupRestoreInformer.GetStore().GetByKey(key)
+		if !exists || err != nil {
+			return 0, err
+		}
+
+		groupRestore, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotGroupRestore)
+		if !ok {
+			return 0, fmt.Errorf(unexpectedResourceFmt, storeObj)
+		}
+		if groupRestore.DeletionTimestamp != nil {
+			return 0, nil
+		}
+
+		return ctrl.updateVMSnapshotGroupRestore(groupRestore.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotGroupController) handleVMSnapshotGroupRestore(obj interface{}) {
+	if groupRestore, ok := obj.(*snapshotv1.VirtualMachineSnapshotGroupRestore); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(groupRestore)
+		if err != nil {
+			log.Log.Errorf(failedKeyFromObjectFmt, err, groupRestore)
+			return
+		}
+
+		log.Log.V(3).Infof(enqueuedForSyncFmt, objName)
+		ctrl.groupRestoreQueue.Add(objName)
+	}
+}
+
+// handleGroupRestoreMember enqueues the group restore of a restore
+func (ctrl *VMSnapshotGroupController) handleGroupRestoreMember(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmRestore, ok := obj.(*snapshotv1.VirtualMachineRestore); ok {
+		if groupRestoreName, ok := vmRestore.Labels[snapshotv1.SnapshotGroupRestoreLabel]; ok {
+			ctrl.groupRestoreQueue.Add(cacheKeyFunc(vmRestore.Namespace, groupRestoreName))
+		}
+	}
+}
+
+func (ctrl *VMSnapshotGroupController) updateVMSnapshotGroupRestore(groupRestore *snapshotv1.VirtualMachineSnapshotGroupRestore) (time.Duration, error) {
+	status, retry, syncErr := ctrl.syncGroupRestore(groupRestore)
+	if !equality.Semantic.DeepEqual(groupRestore.Status, status) {
+		groupRestoreCopy := groupRestore.DeepCopy()
+		groupRestoreCopy.Status = status
+		_, err := ctrl.Client.VirtualMachineSnapshotGroupRestore(groupRestoreCopy.Namespace).UpdateStatus(context.Background(), groupRestoreCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return retry, syncErr
+}
+
+// syncGroupRestore restores each VM of a ready group from its snapshot with a
+// VirtualMachineRestore and completes once all of them completed. It returns
+// the time until the group restore needs to be synced again.
+func (ctrl *VMSnapshotGroupController) syncGroupRestore(groupRestore *snapshotv1.VirtualMachineSnapshotGroupRestore) (*snapshotv1.VirtualMachineSnapshotGroupRestoreStatus, time.Duration, error) {
+	status := &snapshotv1.VirtualMachineSnapshotGroupRestoreStatus{}
+	if groupRestore.Status != nil {
+		status = groupRestore.Status.DeepCopy()
+	}
+	if status.Complete != nil && *status.Complete {
+		return status, 0, nil
+	}
+
+	if len(status.Restores) == 0 {
+		group, err := ctrl.getVMSnapshotGroup(groupRestore.Namespace, groupRestore.Spec.VirtualMachineSnapshotGroupName)
+		if err != nil {
+			return status, 0, err
+		}
+		status.Complete = pointer.P(false)
+		if group == nil {
+			reason := fmt.Sprintf(vmSnapshotGroupMissingMsg, groupRestore.Spec.VirtualMachineSnapshotGroupName)
+			updateGroupRestoreCondition(status, newProgressingCondition(corev1.ConditionFalse, reason))
+			updateGroupRestoreCondition(status, newReadyCondition(corev1.ConditionFalse, reason))
+			return status, groupRestoreRetryInterval, nil
+		}
+		if group.Status == nil || group.Status.ReadyToUse == nil || !*group.Status.ReadyToUse {
+			reason := fmt.Sprintf(vmSnapshotGroupNotReadyMsg, group.Name)
+			updateGroupRestoreCondition(status, newProgressingCondition(corev1.ConditionFalse, reason))
+			updateGroupRestoreCondition(status, newReadyCondition(corev1.ConditionFalse, reason))
+			return status, groupRestoreRetryInterval, nil
+		}
+
+		for _, member := range group.Status.Snapshots {
+			status.Restores = append(status.Restores, snapshotv1.SnapshotGroupRestoreMember{
+				VirtualMachineName:        member.VirtualMachineName,
+				VirtualMachineRestoreName: naming.GetName(groupRestore.Name, member.VirtualMachineName, validation.DNS1035LabelMaxLength),
+			})
+		}
+		updateGroupRestoreCondition(status, newProgressingCondition(corev1.ConditionTrue, groupRestoreInProgressMsg))
+		updateGroupRestoreCondition(status, newReadyCondition(corev1.ConditionFalse, groupRestoreInProgressMsg))
+		// the restores are created once they are recorded
+		return status, 0, nil
+	}
+
+	vmRestores, err := ctrl.groupRestoreMembers(groupRestore, status)
+	if err != nil {
+		return status, 0, err
+	}
+
+	complete := true
+	for i, vmRestore := range vmRestores {
+		if vmRestore == nil {
+			complete = false
+			continue
+		}
+		if vmRestore.Status == nil {
+			complete = false
+			continue
+		}
+		for _, cond := range vmRestore.Status.Conditions {
+			if cond.Type == snapshotv1.ConditionFailure && cond.Status == corev1.ConditionTrue {
+				reason := fmt.Sprintf(failedGroupRestoreMsg, status.Restores[i].VirtualMachineRestoreName, cond.Reason)
+				ctrl.Recorder.Event(groupRestore, corev1.EventTypeWarning, vmSnapshotGroupRestoreFailedEvent, reason)
+				updateGroupRestoreCondition(status, newFailureCondition(corev1.ConditionTrue, reason))
+				updateGroupRestoreCondition(status, newProgressingCondition(corev1.ConditionFalse, "Operation failed"))
+				updateGroupRestoreCondition(status, newReadyCondition(corev1.ConditionFalse, "Operation failed"))
+				return status, 0, nil
+			}
+		}
+		if vmRestore.Status.Complete == nil || !*vmRestore.Status.Complete {
+			complete = false
+		}
+	}
+	if !complete {
+		return status, 0, nil
+	}
+
+	status.Complete = pointer.P(true)
+	status.RestoreTime = currentTime()
+	updateGroupRestoreCondition(status, newProgressingCondition(corev1.ConditionFalse, "Operation complete"))
+	updateGroupRestoreCondition(status, newReadyCondition(corev1.ConditionTrue, "Operation complete"))
+	return status, 0, nil
+}
+
+func (ctrl *VMSnapshotGroupController) getVMSnapshotGroup(namespace, name string) (*snapshotv1.VirtualMachineSnapshotGroup, error) {
+	obj, exists, err := ctrl.VMSnapshotGroupInformer.GetStore().GetByKey(cacheKeyFunc(namespace, name))
+	if err != nil || !exists {
+		return nil, err
+	}
+	return obj.(*snapshotv1.VirtualMachineSnapshotGroup), nil
+}
+
+// groupRestoreMembers returns the restores of the group restore in the order of
+// the status and creates the ones which do not exist yet
+func (ctrl *VMSnapshotGroupController) groupRestoreMembers(groupRestore *snapshotv1.VirtualMachineSnapshotGroupRestore, status *snapshotv1.VirtualMachineSnapshotGroupRestoreStatus) ([]*snapshotv1.VirtualMachineRestore, error) {
+	group, err := ctrl.getVMSnapshotGroup(groupRestore.Namespace, groupRestore.Spec.VirtualMachineSnapshotGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	vmRestores := make([]*snapshotv1.VirtualMachineRestore, len(status.Restores))
+	for i, member := range status.Restores {
+		obj, exists, err := ctrl.VMRestoreInformer.GetStore().GetByKey(cacheKeyFunc(groupRestore.Namespace, member.VirtualMachineRestoreName))
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			vmRestores[i] = obj.(*snapshotv1.VirtualMachineRestore)
+			continue
+		}
+
+		vmSnapshotName := groupSnapshotName(group, member.VirtualMachineName)
+		if vmSnapshotName == "" {
+			return nil, fmt.Errorf("no snapshot of VirtualMachine %s in VirtualMachineSnapshotGroup %s", member.VirtualMachineName, groupRestore.Spec.VirtualMachineSnapshotGroupName)
+		}
+
+		vmRestore := &snapshotv1.VirtualMachineRestore{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      member.VirtualMachineRestoreName,
+				Namespace: groupRestore.Namespace,
+				Labels: map[string]string{
+					snapshotv1.SnapshotGroupRestoreLabel: groupRestore.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(groupRestore, snapshotv1.SchemeGroupVersion.WithKind("VirtualMachineSnapshotGroupRestore")),
+				},
+			},
+			Spec: snapshotv1.VirtualMachineRestoreSpec{
+				Target: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(kubevirtv1.GroupVersion.Group),
+					Kind:     kubevirtv1.VirtualMachineGroupVersionKind.Kind,
+					Name:     member.VirtualMachineName,
+				},
+				VirtualMachineSnapshotName: vmSnapshotName,
+				TargetReadinessPolicy:      groupRestore.Spec.TargetReadinessPolicy,
+				VolumeRestorePolicy:        groupRestore.Spec.VolumeRestorePolicy,
+			},
+		}
+
+		vmRestore, err = ctrl.Client.VirtualMachineRestore(groupRestore.Namespace).Create(context.Background(), vmRestore, metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			// created by an earlier sync, not in the cache yet
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		vmRestores[i] = vmRestore
+	}
+	return vmRestores, nil
+}
+
+func groupSnapshotName(group *snapshotv1.VirtualMachineSnapshotGroup, vmName string) string {
+	if group == nil || group.Status == nil {
+		return ""
+	}
+	for _, member := range group.Status.Snapshots {
+		if member.VirtualMachineName == vmName {
+			return member.VirtualMachineSnapshotName
+		}
+	}
+	return ""
+}
+
+func updateGroupRestoreCondition(status *snapshotv1.VirtualMachineSnapshotGroupRestoreStatus, c snapshotv1.Condition) {
+	status.Conditions = updateCondition(status.Conditions, c)
+}
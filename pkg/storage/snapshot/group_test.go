@@ -0,0 +1,521 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot group controller", func() {
+	const (
+		groupName        = "test-group"
+		groupRestoreName = "test-group-restore"
+		failureDeadline  = 10 * time.Minute
+	)
+
+	var (
+		virtClient                *kubecli.MockKubevirtClient
+		kubevirtClient            *kubevirtfake.Clientset
+		vmiInterface              *kubecli.MockVirtualMachineInstanceInterface
+		groupInformer             cache.SharedIndexInformer
+		groupRestoreInformer      cache.SharedIndexInformer
+		vmSnapshotInformer        cache.SharedIndexInformer
+		vmSnapshotContentInformer cache.SharedIndexInformer
+		vmRestoreInformer         cache.SharedIndexInformer
+		vmInformer                cache.SharedIndexInformer
+		vmiInformer               cache.SharedIndexInformer
+		recorder                  *record.FakeRecorder
+		controller                *VMSnapshotGroupController
+	)
+
+	createGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		return &snapshotv1.VirtualMachineSnapshotGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              groupName,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.Now(),
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotGroupSpec{
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "shop"},
+				},
+				FailureDeadline: &metav1.Duration{Duration: failureDeadline},
+			},
+		}
+	}
+
+	createVM := func(name string, selected bool) *v1.VirtualMachine {
+		vm := createVirtualMachine(testNamespace, name)
+		if selected {
+			vm.Labels["app"] = "shop"
+		}
+		return vm
+	}
+
+	createAgentVMI := func(name string) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Conditions: []v1.VirtualMachineInstanceCondition{
+					{
+						Type:   v1.VirtualMachineInstanceAgentConnected,
+						Status: corev1.ConditionTrue,
+					},
+				},
+			},
+		}
+	}
+
+	memberName := func(vmName string) string {
+		return fmt.Sprintf("%s-%s", groupName, vmName)
+	}
+
+	addGroup := func(group *snapshotv1.VirtualMachineSnapshotGroup) {
+		Expect(groupInformer.GetStore().Add(group)).To(Succeed())
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(testNamespace).Create(context.Background(), group, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	getGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+		group, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(testNamespace).Get(context.Background(), groupName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return group
+	}
+
+	syncGroup := func() time.Duration {
+		group := getGroup()
+		Expect(groupInformer.GetStore().Update(group)).To(Succeed())
+		retry, err := controller.updateVMSnapshotGroup(group)
+		Expect(err).ToNot(HaveOccurred())
+		return retry
+	}
+
+	addVMs := func(vms ...*v1.VirtualMachine) {
+		for _, vm := range vms {
+			Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		}
+	}
+
+	// observeMember syncs a snapshot created by the controller into the cache
+	observeMember := func(vmName string, status *snapshotv1.VirtualMachineSnapshotStatus) {
+		vmSnapshot, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).Get(context.Background(), memberName(vmName), metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		vmSnapshot.Status = status
+		Expect(vmSnapshotInformer.GetStore().Add(vmSnapshot)).To(Succeed())
+	}
+
+	// observeContent adds the content of a snapshot to the client and the cache
+	observeContent := func(vmName string, status *snapshotv1.VirtualMachineSnapshotContentStatus) {
+		content, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotContents(testNamespace).Get(context.Background(), "content-"+memberName(vmName), metav1.GetOptions{})
+		if err != nil {
+			content = &snapshotv1.VirtualMachineSnapshotContent{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "content-" + memberName(vmName),
+					Namespace: testNamespace,
+					Labels:    map[string]string{snapshotv1.SnapshotGroupLabel: groupName},
+				},
+				Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+					VirtualMachineSnapshotName: pointer.P(memberName(vmName)),
+				},
+			}
+			content, err = kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotContents(testNamespace).Create(context.Background(), content, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+		content.Status = status
+		Expect(vmSnapshotContentInformer.GetStore().Add(content)).To(Succeed())
+	}
+
+	getContent := func(vmName string) *snapshotv1.VirtualMachineSnapshotContent {
+		content, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotContents(testNamespace).Get(context.Background(), "content-"+memberName(vmName), metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return content
+	}
+
+	inProgressMember := func(vmName string) *snapshotv1.VirtualMachineSnapshotStatus {
+		return &snapshotv1.VirtualMachineSnapshotStatus{
+			Phase:                             snapshotv1.InProgress,
+			VirtualMachineSnapshotContentName: pointer.P("content-" + memberName(vmName)),
+		}
+	}
+
+	succeededMember := func(vmName string) *snapshotv1.VirtualMachineSnapshotStatus {
+		status := inProgressMember(vmName)
+		status.Phase = snapshotv1.Succeeded
+		status.ReadyToUse = pointer.P(true)
+		return status
+	}
+
+	// startGroup syncs the group until the snapshots and contents of vm-a and vm-b exist
+	startGroup := func() {
+		addGroup(createGroup())
+		addVMs(createVM("vm-b", true), createVM("vm-a", true))
+		syncGroup()
+		syncGroup()
+		for _, vmName := range []string{"vm-a", "vm-b"} {
+			observeMember(vmName, inProgressMember(vmName))
+			observeContent(vmName, nil)
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineInstance(testNamespace).Return(vmiInterface).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotGroup(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotGroupRestore(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroupRestores(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotContent(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotContents(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineRestore(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(testNamespace)).AnyTimes()
+
+		groupInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
+		groupRestoreInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroupRestore{})
+		vmSnapshotInformer, _ = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshot{}, virtcontroller.GetVirtualMachineSnapshotInformerIndexers())
+		vmSnapshotContentInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotContent{})
+		vmRestoreInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		controller = &VMSnapshotGroupController{
+			Client:                         virtClient,
+			VMSnapshotGroupInformer:        groupInformer,
+			VMSnapshotGroupRestoreInformer: groupRestoreInformer,
+			VMSnapshotInformer:             vmSnapshotInformer,
+			VMSnapshotContentInformer:      vmSnapshotContentInformer,
+			VMRestoreInformer:              vmRestoreInformer,
+			VMInformer:                     vmInformer,
+			VMIInformer:                    vmiInformer,
+			Recorder:                       recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	Context("snapshot group", func() {
+		It("should record and snapshot the selected VMs", func() {
+			addGroup(createGroup())
+			addVMs(createVM("vm-b", true), createVM("vm-a", true), createVM("vm-c", false))
+
+			syncGroup()
+			status := getGroup().Status
+			Expect(status.Phase).To(Equal(snapshotv1.InProgress))
+			Expect(status.Snapshots).To(Equal([]snapshotv1.SnapshotGroupMember{
+				{VirtualMachineName: "vm-a", VirtualMachineSnapshotName: memberName("vm-a")},
+				{VirtualMachineName: "vm-b", VirtualMachineSnapshotName: memberName("vm-b")},
+			}))
+
+			syncGroup()
+			vmSnapshots, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmSnapshots.Items).To(HaveLen(2))
+			for _, vmSnapshot := range vmSnapshots.Items {
+				Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotv1.SnapshotGroupLabel, groupName))
+				Expect(vmSnapshot.OwnerReferences).To(HaveLen(1))
+				Expect(vmSnapshot.OwnerReferences[0].Name).To(Equal(groupName))
+				Expect(vmSnapshot.Spec.FailureDeadline.Duration).To(Equal(failureDeadline))
+			}
+		})
+
+		It("should fail when no VM is selected", func() {
+			addGroup(createGroup())
+			addVMs(createVM("vm-c", false))
+
+			syncGroup()
+			status := getGroup().Status
+			Expect(status.Phase).To(Equal(snapshotv1.Failed))
+			Expect(*status.Error.Message).To(Equal(noVMsSelectedMsg))
+			testutils.ExpectEvent(recorder, vmSnapshotGroupFailedEvent)
+		})
+
+		It("should wait for the contents of all snapshots", func() {
+			addGroup(createGroup())
+			addVMs(createVM("vm-a", true), createVM("vm-b", true))
+			syncGroup()
+			syncGroup()
+			observeMember("vm-a", inProgressMember("vm-a"))
+			observeContent("vm-a", nil)
+
+			Expect(syncGroup()).To(BeNumerically(">", 0))
+			Expect(getGroup().Status.CreationTime).To(BeNil())
+			Expect(getContent("vm-a").Annotations).ToNot(HaveKey(SnapshotGroupFrozenAnnotation))
+		})
+
+		It("should freeze all VMs and release the contents", func() {
+			startGroup()
+			Expect(vmiInformer.GetStore().Add(createAgentVMI("vm-a"))).To(Succeed())
+			Expect(vmiInformer.GetStore().Add(createAgentVMI("vm-b"))).To(Succeed())
+			vmiInterface.EXPECT().Freeze(context.Background(), "vm-a", failureDeadline).Return(nil).Times(1)
+			vmiInterface.EXPECT().Freeze(context.Background(), "vm-b", failureDeadline).Return(nil).Times(1)
+
+			syncGroup()
+			testutils.ExpectEvent(recorder, vmSnapshotGroupFrozenEvent)
+			status := getGroup().Status
+			Expect(status.CreationTime).ToNot(BeNil())
+			Expect(status.ThawTime).To(BeNil())
+			for _, vmName := range []string{"vm-a", "vm-b"} {
+				Expect(getContent(vmName).Annotations).To(HaveKeyWithValue(SnapshotGroupFrozenAnnotation, status.CreationTime.UTC().Format(time.RFC3339)))
+			}
+		})
+
+		It("should not freeze VMs without a guest agent", func() {
+			startGroup()
+			Expect(vmiInformer.GetStore().Add(createAgentVMI("vm-a"))).To(Succeed())
+			vmiInterface.EXPECT().Freeze(context.Background(), "vm-a", failureDeadline).Return(nil).Times(1)
+
+			syncGroup()
+			Expect(getContent("vm-b").Annotations).To(HaveKey(SnapshotGroupFrozenAnnotation))
+		})
+
+		It("should thaw all VMs and fail when a VM cannot be frozen", func() {
+			startGroup()
+			Expect(vmiInformer.GetStore().Add(createAgentVMI("vm-a"))).To(Succeed())
+			Expect(vmiInformer.GetStore().Add(createAgentVMI("vm-b"))).To(Succeed())
+			vmiInterface.EXPECT().Freeze(context.Background(), "vm-a", failureDeadline).Return(nil).Times(1)
+			vmiInterface.EXPECT().Freeze(context.Background(), "vm-b", failureDeadline).Return(fmt.Errorf("agent error")).Times(1)
+			vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-a").Return(nil).Times(1)
+			vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-b").Return(nil).Times(1)
+
+			syncGroup()
+			testutils.ExpectEvent(recorder, vmSnapshotGroupFailedEvent)
+			status := getGroup().Status
+			Expect(status.Phase).To(Equal(snapshotv1.Failed))
+			Expect(*status.Error.Message).To(ContainSubstring("agent error"))
+			Expect(getContent("vm-a").Annotations).ToNot(HaveKey(SnapshotGroupFrozenAnnotation))
+		})
+
+		It("should thaw all VMs once all volumes are snapshotted", func() {
+			startGroup()
+			Expect(vmiInformer.GetStore().Add(createAgentVMI("vm-a"))).To(Succeed())
+			Expect(vmiInformer.GetStore().Add(createAgentVMI("vm-b"))).To(Succeed())
+			vmiInterface.EXPECT().Freeze(context.Background(), gomock.Any(), failureDeadline).Return(nil).Times(2)
+			syncGroup()
+			testutils.ExpectEvent(recorder, vmSnapshotGroupFrozenEvent)
+
+			created := &snapshotv1.VirtualMachineSnapshotContentStatus{CreationTime: pointer.P(metav1.Now())}
+			observeContent("vm-a", created)
+			observeContent("vm-b", nil)
+			syncGroup()
+			Expect(getGroup().Status.ThawTime).To(BeNil())
+
+			vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-a").Return(nil).Times(1)
+			vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-b").Return(nil).Times(1)
+			observeContent("vm-b", created)
+			syncGroup()
+			testutils.ExpectEvent(recorder, vmSnapshotGroupThawedEvent)
+			status := getGroup().Status
+			Expect(status.ThawTime).ToNot(BeNil())
+			Expect(status.Phase).To(Equal(snapshotv1.InProgress))
+
+			observeMember("vm-a", succeededMember("vm-a"))
+			observeMember("vm-b", succeededMember("vm-b"))
+			syncGroup()
+			status = getGroup().Status
+			Expect(status.Phase).To(Equal(snapshotv1.Succeeded))
+			Expect(*status.ReadyToUse).To(BeTrue())
+		})
+
+		It("should thaw the frozen VMs and fail when a snapshot fails", func() {
+			startGroup()
+			Expect(vmiInformer.GetStore().Add(createAgentVMI("vm-a"))).To(Succeed())
+			vmiInterface.EXPECT().Freeze(context.Background(), "vm-a", failureDeadline).Return(nil).Times(1)
+			syncGroup()
+
+			vmiInterface.EXPECT().Unfreeze(context.Background(), "vm-a").Return(nil).Times(1)
+			failed := inProgressMember("vm-b")
+			failed.Phase = snapshotv1.Failed
+			observeMember("vm-b", failed)
+			syncGroup()
+			status := getGroup().Status
+			Expect(status.Phase).To(Equal(snapshotv1.Failed))
+			Expect(status.ThawTime).ToNot(BeNil())
+			Expect(*status.Error.Message).To(Equal(fmt.Sprintf(failedGroupMemberMsg, memberName("vm-b"))))
+		})
+
+		It("should fail when the deadline is exceeded", func() {
+			group := createGroup()
+			group.CreationTimestamp = metav1.NewTime(time.Now().Add(-2 * failureDeadline))
+			addGroup(group)
+			addVMs(createVM("vm-a", true))
+			syncGroup()
+			syncGroup()
+
+			status := getGroup().Status
+			Expect(status.Phase).To(Equal(snapshotv1.Failed))
+			Expect(*status.Error.Message).To(Equal(vmSnapshotGroupDeadlineMsg))
+		})
+	})
+
+	Context("snapshot group restore", func() {
+		createGroupRestore := func() *snapshotv1.VirtualMachineSnapshotGroupRestore {
+			return &snapshotv1.VirtualMachineSnapshotGroupRestore{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      groupRestoreName,
+					Namespace: testNamespace,
+				},
+				Spec: snapshotv1.VirtualMachineSnapshotGroupRestoreSpec{
+					VirtualMachineSnapshotGroupName: groupName,
+				},
+			}
+		}
+
+		createReadyGroup := func() *snapshotv1.VirtualMachineSnapshotGroup {
+			group := createGroup()
+			group.Status = &snapshotv1.VirtualMachineSnapshotGroupStatus{
+				Phase:      snapshotv1.Succeeded,
+				ReadyToUse: pointer.P(true),
+				Snapshots: []snapshotv1.SnapshotGroupMember{
+					{VirtualMachineName: "vm-a", VirtualMachineSnapshotName: memberName("vm-a")},
+					{VirtualMachineName: "vm-b", VirtualMachineSnapshotName: memberName("vm-b")},
+				},
+			}
+			return group
+		}
+
+		restoreName := func(vmName string) string {
+			return fmt.Sprintf("%s-%s", groupRestoreName, vmName)
+		}
+
+		addGroupRestore := func() {
+			groupRestore := createGroupRestore()
+			Expect(groupRestoreInformer.GetStore().Add(groupRestore)).To(Succeed())
+			_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroupRestores(testNamespace).Create(context.Background(), groupRestore, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		getStatus := func() *snapshotv1.VirtualMachineSnapshotGroupRestoreStatus {
+			groupRestore, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroupRestores(testNamespace).Get(context.Background(), groupRestoreName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return groupRestore.Status
+		}
+
+		syncGroupRestore := func() time.Duration {
+			groupRestore, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroupRestores(testNamespace).Get(context.Background(), groupRestoreName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			retry, err := controller.updateVMSnapshotGroupRestore(groupRestore)
+			Expect(err).ToNot(HaveOccurred())
+			return retry
+		}
+
+		// observeRestore syncs a restore created by the controller into the cache
+		observeRestore := func(vmName string, status *snapshotv1.VirtualMachineRestoreStatus) {
+			vmRestore, err := kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(testNamespace).Get(context.Background(), restoreName(vmName), metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			vmRestore.Status = status
+			Expect(vmRestoreInformer.GetStore().Add(vmRestore)).To(Succeed())
+		}
+
+		It("should wait for the group to be ready", func() {
+			group := createReadyGroup()
+			group.Status.ReadyToUse = pointer.P(false)
+			Expect(groupInformer.GetStore().Add(group)).To(Succeed())
+			addGroupRestore()
+
+			Expect(syncGroupRestore()).To(Equal(groupRestoreRetryInterval))
+			status := getStatus()
+			Expect(status.Restores).To(BeEmpty())
+			Expect(*status.Complete).To(BeFalse())
+			Expect(status.Conditions).To(ContainElement(And(
+				HaveField("Type", snapshotv1.ConditionProgressing),
+				HaveField("Reason", fmt.Sprintf(vmSnapshotGroupNotReadyMsg, groupName)),
+			)))
+		})
+
+		It("should restore every VM of the group", func() {
+			Expect(groupInformer.GetStore().Add(createReadyGroup())).To(Succeed())
+			addGroupRestore()
+
+			syncGroupRestore()
+			Expect(getStatus().Restores).To(Equal([]snapshotv1.SnapshotGroupRestoreMember{
+				{VirtualMachineName: "vm-a", VirtualMachineRestoreName: restoreName("vm-a")},
+				{VirtualMachineName: "vm-b", VirtualMachineRestoreName: restoreName("vm-b")},
+			}))
+
+			syncGroupRestore()
+			for _, vmName := range []string{"vm-a", "vm-b"} {
+				vmRestore, err := kubevirtClient.SnapshotV1beta1().VirtualMachineRestores(testNamespace).Get(context.Background(), restoreName(vmName), metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(vmRestore.Labels).To(HaveKeyWithValue(snapshotv1.SnapshotGroupRestoreLabel, groupRestoreName))
+				Expect(vmRestore.Spec.Target.Name).To(Equal(vmName))
+				Expect(vmRestore.Spec.VirtualMachineSnapshotName).To(Equal(memberName(vmName)))
+			}
+			Expect(*getStatus().Complete).To(BeFalse())
+
+			observeRestore("vm-a", &snapshotv1.VirtualMachineRestoreStatus{Complete: pointer.P(true)})
+			observeRestore("vm-b", &snapshotv1.VirtualMachineRestoreStatus{Complete: pointer.P(true)})
+			syncGroupRestore()
+			status := getStatus()
+			Expect(*status.Complete).To(BeTrue())
+			Expect(status.RestoreTime).ToNot(BeNil())
+		})
+
+		It("should report a failed restore", func() {
+			Expect(groupInformer.GetStore().Add(createReadyGroup())).To(Succeed())
+			addGroupRestore()
+			syncGroupRestore()
+			syncGroupRestore()
+
+			observeRestore("vm-a", &snapshotv1.VirtualMachineRestoreStatus{Complete: pointer.P(true)})
+			observeRestore("vm-b", &snapshotv1.VirtualMachineRestoreStatus{
+				Complete: pointer.P(false),
+				Conditions: []snapshotv1.Condition{
+					newFailureCondition(corev1.ConditionTrue, "volume restore failed"),
+				},
+			})
+			syncGroupRestore()
+			testutils.ExpectEvent(recorder, vmSnapshotGroupRestoreFailedEvent)
+			status := getStatus()
+			Expect(*status.Complete).To(BeFalse())
+			Expect(status.Conditions).To(ContainElement(And(
+				HaveField("Type", snapshotv1.ConditionFailure),
+				HaveField("Status", corev1.ConditionTrue),
+			)))
+		})
+	})
+})
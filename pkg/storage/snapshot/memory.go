@@ -0,0 +1,266 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	memoryStateSaveEvent   = "SuccessfulMemoryStateSave"
+	memoryStateFailedEvent = "MemoryStateSaveFailed"
+
+	memoryStateSaveRetryInterval = 2 * time.Second
+)
+
+func memoryStatePVCName(vmSnapshot *snapshotv1.VirtualMachineSnapshot) string {
+	return fmt.Sprintf("vmsnapshot-%s-memory", vmSnapshot.UID)
+}
+
+func includeMemory(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
+	return vmSnapshot.Spec.IncludeMemory != nil && *vmSnapshot.Spec.IncludeMemory
+}
+
+func memoryStateSaved(content *snapshotv1.VirtualMachineSnapshotContent) bool {
+	return content.Status != nil &&
+		content.Status.MemoryStateStatus != nil &&
+		content.Status.MemoryStateStatus.CreationTime != nil
+}
+
+// saveMemoryState drives saving the memory state of the source vmi to the
+// memory state pvc of the content. It returns true once the state is saved,
+// the source is then left paused until its volumes are snapshotted.
+func (ctrl *VMSnapshotController) saveMemoryState(vmSnapshot *snapshotv1.VirtualMachineSnapshot, contentCpy *snapshotv1.VirtualMachineSnapshotContent) (bool, error) {
+	if contentCpy.Spec.MemoryState == nil || memoryStateSaved(contentCpy) {
+		return true, nil
+	}
+
+	vmi, err := ctrl.getSourceVMI(vmSnapshot)
+	if err != nil {
+		return false, err
+	}
+	if vmi == nil {
+		return false, fmt.Errorf("source vmi %s/%s does not exist, unable to save its memory state", vmSnapshot.Namespace, vmSnapshot.Spec.Source.Name)
+	}
+
+	if contentCpy.Status.MemoryStateStatus == nil {
+		// record whether the snapshot is going to pause the source before
+		// saving the memory state pauses it, so it can be unpaused afterwards
+		contentCpy.Status.MemoryStateStatus = &snapshotv1.MemoryStateStatus{
+			SourcePaused: !isVMIPaused(vmi),
+		}
+		return false, nil
+	}
+
+	pvcName := contentCpy.Spec.MemoryState.PersistentVolumeClaimName
+	if err := ctrl.createMemoryStatePVC(contentCpy, vmi, pvcName); err != nil {
+		return false, err
+	}
+
+	volumeStatus := memoryStateVolumeStatus(vmi, pvcName)
+	if volumeStatus == nil {
+		return false, ctrl.attachMemoryStateVolume(vmi, pvcName)
+	}
+
+	switch volumeStatus.Phase {
+	case kubevirtv1.MemoryDumpVolumeFailed:
+		ctrl.Recorder.Eventf(contentCpy, corev1.EventTypeWarning, memoryStateFailedEvent,
+			"Failed to save memory state of vmi %s: %s", vmi.Name, volumeStatus.Message)
+		return false, fmt.Errorf("failed to save memory state of vmi %s: %s", vmi.Name, volumeStatus.Message)
+	case kubevirtv1.MemoryDumpVolumeCompleted:
+		if err := ctrl.detachMemoryStateVolume(vmi, pvcName); err != nil {
+			return false, err
+		}
+		contentCpy.Status.MemoryStateStatus.CreationTime = currentTime()
+		ctrl.Recorder.Eventf(contentCpy, corev1.EventTypeNormal, memoryStateSaveEvent,
+			"Successfully saved memory state of vmi %s to PVC %s", vmi.Name, pvcName)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (ctrl *VMSnapshotController) getSourceVMI(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (*kubevirtv1.VirtualMachineInstance, error) {
+	vm, err := ctrl.getVM(vmSnapshot)
+	if err != nil || vm == nil {
+		return nil, err
+	}
+
+	vmi, exists, err := ctrl.getVMI(vm)
+	if err != nil || !exists {
+		return nil, err
+	}
+	return vmi, nil
+}
+
+func isVMIPaused(vmi *kubevirtv1.VirtualMachineInstance) bool {
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	return condManager.HasConditionWithStatus(vmi, kubevirtv1.VirtualMachineInstancePaused, corev1.ConditionTrue)
+}
+
+func memoryStateVolumeStatus(vmi *kubevirtv1.VirtualMachineInstance, volumeName string) *kubevirtv1.VolumeStatus {
+	for i, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.Name == volumeName && volumeStatus.MemoryDumpVolume != nil {
+			return &vmi.Status.VolumeStatus[i]
+		}
+	}
+	return nil
+}
+
+func (ctrl *VMSnapshotController) createMemoryStatePVC(content *snapshotv1.VirtualMachineSnapshotContent, vmi *kubevirtv1.VirtualMachineInstance, pvcName string) error {
+	_, exists, err := ctrl.PVCInformer.GetStore().GetByKey(cacheKeyFunc(content.Namespace, pvcName))
+	if err != nil || exists {
+		return err
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pvcName,
+			Namespace: content.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         snapshotv1.SchemeGroupVersion.String(),
+					Kind:               "VirtualMachineSnapshotContent",
+					Name:               content.Name,
+					UID:                content.UID,
+					Controller:         pointer.P(true),
+					BlockOwnerDeletion: pointer.P(true),
+				},
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			VolumeMode:  pointer.P(corev1.PersistentVolumeFilesystem),
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: *util.CalcExpectedMemoryDumpSize(vmi),
+				},
+			},
+		},
+	}
+
+	_, err = ctrl.Client.CoreV1().PersistentVolumeClaims(content.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		return err
+	}
+	log.Log.Object(content).Infof("Created memory state PVC %s", pvcName)
+	return nil
+}
+
+func (ctrl *VMSnapshotController) attachMemoryStateVolume(vmi *kubevirtv1.VirtualMachineInstance, pvcName string) error {
+	for _, volume := range vmi.Spec.UtilityVolumes {
+		if volume.Name == pvcName {
+			return nil
+		}
+	}
+
+	memoryStateVolume := kubevirtv1.UtilityVolume{
+		Name: pvcName,
+		PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+			ClaimName: pvcName,
+		},
+		Type: pointer.P(kubevirtv1.MemoryState),
+	}
+
+	patchSet := patch.New(
+		patch.WithTest("/spec/utilityVolumes", vmi.Spec.UtilityVolumes),
+	)
+	newUtilityVolumes := append(vmi.Spec.UtilityVolumes, memoryStateVolume)
+	if len(vmi.Spec.UtilityVolumes) > 0 {
+		patchSet.AddOption(patch.WithReplace("/spec/utilityVolumes", newUtilityVolumes))
+	} else {
+		patchSet.AddOption(patch.WithAdd("/spec/utilityVolumes", newUtilityVolumes))
+	}
+
+	return ctrl.patchVMI(vmi, patchSet)
+}
+
+func (ctrl *VMSnapshotController) detachMemoryStateVolume(vmi *kubevirtv1.VirtualMachineInstance, pvcName string) error {
+	newUtilityVolumes := make([]kubevirtv1.UtilityVolume, 0, len(vmi.Spec.UtilityVolumes))
+	for _, volume := range vmi.Spec.UtilityVolumes {
+		if volume.Name != pvcName {
+			newUtilityVolumes = append(newUtilityVolumes, volume)
+		}
+	}
+	if len(newUtilityVolumes) == len(vmi.Spec.UtilityVolumes) {
+		return nil
+	}
+
+	patchSet := patch.New(
+		patch.WithTest("/spec/utilityVolumes", vmi.Spec.UtilityVolumes),
+	)
+	if len(newUtilityVolumes) == 0 {
+		patchSet.AddOption(patch.WithRemove("/spec/utilityVolumes"))
+	} else {
+		patchSet.AddOption(patch.WithReplace("/spec/utilityVolumes", newUtilityVolumes))
+	}
+
+	return ctrl.patchVMI(vmi, patchSet)
+}
+
+func (ctrl *VMSnapshotController) patchVMI(vmi *kubevirtv1.VirtualMachineInstance, patchSet *patch.PatchSet) error {
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.Client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+// releaseMemoryState detaches the memory state volume from the source and
+// unpauses it, if the snapshot paused it to save its memory state
+func (ctrl *VMSnapshotController) releaseMemoryState(vmSnapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent) error {
+	if vmSnapshot == nil || content.Spec.MemoryState == nil || content.Status == nil || content.Status.MemoryStateStatus == nil {
+		return nil
+	}
+
+	vmi, err := ctrl.getSourceVMI(vmSnapshot)
+	if err != nil || vmi == nil {
+		return err
+	}
+
+	if err := ctrl.detachMemoryStateVolume(vmi, content.Spec.MemoryState.PersistentVolumeClaimName); err != nil {
+		return err
+	}
+
+	if !content.Status.MemoryStateStatus.SourcePaused || !isVMIPaused(vmi) {
+		return nil
+	}
+
+	log.Log.Object(vmi).V(3).Infof("Unpausing vmi %s after saving its memory state", vmi.Name)
+	return ctrl.Client.VirtualMachineInstance(vmi.Namespace).Unpause(context.Background(), vmi.Name, &kubevirtv1.UnpauseOptions{})
+}
@@ -604,6 +604,13 @@ func (t *vmRestoreTarget) UpdateDoneRestore() error {
 
 	vmCopy.Status.RestoreInProgress = nil
 	vmCopy.Status.MemoryDumpRequest = nil
+	if resumeFromMemory(t.vmRestore) {
+		startRequest, err := t.memoryStateStartRequest()
+		if err != nil {
+			return err
+		}
+		vmCopy.Status.StateChangeRequests = append(vmCopy.Status.StateChangeRequests, *startRequest)
+	}
 	vmCopy, err := t.controller.Client.VirtualMachine(vmCopy.Namespace).UpdateStatus(context.Background(), vmCopy, metav1.UpdateOptions{})
 	if err != nil {
 		return err
@@ -612,6 +619,35 @@ func (t *vmRestoreTarget) UpdateDoneRestore() error {
 	return nil
 }
 
+func resumeFromMemory(vmRestore *snapshotv1.VirtualMachineRestore) bool {
+	return vmRestore.Spec.ResumeFromMemory != nil && *vmRestore.Spec.ResumeFromMemory
+}
+
+// memoryStateStartRequest returns a start request resuming the restored vm
+// from the memory state saved with the snapshot
+func (t *vmRestoreTarget) memoryStateStartRequest() (*kubevirtv1.VirtualMachineStateChangeRequest, error) {
+	vmSnapshot, err := t.controller.getVMSnapshot(t.vmRestore)
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := t.controller.getSnapshotContent(vmSnapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	if content.Spec.MemoryState == nil {
+		return nil, fmt.Errorf("snapshot %s does not include the memory state of the vm", vmSnapshot.Name)
+	}
+
+	return &kubevirtv1.VirtualMachineStateChangeRequest{
+		Action: kubevirtv1.StartRequest,
+		Data: map[string]string{
+			kubevirtv1.StartRequestDataMemoryStateKey: content.Spec.MemoryState.PersistentVolumeClaimName,
+		},
+	}, nil
+}
+
 func (t *vmRestoreTarget) UpdateRestoreInProgress() error {
 	if !t.Exists() || hasLastRestoreAnnotation(t.vmRestore, t.vm) {
 		return nil
@@ -995,7 +1031,7 @@ func (t *vmRestoreTarget) reconcileSpec(restoredVM *kubevirtv1.VirtualMachine) (
 	}
 
 	if !t.Exists() {
-		restoredVM, err = patchVM(restoredVM, t.vmRestore.Spec.Patches)
+		restoredVM, err = PatchVM(restoredVM, t.vmRestore.Spec.Patches)
 		if err != nil {
 			return false, fmt.Errorf("error patching VM %s: %v", restoredVM.Name, err)
 		}
@@ -1412,7 +1448,8 @@ func (ctrl *VMRestoreController) getVM(namespace, name string) (vm *kubevirtv1.V
 	return obj.(*kubevirtv1.VirtualMachine).DeepCopy(), nil
 }
 
-func patchVM(vm *kubevirtv1.VirtualMachine, patches []string) (*kubevirtv1.VirtualMachine, error) {
+// PatchVM applies the JSON patches to a copy of the VM
+func PatchVM(vm *kubevirtv1.VirtualMachine, patches []string) (*kubevirtv1.VirtualMachine, error) {
 	if len(patches) == 0 {
 		return vm, nil
 	}
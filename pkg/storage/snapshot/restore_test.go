@@ -1318,6 +1318,65 @@ var _ = Describe("Restore controller", func() {
 				Expect(*dvDeleteCalls).To(Equal(len(r.Status.DeletedDataVolumes)))
 			})
 
+			It("should request to resume the vm from its memory state when restore completes", func() {
+				r := createRestoreWithOwner()
+				r.Spec.ResumeFromMemory = pointer.P(true)
+				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
+					Complete: pointer.P(false),
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Updating target spec"),
+						newReadyCondition(corev1.ConditionFalse, "Waiting for target update"),
+					},
+				}
+				addVolumeRestores(r)
+				for i := range r.Status.Restores {
+					r.Status.Restores[i].DataVolumeName = &r.Status.Restores[i].PersistentVolumeClaimName
+				}
+				addVirtualMachineRestore(r)
+
+				Expect(controller.VMSnapshotContentInformer.GetStore().Delete(sc)).To(Succeed())
+				sc = sc.DeepCopy()
+				sc.Spec.MemoryState = &snapshotv1.MemoryStateBackup{PersistentVolumeClaimName: "memory-state-pvc"}
+				Expect(controller.VMSnapshotContentInformer.GetStore().Add(sc)).To(Succeed())
+
+				vm := createRestoreInProgressVM()
+				vm.Annotations = map[string]string{lastRestoreAnnotation: "restore-uid"}
+				for _, pvc := range getRestorePVCs(r) {
+					pvc.Annotations["cdi.kubevirt.io/storage.populatedFor"] = pvc.Name
+					pvc.Status.Phase = corev1.ClaimBound
+					Expect(controller.PVCInformer.GetStore().Add(&pvc)).To(Succeed())
+				}
+				Expect(controller.VMInformer.GetStore().Add(vm)).To(Succeed())
+
+				updatedVM := vm.DeepCopy()
+				updatedVM.Status.RestoreInProgress = nil
+				updatedVM.Status.StateChangeRequests = []kubevirtv1.VirtualMachineStateChangeRequest{
+					{
+						Action: kubevirtv1.StartRequest,
+						Data: map[string]string{
+							kubevirtv1.StartRequestDataMemoryStateKey: "memory-state-pvc",
+						},
+					},
+				}
+
+				ur := r.DeepCopy()
+				ur.ResourceVersion = "1"
+				ur.Status.Complete = pointer.P(true)
+				ur.Status.RestoreTime = timeFunc()
+				ur.Status.Conditions = []snapshotv1.Condition{
+					newProgressingCondition(corev1.ConditionFalse, "Operation complete"),
+					newReadyCondition(corev1.ConditionTrue, "Operation complete"),
+				}
+
+				updateVMStatusCalls := expectVMUpdateStatus(kubevirtClient, updatedVM)
+				updateStatusCalls := expectVMRestoreUpdateStatus(kubevirtClient, ur)
+
+				controller.processVMRestoreWorkItem()
+
+				Expect(*updateVMStatusCalls).To(Equal(1))
+				Expect(*updateStatusCalls).To(Equal(1))
+			})
+
 			It("should complete restore", func() {
 				r := createRestoreWithOwner()
 				r.Status = &snapshotv1.VirtualMachineRestoreStatus{
@@ -0,0 +1,535 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/openshift/library-go/pkg/build/naming"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/cronschedule"
+	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
+)
+
+const (
+	// SnapshotScheduleTimeAnnotation holds the time of the schedule run a snapshot was taken for
+	SnapshotScheduleTimeAnnotation = "snapshot.kubevirt.io/schedule-time"
+
+	snapshotScheduleIndex = "snapshotSchedule"
+
+	vmSnapshotScheduledEvent       = "VirtualMachineSnapshotScheduled"
+	vmSnapshotScheduleSkippedEvent = "VirtualMachineSnapshotScheduleSkipped"
+	vmSnapshotScheduleFailedEvent  = "VirtualMachineSnapshotScheduleFailed"
+	vmSnapshotPrunedEvent          = "VirtualMachineSnapshotPruned"
+
+	invalidSnapshotScheduleMsg   = "invalid schedule %q: %v"
+	invalidSnapshotSelectorMsg   = "invalid selector: %v"
+	snapshotScheduleRunFailedMsg = "a snapshot of the run scheduled at %s failed"
+	vmSnapshotScheduledMsg       = "created VirtualMachineSnapshot %s of VirtualMachine %s"
+	vmSnapshotScheduleSkippedMsg = "skipped VirtualMachine %s in the run scheduled at %s, VirtualMachineSnapshot %s is still in progress"
+	vmSnapshotPrunedMsg          = "deleted VirtualMachineSnapshot %s past its retention"
+	failedScheduledVMSnapshotMsg = "failed to create VirtualMachineSnapshot of VirtualMachine %s: %v"
+	failedVMSnapshotPruneMsg     = "failed to delete VirtualMachineSnapshot %s: %v"
+)
+
+// VMSnapshotScheduleController periodically snapshots the VMs selected by
+// a VirtualMachineSnapshotSchedule and prunes the snapshots past their retention
+type VMSnapshotScheduleController struct {
+	Client kubecli.KubevirtClient
+
+	VMSnapshotScheduleInformer cache.SharedIndexInformer
+	VMSnapshotInformer         cache.SharedIndexInformer
+	VMInformer                 cache.SharedIndexInformer
+
+	Recorder record.EventRecorder
+
+	scheduleQueue workqueue.TypedRateLimitingInterface[string]
+}
+
+// Init initializes the snapshot schedule controller
+func (ctrl *VMSnapshotScheduleController) Init() error {
+	ctrl.scheduleQueue = workqueue.NewTypedRateLimitingQueueWithConfig[string](
+		workqueue.DefaultTypedControllerRateLimiter[string](),
+		workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-snapshot-schedule"},
+	)
+
+	_, err := ctrl.VMSnapshotScheduleInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleVMSnapshotSchedule,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleVMSnapshotSchedule(newObj) },
+			DeleteFunc: ctrl.handleVMSnapshotSchedule,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctrl.VMSnapshotInformer.AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    ctrl.handleScheduledVMSnapshot,
+			UpdateFunc: func(oldObj, newObj interface{}) { ctrl.handleScheduledVMSnapshot(newObj) },
+			DeleteFunc: ctrl.handleScheduledVMSnapshot,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run the controller
+func (ctrl *VMSnapshotScheduleController) Run(threadiness int, stopCh <-chan struct{}) error {
+	defer utilruntime.HandleCrash()
+	defer ctrl.scheduleQueue.ShutDown()
+
+	log.Log.Info("Starting snapshot schedule controller.")
+	defer log.Log.Info("Shutting down snapshot schedule controller.")
+
+	if !cache.WaitForCacheSync(
+		stopCh,
+		ctrl.VMSnapshotScheduleInformer.HasSynced,
+		ctrl.VMSnapshotInformer.HasSynced,
+		ctrl.VMInformer.HasSynced,
+	) {
+		return fmt.Errorf("failed to wait for caches to sync")
+	}
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(ctrl.vmSnapshotScheduleWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+
+	return nil
+}
+
+func (ctrl *VMSnapshotScheduleController) vmSnapshotScheduleWorker() {
+	for ctrl.processVMSnapshotScheduleWorkItem() {
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) processVMSnapshotScheduleWorkItem() bool {
+	return watchutil.ProcessWorkItem(ctrl.scheduleQueue, func(key string) (time.Duration, error) {
+		log.Log.V(3).Infof("vmSnapshotSchedule worker processing key [%s]", key)
+
+		storeObj, exists, err := ctrl.VMSnapshotScheduleInformer.GetStore().GetByKey(key)
+		if err != nil {
+			return 0, err
+		}
+		if !exists {
+			if namespace, name, err := cache.SplitMetaNamespaceKey(key); err == nil {
+				metrics.DeleteVMSnapshotScheduleOverdue(name, namespace)
+			}
+			return 0, nil
+		}
+
+		schedule, ok := storeObj.(*snapshotv1.VirtualMachineSnapshotSchedule)
+		if !ok {
+			return 0, fmt.Errorf("unexpected resource %+v", storeObj)
+		}
+		if schedule.DeletionTimestamp != nil {
+			return 0, nil
+		}
+
+		return ctrl.updateVMSnapshotSchedule(schedule.DeepCopy())
+	})
+}
+
+func (ctrl *VMSnapshotScheduleController) handleVMSnapshotSchedule(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if schedule, ok := obj.(*snapshotv1.VirtualMachineSnapshotSchedule); ok {
+		objName, err := cache.DeletionHandlingMetaNamespaceKeyFunc(schedule)
+		if err != nil {
+			log.Log.Errorf("failed to get key from object: %v, %v", err, schedule)
+			return
+		}
+
+		log.Log.V(3).Infof("enqueued %q for sync", objName)
+		ctrl.scheduleQueue.Add(objName)
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) handleScheduledVMSnapshot(obj interface{}) {
+	if unknown, ok := obj.(cache.DeletedFinalStateUnknown); ok && unknown.Obj != nil {
+		obj = unknown.Obj
+	}
+
+	if vmSnapshot, ok := obj.(*snapshotv1.VirtualMachineSnapshot); ok {
+		if scheduleName, ok := vmSnapshot.Labels[snapshotv1.SnapshotScheduleLabel]; ok {
+			ctrl.scheduleQueue.Add(cacheKeyFunc(vmSnapshot.Namespace, scheduleName))
+		}
+	}
+}
+
+func (ctrl *VMSnapshotScheduleController) updateVMSnapshotSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule) (time.Duration, error) {
+	status, retry, syncErr := ctrl.sync(schedule)
+	if !equality.Semantic.DeepEqual(schedule.Status, status) {
+		scheduleCopy := schedule.DeepCopy()
+		scheduleCopy.Status = status
+		_, err := ctrl.Client.VirtualMachineSnapshotSchedule(scheduleCopy.Namespace).UpdateStatus(context.Background(), scheduleCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return retry, syncErr
+}
+
+// sync snapshots the selected VMs for the most recent missed schedule slot,
+// records the outcome of the completed runs and prunes the snapshots past
+// their retention. VMs with a snapshot of the schedule still in progress are
+// skipped. It returns the time until the schedule needs to be synced again.
+func (ctrl *VMSnapshotScheduleController) sync(schedule *snapshotv1.VirtualMachineSnapshotSchedule) (*snapshotv1.VirtualMachineSnapshotScheduleStatus, time.Duration, error) {
+	status := &snapshotv1.VirtualMachineSnapshotScheduleStatus{}
+	if schedule.Status != nil {
+		status = schedule.Status.DeepCopy()
+	}
+
+	cronSchedule, err := cronschedule.Parse(schedule.Spec.Schedule)
+	if err != nil {
+		ctrl.invalidSchedule(schedule, status, fmt.Sprintf(invalidSnapshotScheduleMsg, schedule.Spec.Schedule, err))
+		return status, 0, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&schedule.Spec.Selector)
+	if err != nil {
+		ctrl.invalidSchedule(schedule, status, fmt.Sprintf(invalidSnapshotSelectorMsg, err))
+		return status, 0, nil
+	}
+
+	vmSnapshots, err := ctrl.scheduledVMSnapshots(schedule)
+	if err != nil {
+		return status, 0, err
+	}
+
+	now := currentTime().Time
+	if !schedule.Spec.Suspend {
+		lastSchedule := schedule.CreationTimestamp.Time
+		if status.LastScheduleTime != nil {
+			lastSchedule = status.LastScheduleTime.Time
+		}
+		if slot, due := cronschedule.MostRecentSlot(cronSchedule, lastSchedule, now); due {
+			created, selected, err := ctrl.snapshotVMs(schedule, selector, vmSnapshots, slot)
+			if err != nil {
+				status.Conditions = updateCondition(status.Conditions, newFailureCondition(corev1.ConditionTrue, err.Error()))
+				return status, 0, err
+			}
+			status.LastScheduleTime = pointer.P(metav1.NewTime(slot))
+			if selected == 0 {
+				// nothing to snapshot, the run trivially succeeded
+				status.LastSuccessTime = laterTime(status.LastSuccessTime, slot)
+			}
+			vmSnapshots = append(vmSnapshots, created...)
+		}
+	}
+
+	updateRunResults(status, vmSnapshots)
+
+	if err := ctrl.pruneVMSnapshots(schedule, vmSnapshots, now); err != nil {
+		return status, 0, err
+	}
+
+	if status.LastFailureTime != nil && (status.LastSuccessTime == nil || status.LastFailureTime.After(status.LastSuccessTime.Time)) {
+		reason := fmt.Sprintf(snapshotScheduleRunFailedMsg, status.LastFailureTime.UTC().Format(time.RFC3339))
+		status.Conditions = updateCondition(status.Conditions, newFailureCondition(corev1.ConditionTrue, reason))
+	} else {
+		status.Conditions = updateCondition(status.Conditions, newFailureCondition(corev1.ConditionFalse, ""))
+	}
+
+	if schedule.Spec.Suspend {
+		status.NextScheduleTime = nil
+		metrics.SetVMSnapshotScheduleOverdue(schedule, false)
+		return status, 0, nil
+	}
+
+	next := cronSchedule.Next(now)
+	status.NextScheduleTime = pointer.P(metav1.NewTime(next))
+	retry := next.Sub(now)
+
+	overdue, overdueAt := scheduleOverdue(schedule, status, cronSchedule, now)
+	metrics.SetVMSnapshotScheduleOverdue(schedule, overdue)
+	if !overdue && !overdueAt.IsZero() && overdueAt.Sub(now) < retry {
+		retry = overdueAt.Sub(now)
+	}
+
+	return status, retry, nil
+}
+
+func (ctrl *VMSnapshotScheduleController) invalidSchedule(schedule *snapshotv1.VirtualMachineSnapshotSchedule, status *snapshotv1.VirtualMachineSnapshotScheduleStatus, reason string) {
+	ctrl.Recorder.Event(schedule, corev1.EventTypeWarning, vmSnapshotScheduleFailedEvent, reason)
+	status.NextScheduleTime = nil
+	status.Conditions = updateCondition(status.Conditions, newFailureCondition(corev1.ConditionTrue, reason))
+	metrics.SetVMSnapshotScheduleOverdue(schedule, false)
+}
+
+// scheduleOverdue reports whether a slot passed more than the failure deadline
+// ago without a successful run since. When the schedule is not overdue it also
+// returns the time it becomes overdue at, if the next run does not succeed.
+func scheduleOverdue(schedule *snapshotv1.VirtualMachineSnapshotSchedule, status *snapshotv1.VirtualMachineSnapshotScheduleStatus, cronSchedule cron.Schedule, now time.Time) (bool, time.Time) {
+	deadline := snapshotv1.DefaultFailureDeadline
+	if schedule.Spec.FailureDeadline != nil {
+		deadline = schedule.Spec.FailureDeadline.Duration
+	}
+	// no deadline set by user
+	if deadline == 0 {
+		return false, time.Time{}
+	}
+
+	lastSuccess := schedule.CreationTimestamp.Time
+	if status.LastSuccessTime != nil && status.LastSuccessTime.After(lastSuccess) {
+		lastSuccess = status.LastSuccessTime.Time
+	}
+	slot := cronSchedule.Next(lastSuccess)
+	if slot.IsZero() {
+		return false, time.Time{}
+	}
+	overdueAt := slot.Add(deadline)
+	return !overdueAt.After(now), overdueAt
+}
+
+// scheduledVMSnapshots returns the snapshots created by the schedule, oldest first
+func (ctrl *VMSnapshotScheduleController) scheduledVMSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule) ([]*snapshotv1.VirtualMachineSnapshot, error) {
+	objs, err := ctrl.VMSnapshotInformer.GetIndexer().ByIndex(snapshotScheduleIndex, cacheKeyFunc(schedule.Namespace, schedule.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	vmSnapshots := make([]*snapshotv1.VirtualMachineSnapshot, 0, len(objs))
+	for _, obj := range objs {
+		vmSnapshots = append(vmSnapshots, obj.(*snapshotv1.VirtualMachineSnapshot))
+	}
+	sort.Slice(vmSnapshots, func(i, j int) bool {
+		ti, tj := vmSnapshots[i].CreationTimestamp, vmSnapshots[j].CreationTimestamp
+		if ti.Equal(&tj) {
+			return vmSnapshots[i].Name < vmSnapshots[j].Name
+		}
+		return ti.Before(&tj)
+	})
+	return vmSnapshots, nil
+}
+
+func scheduledVMSnapshotName(scheduleName, vmName string, slot time.Time) string {
+	// slots are at most one per minute, like the jobs of a CronJob
+	return naming.GetName(scheduleName+"-"+vmName, strconv.FormatInt(slot.Unix()/60, 10), validation.DNS1035LabelMaxLength)
+}
+
+func scheduleTime(vmSnapshot *snapshotv1.VirtualMachineSnapshot) (time.Time, bool) {
+	t, err := time.Parse(time.RFC3339, vmSnapshot.Annotations[SnapshotScheduleTimeAnnotation])
+	return t, err == nil
+}
+
+func laterTime(current *metav1.Time, t time.Time) *metav1.Time {
+	if current != nil && !current.Time.Before(t) {
+		return current
+	}
+	return pointer.P(metav1.NewTime(t))
+}
+
+// snapshotVMs creates the snapshots of the slot for the VMs matching the selector,
+// it returns the created snapshots and the number of matching VMs
+func (ctrl *VMSnapshotScheduleController) snapshotVMs(schedule *snapshotv1.VirtualMachineSnapshotSchedule, selector labels.Selector, vmSnapshots []*snapshotv1.VirtualMachineSnapshot, slot time.Time) ([]*snapshotv1.VirtualMachineSnapshot, int, error) {
+	var vms []*kubevirtv1.VirtualMachine
+	err := cache.ListAllByNamespace(ctrl.VMInformer.GetIndexer(), schedule.Namespace, selector, func(obj interface{}) {
+		vms = append(vms, obj.(*kubevirtv1.VirtualMachine))
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	sort.Slice(vms, func(i, j int) bool { return vms[i].Name < vms[j].Name })
+
+	var created []*snapshotv1.VirtualMachineSnapshot
+	for _, vm := range vms {
+		if vm.DeletionTimestamp != nil {
+			continue
+		}
+		name := scheduledVMSnapshotName(schedule.Name, vm.Name, slot)
+		if inProgress := vmSnapshotInProgress(vmSnapshots, vm.Name); inProgress != nil {
+			if inProgress.Name != name {
+				ctrl.Recorder.Eventf(schedule, corev1.EventTypeNormal, vmSnapshotScheduleSkippedEvent, vmSnapshotScheduleSkippedMsg,
+					vm.Name, slot.UTC().Format(time.RFC3339), inProgress.Name)
+			}
+			continue
+		}
+
+		vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: schedule.Namespace,
+				Labels: map[string]string{
+					snapshotv1.SnapshotScheduleLabel: schedule.Name,
+				},
+				Annotations: map[string]string{
+					SnapshotScheduleTimeAnnotation: slot.UTC().Format(time.RFC3339),
+				},
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotSpec{
+				Source: corev1.TypedLocalObjectReference{
+					APIGroup: pointer.P(kubevirtv1.GroupVersion.Group),
+					Kind:     kubevirtv1.VirtualMachineGroupVersionKind.Kind,
+					Name:     vm.Name,
+				},
+				DeletionPolicy:  pointer.P(snapshotv1.VirtualMachineSnapshotContentDelete),
+				FailureDeadline: schedule.Spec.FailureDeadline,
+			},
+		}
+
+		vmSnapshot, err = ctrl.Client.VirtualMachineSnapshot(schedule.Namespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			// created by an earlier sync which failed to record it
+			continue
+		}
+		if err != nil {
+			ctrl.Recorder.Eventf(schedule, corev1.EventTypeWarning, vmSnapshotScheduleFailedEvent, failedScheduledVMSnapshotMsg, vm.Name, err)
+			return created, len(vms), fmt.Errorf(failedScheduledVMSnapshotMsg, vm.Name, err)
+		}
+		ctrl.Recorder.Eventf(schedule, corev1.EventTypeNormal, vmSnapshotScheduledEvent, vmSnapshotScheduledMsg, vmSnapshot.Name, vm.Name)
+		created = append(created, vmSnapshot)
+	}
+	return created, len(vms), nil
+}
+
+func vmSnapshotInProgress(vmSnapshots []*snapshotv1.VirtualMachineSnapshot, vmName string) *snapshotv1.VirtualMachineSnapshot {
+	for _, vmSnapshot := range vmSnapshots {
+		if vmSnapshot.Spec.Source.Name == vmName && vmSnapshotProgressing(vmSnapshot) && !vmSnapshotDeleting(vmSnapshot) {
+			return vmSnapshot
+		}
+	}
+	return nil
+}
+
+// updateRunResults records the schedule time of the most recent succeeded and
+// failed runs. A run is complete once none of its snapshots is in progress.
+func updateRunResults(status *snapshotv1.VirtualMachineSnapshotScheduleStatus, vmSnapshots []*snapshotv1.VirtualMachineSnapshot) {
+	type runResult struct {
+		completed bool
+		failed    bool
+	}
+	runs := map[time.Time]*runResult{}
+	for _, vmSnapshot := range vmSnapshots {
+		t, ok := scheduleTime(vmSnapshot)
+		if !ok {
+			continue
+		}
+		run, ok := runs[t]
+		if !ok {
+			run = &runResult{completed: true}
+			runs[t] = run
+		}
+		if vmSnapshotProgressing(vmSnapshot) {
+			run.completed = false
+		}
+		if vmSnapshotFailed(vmSnapshot) {
+			run.failed = true
+		}
+	}
+
+	for t, run := range runs {
+		switch {
+		case !run.completed:
+		case run.failed:
+			status.LastFailureTime = laterTime(status.LastFailureTime, t)
+		default:
+			status.LastSuccessTime = laterTime(status.LastSuccessTime, t)
+		}
+	}
+}
+
+// vmSnapshotsToPrune returns the completed snapshots of a single VM the
+// retention does not keep. vmSnapshots is ordered oldest first.
+func vmSnapshotsToPrune(vmSnapshots []*snapshotv1.VirtualMachineSnapshot, retention *snapshotv1.SnapshotRetention, now time.Time) []*snapshotv1.VirtualMachineSnapshot {
+	if retention == nil {
+		return nil
+	}
+
+	var prune []*snapshotv1.VirtualMachineSnapshot
+	succeeded := int32(0)
+	for i := len(vmSnapshots) - 1; i >= 0; i-- {
+		vmSnapshot := vmSnapshots[i]
+		if vmSnapshotProgressing(vmSnapshot) || vmSnapshotDeleting(vmSnapshot) {
+			continue
+		}
+
+		expired := retention.MaxAge != nil && now.Sub(vmSnapshot.CreationTimestamp.Time) > retention.MaxAge.Duration
+		if vmSnapshotSucceeded(vmSnapshot) {
+			succeeded++
+			if expired || (retention.MaxCount != nil && succeeded > *retention.MaxCount) {
+				prune = append(prune, vmSnapshot)
+			}
+			continue
+		}
+		if expired || succeeded > 0 {
+			prune = append(prune, vmSnapshot)
+		}
+	}
+	return prune
+}
+
+// pruneVMSnapshots deletes the snapshots past their retention, their content
+// is deleted together with them
+func (ctrl *VMSnapshotScheduleController) pruneVMSnapshots(schedule *snapshotv1.VirtualMachineSnapshotSchedule, vmSnapshots []*snapshotv1.VirtualMachineSnapshot, now time.Time) error {
+	perVM := map[string][]*snapshotv1.VirtualMachineSnapshot{}
+	var vmNames []string
+	for _, vmSnapshot := range vmSnapshots {
+		vmName := vmSnapshot.Spec.Source.Name
+		if _, ok := perVM[vmName]; !ok {
+			vmNames = append(vmNames, vmName)
+		}
+		perVM[vmName] = append(perVM[vmName], vmSnapshot)
+	}
+
+	for _, vmName := range vmNames {
+		for _, vmSnapshot := range vmSnapshotsToPrune(perVM[vmName], schedule.Spec.Retention, now) {
+			err := ctrl.Client.VirtualMachineSnapshot(vmSnapshot.Namespace).Delete(context.Background(), vmSnapshot.Name, metav1.DeleteOptions{})
+			if err != nil && !k8serrors.IsNotFound(err) {
+				ctrl.Recorder.Eventf(schedule, corev1.EventTypeWarning, vmSnapshotScheduleFailedEvent, failedVMSnapshotPruneMsg, vmSnapshot.Name, err)
+				return err
+			}
+			log.Log.Object(schedule).Infof(vmSnapshotPrunedMsg, vmSnapshot.Name)
+			ctrl.Recorder.Eventf(schedule, corev1.EventTypeNormal, vmSnapshotPrunedEvent, vmSnapshotPrunedMsg, vmSnapshot.Name)
+		}
+	}
+	return nil
+}
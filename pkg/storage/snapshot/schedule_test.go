@@ -0,0 +1,439 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package snapshot
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot schedule controller", func() {
+	const scheduleName = "test-schedule"
+
+	var (
+		virtClient         *kubecli.MockKubevirtClient
+		kubevirtClient     *kubevirtfake.Clientset
+		scheduleInformer   cache.SharedIndexInformer
+		vmSnapshotInformer cache.SharedIndexInformer
+		vmInformer         cache.SharedIndexInformer
+		recorder           *record.FakeRecorder
+		controller         *VMSnapshotScheduleController
+
+		now             time.Time
+		slot            time.Time
+		origCurrentTime func() *metav1.Time
+	)
+
+	createSchedule := func() *snapshotv1.VirtualMachineSnapshotSchedule {
+		return &snapshotv1.VirtualMachineSnapshotSchedule{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              scheduleName,
+				Namespace:         testNamespace,
+				CreationTimestamp: metav1.NewTime(now.Add(-30 * time.Minute)),
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotScheduleSpec{
+				Schedule: "0 * * * *",
+				Selector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"snapshot": "hourly"},
+				},
+				FailureDeadline: &metav1.Duration{Duration: 30 * time.Minute},
+			},
+		}
+	}
+
+	createVM := func(name string, selected bool) *v1.VirtualMachine {
+		vm := createVirtualMachine(testNamespace, name)
+		if selected {
+			vm.Labels["snapshot"] = "hourly"
+		}
+		return vm
+	}
+
+	createScheduledSnapshot := func(vmName string, scheduled time.Time, phase snapshotv1.VirtualMachineSnapshotPhase) *snapshotv1.VirtualMachineSnapshot {
+		vmSnapshot := createVirtualMachineSnapshot(testNamespace, scheduledVMSnapshotName(scheduleName, vmName, scheduled), vmName)
+		vmSnapshot.CreationTimestamp = metav1.NewTime(scheduled)
+		vmSnapshot.Labels = map[string]string{snapshotv1.SnapshotScheduleLabel: scheduleName}
+		vmSnapshot.Annotations = map[string]string{SnapshotScheduleTimeAnnotation: scheduled.UTC().Format(time.RFC3339)}
+		vmSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{Phase: phase}
+		return vmSnapshot
+	}
+
+	addSchedule := func(schedule *snapshotv1.VirtualMachineSnapshotSchedule) {
+		Expect(scheduleInformer.GetStore().Add(schedule)).To(Succeed())
+		_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace).Create(context.Background(), schedule, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addVMs := func(vms ...*v1.VirtualMachine) {
+		for _, vm := range vms {
+			Expect(vmInformer.GetStore().Add(vm)).To(Succeed())
+		}
+	}
+
+	addVMSnapshots := func(vmSnapshots ...*snapshotv1.VirtualMachineSnapshot) {
+		for _, vmSnapshot := range vmSnapshots {
+			Expect(vmSnapshotInformer.GetStore().Add(vmSnapshot)).To(Succeed())
+			_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+	}
+
+	syncSchedule := func() time.Duration {
+		obj, exists, err := scheduleInformer.GetStore().GetByKey(cacheKeyFunc(testNamespace, scheduleName))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeTrue())
+		retry, err := controller.updateVMSnapshotSchedule(obj.(*snapshotv1.VirtualMachineSnapshotSchedule).DeepCopy())
+		Expect(err).ToNot(HaveOccurred())
+		return retry
+	}
+
+	getStatus := func() *snapshotv1.VirtualMachineSnapshotScheduleStatus {
+		schedule, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace).Get(context.Background(), scheduleName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return schedule.Status
+	}
+
+	listVMSnapshots := func() []snapshotv1.VirtualMachineSnapshot {
+		list, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return list.Items
+	}
+
+	expectFailureCondition := func(status *snapshotv1.VirtualMachineSnapshotScheduleStatus, conditionStatus corev1.ConditionStatus) {
+		ExpectWithOffset(1, status.Conditions).To(ContainElement(And(
+			HaveField("Type", snapshotv1.ConditionFailure),
+			HaveField("Status", conditionStatus),
+		)))
+	}
+
+	expectOverdue := func(expected float64) {
+		value, err := metrics.GetVMSnapshotScheduleOverdue(scheduleName, testNamespace)
+		ExpectWithOffset(1, err).ToNot(HaveOccurred())
+		ExpectWithOffset(1, value).To(Equal(expected))
+	}
+
+	BeforeEach(func() {
+		now = time.Date(2025, time.March, 12, 12, 15, 0, 0, time.UTC)
+		slot = time.Date(2025, time.March, 12, 12, 0, 0, 0, time.UTC)
+		origCurrentTime = currentTime
+		currentTime = func() *metav1.Time {
+			return pointer.P(metav1.NewTime(now))
+		}
+
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		kubevirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().VirtualMachineSnapshot(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineSnapshotSchedule(testNamespace).
+			Return(kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(testNamespace)).AnyTimes()
+
+		scheduleInformer, _ = testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmSnapshotInformer, _ = testutils.NewFakeInformerWithIndexersFor(&snapshotv1.VirtualMachineSnapshot{}, virtcontroller.GetVirtualMachineSnapshotInformerIndexers())
+		vmInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachine{}, virtcontroller.GetVirtualMachineInformerIndexers())
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		controller = &VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: scheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			Recorder:                   recorder,
+		}
+		Expect(controller.Init()).To(Succeed())
+	})
+
+	AfterEach(func() {
+		currentTime = origCurrentTime
+		metrics.DeleteVMSnapshotScheduleOverdue(scheduleName, testNamespace)
+	})
+
+	Context("scheduling", func() {
+		It("should snapshot the selected VMs for the most recent missed slot", func() {
+			addSchedule(createSchedule())
+			addVMs(createVM("vm-a", true), createVM("vm-b", true), createVM("vm-c", false))
+
+			retry := syncSchedule()
+			// the run becomes overdue before the next slot
+			Expect(retry).To(Equal(15 * time.Minute))
+
+			vmSnapshots := listVMSnapshots()
+			Expect(vmSnapshots).To(HaveLen(2))
+			for _, vmSnapshot := range vmSnapshots {
+				Expect(vmSnapshot.Name).To(Equal(scheduledVMSnapshotName(scheduleName, vmSnapshot.Spec.Source.Name, slot)))
+				Expect(vmSnapshot.Spec.Source.Name).To(BeElementOf("vm-a", "vm-b"))
+				Expect(vmSnapshot.Spec.Source.Kind).To(Equal("VirtualMachine"))
+				Expect(vmSnapshot.Labels).To(HaveKeyWithValue(snapshotv1.SnapshotScheduleLabel, scheduleName))
+				Expect(vmSnapshot.Annotations).To(HaveKeyWithValue(SnapshotScheduleTimeAnnotation, "2025-03-12T12:00:00Z"))
+				Expect(vmSnapshot.Spec.DeletionPolicy).To(HaveValue(Equal(snapshotv1.VirtualMachineSnapshotContentDelete)))
+				Expect(vmSnapshot.Spec.FailureDeadline.Duration).To(Equal(30 * time.Minute))
+			}
+			testutils.ExpectEvents(recorder, vmSnapshotScheduledEvent, vmSnapshotScheduledEvent)
+
+			status := getStatus()
+			Expect(status.LastScheduleTime.Time).To(BeTemporally("==", slot))
+			Expect(status.NextScheduleTime.Time).To(BeTemporally("==", slot.Add(time.Hour)))
+			Expect(status.LastSuccessTime).To(BeNil())
+			expectFailureCondition(status, corev1.ConditionFalse)
+			expectOverdue(0)
+		})
+
+		It("should skip a VM while its previous snapshot is in progress", func() {
+			previousSlot := slot.Add(-time.Hour)
+			schedule := createSchedule()
+			schedule.CreationTimestamp = metav1.NewTime(previousSlot.Add(-time.Minute))
+			schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+				LastScheduleTime: pointer.P(metav1.NewTime(previousSlot)),
+			}
+			addSchedule(schedule)
+			addVMs(createVM("vm-a", true), createVM("vm-b", true))
+			addVMSnapshots(
+				createScheduledSnapshot("vm-a", previousSlot, snapshotv1.InProgress),
+				createScheduledSnapshot("vm-b", previousSlot, snapshotv1.Succeeded),
+			)
+
+			syncSchedule()
+
+			Expect(listVMSnapshots()).To(HaveLen(3))
+			_, err := kubevirtClient.SnapshotV1beta1().VirtualMachineSnapshots(testNamespace).
+				Get(context.Background(), scheduledVMSnapshotName(scheduleName, "vm-b", slot), metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			testutils.ExpectEvents(recorder, vmSnapshotScheduleSkippedEvent, vmSnapshotScheduledEvent)
+		})
+
+		It("should not snapshot before the next slot", func() {
+			schedule := createSchedule()
+			schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+				LastScheduleTime: pointer.P(metav1.NewTime(slot)),
+			}
+			addSchedule(schedule)
+			addVMs(createVM("vm-a", true))
+
+			syncSchedule()
+
+			Expect(listVMSnapshots()).To(BeEmpty())
+		})
+
+		It("should consider a run without selected VMs successful", func() {
+			addSchedule(createSchedule())
+			addVMs(createVM("vm-a", false))
+
+			syncSchedule()
+
+			Expect(listVMSnapshots()).To(BeEmpty())
+			status := getStatus()
+			Expect(status.LastScheduleTime.Time).To(BeTemporally("==", slot))
+			Expect(status.LastSuccessTime.Time).To(BeTemporally("==", slot))
+		})
+
+		It("should not snapshot while suspended", func() {
+			schedule := createSchedule()
+			schedule.Spec.Suspend = true
+			addSchedule(schedule)
+			addVMs(createVM("vm-a", true))
+
+			Expect(syncSchedule()).To(BeZero())
+
+			Expect(listVMSnapshots()).To(BeEmpty())
+			status := getStatus()
+			Expect(status.LastScheduleTime).To(BeNil())
+			Expect(status.NextScheduleTime).To(BeNil())
+			expectOverdue(0)
+		})
+
+		It("should report an invalid schedule", func() {
+			schedule := createSchedule()
+			schedule.Spec.Schedule = "not a cron expression"
+			addSchedule(schedule)
+			addVMs(createVM("vm-a", true))
+
+			syncSchedule()
+
+			Expect(listVMSnapshots()).To(BeEmpty())
+			status := getStatus()
+			expectFailureCondition(status, corev1.ConditionTrue)
+			Expect(status.NextScheduleTime).To(BeNil())
+			testutils.ExpectEvent(recorder, vmSnapshotScheduleFailedEvent)
+		})
+	})
+
+	Context("run results", func() {
+		var schedule *snapshotv1.VirtualMachineSnapshotSchedule
+
+		BeforeEach(func() {
+			schedule = createSchedule()
+			schedule.Spec.FailureDeadline = nil
+			schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+				LastScheduleTime: pointer.P(metav1.NewTime(slot)),
+			}
+			addSchedule(schedule)
+		})
+
+		It("should record a run whose snapshots all succeeded", func() {
+			addVMSnapshots(
+				createScheduledSnapshot("vm-a", slot, snapshotv1.Succeeded),
+				createScheduledSnapshot("vm-b", slot, snapshotv1.Succeeded),
+			)
+
+			syncSchedule()
+
+			status := getStatus()
+			Expect(status.LastSuccessTime.Time).To(BeTemporally("==", slot))
+			Expect(status.LastFailureTime).To(BeNil())
+			expectFailureCondition(status, corev1.ConditionFalse)
+			expectOverdue(0)
+		})
+
+		It("should record a run with a failed snapshot and report the schedule overdue", func() {
+			addVMSnapshots(
+				createScheduledSnapshot("vm-a", slot, snapshotv1.Succeeded),
+				createScheduledSnapshot("vm-b", slot, snapshotv1.Failed),
+			)
+
+			syncSchedule()
+
+			status := getStatus()
+			Expect(status.LastSuccessTime).To(BeNil())
+			Expect(status.LastFailureTime.Time).To(BeTemporally("==", slot))
+			expectFailureCondition(status, corev1.ConditionTrue)
+			expectOverdue(1)
+		})
+
+		It("should not record a run while a snapshot is in progress", func() {
+			addVMSnapshots(
+				createScheduledSnapshot("vm-a", slot, snapshotv1.Failed),
+				createScheduledSnapshot("vm-b", slot, snapshotv1.InProgress),
+			)
+
+			syncSchedule()
+
+			status := getStatus()
+			Expect(status.LastSuccessTime).To(BeNil())
+			Expect(status.LastFailureTime).To(BeNil())
+			expectFailureCondition(status, corev1.ConditionFalse)
+		})
+
+		It("should remove the overdue metric of a deleted schedule", func() {
+			syncSchedule()
+			expectOverdue(1)
+			Expect(scheduleInformer.GetStore().Delete(schedule)).To(Succeed())
+
+			controller.scheduleQueue.Add(cacheKeyFunc(testNamespace, scheduleName))
+			Expect(controller.processVMSnapshotScheduleWorkItem()).To(BeTrue())
+
+			Expect(metrics.VmSnapshotScheduleOverdue.DeleteLabelValues(scheduleName, testNamespace)).To(BeFalse())
+		})
+	})
+
+	Context("retention", func() {
+		at := func(hours int) time.Time {
+			return time.Date(2025, time.March, 12, hours, 0, 0, 0, time.UTC)
+		}
+		snapshotsAt := func(phases map[int]snapshotv1.VirtualMachineSnapshotPhase, hours ...int) []*snapshotv1.VirtualMachineSnapshot {
+			var vmSnapshots []*snapshotv1.VirtualMachineSnapshot
+			for _, hour := range hours {
+				phase := snapshotv1.Succeeded
+				if p, ok := phases[hour]; ok {
+					phase = p
+				}
+				vmSnapshots = append(vmSnapshots, createScheduledSnapshot("vm-a", at(hour), phase))
+			}
+			return vmSnapshots
+		}
+		names := func(vmSnapshots []*snapshotv1.VirtualMachineSnapshot) []string {
+			var result []string
+			for _, vmSnapshot := range vmSnapshots {
+				result = append(result, vmSnapshot.Name)
+			}
+			return result
+		}
+		nameAt := func(hour int) string {
+			return scheduledVMSnapshotName(scheduleName, "vm-a", at(hour))
+		}
+
+		DescribeTable("should prune", func(retention *snapshotv1.SnapshotRetention, phases map[int]snapshotv1.VirtualMachineSnapshotPhase, expected []int) {
+			vmSnapshots := snapshotsAt(phases, 8, 9, 10, 11, 12)
+
+			var expectedNames []string
+			for _, hour := range expected {
+				expectedNames = append(expectedNames, nameAt(hour))
+			}
+			Expect(names(vmSnapshotsToPrune(vmSnapshots, retention, now))).To(ConsistOf(expectedNames))
+		},
+			Entry("nothing without a retention", nil, nil, nil),
+			Entry("the succeeded snapshots past maxCount",
+				&snapshotv1.SnapshotRetention{MaxCount: pointer.P(int32(2))}, nil, []int{8, 9, 10}),
+			Entry("the snapshots past maxAge",
+				&snapshotv1.SnapshotRetention{MaxAge: &metav1.Duration{Duration: 3 * time.Hour}}, nil, []int{8, 9}),
+			Entry("the failed snapshots older than a succeeded snapshot",
+				&snapshotv1.SnapshotRetention{MaxCount: pointer.P(int32(10))},
+				map[int]snapshotv1.VirtualMachineSnapshotPhase{9: snapshotv1.Failed, 12: snapshotv1.Failed}, []int{9}),
+			Entry("no snapshot in progress",
+				&snapshotv1.SnapshotRetention{MaxCount: pointer.P(int32(1)), MaxAge: &metav1.Duration{Duration: 2 * time.Hour}},
+				map[int]snapshotv1.VirtualMachineSnapshotPhase{8: snapshotv1.InProgress, 12: snapshotv1.InProgress}, []int{9, 10}),
+		)
+
+		It("should count the snapshots of each VM separately and delete the pruned ones", func() {
+			schedule := createSchedule()
+			schedule.Status = &snapshotv1.VirtualMachineSnapshotScheduleStatus{
+				LastScheduleTime: pointer.P(metav1.NewTime(slot)),
+			}
+			schedule.Spec.Retention = &snapshotv1.SnapshotRetention{MaxCount: pointer.P(int32(1))}
+			addSchedule(schedule)
+			addVMSnapshots(
+				createScheduledSnapshot("vm-a", at(11), snapshotv1.Succeeded),
+				createScheduledSnapshot("vm-b", at(11), snapshotv1.Succeeded),
+				createScheduledSnapshot("vm-a", at(12), snapshotv1.Succeeded),
+				createScheduledSnapshot("vm-b", at(12), snapshotv1.Succeeded),
+			)
+
+			syncSchedule()
+
+			var remaining []string
+			for _, vmSnapshot := range listVMSnapshots() {
+				remaining = append(remaining, vmSnapshot.Name)
+			}
+			Expect(remaining).To(ConsistOf(
+				scheduledVMSnapshotName(scheduleName, "vm-a", at(12)),
+				scheduledVMSnapshotName(scheduleName, "vm-b", at(12)),
+			))
+			testutils.ExpectEvents(recorder, vmSnapshotPrunedEvent, vmSnapshotPrunedEvent)
+		})
+	})
+})
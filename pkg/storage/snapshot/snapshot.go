@@ -43,6 +43,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/controller"
 	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	storageutils "kubevirt.io/kubevirt/pkg/storage/utils"
 )
 
@@ -72,11 +73,14 @@ const (
 
 // Indication messages
 var snapshotIndicationMessages = map[snapshotv1.Indication]string{
-	snapshotv1.VMSnapshotOnlineSnapshotIndication: "Snapshot taken while the VM was running. Consistency depends on guest-agent quiescing.",
-	snapshotv1.VMSnapshotGuestAgentIndication:     "Guest agent was active and attempted to quiesce the filesystem for application consistency.",
-	snapshotv1.VMSnapshotNoGuestAgentIndication:   "Guest agent was not available. Snapshot is crash-consistent and may not be application-consistent.",
-	snapshotv1.VMSnapshotQuiesceFailedIndication:  "Guest agent failed to quiesce the filesystem. Snapshot is crash-consistent and may not be application-consistent.",
-	snapshotv1.VMSnapshotPausedIndication:         "Snapshot taken while the VM was paused. Snapshot is crash-consistent and may not be application-consistent.",
+	snapshotv1.VMSnapshotOnlineSnapshotIndication:      "Snapshot taken while the VM was running. Consistency depends on guest-agent quiescing.",
+	snapshotv1.VMSnapshotGuestAgentIndication:          "Guest agent was active and attempted to quiesce the filesystem for application consistency.",
+	snapshotv1.VMSnapshotNoGuestAgentIndication:        "Guest agent was not available. Snapshot is crash-consistent and may not be application-consistent.",
+	snapshotv1.VMSnapshotQuiesceFailedIndication:       "Guest agent failed to quiesce the filesystem. Snapshot is crash-consistent and may not be application-consistent.",
+	snapshotv1.VMSnapshotPausedIndication:              "Snapshot taken while the VM was paused. Snapshot is crash-consistent and may not be application-consistent.",
+	snapshotv1.VMSnapshotMemoryIndication:              "Memory state of the VM was saved together with its volumes. The VM can be resumed from it on restore.",
+	snapshotv1.VMSnapshotPreFreezeHookFailedIndication: "A pre-freeze hook failed in the guest. The filesystem was not frozen and the snapshot may not be application-consistent.",
+	snapshotv1.VMSnapshotPostThawHookFailedIndication:  "A post-thaw hook failed in the guest after the filesystem was thawed. The application may need manual recovery.",
 }
 
 func VmSnapshotReady(vmSnapshot *snapshotv1.VirtualMachineSnapshot) bool {
@@ -353,6 +357,11 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 			log.Log.Warningf("Failed to unfreeze source for snapshot content %s/%s: %+v",
 				content.Namespace, content.Name, err)
 		}
+		err = ctrl.releaseMemoryState(vmSnapshot, content)
+		if err != nil {
+			log.Log.Warningf("Failed to release memory state of source for snapshot content %s/%s: %+v",
+				content.Namespace, content.Name, err)
+		}
 		content, err = ctrl.removeContentFinalizer(content)
 		if err != nil {
 			return 0, err
@@ -375,6 +384,23 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 
 	contentCreated := vmSnapshotContentCreated(content)
 
+	if !contentCreated && vmSnapshot != nil && !vmSnapshotDeleting(vmSnapshot) {
+		saved, err := ctrl.saveMemoryState(vmSnapshot, contentCpy)
+		if err != nil {
+			if shouldUpdateError(contentCpy, err.Error()) {
+				contentCpy.Status.Error = &snapshotv1.Error{
+					Time:    currentTime(),
+					Message: pointer.P(err.Error()),
+				}
+			}
+			contentCpy.Status.ReadyToUse = pointer.P(false)
+			return memoryStateSaveRetryInterval, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
+		}
+		if !saved {
+			return memoryStateSaveRetryInterval, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
+		}
+	}
+
 	for _, volumeBackup := range content.Spec.VolumeBackups {
 		if volumeBackup.VolumeSnapshotName == nil {
 			continue
@@ -408,7 +434,17 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 				continue
 			}
 
-			if !didFreeze {
+			// the snapshot group freezes all of its VMs together
+			// and releases the content once they are frozen
+			if snapshotGroupMember(content) && !snapshotGroupFrozen(content) {
+				log.Log.V(3).Infof("Content %s/%s waiting for its snapshot group to freeze", content.Namespace, content.Name)
+				contentCpy.Status.ReadyToUse = pointer.P(false)
+				return 0, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
+			}
+
+			// the source stays paused since its memory state was saved,
+			// no need to freeze it
+			if !didFreeze && content.Spec.MemoryState == nil && !snapshotGroupMember(content) && !preFreezeHookFailed(contentCpy) {
 				source, err := ctrl.getSnapshotSource(vmSnapshot)
 				if err != nil {
 					return 0, err
@@ -418,7 +454,13 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 					return 0, fmt.Errorf("unable to get snapshot source")
 				}
 
-				if err := source.Freeze(); err != nil {
+				err = source.Freeze()
+				switch {
+				case err != nil && strings.Contains(err.Error(), storagetypes.PreFreezeHookFailedMsg):
+					// like backups, the snapshot proceeds without freezing the guest
+					log.Log.Object(content).Reason(err).Warning("Snapshotting without freezing the guest filesystem")
+					addFreezeHookError(contentCpy, err)
+				case err != nil:
 					contentCpy.Status.Error = &snapshotv1.Error{
 						Time:    currentTime(),
 						Message: pointer.P(err.Error()),
@@ -426,14 +468,13 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 					contentCpy.Status.ReadyToUse = pointer.P(false)
 					// Retry again in 5 seconds
 					return 5 * time.Second, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
+				default:
+					// assuming that VM is frozen once Freeze() returns
+					// which should be the case
+					// if Freeze() were async, we'd have to return
+					// and only continue when source.Frozen() == true
+					didFreeze = true
 				}
-
-				// assuming that VM is frozen once Freeze() returns
-				// which should be the case
-				// if Freeze() were async, we'd have to return
-				// and only continue when source.Frozen() == true
-
-				didFreeze = true
 			}
 
 			volumeSnapshot, err = ctrl.createVolumeSnapshot(content, volumeBackup)
@@ -482,7 +523,19 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 	if created && contentCpy.Status.CreationTime == nil {
 		contentCpy.Status.CreationTime = currentTime()
 
-		err = ctrl.unfreezeSource(vmSnapshot)
+		// the snapshot group thaws its VMs once all of their volumes are snapshotted
+		if !snapshotGroupMember(content) {
+			err = ctrl.unfreezeSource(vmSnapshot)
+			if err != nil {
+				if !strings.Contains(err.Error(), storagetypes.PostThawHookFailedMsg) {
+					return 0, err
+				}
+				// the guest is thawed, only its post-thaw hooks failed
+				addFreezeHookError(contentCpy, err)
+			}
+		}
+
+		err = ctrl.releaseMemoryState(vmSnapshot, contentCpy)
 		if err != nil {
 			return 0, err
 		}
@@ -505,6 +558,28 @@ func (ctrl *VMSnapshotController) updateVMSnapshotContent(content *snapshotv1.Vi
 	return 0, ctrl.updateVmSnapshotContentStatus(content, contentCpy)
 }
 
+func addFreezeHookError(contentCpy *snapshotv1.VirtualMachineSnapshotContent, err error) {
+	for _, hookErr := range contentCpy.Status.FreezeHookErrors {
+		if hookErr.Message != nil && *hookErr.Message == err.Error() {
+			return
+		}
+	}
+	contentCpy.Status.FreezeHookErrors = append(contentCpy.Status.FreezeHookErrors, snapshotv1.Error{
+		Time:    currentTime(),
+		Message: pointer.P(err.Error()),
+	})
+}
+
+// preFreezeHookFailed reports whether the guest was left unfrozen because a pre-freeze hook failed
+func preFreezeHookFailed(contentCpy *snapshotv1.VirtualMachineSnapshotContent) bool {
+	for _, hookErr := range contentCpy.Status.FreezeHookErrors {
+		if hookErr.Message != nil && strings.Contains(*hookErr.Message, storagetypes.PreFreezeHookFailedMsg) {
+			return true
+		}
+	}
+	return false
+}
+
 func shouldUpdateError(contentCpy *snapshotv1.VirtualMachineSnapshotContent, errorMessage string) bool {
 	return contentCpy.Status.Error == nil || contentCpy.Status.Error.Message == nil || *contentCpy.Status.Error.Message != errorMessage
 }
@@ -647,6 +722,14 @@ func (ctrl *VMSnapshotController) createContent(vmSnapshot *snapshotv1.VirtualMa
 	if err != nil {
 		return err
 	}
+
+	var memoryState *snapshotv1.MemoryStateBackup
+	if includeMemory(vmSnapshot) && source.Online() {
+		memoryState = &snapshotv1.MemoryStateBackup{
+			PersistentVolumeClaimName: memoryStatePVCName(vmSnapshot),
+		}
+	}
+
 	content := &snapshotv1.VirtualMachineSnapshotContent{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:       GetVMSnapshotContentName(vmSnapshot),
@@ -657,8 +740,17 @@ func (ctrl *VMSnapshotController) createContent(vmSnapshot *snapshotv1.VirtualMa
 			VirtualMachineSnapshotName: &vmSnapshot.Name,
 			Source:                     sourceSpec,
 			VolumeBackups:              volumeBackups,
+			MemoryState:                memoryState,
 		},
 	}
+	for _, label := range []string{snapshotv1.SnapshotScheduleLabel, snapshotv1.SnapshotGroupLabel} {
+		if value, ok := vmSnapshot.Labels[label]; ok {
+			if content.Labels == nil {
+				content.Labels = map[string]string{}
+			}
+			content.Labels[label] = value
+		}
+	}
 
 	_, err = ctrl.Client.VirtualMachineSnapshotContent(content.Namespace).Create(context.Background(), content, metav1.CreateOptions{})
 	if err != nil && !k8serrors.IsAlreadyExists(err) {
@@ -824,6 +916,8 @@ func (ctrl *VMSnapshotController) updateSnapshotStatus(vmSnapshot *snapshotv1.Vi
 		}
 	}
 
+	updateSnapshotFreezeHookIndications(vmSnapshotCpy, content)
+
 	if VmSnapshotReady(vmSnapshotCpy) {
 		updateSnapshotCondition(vmSnapshotCpy, newReadyCondition(corev1.ConditionTrue, "Ready"))
 	} else {
@@ -859,7 +953,9 @@ func updateSnapshotSourceIndications(snapshot *snapshotv1.VirtualMachineSnapshot
 		indications := sets.New(snapshot.Status.Indications...)
 		indications = sets.Insert(indications, snapshotv1.VMSnapshotOnlineSnapshotIndication)
 
-		if source.Paused() {
+		if includeMemory(snapshot) {
+			indications = sets.Insert(indications, snapshotv1.VMSnapshotMemoryIndication)
+		} else if source.Paused() {
 			indications = sets.Insert(indications, snapshotv1.VMSnapshotPausedIndication)
 		} else if source.GuestAgent() {
 			indications = sets.Insert(indications, snapshotv1.VMSnapshotGuestAgentIndication)
@@ -893,6 +989,50 @@ func updateSnapshotSourceIndications(snapshot *snapshotv1.VirtualMachineSnapshot
 	}
 }
 
+// updateSnapshotFreezeHookIndications reports the guest freeze hooks
+// which failed while the content was being created
+func updateSnapshotFreezeHookIndications(snapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent) {
+	if content == nil || content.Status == nil {
+		return
+	}
+
+	hookMessages := map[snapshotv1.Indication][]string{}
+	for _, hookErr := range content.Status.FreezeHookErrors {
+		if hookErr.Message == nil {
+			continue
+		}
+		switch {
+		case strings.Contains(*hookErr.Message, storagetypes.PreFreezeHookFailedMsg):
+			hookMessages[snapshotv1.VMSnapshotPreFreezeHookFailedIndication] = append(hookMessages[snapshotv1.VMSnapshotPreFreezeHookFailedIndication], *hookErr.Message)
+		case strings.Contains(*hookErr.Message, storagetypes.PostThawHookFailedMsg):
+			hookMessages[snapshotv1.VMSnapshotPostThawHookFailedIndication] = append(hookMessages[snapshotv1.VMSnapshotPostThawHookFailedIndication], *hookErr.Message)
+		}
+	}
+	if len(hookMessages) == 0 {
+		return
+	}
+
+	indications := sets.New(snapshot.Status.Indications...)
+	for indication := range hookMessages {
+		indications.Insert(indication)
+	}
+	indicationsList := sets.List(indications)
+	snapshot.Status.Indications = indicationsList
+
+	var sourceIndications []snapshotv1.SourceIndication
+	for _, indication := range indicationsList {
+		message := IndicationMessage(indication)
+		if hookErrs, ok := hookMessages[indication]; ok {
+			message = fmt.Sprintf("%s %s", message, strings.Join(hookErrs, "; "))
+		}
+		sourceIndications = append(sourceIndications, snapshotv1.SourceIndication{
+			Indication: indication,
+			Message:    message,
+		})
+	}
+	snapshot.Status.SourceIndications = sourceIndications
+}
+
 func (ctrl *VMSnapshotController) updateSnapshotSnapshotableVolumes(snapshot *snapshotv1.VirtualMachineSnapshot, content *snapshotv1.VirtualMachineSnapshotContent) error {
 	if content == nil {
 		return nil
@@ -62,6 +62,7 @@ import (
 	virtcontroller "kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/instancetype/revision"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util"
 )
@@ -1035,6 +1036,82 @@ var _ = Describe("Snapshot controlleer", func() {
 				Expect(*createCalls).To(Equal(1))
 			})
 
+			It("create VirtualMachineSnapshotContent online snapshot including memory", func() {
+				vmSnapshot := createVMSnapshotInProgress()
+				vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+				vm := createLockedVM()
+				vm.Spec.RunStrategy = pointer.P(v1.RunStrategyAlways)
+				vm.Spec.Template.Spec.Domain.Resources.Requests = corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("32Mi"),
+				}
+
+				vmRevision := createVMRevision(vm)
+				crSource.Add(vmRevision)
+				vmi := createVMI(vm)
+				vmi.Status.VirtualMachineRevisionName = vmRevisionName
+				vmiSource.Add(vmi)
+
+				vm.ObjectMeta.Annotations = map[string]string{}
+				vm.Spec.Template.Spec.Volumes = append(vm.Spec.Template.Spec.Volumes, v1.Volume{
+					Name: "disk2",
+					VolumeSource: v1.VolumeSource{
+						PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{PersistentVolumeClaimVolumeSource: corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "test-pvc",
+						}},
+					},
+				})
+				// the content source will have the a combination of the vm revision, the vmi and the vm volumes
+				vm.ObjectMeta.Generation = 2
+				pvcs := createPersistentVolumeClaims()
+
+				expectedContent := createVirtualMachineSnapshotContent(vmSnapshot, vm, pvcs)
+				expectedContent.Spec.MemoryState = &snapshotv1.MemoryStateBackup{
+					PersistentVolumeClaimName: "vmsnapshot-" + string(vmSnapshot.UID) + "-memory",
+				}
+				vm.Spec.Template.Spec.Domain.Resources.Requests = corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("64Mi"),
+				}
+				vmSource.Add(vm)
+				storageClass := createStorageClass()
+				storageClassSource.Add(storageClass)
+				volumeSnapshotClass := createVolumeSnapshotClasses()[0]
+				createCalls := expectVMSnapshotContentCreate(vmSnapshotClient, expectedContent)
+				vmSnapshotSource.Add(vmSnapshot)
+				addVolumeSnapshotClass(volumeSnapshotClass)
+
+				updatedSnapshot := vmSnapshot.DeepCopy()
+				updatedSnapshot.ResourceVersion = "1"
+				updatedSnapshot.Status = &snapshotv1.VirtualMachineSnapshotStatus{
+					SourceUID:  &vmUID,
+					ReadyToUse: pointer.P(false),
+					Phase:      snapshotv1.InProgress,
+					Conditions: []snapshotv1.Condition{
+						newProgressingCondition(corev1.ConditionTrue, "Source locked and operation in progress"),
+						newReadyCondition(corev1.ConditionFalse, "Not ready"),
+					},
+				}
+				updatedSnapshot.Status.Indications = []snapshotv1.Indication{
+					snapshotv1.VMSnapshotMemoryIndication,
+					snapshotv1.VMSnapshotOnlineSnapshotIndication,
+				}
+				updatedSnapshot.Status.SourceIndications = []snapshotv1.SourceIndication{
+					{
+						Indication: snapshotv1.VMSnapshotMemoryIndication,
+						Message:    IndicationMessage(snapshotv1.VMSnapshotMemoryIndication),
+					},
+					{
+						Indication: snapshotv1.VMSnapshotOnlineSnapshotIndication,
+						Message:    IndicationMessage(snapshotv1.VMSnapshotOnlineSnapshotIndication),
+					},
+				}
+				updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
+
+				controller.processVMSnapshotWorkItem()
+				testutils.ExpectEvent(recorder, "SuccessfulVirtualMachineSnapshotContentCreate")
+				Expect(*updateStatusCalls).To(Equal(1))
+				Expect(*createCalls).To(Equal(1))
+			})
+
 			It("should create VirtualMachineSnapshotContent with memory dump", func() {
 				storageClass := createStorageClass()
 				volumeSnapshotClass := createVolumeSnapshotClasses()[0]
@@ -1438,6 +1515,114 @@ var _ = Describe("Snapshot controlleer", func() {
 				Expect(*snapshotCreates).To(Equal(1))
 			})
 
+			It("should record the source pause state before saving the memory state", func() {
+				vm := createLockedVM()
+				vmSnapshot := createVMSnapshotInProgress()
+				vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+				vmSnapshotContent := createVMSnapshotContent()
+				vmSnapshotContent.UID = contentUID
+				vmSnapshotContent.Spec.MemoryState = &snapshotv1.MemoryStateBackup{PersistentVolumeClaimName: "memory-state-pvc"}
+
+				updatedContent := vmSnapshotContent.DeepCopy()
+				updatedContent.ResourceVersion = "1"
+				updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					MemoryStateStatus: &snapshotv1.MemoryStateStatus{
+						SourcePaused: true,
+					},
+				}
+
+				vmSource.Add(vm)
+				vmiSource.Add(createVMI(vm))
+				updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+				vmSnapshotSource.Add(vmSnapshot)
+				addVirtualMachineSnapshotContent(vmSnapshotContent)
+				controller.processVMSnapshotContentWorkItem()
+				Expect(*updateStatusCalls).To(Equal(1))
+			})
+
+			It("should attach the memory state volume to the source vmi", func() {
+				vm := createLockedVM()
+				vmSnapshot := createVMSnapshotInProgress()
+				vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+				vmSnapshotContent := createVMSnapshotContent()
+				vmSnapshotContent.UID = contentUID
+				vmSnapshotContent.Spec.MemoryState = &snapshotv1.MemoryStateBackup{PersistentVolumeClaimName: "memory-state-pvc"}
+				vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					MemoryStateStatus: &snapshotv1.MemoryStateStatus{
+						SourcePaused: true,
+					},
+				}
+				memoryStatePVC := &corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "memory-state-pvc",
+						Namespace: testNamespace,
+					},
+				}
+
+				vmSource.Add(vm)
+				vmiSource.Add(createVMI(vm))
+				pvcSource.Add(memoryStatePVC)
+				vmiInterface.EXPECT().Patch(context.Background(), vm.Name, types.JSONPatchType, gomock.Any(), metav1.PatchOptions{}).
+					DoAndReturn(func(_ context.Context, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions, _ ...string) (*v1.VirtualMachineInstance, error) {
+						Expect(string(data)).To(ContainSubstring(`"op":"add","path":"/spec/utilityVolumes"`))
+						Expect(string(data)).To(ContainSubstring(`"type":"MemoryState"`))
+						return nil, nil
+					}).Times(1)
+				vmSnapshotSource.Add(vmSnapshot)
+				addVirtualMachineSnapshotContent(vmSnapshotContent)
+				controller.processVMSnapshotContentWorkItem()
+			})
+
+			It("should fail the snapshot content if saving the memory state failed", func() {
+				vm := createLockedVM()
+				vmSnapshot := createVMSnapshotInProgress()
+				vmSnapshot.Spec.IncludeMemory = pointer.P(true)
+				vmSnapshotContent := createVMSnapshotContent()
+				vmSnapshotContent.UID = contentUID
+				vmSnapshotContent.Spec.MemoryState = &snapshotv1.MemoryStateBackup{PersistentVolumeClaimName: "memory-state-pvc"}
+				vmSnapshotContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					MemoryStateStatus: &snapshotv1.MemoryStateStatus{
+						SourcePaused: true,
+					},
+				}
+				memoryStatePVC := &corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "memory-state-pvc",
+						Namespace: testNamespace,
+					},
+				}
+				vmi := createVMI(vm)
+				vmi.Status.VolumeStatus = []v1.VolumeStatus{
+					{
+						Name: "memory-state-pvc",
+						MemoryDumpVolume: &v1.DomainMemoryDumpInfo{
+							ClaimName: "memory-state-pvc",
+						},
+						Phase:   v1.MemoryDumpVolumeFailed,
+						Message: "no space left",
+					},
+				}
+
+				errorMessage := fmt.Sprintf("failed to save memory state of vmi %s: no space left", vm.Name)
+				updatedContent := vmSnapshotContent.DeepCopy()
+				updatedContent.ResourceVersion = "1"
+				updatedContent.Status.ReadyToUse = pointer.P(false)
+				updatedContent.Status.Error = &snapshotv1.Error{
+					Time:    timeFunc(),
+					Message: &errorMessage,
+				}
+
+				vmSource.Add(vm)
+				vmiSource.Add(vmi)
+				pvcSource.Add(memoryStatePVC)
+				updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+				vmSnapshotSource.Add(vmSnapshot)
+				addVirtualMachineSnapshotContent(vmSnapshotContent)
+				controller.processVMSnapshotContentWorkItem()
+				testutils.ExpectEvent(recorder, "MemoryStateSaveFailed")
+				Expect(*updateStatusCalls).To(Equal(1))
+			})
+
 			It("should create VolumeSnapshot with online snapshot no guest agent", func() {
 				vm := createLockedVM()
 				storageClass := createStorageClass()
@@ -1575,6 +1760,117 @@ var _ = Describe("Snapshot controlleer", func() {
 				Expect(*updateStatusCalls).To(Equal(1))
 			})
 
+			It("should record the failed pre-freeze hook and snapshot without freezing", func() {
+				storageClass := createStorageClass()
+				storageClassSource.Add(storageClass)
+
+				vmSnapshot := createVMSnapshotInProgress()
+				vmSnapshotSource.Add(vmSnapshot)
+
+				vmSnapshotContent := createVMSnapshotContent()
+				vmSnapshotContent.UID = contentUID
+				vmSnapshotContentSource.Add(vmSnapshotContent)
+
+				vm := createLockedVM()
+				vmSource.Add(vm)
+
+				vmi := createVMI(vm)
+				agentCondition := v1.VirtualMachineInstanceCondition{
+					Type:          v1.VirtualMachineInstanceAgentConnected,
+					LastProbeTime: metav1.Now(),
+					Status:        corev1.ConditionTrue,
+				}
+				vmi.Status.Conditions = append(vmi.Status.Conditions, agentCondition)
+				vmiSource.Add(vmi)
+
+				errorMessage := fmt.Sprintf("%s flush-db: command exited with 1", storagetypes.PreFreezeHookFailedMsg)
+				formatedErr := fmt.Sprintf("%s %s: %v", failedFreezeMsg, vm.Name, errorMessage)
+				updatedContent := vmSnapshotContent.DeepCopy()
+				updatedContent.ResourceVersion = "1"
+				updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					ReadyToUse: pointer.P(false),
+					FreezeHookErrors: []snapshotv1.Error{{
+						Time:    timeFunc(),
+						Message: &formatedErr,
+					}},
+				}
+				for _, volumeSnapshot := range createVolumeSnapshots(vmSnapshotContent) {
+					updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus, snapshotv1.VolumeSnapshotStatus{
+						VolumeSnapshotName: volumeSnapshot.Name,
+					})
+				}
+
+				volumeSnapshotClass := createVolumeSnapshotClasses()[0]
+				addVolumeSnapshotClass(volumeSnapshotClass)
+
+				vmiInterface.EXPECT().Freeze(context.Background(), vm.Name, 0*time.Second).Return(fmt.Errorf("%s", errorMessage)).Times(1)
+				snapshotCreates := expectVolumeSnapshotCreates(k8sSnapshotClient, volumeSnapshotClass.Name, vmSnapshotContent)
+				updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+
+				controller.processVMSnapshotContentWorkItem()
+				Expect(*updateStatusCalls).To(Equal(1))
+				Expect(*snapshotCreates).To(Equal(1))
+			})
+
+			It("should set PreFreezeHookFailed indication with the hook error", func() {
+				vm := createLockedVM()
+				vmSource.Add(vm)
+				vmi := createVMI(vm)
+				agentCondition := v1.VirtualMachineInstanceCondition{
+					Type:          v1.VirtualMachineInstanceAgentConnected,
+					LastProbeTime: metav1.Now(),
+					Status:        corev1.ConditionTrue,
+				}
+				vmi.Status.Conditions = append(vmi.Status.Conditions, agentCondition)
+				vmiSource.Add(vmi)
+
+				hookErr := fmt.Sprintf("%s %s: %s flush-db: command exited with 1", failedFreezeMsg, vm.Name, storagetypes.PreFreezeHookFailedMsg)
+				vmSnapshotContent := createErrorVMSnapshotContent(hookErr)
+				vmSnapshotContent.Status.FreezeHookErrors = []snapshotv1.Error{*vmSnapshotContent.Status.Error}
+				vmSnapshotContentSource.Add(vmSnapshotContent)
+
+				vmSnapshot := createVMSnapshotInProgress()
+				addVirtualMachineSnapshot(vmSnapshot)
+
+				updatedSnapshot := vmSnapshot.DeepCopy()
+				updatedSnapshot.Status.VirtualMachineSnapshotContentName = &vmSnapshotContent.Name
+				updatedSnapshot.Status.ReadyToUse = pointer.P(false)
+				updatedSnapshot.Status.Indications = []snapshotv1.Indication{
+					snapshotv1.VMSnapshotGuestAgentIndication,
+					snapshotv1.VMSnapshotOnlineSnapshotIndication,
+					snapshotv1.VMSnapshotPreFreezeHookFailedIndication,
+					snapshotv1.VMSnapshotQuiesceFailedIndication,
+				}
+				updatedSnapshot.Status.SourceIndications = []snapshotv1.SourceIndication{
+					{
+						Indication: snapshotv1.VMSnapshotGuestAgentIndication,
+						Message:    IndicationMessage(snapshotv1.VMSnapshotGuestAgentIndication),
+					},
+					{
+						Indication: snapshotv1.VMSnapshotOnlineSnapshotIndication,
+						Message:    IndicationMessage(snapshotv1.VMSnapshotOnlineSnapshotIndication),
+					},
+					{
+						Indication: snapshotv1.VMSnapshotPreFreezeHookFailedIndication,
+						Message:    IndicationMessage(snapshotv1.VMSnapshotPreFreezeHookFailedIndication) + " " + hookErr,
+					},
+					{
+						Indication: snapshotv1.VMSnapshotQuiesceFailedIndication,
+						Message:    IndicationMessage(snapshotv1.VMSnapshotQuiesceFailedIndication),
+					},
+				}
+				updatedSnapshot.Status.Conditions = []snapshotv1.Condition{
+					newProgressingCondition(corev1.ConditionFalse, "In error state"),
+					newReadyCondition(corev1.ConditionFalse, "Not ready"),
+				}
+				updatedSnapshot.Status.Error = vmSnapshotContent.Status.Error
+
+				updateStatusCalls := expectVMSnapshotUpdateStatus(vmSnapshotClient, updatedSnapshot)
+
+				controller.processVMSnapshotWorkItem()
+				Expect(*updateStatusCalls).To(Equal(1))
+			})
+
 			It("should not unset QuiesceFailed indication if freeze succeeded afterwards", func() {
 				vm := createLockedVM()
 				vmSource.Add(vm)
@@ -1665,6 +1961,76 @@ var _ = Describe("Snapshot controlleer", func() {
 				Expect(*snapshotCreates).To(Equal(1))
 			})
 
+			It("should wait for the snapshot group to freeze a group member", func() {
+				vmSnapshot := createVMSnapshotInProgress()
+				vmSnapshotContent := createVMSnapshotContent()
+				vmSnapshotContent.UID = contentUID
+				vmSnapshotContent.Labels = map[string]string{snapshotv1.SnapshotGroupLabel: "test-group"}
+				vm := createLockedVM()
+				vmSource.Add(vm)
+
+				vmi := createVMI(vm)
+				vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+					Type:   v1.VirtualMachineInstanceAgentConnected,
+					Status: corev1.ConditionTrue,
+				})
+				vmiSource.Add(vmi)
+
+				updatedContent := vmSnapshotContent.DeepCopy()
+				updatedContent.ResourceVersion = "1"
+				updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					ReadyToUse: pointer.P(false),
+				}
+
+				updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+				vmSnapshotSource.Add(vmSnapshot)
+				addVirtualMachineSnapshotContent(vmSnapshotContent)
+				controller.processVMSnapshotContentWorkItem()
+				Expect(*updateStatusCalls).To(Equal(1))
+			})
+
+			It("should snapshot a released group member without freezing it", func() {
+				storageClass := createStorageClass()
+				vmSnapshot := createVMSnapshotInProgress()
+				volumeSnapshotClass := createVolumeSnapshotClasses()[0]
+				vmSnapshotContent := createVMSnapshotContent()
+				vmSnapshotContent.UID = contentUID
+				vmSnapshotContent.Labels = map[string]string{snapshotv1.SnapshotGroupLabel: "test-group"}
+				vmSnapshotContent.Annotations = map[string]string{SnapshotGroupFrozenAnnotation: timeStamp.UTC().Format(time.RFC3339)}
+				vm := createLockedVM()
+				vmSource.Add(vm)
+				vmSnapshotContentSource.Add(vmSnapshotContent)
+
+				vmi := createVMI(vm)
+				vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+					Type:   v1.VirtualMachineInstanceAgentConnected,
+					Status: corev1.ConditionTrue,
+				})
+				vmiSource.Add(vmi)
+
+				updatedContent := vmSnapshotContent.DeepCopy()
+				updatedContent.ResourceVersion = "1"
+				updatedContent.Status = &snapshotv1.VirtualMachineSnapshotContentStatus{
+					ReadyToUse: pointer.P(false),
+				}
+				for _, volumeSnapshot := range createVolumeSnapshots(vmSnapshotContent) {
+					updatedContent.Status.VolumeSnapshotStatus = append(updatedContent.Status.VolumeSnapshotStatus, snapshotv1.VolumeSnapshotStatus{
+						VolumeSnapshotName: volumeSnapshot.Name,
+					})
+				}
+
+				storageClassSource.Add(storageClass)
+
+				snapshotCreates := expectVolumeSnapshotCreates(k8sSnapshotClient, volumeSnapshotClass.Name, vmSnapshotContent)
+				updateStatusCalls := expectVMSnapshotContentUpdateStatus(vmSnapshotClient, updatedContent)
+				vmSnapshotSource.Add(vmSnapshot)
+				addVolumeSnapshotClass(volumeSnapshotClass)
+				controller.processVMSnapshotContentWorkItem()
+				testutils.ExpectEvent(recorder, "SuccessfulVolumeSnapshotCreate")
+				Expect(*updateStatusCalls).To(Equal(1))
+				Expect(*snapshotCreates).To(Equal(1))
+			})
+
 			It("should not freeze paused vm with guest agent and show Paused indication", func() {
 				storageClass := createStorageClass()
 				vmSnapshot := createVMSnapshotInProgress()
@@ -0,0 +1,71 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package types
+
+import (
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// PreFreezeHookFailedMsg prefixes the errors of the freeze hooks run before the guest filesystems are frozen
+	PreFreezeHookFailedMsg = "Failed running pre-freeze hook"
+	// PostThawHookFailedMsg prefixes the errors of the freeze hooks run after the guest filesystems are thawed
+	PostThawHookFailedMsg = "Failed running post-thaw hook"
+
+	DefaultFreezeHookTimeoutSeconds int32 = 30
+	// MaxFreezeHooksTimeoutSeconds bounds the total time of the pre-freeze or post-thaw hooks,
+	// they run within the freeze and unfreeze requests which the apiserver times out after a minute
+	MaxFreezeHooksTimeoutSeconds int32 = 30
+)
+
+// PreFreezeHooks returns the hooks to run before freezing the filesystems of the VMI
+func PreFreezeHooks(vmiSpec *v1.VirtualMachineInstanceSpec) []v1.FreezeHook {
+	if vmiSpec.FreezeHooks == nil {
+		return nil
+	}
+	return vmiSpec.FreezeHooks.PreFreeze
+}
+
+// PostThawHooks returns the hooks to run after thawing the filesystems of the VMI
+func PostThawHooks(vmiSpec *v1.VirtualMachineInstanceSpec) []v1.FreezeHook {
+	if vmiSpec.FreezeHooks == nil {
+		return nil
+	}
+	return vmiSpec.FreezeHooks.PostThaw
+}
+
+// FreezeHookTimeoutSeconds returns the time the hook is given to complete
+func FreezeHookTimeoutSeconds(hook *v1.FreezeHook) int32 {
+	if hook.TimeoutSeconds == nil {
+		return DefaultFreezeHookTimeoutSeconds
+	}
+	return *hook.TimeoutSeconds
+}
+
+// FreezeHooksTimeout returns the time the hooks are given to complete when run in order
+func FreezeHooksTimeout(hooks []v1.FreezeHook) time.Duration {
+	var timeoutSeconds int32
+	for i := range hooks {
+		timeoutSeconds += FreezeHookTimeoutSeconds(&hooks[i])
+	}
+	return time.Duration(timeoutSeconds) * time.Second
+}
@@ -0,0 +1,111 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var compressionLevelRanges = map[v1.MigrationCompressionMethod][2]int32{
+	v1.MigrationCompressionZlib: {1, 9},
+	v1.MigrationCompressionZstd: {1, 20},
+}
+
+// RequiresParallelMigration returns true if the pages are compressed in the parallel (multifd) channels
+func RequiresParallelMigration(compression *v1.MigrationCompression) bool {
+	return compression != nil &&
+		(compression.Method == v1.MigrationCompressionZstd || compression.Method == v1.MigrationCompressionZlib)
+}
+
+// IsParallelMigration returns true if the memory of the vmi is migrated through parallel (multifd) channels.
+// The migration threads could choke a limited CPU and they are not used together with post-copy.
+func IsParallelMigration(vmi *v1.VirtualMachineInstance, allowPostCopy bool) bool {
+	if cpuLimit, cpuLimitExists := vmi.Spec.Domain.Resources.Limits[k8sv1.ResourceCPU]; cpuLimitExists && !cpuLimit.IsZero() {
+		return false
+	}
+	return !allowPostCopy
+}
+
+// ValidateCompression returns the causes for which the migration compression settings are invalid
+func ValidateCompression(field *k8sfield.Path, compression *v1.MigrationCompression, allowPostCopy *bool) []metav1.StatusCause {
+	if compression == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	switch compression.Method {
+	case v1.MigrationCompressionZstd, v1.MigrationCompressionZlib, v1.MigrationCompressionXBZRLE:
+	default:
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("unsupported compression method %q", compression.Method),
+			Field:   field.Child("method").String(),
+		})
+	}
+
+	if RequiresParallelMigration(compression) && allowPostCopy != nil && *allowPostCopy {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s compression requires a parallel migration, which is not used together with post-copy", compression.Method),
+			Field:   field.Child("method").String(),
+		})
+	}
+
+	if compression.Level != nil {
+		levelRange, supported := compressionLevelRanges[compression.Method]
+		if !supported {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("compression level is not supported by %s", compression.Method),
+				Field:   field.Child("level").String(),
+			})
+		} else if *compression.Level < levelRange[0] || *compression.Level > levelRange[1] {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s compression level must be between %d and %d", compression.Method, levelRange[0], levelRange[1]),
+				Field:   field.Child("level").String(),
+			})
+		}
+	}
+
+	if compression.XBZRLECacheSize != nil {
+		if compression.Method != v1.MigrationCompressionXBZRLE {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("xbzrle cache size is not supported by %s", compression.Method),
+				Field:   field.Child("xbzrleCacheSize").String(),
+			})
+		} else if compression.XBZRLECacheSize.Sign() <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "xbzrle cache size must be positive",
+				Field:   field.Child("xbzrleCacheSize").String(),
+			})
+		}
+	}
+
+	return causes
+}
@@ -0,0 +1,174 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+	// Embed the time zone database, the images do not ship one
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+var maintenanceWindowParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// ParseMaintenanceWindow returns the schedule of the window, evaluated in the time zone of the window
+func ParseMaintenanceWindow(window v1.MaintenanceWindow) (cron.Schedule, error) {
+	if strings.HasPrefix(window.Schedule, "TZ=") || strings.HasPrefix(window.Schedule, "CRON_TZ=") {
+		return nil, fmt.Errorf("invalid schedule %q, the time zone must be set with timeZone", window.Schedule)
+	}
+	schedule, err := maintenanceWindowParser.Parse(window.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid schedule %q: %v", window.Schedule, err)
+	}
+
+	location := time.UTC
+	if window.TimeZone != nil {
+		location, err = time.LoadLocation(*window.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %v", *window.TimeZone, err)
+		}
+	}
+	schedule.(*cron.SpecSchedule).Location = location
+	return schedule, nil
+}
+
+// ValidateMaintenanceWindows returns the causes for which the maintenance windows are invalid
+func ValidateMaintenanceWindows(field *k8sfield.Path, windows []v1.MaintenanceWindow) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	for i, window := range windows {
+		if _, err := ParseMaintenanceWindow(window); err != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: err.Error(),
+				Field:   field.Index(i).String(),
+			})
+		}
+	}
+	return causes
+}
+
+// MaintenanceWindowOpen reports whether automatic migrations may start at the given time, that is when
+// no window is defined or one of the windows is open. When the migrations wait for a window, the time at
+// which the next window opens is returned as well. Windows which can't be parsed are ignored.
+func MaintenanceWindowOpen(windows []v1.MaintenanceWindow, now time.Time) (bool, *time.Time) {
+	minute := now.Truncate(time.Minute)
+	var next *time.Time
+	validWindows := 0
+	for _, window := range windows {
+		schedule, err := ParseMaintenanceWindow(window)
+		if err != nil {
+			continue
+		}
+		validWindows++
+		// The window is open when the current minute is matched by the schedule
+		if schedule.Next(minute.Add(-time.Second)).Equal(minute) {
+			return true, nil
+		}
+		if start := schedule.Next(now); !start.IsZero() && (next == nil || start.Before(*next)) {
+			next = &start
+		}
+	}
+	if validWindows == 0 {
+		return true, nil
+	}
+	return false, next
+}
+
+// MaintenanceWindows returns the windows restricting the automatic migrations of a VMI. The windows of the
+// migration policy applied to the VMI take precedence over the windows of the workload update strategy.
+func MaintenanceWindows(policy *v1alpha1.MigrationPolicy, kv *v1.KubeVirt) []v1.MaintenanceWindow {
+	if policy != nil && len(policy.Spec.MaintenanceWindows) > 0 {
+		return policy.Spec.MaintenanceWindows
+	}
+	if kv == nil {
+		return nil
+	}
+	return kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows
+}
+
+// NodeDrainAllowedOutsideMaintenanceWindows reports whether node drains may migrate a VMI outside of its
+// maintenance windows. The setting of the migration policy applied to the VMI takes precedence over the
+// setting of the workload update strategy. Node drains are allowed unless one of them forbids it.
+func NodeDrainAllowedOutsideMaintenanceWindows(policy *v1alpha1.MigrationPolicy, kv *v1.KubeVirt) bool {
+	if policy != nil && policy.Spec.AllowNodeDrainOutsideMaintenanceWindows != nil {
+		return *policy.Spec.AllowNodeDrainOutsideMaintenanceWindows
+	}
+	if kv == nil || kv.Spec.WorkloadUpdateStrategy.AllowNodeDrainOutsideMaintenanceWindows == nil {
+		return true
+	}
+	return *kv.Spec.WorkloadUpdateStrategy.AllowNodeDrainOutsideMaintenanceWindows
+}
+
+// SyncAutoMigrationDeferredCondition reports on the vmi that its automatic migration waits for the
+// maintenance window opening at next. The condition is removed when next is nil.
+func SyncAutoMigrationDeferredCondition(clientset kubecli.KubevirtClient, vmi *v1.VirtualMachineInstance, next *time.Time) error {
+	conditionManager := controller.NewVirtualMachineInstanceConditionManager()
+	existingCondition := conditionManager.GetCondition(vmi, v1.VirtualMachineInstanceAutoMigrationDeferred)
+
+	vmiCopy := vmi.DeepCopy()
+	if next == nil {
+		if existingCondition == nil {
+			return nil
+		}
+		conditionManager.RemoveCondition(vmiCopy, v1.VirtualMachineInstanceAutoMigrationDeferred)
+	} else {
+		message := fmt.Sprintf("Automatic migration is deferred to the maintenance window opening at %s", next.UTC().Format(time.RFC3339))
+		if existingCondition != nil && existingCondition.Status == k8sv1.ConditionTrue && existingCondition.Message == message {
+			return nil
+		}
+		conditionManager.RemoveCondition(vmiCopy, v1.VirtualMachineInstanceAutoMigrationDeferred)
+		vmiCopy.Status.Conditions = append(vmiCopy.Status.Conditions, v1.VirtualMachineInstanceCondition{
+			Type:               v1.VirtualMachineInstanceAutoMigrationDeferred,
+			Status:             k8sv1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             v1.VirtualMachineInstanceReasonAutoMigrationPending,
+			Message:            message,
+		})
+	}
+
+	patchBytes, err := patch.New(
+		patch.WithTest("/status/conditions", vmi.Status.Conditions),
+		patch.WithReplace("/status/conditions", vmiCopy.Status.Conditions),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("failed updating vmi condition: %v", err)
+	}
+	return nil
+}
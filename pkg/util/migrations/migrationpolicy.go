@@ -1,7 +1,8 @@
-package migration
+package migrations
 
 import (
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/cache"
 
 	k6tv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations/v1alpha1"
@@ -32,7 +33,7 @@ func (score migrationPolicyMatchScore) lessThan(otherScore migrationPolicyMatchS
 	return !score.equals(otherScore) && !score.greaterThan(otherScore)
 }
 
-// matchPolicy returns the policy that is matched to the vmi, or nil of no policy is matched.
+// MatchPolicy returns the policy that is matched to the vmi, or nil of no policy is matched.
 //
 // Since every policy can specify VMI and Namespace labels to match to, matching is done by returning the most
 // detailed policy, meaning the policy that matches the VMI and specifies the most labels that matched either
@@ -41,7 +42,7 @@ func (score migrationPolicyMatchScore) lessThan(otherScore migrationPolicyMatchS
 // If two policies are matched and have the same level of details (i.e. same number of matching labels) the matched
 // policy is chosen by policies' names ordered by lexicographic order. The reason is to create a rather arbitrary yet
 // deterministic way of matching policies.
-func matchPolicy(policyList *v1alpha1.MigrationPolicyList, vmi *k6tv1.VirtualMachineInstance, vmiNamespace *k8sv1.Namespace) *v1alpha1.MigrationPolicy {
+func MatchPolicy(policyList *v1alpha1.MigrationPolicyList, vmi *k6tv1.VirtualMachineInstance, vmiNamespace *k8sv1.Namespace) *v1alpha1.MigrationPolicy {
 	var mathingPolicies []v1alpha1.MigrationPolicy
 	bestScore := migrationPolicyMatchScore{}
 
@@ -79,6 +80,30 @@ func matchPolicy(policyList *v1alpha1.MigrationPolicyList, vmi *k6tv1.VirtualMac
 	return &mathingPolicies[firstPolicyNameLexicographicOrderIdx]
 }
 
+// MatchPolicyFromStores returns the policy that is matched to the vmi, or nil if no policy is matched, looking up
+// the policies and the namespace of the vmi in the given informer stores.
+func MatchPolicyFromStores(policyStore, namespaceStore cache.Store, vmi *k6tv1.VirtualMachineInstance) *v1alpha1.MigrationPolicy {
+	policyList := &v1alpha1.MigrationPolicyList{}
+	for _, obj := range policyStore.List() {
+		policyList.Items = append(policyList.Items, *obj.(*v1alpha1.MigrationPolicy))
+	}
+	if len(policyList.Items) == 0 {
+		return nil
+	}
+
+	vmiNamespace := &k8sv1.Namespace{}
+	if obj, exists, err := namespaceStore.GetByKey(vmi.Namespace); err == nil && exists {
+		vmiNamespace = obj.(*k8sv1.Namespace)
+	}
+	return MatchPolicy(policyList, vmi, vmiNamespace)
+}
+
+// MatchesPolicy returns true if the policy selects the vmi, regardless of other policies taking precedence.
+func MatchesPolicy(policy *v1alpha1.MigrationPolicy, vmi *k6tv1.VirtualMachineInstance, vmiNamespace *k8sv1.Namespace) bool {
+	doesMatch, _ := countMatchingLabels(policy, vmi.Labels, vmiNamespace.Labels)
+	return doesMatch
+}
+
 // countMatchingLabels checks if a policy matches to a VMI and the number of matching labels.
 // In the case that doesMatch is false, matchingLabels needs to be dismissed and not counted on.
 func countMatchingLabels(policy *v1alpha1.MigrationPolicy, vmiLabels, namespaceLabels map[string]string) (doesMatch bool, score migrationPolicyMatchScore) {
@@ -0,0 +1,65 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// HostCPUModelNodeSelector returns the node selector a host-model VMI needs on a migration target, derived from
+// the host-model labels of its source. The returned key is the label of the supported host-model CPU.
+func HostCPUModelNodeSelector(selectorMap map[string]string) (map[string]string, string, error) {
+	result := make(map[string]string)
+	var hostCpuModel, nodeSelectorKeyForHostModel, hostModelLabelValue string
+
+	for key, value := range selectorMap {
+		if strings.HasPrefix(key, v1.HostModelCPULabel) {
+			hostCpuModel = strings.TrimPrefix(key, v1.HostModelCPULabel)
+			hostModelLabelValue = value
+		}
+
+		if strings.HasPrefix(key, v1.HostModelRequiredFeaturesLabel) {
+			requiredFeature := strings.TrimPrefix(key, v1.HostModelRequiredFeaturesLabel)
+			result[v1.CPUFeatureLabel+requiredFeature] = value
+		}
+	}
+
+	if hostCpuModel == "" {
+		return nil, "", fmt.Errorf("unable to locate host cpu model, does not contain label \"%s\" with information", v1.HostModelCPULabel)
+	}
+
+	nodeSelectorKeyForHostModel = v1.SupportedHostModelMigrationCPU + hostCpuModel
+	result[nodeSelectorKeyForHostModel] = hostModelLabelValue
+
+	return result, nodeSelectorKeyForHostModel, nil
+}
+
+// CPUVendorLabelKey returns the CPU vendor label key among the given labels, or an empty string
+func CPUVendorLabelKey(labels map[string]string) string {
+	for key := range labels {
+		if strings.HasPrefix(key, v1.CPUModelVendorLabel) {
+			return key
+		}
+	}
+	return ""
+}
@@ -0,0 +1,49 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migrations
+
+import (
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// VolumeCutoverAllowed reports whether the VM may switch to the migrated volumes at the given time.
+// When the cut-over waits for a window, the time at which the next window opens is returned as well.
+func VolumeCutoverAllowed(policy *v1.VolumeMigrationCutoverPolicy, now time.Time) (bool, *time.Time) {
+	if policy == nil {
+		return true, nil
+	}
+
+	switch policy.Mode {
+	case v1.VolumeMigrationCutoverManual:
+		return false, nil
+	case v1.VolumeMigrationCutoverWindow:
+		if policy.Window == nil {
+			return false, nil
+		}
+		if _, err := ParseMaintenanceWindow(*policy.Window); err != nil {
+			return false, nil
+		}
+		return MaintenanceWindowOpen([]v1.MaintenanceWindow{*policy.Window}, now)
+	default:
+		return true, nil
+	}
+}
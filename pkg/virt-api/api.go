@@ -143,6 +143,9 @@ type virtAPIApp struct {
 	reInitChan chan string
 
 	kubeVirtServiceAccounts map[string]struct{}
+
+	namespaceStore       cache.Store
+	migrationPolicyStore cache.Store
 }
 
 var (
@@ -234,13 +237,14 @@ func (app *virtAPIApp) composeSubresources() {
 	for _, version := range v1.SubresourceGroupVersions {
 		subresourcesvmGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachines"}
 		subresourcesvmiGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachineinstances"}
+		subresourcesvmsnapshotGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachinesnapshots"}
 		expandvmspecGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "expand-vm-spec"}
 
 		subws := new(restful.WebService)
 		subws.Doc(fmt.Sprintf("KubeVirt \"%s\" Subresource API.", version.Version))
 		subws.Path(definitions.GroupVersionBasePath(version))
 
-		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig)
+		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig, app.namespaceStore, app.migrationPolicyStore)
 
 		restartRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("restart")).
 			To(subresourceApp.RestartVMRequestHandler).
@@ -266,6 +270,19 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("migrate-check")).
+			To(subresourceApp.MigrateCheckRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.MigrateOptions{}).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"MigrateCheck").
+			Doc("Check which nodes a running VirtualMachine could be migrated to, without migrating it.").
+			Writes(v1.MigrateCheckResult{}).
+			Returns(http.StatusOK, "OK", v1.MigrateCheckResult{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("start")).
 			To(subresourceApp.StartVMRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -489,6 +506,21 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("changedblocks")).
+			To(subresourceApp.ChangedBlocksRequestHandler).
+			Produces(restful.MIME_JSON, restful.MIME_OCTET).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.ChangedBlocksFromCheckpointParameter(subws)).
+			Param(definitions.ChangedBlocksToCheckpointParameter(subws)).
+			Param(definitions.ChangedBlocksDiskParameter(subws)).
+			Param(definitions.ChangedBlocksFormatParameter(subws)).
+			Operation(version.Version+"ChangedBlocks").
+			Doc("Get the blocks of the changed block tracking disks which changed since a checkpoint").
+			Writes(v1.ChangedBlocksList{}).
+			Returns(http.StatusOK, "OK", v1.ChangedBlocksList{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("objectgraph")).
 			To(subresourceApp.VMIObjectGraph).
 			Consumes(restful.MIME_JSON).
@@ -511,6 +543,16 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.ObjectGraphNode{}).
 			Returns(http.StatusOK, "OK", v1.ObjectGraphNode{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmsnapshotGVR)+definitions.SubResourcePath("guestfs")).
+			To(subresourceApp.SnapshotGuestfsHandler).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmsnapshot-guestfs").
+			Doc("Get the claims restoring the volumes of a Virtual Machine Snapshot for libguestfs").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusConflict, "Conflict", ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMIAddVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -569,6 +611,17 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("resetcontainerdisk")).
+			To(subresourceApp.ResetContainerDiskVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.ResetContainerDiskOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"ResetContainerDisk").
+			Doc("Resets the persistent overlays of the container disks of a stopped Virtual Machine to their image.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		// AMD SEV endpoints
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("sev/fetchcertchain")).
 			To(subresourceApp.SEVFetchCertChainRequestHandler).
@@ -723,6 +776,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachines/migrate",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachines/migrate-check",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachines/expand-spec",
 						Namespaced: true,
@@ -735,6 +792,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachines/evacuate/cancel",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachines/resetcontainerdisk",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/guestosinfo",
 						Namespaced: true,
@@ -747,6 +808,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/changedblocks",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -779,6 +844,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/evacuate/cancel",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachinesnapshots/guestfs",
+						Namespaced: true,
+					},
 				}
 
 				response.WriteAsJson(list)
@@ -1001,12 +1070,27 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 	http.HandleFunc(components.VMRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMRestores(w, r, app.clusterConfig, app.virtCli, informers)
 	})
+	http.HandleFunc(components.VMSnapshotScheduleValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotSchedules(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMSnapshotGroupValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotGroups(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMSnapshotGroupRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMSnapshotGroupRestores(w, r, app.clusterConfig)
+	})
 	http.HandleFunc(components.VMBackupValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMBackups(w, r, app.clusterConfig, app.virtCli, informers)
 	})
 	http.HandleFunc(components.VMBackupTrackerValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMBackupTrackers(w, r, app.clusterConfig)
 	})
+	http.HandleFunc(components.VMBackupScheduleValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMBackupSchedules(w, r, app.clusterConfig)
+	})
+	http.HandleFunc(components.VMBackupRestoreValidatePath, func(w http.ResponseWriter, r *http.Request) {
+		validating_webhook.ServeVMBackupRestores(w, r, app.clusterConfig)
+	})
 	http.HandleFunc(components.VMExportValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeVMExports(w, r, app.clusterConfig)
 	})
@@ -1183,6 +1267,7 @@ func (app *virtAPIApp) Run() {
 	vmRestoreInformer := kubeInformerFactory.VirtualMachineRestore()
 	vmBackupInformer := kubeInformerFactory.VirtualMachineBackup()
 	namespaceInformer := kubeInformerFactory.Namespace()
+	migrationPolicyInformer := kubeInformerFactory.MigrationPolicy()
 
 	stopChan := make(chan struct{}, 1)
 	defer close(stopChan)
@@ -1216,6 +1301,9 @@ func (app *virtAPIApp) Run() {
 	kubeInformerFactory.Start(stopChan)
 	kubeInformerFactory.WaitForCacheSync(stopChan)
 
+	app.namespaceStore = namespaceInformer.GetStore()
+	app.migrationPolicyStore = migrationPolicyInformer.GetStore()
+
 	webhookInformers := &webhooks.Informers{
 		VMIPresetInformer:  vmiPresetInformer,
 		VMRestoreInformer:  vmRestoreInformer,
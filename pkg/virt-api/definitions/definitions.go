@@ -717,3 +717,26 @@ func VSOCKPortParameter(ws *restful.WebService) *restful.Parameter {
 func VSOCKTLSParameter(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(TLSParamName, "Weather to request a TLS encrypted session from the VSOCK application.").DataType("boolean").Required(false)
 }
+
+const (
+	FromCheckpointParamName = "fromCheckpoint"
+	ToCheckpointParamName   = "toCheckpoint"
+	DiskParamName           = "disk"
+	FormatParamName         = "format"
+)
+
+func ChangedBlocksFromCheckpointParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(FromCheckpointParamName, "The checkpoint to report the changed blocks from.").Required(true)
+}
+
+func ChangedBlocksToCheckpointParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(ToCheckpointParamName, "The checkpoint to report the changed blocks up to. Defaults to the current state of the disks.").Required(false)
+}
+
+func ChangedBlocksDiskParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(DiskParamName, "The disk to report the changed blocks of. Defaults to all the disks with changed block tracking.").Required(false)
+}
+
+func ChangedBlocksFormatParameter(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(FormatParamName, "The format of the changed block list, json or binary. The binary format requires a disk.").DefaultValue("json").Required(false)
+}
@@ -0,0 +1,133 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+)
+
+const (
+	changedBlocksNotEnabledErr = "VMI does not have changed block tracking enabled"
+)
+
+// ChangedBlocksRequestHandler returns the extents of the changed block tracking disks
+// which changed since a checkpoint, either as a JSON list or as binary offset/length pairs.
+func (app *SubresourceAPIApp) ChangedBlocksRequestHandler(request *restful.Request, response *restful.Response) {
+	options, statusErr := changedBlocksOptions(request)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		if !cbt.HasCBTStateEnabled(vmi.Status.ChangedBlockTracking) {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(changedBlocksNotEnabledErr))
+		}
+		return nil
+	}
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.ChangedBlocksURI(vmi, options)
+	}
+
+	_, url, conn, statusErr := app.prepareConnection(request, validate, getURL)
+	if statusErr != nil {
+		log.Log.Errorf(prepConnectionErrFmt, statusErr.Error())
+		writeError(statusErr, response)
+		return
+	}
+
+	resp, err := conn.Get(url, restful.MIME_JSON)
+	if err != nil {
+		log.Log.Errorf(getRequestErrFmt, err.Error())
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	changedBlocks := &v1.ChangedBlocksList{}
+	if err := json.Unmarshal([]byte(resp), changedBlocks); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling response")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	if options.Format != v1.ChangedBlocksFormatBinary {
+		response.WriteEntity(changedBlocks)
+		return
+	}
+
+	if len(changedBlocks.Disks) != 1 {
+		writeError(errors.NewInternalError(fmt.Errorf("expected the changed blocks of disk %s only", options.Disk)), response)
+		return
+	}
+	response.AddHeader("Content-Type", restful.MIME_OCTET)
+	response.WriteHeader(http.StatusOK)
+	if _, err := response.Write(encodeChangedBlockExtents(changedBlocks.Disks[0].Extents)); err != nil {
+		log.Log.Reason(err).Error("Failed to write response")
+	}
+}
+
+func changedBlocksOptions(request *restful.Request) (*v1.ChangedBlocksOptions, *errors.StatusError) {
+	options := &v1.ChangedBlocksOptions{
+		FromCheckpoint: request.QueryParameter(definitions.FromCheckpointParamName),
+		ToCheckpoint:   request.QueryParameter(definitions.ToCheckpointParamName),
+		Disk:           request.QueryParameter(definitions.DiskParamName),
+		Format:         v1.ChangedBlocksFormat(request.QueryParameter(definitions.FormatParamName)),
+	}
+	if options.FromCheckpoint == "" {
+		return nil, errors.NewBadRequest(fmt.Sprintf("%s is required", definitions.FromCheckpointParamName))
+	}
+	switch options.Format {
+	case "", v1.ChangedBlocksFormatJSON:
+	case v1.ChangedBlocksFormatBinary:
+		if options.Disk == "" {
+			return nil, errors.NewBadRequest(fmt.Sprintf("the %s format requires a %s", v1.ChangedBlocksFormatBinary, definitions.DiskParamName))
+		}
+	default:
+		return nil, errors.NewBadRequest(fmt.Sprintf("unsupported %s %s", definitions.FormatParamName, options.Format))
+	}
+	return options, nil
+}
+
+// encodeChangedBlockExtents encodes every extent as its big endian 64 bit offset followed by its length
+func encodeChangedBlockExtents(extents []v1.ChangedBlockExtent) []byte {
+	data := make([]byte, 0, len(extents)*16)
+	for _, extent := range extents {
+		data = binary.BigEndian.AppendUint64(data, uint64(extent.Offset))
+		data = binary.BigEndian.AppendUint64(data, uint64(extent.Length))
+	}
+	return data
+}
@@ -0,0 +1,115 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+)
+
+const (
+	persistentOverlayNotEnabledErr = "ContainerDiskPersistentOverlay feature gate not enabled"
+	resetContainerDiskVMRunningErr = "VM must be stopped to reset its container disks"
+)
+
+// ResetContainerDiskVMRequestHandler discards the persistent overlays of the container disks of a stopped VM.
+// The overlays are created again on top of the container disk images on the next start.
+func (app *SubresourceAPIApp) ResetContainerDiskVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.ContainerDiskPersistentOverlayEnabled() {
+		writeError(errors.NewBadRequest(persistentOverlayNotEnabledErr), response)
+		return
+	}
+
+	opts := &v1.ResetContainerDiskOptions{}
+	if request.Request.Body != nil {
+		defer request.Request.Body.Close()
+		if err := decodeBody(request, opts); err != nil {
+			writeError(err, response)
+			return
+		}
+	}
+
+	vm, statusErr := app.fetchVirtualMachine(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	vmi, err := app.virtCli.VirtualMachineInstance(namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil && !errors.IsNotFound(err) {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	if err == nil && !vmi.IsFinal() {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(resetContainerDiskVMRunningErr)), response)
+		return
+	}
+
+	volumeNames, statusErr := persistentOverlayVolumeNames(vm, opts.VolumeName)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	for _, volumeName := range volumeNames {
+		claimName := backendstorage.OverlayPVCName(vm.Name, volumeName)
+		err := app.virtCli.CoreV1().PersistentVolumeClaims(namespace).Delete(context.Background(), claimName, metav1.DeleteOptions{DryRun: opts.DryRun})
+		if err != nil && !errors.IsNotFound(err) {
+			log.Log.Object(vm).Reason(err).Errorf("Failed to delete overlay PVC %s", claimName)
+			writeError(errors.NewInternalError(fmt.Errorf("unable to delete overlay pvc [%s]: %v", claimName, err)), response)
+			return
+		}
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func persistentOverlayVolumeNames(vm *v1.VirtualMachine, volumeName string) ([]string, *errors.StatusError) {
+	if vm.Spec.Template == nil {
+		return nil, errors.NewBadRequest("VM has no template")
+	}
+	var volumeNames []string
+	for _, volume := range backendstorage.PersistentOverlayVolumes(&vm.Spec.Template.Spec) {
+		if volumeName == "" || volume.Name == volumeName {
+			volumeNames = append(volumeNames, volume.Name)
+		}
+	}
+	if len(volumeNames) > 0 {
+		return volumeNames, nil
+	}
+	if volumeName != "" {
+		return nil, errors.NewBadRequest(fmt.Sprintf("volume %s is not a container disk with a persistent overlay", volumeName))
+	}
+	return nil, errors.NewBadRequest("VM has no container disk with a persistent overlay")
+}
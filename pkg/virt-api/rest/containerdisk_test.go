@@ -0,0 +1,184 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8scorev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("ResetContainerDisk Subresource API", func() {
+	const volumeName = "disk0"
+
+	var (
+		request    *restful.Request
+		response   *restful.Response
+		recorder   *httptest.ResponseRecorder
+		virtClient *kubecli.MockKubevirtClient
+		kubeClient *k8sfake.Clientset
+		app        *SubresourceAPIApp
+		overlayPVC string
+	)
+
+	newApp := func(featureGates ...string) *SubresourceAPIApp {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+		})
+		return NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config, nil, nil)
+	}
+
+	newVMWithOverlay := func() *v1.VirtualMachine {
+		vmi := libvmi.New(
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithContainerDiskPersistentOverlay(volumeName, "quay.io/containerdisks/fedora:latest", resource.MustParse("1Gi")),
+		)
+		return libvmi.NewVirtualMachine(vmi)
+	}
+
+	setBody := func(opts *v1.ResetContainerDiskOptions) {
+		body, err := json.Marshal(opts)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = &readCloserWrapper{bytes.NewReader(body)}
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+
+		overlayPVC = backendstorage.OverlayPVCName(testVMName, volumeName)
+		kubeClient = k8sfake.NewClientset(&k8scorev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      overlayPVC,
+				Namespace: metav1.NamespaceDefault,
+			},
+		})
+
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		fakeKubevirtClients := fake.NewSimpleClientset().KubevirtV1()
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(fakeKubevirtClients.VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(fakeKubevirtClients.VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+		app = newApp(featuregate.ContainerDiskPersistentOverlayGate)
+	})
+
+	createVM := func(vm *v1.VirtualMachine) {
+		_, err := virtClient.VirtualMachine(vm.Namespace).Create(context.Background(), vm, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	expectOverlayPVC := func(exists bool) {
+		_, err := kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Get(context.Background(), overlayPVC, metav1.GetOptions{})
+		if exists {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		}
+	}
+
+	It("should fail if the feature gate is not enabled", func() {
+		app = newApp()
+		createVM(newVMWithOverlay())
+
+		app.ResetContainerDiskVMRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		expectOverlayPVC(true)
+	})
+
+	It("should delete the overlay PVC of a stopped VM", func() {
+		createVM(newVMWithOverlay())
+
+		app.ResetContainerDiskVMRequestHandler(request, response)
+
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		expectOverlayPVC(false)
+	})
+
+	It("should succeed if the overlay PVC does not exist yet", func() {
+		createVM(newVMWithOverlay())
+		Expect(kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Delete(
+			context.Background(), overlayPVC, metav1.DeleteOptions{})).To(Succeed())
+
+		app.ResetContainerDiskVMRequestHandler(request, response)
+
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+	})
+
+	It("should fail if the VM is running", func() {
+		vm := newVMWithOverlay()
+		createVM(vm)
+		vmi := libvmi.New(libvmi.WithName(testVMName), libvmi.WithNamespace(metav1.NamespaceDefault))
+		vmi.Status.Phase = v1.Running
+		_, err := virtClient.VirtualMachineInstance(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		app.ResetContainerDiskVMRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		expectOverlayPVC(true)
+	})
+
+	It("should fail if the volume has no persistent overlay", func() {
+		createVM(newVMWithOverlay())
+		setBody(&v1.ResetContainerDiskOptions{VolumeName: "unknown"})
+
+		app.ResetContainerDiskVMRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		expectOverlayPVC(true)
+	})
+
+	It("should fail if the VM has no persistent overlay", func() {
+		createVM(libvmi.NewVirtualMachine(libvmi.New(libvmi.WithName(testVMName), libvmi.WithNamespace(metav1.NamespaceDefault))))
+
+		app.ResetContainerDiskVMRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	})
+})
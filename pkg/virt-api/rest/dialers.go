@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 
 	"github.com/gorilla/websocket"
 
@@ -35,6 +36,7 @@ import (
 	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
 	"kubevirt.io/client-go/log"
 
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 )
 
@@ -128,7 +130,22 @@ func (app *SubresourceAPIApp) getVirtHandlerConnForVMI(vmi *v1.VirtualMachineIns
 	if !vmi.IsRunning() && !vmi.IsScheduled() {
 		return nil, errors.New(fmt.Sprintf("Unable to connect to VirtualMachineInstance because phase is %s instead of %s or %s", vmi.Status.Phase, v1.Running, v1.Scheduled))
 	}
-	return kubecli.NewVirtHandlerClient(app.virtCli, app.handlerHttpClient).Port(app.consoleServerPort).ForNode(vmi.Status.NodeName), nil
+	return kubecli.NewVirtHandlerClient(app.virtCli, app.handlerHttpClientFor(vmi)).Port(app.consoleServerPort).ForNode(vmi.Status.NodeName), nil
+}
+
+// handlerHttpClientFor gives the requests of VMIs with freeze hooks the time to run them,
+// the freeze and unfreeze requests only return once the hooks completed
+func (app *SubresourceAPIApp) handlerHttpClientFor(vmi *v1.VirtualMachineInstance) *http.Client {
+	hooksTimeout := max(
+		storagetypes.FreezeHooksTimeout(storagetypes.PreFreezeHooks(&vmi.Spec)),
+		storagetypes.FreezeHooksTimeout(storagetypes.PostThawHooks(&vmi.Spec)),
+	)
+	if hooksTimeout == 0 {
+		return app.handlerHttpClient
+	}
+	httpClient := *app.handlerHttpClient
+	httpClient.Timeout += hooksTimeout
+	return &httpClient
 }
 
 // get the first available interface IP
@@ -0,0 +1,111 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/openshift/library-go/pkg/build/naming"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/snapshot"
+)
+
+// SnapshotGuestfsHandler returns the claims which restore the volumes of a
+// VirtualMachineSnapshot, so that they can be inspected with libguestfs
+func (app *SubresourceAPIApp) SnapshotGuestfsHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	vmSnapshot, statusErr := app.fetchVMSnapshot(name, namespace)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	if vmSnapshot.Status == nil || vmSnapshot.Status.ReadyToUse == nil || !*vmSnapshot.Status.ReadyToUse ||
+		vmSnapshot.Status.VirtualMachineSnapshotContentName == nil {
+		err := fmt.Errorf("snapshot is not ready to use")
+		writeError(errors.NewConflict(snapshotv1.Resource("virtualmachinesnapshot"), name, err), response)
+		return
+	}
+
+	contentName := *vmSnapshot.Status.VirtualMachineSnapshotContentName
+	content, err := app.virtCli.VirtualMachineSnapshotContent(namespace).Get(context.Background(), contentName, k8smetav1.GetOptions{})
+	if err != nil {
+		writeError(errors.NewInternalError(fmt.Errorf("unable to retrieve vmsnapshotcontent [%s]: %v", contentName, err)), response)
+		return
+	}
+
+	info := kubecli.GuestfsSnapshotInfo{}
+	for i := range content.Spec.VolumeBackups {
+		volumeBackup := &content.Spec.VolumeBackups[i]
+		if volumeBackup.VolumeSnapshotName == nil {
+			continue
+		}
+
+		volumeSnapshotName := *volumeBackup.VolumeSnapshotName
+		volumeSnapshot, err := app.virtCli.KubernetesSnapshotClient().SnapshotV1().VolumeSnapshots(namespace).Get(
+			context.Background(), volumeSnapshotName, k8smetav1.GetOptions{})
+		if err != nil {
+			writeError(errors.NewInternalError(fmt.Errorf("unable to retrieve volumesnapshot [%s]: %v", volumeSnapshotName, err)), response)
+			return
+		}
+
+		// Leave room for the random suffix, so that concurrent inspections of the snapshot do not conflict
+		pvcName := naming.GetName("guestfs-"+name, volumeBackup.VolumeName, k8svalidation.DNS1035LabelMaxLength-6)
+		pvc, err := snapshot.CreateRestorePVCDef(pvcName, volumeSnapshot, volumeBackup)
+		if err != nil {
+			writeError(errors.NewInternalError(err), response)
+			return
+		}
+		pvc.Name = ""
+		pvc.GenerateName = pvcName + "-"
+		pvc.Namespace = namespace
+
+		info.Volumes = append(info.Volumes, kubecli.GuestfsSnapshotVolume{
+			VolumeName:            volumeBackup.VolumeName,
+			PersistentVolumeClaim: *pvc,
+		})
+	}
+
+	if err := response.WriteEntity(info); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
+func (app *SubresourceAPIApp) fetchVMSnapshot(name, namespace string) (*snapshotv1.VirtualMachineSnapshot, *errors.StatusError) {
+	vmSnapshot, err := app.virtCli.VirtualMachineSnapshot(namespace).Get(context.Background(), name, k8smetav1.GetOptions{})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.NewNotFound(snapshotv1.Resource("virtualmachinesnapshot"), name)
+		}
+		return nil, errors.NewInternalError(fmt.Errorf("unable to retrieve vmsnapshot [%s]: %v", name, err))
+	}
+	return vmSnapshot, nil
+}
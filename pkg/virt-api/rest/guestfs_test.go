@@ -0,0 +1,191 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	k8ssnapshotfake "kubevirt.io/client-go/externalsnapshotter/fake"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Snapshot guestfs Subresource", func() {
+	const (
+		snapshotName = "test-snapshot"
+		contentName  = "test-content"
+	)
+
+	var (
+		request     *restful.Request
+		recorder    *httptest.ResponseRecorder
+		response    *restful.Response
+		virtClient  *kubevirtfake.Clientset
+		vsClient    *k8ssnapshotfake.Clientset
+		app         *SubresourceAPIApp
+		restoreSize = resource.MustParse("2Gi")
+	)
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = snapshotName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		virtClient = kubevirtfake.NewSimpleClientset()
+		vsClient = k8ssnapshotfake.NewSimpleClientset()
+		mockVirtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		mockVirtClient.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).Return(virtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
+		mockVirtClient.EXPECT().VirtualMachineSnapshotContent(metav1.NamespaceDefault).Return(virtClient.SnapshotV1beta1().VirtualMachineSnapshotContents(metav1.NamespaceDefault)).AnyTimes()
+		mockVirtClient.EXPECT().KubernetesSnapshotClient().Return(vsClient).AnyTimes()
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+		app = NewSubresourceAPIApp(mockVirtClient, 0, &tls.Config{InsecureSkipVerify: true}, config, nil, nil)
+	})
+
+	createSnapshot := func(ready bool) {
+		vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      snapshotName,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Status: &snapshotv1.VirtualMachineSnapshotStatus{
+				ReadyToUse:                        pointer.P(ready),
+				VirtualMachineSnapshotContentName: pointer.P(contentName),
+			},
+		}
+		_, err := virtClient.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	createContent := func(volumeBackups ...snapshotv1.VolumeBackup) {
+		content := &snapshotv1.VirtualMachineSnapshotContent{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      contentName,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+				VirtualMachineSnapshotName: pointer.P(snapshotName),
+				VolumeBackups:              volumeBackups,
+			},
+		}
+		_, err := virtClient.SnapshotV1beta1().VirtualMachineSnapshotContents(metav1.NamespaceDefault).Create(context.Background(), content, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	createVolumeSnapshot := func(name string) {
+		volumeSnapshot := &vsv1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+			},
+			Status: &vsv1.VolumeSnapshotStatus{
+				ReadyToUse:  pointer.P(true),
+				RestoreSize: &restoreSize,
+			},
+		}
+		_, err := vsClient.SnapshotV1().VolumeSnapshots(metav1.NamespaceDefault).Create(context.Background(), volumeSnapshot, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	volumeBackup := func(volumeName, volumeSnapshotName string) snapshotv1.VolumeBackup {
+		return snapshotv1.VolumeBackup{
+			VolumeName: volumeName,
+			PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: volumeName + "-pvc",
+				},
+				Spec: k8sv1.PersistentVolumeClaimSpec{
+					AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+					Resources: k8sv1.VolumeResourceRequirements{
+						Requests: k8sv1.ResourceList{
+							k8sv1.ResourceStorage: resource.MustParse("1Gi"),
+						},
+					},
+					VolumeMode: pointer.P(k8sv1.PersistentVolumeBlock),
+				},
+			},
+			VolumeSnapshotName: pointer.P(volumeSnapshotName),
+		}
+	}
+
+	It("should fail if the snapshot does not exist", func() {
+		app.SnapshotGuestfsHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusNotFound))
+	})
+
+	It("should fail if the snapshot is not ready to use", func() {
+		createSnapshot(false)
+
+		app.SnapshotGuestfsHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusConflict))
+	})
+
+	It("should fail if a volume snapshot is missing", func() {
+		createSnapshot(true)
+		createContent(volumeBackup("disk0", "vs-disk0"))
+
+		app.SnapshotGuestfsHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+	})
+
+	It("should return a claim restoring each volume snapshot", func() {
+		createSnapshot(true)
+		createVolumeSnapshot("vs-disk0")
+		createContent(volumeBackup("disk0", "vs-disk0"), snapshotv1.VolumeBackup{VolumeName: "memory"})
+
+		app.SnapshotGuestfsHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		info := kubecli.GuestfsSnapshotInfo{}
+		Expect(json.NewDecoder(recorder.Body).Decode(&info)).To(Succeed())
+		Expect(info.Volumes).To(HaveLen(1))
+		Expect(info.Volumes[0].VolumeName).To(Equal("disk0"))
+
+		pvc := info.Volumes[0].PersistentVolumeClaim
+		Expect(pvc.Name).To(BeEmpty())
+		Expect(pvc.GenerateName).To(Equal("guestfs-" + snapshotName + "-disk0-"))
+		Expect(pvc.Namespace).To(Equal(metav1.NamespaceDefault))
+		Expect(pvc.Spec.DataSource.Kind).To(Equal("VolumeSnapshot"))
+		Expect(pvc.Spec.DataSource.Name).To(Equal("vs-disk0"))
+		Expect(*pvc.Spec.VolumeMode).To(Equal(k8sv1.PersistentVolumeBlock))
+		Expect(pvc.Spec.Resources.Requests[k8sv1.ResourceStorage]).To(Equal(restoreSize))
+	})
+})
@@ -36,6 +36,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	kutil "kubevirt.io/kubevirt/pkg/util"
 )
@@ -45,6 +46,7 @@ const (
 	pvcAccessModeErr          = "pvc access mode can't be read only"
 	pvcSizeErrFmt             = "pvc size [%s] should be bigger then [%s]"
 	memoryDumpNameConflictErr = "can't request memory dump for pvc [%s] while pvc [%s] is still associated as the memory dump pvc"
+	memoryDumpFormatErrFmt    = "unsupported memory dump format [%s]"
 )
 
 func (app *SubresourceAPIApp) fetchPersistentVolumeClaim(name string, namespace string) (*k8sv1.PersistentVolumeClaim, *errors.StatusError) {
@@ -109,6 +111,10 @@ func (app *SubresourceAPIApp) validateMemoryDumpClaim(vmi *v1.VirtualMachineInst
 }
 
 func (app *SubresourceAPIApp) validateMemoryDumpRequest(vm *v1.VirtualMachine, memoryDumpReq *v1.VirtualMachineMemoryDumpRequest) *errors.StatusError {
+	if !memorydump.IsValidFormat(memoryDumpReq.Format) {
+		return errors.NewBadRequest(fmt.Sprintf(memoryDumpFormatErrFmt, memoryDumpReq.Format))
+	}
+
 	if memoryDumpReq.ClaimName == "" && vm.Status.MemoryDumpRequest == nil {
 		return errors.NewBadRequest("Memory dump requires claim name to be set")
 	} else if vm.Status.MemoryDumpRequest != nil && memoryDumpReq.ClaimName != "" {
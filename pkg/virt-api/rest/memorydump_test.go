@@ -148,7 +148,7 @@ var _ = Describe("Memory dump Subresource api", func() {
 		cdiConfig := cdiConfigInit()
 		cdiClient = cdifake.NewSimpleClientset(cdiConfig)
 
-		app = NewSubresourceAPIApp(virtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config)
+		app = NewSubresourceAPIApp(virtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config, nil, nil)
 	})
 
 	AfterEach(func() {
@@ -251,6 +251,14 @@ var _ = Describe("Memory dump Subresource api", func() {
 		Entry("VM with a memory dump request pvc size too small should fail", &v1.VirtualMachineMemoryDumpRequest{
 			ClaimName: testPVCName,
 		}, http.StatusConflict, true, true, createTestPVC("1Gi", fs, notReadOnly)),
+		Entry("VM with a compressed memory dump request should succeed", &v1.VirtualMachineMemoryDumpRequest{
+			ClaimName: testPVCName,
+			Format:    v1.MemoryDumpFormatKdumpZlib,
+		}, http.StatusAccepted, true, true, createTestPVC("2Gi", fs, notReadOnly)),
+		Entry("VM with a memory dump request in an unsupported format should fail", &v1.VirtualMachineMemoryDumpRequest{
+			ClaimName: testPVCName,
+			Format:    "win-dmp",
+		}, http.StatusBadRequest, true, true, createTestPVC("2Gi", fs, notReadOnly)),
 	)
 
 	DescribeTable("With memory dump request", func(memDumpReq, prevMemDumpReq *v1.VirtualMachineMemoryDumpRequest, statusCode int) {
@@ -0,0 +1,473 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	migrationsutil "kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+const (
+	migrationInProgressReason = "a migration of the VMI is already in progress"
+	noSourcePodErrFmt         = "unable to find the virt-launcher pod of the VMI on node %s"
+)
+
+// MigrateCheckRequestHandler evaluates the nodes a running VirtualMachine could be live migrated to,
+// without migrating it.
+func (app *SubresourceAPIApp) MigrateCheckRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	bodyStruct := &v1.MigrateOptions{}
+	if request.Request.Body != nil {
+		if err := decodeBody(request, bodyStruct); err != nil {
+			writeError(err, response)
+			return
+		}
+	}
+	if _, statusErr := app.fetchVirtualMachine(name, namespace); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	vmi, statusErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	if vmi.Status.Phase != v1.Running {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(vmNotRunning)), response)
+		return
+	}
+
+	result, err := app.migrateCheck(vmi, bodyStruct.AddedNodeSelector)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	if err := response.WriteEntity(result); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to write migrate check response")
+	}
+}
+
+func (app *SubresourceAPIApp) migrateCheck(vmi *v1.VirtualMachineInstance, addedNodeSelector map[string]string) (*v1.MigrateCheckResult, error) {
+	ctx := context.Background()
+	result := &v1.MigrateCheckResult{}
+
+	migrationConfiguration := app.clusterConfig.GetMigrationConfiguration().DeepCopy()
+	if policy := migrationsutil.MatchPolicyFromStores(app.migrationPolicyStore, app.namespaceStore, vmi); policy != nil {
+		if _, err := policy.GetMigrationConfByPolicy(migrationConfiguration); err != nil {
+			return nil, err
+		}
+		result.MigrationPolicyName = pointer.P(policy.Name)
+	}
+	result.MigrationConfiguration = migrationConfiguration
+
+	sourcePod, err := app.findSourcePod(ctx, vmi)
+	if err != nil {
+		return nil, err
+	}
+	sourceNode, err := app.virtCli.CoreV1().Nodes().Get(ctx, vmi.Status.NodeName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	volumes, err := app.migrateCheckVolumes(ctx, vmi)
+	if err != nil {
+		return nil, err
+	}
+	// A resource version of 0 lets the API server serve the nodes from its watch cache
+	nodes, err := app.virtCli.CoreV1().Nodes().List(ctx, metav1.ListOptions{ResourceVersion: "0"})
+	if err != nil {
+		return nil, err
+	}
+
+	requestedResources := func(nodeName string) (k8sv1.ResourceList, error) {
+		return app.requestedResources(ctx, nodeName)
+	}
+	if err := newMigrateCheck(vmi, sourcePod, sourceNode, addedNodeSelector, volumes).evaluate(nodes.Items, requestedResources, result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (app *SubresourceAPIApp) findSourcePod(ctx context.Context, vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error) {
+	fieldSelector := fields.ParseSelectorOrDie("status.phase==" + string(k8sv1.PodRunning))
+	labelSelector, err := labels.Parse(fmt.Sprintf("%s=virt-launcher,%s=%s", v1.AppLabel, v1.CreatedByLabel, string(vmi.UID)))
+	if err != nil {
+		return nil, err
+	}
+	podList, err := app.virtCli.CoreV1().Pods(vmi.Namespace).List(ctx, metav1.ListOptions{FieldSelector: fieldSelector.String(), LabelSelector: labelSelector.String()})
+	if err != nil {
+		return nil, err
+	}
+	for i := range podList.Items {
+		if podList.Items[i].Spec.NodeName == vmi.Status.NodeName {
+			return &podList.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf(noSourcePodErrFmt, vmi.Status.NodeName)
+}
+
+func (app *SubresourceAPIApp) migrateCheckVolumes(ctx context.Context, vmi *v1.VirtualMachineInstance) ([]migrateCheckVolume, error) {
+	var volumes []migrateCheckVolume
+	for i := range vmi.Spec.Volumes {
+		claimName := storagetypes.PVCNameFromVirtVolume(&vmi.Spec.Volumes[i])
+		if claimName == "" {
+			continue
+		}
+		pvc, err := app.virtCli.CoreV1().PersistentVolumeClaims(vmi.Namespace).Get(ctx, claimName, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		volume := migrateCheckVolume{name: vmi.Spec.Volumes[i].Name, pvc: pvc}
+		if pvc.Spec.VolumeName != "" {
+			volume.pv, err = app.virtCli.CoreV1().PersistentVolumes().Get(ctx, pvc.Spec.VolumeName, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+		}
+		volumes = append(volumes, volume)
+	}
+	return volumes, nil
+}
+
+// requestedResources sums the resource requests of the non terminated pods of the node
+func (app *SubresourceAPIApp) requestedResources(ctx context.Context, nodeName string) (k8sv1.ResourceList, error) {
+	fieldSelector := fields.AndSelectors(
+		fields.OneTermEqualSelector("spec.nodeName", nodeName),
+		fields.OneTermNotEqualSelector("status.phase", string(k8sv1.PodSucceeded)),
+		fields.OneTermNotEqualSelector("status.phase", string(k8sv1.PodFailed)),
+	)
+	podList, err := app.virtCli.CoreV1().Pods(k8sv1.NamespaceAll).List(ctx, metav1.ListOptions{FieldSelector: fieldSelector.String(), ResourceVersion: "0"})
+	if err != nil {
+		return nil, err
+	}
+
+	requested := k8sv1.ResourceList{}
+	for i := range podList.Items {
+		addResources(requested, podRequests(&podList.Items[i]))
+	}
+	return requested, nil
+}
+
+type migrateCheckVolume struct {
+	name string
+	pvc  *k8sv1.PersistentVolumeClaim
+	pv   *k8sv1.PersistentVolume
+}
+
+// migrateCheck evaluates candidate target nodes the same way the migration controller
+// constrains the target pod: the node selector and affinity of the source pod,
+// the host-model CPU and vendor of the source node and the added node selector.
+type migrateCheck struct {
+	vmi               *v1.VirtualMachineInstance
+	reasons           []string
+	nodeSelector      map[string]string
+	addedNodeSelector map[string]string
+	nodeAffinity      *nodeaffinity.LazyErrorNodeSelector
+	tolerations       []k8sv1.Toleration
+	requests          k8sv1.ResourceList
+	volumes           []migrateCheckVolume
+}
+
+func newMigrateCheck(vmi *v1.VirtualMachineInstance, sourcePod *k8sv1.Pod, sourceNode *k8sv1.Node, addedNodeSelector map[string]string, volumes []migrateCheckVolume) *migrateCheck {
+	c := &migrateCheck{
+		vmi:               vmi,
+		addedNodeSelector: addedNodeSelector,
+		tolerations:       sourcePod.Spec.Tolerations,
+		requests:          podRequests(sourcePod),
+		volumes:           volumes,
+	}
+	c.reasons = vmiMigrationBlockers(vmi, volumes)
+
+	nodeSelector := map[string]string{}
+	maps.Copy(nodeSelector, addedNodeSelector)
+	maps.Copy(nodeSelector, sourcePod.Spec.NodeSelector)
+
+	if cpu := vmi.Spec.Domain.CPU; cpu != nil && cpu.Model == v1.CPUModeHostModel && !hasHostModelMigrationLabels(sourcePod.Spec.NodeSelector) {
+		hostModelSelector, _, err := migrationsutil.HostCPUModelNodeSelector(sourceNode.Labels)
+		if err != nil {
+			c.reasons = append(c.reasons, err.Error())
+		} else {
+			maps.Copy(nodeSelector, hostModelSelector)
+		}
+	}
+	if migrationsutil.CPUVendorLabelKey(nodeSelector) == "" {
+		if vendorLabelKey := migrationsutil.CPUVendorLabelKey(sourceNode.Labels); vendorLabelKey != "" {
+			nodeSelector[vendorLabelKey] = "true"
+		}
+	}
+	c.nodeSelector = nodeSelector
+
+	if affinity := sourcePod.Spec.Affinity; affinity != nil && affinity.NodeAffinity != nil {
+		if required := affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution; required != nil {
+			c.nodeAffinity = nodeaffinity.NewLazyErrorNodeSelector(required)
+		}
+	}
+	return c
+}
+
+// evaluate fills the result with the nodes the VMI can be migrated to, ordered by the memory
+// left on them after the migration, and with the rejected nodes. The requested resources are
+// only looked up for the nodes the VMI is not rejected from by their labels, taints and volumes.
+func (c *migrateCheck) evaluate(nodes []k8sv1.Node, requestedResources func(nodeName string) (k8sv1.ResourceList, error), result *v1.MigrateCheckResult) error {
+	result.Reasons = c.reasons
+	for i := range nodes {
+		node := &nodes[i]
+		if node.Name == c.vmi.Status.NodeName {
+			continue
+		}
+
+		if reasons := c.checkNode(node); len(reasons) > 0 {
+			result.RejectedNodes = append(result.RejectedNodes, v1.MigrateCheckNode{Name: node.Name, Reasons: reasons})
+			continue
+		}
+
+		requested, err := requestedResources(node.Name)
+		if err != nil {
+			return err
+		}
+		available := availableResources(node, requested)
+		if reasons := c.checkResources(available); len(reasons) > 0 {
+			result.RejectedNodes = append(result.RejectedNodes, v1.MigrateCheckNode{Name: node.Name, Reasons: reasons})
+			continue
+		}
+
+		memory := available.Memory().DeepCopy()
+		memory.Sub(*c.requests.Memory())
+		result.Nodes = append(result.Nodes, v1.MigrateCheckNode{Name: node.Name, AvailableMemory: &memory})
+	}
+
+	sort.SliceStable(result.Nodes, func(i, j int) bool {
+		if cmp := result.Nodes[i].AvailableMemory.Cmp(*result.Nodes[j].AvailableMemory); cmp != 0 {
+			return cmp > 0
+		}
+		return result.Nodes[i].Name < result.Nodes[j].Name
+	})
+	sort.SliceStable(result.RejectedNodes, func(i, j int) bool {
+		return result.RejectedNodes[i].Name < result.RejectedNodes[j].Name
+	})
+	result.Migratable = len(result.Reasons) == 0 && len(result.Nodes) > 0
+	return nil
+}
+
+func (c *migrateCheck) checkNode(node *k8sv1.Node) []string {
+	var reasons []string
+	if node.Spec.Unschedulable {
+		reasons = append(reasons, "node is cordoned")
+	}
+	if node.Labels[v1.NodeSchedulable] != "true" {
+		reasons = append(reasons, "node is not schedulable for VMIs")
+	}
+
+	for i := range node.Spec.Taints {
+		taint := &node.Spec.Taints[i]
+		if taint.Effect == k8sv1.TaintEffectPreferNoSchedule || c.toleratesTaint(taint) {
+			continue
+		}
+		reasons = append(reasons, fmt.Sprintf("node has the untolerated taint %s", taint.ToString()))
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(c.nodeSelector)) {
+		if value, exists := node.Labels[key]; !exists || value != c.nodeSelector[key] {
+			reasons = append(reasons, c.nodeSelectorReason(key, c.nodeSelector[key]))
+		}
+	}
+	// An invalid affinity matches no node, the same way the scheduler treats it
+	if c.nodeAffinity != nil {
+		if matches, err := c.nodeAffinity.Match(node); err != nil || !matches {
+			reasons = append(reasons, "node does not match the node affinity of the VMI")
+		}
+	}
+
+	for _, volume := range c.volumes {
+		if volume.pv == nil || volume.pv.Spec.NodeAffinity == nil || volume.pv.Spec.NodeAffinity.Required == nil {
+			continue
+		}
+		if matches, err := nodeaffinity.NewLazyErrorNodeSelector(volume.pv.Spec.NodeAffinity.Required).Match(node); err != nil || !matches {
+			reasons = append(reasons, fmt.Sprintf("node can not access the persistent volume %s of volume %s", volume.pv.Name, volume.name))
+		}
+	}
+	return reasons
+}
+
+func (c *migrateCheck) toleratesTaint(taint *k8sv1.Taint) bool {
+	for i := range c.tolerations {
+		if c.tolerations[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *migrateCheck) nodeSelectorReason(key, value string) string {
+	switch {
+	case strings.HasPrefix(key, v1.CPUModelLabel):
+		return fmt.Sprintf("node does not support the CPU model %s", strings.TrimPrefix(key, v1.CPUModelLabel))
+	case strings.HasPrefix(key, v1.SupportedHostModelMigrationCPU):
+		return fmt.Sprintf("node does not support the host-model CPU %s", strings.TrimPrefix(key, v1.SupportedHostModelMigrationCPU))
+	case strings.HasPrefix(key, v1.CPUFeatureLabel):
+		return fmt.Sprintf("node does not support the CPU feature %s", strings.TrimPrefix(key, v1.CPUFeatureLabel))
+	case strings.HasPrefix(key, v1.CPUModelVendorLabel):
+		return fmt.Sprintf("node does not have the %s CPU vendor", strings.TrimPrefix(key, v1.CPUModelVendorLabel))
+	}
+	if addedValue, exists := c.addedNodeSelector[key]; exists && addedValue == value {
+		return fmt.Sprintf("node does not match the added node selector %s=%s", key, value)
+	}
+	return fmt.Sprintf("node does not match the node selector %s=%s", key, value)
+}
+
+func (c *migrateCheck) checkResources(available k8sv1.ResourceList) []string {
+	var reasons []string
+	for _, name := range slices.Sorted(maps.Keys(c.requests)) {
+		requested := c.requests[name]
+		if requested.IsZero() {
+			continue
+		}
+		left, exists := available[name]
+		if exists && left.Cmp(requested) >= 0 {
+			continue
+		}
+		if !exists && c.isHostDevice(name) {
+			reasons = append(reasons, fmt.Sprintf("node does not provide the host device %s", name))
+			continue
+		}
+		reasons = append(reasons, fmt.Sprintf("node does not have enough %s: requested %s, available %s", name, requested.String(), left.String()))
+	}
+	return reasons
+}
+
+func (c *migrateCheck) isHostDevice(name k8sv1.ResourceName) bool {
+	for _, hostDevice := range c.vmi.Spec.Domain.Devices.HostDevices {
+		if hostDevice.DeviceName == string(name) {
+			return true
+		}
+	}
+	for _, gpu := range c.vmi.Spec.Domain.Devices.GPUs {
+		if gpu.DeviceName == string(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// vmiMigrationBlockers returns the reasons why the VMI can not be migrated to any node
+func vmiMigrationBlockers(vmi *v1.VirtualMachineInstance, volumes []migrateCheckVolume) []string {
+	var reasons []string
+	if migrationsutil.IsMigrating(vmi) {
+		reasons = append(reasons, migrationInProgressReason)
+	}
+
+	var storageReasons []string
+	for _, volume := range volumes {
+		accessModes := volume.pvc.Status.AccessModes
+		if len(accessModes) == 0 {
+			accessModes = volume.pvc.Spec.AccessModes
+		}
+		if !storagetypes.HasSharedAccessMode(accessModes) {
+			storageReasons = append(storageReasons, fmt.Sprintf("volume %s uses the PVC %s which does not have the ReadWriteMany access mode", volume.name, volume.pvc.Name))
+		}
+	}
+	reasons = append(reasons, storageReasons...)
+
+	for _, condition := range vmi.Status.Conditions {
+		if condition.Type != v1.VirtualMachineInstanceIsMigratable || condition.Status != k8sv1.ConditionFalse {
+			continue
+		}
+		if condition.Reason == v1.VirtualMachineInstanceReasonDisksNotMigratable && len(storageReasons) > 0 {
+			continue
+		}
+		if condition.Message != "" {
+			reasons = append(reasons, condition.Message)
+		} else {
+			reasons = append(reasons, condition.Reason)
+		}
+	}
+	return reasons
+}
+
+// hasHostModelMigrationLabels mirrors the migration controller, which only derives the host-model
+// node selector from the source node if the VMI did not migrate before.
+func hasHostModelMigrationLabels(nodeSelector map[string]string) bool {
+	for key := range nodeSelector {
+		if strings.Contains(key, v1.CPUFeatureLabel) || strings.Contains(key, v1.SupportedHostModelMigrationCPU) {
+			return true
+		}
+	}
+	return false
+}
+
+func podRequests(pod *k8sv1.Pod) k8sv1.ResourceList {
+	requests := k8sv1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addResources(requests, container.Resources.Requests)
+	}
+	for _, container := range pod.Spec.InitContainers {
+		for name, quantity := range container.Resources.Requests {
+			if current, exists := requests[name]; !exists || quantity.Cmp(current) > 0 {
+				requests[name] = quantity.DeepCopy()
+			}
+		}
+	}
+	addResources(requests, pod.Spec.Overhead)
+	return requests
+}
+
+func addResources(total, resources k8sv1.ResourceList) {
+	for name, quantity := range resources {
+		sum := total[name]
+		sum.Add(quantity)
+		total[name] = sum
+	}
+}
+
+func availableResources(node *k8sv1.Node, requested k8sv1.ResourceList) k8sv1.ResourceList {
+	available := k8sv1.ResourceList{}
+	for name, allocatable := range node.Status.Allocatable {
+		left := allocatable.DeepCopy()
+		if quantity, exists := requested[name]; exists {
+			left.Sub(quantity)
+		}
+		available[name] = left
+	}
+	return available
+}
@@ -0,0 +1,464 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("MigrateCheck Subresource API", func() {
+	const (
+		sourceNode = "source-node"
+		vmiUID     = "test-vmi-uid"
+	)
+
+	var (
+		request    *restful.Request
+		recorder   *httptest.ResponseRecorder
+		response   *restful.Response
+		virtClient *kubecli.MockKubevirtClient
+		kubeClient *k8sfake.Clientset
+		kvClient   *fake.Clientset
+		policies   cache.Store
+		app        *SubresourceAPIApp
+	)
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		kubeClient = k8sfake.NewClientset(
+			&k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault}},
+			newMigrateCheckNode(sourceNode, "16Gi"),
+		)
+		// The fake clientset does not filter by field selectors
+		kubeClient.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			selector := action.(k8stesting.ListAction).GetListRestrictions().Fields
+			if selector.Empty() {
+				return false, nil, nil
+			}
+			obj, err := kubeClient.Tracker().List(k8sv1.SchemeGroupVersion.WithResource("pods"), k8sv1.SchemeGroupVersion.WithKind("Pod"), action.GetNamespace())
+			if err != nil {
+				return true, nil, err
+			}
+			podList := &k8sv1.PodList{}
+			for _, pod := range obj.(*k8sv1.PodList).Items {
+				if selector.Matches(fields.Set{"spec.nodeName": pod.Spec.NodeName, "status.phase": string(pod.Status.Phase)}) {
+					podList.Items = append(podList.Items, pod)
+				}
+			}
+			return true, podList, nil
+		})
+		kvClient = fake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(kvClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(kvClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		namespaces := cache.NewStore(cache.MetaNamespaceKeyFunc)
+		Expect(namespaces.Add(&k8sv1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault}})).To(Succeed())
+		policies = cache.NewStore(cache.MetaNamespaceKeyFunc)
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: "kubevirt"},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{DeveloperConfiguration: &v1.DeveloperConfiguration{}},
+			},
+			Status: v1.KubeVirtStatus{Phase: v1.KubeVirtPhaseDeployed},
+		})
+		app = NewSubresourceAPIApp(virtClient, 0, &tls.Config{InsecureSkipVerify: true}, config, namespaces, policies)
+	})
+
+	createVMAndVMI := func(phase v1.VirtualMachineInstancePhase, opts ...libvmi.Option) *v1.VirtualMachineInstance {
+		opts = append([]libvmi.Option{
+			libvmi.WithName(testVMName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmi.WithLabel("app", "test"),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(phase),
+				libvmistatus.WithNodeName(sourceNode),
+			)),
+		}, opts...)
+		vmi := libvmi.New(opts...)
+		vmi.UID = vmiUID
+
+		_, err := kvClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.Background(), libvmi.NewVirtualMachine(vmi), metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		vmi, err = kvClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.Background(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = kubeClient.CoreV1().Pods(metav1.NamespaceDefault).Create(context.Background(), newMigrateCheckSourcePod(vmi, "2Gi"), metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return vmi
+	}
+
+	createNode := func(node *k8sv1.Node) {
+		_, err := kubeClient.CoreV1().Nodes().Create(context.Background(), node, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	checkResult := func() *v1.MigrateCheckResult {
+		app.MigrateCheckRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		result := &v1.MigrateCheckResult{}
+		Expect(json.NewDecoder(recorder.Body).Decode(result)).To(Succeed())
+		return result
+	}
+
+	It("should fail when the VM is not running", func() {
+		createVMAndVMI(v1.Scheduled)
+		app.MigrateCheckRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+	})
+
+	It("should fail when the VM does not exist", func() {
+		app.MigrateCheckRequestHandler(request, response)
+		Expect(response.StatusCode()).To(Equal(http.StatusNotFound))
+	})
+
+	createPod := func(name, nodeName, memory string, phase k8sv1.PodPhase) {
+		vmi := libvmi.New(libvmi.WithName(name), libvmi.WithNamespace(metav1.NamespaceDefault), libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithNodeName(nodeName))))
+		pod := newMigrateCheckSourcePod(vmi, memory)
+		pod.Status.Phase = phase
+		_, err := kubeClient.CoreV1().Pods(metav1.NamespaceDefault).Create(context.Background(), pod, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("should rank the viable nodes by the memory left after the migration", func() {
+		createVMAndVMI(v1.Running)
+		createNode(newMigrateCheckNode("node-a", "8Gi"))
+		createNode(newMigrateCheckNode("node-b", "36Gi"))
+		cordoned := newMigrateCheckNode("node-c", "64Gi")
+		cordoned.Spec.Unschedulable = true
+		createNode(cordoned)
+		createPod("running", "node-b", "4Gi", k8sv1.PodRunning)
+		createPod("succeeded", "node-a", "4Gi", k8sv1.PodSucceeded)
+
+		result := checkResult()
+		Expect(result.Migratable).To(BeTrue())
+		Expect(result.Reasons).To(BeEmpty())
+		Expect(result.MigrationPolicyName).To(BeNil())
+		Expect(result.MigrationConfiguration).ToNot(BeNil())
+		Expect(result.Nodes).To(HaveLen(2))
+		Expect(result.Nodes[0].Name).To(Equal("node-b"))
+		Expect(result.Nodes[0].AvailableMemory.String()).To(Equal("30Gi"))
+		Expect(result.Nodes[1].Name).To(Equal("node-a"))
+		Expect(result.Nodes[1].AvailableMemory.String()).To(Equal("6Gi"))
+		Expect(result.RejectedNodes).To(ConsistOf(v1.MigrateCheckNode{Name: "node-c", Reasons: []string{"node is cordoned"}}))
+	})
+
+	It("should report the applicable migration policy", func() {
+		createVMAndVMI(v1.Running)
+		createNode(newMigrateCheckNode("node-a", "8Gi"))
+		policy := &migrationsv1.MigrationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-policy"},
+			Spec: migrationsv1.MigrationPolicySpec{
+				AllowPostCopy: pointer.P(true),
+				Selectors: &migrationsv1.Selectors{
+					VirtualMachineInstanceSelector: migrationsv1.LabelSelector{"app": "test"},
+				},
+			},
+		}
+		Expect(policies.Add(policy)).To(Succeed())
+
+		result := checkResult()
+		Expect(result.MigrationPolicyName).To(HaveValue(Equal("test-policy")))
+		Expect(result.MigrationConfiguration.AllowPostCopy).To(HaveValue(BeTrue()))
+	})
+
+	It("should not be migratable when a PVC does not have the ReadWriteMany access mode", func() {
+		createVMAndVMI(v1.Running, libvmi.WithPersistentVolumeClaim("disk0", "test-pvc"))
+		createNode(newMigrateCheckNode("node-a", "8Gi"))
+		_, err := kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Create(context.Background(), &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-pvc", Namespace: metav1.NamespaceDefault},
+			Spec:       k8sv1.PersistentVolumeClaimSpec{AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce}},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		result := checkResult()
+		Expect(result.Migratable).To(BeFalse())
+		Expect(result.Reasons).To(ConsistOf("volume disk0 uses the PVC test-pvc which does not have the ReadWriteMany access mode"))
+		Expect(result.Nodes).To(HaveLen(1))
+	})
+
+	It("should not be migratable without a viable node", func() {
+		createVMAndVMI(v1.Running)
+
+		result := checkResult()
+		Expect(result.Migratable).To(BeFalse())
+		Expect(result.Nodes).To(BeEmpty())
+	})
+
+	Context("evaluating a node", func() {
+		var (
+			vmi  *v1.VirtualMachineInstance
+			pod  *k8sv1.Pod
+			node *k8sv1.Node
+		)
+
+		BeforeEach(func() {
+			vmi = libvmi.New(libvmi.WithName(testVMName), libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithNodeName(sourceNode))))
+			pod = newMigrateCheckSourcePod(vmi, "2Gi")
+			node = newMigrateCheckNode(sourceNode, "16Gi")
+		})
+
+		evaluate := func(target *k8sv1.Node, addedNodeSelector map[string]string, volumes ...migrateCheckVolume) *v1.MigrateCheckResult {
+			result := &v1.MigrateCheckResult{}
+			noRequests := func(string) (k8sv1.ResourceList, error) { return nil, nil }
+			Expect(newMigrateCheck(vmi, pod, node, addedNodeSelector, volumes).evaluate([]k8sv1.Node{*node, *target}, noRequests, result)).To(Succeed())
+			return result
+		}
+
+		It("should skip the source node", func() {
+			result := evaluate(newMigrateCheckNode("node-a", "8Gi"), nil)
+			Expect(result.Nodes).To(HaveLen(1))
+			Expect(result.Nodes[0].Name).To(Equal("node-a"))
+			Expect(result.RejectedNodes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject the node", func(prepare func(target *k8sv1.Node), addedNodeSelector map[string]string, expectedReason string) {
+			target := newMigrateCheckNode("node-a", "8Gi")
+			prepare(target)
+
+			result := evaluate(target, addedNodeSelector)
+			Expect(result.Nodes).To(BeEmpty())
+			Expect(result.Migratable).To(BeFalse())
+			Expect(result.RejectedNodes).To(HaveLen(1))
+			Expect(result.RejectedNodes[0].Reasons).To(ContainElement(expectedReason))
+		},
+			Entry("when it is not schedulable for VMIs", func(target *k8sv1.Node) {
+				target.Labels[v1.NodeSchedulable] = "false"
+			}, nil, "node is not schedulable for VMIs"),
+			Entry("when it has an untolerated taint", func(target *k8sv1.Node) {
+				target.Spec.Taints = []k8sv1.Taint{{Key: "dedicated", Value: "infra", Effect: k8sv1.TaintEffectNoSchedule}}
+			}, nil, "node has the untolerated taint dedicated=infra:NoSchedule"),
+			Entry("when it does not match the added node selector", func(*k8sv1.Node) {}, map[string]string{"zone": "east"}, "node does not match the added node selector zone=east"),
+			Entry("when it does not have enough memory", func(target *k8sv1.Node) {
+				target.Status.Allocatable[k8sv1.ResourceMemory] = resource.MustParse("1Gi")
+			}, nil, "node does not have enough memory: requested 2Gi, available 1Gi"),
+		)
+
+		It("should only look up the requested resources of the nodes passing the node checks", func() {
+			cordoned := newMigrateCheckNode("node-b", "8Gi")
+			cordoned.Spec.Unschedulable = true
+			var lookedUp []string
+			requestedResources := func(nodeName string) (k8sv1.ResourceList, error) {
+				lookedUp = append(lookedUp, nodeName)
+				return k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse("7Gi")}, nil
+			}
+
+			result := &v1.MigrateCheckResult{}
+			Expect(newMigrateCheck(vmi, pod, node, nil, nil).evaluate([]k8sv1.Node{*node, *newMigrateCheckNode("node-a", "8Gi"), *cordoned}, requestedResources, result)).To(Succeed())
+			Expect(lookedUp).To(ConsistOf("node-a"))
+			Expect(result.RejectedNodes).To(ConsistOf(
+				v1.MigrateCheckNode{Name: "node-a", Reasons: []string{"node does not have enough memory: requested 2Gi, available 1Gi"}},
+				v1.MigrateCheckNode{Name: "node-b", Reasons: []string{"node is cordoned"}},
+			))
+		})
+
+		DescribeTable("should evaluate the node affinity of the VMI", func(requirement k8sv1.NodeSelectorRequirement, matchFields bool, expectedReasons []string) {
+			term := k8sv1.NodeSelectorTerm{MatchExpressions: []k8sv1.NodeSelectorRequirement{requirement}}
+			if matchFields {
+				term = k8sv1.NodeSelectorTerm{MatchFields: []k8sv1.NodeSelectorRequirement{requirement}}
+			}
+			pod.Spec.Affinity = &k8sv1.Affinity{NodeAffinity: &k8sv1.NodeAffinity{
+				RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{NodeSelectorTerms: []k8sv1.NodeSelectorTerm{term}},
+			}}
+			target := newMigrateCheckNode("node-a", "8Gi")
+			target.Labels["cores"] = "16"
+
+			result := evaluate(target, nil)
+			if expectedReasons == nil {
+				Expect(result.Nodes).To(HaveLen(1))
+				return
+			}
+			Expect(result.RejectedNodes).To(HaveLen(1))
+			Expect(result.RejectedNodes[0].Reasons).To(Equal(expectedReasons))
+		},
+			Entry("accepting a node with a matching label", k8sv1.NodeSelectorRequirement{Key: "cores", Operator: k8sv1.NodeSelectorOpGt, Values: []string{"8"}}, false, nil),
+			Entry("rejecting a node without a matching label", k8sv1.NodeSelectorRequirement{Key: "cores", Operator: k8sv1.NodeSelectorOpLt, Values: []string{"8"}}, false, []string{"node does not match the node affinity of the VMI"}),
+			Entry("accepting a node with a matching name", k8sv1.NodeSelectorRequirement{Key: "metadata.name", Operator: k8sv1.NodeSelectorOpIn, Values: []string{"node-a"}}, true, nil),
+			Entry("rejecting a node with another name", k8sv1.NodeSelectorRequirement{Key: "metadata.name", Operator: k8sv1.NodeSelectorOpNotIn, Values: []string{"node-a"}}, true, []string{"node does not match the node affinity of the VMI"}),
+			Entry("rejecting a node with an invalid affinity", k8sv1.NodeSelectorRequirement{Key: "cores", Operator: k8sv1.NodeSelectorOpGt, Values: []string{"a"}}, false, []string{"node does not match the node affinity of the VMI"}),
+		)
+
+		It("should accept a node with a tolerated taint", func() {
+			pod.Spec.Tolerations = []k8sv1.Toleration{{Key: "dedicated", Operator: k8sv1.TolerationOpExists}}
+			target := newMigrateCheckNode("node-a", "8Gi")
+			target.Spec.Taints = []k8sv1.Taint{{Key: "dedicated", Value: "infra", Effect: k8sv1.TaintEffectNoSchedule}}
+
+			Expect(evaluate(target, nil).Nodes).To(HaveLen(1))
+		})
+
+		It("should reject a node not supporting the CPU model of the VMI", func() {
+			pod.Spec.NodeSelector = map[string]string{v1.CPUModelLabel + "Skylake": "true", v1.CPUFeatureLabel + "vmx": "true"}
+			target := newMigrateCheckNode("node-a", "8Gi")
+			target.Labels[v1.CPUFeatureLabel+"vmx"] = "true"
+
+			result := evaluate(target, nil)
+			Expect(result.RejectedNodes).To(HaveLen(1))
+			Expect(result.RejectedNodes[0].Reasons).To(ConsistOf("node does not support the CPU model Skylake"))
+		})
+
+		It("should reject a node not supporting the host-model CPU of the source node", func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
+			node.Labels[v1.HostModelCPULabel+"Cascadelake"] = "true"
+			node.Labels[v1.HostModelRequiredFeaturesLabel+"avx512"] = "true"
+			target := newMigrateCheckNode("node-a", "8Gi")
+			target.Labels[v1.SupportedHostModelMigrationCPU+"Cascadelake"] = "true"
+
+			result := evaluate(target, nil)
+			Expect(result.RejectedNodes).To(HaveLen(1))
+			Expect(result.RejectedNodes[0].Reasons).To(ConsistOf("node does not support the CPU feature avx512"))
+		})
+
+		It("should reject a node with another CPU vendor", func() {
+			node.Labels[v1.CPUModelVendorLabel+"Intel"] = "true"
+			target := newMigrateCheckNode("node-a", "8Gi")
+			target.Labels[v1.CPUModelVendorLabel+"AMD"] = "true"
+
+			result := evaluate(target, nil)
+			Expect(result.RejectedNodes).To(HaveLen(1))
+			Expect(result.RejectedNodes[0].Reasons).To(ConsistOf("node does not have the Intel CPU vendor"))
+		})
+
+		It("should reject a node not providing a host device of the VMI", func() {
+			vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "dev", DeviceName: "vendor.com/device"}}
+			pod.Spec.Containers[0].Resources.Requests["vendor.com/device"] = resource.MustParse("1")
+
+			result := evaluate(newMigrateCheckNode("node-a", "8Gi"), nil)
+			Expect(result.RejectedNodes).To(HaveLen(1))
+			Expect(result.RejectedNodes[0].Reasons).To(ConsistOf("node does not provide the host device vendor.com/device"))
+		})
+
+		It("should reject a node without enough hugepages", func() {
+			pod.Spec.Containers[0].Resources.Requests[k8sv1.ResourceHugePagesPrefix+"2Mi"] = resource.MustParse("1Gi")
+			target := newMigrateCheckNode("node-a", "8Gi")
+			target.Status.Allocatable[k8sv1.ResourceHugePagesPrefix+"2Mi"] = resource.MustParse("512Mi")
+
+			result := evaluate(target, nil)
+			Expect(result.RejectedNodes).To(HaveLen(1))
+			Expect(result.RejectedNodes[0].Reasons).To(ConsistOf("node does not have enough hugepages-2Mi: requested 1Gi, available 512Mi"))
+		})
+
+		It("should reject a node not matching the node affinity of a persistent volume", func() {
+			volume := migrateCheckVolume{
+				name: "disk0",
+				pvc:  &k8sv1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "test-pvc"}},
+				pv: &k8sv1.PersistentVolume{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-pv"},
+					Spec: k8sv1.PersistentVolumeSpec{
+						NodeAffinity: &k8sv1.VolumeNodeAffinity{
+							Required: &k8sv1.NodeSelector{
+								NodeSelectorTerms: []k8sv1.NodeSelectorTerm{{
+									MatchExpressions: []k8sv1.NodeSelectorRequirement{{
+										Key:      "kubernetes.io/hostname",
+										Operator: k8sv1.NodeSelectorOpIn,
+										Values:   []string{sourceNode},
+									}},
+								}},
+							},
+						},
+					},
+				},
+			}
+
+			result := evaluate(newMigrateCheckNode("node-a", "8Gi"), nil, volume)
+			Expect(result.RejectedNodes).To(HaveLen(1))
+			Expect(result.RejectedNodes[0].Reasons).To(ConsistOf("node can not access the persistent volume test-pv of volume disk0"))
+		})
+	})
+})
+
+func newMigrateCheckNode(name, memory string) *k8sv1.Node {
+	return &k8sv1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Labels: map[string]string{
+				v1.NodeSchedulable:       "true",
+				"kubernetes.io/hostname": name,
+			},
+		},
+		Status: k8sv1.NodeStatus{
+			Allocatable: k8sv1.ResourceList{
+				k8sv1.ResourceCPU:    resource.MustParse("8"),
+				k8sv1.ResourceMemory: resource.MustParse(memory),
+			},
+		},
+	}
+}
+
+func newMigrateCheckSourcePod(vmi *v1.VirtualMachineInstance, memory string) *k8sv1.Pod {
+	return &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "virt-launcher-" + vmi.Name,
+			Namespace: vmi.Namespace,
+			Labels: map[string]string{
+				v1.AppLabel:       "virt-launcher",
+				v1.CreatedByLabel: string(vmi.UID),
+			},
+		},
+		Spec: k8sv1.PodSpec{
+			NodeName: vmi.Status.NodeName,
+			Containers: []k8sv1.Container{{
+				Name: "compute",
+				Resources: k8sv1.ResourceRequirements{
+					Requests: k8sv1.ResourceList{
+						k8sv1.ResourceCPU:    resource.MustParse("1"),
+						k8sv1.ResourceMemory: resource.MustParse(memory),
+					},
+				},
+			}},
+		},
+		Status: k8sv1.PodStatus{Phase: k8sv1.PodRunning},
+	}
+}
@@ -23,11 +23,13 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
+	"encoding/binary"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 	"time"
@@ -1134,6 +1136,104 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("Changed blocks", func() {
+		changedBlocks := v1.ChangedBlocksList{
+			FromCheckpoint: "checkpoint-1",
+			Disks: []v1.DiskChangedBlocks{{
+				Name: "disk0",
+				Size: 1048576,
+				Extents: []v1.ChangedBlockExtent{
+					{Offset: 0, Length: 65536},
+					{Offset: 524288, Length: 4096},
+				},
+			}},
+		}
+
+		cbtEnabled := func(vmi *v1.VirtualMachineInstance) {
+			vmi.Status.ChangedBlockTracking = &v1.ChangedBlockTrackingStatus{State: v1.ChangedBlockTrackingEnabled}
+		}
+
+		withQuery := func(query url.Values) {
+			request.Request.URL = &url.URL{RawQuery: query.Encode()}
+		}
+
+		It("should return the changed blocks as JSON", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/changedblocks", "fromCheckpoint=checkpoint-1"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, changedBlocks),
+				),
+			)
+			withQuery(url.Values{"fromCheckpoint": {"checkpoint-1"}})
+			expectVMI(Running, UnPaused, cbtEnabled)
+			response.SetRequestAccepts(restful.MIME_JSON)
+
+			app.ChangedBlocksRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			result := v1.ChangedBlocksList{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), &result)).To(Succeed())
+			Expect(result).To(Equal(changedBlocks))
+		})
+
+		It("should return the changed blocks of a disk as binary", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/changedblocks", "disk=disk0&fromCheckpoint=checkpoint-1&toCheckpoint=checkpoint-2"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, changedBlocks),
+				),
+			)
+			withQuery(url.Values{
+				"fromCheckpoint": {"checkpoint-1"},
+				"toCheckpoint":   {"checkpoint-2"},
+				"disk":           {"disk0"},
+				"format":         {"binary"},
+			})
+			expectVMI(Running, UnPaused, cbtEnabled)
+
+			app.ChangedBlocksRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal(restful.MIME_OCTET))
+			expected := []byte{}
+			for _, value := range []uint64{0, 65536, 524288, 4096} {
+				expected = binary.BigEndian.AppendUint64(expected, value)
+			}
+			Expect(recorder.Body.Bytes()).To(Equal(expected))
+		})
+
+		DescribeTable("should reject invalid options", func(query url.Values) {
+			withQuery(query)
+
+			app.ChangedBlocksRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			Entry("without the checkpoint to report the changes from", url.Values{}),
+			Entry("with the binary format for all the disks", url.Values{"fromCheckpoint": {"checkpoint-1"}, "format": {"binary"}}),
+			Entry("with an unknown format", url.Values{"fromCheckpoint": {"checkpoint-1"}, "format": {"xml"}}),
+		)
+
+		It("should fail when the VMI is not running", func() {
+			withQuery(url.Values{"fromCheckpoint": {"checkpoint-1"}})
+			expectVMI(NotRunning, UnPaused, cbtEnabled)
+
+			app.ChangedBlocksRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		It("should fail when changed block tracking is not enabled", func() {
+			withQuery(url.Values{"fromCheckpoint": {"checkpoint-1"}})
+			expectVMI(Running, UnPaused)
+
+			app.ChangedBlocksRequestHandler(request, response)
+
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+			Expect(statusErr.Error()).To(ContainSubstring(changedBlocksNotEnabledErr))
+		})
+	})
+
 	Context("Freezing", func() {
 		It("Should freeze a running VMI", func() {
 
@@ -33,6 +33,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	migrationsutil "kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 )
 
@@ -87,6 +88,9 @@ func (admitter *MigrationPolicyAdmitter) Admit(_ context.Context, ar *admissionv
 		}
 	}
 
+	causes = append(causes, migrationsutil.ValidateCompression(sourceField.Child("compression"), spec.Compression, spec.AllowPostCopy)...)
+	causes = append(causes, migrationsutil.ValidateMaintenanceWindows(sourceField.Child("maintenanceWindows"), spec.MaintenanceWindows)...)
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -25,6 +25,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations"
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
@@ -64,6 +65,46 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 		Entry("negative CompletionTimeoutPerGiB",
 			migrationsv1.MigrationPolicySpec{CompletionTimeoutPerGiB: pointer.P(int64(-1))},
 		),
+
+		Entry("unsupported compression method",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: "lz4"}},
+		),
+
+		Entry("out of range zlib compression level",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZlib, Level: pointer.P(int32(10))}},
+		),
+
+		Entry("out of range zstd compression level",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.P(int32(0))}},
+		),
+
+		Entry("xbzrle compression level",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE, Level: pointer.P(int32(1))}},
+		),
+
+		Entry("zstd xbzrle cache size",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, XBZRLECacheSize: resource.NewQuantity(1024, resource.BinarySI)}},
+		),
+
+		Entry("zlib compression with post-copy",
+			migrationsv1.MigrationPolicySpec{AllowPostCopy: pointer.P(true), Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZlib}},
+		),
+
+		Entry("invalid maintenance window schedule",
+			migrationsv1.MigrationPolicySpec{MaintenanceWindows: []v1.MaintenanceWindow{{Schedule: "* 25 * * *"}}},
+		),
+
+		Entry("maintenance window schedule with a descriptor",
+			migrationsv1.MigrationPolicySpec{MaintenanceWindows: []v1.MaintenanceWindow{{Schedule: "@daily"}}},
+		),
+
+		Entry("maintenance window schedule with a time zone prefix",
+			migrationsv1.MigrationPolicySpec{MaintenanceWindows: []v1.MaintenanceWindow{{Schedule: "CRON_TZ=Europe/Paris * 0-5 * * *"}}},
+		),
+
+		Entry("unknown maintenance window time zone",
+			migrationsv1.MigrationPolicySpec{MaintenanceWindows: []v1.MaintenanceWindow{{Schedule: "* 0-5 * * *", TimeZone: pointer.P("Mars/Olympus_Mons")}}},
+		),
 	)
 
 	DescribeTable("should accept migration policy with", func(policySpec migrationsv1.MigrationPolicySpec) {
@@ -90,6 +131,28 @@ var _ = Describe("Validating MigrationPolicy Admitter", func() {
 			migrationsv1.MigrationPolicySpec{BandwidthPerMigration: resource.NewScaledQuantity(0, 1)},
 		),
 
+		Entry("zstd compression with a level",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.P(int32(20))}},
+		),
+
+		Entry("xbzrle compression with a cache size",
+			migrationsv1.MigrationPolicySpec{Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE, XBZRLECacheSize: resource.NewQuantity(1024, resource.BinarySI)}},
+		),
+
+		Entry("xbzrle compression with post-copy",
+			migrationsv1.MigrationPolicySpec{AllowPostCopy: pointer.P(true), Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE}},
+		),
+
+		Entry("maintenance windows",
+			migrationsv1.MigrationPolicySpec{
+				MaintenanceWindows: []v1.MaintenanceWindow{
+					{Schedule: "* 0-5 * * *", TimeZone: pointer.P("Europe/Paris")},
+					{Schedule: "* * * * 0,6"},
+				},
+				AllowNodeDrainOutsideMaintenanceWindows: pointer.P(false),
+			},
+		),
+
 		Entry("empty spec",
 			migrationsv1.MigrationPolicySpec{},
 		),
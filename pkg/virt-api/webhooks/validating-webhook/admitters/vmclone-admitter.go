@@ -28,12 +28,17 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/link"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
 
 	clonebase "kubevirt.io/api/clone"
 	clone "kubevirt.io/api/clone/v1beta1"
+	"kubevirt.io/api/core"
+	"kubevirt.io/api/snapshot"
 	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -104,6 +109,10 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ctx context.Context, ar *admi
 		causes = append(causes, newCauses...)
 	}
 
+	if newCauses := validateCrossNamespaceAccess(ctx, admitter.Client, ar.Request.UserInfo, vmClone); newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
 	if newCauses := validateNewMacAddresses(vmClone); newCauses != nil {
 		causes = append(causes, newCauses...)
 	}
@@ -233,6 +242,7 @@ func validateTarget(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 
 	if source != nil &&
 		target != nil &&
+		(vmClone.Spec.TargetNamespace == "" || vmClone.Spec.TargetNamespace == vmClone.Namespace) &&
 		source.Kind == virtualMachineKind &&
 		target.Kind == virtualMachineKind &&
 		target.Name == source.Name {
@@ -246,6 +256,99 @@ func validateTarget(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	return causes
 }
 
+// validateCrossNamespaceAccess verifies that the requester could create the target by itself. The clone is
+// executed by virt-controller, so without this check a user could read VMs of any namespace through a clone.
+func validateCrossNamespaceAccess(ctx context.Context, client kubecli.KubevirtClient, userInfo authenticationv1.UserInfo,
+	vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
+	targetNamespace := vmClone.Spec.TargetNamespace
+	if targetNamespace == "" || targetNamespace == vmClone.Namespace || vmClone.Spec.Source == nil {
+		return nil
+	}
+
+	field := k8sfield.NewPath("spec").Child("targetNamespace").String()
+	createSar := func(sar *authv1.SubjectAccessReview) (*authv1.SubjectAccessReview, error) {
+		return client.AuthorizationV1().SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+	}
+	newCause := func(message string) []metav1.StatusCause {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: message,
+			Field:   field,
+		}}
+	}
+
+	accessChecks := []authv1.ResourceAttributes{
+		{
+			Namespace: targetNamespace,
+			Verb:      "create",
+			Group:     core.GroupName,
+			Resource:  "virtualmachines",
+		},
+	}
+	switch vmClone.Spec.Source.Kind {
+	case virtualMachineKind:
+		accessChecks = append(accessChecks, authv1.ResourceAttributes{
+			Namespace: vmClone.Namespace,
+			Verb:      "get",
+			Group:     core.GroupName,
+			Resource:  "virtualmachines",
+			Name:      vmClone.Spec.Source.Name,
+		})
+	case virtualMachineSnapshotKind:
+		accessChecks = append(accessChecks, authv1.ResourceAttributes{
+			Namespace: vmClone.Namespace,
+			Verb:      "get",
+			Group:     snapshot.GroupName,
+			Resource:  "virtualmachinesnapshots",
+			Name:      vmClone.Spec.Source.Name,
+		})
+	}
+
+	for i := range accessChecks {
+		sar := newSubjectAccessReview(userInfo, &accessChecks[i])
+		response, err := createSar(sar)
+		if err != nil {
+			return newCause(fmt.Sprintf("failed to authorize the clone to namespace %s: %v", targetNamespace, err))
+		}
+		if !response.Status.Allowed {
+			return newCause(fmt.Sprintf("user %s is not allowed to %s %s in namespace %s",
+				userInfo.Username, accessChecks[i].Verb, accessChecks[i].Resource, accessChecks[i].Namespace))
+		}
+	}
+
+	// The volumes are copied with cross-namespace DataVolume clones from the snapshot, which require the same
+	// permissions CDI asks for when a user creates such a DataVolume
+	allowed, reason, err := cdiv1.CanUserCloneSnapshot(createSar, vmClone.Namespace, "", targetNamespace, userInfo)
+	if err != nil {
+		return newCause(fmt.Sprintf("failed to authorize the clone to namespace %s: %v", targetNamespace, err))
+	}
+	if !allowed {
+		return newCause(fmt.Sprintf("user %s is not allowed to clone volumes from namespace %s: %s", userInfo.Username, vmClone.Namespace, reason))
+	}
+
+	return nil
+}
+
+func newSubjectAccessReview(userInfo authenticationv1.UserInfo, resourceAttributes *authv1.ResourceAttributes) *authv1.SubjectAccessReview {
+	var extra map[string]authv1.ExtraValue
+	if len(userInfo.Extra) > 0 {
+		extra = make(map[string]authv1.ExtraValue, len(userInfo.Extra))
+		for k, v := range userInfo.Extra {
+			extra[k] = authv1.ExtraValue(v)
+		}
+	}
+
+	return &authv1.SubjectAccessReview{
+		Spec: authv1.SubjectAccessReviewSpec{
+			User:               userInfo.Username,
+			Groups:             userInfo.Groups,
+			UID:                userInfo.UID,
+			Extra:              extra,
+			ResourceAttributes: resourceAttributes,
+		},
+	}
+}
+
 func validateNewMacAddresses(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 
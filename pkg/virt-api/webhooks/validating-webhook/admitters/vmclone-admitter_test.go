@@ -32,10 +32,12 @@ import (
 
 	"go.uber.org/mock/gomock"
 	admissionv1 "k8s.io/api/admission/v1"
+	authv1 "k8s.io/api/authorization/v1"
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/rand"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/tools/cache"
 
 	clonebase "kubevirt.io/api/clone"
@@ -290,6 +292,52 @@ var _ = Describe("Validating VirtualMachineClone Admitter", func() {
 		})
 	})
 
+	Context("with a target namespace", func() {
+		const targetNamespace = "target-ns"
+
+		var deniedResources map[string]bool
+
+		BeforeEach(func() {
+			deniedResources = map[string]bool{}
+			k8sClient := k8sfake.NewSimpleClientset()
+			k8sClient.Fake.PrependReactor("create", "subjectaccessreviews", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				sar := action.(testing.CreateAction).GetObject().(*authv1.SubjectAccessReview)
+				attributes := sar.Spec.ResourceAttributes
+				sar.Status.Allowed = !deniedResources[attributes.Resource+"/"+attributes.Subresource]
+				return true, sar, nil
+			})
+			virtClient.EXPECT().AuthorizationV1().Return(k8sClient.AuthorizationV1()).AnyTimes()
+
+			vmClone.Spec.TargetNamespace = targetNamespace
+		})
+
+		It("should allow the clone if the requester has access to both namespaces", func() {
+			admitter.admitAndExpect(vmClone, true)
+		})
+
+		It("should allow a target with the same name as the source", func() {
+			vmClone.Spec.Target.Name = vmClone.Spec.Source.Name
+			admitter.admitAndExpect(vmClone, true)
+		})
+
+		DescribeTable("should reject the clone if the requester is not allowed to", func(kind string, denied ...string) {
+			vmClone.Spec.Source.Kind = kind
+			for _, resource := range denied {
+				deniedResources[resource] = true
+			}
+			admitter.admitAndExpect(vmClone, false)
+		},
+			Entry("create VMs in the target namespace", virtualMachineKind, "virtualmachines/"),
+			Entry("read the source snapshot", virtualMachineSnapshotKind, "virtualmachinesnapshots/"),
+			Entry("clone DataVolumes from the source namespace", virtualMachineKind, "datavolumes/source", "pods/"),
+		)
+
+		It("should allow cloning DataVolumes with the implicit permissions CDI accepts", func() {
+			deniedResources["datavolumes/source"] = true
+			admitter.admitAndExpect(vmClone, true)
+		})
+	})
+
 	It("Should reject if snapshot feature gate is not enabled", func() {
 		disableFeatureGates()
 		admitter.admitAndExpect(vmClone, false)
@@ -27,6 +27,7 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	k8sv1 "k8s.io/api/core/v1"
@@ -43,6 +44,7 @@ import (
 	netadmitter "kubevirt.io/kubevirt/pkg/network/admitter"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
 	storageadmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 
@@ -217,9 +219,12 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateDomainSpec(field.Child("domain"), &spec.Domain)...)
 	causes = append(causes, validateVolumes(field.Child("volumes"), spec.Volumes, config)...)
 	causes = append(causes, storageadmitters.ValidateContainerDisks(field, spec)...)
+	causes = append(causes, storageadmitters.ValidateDiskEncryption(field, spec, config)...)
+	causes = append(causes, storageadmitters.ValidateContainerDiskPersistentOverlays(field, spec, config)...)
 	causes = append(causes, storageadmitters.ValidateUtilityVolumesNotPresentOnCreation(field, spec)...)
 
 	causes = append(causes, validateAccessCredentials(field.Child("accessCredentials"), spec.AccessCredentials, spec.Volumes)...)
+	causes = append(causes, validateFreezeHooks(field.Child("freezeHooks"), spec.FreezeHooks, spec.Volumes)...)
 
 	if spec.DNSPolicy != "" {
 		causes = append(causes, validateDNSPolicy(&spec.DNSPolicy, field.Child("dnsPolicy"))...)
@@ -236,6 +241,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateFilesystemsWithVirtIOFSEnabled(field, spec, config)...)
 	causes = append(causes, validateVideoConfig(field, spec, config)...)
 	causes = append(causes, validatePanicDevices(field, spec, config)...)
+	causes = append(causes, validateCrashPolicy(field, spec)...)
 
 	return causes
 }
@@ -1552,6 +1558,111 @@ func validateAccessCredentials(field *k8sfield.Path, accessCredentials []v1.Acce
 	return causes
 }
 
+func validateFreezeHooks(field *k8sfield.Path, freezeHooks *v1.FreezeHooks, volumes []v1.Volume) []metav1.StatusCause {
+	if freezeHooks == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	causes = append(causes, validateFreezeHookList(field.Child("preFreeze"), freezeHooks.PreFreeze, volumes)...)
+	causes = append(causes, validateFreezeHookList(field.Child("postThaw"), freezeHooks.PostThaw, volumes)...)
+	return causes
+}
+
+func validateFreezeHookList(field *k8sfield.Path, hooks []v1.FreezeHook, volumes []v1.Volume) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	nameMap := make(map[string]int)
+
+	for idx, hook := range hooks {
+		if hook.Name == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must have a name", field.Index(idx).String()),
+				Field:   field.Index(idx).Child("name").String(),
+			})
+		} else if otherIdx, ok := nameMap[hook.Name]; ok {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s and %s must not have the same name", field.Index(idx).String(), field.Index(otherIdx).String()),
+				Field:   field.Index(idx).Child("name").String(),
+			})
+		} else {
+			nameMap[hook.Name] = idx
+		}
+
+		if (hook.Exec == nil) == (hook.Script == nil) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must have exactly one of exec or script set", field.Index(idx).String()),
+				Field:   field.Index(idx).String(),
+			})
+		}
+
+		if hook.Exec != nil && (len(hook.Exec.Command) == 0 || hook.Exec.Command[0] == "") {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must have a command", field.Index(idx).Child("exec").String()),
+				Field:   field.Index(idx).Child("exec", "command").String(),
+			})
+		}
+
+		if hook.Script != nil {
+			causes = append(causes, validateFreezeHookScript(field.Index(idx).Child("script"), hook.Script, volumes)...)
+		}
+
+		if hook.TimeoutSeconds != nil && *hook.TimeoutSeconds <= 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must be greater than zero", field.Index(idx).Child("timeoutSeconds").String()),
+				Field:   field.Index(idx).Child("timeoutSeconds").String(),
+			})
+		}
+	}
+
+	if storagetypes.FreezeHooksTimeout(hooks) > time.Duration(storagetypes.MaxFreezeHooksTimeoutSeconds)*time.Second {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("the timeouts of %s must not add up to more than %d seconds", field.String(), storagetypes.MaxFreezeHooksTimeoutSeconds),
+			Field:   field.String(),
+		})
+	}
+
+	return causes
+}
+
+func validateFreezeHookScript(field *k8sfield.Path, script *v1.FreezeHookScript, volumes []v1.Volume) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	if script.Key == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must have a key", field.String()),
+			Field:   field.Child("key").String(),
+		})
+	}
+
+	for _, volume := range volumes {
+		if volume.Name != script.VolumeName {
+			continue
+		}
+		if volume.ConfigMap == nil && volume.Secret == nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must reference a configMap or secret volume", field.String()),
+				Field:   field.Child("volumeName").String(),
+			})
+		}
+		return causes
+	}
+
+	causes = append(causes, metav1.StatusCause{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Message: fmt.Sprintf("%s references volume %q which does not exist", field.String(), script.VolumeName),
+		Field:   field.Child("volumeName").String(),
+	})
+	return causes
+}
+
 func validateVolumes(field *k8sfield.Path, volumes []v1.Volume, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	nameMap := make(map[string]int)
@@ -2063,3 +2174,42 @@ func validatePanicDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 
 	return causes
 }
+
+func validateCrashPolicy(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) []metav1.StatusCause {
+	if spec.CrashPolicy == nil || spec.CrashPolicy.MemoryDump == nil {
+		return nil
+	}
+
+	var causes []metav1.StatusCause
+	memoryDumpField := field.Child("crashPolicy", "memoryDump")
+	memoryDump := spec.CrashPolicy.MemoryDump
+	if len(spec.Domain.Devices.PanicDevices) == 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires a panic device to be notified of guest panics", memoryDumpField.String()),
+			Field:   memoryDumpField.String(),
+		})
+	}
+	if memoryDump.ClaimName == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must have a claimName", memoryDumpField.String()),
+			Field:   memoryDumpField.Child("claimName").String(),
+		})
+	}
+	if !memorydump.IsValidFormat(memoryDump.Format) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s is not a supported memory dump format", memoryDump.Format),
+			Field:   memoryDumpField.Child("format").String(),
+		})
+	}
+	if memoryDump.MaxDumps != nil && *memoryDump.MaxDumps < 1 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must retain at least one dump", memoryDumpField.Child("maxDumps").String()),
+			Field:   memoryDumpField.Child("maxDumps").String(),
+		})
+	}
+	return causes
+}
@@ -1732,6 +1732,160 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with FreezeHooks", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes,
+				v1.Volume{
+					Name: "hooks",
+					VolumeSource: v1.VolumeSource{
+						ConfigMap: &v1.ConfigMapVolumeSource{
+							LocalObjectReference: k8sv1.LocalObjectReference{Name: "hooks"},
+						},
+					},
+				},
+				v1.Volume{
+					Name: "empty",
+					VolumeSource: v1.VolumeSource{
+						EmptyDisk: &v1.EmptyDiskSource{Capacity: resource.MustParse("1Gi")},
+					},
+				},
+			)
+		})
+
+		It("should accept valid exec and script hooks", func() {
+			vmi.Spec.FreezeHooks = &v1.FreezeHooks{
+				PreFreeze: []v1.FreezeHook{{
+					Name: "flush",
+					Exec: &v1.FreezeHookExec{Command: []string{"/usr/bin/flush-db"}},
+				}},
+				PostThaw: []v1.FreezeHook{{
+					Name:           "resume",
+					Script:         &v1.FreezeHookScript{VolumeName: "hooks", Key: "post-thaw.sh"},
+					TimeoutSeconds: pointer.P(int32(20)),
+				}},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should reject", func(hook v1.FreezeHook, expectedField string) {
+			vmi.Spec.FreezeHooks = &v1.FreezeHooks{
+				PreFreeze: []v1.FreezeHook{hook},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("a hook without a name",
+				v1.FreezeHook{Exec: &v1.FreezeHookExec{Command: []string{"sync"}}},
+				"fake.freezeHooks.preFreeze[0].name"),
+			Entry("a hook without exec or script",
+				v1.FreezeHook{Name: "hook"},
+				"fake.freezeHooks.preFreeze[0]"),
+			Entry("a hook with both exec and script",
+				v1.FreezeHook{
+					Name:   "hook",
+					Exec:   &v1.FreezeHookExec{Command: []string{"sync"}},
+					Script: &v1.FreezeHookScript{VolumeName: "hooks", Key: "hook.sh"},
+				},
+				"fake.freezeHooks.preFreeze[0]"),
+			Entry("an exec hook without a command",
+				v1.FreezeHook{Name: "hook", Exec: &v1.FreezeHookExec{}},
+				"fake.freezeHooks.preFreeze[0].exec.command"),
+			Entry("a script hook without a key",
+				v1.FreezeHook{Name: "hook", Script: &v1.FreezeHookScript{VolumeName: "hooks"}},
+				"fake.freezeHooks.preFreeze[0].script.key"),
+			Entry("a script hook referencing a missing volume",
+				v1.FreezeHook{Name: "hook", Script: &v1.FreezeHookScript{VolumeName: "missing", Key: "hook.sh"}},
+				"fake.freezeHooks.preFreeze[0].script.volumeName"),
+			Entry("a script hook referencing a volume which is not a configMap or secret",
+				v1.FreezeHook{Name: "hook", Script: &v1.FreezeHookScript{VolumeName: "empty", Key: "hook.sh"}},
+				"fake.freezeHooks.preFreeze[0].script.volumeName"),
+			Entry("a hook with a non positive timeout",
+				v1.FreezeHook{Name: "hook", Exec: &v1.FreezeHookExec{Command: []string{"sync"}}, TimeoutSeconds: pointer.P(int32(0))},
+				"fake.freezeHooks.preFreeze[0].timeoutSeconds"),
+			Entry("a hook with a timeout above the total limit",
+				v1.FreezeHook{Name: "hook", Exec: &v1.FreezeHookExec{Command: []string{"sync"}}, TimeoutSeconds: pointer.P(int32(60))},
+				"fake.freezeHooks.preFreeze"),
+		)
+
+		It("should reject hooks whose timeouts add up above the limit", func() {
+			vmi.Spec.FreezeHooks = &v1.FreezeHooks{
+				PreFreeze: []v1.FreezeHook{
+					{Name: "flush", Exec: &v1.FreezeHookExec{Command: []string{"sync"}}},
+					{Name: "checkpoint", Exec: &v1.FreezeHookExec{Command: []string{"sync"}}, TimeoutSeconds: pointer.P(int32(5))},
+				},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.freezeHooks.preFreeze"))
+			Expect(causes[0].Message).To(ContainSubstring("must not add up to more than 30 seconds"))
+		})
+
+		It("should reject hooks with the same name", func() {
+			hook := v1.FreezeHook{
+				Name:           "hook",
+				Exec:           &v1.FreezeHookExec{Command: []string{"sync"}},
+				TimeoutSeconds: pointer.P(int32(5)),
+			}
+			vmi.Spec.FreezeHooks = &v1.FreezeHooks{
+				PostThaw: []v1.FreezeHook{hook, hook},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.freezeHooks.postThaw[1].name"))
+		})
+	})
+
+	Context("with a crash policy", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = libvmi.New(libvmi.WithPanicDevice(v1.Pvpanic))
+		})
+
+		It("should accept a memory dump to a pvc", func() {
+			vmi.Spec.CrashPolicy = &v1.CrashPolicy{
+				MemoryDump: &v1.CrashMemoryDump{
+					ClaimName: "crash-pvc",
+					Format:    v1.MemoryDumpFormatKdumpZlib,
+					MaxDumps:  pointer.P(int32(3)),
+				},
+			}
+			Expect(validateCrashPolicy(k8sfield.NewPath("fake"), &vmi.Spec)).To(BeEmpty())
+		})
+
+		It("should reject a memory dump without a panic device", func() {
+			vmi.Spec.Domain.Devices.PanicDevices = nil
+			vmi.Spec.CrashPolicy = &v1.CrashPolicy{
+				MemoryDump: &v1.CrashMemoryDump{ClaimName: "crash-pvc"},
+			}
+			causes := validateCrashPolicy(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.crashPolicy.memoryDump"))
+		})
+
+		DescribeTable("should reject", func(memoryDump v1.CrashMemoryDump, expectedField string) {
+			vmi.Spec.CrashPolicy = &v1.CrashPolicy{MemoryDump: &memoryDump}
+			causes := validateCrashPolicy(k8sfield.NewPath("fake"), &vmi.Spec)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("a memory dump without a claim name",
+				v1.CrashMemoryDump{},
+				"fake.crashPolicy.memoryDump.claimName"),
+			Entry("a memory dump with an unknown format",
+				v1.CrashMemoryDump{ClaimName: "crash-pvc", Format: "unknown"},
+				"fake.crashPolicy.memoryDump.format"),
+			Entry("a memory dump retaining no dumps",
+				v1.CrashMemoryDump{ClaimName: "crash-pvc", MaxDumps: pointer.P(int32(0))},
+				"fake.crashPolicy.memoryDump.maxDumps"),
+		)
+	})
+
 	Context("with AccessCredentials", func() {
 		var vmi *v1.VirtualMachineInstance
 
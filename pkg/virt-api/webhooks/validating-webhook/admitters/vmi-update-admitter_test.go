@@ -486,6 +486,50 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			},
 			BeFalse()))
 
+	DescribeTable("should admit changes of a hotplugged memory dump volume", func(updateVolume func(*v1.MemoryDumpVolumeSource), expectAllowed bool) {
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{}
+		vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+			Name: "memorydump",
+			VolumeSource: v1.VolumeSource{
+				MemoryDump: testutils.NewFakeMemoryDumpSource("memorydump"),
+			},
+		})
+		vmi.Status.VolumeStatus = []v1.VolumeStatus{{
+			Name:             "memorydump",
+			HotplugVolume:    &v1.HotplugVolumeStatus{AttachPodName: "hp-volume-pod"},
+			MemoryDumpVolume: &v1.DomainMemoryDumpInfo{ClaimName: "memorydump"},
+			Phase:            v1.MemoryDumpVolumeCompleted,
+		}}
+		updateVmi := vmi.DeepCopy()
+		updateVolume(updateVmi.Spec.Volumes[0].MemoryDump)
+
+		newVMIBytes, _ := json.Marshal(&updateVmi)
+		oldVMIBytes, _ := json.Marshal(&vmi)
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				UserInfo: authv1.UserInfo{Username: "system:serviceaccount:kubevirt:" + components.ControllerServiceAccountName},
+				Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: newVMIBytes,
+				},
+				OldObject: runtime.RawExtension{
+					Raw: oldVMIBytes,
+				},
+				Operation: admissionv1.Update,
+			},
+		}
+		resp := vmiUpdateAdmitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(Equal(expectAllowed))
+	},
+		Entry("allow a new dump format", func(memoryDump *v1.MemoryDumpVolumeSource) {
+			memoryDump.Format = v1.MemoryDumpFormatKdumpZlib
+		}, true),
+		Entry("reject a new claim", func(memoryDump *v1.MemoryDumpVolumeSource) {
+			memoryDump.ClaimName = "other"
+		}, false),
+	)
+
 	It("should reject updates to maxGuest", func() {
 		vmi := api.NewMinimalVMI("testvmi")
 		vmi.Spec.Domain.CPU = &v1.CPU{}
@@ -220,3 +220,15 @@ func (config *ClusterConfig) IncrementalBackupEnabled() bool {
 func (config *ClusterConfig) MigrationPriorityQueueEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.MigrationPriorityQueue)
 }
+
+func (config *ClusterConfig) MemorySnapshotEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.MemorySnapshotGate)
+}
+
+func (config *ClusterConfig) DiskEncryptionEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.DiskEncryptionGate)
+}
+
+func (config *ClusterConfig) ContainerDiskPersistentOverlayEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.ContainerDiskPersistentOverlayGate)
+}
@@ -151,6 +151,28 @@ const (
 	// Alpha: v1.7.0
 	//
 	MigrationPriorityQueue = "MigrationPriorityQueue"
+
+	// Owner: sig-storage
+	// Alpha: v1.7.0
+	//
+	// MemorySnapshot allows VirtualMachineSnapshots to include the memory and device state
+	// of a running VM, and VirtualMachineRestores to resume the guest from that state.
+	// Saving the memory state relies on utility volumes.
+	MemorySnapshotGate = "MemorySnapshot"
+
+	// Owner: sig-storage
+	// Alpha: v1.7.0
+	//
+	// DiskEncryption allows disks to be encrypted with LUKS by QEMU, using a passphrase
+	// stored in a Kubernetes Secret.
+	DiskEncryptionGate = "DiskEncryption"
+
+	// Owner: sig-storage
+	// Alpha: v1.7.0
+	//
+	// ContainerDiskPersistentOverlay allows the writable overlay of a containerDisk volume to be
+	// stored on a PVC, so that guest changes are kept across restarts and migrations.
+	ContainerDiskPersistentOverlayGate = "ContainerDiskPersistentOverlay"
 )
 
 func init() {
@@ -187,4 +209,7 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: PasstIPStackMigration, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: IncrementalBackupGate, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: MigrationPriorityQueue, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: MemorySnapshotGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: DiskEncryptionGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: ContainerDiskPersistentOverlayGate, State: Alpha})
 }
@@ -21,6 +21,8 @@ import (
 	hostdisk "kubevirt.io/kubevirt/pkg/host-disk"
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/encryption"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -488,6 +490,99 @@ func withBackendStorage(vmi *v1.VirtualMachineInstance, backendStoragePVCName st
 	}
 }
 
+func withMemoryStateRestore(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		claimName, exists := memorydump.MemoryStateRestoreClaimName(vmi)
+		// the memory state is only needed to start the domain, not
+		// on migration targets of an already running vmi
+		if !exists || vmi.IsRunning() {
+			return nil
+		}
+
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: memorydump.MemoryStateRestoreVolumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: claimName,
+					ReadOnly:  true,
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      memorydump.MemoryStateRestoreVolumeName,
+			ReadOnly:  true,
+			MountPath: memorydump.MemoryStateRestoreDir,
+		})
+		return nil
+	}
+}
+
+func withCrashMemoryDump(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		crashMemoryDump := memorydump.CrashMemoryDump(vmi)
+		if crashMemoryDump == nil {
+			return nil
+		}
+
+		renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+			Name: memorydump.CrashMemoryDumpVolumeName,
+			VolumeSource: k8sv1.VolumeSource{
+				PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: crashMemoryDump.ClaimName,
+				},
+			},
+		})
+		renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+			Name:      memorydump.CrashMemoryDumpVolumeName,
+			MountPath: memorydump.CrashMemoryDumpDir,
+		})
+		return nil
+	}
+}
+
+func withDiskEncryption(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		for _, secretName := range encryption.SecretNames(vmi) {
+			volumeName := encryption.SecretVolumeName(secretName)
+			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+				Name: volumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					Secret: &k8sv1.SecretVolumeSource{
+						SecretName: secretName,
+					},
+				},
+			})
+			renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+				Name:      volumeName,
+				MountPath: filepath.Dir(encryption.PassphrasePath(secretName)),
+				ReadOnly:  true,
+			})
+		}
+		return nil
+	}
+}
+
+func withContainerDiskOverlays(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		for _, volume := range backendstorage.PersistentOverlayVolumes(&vmi.Spec) {
+			podVolumeName := volume.Name + "-overlay"
+			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+				Name: podVolumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: backendstorage.OverlayPVCName(vmi.Name, volume.Name),
+					},
+				},
+			})
+			renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+				Name:      podVolumeName,
+				MountPath: containerdisk.GetPersistentOverlayDir(volume.Name),
+			})
+		}
+		return nil
+	}
+}
+
 func withSidecarVolumes(hookSidecars hooks.HookSidecarList) VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		if len(hookSidecars) != 0 {
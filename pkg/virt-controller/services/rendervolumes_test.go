@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -15,6 +16,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
@@ -392,6 +394,136 @@ var _ = Describe("Container spec renderer", func() {
 			Expect(vsr.Mounts()).To(ContainElement(expectedMount))
 		})
 	})
+	Context("With disk encryption", func() {
+		It("should mount each passphrase secret once read-only", func() {
+			vmi := libvmi.New(
+				libvmi.WithPersistentVolumeClaim("disk1", "pvc1", libvmi.WithDiskEncryption("luks-secret")),
+				libvmi.WithPersistentVolumeClaim("disk2", "pvc2", libvmi.WithDiskEncryption("luks-secret")),
+			)
+
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withDiskEncryption(vmi))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Volumes()).To(ConsistOf(append(defaultVolumes(), k8sv1.Volume{
+				Name: "luks-secret-disk-encryption",
+				VolumeSource: k8sv1.VolumeSource{
+					Secret: &k8sv1.SecretVolumeSource{SecretName: "luks-secret"},
+				},
+			})))
+			Expect(vsr.Mounts()).To(ConsistOf(append(defaultVolumeMounts(), k8sv1.VolumeMount{
+				Name:      "luks-secret-disk-encryption",
+				MountPath: "/var/run/kubevirt-private/secret/luks-secret-disk-encryption",
+				ReadOnly:  true,
+			})))
+		})
+	})
+
+	Context("With persistent container disk overlays", func() {
+		It("should mount the overlay PVC of each container disk with a persistent overlay", func() {
+			vmi := libvmi.New(
+				libvmi.WithName("testvmi"),
+				libvmi.WithContainerDisk("ephemeral", "image"),
+				libvmi.WithContainerDiskPersistentOverlay("persistent", "image", resource.MustParse("1Gi")),
+			)
+
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withContainerDiskOverlays(vmi))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Volumes()).To(ConsistOf(append(defaultVolumes(), k8sv1.Volume{
+				Name: "persistent-overlay",
+				VolumeSource: k8sv1.VolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "containerdisk-overlay-for-testvmi-persistent",
+					},
+				},
+			})))
+			Expect(vsr.Mounts()).To(ConsistOf(append(defaultVolumeMounts(), k8sv1.VolumeMount{
+				Name:      "persistent-overlay",
+				MountPath: "/var/run/kubevirt-private/containerdisk-overlays/persistent",
+			})))
+		})
+	})
+
+	Context("With memory state restore", func() {
+		It("should not mount a memory state volume when the vmi is not resumed from memory", func() {
+			vmi := libvmi.New()
+
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withMemoryStateRestore(vmi))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Volumes()).To(ConsistOf(defaultVolumes()))
+			Expect(vsr.Mounts()).To(ConsistOf(defaultVolumeMounts()))
+		})
+
+		It("should mount the memory state pvc read-only when the vmi is resumed from memory", func() {
+			vmi := libvmi.New(libvmi.WithAnnotation(v1.MemoryStateRestoreAnnotation, "memory-state-pvc"))
+
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withMemoryStateRestore(vmi))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Volumes()).To(ContainElement(k8sv1.Volume{
+				Name: memorydump.MemoryStateRestoreVolumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "memory-state-pvc",
+						ReadOnly:  true,
+					},
+				},
+			}))
+			Expect(vsr.Mounts()).To(ContainElement(k8sv1.VolumeMount{
+				Name:      memorydump.MemoryStateRestoreVolumeName,
+				ReadOnly:  true,
+				MountPath: memorydump.MemoryStateRestoreDir,
+			}))
+		})
+
+		It("should not mount the memory state pvc on migration targets of a running vmi", func() {
+			vmi := libvmi.New(
+				libvmi.WithAnnotation(v1.MemoryStateRestoreAnnotation, "memory-state-pvc"),
+				libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+			)
+
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withMemoryStateRestore(vmi))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Volumes()).To(ConsistOf(defaultVolumes()))
+		})
+	})
+
+	Context("With crash memory dump", func() {
+		It("should mount the crash memory dump pvc when the crash policy dumps the memory", func() {
+			vmi := libvmi.New()
+			vmi.Spec.CrashPolicy = &v1.CrashPolicy{
+				MemoryDump: &v1.CrashMemoryDump{ClaimName: "crash-pvc"},
+			}
+
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withCrashMemoryDump(vmi))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Volumes()).To(ContainElement(k8sv1.Volume{
+				Name: memorydump.CrashMemoryDumpVolumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "crash-pvc",
+					},
+				},
+			}))
+			Expect(vsr.Mounts()).To(ContainElement(k8sv1.VolumeMount{
+				Name:      memorydump.CrashMemoryDumpVolumeName,
+				MountPath: memorydump.CrashMemoryDumpDir,
+			}))
+		})
+
+		It("should not mount a crash memory dump volume without a crash policy", func() {
+			vmi := libvmi.New()
+
+			var err error
+			vsr, err = NewVolumeRenderer(config, false, launcherImage, make(map[string]string), namespace, ephemeralDisk, containerDisk, virtShareDir, withCrashMemoryDump(vmi))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(vsr.Volumes()).To(ConsistOf(defaultVolumes()))
+			Expect(vsr.Mounts()).To(ConsistOf(defaultVolumeMounts()))
+		})
+	})
 })
 
 func vmiDiskPath(volumeName string) string {
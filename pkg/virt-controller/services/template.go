@@ -300,7 +300,9 @@ func (t *TemplateService) RenderLaunchManifest(vmi *v1.VirtualMachineInstance) (
 		}
 		backendStoragePVCName = backendStoragePVC.Name
 	}
-	return t.renderLaunchManifest(vmi, nil, backendStoragePVCName, false)
+	// The persistent container disk overlays have to stay on top of the images they were first used with
+	imageIDs := backendstorage.OverlayImageIDs(t.persistentVolumeClaimStore, vmi)
+	return t.renderLaunchManifest(vmi, imageIDs, backendStoragePVCName, false)
 }
 
 func generateQemuTimeoutWithJitter(qemuTimeoutBaseSeconds int) string {
@@ -852,7 +854,11 @@ func (t *TemplateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, imag
 		withVMIConfigVolumes(vmi.Spec.Domain.Devices.Disks, vmi.Spec.Volumes),
 		withVMIVolumes(t.persistentVolumeClaimStore, vmi.Spec.Volumes, vmi.Status.VolumeStatus),
 		withAccessCredentials(vmi.Spec.AccessCredentials),
+		withDiskEncryption(vmi),
+		withContainerDiskOverlays(vmi),
 		withBackendStorage(vmi, backendStoragePVCName),
+		withMemoryStateRestore(vmi),
+		withCrashMemoryDump(vmi),
 	}
 	if imageVolumeFeatureGateEnabled {
 		volumeOpts = append(volumeOpts, withImageVolumes(vmi))
@@ -192,8 +192,9 @@ type VirtControllerApp struct {
 	cdiInformer            cache.SharedIndexInformer
 	cdiConfigInformer      cache.SharedIndexInformer
 
-	migrationController *migration.Controller
-	migrationInformer   cache.SharedIndexInformer
+	migrationController             *migration.Controller
+	migrationPolicyStatusController *migration.PolicyStatusController
+	migrationInformer               cache.SharedIndexInformer
 
 	workloadUpdateController *workloadupdater.WorkloadUpdateController
 
@@ -203,6 +204,8 @@ type VirtControllerApp struct {
 	exportController             *export.VMExportController
 	snapshotController           *snapshot.VMSnapshotController
 	restoreController            *snapshot.VMRestoreController
+	snapshotScheduleController   *snapshot.VMSnapshotScheduleController
+	snapshotGroupController      *snapshot.VMSnapshotGroupController
 	vmExportInformer             cache.SharedIndexInformer
 	routeCache                   cache.Store
 	ingressCache                 cache.Store
@@ -210,6 +213,9 @@ type VirtControllerApp struct {
 	vmSnapshotInformer           cache.SharedIndexInformer
 	vmSnapshotContentInformer    cache.SharedIndexInformer
 	vmRestoreInformer            cache.SharedIndexInformer
+	vmSnapshotScheduleInformer   cache.SharedIndexInformer
+	vmSnapshotGroupInformer      cache.SharedIndexInformer
+	vmGroupRestoreInformer       cache.SharedIndexInformer
 	storageClassInformer         cache.SharedIndexInformer
 	allPodInformer               cache.SharedIndexInformer
 	resourceQuotaInformer        cache.SharedIndexInformer
@@ -221,9 +227,14 @@ type VirtControllerApp struct {
 	vmCloneInformer   cache.SharedIndexInformer
 	vmCloneController *clonecontroller.VMCloneController
 
-	vmBackupInformer        cache.SharedIndexInformer
-	vmBackupTrackerInformer cache.SharedIndexInformer
-	vmBackupController      *backup.VMBackupController
+	vmBackupInformer           cache.SharedIndexInformer
+	vmBackupTrackerInformer    cache.SharedIndexInformer
+	vmBackupScheduleInformer   cache.SharedIndexInformer
+	vmBackupRestoreInformer    cache.SharedIndexInformer
+	caConfigMapInformer        cache.SharedIndexInformer
+	vmBackupController         *backup.VMBackupController
+	vmBackupScheduleController *backup.VMBackupScheduleController
+	vmBackupRestoreController  *backup.VMBackupRestoreController
 
 	instancetypeInformer        cache.SharedIndexInformer
 	clusterInstancetypeInformer cache.SharedIndexInformer
@@ -410,10 +421,16 @@ func Execute() {
 
 	app.vmBackupInformer = app.informerFactory.VirtualMachineBackup()
 	app.vmBackupTrackerInformer = app.informerFactory.VirtualMachineBackupTracker()
+	app.vmBackupScheduleInformer = app.informerFactory.VirtualMachineBackupSchedule()
+	app.vmBackupRestoreInformer = app.informerFactory.VirtualMachineBackupRestore()
+	app.caConfigMapInformer = app.informerFactory.KubeVirtCAConfigMap()
 	app.vmExportInformer = app.informerFactory.VirtualMachineExport()
 	app.vmSnapshotInformer = app.informerFactory.VirtualMachineSnapshot()
 	app.vmSnapshotContentInformer = app.informerFactory.VirtualMachineSnapshotContent()
 	app.vmRestoreInformer = app.informerFactory.VirtualMachineRestore()
+	app.vmSnapshotScheduleInformer = app.informerFactory.VirtualMachineSnapshotSchedule()
+	app.vmSnapshotGroupInformer = app.informerFactory.VirtualMachineSnapshotGroup()
+	app.vmGroupRestoreInformer = app.informerFactory.VirtualMachineSnapshotGroupRestore()
 	app.storageClassInformer = app.informerFactory.StorageClass()
 	app.caExportConfigMapInformer = app.informerFactory.KubeVirtExportCAConfigMap()
 	app.exportRouteConfigMapInformer = app.informerFactory.ExportRouteConfigMap()
@@ -497,6 +514,8 @@ func Execute() {
 	app.initEvacuationController()
 	app.initSnapshotController()
 	app.initRestoreController()
+	app.initSnapshotScheduleController()
+	app.initSnapshotGroupController()
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
@@ -625,6 +644,7 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		go vca.poolController.Run(vca.poolControllerThreads, stop)
 		go vca.vmController.Run(vca.vmControllerThreads, stop)
 		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
+		go vca.migrationPolicyStatusController.Run(stop)
 		go func() {
 			if err := vca.snapshotController.Run(vca.snapshotControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the snapshot controller: %v", err)
@@ -635,6 +655,16 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the restore controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.snapshotScheduleController.Run(vca.snapshotControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot schedule controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.snapshotGroupController.Run(vca.snapshotControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the snapshot group controller: %v", err)
+			}
+		}()
 		go func() {
 			if err := vca.exportController.Run(vca.exportControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the export controller: %v", err)
@@ -652,6 +682,16 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 				log.Log.Warningf("error running the backup controller: %v", err)
 			}
 		}()
+		go func() {
+			if err := vca.vmBackupScheduleController.Run(vca.backupControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the backup schedule controller: %v", err)
+			}
+		}()
+		go func() {
+			if err := vca.vmBackupRestoreController.Run(vca.backupControllerThreads, stop); err != nil {
+				log.Log.Warningf("error running the backup restore controller: %v", err)
+			}
+		}()
 
 		cache.WaitForCacheSync(stop, vca.persistentVolumeClaimInformer.HasSynced, vca.namespaceInformer.HasSynced, vca.resourceQuotaInformer.HasSynced)
 		close(vca.readyChan)
@@ -775,6 +815,17 @@ func (vca *VirtControllerApp) initCommon() {
 		panic(err)
 	}
 
+	vca.migrationPolicyStatusController, err = migration.NewPolicyStatusController(
+		vca.migrationPolicyInformer,
+		vca.vmiInformer,
+		vca.namespaceInformer,
+		vca.migrationInformer,
+		vca.clientSet,
+	)
+	if err != nil {
+		panic(err)
+	}
+
 	vca.nodeTopologyUpdater = topology.NewNodeTopologyUpdater(vca.clientSet, topologyHinter, vca.nodeInformer)
 }
 
@@ -867,6 +918,8 @@ func (vca *VirtControllerApp) initWorkloadUpdaterController() {
 		vca.kvPodInformer,
 		vca.migrationInformer,
 		vca.kubeVirtInformer,
+		vca.migrationPolicyInformer,
+		vca.namespaceInformer,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig)
@@ -883,6 +936,8 @@ func (vca *VirtControllerApp) initEvacuationController() {
 		vca.migrationInformer,
 		vca.nodeInformer,
 		vca.kvPodInformer,
+		vca.migrationPolicyInformer,
+		vca.namespaceInformer,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig,
@@ -936,6 +991,38 @@ func (vca *VirtControllerApp) initRestoreController() {
 	}
 }
 
+func (vca *VirtControllerApp) initSnapshotScheduleController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-schedule-controller")
+	vca.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+		Client:                     vca.clientSet,
+		VMSnapshotScheduleInformer: vca.vmSnapshotScheduleInformer,
+		VMSnapshotInformer:         vca.vmSnapshotInformer,
+		VMInformer:                 vca.vmInformer,
+		Recorder:                   recorder,
+	}
+	if err := vca.snapshotScheduleController.Init(); err != nil {
+		panic(err)
+	}
+}
+
+func (vca *VirtControllerApp) initSnapshotGroupController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "snapshot-group-controller")
+	vca.snapshotGroupController = &snapshot.VMSnapshotGroupController{
+		Client:                         vca.clientSet,
+		VMSnapshotGroupInformer:        vca.vmSnapshotGroupInformer,
+		VMSnapshotGroupRestoreInformer: vca.vmGroupRestoreInformer,
+		VMSnapshotInformer:             vca.vmSnapshotInformer,
+		VMSnapshotContentInformer:      vca.vmSnapshotContentInformer,
+		VMRestoreInformer:              vca.vmRestoreInformer,
+		VMInformer:                     vca.vmInformer,
+		VMIInformer:                    vca.vmiInformer,
+		Recorder:                       recorder,
+	}
+	if err := vca.snapshotGroupController.Init(); err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) initExportController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "export-controller")
 	vca.exportController = &export.VMExportController{
@@ -956,6 +1043,7 @@ func (vca *VirtControllerApp) initExportController() {
 		VolumeSnapshotProvider:      vca.snapshotController,
 		VMSnapshotInformer:          vca.vmSnapshotInformer,
 		VMSnapshotContentInformer:   vca.vmSnapshotContentInformer,
+		VMBackupInformer:            vca.vmBackupInformer,
 		VMInformer:                  vca.vmInformer,
 		VMIInformer:                 vca.vmiInformer,
 		CRDInformer:                 vca.crdInformer,
@@ -986,7 +1074,24 @@ func (vca *VirtControllerApp) initBackupController() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "backup-controller")
 	vca.vmBackupController, err = backup.NewVMBackupController(
-		vca.clientSet, vca.vmBackupInformer, vca.vmBackupTrackerInformer, vca.vmInformer, vca.vmiInformer, vca.persistentVolumeClaimInformer, recorder,
+		vca.clientSet, vca.vmBackupInformer, vca.vmBackupTrackerInformer, vca.vmInformer, vca.vmiInformer, vca.persistentVolumeClaimInformer,
+		vca.caConfigMapInformer, vca.kubevirtNamespace, recorder,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	vca.vmBackupScheduleController, err = backup.NewVMBackupScheduleController(
+		vca.clientSet, vca.vmBackupScheduleInformer, vca.vmBackupInformer, vca.vmBackupTrackerInformer, vca.persistentVolumeClaimInformer,
+		vca.newRecorder(k8sv1.NamespaceAll, "backup-schedule-controller"),
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	vca.vmBackupRestoreController, err = backup.NewVMBackupRestoreController(
+		vca.clientSet, vca.vmBackupRestoreInformer, vca.vmBackupInformer, vca.vmBackupTrackerInformer, vca.vmInformer,
+		vca.persistentVolumeClaimInformer, vca.launcherImage, vca.newRecorder(k8sv1.NamespaceAll, "backup-restore-controller"),
 	)
 	if err != nil {
 		panic(err)
@@ -106,6 +106,7 @@ var _ = Describe("Application", func() {
 		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
 		resourceQuotaInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ResourceQuota{})
 		pvcInformer, _ := testutils.NewFakeInformerFor(&k8sv1.PersistentVolumeClaim{})
+		caConfigMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
 		crInformer, _ := testutils.NewFakeInformerFor(&appsv1.ControllerRevision{})
 		dataVolumeInformer, _ := testutils.NewFakeInformerFor(&cdiv1.DataVolume{})
@@ -117,6 +118,9 @@ var _ = Describe("Application", func() {
 		storageClassInformer, _ := testutils.NewFakeInformerFor(&storagev1.StorageClass{})
 		crdInformer, _ := testutils.NewFakeInformerFor(&extv1.CustomResourceDefinition{})
 		vmRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineRestore{})
+		vmSnapshotScheduleInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotSchedule{})
+		vmSnapshotGroupInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroup{})
+		vmSnapshotGroupRestoreInformer, _ := testutils.NewFakeInformerFor(&snapshotv1.VirtualMachineSnapshotGroupRestore{})
 		vmExportInformer, _ := testutils.NewFakeInformerFor(&exportv1.VirtualMachineExport{})
 		configMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
 		routeConfigMapInformer, _ := testutils.NewFakeInformerFor(&k8sv1.ConfigMap{})
@@ -125,6 +129,8 @@ var _ = Describe("Application", func() {
 		cloneInformer, _ := testutils.NewFakeInformerFor(&clone.VirtualMachineClone{})
 		backupInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackup{})
 		backupTrackerInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupTracker{})
+		backupScheduleInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupSchedule{})
+		backupRestoreInformer, _ := testutils.NewFakeInformerFor(&backupv1.VirtualMachineBackupRestore{})
 		secretInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Secret{})
 		instancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineInstancetype{})
 		clusterInstancetypeInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
@@ -137,7 +143,7 @@ var _ = Describe("Application", func() {
 		app.vmiInformer = vmiInformer
 		app.nodeTopologyUpdater = topologyUpdater
 		app.informerFactory = controller.NewKubeInformerFactory(nil, nil, nil, "test")
-		app.evacuationController, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
+		app.evacuationController, _ = evacuation.NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, migrationPolicyInformer, namespaceInformer, recorder, virtClient, config)
 		app.disruptionBudgetController, _ = disruptionbudget.NewDisruptionBudgetController(vmiInformer, pdbInformer, podInformer, migrationInformer, recorder, virtClient)
 		app.nodeController, _ = node.NewController(virtClient, nodeInformer, vmiInformer, recorder)
 		app.vmiController, _ = vmi.NewController(services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
@@ -198,6 +204,13 @@ var _ = Describe("Application", func() {
 			config,
 			stubNetworkAnnotationsGenerator{},
 		)
+		app.migrationPolicyStatusController, _ = migration.NewPolicyStatusController(
+			migrationPolicyInformer,
+			vmiInformer,
+			namespaceInformer,
+			migrationInformer,
+			virtClient,
+		)
 		app.snapshotController = &snapshot.VMSnapshotController{
 			Client:                    virtClient,
 			VMSnapshotInformer:        vmSnapshotInformer,
@@ -227,6 +240,26 @@ var _ = Describe("Application", func() {
 			Recorder:                  recorder,
 		}
 		_ = app.restoreController.Init()
+		app.snapshotScheduleController = &snapshot.VMSnapshotScheduleController{
+			Client:                     virtClient,
+			VMSnapshotScheduleInformer: vmSnapshotScheduleInformer,
+			VMSnapshotInformer:         vmSnapshotInformer,
+			VMInformer:                 vmInformer,
+			Recorder:                   recorder,
+		}
+		_ = app.snapshotScheduleController.Init()
+		app.snapshotGroupController = &snapshot.VMSnapshotGroupController{
+			Client:                         virtClient,
+			VMSnapshotGroupInformer:        vmSnapshotGroupInformer,
+			VMSnapshotGroupRestoreInformer: vmSnapshotGroupRestoreInformer,
+			VMSnapshotInformer:             vmSnapshotInformer,
+			VMSnapshotContentInformer:      vmSnapshotContentInformer,
+			VMRestoreInformer:              vmRestoreInformer,
+			VMInformer:                     vmInformer,
+			VMIInformer:                    vmiInformer,
+			Recorder:                       recorder,
+		}
+		_ = app.snapshotGroupController.Init()
 		app.exportController = &export.VMExportController{
 			Client:                      virtClient,
 			ManifestRenderer:            services.NewTemplateService("a", 240, "b", "c", "d", "e", "f", pvcInformer.GetStore(), virtClient, config, qemuGid, "g", resourceQuotaInformer.GetStore(), namespaceInformer.GetStore()),
@@ -241,6 +274,7 @@ var _ = Describe("Application", func() {
 			SecretInformer:              secretInformer,
 			VMSnapshotInformer:          vmSnapshotInformer,
 			VMSnapshotContentInformer:   vmSnapshotContentInformer,
+			VMBackupInformer:            backupInformer,
 			VMInformer:                  vmInformer,
 			VMIInformer:                 vmiInformer,
 			CRDInformer:                 crdInformer,
@@ -273,6 +307,26 @@ var _ = Describe("Application", func() {
 			vmInformer,
 			vmiInformer,
 			pvcInformer,
+			caConfigMapInformer,
+			"kubevirt",
+			recorder,
+		)
+		app.vmBackupScheduleController, _ = backup.NewVMBackupScheduleController(
+			virtClient,
+			backupScheduleInformer,
+			backupInformer,
+			backupTrackerInformer,
+			pvcInformer,
+			recorder,
+		)
+		app.vmBackupRestoreController, _ = backup.NewVMBackupRestoreController(
+			virtClient,
+			backupRestoreInformer,
+			backupInformer,
+			backupTrackerInformer,
+			vmInformer,
+			pvcInformer,
+			"virt-launcher",
 			recorder,
 		)
 
@@ -96,14 +96,15 @@ func (ctrl *VMCloneController) execute(key string) error {
 	}
 
 	if vmClone.Status.Phase == clone.Succeeded {
-		_, vmExists, err := ctrl.vmStore.GetByKey(fmt.Sprintf("%s/%s", vmClone.Namespace, *vmClone.Status.TargetName))
+		targetNamespace := getTargetNamespace(vmClone)
+		_, vmExists, err := ctrl.vmStore.GetByKey(fmt.Sprintf("%s/%s", targetNamespace, *vmClone.Status.TargetName))
 		if err != nil {
 			return err
 		}
 
 		if !vmExists {
 			if vmClone.DeletionTimestamp == nil {
-				logger.V(3).Infof("Deleting vm clone for deleted vm %s/%s", vmClone.Namespace, *vmClone.Status.TargetName)
+				logger.V(3).Infof("Deleting vm clone for deleted vm %s/%s", targetNamespace, *vmClone.Status.TargetName)
 				return ctrl.client.VirtualMachineClone(vmClone.Namespace).Delete(context.Background(), vmClone.Name, v1.DeleteOptions{})
 			}
 			// nothing to process for a vm clone that's being deleted
@@ -229,6 +230,10 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 			}
 		}
 
+		if isCrossNamespace(vmClone) {
+			return ctrl.createCrossNamespaceTargetVM(vmClone, vmCloneInfo.snapshot, syncInfo)
+		}
+
 		if vmClone.Status.RestoreName == nil {
 			vm, err := ctrl.getVmFromSnapshot(vmCloneInfo.snapshot)
 			if err != nil {
@@ -269,6 +274,18 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 				return syncInfo
 			}
 
+			if vmCloneInfo.sourceType == sourceTypeVM {
+				syncInfo = ctrl.cleanupSnapshot(vmClone, syncInfo)
+				if syncInfo.isFailingOrError() {
+					return syncInfo
+				}
+			}
+		} else if isCrossNamespace(vmClone) && vmClone.Status.SnapshotName != nil {
+			syncInfo = ctrl.verifyCrossNamespacePVCsBound(vmClone, syncInfo)
+			if syncInfo.isFailingOrError() || !syncInfo.pvcBound {
+				return syncInfo
+			}
+
 			if vmCloneInfo.sourceType == sourceTypeVM {
 				syncInfo = ctrl.cleanupSnapshot(vmClone, syncInfo)
 				if syncInfo.isFailingOrError() {
@@ -592,7 +609,7 @@ func (ctrl *VMCloneController) verifyRestoreReady(vmClone *clone.VirtualMachineC
 func (ctrl *VMCloneController) verifyVmReady(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
 	targetVMInfo := vmClone.Spec.Target
 
-	_, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, vmClone.Namespace))
+	_, exists, err := ctrl.vmStore.GetByKey(getKey(targetVMInfo.Name, getTargetNamespace(vmClone)))
 	if !exists {
 		syncInfo.setError(fmt.Errorf("target VM %s is not created yet for clone %s", targetVMInfo.Name, vmClone.Name))
 		return syncInfo
@@ -42,6 +42,7 @@ const (
 	SourceDoesNotExist              Event = "SourceDoesNotExist"
 	SourceWithBackendStorageInvalid Event = "SourceVMWithBackendStorageInvalid"
 	VMVolumeSnapshotsInvalid        Event = "VMVolumeSnapshotsInvalid"
+	TargetVMCreationFailed          Event = "TargetVMCreationFailed"
 )
 
 var (
@@ -257,6 +258,14 @@ func (ctrl *VMCloneController) handlePVC(obj interface{}) {
 		exists      bool
 	)
 
+	// PVCs of a cross-namespace clone target are not restored, they are populated by CDI
+	if cloneKey, isCloneTarget := pvc.Annotations[cloneSourceAnnotation]; isCloneTarget {
+		if pvc.Status.Phase == k8scorev1.ClaimBound {
+			ctrl.vmCloneQueue.AddRateLimited(cloneKey)
+		}
+		return
+	}
+
 	if restoreName, exists = pvc.Annotations[snapshot.RestoreNameAnnotation]; !exists {
 		return
 	}
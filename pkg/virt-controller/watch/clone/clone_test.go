@@ -30,6 +30,7 @@ import (
 	"go.uber.org/mock/gomock"
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sfake "k8s.io/client-go/kubernetes/fake"
@@ -59,6 +60,7 @@ const (
 	testSnapshotName        = "tmp-snapshot-clone-uid"
 	testSnapshotContentName = "vmsnapshot-content-snapshot-UID"
 	testRestoreName         = "tmp-restore-clone-uid"
+	testTargetNamespace     = "target-ns"
 )
 
 var _ = Describe("Clone", func() {
@@ -238,6 +240,7 @@ var _ = Describe("Clone", func() {
 		virtClient.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().VirtualMachineRestore(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineRestores(metav1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().VirtualMachineSnapshotContent(metav1.NamespaceDefault).Return(client.SnapshotV1beta1().VirtualMachineSnapshotContents(metav1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(testTargetNamespace).Return(client.KubevirtV1().VirtualMachines(testTargetNamespace)).AnyTimes()
 
 		k8sClient = k8sfake.NewSimpleClientset()
 		k8sClient.Fake.PrependReactor("*", "*", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
@@ -745,6 +748,141 @@ var _ = Describe("Clone", func() {
 				expectCloneBeInPhase(clone.RestoreInProgress)
 			})
 		})
+
+		Context("with a target namespace", func() {
+			const (
+				volumeName         = "disk0"
+				volumeSnapshotName = "vmsnapshot-disk0"
+				targetDVName       = "clone-" + testCloneUID + "-" + volumeName
+			)
+
+			var snapshotContent *snapshotv1.VirtualMachineSnapshotContent
+
+			BeforeEach(func() {
+				vmClone.Spec.TargetNamespace = testTargetNamespace
+				sourceVM.Spec.Template.Spec.Volumes = append(sourceVM.Spec.Template.Spec.Volumes, virtv1.Volume{
+					Name: volumeName,
+					VolumeSource: virtv1.VolumeSource{
+						PersistentVolumeClaim: &virtv1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "source-pvc",
+							},
+						},
+					},
+				})
+				snapshotContent = createVirtualMachineSnapshotContent(sourceVM)
+				snapshotContent.Spec.VolumeBackups = []snapshotv1.VolumeBackup{{
+					VolumeName: volumeName,
+					PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{Name: "source-pvc"},
+						Spec: k8sv1.PersistentVolumeClaimSpec{
+							AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+							Resources: k8sv1.VolumeResourceRequirements{
+								Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("1Gi")},
+							},
+						},
+					},
+					VolumeSnapshotName: pointer.P(volumeSnapshotName),
+				}}
+			})
+
+			createTargetVM := func() *virtv1.VirtualMachine {
+				targetVM, err := generateCrossNamespaceTargetVM(vmClone, snapshotContent)
+				Expect(err).ToNot(HaveOccurred())
+				return targetVM
+			}
+
+			It("should create the target VM in the target namespace instead of a restore", func() {
+				snapshot := createVirtualMachineSnapshot(sourceVM)
+				snapshot.Status.ReadyToUse = pointer.P(true)
+
+				vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+				vmClone.Status.Phase = clone.SnapshotInProgress
+
+				addVM(sourceVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addSnapshotContent(snapshotContent)
+
+				sanityExecute()
+				expectEvent(SnapshotReady)
+				expectCloneBeInPhase(clone.CreatingTargetVM)
+				expectRestoreDoesNotExist()
+
+				targetVM, err := client.KubevirtV1().VirtualMachines(testTargetNamespace).Get(context.TODO(), vmClone.Spec.Target.Name, metav1.GetOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(targetVM.Spec.RunStrategy).To(HaveValue(Equal(virtv1.RunStrategyHalted)))
+				Expect(targetVM.Spec.DataVolumeTemplates).To(HaveLen(1))
+
+				dataVolumeTemplate := targetVM.Spec.DataVolumeTemplates[0]
+				Expect(dataVolumeTemplate.Name).To(Equal(targetDVName))
+				Expect(dataVolumeTemplate.Annotations).To(HaveKeyWithValue(cloneSourceAnnotation, metav1.NamespaceDefault+"/"+vmClone.Name))
+				Expect(dataVolumeTemplate.Spec.Source.Snapshot.Namespace).To(Equal(metav1.NamespaceDefault))
+				Expect(dataVolumeTemplate.Spec.Source.Snapshot.Name).To(Equal(volumeSnapshotName))
+				Expect(dataVolumeTemplate.Spec.Storage.Resources.Requests).To(HaveKey(k8sv1.ResourceStorage))
+
+				Expect(targetVM.Spec.Template.Spec.Volumes).To(ContainElement(virtv1.Volume{
+					Name: volumeName,
+					VolumeSource: virtv1.VolumeSource{
+						DataVolume: &virtv1.DataVolumeSource{Name: targetDVName},
+					},
+				}))
+			})
+
+			It("should fail if a volume has no volume snapshot", func() {
+				snapshot := createVirtualMachineSnapshot(sourceVM)
+				snapshot.Status.ReadyToUse = pointer.P(true)
+				snapshotContent.Spec.VolumeBackups[0].VolumeSnapshotName = nil
+
+				vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+				vmClone.Status.Phase = clone.RestoreInProgress
+
+				addVM(sourceVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addSnapshotContent(snapshotContent)
+
+				controller.Execute()
+				expectEvent(TargetVMCreationFailed)
+				expectCloneBeInPhase(clone.RestoreInProgress)
+			})
+
+			DescribeTable("when clone succeeded", func(pvcPhase k8sv1.PersistentVolumeClaimPhase, expectSnapshotDeleted bool) {
+				snapshot := createVirtualMachineSnapshot(sourceVM, createOwnerReference(vmClone))
+				targetVM := createTargetVM()
+				pvc := createPVC(testTargetNamespace, pvcPhase)
+				pvc.Name = targetDVName
+
+				vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+				vmClone.Status.TargetName = pointer.P(targetVM.Name)
+				vmClone.Status.Phase = clone.Succeeded
+
+				addVM(sourceVM)
+				addVM(targetVM)
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addPVC(pvc)
+
+				sanityExecute()
+				if expectSnapshotDeleted {
+					expectEvent(PVCBound)
+					expectSnapshotDoesNotExist()
+				} else {
+					expectSnapshotExists()
+				}
+			},
+				Entry("should delete the snapshot once the target PVCs are bound", k8sv1.ClaimBound, true),
+				Entry("should keep the snapshot while the target PVCs are pending", k8sv1.ClaimPending, false),
+			)
+
+			It("should enqueue the clone when a target PVC is bound", func() {
+				pvc := createPVC(testTargetNamespace, k8sv1.ClaimBound)
+				pvc.Annotations = map[string]string{cloneSourceAnnotation: metav1.NamespaceDefault + "/" + vmClone.Name}
+
+				controller.handlePVC(pvc)
+				Expect(mockQueue.GetRateLimitedEnqueueCount()).To(Equal(1))
+			})
+		})
 	})
 
 	Context("generation of target VM", func() {
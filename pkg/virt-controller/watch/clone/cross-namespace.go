@@ -0,0 +1,246 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package clone
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	clone "kubevirt.io/api/clone/v1beta1"
+	k6tv1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	virtsnapshot "kubevirt.io/kubevirt/pkg/storage/snapshot"
+)
+
+// cloneSourceAnnotation is set on the DataVolumes of a cross-namespace clone target, and on their PVCs,
+// to the key of the clone that created them
+const cloneSourceAnnotation = "clone.kubevirt.io/source-clone"
+
+func isCrossNamespace(vmClone *clone.VirtualMachineClone) bool {
+	return vmClone.Spec.TargetNamespace != "" && vmClone.Spec.TargetNamespace != vmClone.Namespace
+}
+
+func getTargetNamespace(vmClone *clone.VirtualMachineClone) string {
+	if vmClone.Spec.TargetNamespace != "" {
+		return vmClone.Spec.TargetNamespace
+	}
+	return vmClone.Namespace
+}
+
+func generateCrossNamespaceDVName(cloneUID types.UID, volumeName string) string {
+	return fmt.Sprintf("clone-%s-%s", string(cloneUID), volumeName)
+}
+
+// createCrossNamespaceTargetVM creates the target VM directly in the target namespace. A VirtualMachineRestore
+// cannot be used since it only restores within the namespace of the snapshot. Instead, every snapshotted volume
+// becomes a DataVolumeTemplate that clones the VolumeSnapshot across namespaces, which CDI authorizes the same way
+// as any other cross-namespace DataVolume clone.
+func (ctrl *VMCloneController) createCrossNamespaceTargetVM(vmClone *clone.VirtualMachineClone, snapshot *snapshotv1.VirtualMachineSnapshot, syncInfo syncInfoType) syncInfoType {
+	content, err := ctrl.getSnapshotContent(snapshot)
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("cannot get snapshot content for clone %s: %v", vmClone.Name, err))
+		return syncInfo
+	}
+
+	targetVM, err := generateCrossNamespaceTargetVM(vmClone, content)
+	if err != nil {
+		retErr := fmt.Errorf("error generating target VM for clone %s: %v", vmClone.Name, err)
+		ctrl.recorder.Event(vmClone, corev1.EventTypeWarning, string(TargetVMCreationFailed), retErr.Error())
+		syncInfo.setError(retErr)
+		return syncInfo
+	}
+
+	log.Log.Object(vmClone).Infof("creating target VM %s/%s for clone %s", targetVM.Namespace, targetVM.Name, vmClone.Name)
+	_, err = ctrl.client.VirtualMachine(targetVM.Namespace).Create(context.Background(), targetVM, metav1.CreateOptions{})
+	if err != nil && !k8serrors.IsAlreadyExists(err) {
+		retErr := fmt.Errorf("failed creating target VM %s/%s for clone %s: %v", targetVM.Namespace, targetVM.Name, vmClone.Name, err)
+		ctrl.recorder.Event(vmClone, corev1.EventTypeWarning, string(TargetVMCreationFailed), retErr.Error())
+		syncInfo.setError(retErr)
+		return syncInfo
+	}
+
+	syncInfo.restoreReady = true
+	syncInfo.targetVMName = targetVM.Name
+	return syncInfo
+}
+
+func generateCrossNamespaceTargetVM(vmClone *clone.VirtualMachineClone, content *snapshotv1.VirtualMachineSnapshotContent) (*k6tv1.VirtualMachine, error) {
+	snapshotVM := content.Spec.Source.VirtualMachine
+	if snapshotVM == nil || snapshotVM.Spec.Template == nil {
+		return nil, fmt.Errorf("snapshot content %s has no VM template", content.Name)
+	}
+
+	sourceVM := &k6tv1.VirtualMachine{
+		ObjectMeta: *snapshotVM.ObjectMeta.DeepCopy(),
+		Spec:       *snapshotVM.Spec.DeepCopy(),
+	}
+	patches, err := generatePatches(sourceVM, &vmClone.Spec)
+	if err != nil {
+		return nil, err
+	}
+	patchedVM, err := virtsnapshot.PatchVM(sourceVM, patches)
+	if err != nil {
+		return nil, err
+	}
+
+	targetVM := &k6tv1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        vmClone.Spec.Target.Name,
+			Namespace:   getTargetNamespace(vmClone),
+			Labels:      patchedVM.Labels,
+			Annotations: patchedVM.Annotations,
+		},
+		Spec: patchedVM.Spec,
+	}
+	if targetVM.Spec.Running != nil {
+		targetVM.Spec.Running = pointer.P(false)
+	} else {
+		targetVM.Spec.RunStrategy = pointer.P(k6tv1.RunStrategyHalted)
+	}
+	// The revisions of the source are ControllerRevisions in the source namespace
+	if targetVM.Spec.Instancetype != nil {
+		targetVM.Spec.Instancetype.RevisionName = ""
+	}
+	if targetVM.Spec.Preference != nil {
+		targetVM.Spec.Preference.RevisionName = ""
+	}
+
+	var (
+		dataVolumeTemplates []k6tv1.DataVolumeTemplateSpec
+		volumes             []k6tv1.Volume
+	)
+	for _, volume := range targetVM.Spec.Template.Spec.Volumes {
+		if volume.MemoryDump != nil {
+			continue
+		}
+		if volume.PersistentVolumeClaim == nil && volume.DataVolume == nil {
+			volumes = append(volumes, volume)
+			continue
+		}
+
+		volumeBackup, err := getVolumeBackup(content, volume.Name)
+		if err != nil {
+			return nil, err
+		}
+		dvName := generateCrossNamespaceDVName(vmClone.UID, volume.Name)
+		dataVolumeTemplates = append(dataVolumeTemplates, generateCrossNamespaceDataVolumeTemplate(vmClone, dvName, volumeBackup))
+		volumes = append(volumes, k6tv1.Volume{
+			Name: volume.Name,
+			VolumeSource: k6tv1.VolumeSource{
+				DataVolume: &k6tv1.DataVolumeSource{Name: dvName},
+			},
+		})
+	}
+	targetVM.Spec.DataVolumeTemplates = dataVolumeTemplates
+	targetVM.Spec.Template.Spec.Volumes = volumes
+
+	return targetVM, nil
+}
+
+func getVolumeBackup(content *snapshotv1.VirtualMachineSnapshotContent, volumeName string) (*snapshotv1.VolumeBackup, error) {
+	for i, volumeBackup := range content.Spec.VolumeBackups {
+		if volumeBackup.VolumeName != volumeName {
+			continue
+		}
+		if volumeBackup.VolumeSnapshotName == nil {
+			return nil, fmt.Errorf("volume %s has no volume snapshot in snapshot content %s", volumeName, content.Name)
+		}
+		return &content.Spec.VolumeBackups[i], nil
+	}
+	return nil, fmt.Errorf(ErrVolumeNotBackedUp, volumeName, content.Name)
+}
+
+func generateCrossNamespaceDataVolumeTemplate(vmClone *clone.VirtualMachineClone, dvName string, volumeBackup *snapshotv1.VolumeBackup) k6tv1.DataVolumeTemplateSpec {
+	pvcSpec := volumeBackup.PersistentVolumeClaim.Spec
+	return k6tv1.DataVolumeTemplateSpec{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: dvName,
+			Annotations: map[string]string{
+				cloneSourceAnnotation: getKey(vmClone.Name, vmClone.Namespace),
+			},
+		},
+		Spec: cdiv1.DataVolumeSpec{
+			Source: &cdiv1.DataVolumeSource{
+				Snapshot: &cdiv1.DataVolumeSourceSnapshot{
+					Namespace: vmClone.Namespace,
+					Name:      *volumeBackup.VolumeSnapshotName,
+				},
+			},
+			Storage: &cdiv1.StorageSpec{
+				AccessModes:      pvcSpec.AccessModes,
+				VolumeMode:       pvcSpec.VolumeMode,
+				StorageClassName: pvcSpec.StorageClassName,
+				Resources: corev1.VolumeResourceRequirements{
+					Requests: pvcSpec.Resources.Requests,
+				},
+			},
+		},
+	}
+}
+
+// verifyCrossNamespacePVCsBound waits for CDI to populate the PVCs of the target VM. The snapshot has to be kept
+// until then since it is the source of the clones.
+func (ctrl *VMCloneController) verifyCrossNamespacePVCsBound(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
+	targetNamespace := getTargetNamespace(vmClone)
+	obj, exists, err := ctrl.vmStore.GetByKey(getKey(vmClone.Spec.Target.Name, targetNamespace))
+	if !exists {
+		syncInfo.setError(fmt.Errorf("target VM %s/%s does not exist for clone %s", targetNamespace, vmClone.Spec.Target.Name, vmClone.Name))
+		return syncInfo
+	} else if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting VM %s/%s from cache for clone %s: %v", targetNamespace, vmClone.Spec.Target.Name, vmClone.Name, err))
+		return syncInfo
+	}
+
+	targetVM := obj.(*k6tv1.VirtualMachine)
+	for _, dataVolumeTemplate := range targetVM.Spec.DataVolumeTemplates {
+		if dataVolumeTemplate.Annotations[cloneSourceAnnotation] != getKey(vmClone.Name, vmClone.Namespace) {
+			continue
+		}
+
+		obj, exists, err := ctrl.pvcStore.GetByKey(getKey(dataVolumeTemplate.Name, targetNamespace))
+		if !exists {
+			log.Log.Object(vmClone).V(defaultVerbosityLevel).Infof("pvc %s/%s for clone %s is not created yet", targetNamespace, dataVolumeTemplate.Name, vmClone.Name)
+			return syncInfo
+		} else if err != nil {
+			syncInfo.setError(fmt.Errorf("error getting PVC %s/%s from cache for clone %s: %v", targetNamespace, dataVolumeTemplate.Name, vmClone.Name, err))
+			return syncInfo
+		}
+
+		pvc := obj.(*corev1.PersistentVolumeClaim)
+		if pvc.Status.Phase != corev1.ClaimBound {
+			log.Log.Object(vmClone).V(defaultVerbosityLevel).Infof("pvc %s/%s for clone %s is not bound yet", pvc.Namespace, pvc.Name, vmClone.Name)
+			return syncInfo
+		}
+	}
+
+	ctrl.logAndRecord(vmClone, PVCBound, fmt.Sprintf("all PVC for clone %s are bound", vmClone.Name))
+	syncInfo.pvcBound = true
+
+	return syncInfo
+}
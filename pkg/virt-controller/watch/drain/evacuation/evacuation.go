@@ -15,6 +15,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 
 	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 
@@ -46,6 +47,8 @@ type EvacuationController struct {
 	recorder              record.EventRecorder
 	migrationExpectations *controller.UIDTrackingControllerExpectations
 	nodeStore             cache.Store
+	migrationPolicyStore  cache.Store
+	namespaceStore        cache.Store
 	clusterConfig         *virtconfig.ClusterConfig
 	hasSynced             func() bool
 }
@@ -55,6 +58,8 @@ func NewEvacuationController(
 	migrationInformer cache.SharedIndexInformer,
 	nodeInformer cache.SharedIndexInformer,
 	vmiPodInformer cache.SharedIndexInformer,
+	migrationPolicyInformer cache.SharedIndexInformer,
+	namespaceInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -69,6 +74,8 @@ func NewEvacuationController(
 		migrationIndexer:      migrationInformer.GetIndexer(),
 		nodeStore:             nodeInformer.GetStore(),
 		vmiPodIndexer:         vmiPodInformer.GetIndexer(),
+		migrationPolicyStore:  migrationPolicyInformer.GetStore(),
+		namespaceStore:        namespaceInformer.GetStore(),
 		recorder:              recorder,
 		clientset:             clientset,
 		migrationExpectations: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
@@ -76,7 +83,8 @@ func NewEvacuationController(
 	}
 
 	c.hasSynced = func() bool {
-		return vmiInformer.HasSynced() && vmiPodInformer.HasSynced() && migrationInformer.HasSynced() && nodeInformer.HasSynced()
+		return vmiInformer.HasSynced() && vmiPodInformer.HasSynced() && migrationInformer.HasSynced() && nodeInformer.HasSynced() &&
+			migrationPolicyInformer.HasSynced() && namespaceInformer.HasSynced()
 	}
 
 	_, err := vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -403,6 +411,13 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 	}
 
 	migrationCandidates, nonMigrateable := c.filterRunningNonMigratingVMIs(vmisToMigrate, activeMigrations)
+	if !nodeHasTaint(taint, node) {
+		var err error
+		migrationCandidates, err = c.deferToMaintenanceWindows(node, migrationCandidates)
+		if err != nil {
+			return err
+		}
+	}
 	if len(migrationCandidates) == 0 && len(nonMigrateable) == 0 {
 		return nil
 	}
@@ -482,6 +497,48 @@ func (c *EvacuationController) sync(node *k8sv1.Node, vmisOnNode []*virtv1.Virtu
 	return nil
 }
 
+// deferToMaintenanceWindows holds back the evicted VMIs which have to wait for a maintenance window and
+// reports the next window on them. The windows of the migration policy of a VMI take precedence over the
+// windows of the workload update strategy. Node drains only wait when they are not allowed outside of the
+// windows. The VMIs which can be migrated right away are returned.
+func (c *EvacuationController) deferToMaintenanceWindows(node *k8sv1.Node, vmis []*virtv1.VirtualMachineInstance) ([]*virtv1.VirtualMachineInstance, error) {
+	now := time.Now()
+	kv := c.clusterConfig.GetConfigFromKubeVirtCR()
+	var migratable []*virtv1.VirtualMachineInstance
+	var nextWindow *time.Time
+	for _, vmi := range vmis {
+		open := true
+		var next *time.Time
+		if policy := migrationutils.MatchPolicyFromStores(c.migrationPolicyStore, c.namespaceStore, vmi); !drainAllowedOutsideMaintenanceWindows(vmi, policy, kv) {
+			open, next = migrationutils.MaintenanceWindowOpen(migrationutils.MaintenanceWindows(policy, kv), now)
+		}
+		if err := migrationutils.SyncAutoMigrationDeferredCondition(c.clientset, vmi, next); err != nil {
+			return nil, err
+		}
+		if open {
+			migratable = append(migratable, vmi)
+			continue
+		}
+		log.Log.Object(vmi).V(4).Infof("migration on eviction deferred to the next maintenance window")
+		if next != nil && (nextWindow == nil || next.Before(*nextWindow)) {
+			nextWindow = next
+		}
+	}
+	if nextWindow != nil {
+		c.Queue.AddAfter(node.Name, time.Until(*nextWindow))
+	}
+	return migratable, nil
+}
+
+// drainAllowedOutsideMaintenanceWindows reports whether the VMI is evicted by a node drain, as opposed to the
+// descheduler, which is allowed to migrate it outside of the maintenance windows
+func drainAllowedOutsideMaintenanceWindows(vmi *virtv1.VirtualMachineInstance, policy *v1alpha1.MigrationPolicy, kv *virtv1.KubeVirt) bool {
+	if vmi.GetAnnotations()[virtv1.EvictionSourceAnnotation] == "descheduler" {
+		return false
+	}
+	return migrationutils.NodeDrainAllowedOutsideMaintenanceWindows(policy, kv)
+}
+
 func hasMigratedOnEviction(vmi *virtv1.VirtualMachineInstance) bool {
 	return vmi.Status.NodeName != vmi.Status.EvacuationNodeName
 }
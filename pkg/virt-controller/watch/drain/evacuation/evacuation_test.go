@@ -3,6 +3,7 @@ package evacuation
 import (
 	"context"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -19,6 +20,7 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	v1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	"kubevirt.io/client-go/api"
 	"kubevirt.io/client-go/kubecli"
 
@@ -33,9 +35,10 @@ import (
 
 var _ = Describe("Evacuation", func() {
 	var (
-		virtClient *kubecli.MockKubevirtClient
-		recorder   *record.FakeRecorder
-		controller *EvacuationController
+		virtClient     *kubecli.MockKubevirtClient
+		fakeVirtClient *kubevirtfake.Clientset
+		recorder       *record.FakeRecorder
+		controller     *EvacuationController
 	)
 
 	addNode := func(node *k8sv1.Node) {
@@ -55,7 +58,7 @@ var _ = Describe("Evacuation", func() {
 	BeforeEach(func() {
 		ctrl := gomock.NewController(GinkgoT())
 		virtClient = kubecli.NewMockKubevirtClient(ctrl)
-		fakeVirtClient := kubevirtfake.NewSimpleClientset()
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
 
 		vmiInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstance{}, cache.Indexers{
 			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
@@ -66,6 +69,8 @@ var _ = Describe("Evacuation", func() {
 		migrationInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstanceMigration{}, virtcontroller.GetVirtualMachineInstanceMigrationInformerIndexers())
 		nodeInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Node{})
 		podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		migrationPolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
+		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 		config, _, kvStore := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
@@ -76,12 +81,13 @@ var _ = Describe("Evacuation", func() {
 			testutils.UpdateFakeKubeVirtClusterConfig(kvStore, kv)
 		}
 
-		controller, _ = NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, recorder, virtClient, config)
+		controller, _ = NewEvacuationController(vmiInformer, migrationInformer, nodeInformer, podInformer, migrationPolicyInformer, namespaceInformer, recorder, virtClient, config)
 		mockQueue := testutils.NewMockWorkQueue(controller.Queue)
 		controller.Queue = mockQueue
 
 		// Set up mock client
 		virtClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().VirtualMachineInstances(k8sv1.NamespaceDefault)).AnyTimes()
 		kubeClient := fake.NewSimpleClientset()
 		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
 		virtClient.EXPECT().PolicyV1().Return(kubeClient.PolicyV1()).AnyTimes()
@@ -96,6 +102,7 @@ var _ = Describe("Evacuation", func() {
 	sanityExecute := func() {
 		controllertesting.SanityExecute(controller, []cache.Store{
 			controller.vmiIndexer, controller.vmiPodIndexer, controller.migrationIndexer, controller.nodeStore,
+			controller.migrationPolicyStore, controller.namespaceStore,
 		}, Default)
 
 	}
@@ -511,6 +518,201 @@ var _ = Describe("Evacuation", func() {
 		})
 	})
 
+	Context("VMIs marked for eviction with maintenance windows", func() {
+		const policyLabel = "maintenance"
+
+		// closedWindow opens in two hours, the current time is never part of it
+		closedWindow := func() (v1.MaintenanceWindow, time.Time) {
+			next := time.Now().UTC().Truncate(time.Hour).Add(2 * time.Hour)
+			return v1.MaintenanceWindow{Schedule: fmt.Sprintf("* %d * * *", next.Hour())}, next
+		}
+
+		addPolicy := func(allowNodeDrain *bool, windows ...v1.MaintenanceWindow) {
+			policy := kubecli.NewMinimalMigrationPolicy("night")
+			policy.Spec.Selectors = &migrationsv1.Selectors{
+				VirtualMachineInstanceSelector: migrationsv1.LabelSelector{policyLabel: "night"},
+			}
+			policy.Spec.MaintenanceWindows = windows
+			policy.Spec.AllowNodeDrainOutsideMaintenanceWindows = allowNodeDrain
+			Expect(controller.migrationPolicyStore.Add(policy)).To(Succeed())
+		}
+
+		addEvictedVMI := func(node *k8sv1.Node, fromDescheduler bool) *v1.VirtualMachineInstance {
+			vmi := newVirtualMachineMarkedForEviction("testvm", node.Name)
+			vmi.Labels = map[string]string{policyLabel: "night"}
+			if fromDescheduler {
+				vmi.Annotations = map[string]string{v1.EvictionSourceAnnotation: "descheduler"}
+			}
+			_, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+			return vmi
+		}
+
+		expectNoMigration := func() {
+			migrationList, err := virtClient.VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			ExpectWithOffset(1, migrationList.Items).To(BeEmpty())
+		}
+
+		getDeferredCondition := func(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstanceCondition {
+			updatedVMI, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			return virtcontroller.NewVirtualMachineInstanceConditionManager().GetCondition(updatedVMI, v1.VirtualMachineInstanceAutoMigrationDeferred)
+		}
+
+		It("should defer the migration of a descheduler eviction to the next window", func() {
+			node := newNode("foo")
+			addNode(node)
+			enqueue(node)
+			window, next := closedWindow()
+			addPolicy(nil, window)
+			vmi := addEvictedVMI(node, true)
+
+			sanityExecute()
+			expectNoMigration()
+			Expect(controller.Queue.(*testutils.MockWorkQueue[string]).GetAddAfterEnqueueCount()).To(Equal(1))
+			condition := getDeferredCondition(vmi)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(k8sv1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonAutoMigrationPending))
+			Expect(condition.Message).To(ContainSubstring(next.Format(time.RFC3339)))
+		})
+
+		It("should migrate a descheduler eviction within a window and clear the deferral", func() {
+			node := newNode("foo")
+			addNode(node)
+			enqueue(node)
+			addPolicy(nil, v1.MaintenanceWindow{Schedule: "* * * * *", TimeZone: pointer.P("Europe/Paris")})
+			vmi := newVirtualMachineMarkedForEviction("testvm", node.Name)
+			vmi.Labels = map[string]string{policyLabel: "night"}
+			vmi.Annotations = map[string]string{v1.EvictionSourceAnnotation: "descheduler"}
+			vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+				Type:   v1.VirtualMachineInstanceAutoMigrationDeferred,
+				Status: k8sv1.ConditionTrue,
+				Reason: v1.VirtualMachineInstanceReasonAutoMigrationPending,
+			})
+			_, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreation()
+			Expect(getDeferredCondition(vmi)).To(BeNil())
+		})
+
+		It("should migrate a node drain outside of the windows by default", func() {
+			node := newNode("foo")
+			addNode(node)
+			enqueue(node)
+			window, _ := closedWindow()
+			addPolicy(nil, window)
+			vmi := addEvictedVMI(node, false)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreation()
+			Expect(getDeferredCondition(vmi)).To(BeNil())
+		})
+
+		It("should defer a node drain when the policy does not allow it outside of the windows", func() {
+			node := newNode("foo")
+			addNode(node)
+			enqueue(node)
+			window, _ := closedWindow()
+			addPolicy(pointer.P(false), window)
+			vmi := addEvictedVMI(node, false)
+
+			sanityExecute()
+			expectNoMigration()
+			Expect(getDeferredCondition(vmi)).ToNot(BeNil())
+		})
+
+		It("should defer a descheduler eviction to the windows of the workload update strategy without a policy window", func() {
+			node := newNode("foo")
+			addNode(node)
+			enqueue(node)
+			window, next := closedWindow()
+			updateKV(func(kv *v1.KubeVirt) {
+				kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []v1.MaintenanceWindow{window}
+			})
+			addPolicy(nil)
+			vmi := addEvictedVMI(node, true)
+
+			sanityExecute()
+			expectNoMigration()
+			condition := getDeferredCondition(vmi)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Message).To(ContainSubstring(next.Format(time.RFC3339)))
+		})
+
+		It("should prefer the windows of the policy over the windows of the workload update strategy", func() {
+			node := newNode("foo")
+			addNode(node)
+			enqueue(node)
+			window, _ := closedWindow()
+			updateKV(func(kv *v1.KubeVirt) {
+				kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []v1.MaintenanceWindow{window}
+			})
+			addPolicy(nil, v1.MaintenanceWindow{Schedule: "* * * * *"})
+			vmi := addEvictedVMI(node, true)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreation()
+			Expect(getDeferredCondition(vmi)).To(BeNil())
+		})
+
+		It("should defer a node drain when the workload update strategy does not allow it outside of the windows", func() {
+			node := newNode("foo")
+			addNode(node)
+			enqueue(node)
+			window, _ := closedWindow()
+			updateKV(func(kv *v1.KubeVirt) {
+				kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []v1.MaintenanceWindow{window}
+				kv.Spec.WorkloadUpdateStrategy.AllowNodeDrainOutsideMaintenanceWindows = pointer.P(false)
+			})
+			vmi := addEvictedVMI(node, false)
+
+			sanityExecute()
+			expectNoMigration()
+			Expect(getDeferredCondition(vmi)).ToNot(BeNil())
+		})
+
+		It("should let the policy allow a node drain the workload update strategy does not allow outside of the windows", func() {
+			node := newNode("foo")
+			addNode(node)
+			enqueue(node)
+			window, _ := closedWindow()
+			updateKV(func(kv *v1.KubeVirt) {
+				kv.Spec.WorkloadUpdateStrategy.AllowNodeDrainOutsideMaintenanceWindows = pointer.P(false)
+			})
+			addPolicy(pointer.P(true), window)
+			vmi := addEvictedVMI(node, false)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreation()
+			Expect(getDeferredCondition(vmi)).To(BeNil())
+		})
+
+		It("should not defer the VMIs of a node with the drain taint", func() {
+			node := newNode("foo")
+			node.Spec.Taints = append(node.Spec.Taints, *newTaint())
+			addNode(node)
+			enqueue(node)
+			window, _ := closedWindow()
+			addPolicy(pointer.P(false), window)
+			vmi := addEvictedVMI(node, true)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			expectMigrationCreation()
+			Expect(getDeferredCondition(vmi)).To(BeNil())
+		})
+	})
+
 	AfterEach(func() {
 		// Ensure that we add checks for expected events to every test
 		Expect(recorder.Events).To(BeEmpty())
@@ -73,6 +73,7 @@ const (
 	successfulUpdatePodDisruptionBudgetReason = "SuccessfulUpdate"
 	failedUpdatePodDisruptionBudgetReason     = "FailedUpdate"
 	failedGetAttractionPodsFmt                = "failed to get attachment pods: %v"
+	unsupportedMigrationCompressionReason     = "UnsupportedMigrationCompression"
 )
 
 const vmiPodIndex = "vmiPodIndex"
@@ -958,7 +959,7 @@ func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMig
 
 	// Ensure migration happens only between nodes with the same CPU vendor
 	// This prevents migrations between AMD and Intel nodes which are not supported
-	vendorLabelKey := getCPUVendorLabelKey(templatePod.Spec.NodeSelector)
+	vendorLabelKey := migrationsutil.CPUVendorLabelKey(templatePod.Spec.NodeSelector)
 	if vendorLabelKey == "" {
 		var sourceLabels map[string]string
 		if migration.IsDecentralizedTarget() {
@@ -971,7 +972,7 @@ func (c *Controller) createTargetPod(migration *virtv1.VirtualMachineInstanceMig
 			sourceLabels = node.Labels
 		}
 
-		vendorLabelKey = getCPUVendorLabelKey(sourceLabels)
+		vendorLabelKey = migrationsutil.CPUVendorLabelKey(sourceLabels)
 		if vendorLabelKey != "" {
 			templatePod.Spec.NodeSelector[vendorLabelKey] = "true"
 		}
@@ -1221,6 +1222,7 @@ func (c *Controller) handleTargetPodHandoff(migration *virtv1.VirtualMachineInst
 	if !c.isMigrationPolicyMatched(vmiCopy) {
 		vmiCopy.Status.MigrationState.MigrationConfiguration = clusterMigrationConfigs
 	}
+	c.dropUnsupportedCompression(migration, vmiCopy)
 
 	if controller.VMIHasHotplugCPU(vmi) && vmi.IsCPUDedicated() {
 		cpuLimitsCount, err := getTargetPodLimitsCount(pod)
@@ -2373,7 +2375,7 @@ func (c *Controller) alertIfHostModelIsUnschedulable(vmi *virtv1.VirtualMachineI
 }
 
 func getNodeSelectorsFromVMIMigrationSourceState(sourceState *virtv1.VirtualMachineInstanceMigrationSourceState) (map[string]string, error) {
-	result, nodeSelectorKeyForHostModel, err := getHostCpuModelFromMap(sourceState.NodeSelectors)
+	result, nodeSelectorKeyForHostModel, err := migrationsutil.HostCPUModelNodeSelector(sourceState.NodeSelectors)
 	if err != nil {
 		return nil, err
 	}
@@ -2397,7 +2399,7 @@ func prepareNodeSelectorForHostCpuModel(node *k8sv1.Node, pod *k8sv1.Pod, source
 	if !migratedAtLeastOnce {
 		// only copy node label keys when the VM has not migrated before. Otherwise if we migrate again
 		// we could be adding labels we don't want which could prevent migrating back to the original node.
-		hostCpuModelMap, nodeSelectorKeyForHostModel, err := getHostCpuModelFromMap(node.Labels)
+		hostCpuModelMap, nodeSelectorKeyForHostModel, err := migrationsutil.HostCPUModelNodeSelector(node.Labels)
 		if err != nil {
 			return nil, err
 		}
@@ -2408,32 +2410,6 @@ func prepareNodeSelectorForHostCpuModel(node *k8sv1.Node, pod *k8sv1.Pod, source
 	return result, nil
 }
 
-func getHostCpuModelFromMap(selectorMap map[string]string) (map[string]string, string, error) {
-	result := make(map[string]string)
-	var hostCpuModel, nodeSelectorKeyForHostModel, hostModelLabelValue string
-
-	for key, value := range selectorMap {
-		if strings.HasPrefix(key, virtv1.HostModelCPULabel) {
-			hostCpuModel = strings.TrimPrefix(key, virtv1.HostModelCPULabel)
-			hostModelLabelValue = value
-		}
-
-		if strings.HasPrefix(key, virtv1.HostModelRequiredFeaturesLabel) {
-			requiredFeature := strings.TrimPrefix(key, virtv1.HostModelRequiredFeaturesLabel)
-			result[virtv1.CPUFeatureLabel+requiredFeature] = value
-		}
-	}
-
-	if hostCpuModel == "" {
-		return nil, "", fmt.Errorf("unable to locate host cpu model, does not contain label \"%s\" with information", virtv1.HostModelCPULabel)
-	}
-
-	nodeSelectorKeyForHostModel = virtv1.SupportedHostModelMigrationCPU + hostCpuModel
-	result[nodeSelectorKeyForHostModel] = hostModelLabelValue
-
-	return result, nodeSelectorKeyForHostModel, nil
-}
-
 func isNodeSuitableForHostModelMigration(node *k8sv1.Node, requiredNodeLabels map[string]string) bool {
 	for key, value := range requiredNodeLabels {
 		nodeValue, ok := node.Labels[key]
@@ -2446,13 +2422,21 @@ func isNodeSuitableForHostModelMigration(node *k8sv1.Node, requiredNodeLabels ma
 	return true
 }
 
-func getCPUVendorLabelKey(labels map[string]string) string {
-	for key := range labels {
-		if strings.HasPrefix(key, virtv1.CPUModelVendorLabel) {
-			return key
-		}
+// dropUnsupportedCompression removes a compression which requires a parallel migration from the
+// migration state when the vmi is not migrated in parallel, so the state shows what is used
+func (c *Controller) dropUnsupportedCompression(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) {
+	migrationConfiguration := vmi.Status.MigrationState.MigrationConfiguration
+	if migrationConfiguration == nil || !migrationsutil.RequiresParallelMigration(migrationConfiguration.Compression) {
+		return
+	}
+	allowPostCopy := migrationConfiguration.AllowPostCopy != nil && *migrationConfiguration.AllowPostCopy
+	if migrationsutil.IsParallelMigration(vmi, allowPostCopy) {
+		return
 	}
-	return ""
+	c.recorder.Eventf(migration, k8sv1.EventTypeWarning, unsupportedMigrationCompressionReason,
+		"%s compression is not used, it requires a parallel migration, which is not used together with post-copy or a CPU limit",
+		migrationConfiguration.Compression.Method)
+	migrationConfiguration.Compression = nil
 }
 
 func (c *Controller) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, clusterMigrationConfiguration *virtv1.MigrationConfiguration) error {
@@ -2471,7 +2455,7 @@ func (c *Controller) matchMigrationPolicy(vmi *virtv1.VirtualMachineInstance, cl
 	policiesListObj := v1alpha1.MigrationPolicyList{Items: policies}
 
 	// Override cluster-wide migration configuration if migration policy is matched
-	matchedPolicy := matchPolicy(&policiesListObj, vmi, vmiNamespace)
+	matchedPolicy := migrationsutil.MatchPolicy(&policiesListObj, vmi, vmiNamespace)
 
 	if matchedPolicy == nil {
 		log.Log.Object(vmi).Reason(err).Infof("no migration policy matched for VMI %s", vmi.Name)
@@ -2007,7 +2007,7 @@ var _ = Describe("Migration watcher", func() {
 				}
 
 				policyList := kubecli.NewMinimalMigrationPolicyList(policies...)
-				actualMatchedPolicy := matchPolicy(policyList, vmi, &namespace)
+				actualMatchedPolicy := migrationsutil.MatchPolicy(policyList, vmi, &namespace)
 
 				Expect(actualMatchedPolicy).ToNot(BeNil())
 				Expect(actualMatchedPolicy.Name).To(Equal(expectedMatchedPolicyName))
@@ -2032,13 +2032,13 @@ var _ = Describe("Migration watcher", func() {
 				policy.Spec.Selectors.VirtualMachineInstanceSelector[fmt.Sprintf(labelKeyFmt, policy.Name)] = "XYZ"
 				policyList := kubecli.NewMinimalMigrationPolicyList(*policy)
 
-				matchedPolicy := matchPolicy(policyList, vmi, &namespace)
+				matchedPolicy := migrationsutil.MatchPolicy(policyList, vmi, &namespace)
 				Expect(matchedPolicy).To(BeNil())
 			})
 
 			It("when no policies exist, MatchPolicy() should return nil", func() {
 				policyList := kubecli.NewMinimalMigrationPolicyList()
-				matchedPolicy := matchPolicy(policyList, vmi, &namespace)
+				matchedPolicy := migrationsutil.MatchPolicy(policyList, vmi, &namespace)
 				Expect(matchedPolicy).To(BeNil())
 			})
 
@@ -2052,7 +2052,7 @@ var _ = Describe("Migration watcher", func() {
 				policyList := kubecli.NewMinimalMigrationPolicyList(*policyWithNSLabels, *policyWithVmiLabels)
 
 				By("Expecting VMI labels policy to be matched")
-				matchedPolicy := matchPolicy(policyList, vmi, &namespace)
+				matchedPolicy := migrationsutil.MatchPolicy(policyList, vmi, &namespace)
 				Expect(matchedPolicy.Name).To(Equal(policyWithVmiLabels.Name), "policy with VMI labels should match")
 			})
 		})
@@ -2154,12 +2154,50 @@ var _ = Describe("Migration watcher", func() {
 				},
 				true,
 			),
+			Entry("set compression",
+				func(p *migrationsv1.MigrationPolicySpec) {
+					p.Compression = &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.P(int32(3))}
+				},
+				func(c *v1.MigrationConfiguration) {
+					Expect(c.Compression).To(Equal(&v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.P(int32(3))}))
+				},
+				true,
+			),
 			Entry("nothing is changed",
 				func(p *migrationsv1.MigrationPolicySpec) {},
 				func(c *v1.MigrationConfiguration) {},
 				false,
 			),
 		)
+
+		It("should drop a parallel compression from the migration state when the VMI has a CPU limit", func() {
+			vmi = newVirtualMachine("testvmi", v1.Running)
+			vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("4")}
+			migration := newMigration("testmigration", vmi.Name, v1.MigrationScheduled)
+
+			targetPod = newTargetPodForVirtualMachine(vmi, migration, k8sv1.PodRunning)
+			targetPod.Spec.NodeName = "node01"
+			targetPod.Status.ContainerStatuses = []k8sv1.ContainerStatus{{
+				Name: "compute", State: k8sv1.ContainerState{Running: &k8sv1.ContainerStateRunning{}},
+			}}
+
+			migrationPolicy := generatePolicyAndAlignVMI(vmi)
+			migrationPolicy.Spec.Compression = &v1.MigrationCompression{Method: v1.MigrationCompressionZstd}
+
+			addMigrationPolicies(*migrationPolicy)
+			addMigration(migration)
+			addPod(targetPod)
+			addVirtualMachineInstance(vmi)
+			addPod(newSourcePodForVirtualMachine(vmi))
+
+			sanityExecute()
+
+			testutils.ExpectEvents(recorder, unsupportedMigrationCompressionReason, virtcontroller.SuccessfulHandOverPodReason)
+			updatedVMI, err := virtClientset.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updatedVMI.Status.MigrationState.MigrationPolicyName).To(HaveValue(Equal(migrationPolicy.Name)))
+			Expect(updatedVMI.Status.MigrationState.MigrationConfiguration.Compression).To(BeNil())
+		})
 	})
 
 	Context("Migration of host-model VMI", func() {
@@ -0,0 +1,422 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	metrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-controller"
+	migrationsutil "kubevirt.io/kubevirt/pkg/util/migrations"
+)
+
+const (
+	// the status of all the policies is computed at once, since which policy applies
+	// to a VMI depends on all the policies selecting it
+	policyStatusKey = "migration-policies"
+
+	defaultPolicyStatusThrottleInterval = 5 * time.Second
+)
+
+// PolicyStatusController keeps the status of the migration policies up to date with the VMIs they
+// select and the outcome of the recent migrations performed under them
+type PolicyStatusController struct {
+	clientset            kubecli.KubevirtClient
+	queue                workqueue.TypedRateLimitingInterface[string]
+	migrationPolicyStore cache.Store
+	vmiStore             cache.Store
+	namespaceStore       cache.Store
+	migrationStore       cache.Store
+
+	throttleInterval time.Duration
+
+	hasSynced func() bool
+}
+
+func NewPolicyStatusController(
+	migrationPolicyInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	namespaceInformer cache.SharedIndexInformer,
+	migrationInformer cache.SharedIndexInformer,
+	clientset kubecli.KubevirtClient,
+) (*PolicyStatusController, error) {
+	c := &PolicyStatusController{
+		clientset: clientset,
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-migration-policy-status"},
+		),
+		migrationPolicyStore: migrationPolicyInformer.GetStore(),
+		vmiStore:             vmiInformer.GetStore(),
+		namespaceStore:       namespaceInformer.GetStore(),
+		migrationStore:       migrationInformer.GetStore(),
+		throttleInterval:     defaultPolicyStatusThrottleInterval,
+		hasSynced: func() bool {
+			return migrationPolicyInformer.HasSynced() && vmiInformer.HasSynced() &&
+				namespaceInformer.HasSynced() && migrationInformer.HasSynced()
+		},
+	}
+
+	_, err := migrationPolicyInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueue() },
+		UpdateFunc: c.updatePolicy,
+		DeleteFunc: c.deletePolicy,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueue() },
+		UpdateFunc: c.updateVMI,
+		DeleteFunc: func(_ interface{}) { c.enqueue() },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = namespaceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.updateNamespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = migrationInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: c.updateMigration,
+		DeleteFunc: func(_ interface{}) { c.enqueue() },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *PolicyStatusController) enqueue() {
+	c.queue.AddAfter(policyStatusKey, c.throttleInterval)
+}
+
+func (c *PolicyStatusController) updatePolicy(old, curr interface{}) {
+	oldPolicy, ok := old.(*v1alpha1.MigrationPolicy)
+	if !ok {
+		return
+	}
+	currPolicy, ok := curr.(*v1alpha1.MigrationPolicy)
+	if !ok {
+		return
+	}
+	// status updates of the controller itself do not change the outcome
+	if equality.Semantic.DeepEqual(oldPolicy.Spec, currPolicy.Spec) {
+		return
+	}
+	c.enqueue()
+}
+
+func (c *PolicyStatusController) deletePolicy(obj interface{}) {
+	policy, ok := obj.(*v1alpha1.MigrationPolicy)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		policy, ok = tombstone.Obj.(*v1alpha1.MigrationPolicy)
+		if !ok {
+			return
+		}
+	}
+	metrics.DeleteMigrationPolicyStatus(policy.Name)
+	c.enqueue()
+}
+
+func (c *PolicyStatusController) updateVMI(old, curr interface{}) {
+	oldVMI, ok := old.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		return
+	}
+	currVMI, ok := curr.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		return
+	}
+	if oldVMI.IsFinal() == currVMI.IsFinal() && equality.Semantic.DeepEqual(oldVMI.Labels, currVMI.Labels) {
+		return
+	}
+	c.enqueue()
+}
+
+func (c *PolicyStatusController) updateNamespace(old, curr interface{}) {
+	oldNamespace, ok := old.(*k8sv1.Namespace)
+	if !ok {
+		return
+	}
+	currNamespace, ok := curr.(*k8sv1.Namespace)
+	if !ok {
+		return
+	}
+	if equality.Semantic.DeepEqual(oldNamespace.Labels, currNamespace.Labels) {
+		return
+	}
+	c.enqueue()
+}
+
+func (c *PolicyStatusController) updateMigration(old, curr interface{}) {
+	oldMigration, ok := old.(*virtv1.VirtualMachineInstanceMigration)
+	if !ok {
+		return
+	}
+	currMigration, ok := curr.(*virtv1.VirtualMachineInstanceMigration)
+	if !ok {
+		return
+	}
+	if oldMigration.IsFinal() || !currMigration.IsFinal() {
+		return
+	}
+	c.enqueue()
+}
+
+// Run runs the passed in PolicyStatusController.
+func (c *PolicyStatusController) Run(stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.queue.ShutDown()
+	log.Log.Info("Starting migration policy status controller.")
+
+	// The queue holds a single key, the status of all the policies is computed at once
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+	c.queue.Add(policyStatusKey)
+
+	go wait.Until(c.runWorker, time.Second, stopCh)
+
+	<-stopCh
+	log.Log.Info("Stopping migration policy status controller.")
+}
+
+func (c *PolicyStatusController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *PolicyStatusController) Execute() bool {
+	key, quit := c.queue.Get()
+	if quit {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	if err := c.execute(); err != nil {
+		log.Log.Reason(err).Info("reenqueuing migration policy status update")
+		c.queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Info("processed migration policy status update")
+		c.queue.Forget(key)
+	}
+	return true
+}
+
+func (c *PolicyStatusController) execute() error {
+	var policies []v1alpha1.MigrationPolicy
+	for _, obj := range c.migrationPolicyStore.List() {
+		policies = append(policies, *obj.(*v1alpha1.MigrationPolicy))
+	}
+	sort.Slice(policies, func(i, j int) bool { return policies[i].Name < policies[j].Name })
+
+	statuses := c.computeStatuses(policies)
+
+	var errs []error
+	for i := range policies {
+		policy := &policies[i]
+		status := statuses[policy.Name]
+		metrics.SetMigrationPolicyStatus(policy.Name, status)
+		if equality.Semantic.DeepEqual(policy.Status, *status) {
+			continue
+		}
+
+		policyCopy := policy.DeepCopy()
+		policyCopy.Status = *status
+		if _, err := c.clientset.MigrationPolicy().UpdateStatus(context.Background(), policyCopy, metav1.UpdateOptions{}); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (c *PolicyStatusController) computeStatuses(policies []v1alpha1.MigrationPolicy) map[string]*v1alpha1.MigrationPolicyStatus {
+	statuses := make(map[string]*v1alpha1.MigrationPolicyStatus, len(policies))
+	overriding := make(map[string]map[string]int32, len(policies))
+	for _, policy := range policies {
+		statuses[policy.Name] = &v1alpha1.MigrationPolicyStatus{}
+		overriding[policy.Name] = map[string]int32{}
+	}
+
+	for appliedName, vmis := range c.indexVMIsByAppliedPolicy(policies) {
+		statuses[appliedName].AppliedVirtualMachineInstances = int32(len(vmis))
+		statuses[appliedName].MatchedVirtualMachineInstances += int32(len(vmis))
+		for _, vmi := range vmis {
+			for _, overriddenName := range vmi.overriddenPolicies {
+				statuses[overriddenName].MatchedVirtualMachineInstances++
+				overriding[overriddenName][appliedName]++
+			}
+		}
+	}
+
+	for name, status := range statuses {
+		for overridingName, count := range overriding[name] {
+			status.OverridingPolicies = append(status.OverridingPolicies, v1alpha1.OverridingMigrationPolicy{
+				Name:                    overridingName,
+				VirtualMachineInstances: count,
+			})
+		}
+		sort.Slice(status.OverridingPolicies, func(i, j int) bool {
+			return status.OverridingPolicies[i].Name < status.OverridingPolicies[j].Name
+		})
+	}
+
+	for name, outcomes := range c.migrationOutcomesByPolicy() {
+		if status, exists := statuses[name]; exists {
+			status.Migrations = outcomes.statistics()
+		}
+	}
+	return statuses
+}
+
+// indexedVMI is a VMI indexed by the policy applied to it
+type indexedVMI struct {
+	vmi *virtv1.VirtualMachineInstance
+	// the other policies selecting the VMI, which the applied policy overrides
+	overriddenPolicies []string
+}
+
+// indexVMIsByAppliedPolicy indexes the non final VMIs by the name of the policy applied to them. Every VMI is
+// matched against the policies once, the applied policy is then chosen among the policies selecting the VMI.
+func (c *PolicyStatusController) indexVMIsByAppliedPolicy(policies []v1alpha1.MigrationPolicy) map[string][]indexedVMI {
+	index := map[string][]indexedVMI{}
+	for _, obj := range c.vmiStore.List() {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.IsFinal() {
+			continue
+		}
+		namespace := c.getNamespace(vmi.Namespace)
+		matching := &v1alpha1.MigrationPolicyList{}
+		for i := range policies {
+			if migrationsutil.MatchesPolicy(&policies[i], vmi, namespace) {
+				matching.Items = append(matching.Items, policies[i])
+			}
+		}
+		applied := migrationsutil.MatchPolicy(matching, vmi, namespace)
+		if applied == nil {
+			continue
+		}
+
+		indexed := indexedVMI{vmi: vmi}
+		for _, policy := range matching.Items {
+			if policy.Name != applied.Name {
+				indexed.overriddenPolicies = append(indexed.overriddenPolicies, policy.Name)
+			}
+		}
+		index[applied.Name] = append(index[applied.Name], indexed)
+	}
+	return index
+}
+
+func (c *PolicyStatusController) getNamespace(name string) *k8sv1.Namespace {
+	obj, exists, err := c.namespaceStore.GetByKey(name)
+	if err != nil || !exists {
+		return &k8sv1.Namespace{}
+	}
+	return obj.(*k8sv1.Namespace)
+}
+
+// migrationOutcomesByPolicy gathers the final migrations still known to the cluster, which are the
+// recent ones since the migration controller garbage collects the older ones
+func (c *PolicyStatusController) migrationOutcomesByPolicy() map[string]*migrationOutcomes {
+	outcomesByPolicy := map[string]*migrationOutcomes{}
+	for _, obj := range c.migrationStore.List() {
+		migration := obj.(*virtv1.VirtualMachineInstanceMigration)
+		state := migration.Status.MigrationState
+		if !migration.IsFinal() || state == nil || state.MigrationPolicyName == nil {
+			continue
+		}
+		outcomes, exists := outcomesByPolicy[*state.MigrationPolicyName]
+		if !exists {
+			outcomes = &migrationOutcomes{}
+			outcomesByPolicy[*state.MigrationPolicyName] = outcomes
+		}
+		outcomes.add(migration)
+	}
+	return outcomesByPolicy
+}
+
+type migrationOutcome struct {
+	count         int32
+	timed         int64
+	totalDuration time.Duration
+}
+
+func (o *migrationOutcome) add(state *virtv1.VirtualMachineInstanceMigrationState) {
+	o.count++
+	if state.StartTimestamp == nil || state.EndTimestamp == nil {
+		return
+	}
+	o.timed++
+	o.totalDuration += state.EndTimestamp.Sub(state.StartTimestamp.Time)
+}
+
+func (o *migrationOutcome) averageDuration() *metav1.Duration {
+	if o.timed == 0 {
+		return nil
+	}
+	return &metav1.Duration{Duration: (o.totalDuration / time.Duration(o.timed)).Round(time.Second)}
+}
+
+type migrationOutcomes struct {
+	succeeded migrationOutcome
+	failed    migrationOutcome
+}
+
+func (o *migrationOutcomes) add(migration *virtv1.VirtualMachineInstanceMigration) {
+	if migration.Status.Phase == virtv1.MigrationSucceeded {
+		o.succeeded.add(migration.Status.MigrationState)
+	} else {
+		o.failed.add(migration.Status.MigrationState)
+	}
+}
+
+func (o *migrationOutcomes) statistics() *v1alpha1.MigrationPolicyMigrationStatistics {
+	return &v1alpha1.MigrationPolicyMigrationStatistics{
+		Succeeded:                o.succeeded.count,
+		Failed:                   o.failed.count,
+		AverageSucceededDuration: o.succeeded.averageDuration(),
+		AverageFailedDuration:    o.failed.averageDuration(),
+	}
+}
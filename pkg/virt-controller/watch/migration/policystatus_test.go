@@ -0,0 +1,213 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package migration
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("Migration policy status controller", func() {
+	const (
+		genericPolicy  = "generic"
+		specificPolicy = "specific"
+	)
+
+	var (
+		controller    *PolicyStatusController
+		virtClientset *kubevirtfake.Clientset
+	)
+
+	BeforeEach(func() {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClientset = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().MigrationPolicy().Return(virtClientset.MigrationsV1alpha1().MigrationPolicies()).AnyTimes()
+
+		migrationPolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
+		vmiInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
+		migrationInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineInstanceMigration{})
+
+		var err error
+		controller, err = NewPolicyStatusController(migrationPolicyInformer, vmiInformer, namespaceInformer, migrationInformer, virtClient)
+		Expect(err).ToNot(HaveOccurred())
+		controller.throttleInterval = 0
+
+		Expect(controller.namespaceStore.Add(&k8sv1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: metav1.NamespaceDefault, Labels: map[string]string{"env": "prod"}},
+		})).To(Succeed())
+	})
+
+	addPolicy := func(name string, vmiSelector, namespaceSelector migrationsv1.LabelSelector) {
+		policy := &migrationsv1.MigrationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: migrationsv1.MigrationPolicySpec{
+				Selectors: &migrationsv1.Selectors{
+					VirtualMachineInstanceSelector: vmiSelector,
+					NamespaceSelector:              namespaceSelector,
+				},
+			},
+		}
+		Expect(controller.migrationPolicyStore.Add(policy)).To(Succeed())
+		_, err := virtClientset.MigrationsV1alpha1().MigrationPolicies().Create(context.Background(), policy, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	addVMI := func(name string, phase v1.VirtualMachineInstancePhase, labels map[string]string) {
+		Expect(controller.vmiStore.Add(&v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault, Labels: labels},
+			Status:     v1.VirtualMachineInstanceStatus{Phase: phase},
+		})).To(Succeed())
+	}
+
+	addMigration := func(name string, phase v1.VirtualMachineInstanceMigrationPhase, policyName string, duration time.Duration) {
+		state := &v1.VirtualMachineInstanceMigrationState{MigrationPolicyName: pointer.P(policyName)}
+		if duration > 0 {
+			start := metav1.NewTime(time.Now().Add(-duration))
+			state.StartTimestamp = &start
+			state.EndTimestamp = pointer.P(metav1.NewTime(start.Add(duration)))
+		}
+		Expect(controller.migrationStore.Add(&v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+			Status:     v1.VirtualMachineInstanceMigrationStatus{Phase: phase, MigrationState: state},
+		})).To(Succeed())
+	}
+
+	getStatus := func(name string) migrationsv1.MigrationPolicyStatus {
+		policy, err := virtClientset.MigrationsV1alpha1().MigrationPolicies().Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return policy.Status
+	}
+
+	It("should count the matched and applied VMIs and the overriding policies", func() {
+		addPolicy(genericPolicy, nil, migrationsv1.LabelSelector{"env": "prod"})
+		addPolicy(specificPolicy, migrationsv1.LabelSelector{"app": "db"}, migrationsv1.LabelSelector{"env": "prod"})
+		addVMI("db", v1.Running, map[string]string{"app": "db"})
+		addVMI("web", v1.Running, map[string]string{"app": "web"})
+		addVMI("old-db", v1.Succeeded, map[string]string{"app": "db"})
+
+		Expect(controller.execute()).To(Succeed())
+
+		Expect(getStatus(genericPolicy)).To(Equal(migrationsv1.MigrationPolicyStatus{
+			MatchedVirtualMachineInstances: 2,
+			AppliedVirtualMachineInstances: 1,
+			OverridingPolicies: []migrationsv1.OverridingMigrationPolicy{
+				{Name: specificPolicy, VirtualMachineInstances: 1},
+			},
+		}))
+		Expect(getStatus(specificPolicy)).To(Equal(migrationsv1.MigrationPolicyStatus{
+			MatchedVirtualMachineInstances: 1,
+			AppliedVirtualMachineInstances: 1,
+		}))
+	})
+
+	It("should choose the overriding policy by name when policies are equally specific", func() {
+		addPolicy("b-policy", migrationsv1.LabelSelector{"app": "db"}, nil)
+		addPolicy("a-policy", migrationsv1.LabelSelector{"tier": "backend"}, nil)
+		addVMI("db", v1.Running, map[string]string{"app": "db", "tier": "backend"})
+
+		Expect(controller.execute()).To(Succeed())
+
+		Expect(getStatus("a-policy").AppliedVirtualMachineInstances).To(Equal(int32(1)))
+		Expect(getStatus("b-policy").AppliedVirtualMachineInstances).To(BeZero())
+		Expect(getStatus("b-policy").OverridingPolicies).To(ConsistOf(migrationsv1.OverridingMigrationPolicy{Name: "a-policy", VirtualMachineInstances: 1}))
+	})
+
+	It("should compute the statistics of the recent migrations under the policy", func() {
+		addPolicy(genericPolicy, nil, migrationsv1.LabelSelector{"env": "prod"})
+		addMigration("succeeded-1", v1.MigrationSucceeded, genericPolicy, time.Minute)
+		addMigration("succeeded-2", v1.MigrationSucceeded, genericPolicy, 2*time.Minute)
+		addMigration("failed", v1.MigrationFailed, genericPolicy, 0)
+		addMigration("running", v1.MigrationRunning, genericPolicy, 0)
+		addMigration("other-policy", v1.MigrationSucceeded, "deleted-policy", time.Minute)
+
+		Expect(controller.execute()).To(Succeed())
+
+		Expect(getStatus(genericPolicy).Migrations).To(Equal(&migrationsv1.MigrationPolicyMigrationStatistics{
+			Succeeded:                2,
+			Failed:                   1,
+			AverageSucceededDuration: &metav1.Duration{Duration: 90 * time.Second},
+		}))
+	})
+
+	It("should not update an unchanged status", func() {
+		addPolicy(genericPolicy, nil, migrationsv1.LabelSelector{"env": "prod"})
+		Expect(controller.execute()).To(Succeed())
+		Expect(virtClientset.Actions()).To(HaveLen(1))
+
+		virtClientset.ClearActions()
+		Expect(controller.execute()).To(Succeed())
+		Expect(virtClientset.Actions()).To(BeEmpty())
+	})
+
+	DescribeTable("should enqueue on VMI updates", func(oldVMI, newVMI *v1.VirtualMachineInstance, expectEnqueue bool) {
+		controller.updateVMI(oldVMI, newVMI)
+		if expectEnqueue {
+			Eventually(controller.queue.Len).Should(Equal(1))
+		} else {
+			Consistently(controller.queue.Len).WithTimeout(100 * time.Millisecond).Should(BeZero())
+		}
+	},
+		Entry("when the labels changed",
+			&v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "db"}}},
+			&v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"app": "web"}}},
+			true),
+		Entry("when the VMI became final",
+			&v1.VirtualMachineInstance{Status: v1.VirtualMachineInstanceStatus{Phase: v1.Running}},
+			&v1.VirtualMachineInstance{Status: v1.VirtualMachineInstanceStatus{Phase: v1.Failed}},
+			true),
+		Entry("not when only the status changed",
+			&v1.VirtualMachineInstance{Status: v1.VirtualMachineInstanceStatus{Phase: v1.Scheduled}},
+			&v1.VirtualMachineInstance{Status: v1.VirtualMachineInstanceStatus{Phase: v1.Running}},
+			false),
+	)
+
+	It("should enqueue when a migration completes", func() {
+		controller.updateMigration(
+			&v1.VirtualMachineInstanceMigration{Status: v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationRunning}},
+			&v1.VirtualMachineInstanceMigration{Status: v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationSucceeded}},
+		)
+		Eventually(controller.queue.Len).Should(Equal(1))
+	})
+
+	It("should enqueue when a policy is deleted through a tombstone", func() {
+		controller.deletePolicy(cache.DeletedFinalStateUnknown{
+			Key: genericPolicy,
+			Obj: &migrationsv1.MigrationPolicy{ObjectMeta: metav1.ObjectMeta{Name: genericPolicy}},
+		})
+		Eventually(controller.queue.Len).Should(Equal(1))
+	})
+})
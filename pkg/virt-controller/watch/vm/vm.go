@@ -124,6 +124,7 @@ const (
 	hotplugMemoryErrorReason           = "HotPlugMemoryError"
 	volumesUpdateErrorReason           = "VolumesUpdateError"
 	tolerationsChangeErrorReason       = "TolerationsChangeError"
+	diskIOTuneChangeErrorReason        = "DiskIOTuneChangeError"
 	annotationsLabelsChangeErrorReason = "AnnotationsLabelsChangeError"
 )
 
@@ -770,6 +771,53 @@ func (c *Controller) handleTolerationsChangeRequest(vm *virtv1.VirtualMachine, v
 	return nil
 }
 
+func (c *Controller) handleDiskIOTuneChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmCopyWithInstancetype := vm.DeepCopy()
+	if err := c.instancetypeController.ApplyToVM(vmCopyWithInstancetype); err != nil {
+		return err
+	}
+
+	vmDisks := storagetypes.GetDisksByName(&vmCopyWithInstancetype.Spec.Template.Spec)
+	newDisks := make([]virtv1.Disk, len(vmi.Spec.Domain.Devices.Disks))
+	changed := false
+	for i, disk := range vmi.Spec.Domain.Devices.Disks {
+		newDisks[i] = *disk.DeepCopy()
+		vmDisk, ok := vmDisks[disk.Name]
+		if !ok || equality.Semantic.DeepEqual(vmDisk.IOTune, disk.IOTune) {
+			continue
+		}
+		newDisks[i].IOTune = vmDisk.IOTune.DeepCopy()
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("disk I/O throttling should not be changed during VMI migration")
+	}
+
+	generatedPatch, err := patch.New(
+		patch.WithTest("/spec/domain/devices/disks", vmi.Spec.Domain.Devices.Disks),
+		patch.WithReplace("/spec/domain/devices/disks", newDisks),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	if _, err := c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update disk I/O throttling: %v", err)
+		return err
+	}
+
+	return nil
+}
+
 func (c *Controller) handleAffinityChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
 		return nil
@@ -880,6 +928,15 @@ func (c *Controller) handleVolumeUpdateRequest(vm *virtv1.VirtualMachine, vmi *v
 		*vm.Spec.UpdateVolumesStrategy == virtv1.UpdateVolumesStrategyReplacement:
 		log.Log.Object(vm).V(4).Infof("not handling replacement update volumes strategy")
 	case *vm.Spec.UpdateVolumesStrategy == virtv1.UpdateVolumesStrategyMigration:
+		// Keep the options of an ongoing volume migration in sync, e.g. for a manual cut-over.
+		// A backend storage class is synced even without migrated volumes, the VMI controller
+		// then requests a migration to move the backend-storage PVC to it.
+		if len(vmi.Status.MigratedVolumes) > 0 || volumemig.BackendStorageClassRequested(vm) {
+			if err := volumemig.PatchVMIVolumeMigrationOptions(c.clientset, vmi, vm); err != nil {
+				log.Log.Object(vm).Errorf("failed to update the volume migration options for vmi:%v", err)
+				return err
+			}
+		}
 		if !volumemig.PersistentVolumesUpdated(&vm.Spec.Template.Spec, &vmi.Spec) {
 			log.Log.Object(vm).V(4).Infof("No persistent volumes updated")
 			return nil
@@ -902,6 +959,10 @@ func (c *Controller) handleVolumeUpdateRequest(vm *virtv1.VirtualMachine, vmi *v
 			setRestartRequired(vm, err.Error())
 			return nil
 		}
+		if err := volumemig.PatchVMIVolumeMigrationOptions(c.clientset, vmi, vm); err != nil {
+			log.Log.Object(vm).Errorf("failed to update the volume migration options for vmi:%v", err)
+			return err
+		}
 		if err := volumemig.PatchVMIStatusWithMigratedVolumes(c.clientset, migVols, vmi); err != nil {
 			log.Log.Object(vm).Errorf("failed to update migrating volumes for vmi:%v", err)
 			return err
@@ -1549,6 +1610,24 @@ func startFailureBackoffTimeLeft(vm *virtv1.VirtualMachine) int64 {
 	return 0
 }
 
+// syncCrashMemoryDumps records on the vm the memory dump taken when the guest of the vmi panicked
+func (c *Controller) syncCrashMemoryDumps(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	if vmi == nil || vmi.Status.CrashMemoryDump == nil || vmi.Status.CrashMemoryDump.EndTimestamp == nil {
+		return
+	}
+	crashMemoryDump := *vmi.Status.CrashMemoryDump
+	for _, dump := range vm.Status.CrashMemoryDumps {
+		if dump.TargetFileName == crashMemoryDump.TargetFileName {
+			return
+		}
+	}
+
+	maxDumps := memorydump.MaxCrashMemoryDumps(memorydump.CrashMemoryDump(vmi))
+	vm.Status.CrashMemoryDumps = memorydump.AppendCrashMemoryDump(vm.Status.CrashMemoryDumps, crashMemoryDump, maxDumps)
+	c.recorder.Eventf(vm, k8score.EventTypeWarning, memorydump.CrashMemoryDumpCompletedReason,
+		"Guest panicked, its memory was dumped to %s in pvc %s", crashMemoryDump.TargetFileName, crashMemoryDump.ClaimName)
+}
+
 func syncStartFailureStatus(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
 	if shouldClearStartFailure(vm, vmi) {
 		// if a vmi associated with the vm hits a running phase, then reset the start failure counter
@@ -1628,6 +1707,32 @@ func syncVolumeMigration(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineIn
 		cond.Status == k8score.ConditionFalse &&
 		cond.Reason == virtv1.VirtualMachineInstanceReasonVolumesChangeCancellation {
 		vm.Status.VolumeUpdateState.VolumeMigrationState = nil
+		return
+	}
+
+	syncVolumeMigrationProgress(vm, vmi)
+}
+
+// syncVolumeMigrationProgress reports the copy progress of the migrated volumes and the state of the cut-over
+func syncVolumeMigrationProgress(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) {
+	state := vm.Status.VolumeUpdateState.VolumeMigrationState
+	progress := make(map[string]*virtv1.VolumeMigrationProgress)
+	for _, v := range vmi.Status.MigratedVolumes {
+		progress[v.VolumeName] = v.Progress
+	}
+	state.CutoverPending = false
+	for i, v := range state.MigratedVolumes {
+		state.MigratedVolumes[i].Progress = progress[v.VolumeName].DeepCopy()
+		if p := state.MigratedVolumes[i].Progress; p != nil && p.CutoverPending {
+			state.CutoverPending = true
+		}
+	}
+	state.NextCutoverTime = nil
+	if !state.CutoverPending || vm.Spec.VolumeMigration == nil {
+		return
+	}
+	if _, next := migrations.VolumeCutoverAllowed(vm.Spec.VolumeMigration.CutoverPolicy, time.Now()); next != nil {
+		state.NextCutoverTime = pointer.P(metav1.NewTime(*next))
 	}
 }
 
@@ -1855,6 +1960,13 @@ func SetupVMIFromVM(vm *virtv1.VirtualMachine) *virtv1.VirtualMachineInstance {
 		vmi.Spec.StartStrategy = &strategy
 	}
 
+	if claimName, ok := startFromMemoryStateRequest(vm); ok {
+		if vmi.Annotations == nil {
+			vmi.Annotations = map[string]string{}
+		}
+		vmi.Annotations[virtv1.MemoryStateRestoreAnnotation] = claimName
+	}
+
 	// prevent from retriggering memory dump after shutdown if memory dump is complete
 	if memorydump.HasCompleted(vm) {
 		vmi.Spec = *memorydump.RemoveMemoryDumpVolumeFromVMISpec(&vmi.Spec, vm.Status.MemoryDumpRequest.ClaimName)
@@ -1885,6 +1997,17 @@ func hasStartPausedRequest(vm *virtv1.VirtualMachine) bool {
 		pausedValue == virtv1.StartRequestDataPausedTrue
 }
 
+// startFromMemoryStateRequest returns the pvc holding the memory state
+// the start request asks the vmi to be resumed from, if any
+func startFromMemoryStateRequest(vm *virtv1.VirtualMachine) (string, bool) {
+	if !hasStartRequest(vm) {
+		return "", false
+	}
+
+	claimName, hasMemoryState := vm.Status.StateChangeRequests[0].Data[virtv1.StartRequestDataMemoryStateKey]
+	return claimName, hasMemoryState && claimName != ""
+}
+
 func hasStartRequest(vm *virtv1.VirtualMachine) bool {
 	if len(vm.Status.StateChangeRequests) == 0 {
 		return false
@@ -2458,6 +2581,7 @@ func (c *Controller) updateStatus(vm, vmOrig *virtv1.VirtualMachine, vmi *virtv1
 
 	c.trimDoneVolumeRequests(vm)
 	memorydump.UpdateRequest(vm, vmi)
+	c.syncCrashMemoryDumps(vm, vmi)
 
 	if c.isTrimFirstChangeRequestNeeded(vm, vmi) {
 		popStateChangeRequest(vm)
@@ -3007,6 +3131,13 @@ func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMa
 		lastSeenVM.Spec.Template.Spec.NodeSelector = currentVM.Spec.Template.Spec.NodeSelector
 		lastSeenVM.Spec.Template.Spec.Affinity = currentVM.Spec.Template.Spec.Affinity
 		lastSeenVM.Spec.Template.Spec.Tolerations = currentVM.Spec.Template.Spec.Tolerations
+
+		currentDisks := storagetypes.GetDisksByName(&currentVM.Spec.Template.Spec)
+		for i, disk := range lastSeenVM.Spec.Template.Spec.Domain.Devices.Disks {
+			if currentDisk, ok := currentDisks[disk.Name]; ok {
+				lastSeenVM.Spec.Template.Spec.Domain.Devices.Disks[i].IOTune = currentDisk.IOTune
+			}
+		}
 	}
 
 	if !netvmliveupdate.IsRestartRequired(currentVM, vmi) {
@@ -3239,6 +3370,10 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 			return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling tolerations change request: %v", err), tolerationsChangeErrorReason), nil
 		}
 
+		if err := c.handleDiskIOTuneChangeRequest(vmCopy, vmi); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("Error encountered while handling disk I/O throttling change request: %v", err), diskIOTuneChangeErrorReason), nil
+		}
+
 		if err := c.handleMemoryHotplugRequest(vmCopy, vmi); err != nil {
 			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling memory hotplug requests: %v", err), hotplugMemoryErrorReason), nil
 		}
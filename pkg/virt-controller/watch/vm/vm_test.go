@@ -46,6 +46,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libdv"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -2619,6 +2620,24 @@ var _ = Describe("VirtualMachine", func() {
 			Expect(string(vmi1.Spec.Domain.Firmware.UUID)).To(Equal(uid))
 		})
 
+		It("should resume the VirtualMachineInstance from the memory state of the start request", func() {
+			vm, _ := watchtesting.DefaultVirtualMachine(true)
+			vm.Status.StateChangeRequests = []v1.VirtualMachineStateChangeRequest{{
+				Action: v1.StartRequest,
+				Data:   map[string]string{v1.StartRequestDataMemoryStateKey: "memory-state-pvc"},
+			}}
+
+			vmi := SetupVMIFromVM(vm)
+			Expect(vmi.Annotations).To(HaveKeyWithValue(v1.MemoryStateRestoreAnnotation, "memory-state-pvc"))
+		})
+
+		It("should not resume the VirtualMachineInstance from a memory state without a start request", func() {
+			vm, _ := watchtesting.DefaultVirtualMachine(true)
+
+			vmi := SetupVMIFromVM(vm)
+			Expect(vmi.Annotations).ToNot(HaveKey(v1.MemoryStateRestoreAnnotation))
+		})
+
 		It("should delete VirtualMachineInstance when stopped", func() {
 			vm, vmi := watchtesting.DefaultVirtualMachine(false)
 
@@ -3858,6 +3877,42 @@ var _ = Describe("VirtualMachine", func() {
 				Entry("in phase Dissociating", v1.MemoryDumpDissociating),
 			)
 
+			It("should record the crash memory dump of the vmi and retain the configured number of dumps", func() {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vm.Status.Created = true
+				vm.Status.CrashMemoryDumps = []v1.DomainMemoryDumpInfo{
+					{ClaimName: testPVCName, TargetFileName: "dump1"},
+					{ClaimName: testPVCName, TargetFileName: "dump2"},
+				}
+				crashPolicy := &v1.CrashPolicy{
+					MemoryDump: &v1.CrashMemoryDump{ClaimName: testPVCName, MaxDumps: pointer.P(int32(2))},
+				}
+				vm.Spec.Template.Spec.CrashPolicy = crashPolicy
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				now := metav1.Now()
+				vmi.Spec.CrashPolicy = crashPolicy
+				vmi.Status.Phase = v1.Running
+				vmi.Status.CrashMemoryDump = &v1.DomainMemoryDumpInfo{
+					ClaimName:      testPVCName,
+					TargetFileName: "dump3",
+					StartTimestamp: &now,
+					EndTimestamp:   &now,
+				}
+				controller.vmiIndexer.Add(vmi)
+
+				sanityExecute(vm)
+
+				testutils.ExpectEvent(recorder, memorydump.CrashMemoryDumpCompletedReason)
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(vm.Status.CrashMemoryDumps).To(HaveLen(2))
+				Expect(vm.Status.CrashMemoryDumps[0].TargetFileName).To(Equal("dump2"))
+				Expect(vm.Status.CrashMemoryDumps[1].TargetFileName).To(Equal("dump3"))
+			})
 		})
 
 		Context("VM printableStatus", func() {
@@ -5926,6 +5981,74 @@ var _ = Describe("VirtualMachine", func() {
 				)
 			})
 
+			Context("Disk I/O throttling", func() {
+				newDisks := func(ioTune *v1.DiskIOTune) []v1.Disk {
+					return []v1.Disk{{
+						Name:   "disk0",
+						IOTune: ioTune,
+					}}
+				}
+
+				DescribeTable("should be live-updated", func(existingIOTune, updatedIOTune *v1.DiskIOTune) {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+
+					vm.Spec.Template.Spec.Domain.Devices.Disks = newDisks(updatedIOTune)
+					vmi.Spec.Domain.Devices.Disks = newDisks(existingIOTune)
+
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+					addVirtualMachine(vm)
+
+					sanityExecute(vm)
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+
+					By("Expecting to see the updated VMI with the new I/O throttling")
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.Disks[0].IOTune).To(Equal(updatedIOTune))
+				},
+					Entry("when adding limits",
+						nil,
+						&v1.DiskIOTune{TotalIOPS: 1000},
+					),
+					Entry("when changing limits",
+						&v1.DiskIOTune{TotalIOPS: 1000},
+						&v1.DiskIOTune{TotalIOPS: 2000, Burst: &v1.DiskIOTuneBurst{TotalIOPS: 4000}},
+					),
+					Entry("when removing limits",
+						&v1.DiskIOTune{TotalIOPS: 1000},
+						nil,
+					),
+				)
+
+				It("should not be changed during migration", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Devices.Disks = newDisks(&v1.DiskIOTune{TotalIOPS: 2000})
+					vmi.Spec.Domain.Devices.Disks = newDisks(&v1.DiskIOTune{TotalIOPS: 1000})
+					vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{
+						StartTimestamp: pointer.P(metav1.Now()),
+					}
+
+					err := controller.handleDiskIOTuneChangeRequest(vm, vmi)
+					Expect(err).To(MatchError(ContainSubstring("should not be changed during VMI migration")))
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+				})
+			})
+
 			Context("Affinity", func() {
 				It("should be live-updated", func() {
 					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
@@ -6076,6 +6199,27 @@ var _ = Describe("VirtualMachine", func() {
 					Entry("when the destination DV doesn't exist", false, storagetypes.NewDVNotFoundError(newDVName)),
 					Entry("when the destination DV exist but the PVC", true, storagetypes.NewPVCNotFoundError(newDVName)),
 				)
+
+				It("should sync the backend storage class to the VMI without any volume to migrate", func() {
+					vmi := libvmi.New(libvmi.WithNamespace(ns), libvmi.WithDataVolume(diskName, oldDVName))
+					vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithNamespace(ns),
+						libvmi.WithDataVolume(diskName, oldDVName)),
+						libvmi.WithUpdateVolumeStrategy(v1.UpdateVolumesStrategyMigration))
+					vm.Spec.VolumeMigration = &v1.VolumeMigrationOptions{BackendStorageClassName: pointer.P("fast")}
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm,
+						metav1.CreateOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.TODO(),
+						vmi, metav1.CreateOptions{})
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(controller.handleVolumeUpdateRequest(vm, vmi)).To(Succeed())
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.TODO(),
+						vmi.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Status.VolumeMigration).To(Equal(vm.Spec.VolumeMigration))
+					Expect(vmi.Status.MigratedVolumes).To(BeEmpty())
+				})
 			})
 
 			Context("Instance Types and Preferences", func() {
@@ -7263,6 +7407,52 @@ var _ = Describe("VirtualMachine", func() {
 				}, true,
 			),
 		)
+
+		DescribeTable("should report the progress of the volume copy", func(policy *v1.VolumeMigrationCutoverPolicy, cutoverPending, expectNextCutover bool) {
+			progress := &v1.VolumeMigrationProgress{
+				BytesTotal:     1024,
+				BytesCopied:    1024,
+				BytesPerSecond: 512,
+				CutoverPending: cutoverPending,
+			}
+			vm := libvmi.NewVirtualMachine(libvmi.New(libvmi.WithDataVolume(volName, "dv1")),
+				libvmistatus.WithVMStatus(
+					libvmistatus.NewVMStatus(libvmistatus.WithVMVolumeUpdateState(&v1.VolumeUpdateState{VolumeMigrationState: &v1.VolumeMigrationState{
+						MigratedVolumes: withMigVols(volName, "dv0", "dv1"),
+					}}), libvmistatus.WithVMCondition(withVMCondVolumeMigInProgress(k8sv1.ConditionTrue, "")),
+					)))
+			vm.Spec.UpdateVolumesStrategy = pointer.P(v1.UpdateVolumesStrategyMigration)
+			vm.Spec.VolumeMigration = &v1.VolumeMigrationOptions{CutoverPolicy: policy}
+			vmi := libvmi.New(libvmi.WithDataVolume(volName, "dv0"),
+				libvmistatus.WithStatus(
+					libvmistatus.New(libvmistatus.WithCondition(
+						withVMICondVolumeMigInProgress(k8sv1.ConditionTrue, "")))),
+			)
+			vmi.Status.MigratedVolumes = withMigVols(volName, "dv0", "dv1")
+			vmi.Status.MigratedVolumes[0].Progress = progress
+
+			syncVolumeMigration(vm, vmi)
+			state := vm.Status.VolumeUpdateState.VolumeMigrationState
+			Expect(state).ToNot(BeNil())
+			Expect(state.MigratedVolumes).To(HaveLen(1))
+			Expect(state.MigratedVolumes[0].Progress).To(Equal(progress))
+			Expect(state.CutoverPending).To(Equal(cutoverPending))
+			if expectNextCutover {
+				Expect(state.NextCutoverTime).ToNot(BeNil())
+				Expect(state.NextCutoverTime.Time).To(BeTemporally(">", time.Now()))
+			} else {
+				Expect(state.NextCutoverTime).To(BeNil())
+			}
+		},
+			Entry("while the copy is in progress", nil, false, false),
+			Entry("with a manual cut-over", &v1.VolumeMigrationCutoverPolicy{Mode: v1.VolumeMigrationCutoverManual}, true, false),
+			Entry("with a cut-over window", &v1.VolumeMigrationCutoverPolicy{
+				Mode: v1.VolumeMigrationCutoverWindow,
+				Window: &v1.MaintenanceWindow{
+					Schedule: fmt.Sprintf("* %d * * *", time.Now().UTC().Add(2*time.Hour).Hour()),
+				},
+			}, true, true),
+		)
 	})
 })
 
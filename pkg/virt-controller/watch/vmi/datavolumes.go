@@ -30,6 +30,7 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 )
@@ -73,6 +74,11 @@ func (c *Controller) updateDataVolume(old, cur interface{}) {
 		}
 		return
 	}
+	if persistentStateFor, exists := curDataVolume.Labels[backendstorage.PVCPrefix]; exists {
+		// The DataVolume imports a backend-storage PVC, which isn't referenced by the VMI volumes
+		c.Queue.Add(controller.NamespacedKey(curDataVolume.Namespace, persistentStateFor))
+		return
+	}
 	vmis, err := c.listVMIsMatchingDV(curDataVolume.Namespace, curDataVolume.Name)
 	if err != nil {
 		log.Log.Object(curDataVolume).Errorf("Error encountered during datavolume update: %v", err)
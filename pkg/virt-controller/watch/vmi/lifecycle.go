@@ -123,6 +123,9 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 		if syncErr != nil {
 			return syncErr, pod
 		}
+		if syncErr := c.handleContainerDiskOverlays(vmi); syncErr != nil {
+			return syncErr, pod
+		}
 		// If a backend-storage PVC was just created but not yet seen by the informer, give it time
 		if !c.pvcExpectations.SatisfiedExpectations(key) {
 			return nil, pod
@@ -135,6 +138,14 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 			log.Log.V(2).Object(vmi).Infof("Delaying pod creation while backend storage populates.")
 			return common.NewSyncError(fmt.Errorf("PVC pending"), controller.BackendStorageNotReadyReason), pod
 		}
+		overlaysReady, err := c.backendStorage.AreOverlayPVCsReady(vmi)
+		if err != nil {
+			return common.NewSyncError(err, controller.FailedBackendStorageProbeReason), pod
+		}
+		if !overlaysReady {
+			log.Log.V(2).Object(vmi).Infof("Delaying pod creation while container disk overlay PVCs populate.")
+			return common.NewSyncError(fmt.Errorf("PVC pending"), controller.BackendStorageNotReadyReason), pod
+		}
 
 		var templatePod *k8sv1.Pod
 		if isWaitForFirstConsumer {
@@ -204,6 +215,10 @@ func (c *Controller) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod, da
 		}
 		pod = patchedPod
 
+		if syncErr := c.recordContainerDiskOverlayDigests(vmi, pod); syncErr != nil {
+			return syncErr, pod
+		}
+
 		hotplugVolumes := storagetypes.GetHotplugVolumes(vmi, pod)
 		hotplugAttachmentPods, err := controller.AttachmentPods(pod, c.podIndexer)
 		if err != nil {
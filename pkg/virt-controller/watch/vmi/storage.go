@@ -34,8 +34,10 @@ import (
 
 	"kubevirt.io/client-go/log"
 
+	containerdisk "kubevirt.io/kubevirt/pkg/container-disk"
 	"kubevirt.io/kubevirt/pkg/controller"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 )
@@ -53,6 +55,12 @@ func (c *Controller) addPVC(obj interface{}) {
 		c.Queue.Add(vmiKey)
 		return // The PVC is a backend-storage PVC, won't be listed by `c.listVMIsMatchingDV()`
 	}
+	if overlayFor, exists := pvc.Labels[backendstorage.OverlayPVCPrefix]; exists {
+		vmiKey := controller.NamespacedKey(pvc.Namespace, overlayFor)
+		c.pvcExpectations.CreationObserved(vmiKey)
+		c.Queue.Add(vmiKey)
+		return
+	}
 	vmis, err := c.listVMIsMatchingDV(pvc.Namespace, pvc.Name)
 	if err != nil {
 		return
@@ -149,6 +157,15 @@ func (c *Controller) handleBackendStorage(vmi *virtv1.VirtualMachineInstance) (s
 		}
 		return "", nil
 	}
+	// The backend storage is imported, e.g. from the manifests of a VirtualMachineExport,
+	// wait for it instead of creating an empty PVC
+	dv, err := backendstorage.DataVolumeForVMI(c.dataVolumeIndexer, vmi)
+	if err != nil {
+		return "", common.NewSyncError(err, controller.FailedBackendStorageProbeReason)
+	}
+	if dv != nil && dv.Status.Phase != cdiv1.Succeeded {
+		return "", common.NewSyncError(fmt.Errorf("backend storage DataVolume %s is not populated yet", dv.Name), controller.BackendStorageNotReadyReason)
+	}
 	pvc := backendstorage.PVCForVMI(c.pvcIndexer, vmi)
 	if pvc == nil {
 		c.pvcExpectations.ExpectCreations(key, 1)
@@ -160,6 +177,58 @@ func (c *Controller) handleBackendStorage(vmi *virtv1.VirtualMachineInstance) (s
 	return pvc.Name, nil
 }
 
+// handleContainerDiskOverlays creates the PVCs backing persistent container disk overlays of the VMI.
+func (c *Controller) handleContainerDiskOverlays(vmi *virtv1.VirtualMachineInstance) common.SyncError {
+	key, err := controller.KeyFunc(vmi)
+	if err != nil {
+		return common.NewSyncError(err, controller.FailedBackendStorageCreateReason)
+	}
+	for _, volume := range backendstorage.PersistentOverlayVolumes(&vmi.Spec) {
+		if pvc := backendstorage.OverlayPVCForVolume(c.pvcIndexer, vmi.Namespace, vmi.Name, volume.Name); pvc != nil {
+			if pvc.DeletionTimestamp != nil {
+				// The overlay is being reset, wait for the old PVC to be gone before creating a new one
+				return common.NewSyncError(fmt.Errorf("overlay PVC %s is being deleted", pvc.Name), controller.BackendStorageNotReadyReason)
+			}
+			if backendstorage.IsOverlayImageMismatch(pvc, &volume) {
+				return common.NewSyncError(
+					fmt.Errorf("overlay PVC %s of volume %s was created for image %s, reset the container disk to use image %s",
+						pvc.Name, volume.Name, pvc.Annotations[backendstorage.OverlayImageAnnotation], volume.ContainerDisk.Image),
+					controller.ContainerDiskOverlayImageMismatchReason)
+			}
+			continue
+		}
+		c.pvcExpectations.ExpectCreations(key, 1)
+		if _, err := c.backendStorage.CreateOverlayPVCForVolume(vmi, &volume); err != nil {
+			c.pvcExpectations.CreationObserved(key)
+			return common.NewSyncError(err, controller.FailedBackendStorageCreateReason)
+		}
+	}
+	return nil
+}
+
+// recordContainerDiskOverlayDigests records the digests of the images the persistent container disk overlays
+// run on top of, so that the following starts use the same images even if their tags move.
+func (c *Controller) recordContainerDiskOverlayDigests(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod) common.SyncError {
+	volumes := backendstorage.PersistentOverlayVolumes(&vmi.Spec)
+	if len(volumes) == 0 {
+		return nil
+	}
+	imageIDs, err := containerdisk.ExtractImageIDsFromSourcePod(vmi, pod, c.clusterConfig.ImageVolumeEnabled())
+	if err != nil {
+		return common.NewSyncError(err, controller.FailedBackendStorageProbeReason)
+	}
+	for _, volume := range volumes {
+		pvc := backendstorage.OverlayPVCForVolume(c.pvcIndexer, vmi.Namespace, vmi.Name, volume.Name)
+		if pvc == nil || !backendstorage.NeedsOverlayImageDigest(pvc, imageIDs[volume.Name]) {
+			continue
+		}
+		if err := c.backendStorage.RecordOverlayImageDigest(pvc, imageIDs[volume.Name]); err != nil {
+			return common.NewSyncError(fmt.Errorf("failed to record the image digest on overlay PVC %s: %v", pvc.Name, err), controller.FailedBackendStorageProbeReason)
+		}
+	}
+	return nil
+}
+
 func (c *Controller) processHotplugVolumeStatus(
 	vmi *virtv1.VirtualMachineInstance,
 	volumeName string,
@@ -269,6 +338,32 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 		}
 	}
 
+	for _, volume := range backendstorage.PersistentOverlayVolumes(&vmi.Spec) {
+		overlayPVCName := backendstorage.OverlayPVCName(vmi.Name, volume.Name)
+		status := virtv1.VolumeStatus{Name: overlayPVCName}
+		if existingStatus, ok := oldStatusMap[overlayPVCName]; ok {
+			status = existingStatus
+		}
+		delete(oldStatusMap, overlayPVCName)
+		if err = c.processPVCInfo(&status, overlayPVCName, vmi.Namespace, false); err != nil {
+			return err
+		}
+		newStatus = append(newStatus, status)
+	}
+
+	// the pvc of the crash policy is mounted like a volume, its access modes decide migratability
+	if crashMemoryDump := memorydump.CrashMemoryDump(vmi); crashMemoryDump != nil {
+		status := virtv1.VolumeStatus{Name: memorydump.CrashMemoryDumpVolumeName}
+		if existingStatus, ok := oldStatusMap[memorydump.CrashMemoryDumpVolumeName]; ok {
+			status = existingStatus
+		}
+		delete(oldStatusMap, memorydump.CrashMemoryDumpVolumeName)
+		if err = c.processPVCInfo(&status, crashMemoryDump.ClaimName, vmi.Namespace, false); err != nil {
+			return err
+		}
+		newStatus = append(newStatus, status)
+	}
+
 	for _, volume := range vmi.Spec.Volumes {
 		status := virtv1.VolumeStatus{}
 		if existingStatus, ok := oldStatusMap[volume.Name]; ok {
@@ -308,6 +403,12 @@ func (c *Controller) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virt
 		}
 		// Remove from map so we can detect volumes removed from spec
 		delete(oldStatusMap, utilityVolume.Name)
+
+		if utilityVolume.Type != nil && *utilityVolume.Type == virtv1.MemoryState && status.MemoryDumpVolume == nil {
+			status.MemoryDumpVolume = &virtv1.DomainMemoryDumpInfo{
+				ClaimName: utilityVolume.ClaimName,
+			}
+		}
 		c.processHotplugVolumeStatus(vmi, utilityVolume.Name, utilityVolume.ClaimName, &status, attachmentPod)
 		err = c.processPVCInfo(&status, utilityVolume.ClaimName, vmi.Namespace, true)
 		if err != nil {
@@ -449,12 +550,13 @@ func (c *Controller) syncVolumesUpdate(vmi *virtv1.VirtualMachineInstance) {
 }
 
 func (c *Controller) requireVolumesUpdate(vmi *virtv1.VirtualMachineInstance) bool {
-	if len(vmi.Status.MigratedVolumes) < 1 {
-		return false
-	}
 	if controller.NewVirtualMachineInstanceConditionManager().HasCondition(vmi, virtv1.VirtualMachineInstanceVolumesChange) {
 		return false
 	}
+	if len(vmi.Status.MigratedVolumes) < 1 {
+		// Moving the backend-storage PVC to another storage class requires a migration as well
+		return backendstorage.StorageClassChangeRequested(c.pvcIndexer, vmi)
+	}
 	migVolsMap := make(map[string]string)
 	for _, v := range vmi.Status.MigratedVolumes {
 		migVolsMap[v.SourcePVCInfo.ClaimName] = v.DestinationPVCInfo.ClaimName
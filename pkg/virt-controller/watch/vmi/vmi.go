@@ -155,7 +155,7 @@ func NewController(templateService templateService,
 		return nil, err
 	}
 
-	return c, nil
+	return c, backendstorage.AddDataVolumeIndexer(c.dataVolumeIndexer)
 }
 
 type informalSyncError struct {
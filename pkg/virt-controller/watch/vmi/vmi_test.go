@@ -59,7 +59,9 @@ import (
 	kvcontroller "kubevirt.io/kubevirt/pkg/controller"
 	controllertesting "kubevirt.io/kubevirt/pkg/controller/testing"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -571,6 +573,19 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				expectMatchingPodCreation(vmi)
 			})
 
+			It("should wait for an imported backend storage DataVolume instead of creating a PVC", func() {
+				dataVolume := newDv(vmi.Namespace, "persistent-state-for-"+vmi.Name+"-12345", cdiv1.ImportInProgress)
+				dataVolume.Labels = map[string]string{"persistent-state-for": vmi.Name}
+				addDataVolume(dataVolume)
+
+				sanityExecute()
+				expectVirtualMachinePendingState(vmi.Namespace, vmi.Name)
+				pvcs, err := kubeClient.CoreV1().PersistentVolumeClaims(vmi.Namespace).List(context.Background(), metav1.ListOptions{
+					LabelSelector: "persistent-state-for=" + vmi.Name,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pvcs.Items).To(BeEmpty())
+			})
 		})
 
 		When("backend storage no RWX support", func() {
@@ -2553,6 +2568,41 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 				Expect(vmi.Labels).To(HaveKeyWithValue(virtv1.MemoryHotplugOverheadRatioLabel, overheadRatio))
 			})
 		})
+
+		Context("with a backend storage class change", func() {
+			var vmi *virtv1.VirtualMachineInstance
+
+			BeforeEach(func() {
+				vmi = newPendingVirtualMachine("testvmi")
+				vmi.Status.Phase = virtv1.Running
+				vmi.Spec.Domain.Devices.TPM = &virtv1.TPMDevice{Persistent: pointer.P(true)}
+				Expect(controller.pvcIndexer.Add(&k8sv1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "persistent-state-for-testvmi-abcde",
+						Namespace: vmi.Namespace,
+						Labels:    map[string]string{backendstorage.PVCPrefix: vmi.Name},
+					},
+					Spec: k8sv1.PersistentVolumeClaimSpec{StorageClassName: pointer.P("standard")},
+				})).To(Succeed())
+			})
+
+			DescribeTable("should require a migration", func(storageClass *string, expected bool) {
+				if storageClass != nil {
+					vmi.Status.VolumeMigration = &virtv1.VolumeMigrationOptions{BackendStorageClassName: storageClass}
+				}
+				Expect(controller.requireVolumesUpdate(vmi)).To(Equal(expected))
+			},
+				Entry("when another storage class is requested", pointer.P("fast"), true),
+				Entry("not when the PVC has the requested storage class", pointer.P("standard"), false),
+				Entry("not when no storage class is requested", nil, false),
+			)
+
+			It("should not require a migration while the volumes change is in progress", func() {
+				vmi.Status.VolumeMigration = &virtv1.VolumeMigrationOptions{BackendStorageClassName: pointer.P("fast")}
+				controller.syncVolumesUpdate(vmi)
+				Expect(controller.requireVolumesUpdate(vmi)).To(BeFalse())
+			})
+		})
 	})
 
 	Context("hotplug volume", func() {
@@ -3438,6 +3488,77 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 			Expect(volumeStatus.PersistentVolumeClaimInfo.ClaimName).To(Equal("filesystem-pvc"))
 		})
 
+		It("Should track the memory dump of a memory state utility volume", func() {
+			vmi := newPendingVirtualMachine("testvmi")
+			vmi.Spec.UtilityVolumes = []virtv1.UtilityVolume{
+				{
+					Name: "memory-state-vol",
+					PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "memory-state-pvc",
+					},
+					Type: pointer.P(virtv1.MemoryState),
+				},
+			}
+
+			memoryStatePVC := &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "memory-state-pvc",
+					Namespace: k8sv1.NamespaceDefault,
+				},
+				Spec: k8sv1.PersistentVolumeClaimSpec{
+					AccessModes: []k8sv1.PersistentVolumeAccessMode{
+						k8sv1.ReadWriteOnce,
+					},
+				},
+				Status: k8sv1.PersistentVolumeClaimStatus{
+					Phase: k8sv1.ClaimBound,
+				},
+			}
+
+			Expect(controller.pvcIndexer.Add(memoryStatePVC)).To(Succeed())
+
+			virtlauncherPod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			Expect(controller.updateVolumeStatus(vmi, virtlauncherPod)).To(Succeed())
+
+			Expect(vmi.Status.VolumeStatus).To(HaveLen(1))
+			Expect(vmi.Status.VolumeStatus[0].MemoryDumpVolume).To(Equal(&virtv1.DomainMemoryDumpInfo{
+				ClaimName: "memory-state-pvc",
+			}))
+		})
+
+		It("Should track the pvc of the crash policy", func() {
+			vmi := newPendingVirtualMachine("testvmi")
+			vmi.Spec.CrashPolicy = &virtv1.CrashPolicy{
+				MemoryDump: &virtv1.CrashMemoryDump{ClaimName: "crash-pvc"},
+			}
+
+			crashPVC := &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "crash-pvc",
+					Namespace: k8sv1.NamespaceDefault,
+				},
+				Spec: k8sv1.PersistentVolumeClaimSpec{
+					AccessModes: []k8sv1.PersistentVolumeAccessMode{
+						k8sv1.ReadWriteOnce,
+					},
+				},
+				Status: k8sv1.PersistentVolumeClaimStatus{
+					Phase: k8sv1.ClaimBound,
+				},
+			}
+
+			Expect(controller.pvcIndexer.Add(crashPVC)).To(Succeed())
+
+			virtlauncherPod := newPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			Expect(controller.updateVolumeStatus(vmi, virtlauncherPod)).To(Succeed())
+
+			Expect(vmi.Status.VolumeStatus).To(HaveLen(1))
+			Expect(vmi.Status.VolumeStatus[0].Name).To(Equal(memorydump.CrashMemoryDumpVolumeName))
+			Expect(vmi.Status.VolumeStatus[0].PersistentVolumeClaimInfo).ToNot(BeNil())
+			Expect(vmi.Status.VolumeStatus[0].PersistentVolumeClaimInfo.ClaimName).To(Equal("crash-pvc"))
+			Expect(vmi.Status.VolumeStatus[0].PersistentVolumeClaimInfo.AccessModes).To(ConsistOf(k8sv1.ReadWriteOnce))
+		})
+
 		Context("isUtilityVolumeWithBlockPVC", func() {
 			It("should return true for a utility volume with block mode PVC", func() {
 				vmi := newPendingVirtualMachine("testvmi")
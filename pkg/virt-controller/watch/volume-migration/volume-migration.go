@@ -362,6 +362,28 @@ func PatchVMIStatusWithMigratedVolumes(clientset kubecli.KubevirtClient, migVols
 	return err
 }
 
+// PatchVMIVolumeMigrationOptions patches the VMI status with the volume migration options of the VM, so that
+// virt-handler can apply them to the ongoing migration
+func PatchVMIVolumeMigrationOptions(clientset kubecli.KubevirtClient, vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine) error {
+	if equality.Semantic.DeepEqual(vm.Spec.VolumeMigration, vmi.Status.VolumeMigration) {
+		return nil
+	}
+	p, err := patch.New(
+		patch.WithTest("/status/volumeMigration", vmi.Status.VolumeMigration),
+		patch.WithReplace("/status/volumeMigration", vm.Spec.VolumeMigration),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, p, metav1.PatchOptions{})
+	return err
+}
+
+// BackendStorageClassRequested returns true if the VM requests a storage class for its backend-storage PVC
+func BackendStorageClassRequested(vm *virtv1.VirtualMachine) bool {
+	return vm.Spec.VolumeMigration != nil && vm.Spec.VolumeMigration.BackendStorageClassName != nil
+}
+
 // PatchVMIVolumes replaces the VMI volumes with the migrated volumes
 func PatchVMIVolumes(clientset kubecli.KubevirtClient, vmi *virtv1.VirtualMachineInstance, vm *virtv1.VirtualMachine) (*virtv1.VirtualMachineInstance, error) {
 	if vmi == nil || vm == nil {
@@ -358,6 +358,41 @@ var _ = Describe("Volume Migration", func() {
 
 	})
 
+	Context("PatchVMIVolumeMigrationOptions", func() {
+		var (
+			virtClient    *kubecli.MockKubevirtClient
+			fakeClientset *fake.Clientset
+		)
+		const ns = k8sv1.NamespaceDefault
+
+		BeforeEach(func() {
+			virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+			fakeClientset = fake.NewSimpleClientset()
+			virtClient.EXPECT().VirtualMachineInstance(ns).Return(fakeClientset.KubevirtV1().VirtualMachineInstances(ns)).AnyTimes()
+		})
+
+		It("should copy the volume migration options of the VM to the VMI status", func() {
+			vmi := libvmi.New(libvmi.WithNamespace(ns))
+			vmi, err := fakeClientset.KubevirtV1().VirtualMachineInstances(ns).Create(context.TODO(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			vm := libvmi.NewVirtualMachine(vmi)
+			vm.Spec.VolumeMigration = &v1.VolumeMigrationOptions{
+				CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{Mode: v1.VolumeMigrationCutoverManual},
+			}
+
+			Expect(volumemigration.PatchVMIVolumeMigrationOptions(virtClient, vmi, vm)).To(Succeed())
+			vmi, err = fakeClientset.KubevirtV1().VirtualMachineInstances(ns).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmi.Status.VolumeMigration).To(Equal(vm.Spec.VolumeMigration))
+
+			vm.Spec.VolumeMigration.CutoverPolicy.Mode = v1.VolumeMigrationCutoverImmediate
+			Expect(volumemigration.PatchVMIVolumeMigrationOptions(virtClient, vmi, vm)).To(Succeed())
+			vmi, err = fakeClientset.KubevirtV1().VirtualMachineInstances(ns).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vmi.Status.VolumeMigration.CutoverPolicy.Mode).To(Equal(v1.VolumeMigrationCutoverImmediate))
+		})
+	})
+
 	Context("ValidateVolumesUpdateMigration", func() {
 		DescribeTable("should validate if the VMI can be migrate due to a volume update", func(vmi *v1.VirtualMachineInstance, exectedRes error) {
 			var err error
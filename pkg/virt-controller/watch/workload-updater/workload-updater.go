@@ -2,6 +2,7 @@ package workloadupdater
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
 	"math"
 	"math/rand"
@@ -69,6 +70,8 @@ type WorkloadUpdateController struct {
 	recorder              record.EventRecorder
 	migrationExpectations *controller.UIDTrackingControllerExpectations
 	kubeVirtStore         cache.Store
+	migrationPolicyStore  cache.Store
+	namespaceStore        cache.Store
 	clusterConfig         *virtconfig.ClusterConfig
 	launcherImage         string
 
@@ -82,6 +85,9 @@ type updateData struct {
 	migratableOutdatedVMIs []*virtv1.VirtualMachineInstance
 	evictOutdatedVMIs      []*virtv1.VirtualMachineInstance
 	abortChangeVMIs        []*virtv1.VirtualMachineInstance
+	// deferredVMIs maps the keys of the outdated VMIs waiting for a maintenance window
+	// to migrate to the time at which their next window opens
+	deferredVMIs map[string]*time.Time
 
 	numActiveMigrations int
 }
@@ -92,6 +98,8 @@ func NewWorkloadUpdateController(
 	podInformer cache.SharedIndexInformer,
 	migrationInformer cache.SharedIndexInformer,
 	kubeVirtInformer cache.SharedIndexInformer,
+	migrationPolicyInformer cache.SharedIndexInformer,
+	namespaceInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -111,13 +119,16 @@ func NewWorkloadUpdateController(
 		podIndexer:            podInformer.GetIndexer(),
 		migrationIndexer:      migrationInformer.GetIndexer(),
 		kubeVirtStore:         kubeVirtInformer.GetStore(),
+		migrationPolicyStore:  migrationPolicyInformer.GetStore(),
+		namespaceStore:        namespaceInformer.GetStore(),
 		recorder:              recorder,
 		clientset:             clientset,
 		launcherImage:         launcherImage,
 		migrationExpectations: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		clusterConfig:         clusterConfig,
 		hasSynced: func() bool {
-			return migrationInformer.HasSynced() && vmiInformer.HasSynced() && podInformer.HasSynced() && kubeVirtInformer.HasSynced()
+			return migrationInformer.HasSynced() && vmiInformer.HasSynced() && podInformer.HasSynced() && kubeVirtInformer.HasSynced() &&
+				migrationPolicyInformer.HasSynced() && namespaceInformer.HasSynced()
 		},
 	}
 
@@ -363,8 +374,17 @@ func (c *WorkloadUpdateController) shouldAbortMigration(vmi *virtv1.VirtualMachi
 	return numMig > 0
 }
 
+// maintenanceWindows returns the windows restricting the automated migrations of the VMI. The windows of the
+// migration policy applied to the VMI take precedence over the windows of the workload update strategy.
+func (c *WorkloadUpdateController) maintenanceWindows(kv *virtv1.KubeVirt, vmi *virtv1.VirtualMachineInstance) []virtv1.MaintenanceWindow {
+	return migrationutils.MaintenanceWindows(migrationutils.MatchPolicyFromStores(c.migrationPolicyStore, c.namespaceStore, vmi), kv)
+}
+
 func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateData {
-	data := &updateData{}
+	data := &updateData{
+		deferredVMIs: make(map[string]*time.Time),
+	}
+	now := time.Now()
 
 	lookup := make(map[string]bool)
 
@@ -419,6 +439,10 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 			volMig = true
 		}
 		if automatedMigrationAllowed && (vmi.IsMigratable() || volMig) {
+			if open, next := migrationutils.MaintenanceWindowOpen(c.maintenanceWindows(kv, vmi), now); !open {
+				data.deferredVMIs[vmi.Namespace+"/"+vmi.Name] = next
+				continue
+			}
 			data.migratableOutdatedVMIs = append(data.migratableOutdatedVMIs, vmi)
 		} else if automatedShutdownAllowed {
 			data.evictOutdatedVMIs = append(data.evictOutdatedVMIs, vmi)
@@ -492,6 +516,11 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 		}
 	}
 
+	nextWindow, conditionErr := c.syncAutoMigrationDeferredConditions(data.deferredVMIs)
+	if nextWindow != nil {
+		c.queue.AddAfter(key, time.Until(*nextWindow))
+	}
+
 	// Rather than enqueing based on VMI activity, we keep periodically poping the loop
 	// until all VMIs are updated. Watching all VMI activity is chatty for this controller
 	// when we don't need to be that efficent in how quickly the updates are being processed.
@@ -660,5 +689,27 @@ func (c *WorkloadUpdateController) sync(kv *virtv1.KubeVirt) error {
 	default:
 	}
 
-	return nil
+	return conditionErr
+}
+
+// syncAutoMigrationDeferredConditions reports the next maintenance window on the VMIs whose migration is
+// deferred and clears it from the other VMIs. VMIs marked for eviction are left to the evacuation controller.
+// The earliest time at which a window opens is returned.
+func (c *WorkloadUpdateController) syncAutoMigrationDeferredConditions(deferredVMIs map[string]*time.Time) (*time.Time, error) {
+	var nextWindow *time.Time
+	var errs []error
+	for _, obj := range c.vmiStore.List() {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.IsMarkedForEviction() {
+			continue
+		}
+		next := deferredVMIs[vmi.Namespace+"/"+vmi.Name]
+		if next != nil && (nextWindow == nil || next.Before(*nextWindow)) {
+			nextWindow = next
+		}
+		if err := migrationutils.SyncAutoMigrationDeferredCondition(c.clientset, vmi, next); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return nextWindow, goerrors.Join(errs...)
 }
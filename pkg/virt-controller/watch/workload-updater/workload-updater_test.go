@@ -23,6 +23,7 @@ import (
 	"k8s.io/client-go/tools/record"
 
 	v1 "kubevirt.io/api/core/v1"
+	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 	"kubevirt.io/client-go/testing"
@@ -70,6 +71,7 @@ var _ = Describe("Workload Updater", func() {
 	sanityExecute := func() {
 		controllertesting.SanityExecute(controller, []cache.Store{
 			controller.vmiStore, controller.podIndexer, controller.migrationIndexer, controller.kubeVirtStore,
+			controller.migrationPolicyStore, controller.namespaceStore,
 		}, Default)
 	}
 
@@ -98,11 +100,14 @@ var _ = Describe("Workload Updater", func() {
 		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
 
 		kubeVirtInformer, _ := testutils.NewFakeInformerFor(&v1.KubeVirt{})
+		migrationPolicyInformer, _ := testutils.NewFakeInformerFor(&migrationsv1.MigrationPolicy{})
+		namespaceInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Namespace{})
 
-		controller, _ = NewWorkloadUpdateController(expectedImage, vmiInformer, podInformer, migrationInformer, kubeVirtInformer, recorder, virtClient, config)
+		controller, _ = NewWorkloadUpdateController(expectedImage, vmiInformer, podInformer, migrationInformer, kubeVirtInformer, migrationPolicyInformer, namespaceInformer, recorder, virtClient, config)
 
 		// Set up mock client
 		virtClient.EXPECT().VirtualMachineInstanceMigration(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault)).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().VirtualMachineInstances(k8sv1.NamespaceDefault)).AnyTimes()
 		virtClient.EXPECT().KubeVirt(k8sv1.NamespaceDefault).Return(fakeVirtClient.KubevirtV1().KubeVirts(k8sv1.NamespaceDefault)).AnyTimes()
 		kubeClient = fake.NewSimpleClientset()
 		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
@@ -692,6 +697,111 @@ var _ = Describe("Workload Updater", func() {
 		)
 	})
 
+	Context("with maintenance windows", func() {
+		// closedWindow opens in two hours, the current time is never part of it
+		closedWindow := func() (v1.MaintenanceWindow, time.Time) {
+			next := time.Now().UTC().Truncate(time.Hour).Add(2 * time.Hour)
+			return v1.MaintenanceWindow{Schedule: fmt.Sprintf("* %d * * *", next.Hour())}, next
+		}
+
+		addVMI := func(vmi *v1.VirtualMachineInstance) {
+			_, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(controller.vmiStore.Add(vmi)).To(Succeed())
+			Expect(controller.podIndexer.Add(newLauncherPodForVMI(vmi))).To(Succeed())
+		}
+
+		addKubeVirtWithWindows := func(outdatedVMIs int, windows ...v1.MaintenanceWindow) {
+			kv := newKubeVirt(outdatedVMIs)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate}
+			kv.Spec.WorkloadUpdateStrategy.MaintenanceWindows = windows
+			addKubeVirt(kv)
+		}
+
+		listMigrations := func() []v1.VirtualMachineInstanceMigration {
+			migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			return migrations.Items
+		}
+
+		getDeferredCondition := func(vmi *v1.VirtualMachineInstance) *v1.VirtualMachineInstanceCondition {
+			updatedVMI, err := fakeVirtClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			return virtcontroller.NewVirtualMachineInstanceConditionManager().GetCondition(updatedVMI, v1.VirtualMachineInstanceAutoMigrationDeferred)
+		}
+
+		It("should defer the migration to the next window of the workload update strategy", func() {
+			window, next := closedWindow()
+			addKubeVirtWithWindows(1, window)
+			vmi := newVirtualMachineInstance("testvm", true, "madeup")
+			addVMI(vmi)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 1)
+
+			sanityExecute()
+			Expect(listMigrations()).To(BeEmpty())
+			condition := getDeferredCondition(vmi)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(k8sv1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonAutoMigrationPending))
+			Expect(condition.Message).To(ContainSubstring(next.Format(time.RFC3339)))
+		})
+
+		It("should migrate the VMI within a window of the workload update strategy", func() {
+			window, _ := closedWindow()
+			addKubeVirtWithWindows(1, window, v1.MaintenanceWindow{Schedule: "* * * * *", TimeZone: pointer.P("America/New_York")})
+			vmi := newVirtualMachineInstance("testvm", true, "madeup")
+			addVMI(vmi)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 1)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			Expect(listMigrations()).To(HaveLen(1))
+			Expect(getDeferredCondition(vmi)).To(BeNil())
+		})
+
+		It("should prefer the windows of the migration policy applied to the VMI", func() {
+			addKubeVirtWithWindows(2, v1.MaintenanceWindow{Schedule: "* * * * *"})
+			window, _ := closedWindow()
+			policy := kubecli.NewMinimalMigrationPolicy("night")
+			policy.Spec.Selectors = &migrationsv1.Selectors{
+				VirtualMachineInstanceSelector: migrationsv1.LabelSelector{"tier": "latency-sensitive"},
+			}
+			policy.Spec.MaintenanceWindows = []v1.MaintenanceWindow{window}
+			Expect(controller.migrationPolicyStore.Add(policy)).To(Succeed())
+
+			vmi := newVirtualMachineInstance("testvm", true, "madeup")
+			vmi.Labels = map[string]string{"tier": "latency-sensitive"}
+			addVMI(vmi)
+			otherVMI := newVirtualMachineInstance("othervm", true, "madeup")
+			otherVMI.Labels = map[string]string{"tier": "batch"}
+			addVMI(otherVMI)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 2)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			migrations := listMigrations()
+			Expect(migrations).To(HaveLen(1))
+			Expect(migrations[0].Spec.VMIName).To(Equal(otherVMI.Name))
+			Expect(getDeferredCondition(vmi)).ToNot(BeNil())
+		})
+
+		It("should clear the deferral of VMIs which no longer wait for a window", func() {
+			addKubeVirtWithWindows(0, v1.MaintenanceWindow{Schedule: "* * * * *"})
+			vmi := newVirtualMachineInstance("testvm", true, expectedImage)
+			vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+				Type:   v1.VirtualMachineInstanceAutoMigrationDeferred,
+				Status: k8sv1.ConditionTrue,
+				Reason: v1.VirtualMachineInstanceReasonAutoMigrationPending,
+			})
+			addVMI(vmi)
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 1)
+
+			sanityExecute()
+			Expect(listMigrations()).To(BeEmpty())
+			Expect(getDeferredCondition(vmi)).To(BeNil())
+		})
+	})
+
 	Context("when MigrationPriorityQueue feature gate is enabled", func() {
 		BeforeEach(func() {
 			config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
@@ -0,0 +1,402 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package backupproxy
+
+import (
+	"crypto/subtle"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/nbd"
+)
+
+const (
+	// Clients prefix the export name with the backup token: "<token>/<export>"
+	tokenSeparator = "/"
+
+	negotiationTimeout = 30 * time.Second
+)
+
+var errAborted = errors.New("client aborted the NBD negotiation")
+
+// ProxyManager exposes the NBD servers of pull mode backups, which only listen
+// on a unix socket inside the virt-launcher pod, on the virt-handler pod network.
+// Connections are TLS protected and authenticated with the backup token.
+type ProxyManager interface {
+	StartExport(key string, tokenHash string, unixSocketPath string) error
+	StopExport(key string)
+	Endpoint() string
+}
+
+type backupExport struct {
+	key            string
+	tokenHash      string
+	unixSocketPath string
+}
+
+type backupProxyManager struct {
+	exports         map[string]backupExport
+	managerLock     sync.Mutex
+	serverTLSConfig *tls.Config
+	bindAddress     string
+	port            int
+	listener        net.Listener
+
+	logger *log.FilteredLogger
+}
+
+func NewBackupProxyManager(bindAddress string, port int, serverTLSConfig *tls.Config) ProxyManager {
+	return &backupProxyManager{
+		exports:         make(map[string]backupExport),
+		serverTLSConfig: serverTLSConfig,
+		bindAddress:     bindAddress,
+		port:            port,
+		logger:          log.Log.With("component", "backup-proxy"),
+	}
+}
+
+// StartExport makes the NBD server behind unixSocketPath reachable to clients
+// presenting a token matching tokenHash. The listener is started with the first export.
+func (m *backupProxyManager) StartExport(key string, tokenHash string, unixSocketPath string) error {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	if tokenHash == "" {
+		return fmt.Errorf("refusing to export %s without a token", key)
+	}
+	m.exports[key] = backupExport{
+		key:            key,
+		tokenHash:      tokenHash,
+		unixSocketPath: unixSocketPath,
+	}
+	if m.listener != nil {
+		return nil
+	}
+
+	listener, err := net.Listen("tcp", net.JoinHostPort(m.bindAddress, strconv.Itoa(m.port)))
+	if err != nil {
+		delete(m.exports, key)
+		m.logger.Reason(err).Error("failed to create backup proxy listener")
+		return err
+	}
+	if m.port == 0 {
+		// update the random port that was selected
+		m.port = listener.Addr().(*net.TCPAddr).Port
+	}
+	m.listener = listener
+	m.logger.Infof("backup proxy listening on %s", listener.Addr())
+	go m.serve(listener)
+	return nil
+}
+
+// StopExport stops accepting new connections for the export. Established
+// connections end once the NBD server in virt-launcher goes away.
+func (m *backupProxyManager) StopExport(key string) {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	if _, exists := m.exports[key]; !exists {
+		return
+	}
+	delete(m.exports, key)
+	if len(m.exports) == 0 && m.listener != nil {
+		m.logger.Info("backup proxy stopped listening")
+		m.listener.Close()
+		m.listener = nil
+	}
+}
+
+// Endpoint returns the address clients connect to, or an empty string when nothing is exported
+func (m *backupProxyManager) Endpoint() string {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	if m.listener == nil {
+		return ""
+	}
+	return net.JoinHostPort(m.bindAddress, strconv.Itoa(m.port))
+}
+
+func (m *backupProxyManager) lookupExport(token string) (backupExport, bool) {
+	m.managerLock.Lock()
+	defer m.managerLock.Unlock()
+
+	tokenHash := []byte(cbt.BackupTokenHash(token))
+	for _, export := range m.exports {
+		if subtle.ConstantTimeCompare(tokenHash, []byte(export.tokenHash)) == 1 {
+			return export, true
+		}
+	}
+	return backupExport{}, false
+}
+
+func (m *backupProxyManager) serve(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return
+			}
+			m.logger.Reason(err).Error("backup proxy listener returned error.")
+			return
+		}
+		go m.handleConnection(conn)
+	}
+}
+
+func (m *backupProxyManager) handleConnection(conn net.Conn) {
+	defer conn.Close()
+
+	logger := m.logger.With("remote", conn.RemoteAddr().String())
+	if err := conn.SetDeadline(time.Now().Add(negotiationTimeout)); err != nil {
+		logger.Reason(err).Error("failed to set the negotiation deadline")
+		return
+	}
+	client, upstream, err := m.negotiate(conn)
+	if err != nil {
+		if !errors.Is(err, errAborted) {
+			logger.Reason(err).Warning("NBD negotiation failed")
+		}
+		return
+	}
+	defer client.Close()
+	defer upstream.Close()
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		logger.Reason(err).Error("failed to clear the negotiation deadline")
+		return
+	}
+
+	outBoundErr := make(chan error, 1)
+	inBoundErr := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(client, upstream)
+		inBoundErr <- err
+	}()
+	go func() {
+		_, err := io.Copy(upstream, client)
+		outBoundErr <- err
+	}()
+
+	select {
+	case err = <-outBoundErr:
+		if err != nil {
+			logger.Reason(err).Error("error encountered copying data to the NBD server")
+		}
+	case err = <-inBoundErr:
+		if err != nil {
+			logger.Reason(err).Error("error encountered copying data to the NBD client")
+		}
+	}
+}
+
+// negotiate runs the option haggling phase on behalf of the NBD server in virt-launcher.
+// TLS is mandatory before any export is revealed, the token prefix is stripped from
+// export names before they are forwarded, and once the client selected an export the
+// connections are returned ready for transmission.
+func (m *backupProxyManager) negotiate(conn net.Conn) (net.Conn, net.Conn, error) {
+	if err := nbd.WriteGreeting(conn); err != nil {
+		return nil, nil, err
+	}
+	clientFlags, err := nbd.ReadClientFlags(conn)
+	if err != nil {
+		return nil, nil, err
+	}
+	if clientFlags&nbd.FlagCFixedNewstyle == 0 {
+		return nil, nil, fmt.Errorf("NBD client does not support the fixed newstyle handshake")
+	}
+
+	var client net.Conn = conn
+	var upstream net.Conn
+	var export backupExport
+	tlsEstablished := false
+	structuredReplies := false
+
+	closeUpstream := func() {
+		if upstream != nil {
+			upstream.Close()
+		}
+	}
+
+	for {
+		opt, err := nbd.ReadOption(client)
+		if err != nil {
+			closeUpstream()
+			return nil, nil, err
+		}
+
+		if opt.Code == nbd.OptAbort {
+			nbd.ReplyTo(client, opt, nbd.RepAck)
+			closeUpstream()
+			return nil, nil, errAborted
+		}
+
+		if !tlsEstablished {
+			if opt.Code != nbd.OptStartTLS {
+				if err := nbd.ReplyTo(client, opt, nbd.RepErrTLSReqd); err != nil {
+					return nil, nil, err
+				}
+				continue
+			}
+			if err := nbd.ReplyTo(client, opt, nbd.RepAck); err != nil {
+				return nil, nil, err
+			}
+			tlsConn := tls.Server(conn, m.serverTLSConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return nil, nil, err
+			}
+			client = tlsConn
+			tlsEstablished = true
+			continue
+		}
+
+		switch {
+		case opt.Code == nbd.OptStartTLS:
+			err = nbd.ReplyTo(client, opt, nbd.RepErrInvalid)
+		case nbd.CarriesExportName(opt.Code):
+			var name string
+			name, err = opt.ExportName()
+			if err != nil {
+				if opt.Code == nbd.OptExportName {
+					closeUpstream()
+					return nil, nil, err
+				}
+				err = nbd.ReplyTo(client, opt, nbd.RepErrInvalid)
+				break
+			}
+			token, exportName, found := strings.Cut(name, tokenSeparator)
+			requested, authorized := m.lookupExport(token)
+			if !found || !authorized || (upstream != nil && requested.key != export.key) {
+				if opt.Code == nbd.OptExportName {
+					// NBD_OPT_EXPORT_NAME has no way to report errors but closing the connection
+					closeUpstream()
+					return nil, nil, fmt.Errorf("unauthorized NBD export request")
+				}
+				err = nbd.ReplyTo(client, opt, nbd.RepErrPolicy)
+				break
+			}
+			if upstream == nil {
+				export = requested
+				upstream, err = dialUpstream(export.unixSocketPath, clientFlags, structuredReplies)
+				if err != nil {
+					return nil, nil, err
+				}
+			}
+			if err = nbd.WriteOption(upstream, opt.WithExportName(exportName)); err != nil {
+				break
+			}
+			if opt.Code == nbd.OptExportName {
+				// the server answers with the export details and transmission starts
+				return client, upstream, nil
+			}
+			var reply *nbd.OptionReply
+			reply, err = relayReplies(upstream, client)
+			if err == nil && opt.Code == nbd.OptGo && reply.ReplyType == nbd.RepAck {
+				return client, upstream, nil
+			}
+		case upstream != nil:
+			if err = nbd.WriteOption(upstream, opt); err == nil {
+				_, err = relayReplies(upstream, client)
+			}
+		case opt.Code == nbd.OptStructuredReply:
+			// replayed against the NBD server once the client picks an export
+			structuredReplies = true
+			err = nbd.ReplyTo(client, opt, nbd.RepAck)
+		case opt.Code == nbd.OptList:
+			err = nbd.ReplyTo(client, opt, nbd.RepErrPolicy)
+		default:
+			err = nbd.ReplyTo(client, opt, nbd.RepErrUnsup)
+		}
+		if err != nil {
+			closeUpstream()
+			return nil, nil, err
+		}
+	}
+}
+
+// dialUpstream connects to the NBD server in virt-launcher and negotiates
+// the same handshake flags the client asked for
+func dialUpstream(unixSocketPath string, clientFlags uint32, structuredReplies bool) (net.Conn, error) {
+	conn, err := net.Dial("unix", unixSocketPath)
+	if err != nil {
+		return nil, err
+	}
+	if err := handshakeUpstream(conn, clientFlags, structuredReplies); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+func handshakeUpstream(conn net.Conn, clientFlags uint32, structuredReplies bool) error {
+	serverFlags, err := nbd.ReadGreeting(conn)
+	if err != nil {
+		return err
+	}
+	if serverFlags&nbd.FlagFixedNewstyle == 0 {
+		return fmt.Errorf("NBD server does not support the fixed newstyle handshake")
+	}
+	flags := nbd.FlagCFixedNewstyle
+	if clientFlags&nbd.FlagCNoZeroes != 0 && serverFlags&nbd.FlagNoZeroes != 0 {
+		flags |= nbd.FlagCNoZeroes
+	}
+	if err := nbd.WriteClientFlags(conn, flags); err != nil {
+		return err
+	}
+	if !structuredReplies {
+		return nil
+	}
+	if err := nbd.WriteOption(conn, &nbd.Option{Code: nbd.OptStructuredReply}); err != nil {
+		return err
+	}
+	reply, err := nbd.ReadOptionReply(conn)
+	if err != nil {
+		return err
+	}
+	if reply.ReplyType != nbd.RepAck {
+		return fmt.Errorf("NBD server refused structured replies")
+	}
+	return nil
+}
+
+// relayReplies forwards option replies to the client up to and including the final one
+func relayReplies(upstream net.Conn, client net.Conn) (*nbd.OptionReply, error) {
+	for {
+		reply, err := nbd.ReadOptionReply(upstream)
+		if err != nil {
+			return nil, err
+		}
+		if err := nbd.WriteOptionReply(client, reply); err != nil {
+			return nil, err
+		}
+		if reply.IsFinal() {
+			return reply, nil
+		}
+	}
+}
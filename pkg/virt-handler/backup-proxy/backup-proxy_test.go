@@ -0,0 +1,284 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package backupproxy
+
+import (
+	"crypto/tls"
+	"encoding/binary"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/certificates"
+	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/nbd"
+)
+
+const (
+	testToken  = "test-token"
+	testExport = "disk0"
+	testBitmap = "qemu:dirty-bitmap:backup-disk0"
+)
+
+// fakeNBDServer plays the qemu side of a pull mode backup: it answers the
+// handshake, records the options it receives and echoes the transmission phase
+type fakeNBDServer struct {
+	listener net.Listener
+	options  chan *nbd.Option
+}
+
+func newFakeNBDServer(socketPath string) *fakeNBDServer {
+	listener, err := net.Listen("unix", socketPath)
+	Expect(err).ToNot(HaveOccurred())
+	server := &fakeNBDServer{
+		listener: listener,
+		options:  make(chan *nbd.Option, 10),
+	}
+	go server.serve()
+	return server
+}
+
+func (s *fakeNBDServer) serve() {
+	defer GinkgoRecover()
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *fakeNBDServer) handle(conn net.Conn) {
+	defer GinkgoRecover()
+	defer conn.Close()
+
+	Expect(nbd.WriteGreeting(conn)).To(Succeed())
+	flags, err := nbd.ReadClientFlags(conn)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(flags & nbd.FlagCFixedNewstyle).ToNot(BeZero())
+
+	for {
+		opt, err := nbd.ReadOption(conn)
+		if err != nil {
+			return
+		}
+		s.options <- opt
+		switch opt.Code {
+		case nbd.OptSetMetaContext:
+			context := make([]byte, 4, 4+len(testBitmap))
+			binary.BigEndian.PutUint32(context, 1)
+			context = append(context, testBitmap...)
+			Expect(nbd.WriteOptionReply(conn, &nbd.OptionReply{Option: opt.Code, ReplyType: 4, Data: context})).To(Succeed())
+			Expect(nbd.ReplyTo(conn, opt, nbd.RepAck)).To(Succeed())
+		case nbd.OptGo:
+			name, err := opt.ExportName()
+			Expect(err).ToNot(HaveOccurred())
+			if name != testExport {
+				Expect(nbd.ReplyTo(conn, opt, nbd.RepErrUnsup)).To(Succeed())
+				continue
+			}
+			Expect(nbd.ReplyTo(conn, opt, nbd.RepAck)).To(Succeed())
+			io.Copy(conn, conn)
+			return
+		default:
+			Expect(nbd.ReplyTo(conn, opt, nbd.RepAck)).To(Succeed())
+		}
+	}
+}
+
+func (s *fakeNBDServer) Close() {
+	s.listener.Close()
+}
+
+// nbdClient is the minimal fixed newstyle client needed to reach the transmission phase
+type nbdClient struct {
+	net.Conn
+}
+
+func dialNBD(address string) *nbdClient {
+	conn, err := net.Dial("tcp", address)
+	Expect(err).ToNot(HaveOccurred())
+	flags, err := nbd.ReadGreeting(conn)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(flags & nbd.FlagFixedNewstyle).ToNot(BeZero())
+	Expect(nbd.WriteClientFlags(conn, nbd.FlagCFixedNewstyle|nbd.FlagCNoZeroes)).To(Succeed())
+	return &nbdClient{Conn: conn}
+}
+
+func (c *nbdClient) option(code uint32, data []byte) []*nbd.OptionReply {
+	Expect(nbd.WriteOption(c, &nbd.Option{Code: code, Data: data})).To(Succeed())
+	var replies []*nbd.OptionReply
+	for {
+		reply, err := nbd.ReadOptionReply(c)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reply.Option).To(Equal(code))
+		replies = append(replies, reply)
+		if reply.IsFinal() {
+			return replies
+		}
+	}
+}
+
+func (c *nbdClient) startTLS() {
+	replies := c.option(nbd.OptStartTLS, nil)
+	Expect(replies[0].ReplyType).To(Equal(nbd.RepAck))
+	tlsConn := tls.Client(c.Conn, &tls.Config{InsecureSkipVerify: true})
+	Expect(tlsConn.Handshake()).To(Succeed())
+	c.Conn = tlsConn
+}
+
+func exportNameData(name string, rest ...byte) []byte {
+	data := make([]byte, 4, 4+len(name)+len(rest))
+	binary.BigEndian.PutUint32(data, uint32(len(name)))
+	data = append(data, name...)
+	return append(data, rest...)
+}
+
+func metaContextQuery(name, query string) []byte {
+	queries := make([]byte, 8, 8+len(query))
+	binary.BigEndian.PutUint32(queries, 1)
+	binary.BigEndian.PutUint32(queries[4:], uint32(len(query)))
+	queries = append(queries, query...)
+	return exportNameData(name, queries...)
+}
+
+var _ = Describe("BackupProxy", func() {
+	var (
+		tlsConfig  *tls.Config
+		tmpDir     string
+		socketPath string
+		nbdServer  *fakeNBDServer
+		manager    ProxyManager
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = os.MkdirTemp("", "backupproxytest")
+		Expect(err).ToNot(HaveOccurred())
+		store, err := certificates.GenerateSelfSignedCert(tmpDir, "test", "test")
+		Expect(err).ToNot(HaveOccurred())
+		tlsConfig = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			GetCertificate: func(info *tls.ClientHelloInfo) (certificate *tls.Certificate, e error) {
+				return store.Current()
+			},
+		}
+
+		socketPath = filepath.Join(tmpDir, cbt.BackupNBDSocketName)
+		nbdServer = newFakeNBDServer(socketPath)
+		manager = NewBackupProxyManager("127.0.0.1", 0, tlsConfig)
+		Expect(manager.StartExport("vmi-uid", cbt.BackupTokenHash(testToken), socketPath)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		manager.StopExport("vmi-uid")
+		nbdServer.Close()
+		os.RemoveAll(tmpDir)
+	})
+
+	It("should forward an authorized export with its dirty bitmap", func() {
+		client := dialNBD(manager.Endpoint())
+		defer client.Close()
+		client.startTLS()
+
+		replies := client.option(nbd.OptSetMetaContext, metaContextQuery(testToken+"/"+testExport, testBitmap))
+		Expect(replies).To(HaveLen(2))
+		Expect(string(replies[0].Data[4:])).To(Equal(testBitmap))
+		Expect(replies[1].ReplyType).To(Equal(nbd.RepAck))
+		opt := <-nbdServer.options
+		Expect(opt.ExportName()).To(Equal(testExport))
+		Expect(opt.Data).To(Equal(metaContextQuery(testExport, testBitmap)))
+
+		replies = client.option(nbd.OptGo, exportNameData(testToken+"/"+testExport, 0, 0))
+		Expect(replies[0].ReplyType).To(Equal(nbd.RepAck))
+		opt = <-nbdServer.options
+		Expect(opt.Data).To(Equal(exportNameData(testExport, 0, 0)))
+
+		// transmission phase is spliced through
+		message := []byte("nbd transmission")
+		_, err := client.Write(message)
+		Expect(err).ToNot(HaveOccurred())
+		echo := make([]byte, len(message))
+		_, err = io.ReadFull(client, echo)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(echo).To(Equal(message))
+	})
+
+	It("should replay structured replies negotiated before the export was selected", func() {
+		client := dialNBD(manager.Endpoint())
+		defer client.Close()
+		client.startTLS()
+
+		replies := client.option(nbd.OptStructuredReply, nil)
+		Expect(replies[0].ReplyType).To(Equal(nbd.RepAck))
+		Expect(nbdServer.options).To(BeEmpty())
+
+		replies = client.option(nbd.OptGo, exportNameData(testToken+"/"+testExport, 0, 0))
+		Expect(replies[0].ReplyType).To(Equal(nbd.RepAck))
+		Expect((<-nbdServer.options).Code).To(Equal(nbd.OptStructuredReply))
+		Expect((<-nbdServer.options).Code).To(Equal(nbd.OptGo))
+	})
+
+	It("should require TLS before any export is revealed", func() {
+		client := dialNBD(manager.Endpoint())
+		defer client.Close()
+
+		replies := client.option(nbd.OptGo, exportNameData(testToken+"/"+testExport, 0, 0))
+		Expect(replies[0].ReplyType).To(Equal(nbd.RepErrTLSReqd))
+		replies = client.option(nbd.OptList, nil)
+		Expect(replies[0].ReplyType).To(Equal(nbd.RepErrTLSReqd))
+		Expect(nbdServer.options).To(BeEmpty())
+	})
+
+	DescribeTable("should refuse exports without a valid token", func(exportName string) {
+		client := dialNBD(manager.Endpoint())
+		defer client.Close()
+		client.startTLS()
+
+		replies := client.option(nbd.OptGo, exportNameData(exportName, 0, 0))
+		Expect(replies[0].ReplyType).To(Equal(nbd.RepErrPolicy))
+		replies = client.option(nbd.OptList, nil)
+		Expect(replies[0].ReplyType).To(Equal(nbd.RepErrPolicy))
+		Expect(nbdServer.options).To(BeEmpty())
+	},
+		Entry("with a wrong token", "wrong-token/"+testExport),
+		Entry("without a token", testExport),
+	)
+
+	It("should stop listening once the last export stopped", func() {
+		endpoint := manager.Endpoint()
+		Expect(endpoint).ToNot(BeEmpty())
+
+		manager.StopExport("vmi-uid")
+
+		Expect(manager.Endpoint()).To(BeEmpty())
+		_, err := net.Dial("tcp", endpoint)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should refuse to export without a token", func() {
+		Expect(manager.StartExport("other-uid", "", socketPath)).ToNot(Succeed())
+	})
+})
@@ -49,6 +49,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/info"
 	cmdv1 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	"kubevirt.io/kubevirt/pkg/safepath"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	grpcutil "kubevirt.io/kubevirt/pkg/util/net/grpc"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
@@ -75,6 +76,7 @@ type MigrationOptions struct {
 	AllowPostCopy            bool
 	ParallelMigrationThreads *uint
 	AllowWorkloadDisruption  bool
+	Compression              *v1.MigrationCompression
 }
 
 type LauncherClient interface {
@@ -103,7 +105,7 @@ type LauncherClient interface {
 	Ping() error
 	GuestPing(string, int32) error
 	Close()
-	VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
+	VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string, format v1.MemoryDumpFormat) error
 	GetQemuVersion() (string, error)
 	SyncVirtualMachineCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
@@ -113,6 +115,7 @@ type LauncherClient interface {
 	GetDomainDirtyRateStats() (dirtyRateMbps int64, err error)
 	GetScreenshot(*v1.VirtualMachineInstance) (*cmdv1.ScreenshotResponse, error)
 	VirtualMachineBackup(vmi *v1.VirtualMachineInstance, options *backupv1.BackupOptions) error
+	GetChangedBlocks(vmi *v1.VirtualMachineInstance, options *v1.ChangedBlocksOptions) (*v1.ChangedBlocksList, error)
 }
 
 type VirtLauncherClient struct {
@@ -331,7 +334,9 @@ func (c *VirtLauncherClient) FreezeVirtualMachine(vmi *v1.VirtualMachineInstance
 		UnfreezeTimeoutSeconds: unfreezeTimeoutSeconds,
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	// the pre-freeze hooks run before the guest is frozen
+	timeout := longTimeout + storagetypes.FreezeHooksTimeout(storagetypes.PreFreezeHooks(&vmi.Spec))
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 	response, err := c.v1client.FreezeVirtualMachine(ctx, request)
 
@@ -340,10 +345,29 @@ func (c *VirtLauncherClient) FreezeVirtualMachine(vmi *v1.VirtualMachineInstance
 }
 
 func (c *VirtLauncherClient) UnfreezeVirtualMachine(vmi *v1.VirtualMachineInstance) error {
-	return c.genericSendVMICmd("Unfreeze", c.v1client.UnfreezeVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.VMIRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: &cmdv1.VirtualMachineOptions{},
+	}
+
+	// the post-thaw hooks run after the guest is thawed
+	timeout := longTimeout + storagetypes.FreezeHooksTimeout(storagetypes.PostThawHooks(&vmi.Spec))
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	response, err := c.v1client.UnfreezeVirtualMachine(ctx, request)
+
+	err = handleError(err, "Unfreeze", response)
+	return err
 }
 
-func (c *VirtLauncherClient) VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
+func (c *VirtLauncherClient) VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string, format v1.MemoryDumpFormat) error {
 	vmiJson, err := json.Marshal(vmi)
 	if err != nil {
 		return err
@@ -354,6 +378,7 @@ func (c *VirtLauncherClient) VirtualMachineMemoryDump(vmi *v1.VirtualMachineInst
 			VmiJson: vmiJson,
 		},
 		DumpPath: dumpPath,
+		Format:   string(format),
 	}
 
 	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
@@ -794,10 +819,52 @@ func (c *VirtLauncherClient) VirtualMachineBackup(vmi *v1.VirtualMachineInstance
 		Options: optionsJson,
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	// the backup freezes and thaws the guest around the start of the backup job
+	timeout := longTimeout +
+		storagetypes.FreezeHooksTimeout(storagetypes.PreFreezeHooks(&vmi.Spec)) +
+		storagetypes.FreezeHooksTimeout(storagetypes.PostThawHooks(&vmi.Spec))
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
 	defer cancel()
 	response, err := c.v1client.BackupVirtualMachine(ctx, request)
 
 	err = handleError(err, "Backup", response)
 	return err
 }
+
+func (c *VirtLauncherClient) GetChangedBlocks(vmi *v1.VirtualMachineInstance, options *v1.ChangedBlocksOptions) (*v1.ChangedBlocksList, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	optionsJson, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &cmdv1.ChangedBlocksRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: optionsJson,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+	changedBlocksResponse, err := c.v1client.GetChangedBlocks(ctx, request)
+	var response *cmdv1.Response
+	if changedBlocksResponse != nil {
+		response = changedBlocksResponse.Response
+	}
+
+	if err = handleError(err, "GetChangedBlocks", response); err != nil {
+		return nil, err
+	}
+
+	changedBlocks := &v1.ChangedBlocksList{}
+	if err := json.Unmarshal([]byte(changedBlocksResponse.ChangedBlocks), changedBlocks); err != nil {
+		log.Log.Reason(err).Error("error unmarshalling changed blocks response")
+		return nil, err
+	}
+	return changedBlocks, nil
+}
@@ -129,6 +129,21 @@ func (mr *MockLauncherClientMockRecorder) FreezeVirtualMachine(vmi, unfreezeTime
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeVirtualMachine", reflect.TypeOf((*MockLauncherClient)(nil).FreezeVirtualMachine), vmi, unfreezeTimeoutSeconds)
 }
 
+// GetChangedBlocks mocks base method.
+func (m *MockLauncherClient) GetChangedBlocks(vmi *v1.VirtualMachineInstance, options *v1.ChangedBlocksOptions) (*v1.ChangedBlocksList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangedBlocks", vmi, options)
+	ret0, _ := ret[0].(*v1.ChangedBlocksList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangedBlocks indicates an expected call of GetChangedBlocks.
+func (mr *MockLauncherClientMockRecorder) GetChangedBlocks(vmi, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangedBlocks", reflect.TypeOf((*MockLauncherClient)(nil).GetChangedBlocks), vmi, options)
+}
+
 // GetDomain mocks base method.
 func (m *MockLauncherClient) GetDomain() (*api.Domain, bool, error) {
 	m.ctrl.T.Helper()
@@ -534,15 +549,15 @@ func (mr *MockLauncherClientMockRecorder) VirtualMachineBackup(vmi, options any)
 }
 
 // VirtualMachineMemoryDump mocks base method.
-func (m *MockLauncherClient) VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
+func (m *MockLauncherClient) VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string, format v1.MemoryDumpFormat) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VirtualMachineMemoryDump", vmi, dumpPath)
+	ret := m.ctrl.Call(m, "VirtualMachineMemoryDump", vmi, dumpPath, format)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // VirtualMachineMemoryDump indicates an expected call of VirtualMachineMemoryDump.
-func (mr *MockLauncherClientMockRecorder) VirtualMachineMemoryDump(vmi, dumpPath any) *gomock.Call {
+func (mr *MockLauncherClientMockRecorder) VirtualMachineMemoryDump(vmi, dumpPath, format any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineMemoryDump", reflect.TypeOf((*MockLauncherClient)(nil).VirtualMachineMemoryDump), vmi, dumpPath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineMemoryDump", reflect.TypeOf((*MockLauncherClient)(nil).VirtualMachineMemoryDump), vmi, dumpPath, format)
 }
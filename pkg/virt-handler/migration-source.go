@@ -204,6 +204,42 @@ func (c *MigrationSourceController) setMigrationProgressStatus(vmi *v1.VirtualMa
 	}
 
 	vmi.Status.MigrationState.Mode = migrationMetadata.Mode
+	setVolumeMigrationProgress(vmi, migrationMetadata.Volumes)
+}
+
+func isVolumeMigrationRunning(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
+	if len(vmi.Status.MigratedVolumes) == 0 || domain == nil {
+		return false
+	}
+	migrationMetadata := domain.Spec.Metadata.KubeVirt.Migration
+	return migrationMetadata != nil &&
+		migrationMetadata.UID == vmi.Status.MigrationState.MigrationUID &&
+		migrationMetadata.StartTimestamp != nil &&
+		migrationMetadata.EndTimestamp == nil
+}
+
+// setVolumeMigrationProgress reports the progress of the copy of the migrated volumes
+func setVolumeMigrationProgress(vmi *v1.VirtualMachineInstance, volumes *api.MigrationVolumesMetadata) {
+	if volumes == nil {
+		return
+	}
+	progress := make(map[string]api.MigrationVolumeMetadata)
+	for _, vol := range volumes.Volumes {
+		progress[vol.Name] = vol
+	}
+	for i, migVol := range vmi.Status.MigratedVolumes {
+		vol, ok := progress[migVol.VolumeName]
+		if !ok {
+			continue
+		}
+		vmi.Status.MigratedVolumes[i].Progress = &v1.VolumeMigrationProgress{
+			BytesTotal:     int64(vol.BytesTotal),
+			BytesCopied:    int64(vol.BytesCopied),
+			BytesRemaining: int64(vol.BytesTotal - min(vol.BytesCopied, vol.BytesTotal)),
+			BytesPerSecond: int64(vol.BytesPerSecond),
+			CutoverPending: vol.CutoverPending,
+		}
+	}
 }
 
 func (c *MigrationSourceController) updateStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
@@ -486,6 +522,10 @@ func (c *MigrationSourceController) migrateVMI(vmi *v1.VirtualMachineInstance, d
 	}
 
 	if isMigrationInProgress(vmi, domain) {
+		if isVolumeMigrationRunning(vmi, domain) && vmi.Status.VolumeMigration != nil {
+			// Hand the current volume migration options over to the running migration
+			return client.MigrateVirtualMachine(vmi, &cmdclient.MigrationOptions{})
+		}
 		// we already started this migration, no need to rerun this
 		c.logger.Object(vmi).V(4).Infof("migration %s has already been started", vmi.Status.MigrationState.MigrationUID)
 		return nil
@@ -523,6 +563,7 @@ func (c *MigrationSourceController) migrateVMI(vmi *v1.VirtualMachineInstance, d
 		AllowAutoConverge:       *migrationConfiguration.AllowAutoConverge,
 		AllowPostCopy:           *migrationConfiguration.AllowPostCopy,
 		AllowWorkloadDisruption: *migrationConfiguration.AllowWorkloadDisruption,
+		Compression:             migrationConfiguration.Compression,
 	}
 
 	configureParallelMigrationThreads(options, vmi)
@@ -627,13 +668,7 @@ func (c *MigrationSourceController) handleMigrationAbort(vmi *v1.VirtualMachineI
 }
 
 func configureParallelMigrationThreads(options *cmdclient.MigrationOptions, vm *v1.VirtualMachineInstance) {
-	// When the CPU is limited, there's a risk of the migration threads choking the CPU resources on the compute container.
-	// For this reason, we will avoid configuring migration threads in such scenarios.
-	if cpuLimit, cpuLimitExists := vm.Spec.Domain.Resources.Limits[k8sv1.ResourceCPU]; cpuLimitExists && !cpuLimit.IsZero() {
-		return
-	}
-
-	if options.AllowPostCopy {
+	if !migrations.IsParallelMigration(vm, options.AllowPostCopy) {
 		return
 	}
 
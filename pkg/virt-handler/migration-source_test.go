@@ -388,6 +388,37 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 			Expect(vmi.Status.MigrationState.FailureReason).To(Equal(d.Spec.Metadata.KubeVirt.Migration.FailureReason))
 			testutils.ExpectEvent(recorder, v1.Migrated.String())
 		})
+
+		It("should report the progress of the migrated volumes", func() {
+			d := newDomainMigrationKubevirtMetadata("1234", nil, false, false, v1.MigrationPreCopy)
+			d.Spec.Metadata.KubeVirt.Migration.Volumes = &api.MigrationVolumesMetadata{
+				Volumes: []api.MigrationVolumeMetadata{{
+					Name:           "disk0",
+					BytesTotal:     1000,
+					BytesCopied:    1000,
+					BytesPerSecond: 10,
+					CutoverPending: true,
+				}},
+			}
+			vmi := libvmi.New(libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithMigrationState(v1.VirtualMachineInstanceMigrationState{
+					MigrationUID:      "1234",
+					SourceNode:        host,
+					TargetNodeAddress: "othernode",
+				}), libvmistatus.WithNodeName(host)),
+			))
+			vmi.Status.MigratedVolumes = []v1.StorageMigratedVolumeInfo{{VolumeName: "disk0"}, {VolumeName: "disk1"}}
+
+			controller.setMigrationProgressStatus(vmi, d)
+
+			Expect(vmi.Status.MigratedVolumes[0].Progress).To(Equal(&v1.VolumeMigrationProgress{
+				BytesTotal:     1000,
+				BytesCopied:    1000,
+				BytesPerSecond: 10,
+				CutoverPending: true,
+			}))
+			Expect(vmi.Status.MigratedVolumes[1].Progress).To(BeNil())
+		})
 	})
 
 	Context("handleMigrationAbort", func() {
@@ -558,6 +589,27 @@ var _ = Describe("VirtualMachineInstance migration target", func() {
 				Entry("if CPU is limited", false, k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("4")}),
 				Entry("if post-copy is enabled", true, k8sv1.ResourceList{}),
 			)
+
+			It("should pass the compression to the launcher", func() {
+				compression := &v1.MigrationCompression{Method: v1.MigrationCompressionZlib, Level: pointer.P(int32(6))}
+				vmi.Status.MigrationState.MigrationConfiguration = &v1.MigrationConfiguration{
+					BandwidthPerMigration:   pointer.P(resource.MustParse("0Mi")),
+					ProgressTimeout:         pointer.P(int64(150)),
+					AllowAutoConverge:       pointer.P(false),
+					CompletionTimeoutPerGiB: pointer.P(int64(50)),
+					UnsafeMigrationOverride: pointer.P(false),
+					AllowPostCopy:           pointer.P(false),
+					AllowWorkloadDisruption: pointer.P(false),
+					Compression:             compression,
+				}
+
+				client.EXPECT().MigrateVirtualMachine(gomock.Any(), gomock.Any()).Do(func(_ *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) {
+					Expect(options.Compression).To(Equal(compression))
+				}).Times(1).Return(nil)
+
+				controller.Execute()
+				testutils.ExpectEvent(recorder, VMIMigrating)
+			})
 		})
 	})
 
@@ -0,0 +1,59 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+func (lh *LifecycleHandler) GetChangedBlocks(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+	defer client.Close()
+
+	options := &v1.ChangedBlocksOptions{
+		FromCheckpoint: request.QueryParameter("fromCheckpoint"),
+		ToCheckpoint:   request.QueryParameter("toCheckpoint"),
+		Disk:           request.QueryParameter("disk"),
+	}
+	if options.FromCheckpoint == "" {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("fromCheckpoint is required"))
+		return
+	}
+
+	log.Log.Object(vmi).Infof("Retrieving the blocks changed since checkpoint %s", options.FromCheckpoint)
+
+	changedBlocks, err := client.GetChangedBlocks(vmi, options)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get changed blocks")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(changedBlocks)
+}
@@ -47,6 +47,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
@@ -61,8 +62,11 @@ import (
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	netsetup "kubevirt.io/kubevirt/pkg/network/setup"
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/safepath"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -70,6 +74,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/util/migrations"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
+	backupproxy "kubevirt.io/kubevirt/pkg/virt-handler/backup-proxy"
 	virtcache "kubevirt.io/kubevirt/pkg/virt-handler/cache"
 	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
@@ -98,6 +103,7 @@ type downwardMetricsManager interface {
 
 type VirtualMachineController struct {
 	*BaseController
+	backupProxy              backupproxy.ProxyManager
 	capabilities             *libvirtxml.Caps
 	clientset                kubecli.KubevirtClient
 	containerDiskMounter     containerdisk.Mounter
@@ -134,6 +140,7 @@ func NewVirtualMachineController(
 	clusterConfig *virtconfig.ClusterConfig,
 	podIsolationDetector isolation.PodIsolationDetector,
 	migrationProxy migrationproxy.ProxyManager,
+	backupProxy backupproxy.ProxyManager,
 	downwardMetricsManager downwardMetricsManager,
 	capabilities *libvirtxml.Caps,
 	hostCpuModel string,
@@ -178,6 +185,7 @@ func NewVirtualMachineController(
 
 	c := &VirtualMachineController{
 		BaseController:           baseCtrl,
+		backupProxy:              backupProxy,
 		capabilities:             capabilities,
 		clientset:                clientset,
 		containerDiskMounter:     containerdisk.NewMounter(podIsolationDetector, containerDiskState, clusterConfig),
@@ -837,9 +845,8 @@ func (c *VirtualMachineController) updatePausedConditions(vmi *v1.VirtualMachine
 	}
 }
 
-func dumpTargetFile(vmiName, volName string) string {
-	targetFileName := fmt.Sprintf("%s-%s-%s.memory.dump", vmiName, volName, time.Now().Format("20060102-150405"))
-	return targetFileName
+func dumpTargetFile(vmiName, volName string, format v1.MemoryDumpFormat) string {
+	return memorydump.DumpFileName(vmiName, volName, format, time.Now())
 }
 
 func (c *VirtualMachineController) updateMemoryDumpInfo(vmi *v1.VirtualMachineInstance, volumeStatus v1.VolumeStatus, domain *api.Domain) (v1.VolumeStatus, bool) {
@@ -851,7 +858,11 @@ func (c *VirtualMachineController) updateMemoryDumpInfo(vmi *v1.VirtualMachineIn
 		volumeStatus.Phase = v1.MemoryDumpVolumeInProgress
 		volumeStatus.Message = fmt.Sprintf("Memory dump Volume %s is attached, getting memory dump", volumeStatus.Name)
 		volumeStatus.Reason = VolumeMountedToPodReason
-		volumeStatus.MemoryDumpVolume.TargetFileName = dumpTargetFile(vmi.Name, volumeStatus.Name)
+		if memorydump.IsMemoryStateVolume(vmi, volumeStatus.Name) {
+			volumeStatus.MemoryDumpVolume.TargetFileName = memorydump.MemoryStateFileName(vmi.Name, volumeStatus.Name)
+		} else {
+			volumeStatus.MemoryDumpVolume.TargetFileName = dumpTargetFile(vmi.Name, volumeStatus.Name, memorydump.VolumeDumpFormat(vmi, volumeStatus.Name))
+		}
 	case v1.MemoryDumpVolumeInProgress:
 		var memoryDumpMetadata *api.MemoryDumpMetadata
 		if domain != nil {
@@ -882,6 +893,81 @@ func (c *VirtualMachineController) updateMemoryDumpInfo(vmi *v1.VirtualMachineIn
 	return volumeStatus, needsRefresh
 }
 
+// crashMemoryDumpStartTimeout bounds the retries of the crash memory dump command
+const crashMemoryDumpStartTimeout = 5 * time.Minute
+
+// isCrashMemoryDumpPending returns true while the memory of a panicked domain
+// is not dumped yet to the pvc of the crash policy of the vmi. A dump which
+// does not start in time is given up, so the vmi fails like without a crash policy.
+func isCrashMemoryDumpPending(vmi *v1.VirtualMachineInstance, domain *api.Domain) bool {
+	if domain == nil || memorydump.CrashMemoryDump(vmi) == nil ||
+		domain.Status.Status != api.Crashed || domain.Status.Reason != api.ReasonPanicked {
+		return false
+	}
+	crashMemoryDump := vmi.Status.CrashMemoryDump
+	if crashMemoryDump == nil {
+		return true
+	}
+	memoryDumpMetadata := domain.Spec.Metadata.KubeVirt.MemoryDump
+	if memoryDumpMetadata == nil || memoryDumpMetadata.FileName != crashMemoryDump.TargetFileName {
+		return !isCrashMemoryDumpStartTimedOut(crashMemoryDump)
+	}
+	return !memoryDumpMetadata.Completed
+}
+
+func isCrashMemoryDumpStartTimedOut(crashMemoryDump *v1.DomainMemoryDumpInfo) bool {
+	return crashMemoryDump.StartTimestamp != nil &&
+		time.Since(crashMemoryDump.StartTimestamp.Time) > crashMemoryDumpStartTimeout
+}
+
+func (c *VirtualMachineController) updateCrashMemoryDump(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	crashMemoryDumpPolicy := memorydump.CrashMemoryDump(vmi)
+	if crashMemoryDumpPolicy == nil || domain == nil {
+		return
+	}
+
+	crashMemoryDump := vmi.Status.CrashMemoryDump
+	if crashMemoryDump == nil {
+		if domain.Status.Status != api.Crashed || domain.Status.Reason != api.ReasonPanicked {
+			return
+		}
+		c.logger.Object(vmi).Infof("Guest panicked, dumping its memory to pvc %s", crashMemoryDumpPolicy.ClaimName)
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, memorydump.CrashMemoryDumpStartedReason,
+			"Guest panicked, dumping its memory to pvc %s", crashMemoryDumpPolicy.ClaimName)
+		now := metav1.Now()
+		vmi.Status.CrashMemoryDump = &v1.DomainMemoryDumpInfo{
+			ClaimName:      crashMemoryDumpPolicy.ClaimName,
+			TargetFileName: memorydump.CrashMemoryDumpFileName(vmi.Name, crashMemoryDumpPolicy.Format, now.Time),
+			StartTimestamp: &now,
+		}
+		return
+	}
+
+	memoryDumpMetadata := domain.Spec.Metadata.KubeVirt.MemoryDump
+	if crashMemoryDump.EndTimestamp != nil {
+		return
+	}
+	if memoryDumpMetadata == nil || memoryDumpMetadata.FileName != crashMemoryDump.TargetFileName {
+		if isCrashMemoryDumpStartTimedOut(crashMemoryDump) {
+			c.logger.Object(vmi).Errorf("Crash memory dump to pvc %s did not start within %s", crashMemoryDump.ClaimName, crashMemoryDumpStartTimeout)
+			c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, memorydump.CrashMemoryDumpFailedReason,
+				"Crash memory dump to pvc %s did not start within %s", crashMemoryDump.ClaimName, crashMemoryDumpStartTimeout)
+		}
+		return
+	}
+	crashMemoryDump.StartTimestamp = memoryDumpMetadata.StartTimestamp
+	if !memoryDumpMetadata.Completed {
+		return
+	}
+	if memoryDumpMetadata.Failed {
+		c.logger.Object(vmi).Errorf("Crash memory dump to pvc %s failed: %v", crashMemoryDump.ClaimName, memoryDumpMetadata.FailureReason)
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, memorydump.CrashMemoryDumpFailedReason,
+			"Crash memory dump to pvc %s failed: %v", crashMemoryDump.ClaimName, memoryDumpMetadata.FailureReason)
+		return
+	}
+	crashMemoryDump.EndTimestamp = memoryDumpMetadata.EndTimestamp
+}
+
 func (c *VirtualMachineController) updateFSFreezeStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 
 	if domain == nil || domain.Status.FSFreezeStatus.Status == "" {
@@ -1024,6 +1110,7 @@ func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 	c.updateVolumeStatusesFromDomain(vmi, domain)
 	c.updateFSFreezeStatus(vmi, domain)
 	c.updateBackupStatus(vmi, domain)
+	c.updateCrashMemoryDump(vmi, domain)
 	c.updateMachineType(vmi, domain)
 	if err = c.updateMemoryInfo(vmi, domain); err != nil {
 		return err
@@ -1426,7 +1513,10 @@ func (c *VirtualMachineController) sync(key string,
 		shouldDelete = true
 	}
 
-	if !domainAlive && domainExists && !vmi.IsFinal() {
+	// a panicked domain is kept until its memory is dumped by the crash policy
+	crashMemoryDumpPending := vmiExists && isCrashMemoryDumpPending(vmi, domain)
+
+	if !domainAlive && domainExists && !vmi.IsFinal() && !crashMemoryDumpPending {
 		c.logger.Object(vmi).V(3).Info("Deleting inactive domain for vmi.")
 		shouldDelete = true
 	}
@@ -1472,6 +1562,9 @@ func (c *VirtualMachineController) sync(key string,
 	case shouldDelete:
 		c.logger.Object(vmi).V(3).Info("Processing deletion.")
 		syncErr = c.deleteVM(vmi)
+	case crashMemoryDumpPending:
+		c.logger.Object(vmi).V(3).Info("Processing crash memory dump.")
+		syncErr = c.processCrashMemoryDump(vmi)
 	case shouldUpdate:
 		c.logger.Object(vmi).V(3).Info("Processing vmi update")
 		syncErr = c.processVmUpdate(vmi, domain)
@@ -1511,6 +1604,7 @@ func (c *VirtualMachineController) processVmCleanup(vmi *v1.VirtualMachineInstan
 
 	c.migrationProxy.StopTargetListener(vmiId)
 	c.migrationProxy.StopSourceListener(vmiId)
+	c.backupProxy.StopExport(vmiId)
 
 	c.downwardMetricsManager.StopServer(vmi)
 
@@ -1747,6 +1841,16 @@ func (c *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 
 	filesystems := storagetypes.GetFilesystemsFromVolumes(vmi)
 
+	if crashMemoryDump := memorydump.CrashMemoryDump(vmi); crashMemoryDump != nil {
+		volumeStatus, ok := volumeStatusMap[memorydump.CrashMemoryDumpVolumeName]
+		if !ok || volumeStatus.PersistentVolumeClaimInfo == nil {
+			return true, fmt.Errorf("cannot migrate VMI: Unable to determine if the crash memory dump PVC %v is shared", crashMemoryDump.ClaimName)
+		} else if !storagetypes.HasSharedAccessMode(volumeStatus.PersistentVolumeClaimInfo.AccessModes) {
+			return true, fmt.Errorf("cannot migrate VMI: the crash memory dump PVC %v is not shared, "+
+				"live migration requires the ReadWriteMany access mode", crashMemoryDump.ClaimName)
+		}
+	}
+
 	// Check if all VMI volumes can be shared between the source and the destination
 	// of a live migration. blockMigrate will be returned as false, only if all volumes
 	// are shared and the VMI has no local disks
@@ -1785,6 +1889,15 @@ func (c *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachi
 			if !shared {
 				return true, fmt.Errorf("cannot migrate VMI with non-shared HostDisk")
 			}
+		} else if volSrc.ContainerDisk != nil && volSrc.ContainerDisk.PersistentOverlay != nil {
+			claimName := backendstorage.OverlayPVCName(vmi.Name, volume.Name)
+			volumeStatus, ok := volumeStatusMap[claimName]
+			if !ok || volumeStatus.PersistentVolumeClaimInfo == nil {
+				return true, fmt.Errorf("cannot migrate VMI: Unable to determine if the overlay PVC %v of container disk %v is shared", claimName, volume.Name)
+			} else if !storagetypes.HasSharedAccessMode(volumeStatus.PersistentVolumeClaimInfo.AccessModes) {
+				return true, fmt.Errorf("cannot migrate VMI: the overlay PVC %v of container disk %v is not shared, "+
+					"live migration requires the ReadWriteMany access mode", claimName, volume.Name)
+			}
 		} else {
 			if _, ok := filesystems[volume.Name]; ok {
 				c.logger.Object(vmi).Infof("Volume %s is shared with virtiofs, allow live migration", volume.Name)
@@ -2224,7 +2337,7 @@ func (c *VirtualMachineController) getMemoryDump(vmi *v1.VirtualMachineInstance)
 		}
 
 		c.logger.V(3).Object(vmi).Info("sending memory dump command")
-		err = client.VirtualMachineMemoryDump(vmi, memoryDumpPath(volumeStatus))
+		err = client.VirtualMachineMemoryDump(vmi, memoryDumpPath(volumeStatus), memorydump.VolumeDumpFormat(vmi, volumeStatus.Name))
 		if err != nil {
 			return fmt.Errorf("%s: %v", errMsgPrefix, err)
 		}
@@ -2233,6 +2346,30 @@ func (c *VirtualMachineController) getMemoryDump(vmi *v1.VirtualMachineInstance)
 	return nil
 }
 
+func (c *VirtualMachineController) processCrashMemoryDump(vmi *v1.VirtualMachineInstance) error {
+	const errMsgPrefix = "failed to dump the memory of the panicked guest"
+
+	if vmi.Status.CrashMemoryDump == nil {
+		// the target file is recorded on the vmi status first
+		return nil
+	}
+	client, err := c.launcherClients.GetVerifiedLauncherClient(vmi)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	c.logger.V(3).Object(vmi).Info("sending crash memory dump command")
+	dumpPath := filepath.Join(memorydump.CrashMemoryDumpDir, vmi.Status.CrashMemoryDump.TargetFileName)
+	var format v1.MemoryDumpFormat
+	if crashMemoryDumpPolicy := memorydump.CrashMemoryDump(vmi); crashMemoryDumpPolicy != nil {
+		format = crashMemoryDumpPolicy.Format
+	}
+	if err := client.VirtualMachineMemoryDump(vmi, dumpPath, format); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+	return nil
+}
+
 func (c *VirtualMachineController) hotplugVolumesReady(vmi *v1.VirtualMachineInstance) bool {
 	hasHotplugVolume := false
 	for _, v := range vmi.Spec.Volumes {
@@ -2327,6 +2464,9 @@ func (c *VirtualMachineController) calculateVmPhaseForStatusReason(domain *api.D
 		case api.Shutoff, api.Crashed:
 			switch domain.Status.Reason {
 			case api.ReasonCrashed, api.ReasonPanicked:
+				if isCrashMemoryDumpPending(vmi, domain) {
+					return vmi.Status.Phase, nil
+				}
 				return v1.Failed, nil
 			case api.ReasonDestroyed:
 				if isACPIEnabled(vmi, domain) {
@@ -2483,5 +2623,45 @@ func (c *VirtualMachineController) updateBackupStatus(vmi *v1.VirtualMachineInst
 	if backupMetadata.CheckpointName != "" {
 		vmi.Status.ChangedBlockTracking.BackupStatus.CheckpointName = &backupMetadata.CheckpointName
 	}
+	if backupMetadata.Mode == string(backupv1.PullMode) {
+		c.updateBackupExport(vmi, backupMetadata)
+	}
 	// TODO: Handle backup failure (backupMetadata.Failed) and abort status (backupMetadata.AbortStatus)
 }
+
+// updateBackupExport proxies the NBD server of a running pull mode backup and
+// reports where it can be reached
+func (c *VirtualMachineController) updateBackupExport(vmi *v1.VirtualMachineInstance, backupMetadata *api.BackupMetadata) {
+	backupStatus := vmi.Status.ChangedBlockTracking.BackupStatus
+	vmiUID := string(vmi.UID)
+	if backupMetadata.EndTimestamp != nil || backupMetadata.Exports == nil {
+		c.backupProxy.StopExport(vmiUID)
+		backupStatus.PullEndpoint = nil
+		backupStatus.Exports = nil
+		return
+	}
+
+	res, err := c.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		c.logger.Object(vmi).Reason(err).Error("failed to detect the virt-launcher of the backup export")
+		return
+	}
+	socketFile := fmt.Sprintf(filepath.Join(c.virtLauncherFSRunDirPattern, "kubevirt-private", vmiUID, cbt.BackupNBDSocketName), res.Pid())
+	if err := c.backupProxy.StartExport(vmiUID, backupMetadata.TokenHash, socketFile); err != nil {
+		c.logger.Object(vmi).Reason(err).Error("failed to proxy the backup export")
+		return
+	}
+
+	backupStatus.PullEndpoint = pointer.P(c.backupProxy.Endpoint())
+	backupStatus.Exports = nil
+	for _, export := range backupMetadata.Exports.Exports {
+		vmiExport := v1.VirtualMachineInstanceBackupExport{
+			VolumeName: export.VolumeName,
+			ExportName: export.ExportName,
+		}
+		if export.DirtyBitmap != "" {
+			vmiExport.DirtyBitmap = pointer.P(export.DirtyBitmap)
+		}
+		backupStatus.Exports = append(backupStatus.Exports, vmiExport)
+	}
+}
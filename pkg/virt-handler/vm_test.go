@@ -58,9 +58,11 @@ import (
 	neterrors "kubevirt.io/kubevirt/pkg/network/errors"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	backupproxy "kubevirt.io/kubevirt/pkg/virt-handler/backup-proxy"
 	virtcache "kubevirt.io/kubevirt/pkg/virt-handler/cache"
 	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
@@ -192,6 +194,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 			config,
 			mockIsolationDetector,
 			migrationProxy,
+			backupproxy.NewBackupProxyManager("127.0.0.1", 0, tlsConfig),
 			fakeDownwardMetricsManager,
 			nil, // capabilities
 			"",  // host cpu model
@@ -1104,6 +1107,112 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Status.Phase).To(Equal(v1.Failed))
 		})
 
+		Context("with a crash policy dumping the memory", func() {
+			newCrashPolicyVMI := func() *v1.VirtualMachineInstance {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.ObjectMeta.ResourceVersion = "1"
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Spec.CrashPolicy = &v1.CrashPolicy{
+					MemoryDump: &v1.CrashMemoryDump{ClaimName: "crash-pvc"},
+				}
+				return vmi
+			}
+
+			newPanickedDomain := func() *api.Domain {
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Crashed
+				domain.Status.Reason = api.ReasonPanicked
+				return domain
+			}
+
+			It("should keep the panicked domain and record the crash memory dump target", func() {
+				createVMI(newCrashPolicyVMI())
+				addDomain(newPanickedDomain())
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, memorydump.CrashMemoryDumpStartedReason)
+				updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), "testvmi", metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedVMI.Status.Phase).To(Equal(v1.Running))
+				Expect(updatedVMI.Status.CrashMemoryDump).ToNot(BeNil())
+				Expect(updatedVMI.Status.CrashMemoryDump.ClaimName).To(Equal("crash-pvc"))
+				Expect(updatedVMI.Status.CrashMemoryDump.StartTimestamp).ToNot(BeNil())
+				Expect(memorydump.IsCrashMemoryDumpFile("testvmi", updatedVMI.Status.CrashMemoryDump.TargetFileName)).To(BeTrue())
+			})
+
+			It("should dump the memory of the panicked domain into the crash memory dump pvc", func() {
+				vmi := newCrashPolicyVMI()
+				vmi.Status.CrashMemoryDump = &v1.DomainMemoryDumpInfo{
+					ClaimName:      "crash-pvc",
+					TargetFileName: "testvmi-crash-20250101-101010.memory.dump",
+				}
+				createVMI(vmi)
+				addDomain(newPanickedDomain())
+
+				client.EXPECT().VirtualMachineMemoryDump(gomock.Any(), filepath.Join(memorydump.CrashMemoryDumpDir, "testvmi-crash-20250101-101010.memory.dump"), gomock.Any())
+
+				sanityExecute()
+
+				updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), "testvmi", metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedVMI.Status.Phase).To(Equal(v1.Running))
+			})
+
+			It("should move VirtualMachineInstance to Failed once the crash memory dump completed", func() {
+				vmi := newCrashPolicyVMI()
+				vmi.Status.CrashMemoryDump = &v1.DomainMemoryDumpInfo{
+					ClaimName:      "crash-pvc",
+					TargetFileName: "testvmi-crash-20250101-101010.memory.dump",
+				}
+				createVMI(vmi)
+				domain := newPanickedDomain()
+				now := metav1.Now()
+				domain.Spec.Metadata.KubeVirt.MemoryDump = &api.MemoryDumpMetadata{
+					FileName:       "testvmi-crash-20250101-101010.memory.dump",
+					StartTimestamp: &now,
+					EndTimestamp:   &now,
+					Completed:      true,
+				}
+				addDomain(domain)
+
+				mockHotplugVolumeMounter.EXPECT().UnmountAll(gomock.Any(), mockCgroupManager).Return(nil)
+				client.EXPECT().DeleteDomain(gomock.Any())
+
+				sanityExecuteNoDomain()
+
+				testutils.ExpectEvent(recorder, VMISignalDeletion)
+				testutils.ExpectEvent(recorder, VMICrashed)
+				updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), "testvmi", metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedVMI.Status.Phase).To(Equal(v1.Failed))
+				Expect(updatedVMI.Status.CrashMemoryDump.EndTimestamp).ToNot(BeNil())
+			})
+
+			It("should move VirtualMachineInstance to Failed when the crash memory dump does not start in time", func() {
+				vmi := newCrashPolicyVMI()
+				vmi.Status.CrashMemoryDump = &v1.DomainMemoryDumpInfo{
+					ClaimName:      "crash-pvc",
+					TargetFileName: "testvmi-crash-20250101-101010.memory.dump",
+					StartTimestamp: pointer.P(metav1.NewTime(time.Now().Add(-crashMemoryDumpStartTimeout - time.Minute))),
+				}
+				createVMI(vmi)
+				addDomain(newPanickedDomain())
+
+				mockHotplugVolumeMounter.EXPECT().UnmountAll(gomock.Any(), mockCgroupManager).Return(nil)
+				client.EXPECT().DeleteDomain(gomock.Any())
+
+				sanityExecuteNoDomain()
+
+				testutils.ExpectEvents(recorder, memorydump.CrashMemoryDumpFailedReason, VMISignalDeletion, VMICrashed)
+				updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), "testvmi", metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedVMI.Status.Phase).To(Equal(v1.Failed))
+				Expect(updatedVMI.Status.CrashMemoryDump.EndTimestamp).To(BeNil())
+			})
+		})
+
 		It("should move VirtualMachineInstance to Failed if configuring the networks on the virt-launcher fails with critical error", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1637,19 +1746,56 @@ var _ = Describe("VirtualMachineInstance", func() {
 				addVMI(vmi, domain)
 
 				updatedVolumeStatus := *volumeStatus.DeepCopy()
-				updatedVolumeStatus.MemoryDumpVolume.TargetFileName = dumpTargetFile(vmi.Name, volumeStatus.Name)
+				updatedVolumeStatus.MemoryDumpVolume.TargetFileName = dumpTargetFile(vmi.Name, volumeStatus.Name, "")
 				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
 				hasHotplug := controller.updateVolumeStatusesFromDomain(vmi, domain)
 				Expect(hasHotplug).To(BeTrue())
 
 				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.MemoryDumpVolumeInProgress))
-				Expect(vmi.Status.VolumeStatus[0].MemoryDumpVolume.TargetFileName).To(Equal(dumpTargetFile(vmi.Name, volumeStatus.Name)))
+				Expect(vmi.Status.VolumeStatus[0].MemoryDumpVolume.TargetFileName).To(Equal(dumpTargetFile(vmi.Name, volumeStatus.Name, "")))
 				testutils.ExpectEvent(recorder, "Memory dump Volume test is attached, getting memory dump")
 				By("Calling it again with updated status, no new events are generated as long as memory dump not completed")
 				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil)
 				controller.updateVolumeStatusesFromDomain(vmi, domain)
 			})
 
+			It("Should save the memory state to a memory state utility volume once mounted", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Spec.UtilityVolumes = append(vmi.Spec.UtilityVolumes, v1.UtilityVolume{
+					Name: "test",
+					PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: "test",
+					},
+					Type: pointer.P(v1.MemoryState),
+				})
+				volumeStatus := v1.VolumeStatus{
+					Name:    "test",
+					Phase:   v1.HotplugVolumeMounted,
+					Reason:  "reason",
+					Message: "message",
+					HotplugVolume: &v1.HotplugVolumeStatus{
+						AttachPodName: "testpod",
+						AttachPodUID:  "1234",
+					},
+					MemoryDumpVolume: &v1.DomainMemoryDumpInfo{
+						ClaimName: "test",
+					},
+				}
+				vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, volumeStatus)
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+				addVMI(vmi, domain)
+
+				mockHotplugVolumeMounter.EXPECT().IsMounted(vmi, "test", gomock.Any()).Return(true, nil).AnyTimes()
+				controller.updateVolumeStatusesFromDomain(vmi, domain)
+
+				Expect(vmi.Status.VolumeStatus[0].Phase).To(Equal(v1.MemoryDumpVolumeInProgress))
+				Expect(vmi.Status.VolumeStatus[0].MemoryDumpVolume.TargetFileName).To(Equal(memorydump.MemoryStateFileName(vmi.Name, volumeStatus.Name)))
+				testutils.ExpectEvent(recorder, "Memory dump Volume test is attached, getting memory dump")
+			})
+
 			It("Should generate memory dump completed event once memory dump completed", func() {
 				vmi := api2.NewMinimalVMI("testvmi")
 				vmi.UID = vmiTestUUID
@@ -1668,14 +1814,14 @@ var _ = Describe("VirtualMachineInstance", func() {
 					},
 					MemoryDumpVolume: &v1.DomainMemoryDumpInfo{
 						ClaimName:      "test",
-						TargetFileName: dumpTargetFile(vmi.Name, "test"),
+						TargetFileName: dumpTargetFile(vmi.Name, "test", ""),
 					},
 				}
 				vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, volumeStatus)
 				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
 				now := metav1.Now()
 				domain.Spec.Metadata.KubeVirt.MemoryDump = &api.MemoryDumpMetadata{
-					FileName:       dumpTargetFile(vmi.Name, "test"),
+					FileName:       dumpTargetFile(vmi.Name, "test", ""),
 					StartTimestamp: &now,
 					EndTimestamp:   &now,
 					Completed:      true,
@@ -1714,7 +1860,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 					},
 					MemoryDumpVolume: &v1.DomainMemoryDumpInfo{
 						ClaimName:      "test",
-						TargetFileName: dumpTargetFile(vmi.Name, "test"),
+						TargetFileName: dumpTargetFile(vmi.Name, "test", ""),
 					},
 				}
 				vmi.Status.VolumeStatus = append(vmi.Status.VolumeStatus, volumeStatus)
@@ -1723,7 +1869,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 				now := metav1.Now()
 				failureReason := "memory dump failed"
 				domain.Spec.Metadata.KubeVirt.MemoryDump = &api.MemoryDumpMetadata{
-					FileName:       dumpTargetFile(vmi.Name, "test"),
+					FileName:       dumpTargetFile(vmi.Name, "test", ""),
 					StartTimestamp: &now,
 					EndTimestamp:   &now,
 					Failed:         true,
@@ -2156,6 +2302,60 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(blockMigrate).To(BeTrue())
 			Expect(err).To(Equal(fmt.Errorf("cannot migrate VMI with non-shared HostDisk")))
 		})
+		DescribeTable("with a persistent container disk overlay", func(accessMode k8sv1.PersistentVolumeAccessMode, expectErr bool) {
+			vmi := libvmi.New(
+				libvmi.WithName("testvmi"),
+				libvmi.WithContainerDiskPersistentOverlay("myvolume", "image", resource.MustParse("1Gi")),
+			)
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name: "containerdisk-overlay-for-testvmi-myvolume",
+					PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+						ClaimName:   "containerdisk-overlay-for-testvmi-myvolume",
+						AccessModes: []k8sv1.PersistentVolumeAccessMode{accessMode},
+					},
+				},
+			}
+
+			blockMigrate, err := controller.checkVolumesForMigration(vmi)
+			if expectErr {
+				Expect(err).To(MatchError(ContainSubstring("is not shared")))
+				Expect(blockMigrate).To(BeTrue())
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(blockMigrate).To(BeFalse())
+			}
+		},
+			Entry("should be allowed to live-migrate when the overlay PVC is shared", k8sv1.ReadWriteMany, false),
+			Entry("should not be allowed to live-migrate when the overlay PVC is not shared", k8sv1.ReadWriteOnce, true),
+		)
+		DescribeTable("with a crash policy", func(accessMode k8sv1.PersistentVolumeAccessMode, expectErr bool) {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.CrashPolicy = &v1.CrashPolicy{
+				MemoryDump: &v1.CrashMemoryDump{ClaimName: "crash-pvc"},
+			}
+			vmi.Status.VolumeStatus = []v1.VolumeStatus{
+				{
+					Name: memorydump.CrashMemoryDumpVolumeName,
+					PersistentVolumeClaimInfo: &v1.PersistentVolumeClaimInfo{
+						ClaimName:   "crash-pvc",
+						AccessModes: []k8sv1.PersistentVolumeAccessMode{accessMode},
+					},
+				},
+			}
+
+			blockMigrate, err := controller.checkVolumesForMigration(vmi)
+			if expectErr {
+				Expect(err).To(MatchError(ContainSubstring("crash memory dump PVC crash-pvc is not shared")))
+				Expect(blockMigrate).To(BeTrue())
+			} else {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(blockMigrate).To(BeFalse())
+			}
+		},
+			Entry("should be allowed to live-migrate when the crash memory dump PVC is shared", k8sv1.ReadWriteMany, false),
+			Entry("should not be allowed to live-migrate when the crash memory dump PVC is not shared", k8sv1.ReadWriteOnce, true),
+		)
 		DescribeTable("with host model", func(hostCpuModel string) {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeHostModel}
@@ -37,7 +37,6 @@ func (e ExecExitCode) Error() string {
 // GuestExec sends the provided command and args to the guest agent for execution and returns an error on an unsucessful exit code
 // The resulting stdout will be returned as a string
 func GuestExec(virConn cli.Connection, domName string, command string, args []string, timeoutSeconds int32) (string, error) {
-	stdOut := ""
 	argsStr := ""
 	for _, arg := range args {
 		if argsStr == "" {
@@ -48,6 +47,43 @@ func GuestExec(virConn cli.Connection, domName string, command string, args []st
 	}
 
 	cmdExec := fmt.Sprintf(`{"execute": "guest-exec", "arguments": { "path": "%s", "arg": [ %s ], "capture-output":true } }`, command, argsStr)
+	return guestExec(virConn, domName, command, cmdExec, timeoutSeconds)
+}
+
+type execCommand struct {
+	Execute   string        `json:"execute"`
+	Arguments execArguments `json:"arguments"`
+}
+
+type execArguments struct {
+	Path          string   `json:"path"`
+	Arg           []string `json:"arg"`
+	InputData     string   `json:"input-data,omitempty"`
+	CaptureOutput bool     `json:"capture-output"`
+}
+
+// GuestExecWithInput behaves like GuestExec and additionally passes input to the standard input of the command
+func GuestExecWithInput(virConn cli.Connection, domName string, command string, args []string, input []byte, timeoutSeconds int32) (string, error) {
+	if args == nil {
+		args = []string{}
+	}
+	cmdExec, err := json.Marshal(execCommand{
+		Execute: "guest-exec",
+		Arguments: execArguments{
+			Path:          command,
+			Arg:           args,
+			InputData:     base64.StdEncoding.EncodeToString(input),
+			CaptureOutput: true,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return guestExec(virConn, domName, command, string(cmdExec), timeoutSeconds)
+}
+
+func guestExec(virConn cli.Connection, domName string, command string, cmdExec string, timeoutSeconds int32) (string, error) {
+	stdOut := ""
 	output, err := virConn.QemuAgentCommand(cmdExec, domName)
 	if err != nil {
 		return "", err
@@ -190,6 +190,11 @@ func (in *BackupDisk) DeepCopyInto(out *BackupDisk) {
 		*out = new(BackupTarget)
 		**out = **in
 	}
+	if in.Scratch != nil {
+		in, out := &in.Scratch, &out.Scratch
+		*out = new(BackupScratch)
+		**out = **in
+	}
 	return
 }
 
@@ -226,6 +231,43 @@ func (in *BackupDisks) DeepCopy() *BackupDisks {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupExportMetadata) DeepCopyInto(out *BackupExportMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupExportMetadata.
+func (in *BackupExportMetadata) DeepCopy() *BackupExportMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupExportMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupExportsMetadata) DeepCopyInto(out *BackupExportsMetadata) {
+	*out = *in
+	if in.Exports != nil {
+		in, out := &in.Exports, &out.Exports
+		*out = make([]BackupExportMetadata, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupExportsMetadata.
+func (in *BackupExportsMetadata) DeepCopy() *BackupExportsMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupExportsMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupMetadata) DeepCopyInto(out *BackupMetadata) {
 	*out = *in
@@ -237,6 +279,11 @@ func (in *BackupMetadata) DeepCopyInto(out *BackupMetadata) {
 		in, out := &in.EndTimestamp, &out.EndTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Exports != nil {
+		in, out := &in.Exports, &out.Exports
+		*out = new(BackupExportsMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -250,6 +297,38 @@ func (in *BackupMetadata) DeepCopy() *BackupMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupScratch) DeepCopyInto(out *BackupScratch) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupScratch.
+func (in *BackupScratch) DeepCopy() *BackupScratch {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupScratch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupServer) DeepCopyInto(out *BackupServer) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupServer.
+func (in *BackupServer) DeepCopy() *BackupServer {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupTarget) DeepCopyInto(out *BackupTarget) {
 	*out = *in
@@ -1070,6 +1149,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(Shareable)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(IOTune)
+		**out = **in
+	}
 	return
 }
 
@@ -1135,6 +1219,27 @@ func (in *DiskDriver) DeepCopy() *DiskDriver {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskEncryption) DeepCopyInto(out *DiskEncryption) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(DiskSecret)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryption.
+func (in *DiskEncryption) DeepCopy() *DiskEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskIOThread) DeepCopyInto(out *DiskIOThread) {
 	*out = *in
@@ -1211,6 +1316,11 @@ func (in *DiskSource) DeepCopyInto(out *DiskSource) {
 		*out = new(DataStore)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(DiskEncryption)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1293,6 +1403,11 @@ func (in *DomainBackup) DeepCopyInto(out *DomainBackup) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		*out = new(BackupServer)
+		**out = **in
+	}
 	if in.BackupDisks != nil {
 		in, out := &in.BackupDisks, &out.BackupDisks
 		*out = new(BackupDisks)
@@ -1409,6 +1524,70 @@ func (in *DomainList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSnapshot) DeepCopyInto(out *DomainSnapshot) {
+	*out = *in
+	out.XMLName = in.XMLName
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(SnapshotMemory)
+		**out = **in
+	}
+	if in.SnapshotDisks != nil {
+		in, out := &in.SnapshotDisks, &out.SnapshotDisks
+		*out = new(DomainSnapshotDisks)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSnapshot.
+func (in *DomainSnapshot) DeepCopy() *DomainSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSnapshotDisk) DeepCopyInto(out *DomainSnapshotDisk) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSnapshotDisk.
+func (in *DomainSnapshotDisk) DeepCopy() *DomainSnapshotDisk {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshotDisk)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainSnapshotDisks) DeepCopyInto(out *DomainSnapshotDisks) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]DomainSnapshotDisk, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSnapshotDisks.
+func (in *DomainSnapshotDisks) DeepCopy() *DomainSnapshotDisks {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainSnapshotDisks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	*out = *in
@@ -2225,6 +2404,22 @@ func (in *IOThreads) DeepCopy() *IOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IOTune) DeepCopyInto(out *IOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IOTune.
+func (in *IOTune) DeepCopy() *IOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(IOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Input) DeepCopyInto(out *Input) {
 	*out = *in
@@ -2908,6 +3103,11 @@ func (in *MigrationMetadata) DeepCopyInto(out *MigrationMetadata) {
 		in, out := &in.EndTimestamp, &out.EndTimestamp
 		*out = (*in).DeepCopy()
 	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = new(MigrationVolumesMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2921,6 +3121,43 @@ func (in *MigrationMetadata) DeepCopy() *MigrationMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationVolumeMetadata) DeepCopyInto(out *MigrationVolumeMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationVolumeMetadata.
+func (in *MigrationVolumeMetadata) DeepCopy() *MigrationVolumeMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationVolumeMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationVolumesMetadata) DeepCopyInto(out *MigrationVolumesMetadata) {
+	*out = *in
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]MigrationVolumeMetadata, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationVolumesMetadata.
+func (in *MigrationVolumesMetadata) DeepCopy() *MigrationVolumesMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationVolumesMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Model) DeepCopyInto(out *Model) {
 	*out = *in
@@ -3555,6 +3792,22 @@ func (in *SliceType) DeepCopy() *SliceType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotMemory) DeepCopyInto(out *SnapshotMemory) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotMemory.
+func (in *SnapshotMemory) DeepCopy() *SnapshotMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SoundCard) DeepCopyInto(out *SoundCard) {
 	*out = *in
@@ -208,6 +208,7 @@ type DomainSpec struct {
 	SysInfo        *SysInfo        `xml:"sysinfo,omitempty"`
 	Devices        Devices         `xml:"devices"`
 	Clock          *Clock          `xml:"clock,omitempty"`
+	OnCrash        string          `xml:"on_crash,omitempty"`
 	Resource       *Resource       `xml:"resource,omitempty"`
 	QEMUCmd        *Commandline    `xml:"qemu:commandline,omitempty"`
 	Metadata       Metadata        `xml:"metadata,omitempty"`
@@ -408,23 +409,49 @@ type MemoryDumpMetadata struct {
 }
 
 type MigrationMetadata struct {
-	UID            types.UID        `xml:"uid,omitempty"`
-	StartTimestamp *metav1.Time     `xml:"startTimestamp,omitempty"`
-	EndTimestamp   *metav1.Time     `xml:"endTimestamp,omitempty"`
-	Failed         bool             `xml:"failed,omitempty"`
-	FailureReason  string           `xml:"failureReason,omitempty"`
-	AbortStatus    string           `xml:"abortStatus,omitempty"`
-	Mode           v1.MigrationMode `xml:"mode,omitempty"`
+	UID            types.UID                 `xml:"uid,omitempty"`
+	StartTimestamp *metav1.Time              `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time              `xml:"endTimestamp,omitempty"`
+	Failed         bool                      `xml:"failed,omitempty"`
+	FailureReason  string                    `xml:"failureReason,omitempty"`
+	AbortStatus    string                    `xml:"abortStatus,omitempty"`
+	Mode           v1.MigrationMode          `xml:"mode,omitempty"`
+	Volumes        *MigrationVolumesMetadata `xml:"volumes,omitempty"`
+}
+
+type MigrationVolumesMetadata struct {
+	Volumes []MigrationVolumeMetadata `xml:"volume"`
+}
+
+type MigrationVolumeMetadata struct {
+	Name           string `xml:"name"`
+	BytesTotal     uint64 `xml:"bytesTotal,omitempty"`
+	BytesCopied    uint64 `xml:"bytesCopied,omitempty"`
+	BytesPerSecond uint64 `xml:"bytesPerSecond,omitempty"`
+	CutoverPending bool   `xml:"cutoverPending,omitempty"`
 }
 
 type BackupMetadata struct {
-	Name           string       `xml:"name,omitempty"`
-	SkipQuiesce    bool         `xml:"skipQuiesce,omitempty"`
-	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
-	EndTimestamp   *metav1.Time `xml:"endTimestamp,omitempty"`
-	Completed      bool         `xml:"completed,omitempty"`
-	BackupMsg      string       `xml:"backupMsg,omitempty"`
-	CheckpointName string       `xml:"checkpointName,omitempty"`
+	Name           string                 `xml:"name,omitempty"`
+	SkipQuiesce    bool                   `xml:"skipQuiesce,omitempty"`
+	StartTimestamp *metav1.Time           `xml:"startTimestamp,omitempty"`
+	EndTimestamp   *metav1.Time           `xml:"endTimestamp,omitempty"`
+	Completed      bool                   `xml:"completed,omitempty"`
+	BackupMsg      string                 `xml:"backupMsg,omitempty"`
+	CheckpointName string                 `xml:"checkpointName,omitempty"`
+	Mode           string                 `xml:"mode,omitempty"`
+	TokenHash      string                 `xml:"tokenHash,omitempty"`
+	Exports        *BackupExportsMetadata `xml:"exports,omitempty"`
+}
+
+type BackupExportsMetadata struct {
+	Exports []BackupExportMetadata `xml:"export"`
+}
+
+type BackupExportMetadata struct {
+	VolumeName  string `xml:"volumeName"`
+	ExportName  string `xml:"exportName"`
+	DirtyBitmap string `xml:"dirtyBitmap,omitempty"`
 }
 
 type GracePeriodMetadata struct {
@@ -435,10 +462,16 @@ type GracePeriodMetadata struct {
 
 // DomainBackup mirroring libvirt XML under https://libvirt.org/formatbackup.html#backup-xml-format
 type DomainBackup struct {
-	XMLName     xml.Name     `xml:"domainbackup"`
-	Mode        string       `xml:"mode,attr"`
-	Incremental *string      `xml:"incremental,omitempty"`
-	BackupDisks *BackupDisks `xml:"disks"`
+	XMLName     xml.Name      `xml:"domainbackup"`
+	Mode        string        `xml:"mode,attr"`
+	Incremental *string       `xml:"incremental,omitempty"`
+	Server      *BackupServer `xml:"server,omitempty"`
+	BackupDisks *BackupDisks  `xml:"disks"`
+}
+
+type BackupServer struct {
+	Transport string `xml:"transport,attr"`
+	Socket    string `xml:"socket,attr,omitempty"`
 }
 
 type BackupDisks struct {
@@ -446,10 +479,17 @@ type BackupDisks struct {
 }
 
 type BackupDisk struct {
-	Name   string        `xml:"name,attr"`
-	Backup string        `xml:"backup,attr"`
-	Type   string        `xml:"type,attr,omitempty"`
-	Target *BackupTarget `xml:"target,omitempty"`
+	Name         string         `xml:"name,attr"`
+	Backup       string         `xml:"backup,attr"`
+	Type         string         `xml:"type,attr,omitempty"`
+	ExportName   string         `xml:"exportname,attr,omitempty"`
+	ExportBitmap string         `xml:"exportbitmap,attr,omitempty"`
+	Target       *BackupTarget  `xml:"target,omitempty"`
+	Scratch      *BackupScratch `xml:"scratch,omitempty"`
+}
+
+type BackupScratch struct {
+	File string `xml:"file,attr,omitempty"`
 }
 
 type BackupTarget struct {
@@ -478,6 +518,28 @@ type CheckpointParent struct {
 	Name string `xml:"name"`
 }
 
+// DomainSnapshot is used to save the memory and device state of a domain
+// without snapshotting its disks
+type DomainSnapshot struct {
+	XMLName       xml.Name             `xml:"domainsnapshot"`
+	Memory        *SnapshotMemory      `xml:"memory"`
+	SnapshotDisks *DomainSnapshotDisks `xml:"disks"`
+}
+
+type SnapshotMemory struct {
+	Snapshot string `xml:"snapshot,attr"`
+	File     string `xml:"file,attr,omitempty"`
+}
+
+type DomainSnapshotDisks struct {
+	Disks []DomainSnapshotDisk `xml:"disk"`
+}
+
+type DomainSnapshotDisk struct {
+	Name     string `xml:"name,attr"`
+	Snapshot string `xml:"snapshot,attr"`
+}
+
 type Commandline struct {
 	QEMUEnv []Env `xml:"qemu:env,omitempty"`
 	QEMUArg []Arg `xml:"qemu:arg,omitempty"`
@@ -745,6 +807,7 @@ type Disk struct {
 	Capacity           *int64        `xml:"capacity,omitempty"`
 	ExpandDisksEnabled bool          `xml:"expandDisksEnabled,omitempty"`
 	Shareable          *Shareable    `xml:"shareable,omitempty"`
+	IOTune             *IOTune       `xml:"iotune,omitempty"`
 }
 
 type DiskAuth struct {
@@ -770,6 +833,13 @@ type DiskSource struct {
 	Reservations  *Reservations   `xml:"reservations,omitempty"`
 	Slices        []Slice         `xml:"slices,omitempty"`
 	DataStore     *DataStore      `xml:"dataStore,omitempty"`
+	Encryption    *DiskEncryption `xml:"encryption,omitempty"`
+}
+
+type DiskEncryption struct {
+	Format string      `xml:"format,attr"`
+	Engine string      `xml:"engine,attr,omitempty"`
+	Secret *DiskSecret `xml:"secret,omitempty"`
 }
 
 type DiskTarget struct {
@@ -830,6 +900,28 @@ type BlockIO struct {
 	DiscardGranularity *uint `xml:"discard_granularity,attr,omitempty"`
 }
 
+type IOTune struct {
+	TotalBytesSec          uint64 `xml:"total_bytes_sec,omitempty"`
+	ReadBytesSec           uint64 `xml:"read_bytes_sec,omitempty"`
+	WriteBytesSec          uint64 `xml:"write_bytes_sec,omitempty"`
+	TotalIopsSec           uint64 `xml:"total_iops_sec,omitempty"`
+	ReadIopsSec            uint64 `xml:"read_iops_sec,omitempty"`
+	WriteIopsSec           uint64 `xml:"write_iops_sec,omitempty"`
+	TotalBytesSecMax       uint64 `xml:"total_bytes_sec_max,omitempty"`
+	ReadBytesSecMax        uint64 `xml:"read_bytes_sec_max,omitempty"`
+	WriteBytesSecMax       uint64 `xml:"write_bytes_sec_max,omitempty"`
+	TotalIopsSecMax        uint64 `xml:"total_iops_sec_max,omitempty"`
+	ReadIopsSecMax         uint64 `xml:"read_iops_sec_max,omitempty"`
+	WriteIopsSecMax        uint64 `xml:"write_iops_sec_max,omitempty"`
+	TotalBytesSecMaxLength uint64 `xml:"total_bytes_sec_max_length,omitempty"`
+	ReadBytesSecMaxLength  uint64 `xml:"read_bytes_sec_max_length,omitempty"`
+	WriteBytesSecMaxLength uint64 `xml:"write_bytes_sec_max_length,omitempty"`
+	TotalIopsSecMaxLength  uint64 `xml:"total_iops_sec_max_length,omitempty"`
+	ReadIopsSecMaxLength   uint64 `xml:"read_iops_sec_max_length,omitempty"`
+	WriteIopsSecMaxLength  uint64 `xml:"write_iops_sec_max_length,omitempty"`
+	GroupName              string `xml:"group_name,omitempty"`
+}
+
 type Reservations struct {
 	Managed            string              `xml:"managed,attr,omitempty"`
 	SourceReservations *SourceReservations `xml:"source,omitempty"`
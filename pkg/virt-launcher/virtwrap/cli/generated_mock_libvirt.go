@@ -172,6 +172,20 @@ func (mr *MockConnectionMockRecorder) DomainEventMemoryDeviceSizeChangeRegister(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainEventMemoryDeviceSizeChangeRegister", reflect.TypeOf((*MockConnection)(nil).DomainEventMemoryDeviceSizeChangeRegister), callback)
 }
 
+// DomainRestoreFlags mocks base method.
+func (m *MockConnection) DomainRestoreFlags(srcFile, xml string, flags libvirt.DomainSaveRestoreFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DomainRestoreFlags", srcFile, xml, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DomainRestoreFlags indicates an expected call of DomainRestoreFlags.
+func (mr *MockConnectionMockRecorder) DomainRestoreFlags(srcFile, xml, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DomainRestoreFlags", reflect.TypeOf((*MockConnection)(nil).DomainRestoreFlags), srcFile, xml, flags)
+}
+
 // GetAllDomainStats mocks base method.
 func (m *MockConnection) GetAllDomainStats(statsTypes libvirt.DomainStatsTypes, flags libvirt.ConnectGetAllDomainStatsFlags) ([]libvirt.DomainStats, error) {
 	m.ctrl.T.Helper()
@@ -307,6 +321,21 @@ func (mr *MockConnectionMockRecorder) QemuAgentCommand(command, domainName any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QemuAgentCommand", reflect.TypeOf((*MockConnection)(nil).QemuAgentCommand), command, domainName)
 }
 
+// SecretDefineXML mocks base method.
+func (m *MockConnection) SecretDefineXML(xml string) (VirSecret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretDefineXML", xml)
+	ret0, _ := ret[0].(VirSecret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretDefineXML indicates an expected call of SecretDefineXML.
+func (mr *MockConnectionMockRecorder) SecretDefineXML(xml any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretDefineXML", reflect.TypeOf((*MockConnection)(nil).SecretDefineXML), xml)
+}
+
 // SetReconnectChan mocks base method.
 func (m *MockConnection) SetReconnectChan(reconnect chan bool) {
 	m.ctrl.T.Helper()
@@ -496,6 +525,20 @@ func (mr *MockVirDomainMockRecorder) BackupBegin(backupXML, checkpointXML, flags
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BackupBegin", reflect.TypeOf((*MockVirDomain)(nil).BackupBegin), backupXML, checkpointXML, flags)
 }
 
+// BlockJobSetSpeed mocks base method.
+func (m *MockVirDomain) BlockJobSetSpeed(disk string, bandwidth uint64, flags libvirt.DomainBlockJobSetSpeedFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockJobSetSpeed", disk, bandwidth, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockJobSetSpeed indicates an expected call of BlockJobSetSpeed.
+func (mr *MockVirDomainMockRecorder) BlockJobSetSpeed(disk, bandwidth, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockJobSetSpeed", reflect.TypeOf((*MockVirDomain)(nil).BlockJobSetSpeed), disk, bandwidth, flags)
+}
+
 // BlockResize mocks base method.
 func (m *MockVirDomain) BlockResize(disk string, size uint64, flags libvirt.DomainBlockResizeFlags) error {
 	m.ctrl.T.Helper()
@@ -510,6 +553,21 @@ func (mr *MockVirDomainMockRecorder) BlockResize(disk, size, flags any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockResize", reflect.TypeOf((*MockVirDomain)(nil).BlockResize), disk, size, flags)
 }
 
+// CheckpointLookupByName mocks base method.
+func (m *MockVirDomain) CheckpointLookupByName(name string, flags uint32) (*libvirt.DomainCheckpoint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckpointLookupByName", name, flags)
+	ret0, _ := ret[0].(*libvirt.DomainCheckpoint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckpointLookupByName indicates an expected call of CheckpointLookupByName.
+func (mr *MockVirDomainMockRecorder) CheckpointLookupByName(name, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckpointLookupByName", reflect.TypeOf((*MockVirDomain)(nil).CheckpointLookupByName), name, flags)
+}
+
 // CoreDumpWithFormat mocks base method.
 func (m *MockVirDomain) CoreDumpWithFormat(to string, format libvirt.DomainCoreDumpFormat, flags libvirt.DomainCoreDumpFlags) error {
 	m.ctrl.T.Helper()
@@ -524,6 +582,21 @@ func (mr *MockVirDomainMockRecorder) CoreDumpWithFormat(to, format, flags any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CoreDumpWithFormat", reflect.TypeOf((*MockVirDomain)(nil).CoreDumpWithFormat), to, format, flags)
 }
 
+// CreateSnapshotXML mocks base method.
+func (m *MockVirDomain) CreateSnapshotXML(xml string, flags libvirt.DomainSnapshotCreateFlags) (*libvirt.DomainSnapshot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshotXML", xml, flags)
+	ret0, _ := ret[0].(*libvirt.DomainSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshotXML indicates an expected call of CreateSnapshotXML.
+func (mr *MockVirDomainMockRecorder) CreateSnapshotXML(xml, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotXML", reflect.TypeOf((*MockVirDomain)(nil).CreateSnapshotXML), xml, flags)
+}
+
 // CreateWithFlags mocks base method.
 func (m *MockVirDomain) CreateWithFlags(flags libvirt.DomainCreateFlags) error {
 	m.ctrl.T.Helper()
@@ -623,6 +696,21 @@ func (mr *MockVirDomainMockRecorder) GetBlockInfo(disk, flags any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockInfo", reflect.TypeOf((*MockVirDomain)(nil).GetBlockInfo), disk, flags)
 }
 
+// GetBlockJobInfo mocks base method.
+func (m *MockVirDomain) GetBlockJobInfo(disk string, flags libvirt.DomainBlockJobInfoFlags) (*libvirt.DomainBlockJobInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockJobInfo", disk, flags)
+	ret0, _ := ret[0].(*libvirt.DomainBlockJobInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockJobInfo indicates an expected call of GetBlockJobInfo.
+func (mr *MockVirDomainMockRecorder) GetBlockJobInfo(disk, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockJobInfo", reflect.TypeOf((*MockVirDomain)(nil).GetBlockJobInfo), disk, flags)
+}
+
 // GetDiskErrors mocks base method.
 func (m *MockVirDomain) GetDiskErrors(flags uint32) ([]libvirt.DomainDiskError, error) {
 	m.ctrl.T.Helper()
@@ -774,6 +862,34 @@ func (mr *MockVirDomainMockRecorder) MemoryStats(nrStats, flags any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemoryStats", reflect.TypeOf((*MockVirDomain)(nil).MemoryStats), nrStats, flags)
 }
 
+// MigrateSetMaxDowntime mocks base method.
+func (m *MockVirDomain) MigrateSetMaxDowntime(downtime uint64, flags uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSetMaxDowntime", downtime, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateSetMaxDowntime indicates an expected call of MigrateSetMaxDowntime.
+func (mr *MockVirDomainMockRecorder) MigrateSetMaxDowntime(downtime, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSetMaxDowntime", reflect.TypeOf((*MockVirDomain)(nil).MigrateSetMaxDowntime), downtime, flags)
+}
+
+// MigrateSetMaxSpeed mocks base method.
+func (m *MockVirDomain) MigrateSetMaxSpeed(speed uint64, flags libvirt.DomainMigrateMaxSpeedFlags) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSetMaxSpeed", speed, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MigrateSetMaxSpeed indicates an expected call of MigrateSetMaxSpeed.
+func (mr *MockVirDomainMockRecorder) MigrateSetMaxSpeed(speed, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSetMaxSpeed", reflect.TypeOf((*MockVirDomain)(nil).MigrateSetMaxSpeed), speed, flags)
+}
+
 // MigrateStartPostCopy mocks base method.
 func (m *MockVirDomain) MigrateStartPostCopy(flags uint32) error {
 	m.ctrl.T.Helper()
@@ -830,6 +946,21 @@ func (mr *MockVirDomainMockRecorder) PinVcpuFlags(vcpu, cpuMap, flags any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinVcpuFlags", reflect.TypeOf((*MockVirDomain)(nil).PinVcpuFlags), vcpu, cpuMap, flags)
 }
 
+// QemuMonitorCommand mocks base method.
+func (m *MockVirDomain) QemuMonitorCommand(command string, flags libvirt.DomainQemuMonitorCommandFlags) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QemuMonitorCommand", command, flags)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QemuMonitorCommand indicates an expected call of QemuMonitorCommand.
+func (mr *MockVirDomainMockRecorder) QemuMonitorCommand(command, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QemuMonitorCommand", reflect.TypeOf((*MockVirDomain)(nil).QemuMonitorCommand), command, flags)
+}
+
 // Reboot mocks base method.
 func (m *MockVirDomain) Reboot(flags libvirt.DomainRebootFlagValues) error {
 	m.ctrl.T.Helper()
@@ -887,6 +1018,20 @@ func (mr *MockVirDomainMockRecorder) Screenshot(stream, screen, flags any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Screenshot", reflect.TypeOf((*MockVirDomain)(nil).Screenshot), stream, screen, flags)
 }
 
+// SetBlockIoTune mocks base method.
+func (m *MockVirDomain) SetBlockIoTune(disk string, params *libvirt.DomainBlockIoTuneParameters, flags libvirt.DomainModificationImpact) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBlockIoTune", disk, params, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBlockIoTune indicates an expected call of SetBlockIoTune.
+func (mr *MockVirDomainMockRecorder) SetBlockIoTune(disk, params, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBlockIoTune", reflect.TypeOf((*MockVirDomain)(nil).SetBlockIoTune), disk, params, flags)
+}
+
 // SetLaunchSecurityState mocks base method.
 func (m *MockVirDomain) SetLaunchSecurityState(params *libvirt.DomainLaunchSecurityStateParameters, flags uint32) error {
 	m.ctrl.T.Helper()
@@ -998,3 +1143,55 @@ func (mr *MockVirDomainMockRecorder) UpdateDeviceFlags(xml, flags any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeviceFlags", reflect.TypeOf((*MockVirDomain)(nil).UpdateDeviceFlags), xml, flags)
 }
+
+// MockVirSecret is a mock of VirSecret interface.
+type MockVirSecret struct {
+	ctrl     *gomock.Controller
+	recorder *MockVirSecretMockRecorder
+	isgomock struct{}
+}
+
+// MockVirSecretMockRecorder is the mock recorder for MockVirSecret.
+type MockVirSecretMockRecorder struct {
+	mock *MockVirSecret
+}
+
+// NewMockVirSecret creates a new mock instance.
+func NewMockVirSecret(ctrl *gomock.Controller) *MockVirSecret {
+	mock := &MockVirSecret{ctrl: ctrl}
+	mock.recorder = &MockVirSecretMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVirSecret) EXPECT() *MockVirSecretMockRecorder {
+	return m.recorder
+}
+
+// Free mocks base method.
+func (m *MockVirSecret) Free() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Free")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Free indicates an expected call of Free.
+func (mr *MockVirSecretMockRecorder) Free() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Free", reflect.TypeOf((*MockVirSecret)(nil).Free))
+}
+
+// SetValue mocks base method.
+func (m *MockVirSecret) SetValue(value []byte, flags uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetValue", value, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetValue indicates an expected call of SetValue.
+func (mr *MockVirSecretMockRecorder) SetValue(value, flags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetValue", reflect.TypeOf((*MockVirSecret)(nil).SetValue), value, flags)
+}
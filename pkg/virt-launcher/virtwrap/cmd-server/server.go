@@ -251,7 +251,7 @@ func (l *Launcher) VirtualMachineMemoryDump(_ context.Context, request *cmdv1.Me
 		return response, nil
 	}
 
-	if err := l.domainManager.MemoryDump(vmi, request.DumpPath); err != nil {
+	if err := l.domainManager.MemoryDump(vmi, request.DumpPath, v1.MemoryDumpFormat(request.Format)); err != nil {
 		log.Log.Object(vmi).Reason(err).Errorf("Failed to Dump vmi memory")
 		response.Success = false
 		response.Message = getErrorMessage(err)
@@ -822,11 +822,21 @@ func getBackupOptionsFromRequest(request *cmdv1.BackupRequest) (*backupv1.Backup
 		return nil, fmt.Errorf("no valid backup options object present in command server request: %v", err)
 	}
 
-	if options.Mode != backupv1.PushMode {
-		return nil, fmt.Errorf("currently only backup in push mode is supported")
+	if options.Cmd == backupv1.Stop {
+		return options, nil
 	}
-	if options.PushPath == nil {
-		return nil, fmt.Errorf("backup with push mode - pushPath wasn't provided")
+
+	switch options.Mode {
+	case backupv1.PushMode:
+		if options.PushPath == nil {
+			return nil, fmt.Errorf("backup with push mode - pushPath wasn't provided")
+		}
+	case backupv1.PullMode:
+		if options.TokenHash == nil || *options.TokenHash == "" {
+			return nil, fmt.Errorf("backup with pull mode - tokenHash wasn't provided")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported backup mode %q", options.Mode)
 	}
 
 	return options, nil
@@ -861,3 +871,43 @@ func (l *Launcher) BackupVirtualMachine(_ context.Context, request *cmdv1.Backup
 	log.Log.Object(vmi).Info("VMI backup job initiated")
 	return response, nil
 }
+
+func (l *Launcher) GetChangedBlocks(_ context.Context, request *cmdv1.ChangedBlocksRequest) (*cmdv1.ChangedBlocksResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	changedBlocksResponse := &cmdv1.ChangedBlocksResponse{
+		Response: response,
+	}
+	if !response.Success {
+		return changedBlocksResponse, nil
+	}
+
+	if !storage.IsChangedBlockTrackingEnabled(vmi) {
+		response.Success = false
+		response.Message = storage.ChangedBlocksNotTrackedMsg
+		return changedBlocksResponse, nil
+	}
+
+	var options *v1.ChangedBlocksOptions
+	if err := json.Unmarshal(request.Options, &options); err != nil || options == nil {
+		response.Success = false
+		response.Message = "no valid changed blocks options object present in command server request"
+		return changedBlocksResponse, nil
+	}
+
+	changedBlocks, err := l.domainManager.GetChangedBlocks(vmi, options)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to query changed blocks")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return changedBlocksResponse, nil
+	}
+
+	jChangedBlocks, err := json.Marshal(changedBlocks)
+	if err != nil {
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return changedBlocksResponse, nil
+	}
+	changedBlocksResponse.ChangedBlocks = string(jChangedBlocks)
+	return changedBlocksResponse, nil
+}
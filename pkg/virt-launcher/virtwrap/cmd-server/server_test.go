@@ -129,8 +129,8 @@ var _ = Describe("Virt remote commands", func() {
 		It("should call memory dump", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			dumpPath := "path/to/dump/volMem"
-			domainManager.EXPECT().MemoryDump(vmi, dumpPath)
-			err := client.VirtualMachineMemoryDump(vmi, dumpPath)
+			domainManager.EXPECT().MemoryDump(vmi, dumpPath, v1.MemoryDumpFormatKdumpZlib)
+			err := client.VirtualMachineMemoryDump(vmi, dumpPath, v1.MemoryDumpFormatKdumpZlib)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
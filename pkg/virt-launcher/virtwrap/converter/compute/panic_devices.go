@@ -25,6 +25,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
+// onCrashPreserve keeps a panicked domain until it is destroyed, so its memory can be dumped
+const onCrashPreserve = "preserve"
+
 type PanicDevicesDomainConfigurator struct{}
 
 func (p PanicDevicesDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
@@ -34,5 +37,9 @@ func (p PanicDevicesDomainConfigurator) Configure(vmi *v1.VirtualMachineInstance
 		})
 	}
 
+	if len(domain.Spec.Devices.PanicDevices) > 0 && vmi.Spec.CrashPolicy != nil && vmi.Spec.CrashPolicy.MemoryDump != nil {
+		domain.Spec.OnCrash = onCrashPreserve
+	}
+
 	return nil
 }
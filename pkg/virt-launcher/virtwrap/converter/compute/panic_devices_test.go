@@ -65,4 +65,16 @@ var _ = Describe("Panic Device Domain Configurator", func() {
 		}
 		Expect(domain).To(Equal(expectedDomain))
 	})
+
+	It("Should preserve the crashed domain when the crash policy dumps the memory", func() {
+		pvpanicModel := v1.Pvpanic
+		vmi := libvmi.New(libvmi.WithPanicDevice(pvpanicModel))
+		vmi.Spec.CrashPolicy = &v1.CrashPolicy{
+			MemoryDump: &v1.CrashMemoryDump{ClaimName: "crash-pvc"},
+		}
+		var domain api.Domain
+
+		Expect(compute.PanicDevicesDomainConfigurator{}.Configure(vmi, &domain)).To(Succeed())
+		Expect(domain.Spec.OnCrash).To(Equal("preserve"))
+	})
 })
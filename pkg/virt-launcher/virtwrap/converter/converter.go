@@ -38,6 +38,7 @@ import (
 	"golang.org/x/sys/unix"
 
 	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -54,6 +55,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/storage/encryption"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -215,10 +217,77 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 	if (c.UseLaunchSecuritySEV || c.UseLaunchSecurityPV) && disk.Target.Bus == v1.DiskBusVirtio {
 		disk.Driver.IOMMU = "on"
 	}
+	disk.IOTune = Convert_v1_DiskIOTune_To_api_IOTune(diskDevice.IOTune)
 
 	return nil
 }
 
+// Convert_v1_DiskEncryption_To_api_Disk lets QEMU open the volume of an encrypted disk with LUKS,
+// using the libvirt secret which virt-launcher defines for the disk
+func Convert_v1_DiskEncryption_To_api_Disk(vmiUID types.UID, source *v1.Disk, disk *api.Disk) {
+	if !encryption.IsEncrypted(source) {
+		return
+	}
+	disk.Source.Encryption = &api.DiskEncryption{
+		Format: "luks",
+		Engine: "qemu",
+		Secret: &api.DiskSecret{
+			Type: "passphrase",
+			UUID: encryption.SecretUUID(vmiUID, source.Name),
+		},
+	}
+	// the LUKS header is part of the image, the image can't be resized like a raw image
+	disk.ExpandDisksEnabled = false
+}
+
+// defaultIOTuneBurstLengthSeconds matches the burst length QEMU applies when none is given
+const defaultIOTuneBurstLengthSeconds = 1
+
+func Convert_v1_DiskIOTune_To_api_IOTune(source *v1.DiskIOTune) *api.IOTune {
+	if source == nil {
+		return nil
+	}
+
+	ioTune := &api.IOTune{
+		TotalBytesSec: uint64(source.TotalBytesPerSec),
+		ReadBytesSec:  uint64(source.ReadBytesPerSec),
+		WriteBytesSec: uint64(source.WriteBytesPerSec),
+		TotalIopsSec:  uint64(source.TotalIOPS),
+		ReadIopsSec:   uint64(source.ReadIOPS),
+		WriteIopsSec:  uint64(source.WriteIOPS),
+		GroupName:     source.GroupName,
+	}
+
+	if burst := source.Burst; burst != nil {
+		ioTune.TotalBytesSecMax = uint64(burst.TotalBytesPerSec)
+		ioTune.ReadBytesSecMax = uint64(burst.ReadBytesPerSec)
+		ioTune.WriteBytesSecMax = uint64(burst.WriteBytesPerSec)
+		ioTune.TotalIopsSecMax = uint64(burst.TotalIOPS)
+		ioTune.ReadIopsSecMax = uint64(burst.ReadIOPS)
+		ioTune.WriteIopsSecMax = uint64(burst.WriteIOPS)
+
+		lengthSeconds := uint64(burst.LengthSeconds)
+		if lengthSeconds == 0 {
+			lengthSeconds = defaultIOTuneBurstLengthSeconds
+		}
+		// the burst length only applies to the burst limits which are set
+		burstLength := func(limit int64) uint64 {
+			if limit == 0 {
+				return 0
+			}
+			return lengthSeconds
+		}
+		ioTune.TotalBytesSecMaxLength = burstLength(burst.TotalBytesPerSec)
+		ioTune.ReadBytesSecMaxLength = burstLength(burst.ReadBytesPerSec)
+		ioTune.WriteBytesSecMaxLength = burstLength(burst.WriteBytesPerSec)
+		ioTune.TotalIopsSecMaxLength = burstLength(burst.TotalIOPS)
+		ioTune.ReadIopsSecMaxLength = burstLength(burst.ReadIOPS)
+		ioTune.WriteIopsSecMaxLength = burstLength(burst.WriteIOPS)
+	}
+
+	return ioTune
+}
+
 func setReservation(disk *api.Disk) {
 	disk.Source.Reservations = &api.Reservations{
 		Managed: "no",
@@ -903,13 +972,17 @@ func Convert_v1_EmptyDiskSource_To_api_Disk(volumeName string, _ *v1.EmptyDiskSo
 	return nil
 }
 
-func Convert_v1_ContainerDiskSource_To_api_Disk(volumeName string, _ *v1.ContainerDiskSource, disk *api.Disk, c *ConverterContext, diskIndex int) error {
+func Convert_v1_ContainerDiskSource_To_api_Disk(volumeName string, containerDisk *v1.ContainerDiskSource, disk *api.Disk, c *ConverterContext, diskIndex int) error {
 	if disk.Type == "lun" {
 		return fmt.Errorf(deviceTypeNotCompatibleFmt, disk.Alias.GetName())
 	}
 	disk.Type = "file"
 	setDiskDriver(disk, "qcow2", true)
-	disk.Source.File = c.EphemeraldiskCreator.GetFilePath(volumeName)
+	if containerDisk.PersistentOverlay != nil {
+		disk.Source.File = containerdisk.GetPersistentOverlayPath(volumeName)
+	} else {
+		disk.Source.File = c.EphemeraldiskCreator.GetFilePath(volumeName)
+	}
 	disk.BackingStore = &api.BackingStore{
 		Format: &api.BackingStoreFormat{},
 		Source: &api.DiskSource{},
@@ -1338,6 +1411,7 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		if err := Convert_v1_BlockSize_To_api_BlockIO(&disk, &newDisk); err != nil {
 			return err
 		}
+		Convert_v1_DiskEncryption_To_api_Disk(vmi.UID, &disk, &newDisk)
 
 		_, isPermVolume := c.PermanentVolumes[disk.Name]
 		// if len(c.PermanentVolumes) == 0, it means the vmi is not ready yet, add all disks
@@ -53,6 +53,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/os/disk"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/encryption"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
@@ -274,6 +275,97 @@ var _ = Describe("Converter", func() {
 			xml := string(data)
 			Expect(xml).To(Equal(expectedXML))
 		})
+		DescribeTable("Should add iotune fields when I/O throttling is requested", func(ioTune *v1.DiskIOTune, expectedXML string) {
+			libvirtDisk := &api.Disk{
+				IOTune: Convert_v1_DiskIOTune_To_api_IOTune(ioTune),
+			}
+			data, err := xml.MarshalIndent(libvirtDisk, "", "  ")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(expectedXML))
+		},
+			Entry("with limits only", &v1.DiskIOTune{
+				ReadBytesPerSec: 1024,
+				WriteIOPS:       100,
+				GroupName:       "group0",
+			}, `<Disk device="" type="">
+  <source></source>
+  <target></target>
+  <iotune>
+    <read_bytes_sec>1024</read_bytes_sec>
+    <write_iops_sec>100</write_iops_sec>
+    <group_name>group0</group_name>
+  </iotune>
+</Disk>`),
+			Entry("with the default burst length", &v1.DiskIOTune{
+				TotalIOPS: 100,
+				Burst: &v1.DiskIOTuneBurst{
+					TotalIOPS: 200,
+				},
+			}, `<Disk device="" type="">
+  <source></source>
+  <target></target>
+  <iotune>
+    <total_iops_sec>100</total_iops_sec>
+    <total_iops_sec_max>200</total_iops_sec_max>
+    <total_iops_sec_max_length>1</total_iops_sec_max_length>
+  </iotune>
+</Disk>`),
+			Entry("with a custom burst length", &v1.DiskIOTune{
+				TotalBytesPerSec: 1024,
+				Burst: &v1.DiskIOTuneBurst{
+					TotalBytesPerSec: 4096,
+					LengthSeconds:    10,
+				},
+			}, `<Disk device="" type="">
+  <source></source>
+  <target></target>
+  <iotune>
+    <total_bytes_sec>1024</total_bytes_sec>
+    <total_bytes_sec_max>4096</total_bytes_sec_max>
+    <total_bytes_sec_max_length>10</total_bytes_sec_max_length>
+  </iotune>
+</Disk>`),
+		)
+		It("Should add the LUKS encryption of an encrypted disk", func() {
+			kubevirtDisk := &v1.Disk{
+				Name: "mydisk",
+				Encryption: &v1.DiskEncryption{
+					SecretRef: k8sv1.LocalObjectReference{Name: "luks-secret"},
+				},
+			}
+			libvirtDisk := &api.Disk{
+				Source:             api.DiskSource{File: "/var/run/kubevirt-private/vmi-disks/mydisk/disk.img"},
+				ExpandDisksEnabled: true,
+			}
+			Convert_v1_DiskEncryption_To_api_Disk("1234", kubevirtDisk, libvirtDisk)
+			Expect(libvirtDisk.ExpandDisksEnabled).To(BeFalse())
+			data, err := xml.MarshalIndent(libvirtDisk, "", "  ")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(fmt.Sprintf(`<Disk device="" type="">
+  <source file="/var/run/kubevirt-private/vmi-disks/mydisk/disk.img">
+    <encryption format="luks" engine="qemu">
+      <secret type="passphrase" uuid="%s"></secret>
+    </encryption>
+  </source>
+  <target></target>
+</Disk>`, encryption.SecretUUID("1234", "mydisk"))))
+		})
+		DescribeTable("should place the container disk overlay", func(persistentOverlay *v1.ContainerDiskPersistentOverlay, expectedFile string) {
+			c := &ConverterContext{
+				EphemeraldiskCreator: &fake.MockEphemeralDiskImageCreator{BaseDir: "/var/run/kubevirt-ephemeral-disks/disk-data"},
+				DisksInfo:            map[string]*disk.DiskInfo{"mydisk": {Format: "raw"}},
+			}
+			libvirtDisk := &api.Disk{Driver: &api.DiskDriver{}}
+			source := &v1.ContainerDiskSource{Image: "image", PersistentOverlay: persistentOverlay}
+			Expect(Convert_v1_ContainerDiskSource_To_api_Disk("mydisk", source, libvirtDisk, c, 1)).To(Succeed())
+			Expect(libvirtDisk.Source.File).To(Equal(expectedFile))
+			Expect(libvirtDisk.BackingStore.Source.File).To(Equal("/var/run/kubevirt/container-disks/disk_1.img"))
+		},
+			Entry("on the pod filesystem by default", nil,
+				"/var/run/kubevirt-ephemeral-disks/disk-data/mydisk/disk.qcow2"),
+			Entry("on its PVC with a persistent overlay", &v1.ContainerDiskPersistentOverlay{Size: resource.MustParse("1Gi")},
+				"/var/run/kubevirt-private/containerdisk-overlays/mydisk/disk.qcow2"),
+		)
 		DescribeTable("should set sharable and the cache if requested", func(arch, expectedModel string) {
 			v1Disk := &v1.Disk{
 				Name: "mydisk",
@@ -132,6 +132,21 @@ func (mr *MockDomainManagerMockRecorder) FreezeVMI(arg0, arg1 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeVMI", reflect.TypeOf((*MockDomainManager)(nil).FreezeVMI), arg0, arg1)
 }
 
+// GetChangedBlocks mocks base method.
+func (m *MockDomainManager) GetChangedBlocks(vmi *v1.VirtualMachineInstance, options *v1.ChangedBlocksOptions) (*v1.ChangedBlocksList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChangedBlocks", vmi, options)
+	ret0, _ := ret[0].(*v1.ChangedBlocksList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChangedBlocks indicates an expected call of GetChangedBlocks.
+func (mr *MockDomainManagerMockRecorder) GetChangedBlocks(vmi, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChangedBlocks", reflect.TypeOf((*MockDomainManager)(nil).GetChangedBlocks), vmi, options)
+}
+
 // GetDomainDirtyRateStats mocks base method.
 func (m *MockDomainManager) GetDomainDirtyRateStats(calculationDuration time.Duration) (*stats.DomainStatsDirtyRate, error) {
 	m.ctrl.T.Helper()
@@ -376,17 +391,17 @@ func (mr *MockDomainManagerMockRecorder) MarkGracefulShutdownVMI() *gomock.Call
 }
 
 // MemoryDump mocks base method.
-func (m *MockDomainManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
+func (m *MockDomainManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string, format v1.MemoryDumpFormat) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MemoryDump", vmi, dumpPath)
+	ret := m.ctrl.Call(m, "MemoryDump", vmi, dumpPath, format)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // MemoryDump indicates an expected call of MemoryDump.
-func (mr *MockDomainManagerMockRecorder) MemoryDump(vmi, dumpPath any) *gomock.Call {
+func (mr *MockDomainManagerMockRecorder) MemoryDump(vmi, dumpPath, format any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemoryDump", reflect.TypeOf((*MockDomainManager)(nil).MemoryDump), vmi, dumpPath)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MemoryDump", reflect.TypeOf((*MockDomainManager)(nil).MemoryDump), vmi, dumpPath, format)
 }
 
 // MigrateVMI mocks base method.
@@ -88,6 +88,8 @@ type migrationMonitor struct {
 	progressTimeout          int64
 	acceptableCompletionTime int64
 	migrationFailedWithError error
+
+	volumes *volumeMigrationMonitor
 }
 
 type inflightMigrationAborted struct {
@@ -116,6 +118,9 @@ func generateMigrationFlags(isBlockMigration, migratePaused bool, options *cmdcl
 	if shouldConfigureParallel, _ := shouldConfigureParallelMigration(options); shouldConfigureParallel {
 		migrateFlags |= libvirt.MIGRATE_PARALLEL
 	}
+	if shouldConfigureCompression(options) {
+		migrateFlags |= libvirt.MIGRATE_COMPRESSED
+	}
 
 	return migrateFlags
 
@@ -295,6 +300,9 @@ func classifyVolumesForMigration(vmi *v1.VirtualMachineInstance) *migrationDisks
 			} else if volSrc.HostDisk.Shared != nil && *volSrc.HostDisk.Shared {
 				disks.shared[volume.Name] = true
 			}
+		case volSrc.ContainerDisk != nil && volSrc.ContainerDisk.PersistentOverlay != nil:
+			// The overlay lives on a PVC which is mounted by both the source and the target
+			disks.shared[volume.Name] = true
 		case volSrc.ConfigMap != nil || volSrc.Secret != nil || volSrc.DownwardAPI != nil ||
 			volSrc.ServiceAccount != nil || volSrc.CloudInitNoCloud != nil ||
 			volSrc.CloudInitConfigDrive != nil || volSrc.ContainerDisk != nil:
@@ -344,6 +352,8 @@ func (l *LibvirtDomainManager) startMigration(vmi *v1.VirtualMachineInstance, op
 	if err != nil {
 		return err
 	}
+	// The volume migration options may change while the migration is running
+	l.volumeMigrationOptions.Store(vmi.Status.VolumeMigration.DeepCopy())
 	if inProgress {
 		return nil
 	}
@@ -464,6 +474,7 @@ func newMigrationMonitor(vmi *v1.VirtualMachineInstance, l *LibvirtDomainManager
 		remainingData:            0,
 		progressTimeout:          options.ProgressTimeout,
 		acceptableCompletionTime: options.CompletionTimeoutPerGiB * getVMIMigrationDataSize(vmi, l.ephemeralDiskDir),
+		volumes:                  newVolumeMigrationMonitor(vmi, options),
 	}
 
 	return monitor
@@ -560,6 +571,23 @@ func (m *migrationMonitor) processInflightMigration(dom cli.VirDomain, stats *li
 	}
 	m.progressWatermark = m.remainingData
 
+	if m.processVolumeMigration(dom) {
+		// The migration is not expected to converge or to progress while the cut-over to the
+		// migrated volumes is held, but the hold is still bounded by the completion timeout
+		if !m.shouldTriggerTimeout(elapsed) {
+			return nil
+		}
+		if err := dom.AbortJob(); err != nil {
+			logger.Reason(err).Error("failed to abort migration")
+			return nil
+		}
+
+		aborted := &inflightMigrationAborted{}
+		aborted.message = fmt.Sprintf("Live migration is not completed after %d seconds and has been aborted, the cut-over to the migrated volumes was held by the cut-over policy", m.acceptableCompletionTime)
+		aborted.abortStatus = v1.MigrationAbortSucceeded
+		return aborted
+	}
+
 	switch {
 	case m.isMigrationPostCopy():
 		// Currently, there is nothing for us to track when in Post Copy mode.
@@ -852,6 +880,12 @@ func generateMigrationParams(dom cli.VirDomain, vmi *v1.VirtualMachineInstance,
 		DestNameSet:            true,
 	}
 
+	if shouldConfigureCompression(options) {
+		configureMigrationCompression(params, options.Compression)
+	} else if options.Compression != nil {
+		log.Log.Object(vmi).Warningf("skipping %s migration compression, it requires a parallel migration", options.Compression.Method)
+	}
+
 	copyDisks := getDiskTargetsForMigration(dom, vmi)
 	if len(copyDisks) != 0 {
 		params.MigrateDisks = copyDisks
@@ -1165,3 +1199,39 @@ func shouldConfigureParallelMigration(options *cmdclient.MigrationOptions) (shou
 	threadsCount = int(*options.ParallelMigrationThreads)
 	return
 }
+
+// shouldConfigureCompression returns true if the requested compression can be used. zstd and zlib
+// compress the pages in the multifd channels, hence they are only available for parallel migrations.
+func shouldConfigureCompression(options *cmdclient.MigrationOptions) bool {
+	if options == nil || options.Compression == nil {
+		return false
+	}
+	if options.Compression.Method == v1.MigrationCompressionXBZRLE {
+		return true
+	}
+	shouldConfigureParallel, _ := shouldConfigureParallelMigration(options)
+	return shouldConfigureParallel
+}
+
+func configureMigrationCompression(params *libvirt.DomainMigrateParameters, compression *v1.MigrationCompression) {
+	params.Compression = string(compression.Method)
+	params.CompressionSet = true
+
+	switch compression.Method {
+	case v1.MigrationCompressionZstd:
+		if compression.Level != nil {
+			params.CompressionZstdLevel = int(*compression.Level)
+			params.CompressionZstdLevelSet = true
+		}
+	case v1.MigrationCompressionZlib:
+		if compression.Level != nil {
+			params.CompressionZlibLevel = int(*compression.Level)
+			params.CompressionZlibLevelSet = true
+		}
+	case v1.MigrationCompressionXBZRLE:
+		if compression.XBZRLECacheSize != nil {
+			params.CompressionXBZRLECache = uint64(compression.XBZRLECacheSize.Value())
+			params.CompressionXBZRLECacheSet = true
+		}
+	}
+}
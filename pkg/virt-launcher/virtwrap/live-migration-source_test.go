@@ -25,6 +25,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -160,5 +161,18 @@ var _ = Describe("Live migration source", func() {
 					localToMigrate: map[string]bool{vol: true},
 				})))
 		})
+
+		It("should classify container disks with a persistent overlay as shared", func() {
+			vmi := libvmi.New(
+				libvmi.WithContainerDisk("ephemeral", "image"),
+				libvmi.WithContainerDiskPersistentOverlay("persistent", "image", resource.MustParse("1Gi")),
+			)
+			Expect(classifyVolumesForMigration(vmi)).To(PointTo(Equal(
+				migrationDisks{
+					shared:         map[string]bool{"persistent": true},
+					generated:      map[string]bool{"ephemeral": true},
+					localToMigrate: map[string]bool{},
+				})))
+		})
 	})
 })
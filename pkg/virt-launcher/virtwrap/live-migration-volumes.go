@@ -0,0 +1,237 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"time"
+
+	"libvirt.org/go/libvirt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util/migrations"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/converter/vcpu"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
+)
+
+const (
+	volumeProgressPeriod = 5 * time.Second
+
+	// While the cut-over is held, the block copy goes on but the memory transfer is throttled and
+	// the allowed downtime is reduced below the size of a page. The migration then only converges
+	// if the guest leaves no page dirty between two passes, and the completion timeout bounds the hold.
+	cutoverHoldBandwidthMiB = 1
+	cutoverHoldDowntimeMS   = 1
+	// Values restored when the cut-over is released, they match the libvirt defaults
+	unlimitedMigrationBandwidthMiB = 8796093022207
+	defaultMigrationDowntimeMS     = 300
+)
+
+type volumeMigrationDisk struct {
+	volume     string
+	target     string
+	lastCopied uint64
+	bandwidth  uint64
+}
+
+// volumeMigrationMonitor tracks the block jobs copying the migrated volumes and holds the
+// cut-over to the destination volumes until the cut-over policy allows it.
+type volumeMigrationMonitor struct {
+	vmi     *v1.VirtualMachineInstance
+	options *cmdclient.MigrationOptions
+
+	disks        []*volumeMigrationDisk
+	lastSample   time.Time
+	cutoverHeld  bool
+	copyInSync   bool
+	releasedHold bool
+}
+
+func newVolumeMigrationMonitor(vmi *v1.VirtualMachineInstance, options *cmdclient.MigrationOptions) *volumeMigrationMonitor {
+	if len(vmi.Status.MigratedVolumes) == 0 {
+		return nil
+	}
+	return &volumeMigrationMonitor{
+		vmi:     vmi,
+		options: options,
+	}
+}
+
+// process updates the progress of the volume copy and applies the volume migration options.
+// It returns true while the cut-over is held.
+func (v *volumeMigrationMonitor) process(dom cli.VirDomain, opts *v1.VolumeMigrationOptions, now time.Time) (bool, []api.MigrationVolumeMetadata) {
+	var policy *v1.VolumeMigrationCutoverPolicy
+	var bandwidth uint64
+	if opts != nil {
+		policy = opts.CutoverPolicy
+		if opts.Bandwidth != nil {
+			bandwidth = uint64(opts.Bandwidth.Value())
+		}
+	}
+
+	allowed, _ := migrations.VolumeCutoverAllowed(policy, now)
+	v.releasedHold = false
+	switch {
+	case !allowed && !v.cutoverHeld:
+		v.holdCutover(dom)
+	case allowed && v.cutoverHeld:
+		v.releaseCutover(dom)
+	}
+
+	if !v.lastSample.IsZero() && now.Sub(v.lastSample) < volumeProgressPeriod {
+		return v.cutoverHeld, nil
+	}
+	return v.cutoverHeld, v.sampleProgress(dom, bandwidth, now)
+}
+
+func (v *volumeMigrationMonitor) holdCutover(dom cli.VirDomain) {
+	logger := log.Log.Object(v.vmi)
+	if err := dom.MigrateSetMaxSpeed(cutoverHoldBandwidthMiB, 0); err != nil {
+		logger.Reason(err).Warning("failed to throttle the migration to hold the cut-over")
+		return
+	}
+	if err := dom.MigrateSetMaxDowntime(cutoverHoldDowntimeMS, 0); err != nil {
+		logger.Reason(err).Warning("failed to reduce the migration downtime to hold the cut-over")
+		return
+	}
+	logger.Info("Holding the cut-over to the migrated volumes")
+	v.cutoverHeld = true
+}
+
+func (v *volumeMigrationMonitor) releaseCutover(dom cli.VirDomain) {
+	logger := log.Log.Object(v.vmi)
+	speed, err := vcpu.QuantityToMebiByte(v.options.Bandwidth)
+	if err != nil || speed == 0 {
+		speed = unlimitedMigrationBandwidthMiB
+	}
+	if err := dom.MigrateSetMaxSpeed(speed, 0); err != nil {
+		logger.Reason(err).Warning("failed to restore the migration bandwidth")
+		return
+	}
+	if err := dom.MigrateSetMaxDowntime(defaultMigrationDowntimeMS, 0); err != nil {
+		logger.Reason(err).Warning("failed to restore the migration downtime")
+		return
+	}
+	logger.Info("Releasing the cut-over to the migrated volumes")
+	v.cutoverHeld = false
+	v.releasedHold = true
+}
+
+func (v *volumeMigrationMonitor) resolveDisks(dom cli.VirDomain) error {
+	if v.disks != nil {
+		return nil
+	}
+	disks, err := util.GetAllDomainDisks(dom)
+	if err != nil {
+		return err
+	}
+	targets := make(map[string]string)
+	for _, disk := range disks {
+		targets[disk.Alias.GetName()] = disk.Target.Device
+	}
+	v.disks = []*volumeMigrationDisk{}
+	for _, vol := range v.vmi.Status.MigratedVolumes {
+		if target, ok := targets[vol.VolumeName]; ok {
+			v.disks = append(v.disks, &volumeMigrationDisk{volume: vol.VolumeName, target: target})
+		}
+	}
+	return nil
+}
+
+func (v *volumeMigrationMonitor) sampleProgress(dom cli.VirDomain, bandwidth uint64, now time.Time) []api.MigrationVolumeMetadata {
+	logger := log.Log.Object(v.vmi)
+	if err := v.resolveDisks(dom); err != nil {
+		logger.Reason(err).Warning("failed to get the disks of the migrated volumes")
+		return nil
+	}
+
+	elapsed := now.Sub(v.lastSample).Seconds()
+	first := v.lastSample.IsZero()
+	v.lastSample = now
+	v.copyInSync = len(v.disks) > 0
+
+	volumes := []api.MigrationVolumeMetadata{}
+	for _, disk := range v.disks {
+		info, err := dom.GetBlockJobInfo(disk.target, libvirt.DOMAIN_BLOCK_JOB_INFO_BANDWIDTH_BYTES)
+		if err != nil || info.End == 0 {
+			// The block job has not started yet
+			v.copyInSync = false
+			continue
+		}
+		if disk.bandwidth != bandwidth {
+			if err := dom.BlockJobSetSpeed(disk.target, bandwidth, libvirt.DOMAIN_BLOCK_JOB_SPEED_BANDWIDTH_BYTES); err != nil {
+				logger.Reason(err).Warningf("failed to set the bandwidth of the copy of volume %s", disk.volume)
+			} else {
+				disk.bandwidth = bandwidth
+			}
+		}
+
+		volume := api.MigrationVolumeMetadata{
+			Name:        disk.volume,
+			BytesTotal:  info.End,
+			BytesCopied: info.Cur,
+		}
+		if !first && elapsed > 0 && info.Cur >= disk.lastCopied {
+			volume.BytesPerSecond = uint64(float64(info.Cur-disk.lastCopied) / elapsed)
+		}
+		disk.lastCopied = info.Cur
+		if info.Cur < info.End {
+			v.copyInSync = false
+		}
+		volumes = append(volumes, volume)
+	}
+
+	for i := range volumes {
+		volumes[i].CutoverPending = v.cutoverHeld && v.copyInSync
+	}
+	return volumes
+}
+
+// processVolumeMigration reports the progress of the volume copy in the migration metadata
+// and returns true while the cut-over to the migrated volumes is held.
+func (m *migrationMonitor) processVolumeMigration(dom cli.VirDomain) bool {
+	if m.volumes == nil {
+		return false
+	}
+
+	held, volumes := m.volumes.process(dom, m.l.volumeMigrationOptions.Load(), time.Now())
+	if m.volumes.releasedHold {
+		// The migration could not converge while the cut-over was held, restart the timeouts
+		now := time.Now().UTC().UnixNano()
+		m.start = now
+		m.lastProgressUpdate = now
+		m.progressWatermark = 0
+	}
+	if volumes != nil {
+		m.l.metadataCache.Migration.WithSafeBlock(func(migrationMetadata *api.MigrationMetadata, _ bool) {
+			if migrationMetadata.Volumes != nil && equality.Semantic.DeepEqual(migrationMetadata.Volumes.Volumes, volumes) {
+				return
+			}
+			migrationMetadata.Volumes = &api.MigrationVolumesMetadata{Volumes: volumes}
+		})
+	}
+	return held
+}
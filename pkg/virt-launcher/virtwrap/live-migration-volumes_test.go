@@ -0,0 +1,195 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package virtwrap
+
+import (
+	"encoding/xml"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"libvirt.org/go/libvirt"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Volume migration monitor", func() {
+	const (
+		volName = "disk0"
+		target  = "vda"
+	)
+	var (
+		mockDomain *cli.MockVirDomain
+		monitor    *volumeMigrationMonitor
+		now        time.Time
+	)
+
+	expectBlockJob := func(cur, end uint64) {
+		mockDomain.EXPECT().GetBlockJobInfo(target, libvirt.DOMAIN_BLOCK_JOB_INFO_BANDWIDTH_BYTES).Return(&libvirt.DomainBlockJobInfo{
+			Cur: cur,
+			End: end,
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		vmi := &v1.VirtualMachineInstance{
+			Status: v1.VirtualMachineInstanceStatus{
+				MigratedVolumes: []v1.StorageMigratedVolumeInfo{{VolumeName: volName}},
+			},
+		}
+		monitor = newVolumeMigrationMonitor(vmi, &cmdclient.MigrationOptions{})
+		Expect(monitor).ToNot(BeNil())
+
+		domSpec := api.NewMinimalDomainSpec("test")
+		domSpec.Devices.Disks = []api.Disk{{
+			Target: api.DiskTarget{Device: target},
+			Alias:  api.NewUserDefinedAlias(volName),
+		}}
+		domXML, err := xml.Marshal(domSpec)
+		Expect(err).ToNot(HaveOccurred())
+		mockDomain.EXPECT().GetXMLDesc(gomock.Any()).Return(string(domXML), nil).AnyTimes()
+		now = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	It("should not be created without migrated volumes", func() {
+		Expect(newVolumeMigrationMonitor(&v1.VirtualMachineInstance{}, &cmdclient.MigrationOptions{})).To(BeNil())
+	})
+
+	It("should report the progress and the rate of the copy", func() {
+		expectBlockJob(100, 1000)
+		held, volumes := monitor.process(mockDomain, nil, now)
+		Expect(held).To(BeFalse())
+		Expect(volumes).To(ConsistOf(api.MigrationVolumeMetadata{Name: volName, BytesTotal: 1000, BytesCopied: 100}))
+
+		By("skipping the samples within the progress period")
+		_, volumes = monitor.process(mockDomain, nil, now.Add(time.Second))
+		Expect(volumes).To(BeNil())
+
+		expectBlockJob(600, 1000)
+		_, volumes = monitor.process(mockDomain, nil, now.Add(volumeProgressPeriod))
+		Expect(volumes).To(ConsistOf(api.MigrationVolumeMetadata{
+			Name:           volName,
+			BytesTotal:     1000,
+			BytesCopied:    600,
+			BytesPerSecond: 100,
+		}))
+	})
+
+	It("should limit the bandwidth of the copy", func() {
+		opts := &v1.VolumeMigrationOptions{Bandwidth: pointer.P(resource.MustParse("1Mi"))}
+		expectBlockJob(100, 1000)
+		mockDomain.EXPECT().BlockJobSetSpeed(target, uint64(1024*1024), libvirt.DOMAIN_BLOCK_JOB_SPEED_BANDWIDTH_BYTES).Return(nil)
+		monitor.process(mockDomain, opts, now)
+
+		By("not setting the same bandwidth twice")
+		expectBlockJob(200, 1000)
+		monitor.process(mockDomain, opts, now.Add(volumeProgressPeriod))
+	})
+
+	It("should hold the cut-over until it is released", func() {
+		manual := &v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{Mode: v1.VolumeMigrationCutoverManual},
+		}
+		mockDomain.EXPECT().MigrateSetMaxSpeed(uint64(cutoverHoldBandwidthMiB), libvirt.DomainMigrateMaxSpeedFlags(0)).Return(nil)
+		mockDomain.EXPECT().MigrateSetMaxDowntime(uint64(cutoverHoldDowntimeMS), uint32(0)).Return(nil)
+		expectBlockJob(1000, 1000)
+		held, volumes := monitor.process(mockDomain, manual, now)
+		Expect(held).To(BeTrue())
+		Expect(volumes).To(HaveLen(1))
+		Expect(volumes[0].CutoverPending).To(BeTrue())
+
+		By("releasing the cut-over")
+		immediate := &v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{Mode: v1.VolumeMigrationCutoverImmediate},
+		}
+		mockDomain.EXPECT().MigrateSetMaxSpeed(uint64(unlimitedMigrationBandwidthMiB), libvirt.DomainMigrateMaxSpeedFlags(0)).Return(nil)
+		mockDomain.EXPECT().MigrateSetMaxDowntime(uint64(defaultMigrationDowntimeMS), uint32(0)).Return(nil)
+		expectBlockJob(1000, 1000)
+		held, volumes = monitor.process(mockDomain, immediate, now.Add(volumeProgressPeriod))
+		Expect(held).To(BeFalse())
+		Expect(monitor.releasedHold).To(BeTrue())
+		Expect(volumes[0].CutoverPending).To(BeFalse())
+	})
+
+	It("should hold the cut-over outside of the window", func() {
+		window := &v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{
+				Mode: v1.VolumeMigrationCutoverWindow,
+				Window: &v1.MaintenanceWindow{
+					Schedule: "* 22 * * *",
+				},
+			},
+		}
+		mockDomain.EXPECT().MigrateSetMaxSpeed(uint64(cutoverHoldBandwidthMiB), gomock.Any()).Return(nil)
+		mockDomain.EXPECT().MigrateSetMaxDowntime(uint64(cutoverHoldDowntimeMS), gomock.Any()).Return(nil)
+		expectBlockJob(500, 1000)
+		held, volumes := monitor.process(mockDomain, window, now)
+		Expect(held).To(BeTrue())
+		Expect(volumes[0].CutoverPending).To(BeFalse())
+
+		By("releasing the cut-over when the window opens")
+		mockDomain.EXPECT().MigrateSetMaxSpeed(uint64(unlimitedMigrationBandwidthMiB), gomock.Any()).Return(nil)
+		mockDomain.EXPECT().MigrateSetMaxDowntime(uint64(defaultMigrationDowntimeMS), gomock.Any()).Return(nil)
+		expectBlockJob(1000, 1000)
+		held, _ = monitor.process(mockDomain, window, now.Add(10*time.Hour+time.Minute))
+		Expect(held).To(BeFalse())
+	})
+
+	It("should abort a migration whose cut-over is held beyond the completion timeout", func() {
+		manager := &LibvirtDomainManager{metadataCache: metadata.NewCache()}
+		manager.volumeMigrationOptions.Store(&v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{Mode: v1.VolumeMigrationCutoverManual},
+		})
+		migration := &migrationMonitor{
+			l:                        manager,
+			vmi:                      &v1.VirtualMachineInstance{},
+			options:                  &cmdclient.MigrationOptions{},
+			volumes:                  monitor,
+			progressTimeout:          1,
+			acceptableCompletionTime: 60,
+		}
+		migration.start = time.Now().UTC().UnixNano()
+		migration.lastProgressUpdate = migration.start - int64(time.Minute)
+
+		By("not applying the progress timeout while the cut-over is held")
+		mockDomain.EXPECT().MigrateSetMaxSpeed(uint64(cutoverHoldBandwidthMiB), gomock.Any()).Return(nil)
+		mockDomain.EXPECT().MigrateSetMaxDowntime(uint64(cutoverHoldDowntimeMS), gomock.Any()).Return(nil)
+		expectBlockJob(1000, 1000)
+		Expect(migration.processInflightMigration(mockDomain, &libvirt.DomainJobInfo{})).To(BeNil())
+
+		By("aborting the migration once the completion timeout expires")
+		migration.start -= int64(2 * time.Minute)
+		mockDomain.EXPECT().AbortJob().Return(nil)
+		aborted := migration.processInflightMigration(mockDomain, &libvirt.DomainJobInfo{})
+		Expect(aborted).ToNot(BeNil())
+		Expect(aborted.abortStatus).To(Equal(v1.MigrationAbortSucceeded))
+		Expect(aborted.message).To(ContainSubstring("the cut-over to the migrated volumes was held by the cut-over policy"))
+	})
+})
@@ -39,6 +39,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -77,6 +78,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/safepath"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/unsafepath"
 	kutil "kubevirt.io/kubevirt/pkg/util"
@@ -152,7 +154,7 @@ type DomainManager interface {
 	GetGuestOSInfo() *api.GuestOSInfo
 	Exec(string, string, []string, int32) (string, error)
 	GuestPing(string) error
-	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
+	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string, format v1.MemoryDumpFormat) error
 	BackupVirtualMachine(*v1.VirtualMachineInstance, *backupv1.BackupOptions) error
 	GetQemuVersion() (string, error)
 	UpdateVCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
@@ -162,6 +164,7 @@ type DomainManager interface {
 	UpdateGuestMemory(vmi *v1.VirtualMachineInstance) error
 	GetDomainDirtyRateStats(calculationDuration time.Duration) (*stats.DomainStatsDirtyRate, error)
 	GetScreenshot(vmi *v1.VirtualMachineInstance) (*cmdv1.ScreenshotResponse, error)
+	GetChangedBlocks(vmi *v1.VirtualMachineInstance, options *v1.ChangedBlocksOptions) (*v1.ChangedBlocksList, error)
 }
 
 type LibvirtDomainManager struct {
@@ -190,6 +193,7 @@ type LibvirtDomainManager struct {
 	disksInfo              map[string]*osdisk.DiskInfo
 	domainInfoStats        *stats.DomainJobInfo
 	diskMemoryLimitBytes   int64
+	volumeMigrationOptions atomic.Pointer[v1.VolumeMigrationOptions]
 
 	metadataCache             *metadata.Cache
 	domainStatsCache          *virtcache.TimeDefinedCache[*stats.DomainStats]
@@ -829,6 +833,10 @@ func (l *LibvirtDomainManager) preStartHook(vmi *v1.VirtualMachineInstance, doma
 		return domain, fmt.Errorf("Starting qemu agent access credential propagation failed: %v", err)
 	}
 
+	if err := l.storageManager.PrepareEncryptedDisks(vmi, domain.Spec.Devices.Disks); err != nil {
+		return domain, fmt.Errorf("preparing encrypted disks failed: %v", err)
+	}
+
 	// expand disk image files if they're too small
 	expandDiskImagesOffline(vmi, domain)
 
@@ -1210,6 +1218,10 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		return nil, err
 	}
 
+	if err := syncDiskIOTune(domain, oldSpec, dom, vmi); err != nil {
+		return nil, err
+	}
+
 	var domainAttachments map[string]string
 	if options != nil {
 		domainAttachments = options.GetInterfaceDomainAttachment()
@@ -1262,6 +1274,9 @@ func (l *LibvirtDomainManager) syncDisks(
 			return err
 		}
 		converter.SetOptimalIOMode(&attachDisk, converter.IsPreAllocated)
+		if err := l.storageManager.PrepareEncryptedDisks(vmi, []api.Disk{attachDisk}); err != nil {
+			return err
+		}
 
 		attachBytes, err := xml.Marshal(attachDisk)
 		if err != nil {
@@ -1320,6 +1335,78 @@ func (l *LibvirtDomainManager) syncDisks(
 	return nil
 }
 
+// syncDiskIOTune applies changed I/O throttling limits to the disks of the running domain
+func syncDiskIOTune(domain *api.Domain, spec *api.DomainSpec, dom cli.VirDomain, vmi *v1.VirtualMachineInstance) error {
+	oldDisks := make(map[string]api.Disk, len(spec.Devices.Disks))
+	for _, disk := range spec.Devices.Disks {
+		oldDisks[disk.Target.Device] = disk
+	}
+
+	for _, disk := range domain.Spec.Devices.Disks {
+		oldDisk, exists := oldDisks[disk.Target.Device]
+		// freshly attached disks get their limits with the attachment
+		if !exists || equality.Semantic.DeepEqual(oldDisk.IOTune, disk.IOTune) {
+			continue
+		}
+
+		log.Log.Object(vmi).V(1).Infof("Updating I/O throttling of disk %s, target %s", disk.Alias.GetName(), disk.Target.Device)
+		if err := dom.SetBlockIoTune(disk.Target.Device, blockIoTuneParameters(disk.IOTune), libvirt.DOMAIN_AFFECT_LIVE); err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("updating I/O throttling of disk %s failed", disk.Alias.GetName())
+			return err
+		}
+	}
+
+	return nil
+}
+
+// blockIoTuneParameters sets every limit, so that removed limits are reset
+func blockIoTuneParameters(ioTune *api.IOTune) *libvirt.DomainBlockIoTuneParameters {
+	if ioTune == nil {
+		ioTune = &api.IOTune{}
+	}
+
+	return &libvirt.DomainBlockIoTuneParameters{
+		TotalBytesSecSet:          true,
+		TotalBytesSec:             ioTune.TotalBytesSec,
+		ReadBytesSecSet:           true,
+		ReadBytesSec:              ioTune.ReadBytesSec,
+		WriteBytesSecSet:          true,
+		WriteBytesSec:             ioTune.WriteBytesSec,
+		TotalIopsSecSet:           true,
+		TotalIopsSec:              ioTune.TotalIopsSec,
+		ReadIopsSecSet:            true,
+		ReadIopsSec:               ioTune.ReadIopsSec,
+		WriteIopsSecSet:           true,
+		WriteIopsSec:              ioTune.WriteIopsSec,
+		TotalBytesSecMaxSet:       true,
+		TotalBytesSecMax:          ioTune.TotalBytesSecMax,
+		ReadBytesSecMaxSet:        true,
+		ReadBytesSecMax:           ioTune.ReadBytesSecMax,
+		WriteBytesSecMaxSet:       true,
+		WriteBytesSecMax:          ioTune.WriteBytesSecMax,
+		TotalIopsSecMaxSet:        true,
+		TotalIopsSecMax:           ioTune.TotalIopsSecMax,
+		ReadIopsSecMaxSet:         true,
+		ReadIopsSecMax:            ioTune.ReadIopsSecMax,
+		WriteIopsSecMaxSet:        true,
+		WriteIopsSecMax:           ioTune.WriteIopsSecMax,
+		TotalBytesSecMaxLengthSet: ioTune.TotalBytesSecMaxLength != 0,
+		TotalBytesSecMaxLength:    ioTune.TotalBytesSecMaxLength,
+		ReadBytesSecMaxLengthSet:  ioTune.ReadBytesSecMaxLength != 0,
+		ReadBytesSecMaxLength:     ioTune.ReadBytesSecMaxLength,
+		WriteBytesSecMaxLengthSet: ioTune.WriteBytesSecMaxLength != 0,
+		WriteBytesSecMaxLength:    ioTune.WriteBytesSecMaxLength,
+		TotalIopsSecMaxLengthSet:  ioTune.TotalIopsSecMaxLength != 0,
+		TotalIopsSecMaxLength:     ioTune.TotalIopsSecMaxLength,
+		ReadIopsSecMaxLengthSet:   ioTune.ReadIopsSecMaxLength != 0,
+		ReadIopsSecMaxLength:      ioTune.ReadIopsSecMaxLength,
+		WriteIopsSecMaxLengthSet:  ioTune.WriteIopsSecMaxLength != 0,
+		WriteIopsSecMaxLength:     ioTune.WriteIopsSecMaxLength,
+		GroupNameSet:              ioTune.GroupName != "",
+		GroupName:                 ioTune.GroupName,
+	}
+}
+
 func (l *LibvirtDomainManager) startDomain(
 	vmi *v1.VirtualMachineInstance,
 	dom cli.VirDomain,
@@ -1330,7 +1417,12 @@ func (l *LibvirtDomainManager) startDomain(
 	}
 
 	createFlags := getDomainCreateFlags(vmi)
-	if err := dom.CreateWithFlags(createFlags); err != nil {
+	if _, exists := memorydump.MemoryStateRestoreClaimName(vmi); exists {
+		if err := l.restoreDomainMemoryState(dom, createFlags); err != nil {
+			logger.Reason(err).Error("Failed to resume VirtualMachineInstance from its memory state.")
+			return err
+		}
+	} else if err := dom.CreateWithFlags(createFlags); err != nil {
 		logger.Reason(err).
 			Errorf("Failed to start VirtualMachineInstance with flags %v.", createFlags)
 		return err
@@ -1343,6 +1435,24 @@ func (l *LibvirtDomainManager) startDomain(
 	return nil
 }
 
+// restoreDomainMemoryState starts the defined domain from the memory state
+// mounted into the pod instead of booting it
+func (l *LibvirtDomainManager) restoreDomainMemoryState(dom cli.VirDomain, createFlags libvirt.DomainCreateFlags) error {
+	stateFile, err := memorydump.FindMemoryStateFile(memorydump.MemoryStateRestoreDir)
+	if err != nil {
+		return err
+	}
+	domainXML, err := dom.GetXMLDesc(0)
+	if err != nil {
+		return err
+	}
+	restoreFlags := libvirt.DOMAIN_SAVE_RUNNING
+	if createFlags&libvirt.DOMAIN_START_PAUSED != 0 {
+		restoreFlags = libvirt.DOMAIN_SAVE_PAUSED
+	}
+	return l.virConn.DomainRestoreFlags(stateFile, domainXML, restoreFlags)
+}
+
 func (l *LibvirtDomainManager) lookupOrCreateVirDomain(
 	domain *api.Domain,
 	vmi *v1.VirtualMachineInstance,
@@ -1621,8 +1731,8 @@ func (l *LibvirtDomainManager) getDomainSpec(dom cli.VirDomain) (*api.DomainSpec
 	return domainSpec, err
 }
 
-func (l *LibvirtDomainManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
-	return l.storageManager.MemoryDump(vmi, dumpPath)
+func (l *LibvirtDomainManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string, format v1.MemoryDumpFormat) error {
+	return l.storageManager.MemoryDump(vmi, dumpPath, format)
 }
 
 func (l *LibvirtDomainManager) PauseVMI(vmi *v1.VirtualMachineInstance) error {
@@ -2531,8 +2641,14 @@ func (l *LibvirtDomainManager) BackupVirtualMachine(vmi *v1.VirtualMachineInstan
 	switch backupOptions.Cmd {
 	case backupv1.Start:
 		return l.storageManager.BackupVirtualMachine(vmi, backupOptions)
+	case backupv1.Stop:
+		return l.storageManager.StopBackup(vmi, backupOptions)
 	default:
 		// TODO: Implement backup abort functionality
 		return fmt.Errorf("recieved unknown backup command")
 	}
 }
+
+func (l *LibvirtDomainManager) GetChangedBlocks(vmi *v1.VirtualMachineInstance, options *v1.ChangedBlocksOptions) (*v1.ChangedBlocksList, error) {
+	return l.storageManager.GetChangedBlocks(vmi, options)
+}
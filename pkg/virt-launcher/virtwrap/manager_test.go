@@ -56,6 +56,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/liveupdate/memory"
 	virtpointer "kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/storage/cbt"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/util/net/ip"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -496,6 +497,30 @@ var _ = Describe("Manager", func() {
 			Expect(newspec).ToNot(BeNil())
 		})
 
+		It("should define and resume a new VirtualMachineInstance from its memory state", func() {
+			stateDir := GinkgoT().TempDir()
+			origStateDir := memorydump.MemoryStateRestoreDir
+			memorydump.MemoryStateRestoreDir = stateDir
+			DeferCleanup(func() { memorydump.MemoryStateRestoreDir = origStateDir })
+			stateFile := filepath.Join(stateDir, "testvmi-vol1"+memorydump.MemoryStateFileSuffix)
+			Expect(os.WriteFile(stateFile, []byte{}, 0o600)).To(Succeed())
+
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Annotations = map[string]string{v1.MemoryStateRestoreAnnotation: "memory-state-pvc"}
+			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).Return(nil, libvirt.Error{Code: libvirt.ERR_NO_DOMAIN})
+
+			setDomainExpectations(vmi)
+
+			mockLibvirt.DomainEXPECT().GetState().Return(libvirt.DOMAIN_SHUTDOWN, 1, nil)
+			mockLibvirt.DomainEXPECT().GetXMLDesc(libvirt.DomainXMLFlags(0)).Return("<domain/>", nil)
+			mockLibvirt.DomainEXPECT().CreateWithFlags(gomock.Any()).Times(0)
+			mockLibvirt.ConnectionEXPECT().DomainRestoreFlags(stateFile, gomock.Any(), libvirt.DOMAIN_SAVE_RUNNING).Return(nil)
+			manager, _ := newLibvirtDomainManagerDefault()
+			newspec, err := manager.SyncVMI(vmi, true, &cmdv1.VirtualMachineOptions{VirtualMachineSMBios: &cmdv1.SMBios{}})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newspec).ToNot(BeNil())
+		})
+
 		It("should define and start a new VirtualMachineInstance with userData", func() {
 			vmi := newVMI(testNamespace, testVmName)
 			mockLibvirt.ConnectionEXPECT().LookupDomainByName(testDomainName).Return(nil, libvirt.Error{Code: libvirt.ERR_NO_DOMAIN})
@@ -3138,6 +3163,89 @@ var _ = Describe("getUpdatedDisks", func() {
 	)
 })
 
+var _ = Describe("syncDiskIOTune", func() {
+	var mockDomain *cli.MockVirDomain
+	vmi := api2.NewMinimalVMI("testvmi")
+
+	newSpec := func(ioTunes map[string]*api.IOTune) *api.DomainSpec {
+		spec := &api.DomainSpec{}
+		for _, device := range []string{"vda", "vdb"} {
+			ioTune, ok := ioTunes[device]
+			if !ok {
+				continue
+			}
+			spec.Devices.Disks = append(spec.Devices.Disks, api.Disk{
+				Alias:  api.NewUserDefinedAlias(device),
+				Target: api.DiskTarget{Device: device},
+				IOTune: ioTune,
+			})
+		}
+		return spec
+	}
+
+	BeforeEach(func() {
+		mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+	})
+
+	It("should update the limits of disks with changed I/O throttling", func() {
+		oldSpec := newSpec(map[string]*api.IOTune{
+			"vda": {TotalIopsSec: 100},
+			"vdb": {TotalIopsSec: 100},
+		})
+		domain := &api.Domain{Spec: *newSpec(map[string]*api.IOTune{
+			"vda": {TotalIopsSec: 100},
+			"vdb": {TotalIopsSec: 200, TotalIopsSecMax: 400, TotalIopsSecMaxLength: 1},
+		})}
+
+		mockDomain.EXPECT().SetBlockIoTune("vdb", gomock.Any(), libvirt.DOMAIN_AFFECT_LIVE).DoAndReturn(
+			func(_ string, params *libvirt.DomainBlockIoTuneParameters, _ libvirt.DomainModificationImpact) error {
+				Expect(params.TotalIopsSecSet).To(BeTrue())
+				Expect(params.TotalIopsSec).To(BeEquivalentTo(200))
+				Expect(params.TotalIopsSecMax).To(BeEquivalentTo(400))
+				Expect(params.TotalIopsSecMaxLengthSet).To(BeTrue())
+				Expect(params.ReadBytesSecSet).To(BeTrue())
+				Expect(params.ReadBytesSec).To(BeZero())
+				Expect(params.GroupNameSet).To(BeFalse())
+				return nil
+			})
+
+		Expect(syncDiskIOTune(domain, oldSpec, mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should reset the limits of disks with removed I/O throttling", func() {
+		oldSpec := newSpec(map[string]*api.IOTune{"vda": {TotalIopsSec: 100}})
+		domain := &api.Domain{Spec: *newSpec(map[string]*api.IOTune{"vda": nil})}
+
+		mockDomain.EXPECT().SetBlockIoTune("vda", gomock.Any(), libvirt.DOMAIN_AFFECT_LIVE).DoAndReturn(
+			func(_ string, params *libvirt.DomainBlockIoTuneParameters, _ libvirt.DomainModificationImpact) error {
+				Expect(params.TotalIopsSecSet).To(BeTrue())
+				Expect(params.TotalIopsSec).To(BeZero())
+				return nil
+			})
+
+		Expect(syncDiskIOTune(domain, oldSpec, mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should not update freshly attached disks", func() {
+		oldSpec := newSpec(map[string]*api.IOTune{"vda": nil})
+		domain := &api.Domain{Spec: *newSpec(map[string]*api.IOTune{
+			"vda": nil,
+			"vdb": {TotalIopsSec: 100},
+		})}
+
+		Expect(syncDiskIOTune(domain, oldSpec, mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should fail if libvirt fails to update the limits", func() {
+		oldSpec := newSpec(map[string]*api.IOTune{"vda": nil})
+		domain := &api.Domain{Spec: *newSpec(map[string]*api.IOTune{"vda": {TotalIopsSec: 100}})}
+
+		mockDomain.EXPECT().SetBlockIoTune("vda", gomock.Any(), libvirt.DOMAIN_AFFECT_LIVE).Return(fmt.Errorf("failure"))
+
+		Expect(syncDiskIOTune(domain, oldSpec, mockDomain, vmi)).To(MatchError("failure"))
+	})
+})
+
 var _ = Describe("migratableDomXML", func() {
 	var ctrl *gomock.Controller
 	var mockLibvirt *testing.Libvirt
@@ -3640,6 +3748,39 @@ var _ = Describe("Manager helper functions", func() {
 			Expect(shouldConfigure).To(BeTrue())
 		})
 	})
+
+	Context("migration compression", func() {
+		DescribeTable("should decide whether to configure compression", func(options *cmdclient.MigrationOptions, expected bool) {
+			Expect(shouldConfigureCompression(options)).To(Equal(expected))
+			flags := generateMigrationFlags(false, false, options)
+			Expect(flags&libvirt.MIGRATE_COMPRESSED != 0).To(Equal(expected))
+		},
+			Entry("not without compression", &cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(8))}, false),
+			Entry("with zstd and parallel migration",
+				&cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(8)), Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZstd}}, true),
+			Entry("not with zlib and no parallel migration",
+				&cmdclient.MigrationOptions{Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZlib}}, false),
+			Entry("not with zstd and post-copy",
+				&cmdclient.MigrationOptions{ParallelMigrationThreads: virtpointer.P(uint(8)), AllowPostCopy: true, Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZstd}}, false),
+			Entry("with xbzrle and no parallel migration",
+				&cmdclient.MigrationOptions{Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE}}, true),
+		)
+
+		DescribeTable("should set the compression parameters", func(compression *v1.MigrationCompression, expected *libvirt.DomainMigrateParameters) {
+			params := &libvirt.DomainMigrateParameters{}
+			configureMigrationCompression(params, compression)
+			Expect(params).To(Equal(expected))
+		},
+			Entry("for zstd with a level", &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: virtpointer.P(int32(5))},
+				&libvirt.DomainMigrateParameters{Compression: "zstd", CompressionSet: true, CompressionZstdLevel: 5, CompressionZstdLevelSet: true}),
+			Entry("for zlib with a level", &v1.MigrationCompression{Method: v1.MigrationCompressionZlib, Level: virtpointer.P(int32(9))},
+				&libvirt.DomainMigrateParameters{Compression: "zlib", CompressionSet: true, CompressionZlibLevel: 9, CompressionZlibLevelSet: true}),
+			Entry("for zlib without a level", &v1.MigrationCompression{Method: v1.MigrationCompressionZlib},
+				&libvirt.DomainMigrateParameters{Compression: "zlib", CompressionSet: true}),
+			Entry("for xbzrle with a cache size", &v1.MigrationCompression{Method: v1.MigrationCompressionXBZRLE, XBZRLECacheSize: virtpointer.P(resource.MustParse("256Mi"))},
+				&libvirt.DomainMigrateParameters{Compression: "xbzrle", CompressionSet: true, CompressionXBZRLECache: 256 * 1024 * 1024, CompressionXBZRLECacheSet: true}),
+		)
+	})
 })
 
 var _ = Describe("Changed Block Tracking", func() {
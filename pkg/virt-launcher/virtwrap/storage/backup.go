@@ -33,6 +33,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/storage/cbt"
 	kutil "kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
@@ -46,6 +47,8 @@ const (
 	backupTimeXMLFormat               = "2006-01-02_15-04-05"
 	freezeFailedMsg                   = "Failed freezing guest filesystem: %s"
 	unfreezeFailedMsg                 = "Failed to unfreeze filesystem after backup completion"
+
+	backupScratchDir = "backup-scratch"
 )
 
 func (m *StorageManager) BackupVirtualMachine(vmi *v1.VirtualMachineInstance, backupOptions *backupv1.BackupOptions) error {
@@ -54,6 +57,9 @@ func (m *StorageManager) BackupVirtualMachine(vmi *v1.VirtualMachineInstance, ba
 	if m.MigrationInProgress() {
 		return fmt.Errorf("failed to do backup, VMI is currently during migration")
 	}
+	if len(m.changedBlocksInProgress) > 0 {
+		return fmt.Errorf("failed to do backup, a changed blocks query is in progress")
+	}
 	inProgress, err := m.initializeBackupMetadata(backupOptions)
 	if err != nil {
 		logger.Reason(err).Warning("Failed to initialize backup metadata")
@@ -108,6 +114,10 @@ func (m *StorageManager) initializeBackupMetadata(backupOptions *backupv1.Backup
 		Name:           backupOptions.BackupName,
 		StartTimestamp: backupOptions.BackupStartTime,
 		SkipQuiesce:    backupOptions.SkipQuiesce,
+		Mode:           string(backupOptions.Mode),
+	}
+	if backupOptions.TokenHash != nil {
+		b.TokenHash = *backupOptions.TokenHash
 	}
 	m.metadataCache.Backup.Store(b)
 	log.Log.Infof("Initialized backup metadata: %v", b)
@@ -131,8 +141,8 @@ func (m *StorageManager) backup(vmi *v1.VirtualMachineInstance, backupOptions *b
 	}
 
 	var backupPath string
-	if backupOptions.PushPath != nil {
-		backupPath = getBackupPath(backupOptions, vmi.Name)
+	if backupOptions.PushPath != nil || isPullBackup(backupOptions) {
+		backupPath = getBackupPath(backupOptions, vmi)
 		if err := kutil.MkdirAllWithNosec(backupPath); err != nil {
 			logger.Reason(err).Error("error creating dir for backup")
 			return fmt.Errorf("error creating dir for backup: %w", err)
@@ -147,6 +157,12 @@ func (m *StorageManager) backup(vmi *v1.VirtualMachineInstance, backupOptions *b
 		}(backupPath)
 	}
 	domainBackup, domainCheckpoint := generateDomainBackup(domainDisks, backupOptions, backupPath)
+	if isPullBackup(backupOptions) {
+		domainBackup.Server = &api.BackupServer{
+			Transport: "unix",
+			Socket:    backupNBDSocketPath(vmi),
+		}
+	}
 	backupXML, err := xml.Marshal(domainBackup)
 	if err != nil {
 		logger.Reason(err).Error("marshalling backup xml failed")
@@ -160,16 +176,20 @@ func (m *StorageManager) backup(vmi *v1.VirtualMachineInstance, backupOptions *b
 
 	m.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, _ bool) {
 		backupMetadata.CheckpointName = domainCheckpoint.Name
+		backupMetadata.Exports = backupExports(domainBackup)
 	})
 
 	frozenFS := false
 	if !backupOptions.SkipQuiesce {
 		logger.Info("Freezing VMI to capture backup state")
-		if err := dom.FSFreeze(nil, 0); err != nil {
+		if err := m.runPreFreezeHooks(vmi); err != nil {
+			logger.Reason(err).Warning("Backing up without freezing the guest filesystem")
+			m.setBackupMsg(err.Error())
+			m.resumeAfterFailedFreeze(vmi)
+		} else if err := dom.FSFreeze(nil, 0); err != nil {
 			logger.Warningf(freezeFailedMsg, err)
-			m.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, _ bool) {
-				backupMetadata.BackupMsg = fmt.Sprintf(freezeFailedMsg, err)
-			})
+			m.setBackupMsg(fmt.Sprintf(freezeFailedMsg, err))
+			m.resumeAfterFailedFreeze(vmi)
 		} else {
 			frozenFS = true
 		}
@@ -180,9 +200,11 @@ func (m *StorageManager) backup(vmi *v1.VirtualMachineInstance, backupOptions *b
 			logger.Info("Thawing VMI after backup job started")
 			if err := dom.FSThaw(nil, 0); err != nil {
 				logger.Reason(err).Error(unfreezeFailedMsg)
-				m.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, _ bool) {
-					backupMetadata.BackupMsg = unfreezeFailedMsg
-				})
+				m.setBackupMsg(unfreezeFailedMsg)
+				return
+			}
+			if err := m.runPostThawHooks(vmi); err != nil {
+				m.setBackupMsg(err.Error())
 			}
 		}
 	}()
@@ -190,6 +212,12 @@ func (m *StorageManager) backup(vmi *v1.VirtualMachineInstance, backupOptions *b
 	return dom.BackupBegin(strings.ToLower(string(backupXML)), strings.ToLower(string(checkpointXML)), 0)
 }
 
+func (m *StorageManager) setBackupMsg(msg string) {
+	m.metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, _ bool) {
+		backupMetadata.BackupMsg = msg
+	})
+}
+
 func generateDomainBackup(disks []api.Disk, backupOptions *backupv1.BackupOptions, backupPath string) (*api.DomainBackup, *api.DomainCheckpoint) {
 	domainBackup := &api.DomainBackup{
 		Mode: string(backupOptions.Mode),
@@ -215,7 +243,15 @@ func generateDomainBackup(disks []api.Disk, backupOptions *backupv1.BackupOption
 		if disk.Source.DataStore != nil {
 			backupDisk.Backup = "yes"
 			backupDisk.Type = "file"
-			if backupOptions.PushPath != nil {
+			if isPullBackup(backupOptions) {
+				backupDisk.ExportName = volumeName
+				if isIncrementalBackup(backupOptions) {
+					backupDisk.ExportBitmap = exportBitmapName(volumeName)
+				}
+				backupDisk.Scratch = &api.BackupScratch{
+					File: scratchQCOW2File(backupPath, backupOptions.BackupName, volumeName),
+				}
+			} else if backupOptions.PushPath != nil {
 				backupDisk.Target = &api.BackupTarget{
 					File: targetQCOW2File(backupPath, backupOptions.BackupName, volumeName),
 				}
@@ -239,15 +275,55 @@ func generateDomainBackup(disks []api.Disk, backupOptions *backupv1.BackupOption
 	return domainBackup, domainCheckpoint
 }
 
-func getBackupPath(backupOptions *backupv1.BackupOptions, vmiName string) string {
-	backupTime := backupTimeFormatted(backupOptions.BackupStartTime)
-	backupNameWithTime := fmt.Sprintf("%s-%s", backupOptions.BackupName, backupTime)
-	return filepath.Join(*backupOptions.PushPath, vmiName, backupNameWithTime)
+func getBackupPath(backupOptions *backupv1.BackupOptions, vmi *v1.VirtualMachineInstance) string {
+	basePath := filepath.Join(kutil.VirtPrivateDir, string(vmi.UID), backupScratchDir)
+	switch {
+	case backupOptions.PushPath != nil:
+		basePath = *backupOptions.PushPath
+	case backupOptions.ScratchPath != nil:
+		basePath = *backupOptions.ScratchPath
+	}
+	return filepath.Join(basePath, cbt.BackupDir(vmi.Name, backupOptions.BackupName, backupOptions.BackupStartTime))
+}
+
+func backupNBDSocketPath(vmi *v1.VirtualMachineInstance) string {
+	return filepath.Join(kutil.VirtPrivateDir, string(vmi.UID), cbt.BackupNBDSocketName)
 }
 
 func targetQCOW2File(pushPath, backupName, volumeName string) string {
-	fileName := fmt.Sprintf("%s-%s.qcow2", backupName, volumeName)
-	return filepath.Join(pushPath, fileName)
+	return filepath.Join(pushPath, cbt.BackupVolumeFileName(backupName, volumeName))
+}
+
+func scratchQCOW2File(scratchPath, backupName, volumeName string) string {
+	fileName := fmt.Sprintf("%s-%s-scratch.qcow2", backupName, volumeName)
+	return filepath.Join(scratchPath, fileName)
+}
+
+// exportBitmapName is the bitmap qemu exposes to NBD clients as the
+// "qemu:dirty-bitmap:<name>" metadata context of an incremental export
+func exportBitmapName(volumeName string) string {
+	return fmt.Sprintf("backup-%s", volumeName)
+}
+
+func backupExports(domainBackup *api.DomainBackup) *api.BackupExportsMetadata {
+	if domainBackup.Server == nil || domainBackup.BackupDisks == nil {
+		return nil
+	}
+	exports := &api.BackupExportsMetadata{}
+	for _, disk := range domainBackup.BackupDisks.Disks {
+		if disk.ExportName == "" {
+			continue
+		}
+		export := api.BackupExportMetadata{
+			VolumeName: disk.ExportName,
+			ExportName: disk.ExportName,
+		}
+		if disk.ExportBitmap != "" {
+			export.DirtyBitmap = fmt.Sprintf("qemu:dirty-bitmap:%s", disk.ExportBitmap)
+		}
+		exports.Exports = append(exports.Exports, export)
+	}
+	return exports
 }
 
 func backupTimeFormatted(time *metav1.Time) string {
@@ -258,12 +334,53 @@ func isIncrementalBackup(backupOptions *backupv1.BackupOptions) bool {
 	return backupOptions.Incremental != nil && *backupOptions.Incremental != ""
 }
 
+func isPullBackup(backupOptions *backupv1.BackupOptions) bool {
+	return backupOptions.Mode == backupv1.PullMode
+}
+
+// StopBackup ends a running pull mode backup job. In pull mode the job
+// only finishes once aborted, the resulting job completed event marks
+// the backup as completed.
+func (m *StorageManager) StopBackup(vmi *v1.VirtualMachineInstance, backupOptions *backupv1.BackupOptions) error {
+	logger := log.Log.With("backupName", backupOptions.BackupName)
+	backupMetadata, exists := m.metadataCache.Backup.Load()
+	if !exists || backupMetadata.Name != backupOptions.BackupName {
+		return fmt.Errorf("backup %s is not running", backupOptions.BackupName)
+	}
+	if backupMetadata.EndTimestamp != nil {
+		logger.Info("Backup already stopped")
+		return nil
+	}
+	if backupMetadata.Mode != string(backupv1.PullMode) {
+		return fmt.Errorf("only pull mode backups can be stopped")
+	}
+
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := m.virConn.LookupDomainByName(domName)
+	if dom == nil || err != nil {
+		return err
+	}
+	defer dom.Free()
+
+	logger.Info("Stopping pull mode backup job")
+	if err := dom.AbortJob(); err != nil {
+		logger.Reason(err).Error("Failed to stop backup job")
+		return err
+	}
+	return nil
+}
+
 func HandleBackupJobCompletedEvent(domain cli.VirDomain, event *libvirt.DomainEventJobCompleted, metadataCache *metadata.Cache) {
 	backupMetadata, exists := metadataCache.Backup.Load()
 	if !exists {
 		log.Log.Warning("Received backup job completed event, but no active backup metadata found in cache. Ignoring event.")
 		return
 	}
+	if backupMetadata.Name == "" || backupMetadata.EndTimestamp != nil {
+		// changed blocks queries run backup jobs outside of any backup
+		log.Log.V(3).Info("Received backup job completed event without a running backup. Ignoring event.")
+		return
+	}
 	backupName := backupMetadata.Name
 	logger := log.Log.With("backupName", backupName)
 
@@ -277,9 +394,13 @@ func HandleBackupJobCompletedEvent(domain cli.VirDomain, event *libvirt.DomainEv
 	}
 
 	// TODO: Handle non-success job completion (DOMAIN_JOB_FAILED, DOMAIN_JOB_CANCELLED, unknown types)
-	if event.Info.Type == libvirt.DOMAIN_JOB_COMPLETED {
+	switch {
+	case event.Info.Type == libvirt.DOMAIN_JOB_COMPLETED:
 		logger.Info("Backup has been completed successfully")
-	} else {
+	case event.Info.Type == libvirt.DOMAIN_JOB_CANCELLED && backupMetadata.Mode == string(backupv1.PullMode):
+		// pull mode jobs run until they are aborted
+		logger.Info("Pull mode backup has been stopped")
+	default:
 		logger.Warningf("Unexpected job completion type: %d (only handling success case)", event.Info.Type)
 	}
 
@@ -414,6 +414,138 @@ var _ = Describe("Backup", func() {
 		})
 	})
 
+	Describe("pull mode", func() {
+		var domainXML string
+
+		BeforeEach(func() {
+			backupOptions.Mode = backupv1.PullMode
+			backupOptions.PushPath = nil
+			backupOptions.ScratchPath = pointer.P(tempDir)
+			backupOptions.TokenHash = pointer.P("token-hash")
+			domainXML = `<domain type='kvm'>
+				<devices>
+					<disk type='file' device='disk'>
+						<driver name='qemu' type='qcow2'/>
+						<source file='/path/to/disk.qcow2'>
+							<dataStore type='file'>
+								<format type='qcow2'/>
+								<source file='/path/to/disk.qcow2.cbt'/>
+							</dataStore>
+						</source>
+						<target dev='vda' bus='virtio'/>
+						<alias name='ua-disk0'/>
+					</disk>
+				</devices>
+			</domain>`
+		})
+
+		It("should generate NBD exports with scratch files", func() {
+			disks := []api.Disk{
+				{
+					Target: api.DiskTarget{Device: "vda"},
+					Source: api.DiskSource{DataStore: &api.DataStore{}},
+					Alias:  api.NewUserDefinedAlias("disk0"),
+				},
+			}
+
+			domainBackup, _ := generateDomainBackup(disks, backupOptions, tempDir)
+
+			Expect(domainBackup.Mode).To(Equal(string(backupv1.PullMode)))
+			disk := domainBackup.BackupDisks.Disks[0]
+			Expect(disk.Target).To(BeNil())
+			Expect(disk.ExportName).To(Equal("disk0"))
+			Expect(disk.ExportBitmap).To(BeEmpty())
+			Expect(disk.Scratch).ToNot(BeNil())
+			Expect(disk.Scratch.File).To(Equal(filepath.Join(tempDir, "test-backup-disk0-scratch.qcow2")))
+		})
+
+		It("should export the dirty bitmap of incremental backups", func() {
+			backupOptions.Incremental = pointer.P("previous-checkpoint")
+			disks := []api.Disk{
+				{
+					Target: api.DiskTarget{Device: "vda"},
+					Source: api.DiskSource{DataStore: &api.DataStore{}},
+					Alias:  api.NewUserDefinedAlias("disk0"),
+				},
+			}
+
+			domainBackup, _ := generateDomainBackup(disks, backupOptions, tempDir)
+			domainBackup.Server = &api.BackupServer{Transport: "unix", Socket: "/test.sock"}
+
+			Expect(domainBackup.BackupDisks.Disks[0].ExportBitmap).To(Equal("backup-disk0"))
+			Expect(backupExports(domainBackup).Exports).To(ConsistOf(api.BackupExportMetadata{
+				VolumeName:  "disk0",
+				ExportName:  "disk0",
+				DirtyBitmap: "qemu:dirty-bitmap:backup-disk0",
+			}))
+		})
+
+		It("should serve the exports on the NBD socket and record them in the metadata", func() {
+			backupOptions.Incremental = pointer.P("previous-checkpoint")
+			mockConn.EXPECT().LookupDomainByName(gomock.Any()).Return(mockDomain, nil)
+			mockDomain.EXPECT().GetXMLDesc(gomock.Any()).Return(domainXML, nil)
+			mockDomain.EXPECT().BackupBegin(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(backupXML, _ string, _ libvirt.DomainBackupBeginFlags) error {
+					Expect(backupXML).To(ContainSubstring(`<domainbackup mode="pull">`))
+					Expect(backupXML).To(ContainSubstring(`<server transport="unix" socket="/var/run/kubevirt-private/test-uid/virt-backup-nbd">`))
+					Expect(backupXML).To(ContainSubstring(`exportname="disk0" exportbitmap="backup-disk0"`))
+					return nil
+				})
+			mockDomain.EXPECT().Free().Return(nil)
+
+			Expect(manager.BackupVirtualMachine(vmi, backupOptions)).To(Succeed())
+
+			backupMetadata, exists := metadataCache.Backup.Load()
+			Expect(exists).To(BeTrue())
+			Expect(backupMetadata.Mode).To(Equal(string(backupv1.PullMode)))
+			Expect(backupMetadata.TokenHash).To(Equal("token-hash"))
+			Expect(backupMetadata.Exports).ToNot(BeNil())
+			Expect(backupMetadata.Exports.Exports).To(HaveLen(1))
+			Expect(backupMetadata.Exports.Exports[0].ExportName).To(Equal("disk0"))
+		})
+
+		Context("StopBackup", func() {
+			BeforeEach(func() {
+				metadataCache.Backup.Store(api.BackupMetadata{
+					Name:           backupOptions.BackupName,
+					StartTimestamp: backupOptions.BackupStartTime,
+					Mode:           string(backupv1.PullMode),
+				})
+				backupOptions.Cmd = backupv1.Stop
+			})
+
+			It("should abort the backup job", func() {
+				mockConn.EXPECT().LookupDomainByName(gomock.Any()).Return(mockDomain, nil)
+				mockDomain.EXPECT().AbortJob().Return(nil)
+				mockDomain.EXPECT().Free().Return(nil)
+
+				Expect(manager.StopBackup(vmi, backupOptions)).To(Succeed())
+			})
+
+			It("should do nothing when the backup already ended", func() {
+				metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, _ bool) {
+					backupMetadata.EndTimestamp = pointer.P(metav1.Now())
+				})
+
+				Expect(manager.StopBackup(vmi, backupOptions)).To(Succeed())
+			})
+
+			It("should fail when another backup is running", func() {
+				backupOptions.BackupName = "other-backup"
+
+				Expect(manager.StopBackup(vmi, backupOptions)).To(MatchError(ContainSubstring("is not running")))
+			})
+
+			It("should fail for push mode backups", func() {
+				metadataCache.Backup.WithSafeBlock(func(backupMetadata *api.BackupMetadata, _ bool) {
+					backupMetadata.Mode = string(backupv1.PushMode)
+				})
+
+				Expect(manager.StopBackup(vmi, backupOptions)).To(MatchError(ContainSubstring("only pull mode")))
+			})
+		})
+	})
+
 	Describe("HandleBackupJobCompletedEvent", func() {
 		var (
 			mockDomain *cli.MockVirDomain
@@ -508,7 +640,7 @@ var _ = Describe("Backup", func() {
 	Describe("utility functions", func() {
 		Describe("getBackupPath", func() {
 			It("should create correct path", func() {
-				path := getBackupPath(backupOptions, "test-vmi")
+				path := getBackupPath(backupOptions, vmi)
 				Expect(path).To(ContainSubstring(tempDir))
 				Expect(path).To(ContainSubstring("test-vmi"))
 				Expect(path).To(ContainSubstring("test-backup"))
@@ -586,7 +718,7 @@ var _ = Describe("Backup", func() {
 				Expect(err).To(HaveOccurred())
 
 				// Directory should be cleaned up
-				backupPath := getBackupPath(backupOptions, vmi.Name)
+				backupPath := getBackupPath(backupOptions, vmi)
 				_, err = os.Stat(backupPath)
 				Expect(os.IsNotExist(err)).To(BeTrue())
 			})
@@ -0,0 +1,386 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"libvirt.org/go/libvirt"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/nbd"
+	kutil "kubevirt.io/kubevirt/pkg/util"
+	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
+)
+
+const (
+	ChangedBlocksNotTrackedMsg = "Changed blocks query failed ChangedBlockTracking is not enabled"
+
+	changedBlocksQueryName     = "changed-blocks"
+	changedBlocksNBDSocketName = "changed-blocks.sock"
+	changedBlocksTimeout       = 15 * time.Second
+)
+
+var dialChangedBlocksNBD = func(socketPath string) (net.Conn, error) {
+	return net.DialTimeout("unix", socketPath, changedBlocksTimeout)
+}
+
+var checkpointParent = func(dom cli.VirDomain, name string) (string, error) {
+	checkpoint, err := dom.CheckpointLookupByName(name, 0)
+	if err != nil {
+		return "", err
+	}
+	defer checkpoint.Free()
+
+	checkpointXML, err := checkpoint.GetXMLDesc(0)
+	if err != nil {
+		return "", err
+	}
+	domainCheckpoint := &api.DomainCheckpoint{}
+	if err := xml.Unmarshal([]byte(checkpointXML), domainCheckpoint); err != nil {
+		return "", err
+	}
+	if domainCheckpoint.Parent == nil {
+		return "", nil
+	}
+	return domainCheckpoint.Parent.Name, nil
+}
+
+// GetChangedBlocks reports the extents of the CBT enabled disks which changed since a checkpoint,
+// or between two checkpoints. The dirty bitmaps are read through a short lived pull mode backup job,
+// which creates no checkpoint.
+func (m *StorageManager) GetChangedBlocks(vmi *v1.VirtualMachineInstance, options *v1.ChangedBlocksOptions) (*v1.ChangedBlocksList, error) {
+	if options.FromCheckpoint == "" {
+		return nil, fmt.Errorf("the checkpoint to report the changes from is required")
+	}
+	if m.MigrationInProgress() {
+		return nil, fmt.Errorf("failed to query changed blocks, VMI is currently during migration")
+	}
+	if m.backupInProgress() {
+		return nil, fmt.Errorf("failed to query changed blocks, a backup is in progress")
+	}
+
+	select {
+	case m.changedBlocksInProgress <- struct{}{}:
+	default:
+		return nil, fmt.Errorf("a changed blocks query is already in progress")
+	}
+	defer func() { <-m.changedBlocksInProgress }()
+
+	return m.changedBlocks(vmi, options)
+}
+
+func (m *StorageManager) backupInProgress() bool {
+	backupMetadata, exists := m.metadataCache.Backup.Load()
+	return exists && backupMetadata.Name != "" && backupMetadata.EndTimestamp == nil
+}
+
+func (m *StorageManager) changedBlocks(vmi *v1.VirtualMachineInstance, options *v1.ChangedBlocksOptions) (*v1.ChangedBlocksList, error) {
+	logger := log.Log.Object(vmi)
+	domName := api.VMINamespaceKeyFunc(vmi)
+	dom, err := m.virConn.LookupDomainByName(domName)
+	if dom == nil || err != nil {
+		return nil, err
+	}
+	defer dom.Free()
+
+	var checkpoints []string
+	if options.ToCheckpoint != "" {
+		checkpoints, err = checkpointRange(dom, options.FromCheckpoint, options.ToCheckpoint)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	domainDisks, err := util.GetAllDomainDisks(dom)
+	if err != nil {
+		logger.Reason(err).Error("failed to parse domain XML to get disks.")
+		return nil, err
+	}
+
+	now := metav1.Now()
+	backupOptions := &backupv1.BackupOptions{
+		BackupName:      changedBlocksQueryName,
+		BackupStartTime: &now,
+		Mode:            backupv1.PullMode,
+		Incremental:     &options.FromCheckpoint,
+	}
+	backupPath := getBackupPath(backupOptions, vmi)
+	if err := kutil.MkdirAllWithNosec(backupPath); err != nil {
+		return nil, fmt.Errorf("error creating dir for changed blocks query: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(backupPath); err != nil {
+			logger.Reason(err).Error("failed to clean up changed blocks query directory")
+		}
+	}()
+
+	domainBackup, _ := generateDomainBackup(domainDisks, backupOptions, backupPath)
+	volumes := selectChangedBlocksDisks(domainBackup, options.Disk)
+	if len(volumes) == 0 {
+		if options.Disk != "" {
+			return nil, fmt.Errorf("disk %s does not have changed block tracking enabled", options.Disk)
+		}
+		return nil, fmt.Errorf("no disk has changed block tracking enabled")
+	}
+
+	socketPath := changedBlocksNBDSocketPath(vmi)
+	if err := os.Remove(socketPath); err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	domainBackup.Server = &api.BackupServer{
+		Transport: "unix",
+		Socket:    socketPath,
+	}
+	backupXML, err := xml.Marshal(domainBackup)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.ToCheckpoint != "" {
+		logger.Infof("Querying the blocks changed between checkpoints %s and %s", options.FromCheckpoint, options.ToCheckpoint)
+	} else {
+		logger.Infof("Querying the blocks changed since checkpoint %s", options.FromCheckpoint)
+	}
+	if err := dom.BackupBegin(strings.ToLower(string(backupXML)), "", 0); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := dom.AbortJob(); err != nil {
+			logger.Reason(err).Error("Failed to stop changed blocks query job")
+		}
+	}()
+
+	changedBlocks := &v1.ChangedBlocksList{
+		FromCheckpoint: options.FromCheckpoint,
+		ToCheckpoint:   options.ToCheckpoint,
+	}
+	for _, volume := range volumes {
+		var disk *v1.DiskChangedBlocks
+		if len(checkpoints) > 0 {
+			disk, err = queryCheckpointBitmaps(dom, socketPath, volume, checkpoints)
+		} else {
+			disk, err = queryDirtyBitmap(socketPath, volume, exportBitmapName(volume))
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to query the changed blocks of disk %s: %w", volume, err)
+		}
+		changedBlocks.Disks = append(changedBlocks.Disks, *disk)
+	}
+	return changedBlocks, nil
+}
+
+// checkpointRange returns the checkpoints from the first one up to the one
+// preceding the last one. The bitmap of a checkpoint tracks the writes until
+// the next checkpoint is created, so the bitmaps of these checkpoints cover
+// the changes between the first and the last one.
+func checkpointRange(dom cli.VirDomain, from, to string) ([]string, error) {
+	if from == to {
+		return nil, fmt.Errorf("checkpoint %s has to be later than checkpoint %s", to, from)
+	}
+	var checkpoints []string
+	for name := to; name != from; {
+		parent, err := checkpointParent(dom, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to look up checkpoint %s: %w", name, err)
+		}
+		if parent == "" {
+			return nil, fmt.Errorf("checkpoint %s is not later than checkpoint %s", to, from)
+		}
+		checkpoints = append(checkpoints, parent)
+		name = parent
+	}
+	slices.Reverse(checkpoints)
+	return checkpoints, nil
+}
+
+// selectChangedBlocksDisks excludes the disks which were not requested
+// from the backup job and returns the exported volumes
+func selectChangedBlocksDisks(domainBackup *api.DomainBackup, diskName string) []string {
+	var volumes []string
+	for i := range domainBackup.BackupDisks.Disks {
+		disk := &domainBackup.BackupDisks.Disks[i]
+		if disk.ExportName == "" {
+			continue
+		}
+		if diskName != "" && disk.ExportName != diskName {
+			*disk = api.BackupDisk{Name: disk.Name, Backup: "no"}
+			continue
+		}
+		volumes = append(volumes, disk.ExportName)
+	}
+	return volumes
+}
+
+func queryDirtyBitmap(socketPath, exportName, bitmap string) (*v1.DiskChangedBlocks, error) {
+	conn, err := dialChangedBlocksNBD(socketPath)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(time.Now().Add(changedBlocksTimeout)); err != nil {
+		return nil, err
+	}
+
+	client, err := nbd.NewClient(conn, exportName, fmt.Sprintf("qemu:dirty-bitmap:%s", bitmap))
+	if err != nil {
+		return nil, err
+	}
+	extents, err := client.DirtyExtents()
+	if err != nil {
+		return nil, err
+	}
+	if err := client.Disconnect(); err != nil {
+		log.Log.Reason(err).Warningf("Failed to disconnect from NBD export %s", exportName)
+	}
+	return &v1.DiskChangedBlocks{
+		Name:    exportName,
+		Size:    client.Size(),
+		Extents: extents,
+	}, nil
+}
+
+// queryCheckpointBitmaps reports the changes of a volume covered by the
+// bitmaps of the checkpoints. The bitmaps are exported next to the volume
+// on the NBD server of the query job and merged.
+func queryCheckpointBitmaps(dom cli.VirDomain, socketPath, volume string, checkpoints []string) (*v1.DiskChangedBlocks, error) {
+	exportID, err := exportCheckpointBitmaps(dom, volume, checkpoints)
+	if err != nil {
+		return nil, fmt.Errorf("failed to export the checkpoint bitmaps: %w", err)
+	}
+	defer func() {
+		if err := qemuMonitorCommand(dom, qmpCommand{Execute: "block-export-del", Arguments: map[string]string{"id": exportID}}, nil); err != nil {
+			log.Log.Reason(err).Warningf("Failed to remove NBD export %s", exportID)
+		}
+	}()
+
+	disk := &v1.DiskChangedBlocks{Name: volume}
+	var bitmaps [][]v1.ChangedBlockExtent
+	for _, checkpoint := range checkpoints {
+		bitmap, err := queryDirtyBitmap(socketPath, exportID, checkpoint)
+		if err != nil {
+			return nil, err
+		}
+		disk.Size = bitmap.Size
+		bitmaps = append(bitmaps, bitmap.Extents)
+	}
+	disk.Extents = nbd.MergeExtents(bitmaps...)
+	return disk, nil
+}
+
+// exportCheckpointBitmaps exports the block node of a volume, which holds
+// the checkpoint bitmaps, read-only with the bitmap of each checkpoint as
+// a metadata context. libvirt has no API to export more than one bitmap,
+// so the export is added over QMP.
+func exportCheckpointBitmaps(dom cli.VirDomain, volume string, checkpoints []string) (string, error) {
+	nodeName, err := volumeNodeName(dom, volume)
+	if err != nil {
+		return "", err
+	}
+	exportID := fmt.Sprintf("%s-%s", changedBlocksQueryName, volume)
+	err = qemuMonitorCommand(dom, qmpCommand{
+		Execute: "block-export-add",
+		Arguments: map[string]any{
+			"type":      "nbd",
+			"id":        exportID,
+			"node-name": nodeName,
+			"name":      exportID,
+			"writable":  false,
+			"bitmaps":   checkpoints,
+		},
+	}, nil)
+	return exportID, err
+}
+
+// volumeNodeName returns the top block node of the disk of a volume, virtio
+// disks report the path of their backend device instead of the alias
+func volumeNodeName(dom cli.VirDomain, volume string) (string, error) {
+	var blocks []struct {
+		Qdev     string `json:"qdev"`
+		Inserted *struct {
+			NodeName string `json:"node-name"`
+		} `json:"inserted"`
+	}
+	if err := qemuMonitorCommand(dom, qmpCommand{Execute: "query-block"}, &blocks); err != nil {
+		return "", err
+	}
+	alias := api.UserAliasPrefix + volume
+	for _, block := range blocks {
+		if block.Inserted == nil {
+			continue
+		}
+		if block.Qdev == alias || strings.HasPrefix(block.Qdev, "/machine/peripheral/"+alias+"/") {
+			return block.Inserted.NodeName, nil
+		}
+	}
+	return "", fmt.Errorf("no block node found for disk %s", volume)
+}
+
+type qmpCommand struct {
+	Execute   string `json:"execute"`
+	Arguments any    `json:"arguments,omitempty"`
+}
+
+// qemuMonitorCommand runs a QMP command and decodes its return value into
+// result. libvirt passes the reply through, errors of QEMU included.
+func qemuMonitorCommand(dom cli.VirDomain, command qmpCommand, result any) error {
+	request, err := json.Marshal(command)
+	if err != nil {
+		return err
+	}
+	reply, err := dom.QemuMonitorCommand(string(request), libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT)
+	if err != nil {
+		return err
+	}
+	response := struct {
+		Return json.RawMessage `json:"return"`
+		Error  *struct {
+			Desc string `json:"desc"`
+		} `json:"error"`
+	}{}
+	if err := json.Unmarshal([]byte(reply), &response); err != nil {
+		return err
+	}
+	if response.Error != nil {
+		return fmt.Errorf("%s failed: %s", command.Execute, response.Error.Desc)
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(response.Return, result)
+}
+
+func changedBlocksNBDSocketPath(vmi *v1.VirtualMachineInstance) string {
+	return filepath.Join(kutil.VirtPrivateDir, string(vmi.UID), changedBlocksNBDSocketName)
+}
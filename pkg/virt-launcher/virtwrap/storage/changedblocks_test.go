@@ -0,0 +1,229 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"libvirt.org/go/libvirt"
+
+	backupv1 "kubevirt.io/api/backup/v1alpha1"
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Changed blocks", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockConn      *cli.MockConnection
+		manager       *StorageManager
+		metadataCache *metadata.Cache
+		vmi           *v1.VirtualMachineInstance
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockConn = cli.NewMockConnection(ctrl)
+		metadataCache = metadata.NewCache()
+		manager = NewStorageManager(mockConn, metadataCache)
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-vmi",
+				Namespace: "default",
+				UID:       "test-uid",
+			},
+		}
+	})
+
+	Describe("GetChangedBlocks", func() {
+		It("should require the checkpoint to report the changes from", func() {
+			_, err := manager.GetChangedBlocks(vmi, &v1.ChangedBlocksOptions{})
+			Expect(err).To(MatchError(ContainSubstring("checkpoint to report the changes from is required")))
+		})
+
+		It("should fail when migration is in progress", func() {
+			metadataCache.Migration.Store(api.MigrationMetadata{
+				StartTimestamp: pointer.P(metav1.Now()),
+			})
+			_, err := manager.GetChangedBlocks(vmi, &v1.ChangedBlocksOptions{FromCheckpoint: "checkpoint-1"})
+			Expect(err).To(MatchError(ContainSubstring("migration")))
+		})
+
+		It("should fail when a backup is in progress", func() {
+			metadataCache.Backup.Store(api.BackupMetadata{
+				Name:           "backup",
+				StartTimestamp: pointer.P(metav1.Now()),
+			})
+			_, err := manager.GetChangedBlocks(vmi, &v1.ChangedBlocksOptions{FromCheckpoint: "checkpoint-1"})
+			Expect(err).To(MatchError(ContainSubstring("a backup is in progress")))
+		})
+
+		It("should fail when another query is in progress", func() {
+			manager.changedBlocksInProgress <- struct{}{}
+			_, err := manager.GetChangedBlocks(vmi, &v1.ChangedBlocksOptions{FromCheckpoint: "checkpoint-1"})
+			Expect(err).To(MatchError(ContainSubstring("already in progress")))
+		})
+
+		It("should reject a backup while a query is in progress", func() {
+			manager.changedBlocksInProgress <- struct{}{}
+			err := manager.BackupVirtualMachine(vmi, &backupv1.BackupOptions{
+				BackupName:      "test-backup",
+				BackupStartTime: pointer.P(metav1.Now()),
+				Mode:            backupv1.PullMode,
+			})
+			Expect(err).To(MatchError(ContainSubstring("changed blocks query is in progress")))
+		})
+	})
+
+	Describe("checkpointRange", func() {
+		var origCheckpointParent func(cli.VirDomain, string) (string, error)
+
+		BeforeEach(func() {
+			origCheckpointParent = checkpointParent
+			parents := map[string]string{
+				"checkpoint-1": "",
+				"checkpoint-2": "checkpoint-1",
+				"checkpoint-3": "checkpoint-2",
+				"checkpoint-4": "checkpoint-3",
+			}
+			checkpointParent = func(_ cli.VirDomain, name string) (string, error) {
+				parent, exists := parents[name]
+				if !exists {
+					return "", fmt.Errorf("checkpoint not found")
+				}
+				return parent, nil
+			}
+		})
+
+		AfterEach(func() {
+			checkpointParent = origCheckpointParent
+		})
+
+		It("should return the checkpoints whose bitmaps cover the range", func() {
+			Expect(checkpointRange(nil, "checkpoint-2", "checkpoint-4")).To(Equal([]string{"checkpoint-2", "checkpoint-3"}))
+		})
+
+		It("should return the first checkpoint of consecutive checkpoints", func() {
+			Expect(checkpointRange(nil, "checkpoint-1", "checkpoint-2")).To(Equal([]string{"checkpoint-1"}))
+		})
+
+		It("should reject checkpoints out of order", func() {
+			_, err := checkpointRange(nil, "checkpoint-3", "checkpoint-2")
+			Expect(err).To(MatchError(ContainSubstring("is not later than")))
+		})
+
+		It("should reject an empty range", func() {
+			_, err := checkpointRange(nil, "checkpoint-2", "checkpoint-2")
+			Expect(err).To(MatchError(ContainSubstring("has to be later than")))
+		})
+
+		It("should fail when a checkpoint does not exist", func() {
+			_, err := checkpointRange(nil, "checkpoint-1", "checkpoint-5")
+			Expect(err).To(MatchError(ContainSubstring("failed to look up checkpoint checkpoint-5")))
+		})
+	})
+
+	Describe("exportCheckpointBitmaps", func() {
+		var mockDomain *cli.MockVirDomain
+
+		BeforeEach(func() {
+			mockDomain = cli.NewMockVirDomain(ctrl)
+		})
+
+		expectQueryBlock := func() {
+			mockDomain.EXPECT().QemuMonitorCommand(`{"execute":"query-block"}`, libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT).Return(`{"return":[
+				{"device":"","qdev":"/machine/peripheral/ua-disk0/virtio-backend","inserted":{"node-name":"libvirt-2-format"}},
+				{"device":"","qdev":"ua-disk1","inserted":{"node-name":"libvirt-1-format"}},
+				{"device":"","qdev":"ua-cdrom"}
+			]}`, nil)
+		}
+
+		DescribeTable("should export the bitmaps of the checkpoints on the block node of the disk", func(volume, nodeName string) {
+			expectQueryBlock()
+			mockDomain.EXPECT().QemuMonitorCommand(gomock.Any(), libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT).DoAndReturn(
+				func(command string, _ libvirt.DomainQemuMonitorCommandFlags) (string, error) {
+					Expect(command).To(MatchJSON(fmt.Sprintf(`{"execute":"block-export-add","arguments":{
+						"type":"nbd","id":"changed-blocks-%[1]s","name":"changed-blocks-%[1]s","node-name":%[2]q,
+						"writable":false,"bitmaps":["checkpoint-1","checkpoint-2"]}}`, volume, nodeName)))
+					return `{"return":{}}`, nil
+				})
+
+			exportID, err := exportCheckpointBitmaps(mockDomain, volume, []string{"checkpoint-1", "checkpoint-2"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exportID).To(Equal("changed-blocks-" + volume))
+		},
+			Entry("of a virtio disk", "disk0", "libvirt-2-format"),
+			Entry("of a scsi disk", "disk1", "libvirt-1-format"),
+		)
+
+		It("should fail when the disk has no block node", func() {
+			expectQueryBlock()
+			_, err := exportCheckpointBitmaps(mockDomain, "cdrom", []string{"checkpoint-1"})
+			Expect(err).To(MatchError(ContainSubstring("no block node found for disk cdrom")))
+		})
+
+		It("should report the error of QEMU", func() {
+			expectQueryBlock()
+			mockDomain.EXPECT().QemuMonitorCommand(gomock.Any(), libvirt.DOMAIN_QEMU_MONITOR_COMMAND_DEFAULT).Return(
+				`{"error":{"class":"GenericError","desc":"Bitmap 'checkpoint-1' is not found"}}`, nil)
+			_, err := exportCheckpointBitmaps(mockDomain, "disk0", []string{"checkpoint-1"})
+			Expect(err).To(MatchError("block-export-add failed: Bitmap 'checkpoint-1' is not found"))
+		})
+	})
+
+	Describe("selectChangedBlocksDisks", func() {
+		newDomainBackup := func() *api.DomainBackup {
+			return &api.DomainBackup{
+				BackupDisks: &api.BackupDisks{
+					Disks: []api.BackupDisk{
+						{Name: "vda", Backup: "yes", ExportName: "disk0"},
+						{Name: "vdb", Backup: "yes", ExportName: "disk1"},
+						{Name: "vdc", Backup: "no"},
+					},
+				},
+			}
+		}
+
+		It("should export every disk with changed block tracking", func() {
+			domainBackup := newDomainBackup()
+			Expect(selectChangedBlocksDisks(domainBackup, "")).To(Equal([]string{"disk0", "disk1"}))
+		})
+
+		It("should export the requested disk only", func() {
+			domainBackup := newDomainBackup()
+			Expect(selectChangedBlocksDisks(domainBackup, "disk1")).To(Equal([]string{"disk1"}))
+			Expect(domainBackup.BackupDisks.Disks[0]).To(Equal(api.BackupDisk{Name: "vda", Backup: "no"}))
+		})
+
+		It("should export nothing when the requested disk is not tracked", func() {
+			domainBackup := newDomainBackup()
+			Expect(selectChangedBlocksDisks(domainBackup, "disk2")).To(BeEmpty())
+		})
+	})
+})
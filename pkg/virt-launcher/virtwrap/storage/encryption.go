@@ -0,0 +1,155 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/storage/encryption"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	// luksHeaderReserve is the space kept for the LUKS header and key slots
+	// when a volume is formatted
+	luksHeaderReserve = 16 * 1024 * 1024
+)
+
+var luksMagic = []byte{'L', 'U', 'K', 'S', 0xba, 0xbe}
+
+var FormatLUKSVolume = formatLUKSVolumeFunc
+
+// PrepareEncryptedDisks defines the libvirt secrets of the encrypted disks and
+// formats their volumes with LUKS if they do not carry a LUKS header yet and
+// formatting is allowed on the disk
+func (m *StorageManager) PrepareEncryptedDisks(vmi *v1.VirtualMachineInstance, disks []api.Disk) error {
+	vmiDisks := map[string]*v1.Disk{}
+	for i := range vmi.Spec.Domain.Devices.Disks {
+		if encryption.IsEncrypted(&vmi.Spec.Domain.Devices.Disks[i]) {
+			vmiDisks[vmi.Spec.Domain.Devices.Disks[i].Name] = &vmi.Spec.Domain.Devices.Disks[i]
+		}
+	}
+
+	for _, disk := range disks {
+		if disk.Source.Encryption == nil || disk.Source.Encryption.Secret == nil || disk.Alias == nil {
+			continue
+		}
+		vmiDisk, exists := vmiDisks[disk.Alias.GetName()]
+		if !exists {
+			continue
+		}
+
+		passphrasePath := encryption.PassphrasePath(vmiDisk.Encryption.SecretRef.Name)
+		passphrase, err := os.ReadFile(passphrasePath)
+		if err != nil {
+			return fmt.Errorf("failed to read the passphrase of disk %s: %v", vmiDisk.Name, err)
+		}
+		if len(passphrase) == 0 {
+			return fmt.Errorf("the passphrase of disk %s is empty", vmiDisk.Name)
+		}
+		if err := m.defineDiskSecret(disk.Source.Encryption.Secret.UUID, vmiDisk.Name, passphrase); err != nil {
+			return err
+		}
+
+		sourcePath := disk.Source.File
+		if sourcePath == "" {
+			sourcePath = disk.Source.Dev
+		}
+		luks, err := isLUKSVolume(sourcePath)
+		if err != nil {
+			return err
+		}
+		if luks {
+			continue
+		}
+		if !vmiDisk.Encryption.Format {
+			return fmt.Errorf("the volume of disk %s is not encrypted with LUKS, set encryption.format on the disk to format it and destroy its content", vmiDisk.Name)
+		}
+		if err := FormatLUKSVolume(sourcePath, passphrasePath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *StorageManager) defineDiskSecret(uuid, diskName string, passphrase []byte) error {
+	secretXML := fmt.Sprintf(`<secret ephemeral="yes" private="yes"><uuid>%s</uuid><description>passphrase of disk %s</description></secret>`,
+		uuid, diskName)
+	secret, err := m.virConn.SecretDefineXML(secretXML)
+	if err != nil {
+		return fmt.Errorf("failed to define the secret of disk %s: %v", diskName, err)
+	}
+	defer secret.Free()
+
+	if err := secret.SetValue(passphrase, 0); err != nil {
+		return fmt.Errorf("failed to set the secret of disk %s: %v", diskName, err)
+	}
+	return nil
+}
+
+// isLUKSVolume returns true if the volume carries a LUKS header
+func isLUKSVolume(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	header := make([]byte, len(luksMagic))
+	n, err := io.ReadFull(f, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return false, fmt.Errorf("failed to read the header of %s: %v", path, err)
+	}
+	return bytes.Equal(header[:n], luksMagic), nil
+}
+
+func formatLUKSVolumeFunc(path, passphrasePath string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	capacity, err := f.Seek(0, io.SeekEnd)
+	f.Close()
+	if err != nil {
+		return fmt.Errorf("failed to get the size of %s: %v", path, err)
+	}
+	size := capacity - luksHeaderReserve
+	if size <= 0 {
+		return fmt.Errorf("%s is too small to be encrypted", path)
+	}
+
+	log.Log.Infof("formatting %s with LUKS", path)
+	cmd := exec.Command("/usr/bin/qemu-img", "create", "-f", "luks",
+		"--object", fmt.Sprintf("secret,id=sec0,file=%s", passphrasePath),
+		"-o", "key-secret=sec0", path, strconv.FormatInt(size, 10))
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("formatting %s with LUKS failed with error: %v, output: %s", path, err, out)
+	}
+	return nil
+}
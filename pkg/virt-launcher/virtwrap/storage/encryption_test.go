@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/config"
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/storage/encryption"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Disk encryption", func() {
+	const secretName = "luks-secret"
+
+	var (
+		ctrl             *gomock.Controller
+		mockConn         *cli.MockConnection
+		mockSecret       *cli.MockVirSecret
+		manager          *StorageManager
+		vmi              *v1.VirtualMachineInstance
+		imagePath        string
+		formattedVolumes []string
+		secretSourceDir  string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockConn = cli.NewMockConnection(ctrl)
+		mockSecret = cli.NewMockVirSecret(ctrl)
+		manager = NewStorageManager(mockConn, metadata.NewCache())
+
+		secretSourceDir = config.SecretSourceDir
+		config.SecretSourceDir = GinkgoT().TempDir()
+		DeferCleanup(func() { config.SecretSourceDir = secretSourceDir })
+		passphrasePath := encryption.PassphrasePath(secretName)
+		Expect(os.MkdirAll(filepath.Dir(passphrasePath), 0o755)).To(Succeed())
+		Expect(os.WriteFile(passphrasePath, []byte("secret passphrase"), 0o600)).To(Succeed())
+
+		imagePath = filepath.Join(GinkgoT().TempDir(), "disk.img")
+		Expect(os.WriteFile(imagePath, make([]byte, 1024*1024), 0o600)).To(Succeed())
+
+		formattedVolumes = nil
+		FormatLUKSVolume = func(path, _ string) error {
+			formattedVolumes = append(formattedVolumes, path)
+			return nil
+		}
+		DeferCleanup(func() { FormatLUKSVolume = formatLUKSVolumeFunc })
+
+		vmi = libvmi.New(libvmi.WithPersistentVolumeClaim("disk0", "pvc", libvmi.WithDiskEncryption(secretName)))
+		vmi.UID = "test-uid"
+	})
+
+	encryptedDisk := func() api.Disk {
+		return api.Disk{
+			Source: api.DiskSource{
+				File: imagePath,
+				Encryption: &api.DiskEncryption{
+					Format: "luks",
+					Secret: &api.DiskSecret{Type: "passphrase", UUID: encryption.SecretUUID(vmi.UID, "disk0")},
+				},
+			},
+			Alias: api.NewUserDefinedAlias("disk0"),
+		}
+	}
+
+	expectSecretDefined := func() {
+		mockConn.EXPECT().SecretDefineXML(gomock.Any()).DoAndReturn(func(xml string) (cli.VirSecret, error) {
+			Expect(xml).To(ContainSubstring(encryption.SecretUUID(vmi.UID, "disk0")))
+			Expect(xml).To(ContainSubstring(`ephemeral="yes" private="yes"`))
+			return mockSecret, nil
+		})
+		mockSecret.EXPECT().SetValue([]byte("secret passphrase"), uint32(0)).Return(nil)
+		mockSecret.EXPECT().Free().Return(nil)
+	}
+
+	It("should define the secret and format a volume when formatting is allowed", func() {
+		vmi.Spec.Domain.Devices.Disks[0].Encryption.Format = true
+		expectSecretDefined()
+		Expect(manager.PrepareEncryptedDisks(vmi, []api.Disk{encryptedDisk()})).To(Succeed())
+		Expect(formattedVolumes).To(ConsistOf(imagePath))
+	})
+
+	It("should not format a volume which already carries a LUKS header", func() {
+		header := append([]byte{}, luksMagic...)
+		Expect(os.WriteFile(imagePath, append(header, make([]byte, 4096)...), 0o600)).To(Succeed())
+		expectSecretDefined()
+		Expect(manager.PrepareEncryptedDisks(vmi, []api.Disk{encryptedDisk()})).To(Succeed())
+		Expect(formattedVolumes).To(BeEmpty())
+	})
+
+	DescribeTable("should refuse to format a volume unless formatting is allowed", func(content []byte) {
+		Expect(os.WriteFile(imagePath, content, 0o600)).To(Succeed())
+		expectSecretDefined()
+		Expect(manager.PrepareEncryptedDisks(vmi, []api.Disk{encryptedDisk()})).To(
+			MatchError(ContainSubstring("not encrypted with LUKS, set encryption.format")))
+		Expect(formattedVolumes).To(BeEmpty())
+	},
+		Entry("holding data", []byte("plain data")),
+		// data may only start past the zeroed first sectors
+		Entry("starting with zeroes", append(make([]byte, 8192), []byte("plain data")...)),
+	)
+
+	It("should ignore disks which are not encrypted", func() {
+		Expect(manager.PrepareEncryptedDisks(vmi, []api.Disk{{
+			Source: api.DiskSource{File: imagePath},
+			Alias:  api.NewUserDefinedAlias("disk0"),
+		}})).To(Succeed())
+		Expect(formattedVolumes).To(BeEmpty())
+	})
+})
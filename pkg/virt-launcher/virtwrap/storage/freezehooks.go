@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/config"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const defaultFreezeHookInterpreter = "/bin/sh"
+
+var freezeHookVolumeSourcePath = func(volume *v1.Volume) (string, error) {
+	switch {
+	case volume.ConfigMap != nil:
+		return config.GetConfigMapSourcePath(volume.Name), nil
+	case volume.Secret != nil:
+		return config.GetSecretSourcePath(volume.Name), nil
+	}
+	return "", fmt.Errorf("volume %s is not a ConfigMap or Secret volume", volume.Name)
+}
+
+// runPreFreezeHooks runs the pre-freeze hooks of the VMI in order and stops at the first failure
+func (m *StorageManager) runPreFreezeHooks(vmi *v1.VirtualMachineInstance) error {
+	for _, hook := range storagetypes.PreFreezeHooks(&vmi.Spec) {
+		if err := m.runFreezeHook(vmi, &hook); err != nil {
+			return fmt.Errorf("%s %s: %v", storagetypes.PreFreezeHookFailedMsg, hook.Name, err)
+		}
+	}
+	return nil
+}
+
+// runPostThawHooks runs all the post-thaw hooks of the VMI in order, a failing hook does not prevent the next ones from running
+func (m *StorageManager) runPostThawHooks(vmi *v1.VirtualMachineInstance) error {
+	var errs []error
+	for _, hook := range storagetypes.PostThawHooks(&vmi.Spec) {
+		if err := m.runFreezeHook(vmi, &hook); err != nil {
+			errs = append(errs, fmt.Errorf("%s %s: %v", storagetypes.PostThawHookFailedMsg, hook.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (m *StorageManager) runFreezeHook(vmi *v1.VirtualMachineInstance, hook *v1.FreezeHook) error {
+	domainName := api.VMINamespaceKeyFunc(vmi)
+	timeoutSeconds := storagetypes.FreezeHookTimeoutSeconds(hook)
+	log.Log.Object(vmi).Infof("Running freeze hook %s", hook.Name)
+
+	var err error
+	switch {
+	case hook.Exec != nil && len(hook.Exec.Command) > 0:
+		_, err = agent.GuestExec(m.virConn, domainName, hook.Exec.Command[0], hook.Exec.Command[1:], timeoutSeconds)
+	case hook.Script != nil:
+		var script []byte
+		script, err = readFreezeHookScript(vmi, hook.Script)
+		if err != nil {
+			return err
+		}
+		interpreter := hook.Script.Interpreter
+		if len(interpreter) == 0 {
+			interpreter = []string{defaultFreezeHookInterpreter}
+		}
+		_, err = agent.GuestExecWithInput(m.virConn, domainName, interpreter[0], interpreter[1:], script, timeoutSeconds)
+	default:
+		return fmt.Errorf("the hook has neither a command nor a script")
+	}
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Freeze hook %s failed", hook.Name)
+	}
+	return err
+}
+
+func readFreezeHookScript(vmi *v1.VirtualMachineInstance, script *v1.FreezeHookScript) ([]byte, error) {
+	for i := range vmi.Spec.Volumes {
+		volume := &vmi.Spec.Volumes[i]
+		if volume.Name != script.VolumeName {
+			continue
+		}
+		sourcePath, err := freezeHookVolumeSourcePath(volume)
+		if err != nil {
+			return nil, err
+		}
+		return os.ReadFile(filepath.Join(sourcePath, script.Key))
+	}
+	return nil, fmt.Errorf("volume %s not found", script.VolumeName)
+}
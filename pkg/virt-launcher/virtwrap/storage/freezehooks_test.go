@@ -0,0 +1,216 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package storage
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
+	agentpoller "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent-poller"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Freeze hooks", func() {
+	var (
+		ctrl       *gomock.Controller
+		mockConn   *cli.MockConnection
+		mockDomain *cli.MockVirDomain
+		manager    *StorageManager
+	)
+
+	const (
+		testDomainName       = "testnamespace_testvmi"
+		expectedThawedOutput = `{"return":"thawed"}`
+		expectedFrozenOutput = `{"return":"frozen"}`
+		execOutput           = `{"return":{"pid":42}}`
+		exitedOutput         = `{"return":{"exited":true,"exitcode":0}}`
+		failedOutput         = `{"return":{"exited":true,"exitcode":1}}`
+	)
+
+	newVMI := func(freezeHooks *v1.FreezeHooks) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "testvmi",
+				Namespace: "testnamespace",
+			},
+			Spec: v1.VirtualMachineInstanceSpec{
+				FreezeHooks: freezeHooks,
+			},
+		}
+	}
+
+	execHook := func(name string, command ...string) v1.FreezeHook {
+		return v1.FreezeHook{
+			Name:           name,
+			Exec:           &v1.FreezeHookExec{Command: command},
+			TimeoutSeconds: pointer.P(int32(1)),
+		}
+	}
+
+	expectGuestExec := func(command string) *gomock.Call {
+		return mockConn.EXPECT().QemuAgentCommand(gomock.Any(), testDomainName).DoAndReturn(func(cmd, _ string) (string, error) {
+			Expect(cmd).To(ContainSubstring(`"guest-exec"`))
+			Expect(cmd).To(ContainSubstring(command))
+			return execOutput, nil
+		})
+	}
+
+	expectGuestExecStatus := func(statusOutput string) *gomock.Call {
+		return mockConn.EXPECT().QemuAgentCommand(`{"execute": "guest-exec-status", "arguments": { "pid": 42 } }`, testDomainName).Return(statusOutput, nil)
+	}
+
+	expectFSFreezeStatus := func(output string) *gomock.Call {
+		return mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GetFSFreezeStatus)+`"}`, testDomainName).Return(output, nil)
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockConn = cli.NewMockConnection(ctrl)
+		mockDomain = cli.NewMockVirDomain(ctrl)
+		manager = NewStorageManager(mockConn, metadata.NewCache())
+		mockConn.EXPECT().LookupDomainByName(testDomainName).Return(mockDomain, nil).AnyTimes()
+		mockDomain.EXPECT().Free().AnyTimes()
+	})
+
+	It("should run the pre-freeze hooks before freezing the guest", func() {
+		vmi := newVMI(&v1.FreezeHooks{
+			PreFreeze: []v1.FreezeHook{execHook("flush", "/usr/bin/flush-db")},
+		})
+
+		gomock.InOrder(
+			expectFSFreezeStatus(expectedThawedOutput),
+			expectGuestExec("/usr/bin/flush-db"),
+			expectGuestExecStatus(exitedOutput),
+			mockDomain.EXPECT().FSFreeze(nil, uint32(0)),
+		)
+
+		Expect(manager.FreezeVMI(vmi, 0)).To(Succeed())
+	})
+
+	It("should run the post-thaw hooks after thawing the guest", func() {
+		vmi := newVMI(&v1.FreezeHooks{
+			PostThaw: []v1.FreezeHook{execHook("resume", "/usr/bin/resume-db")},
+		})
+
+		gomock.InOrder(
+			expectFSFreezeStatus(expectedFrozenOutput),
+			mockDomain.EXPECT().FSThaw(nil, uint32(0)),
+			expectGuestExec("/usr/bin/resume-db"),
+			expectGuestExecStatus(exitedOutput),
+		)
+
+		Expect(manager.UnfreezeVMI(vmi)).To(Succeed())
+	})
+
+	It("should not freeze the guest and resume it when a pre-freeze hook fails", func() {
+		vmi := newVMI(&v1.FreezeHooks{
+			PreFreeze: []v1.FreezeHook{
+				execHook("flush", "/usr/bin/flush-db"),
+				execHook("never", "/usr/bin/never-run"),
+			},
+			PostThaw: []v1.FreezeHook{execHook("resume", "/usr/bin/resume-db")},
+		})
+
+		gomock.InOrder(
+			expectFSFreezeStatus(expectedThawedOutput),
+			expectGuestExec("/usr/bin/flush-db"),
+			expectGuestExecStatus(failedOutput),
+			expectGuestExec("/usr/bin/resume-db"),
+			expectGuestExecStatus(exitedOutput),
+		)
+
+		err := manager.FreezeVMI(vmi, 0)
+		Expect(err).To(MatchError(ContainSubstring(storagetypes.PreFreezeHookFailedMsg + " flush")))
+	})
+
+	It("should run every post-thaw hook and report the failed ones", func() {
+		vmi := newVMI(&v1.FreezeHooks{
+			PostThaw: []v1.FreezeHook{
+				execHook("first", "/usr/bin/first"),
+				execHook("second", "/usr/bin/second"),
+			},
+		})
+
+		gomock.InOrder(
+			expectFSFreezeStatus(expectedFrozenOutput),
+			mockDomain.EXPECT().FSThaw(nil, uint32(0)),
+			expectGuestExec("/usr/bin/first"),
+			expectGuestExecStatus(failedOutput),
+			expectGuestExec("/usr/bin/second"),
+			expectGuestExecStatus(exitedOutput),
+		)
+
+		err := manager.UnfreezeVMI(vmi)
+		Expect(err).To(MatchError(ContainSubstring(storagetypes.PostThawHookFailedMsg + " first")))
+		Expect(err.Error()).ToNot(ContainSubstring("second"))
+	})
+
+	It("should pass the script of the volume to the interpreter", func() {
+		scriptDir := GinkgoT().TempDir()
+		script := "#!/bin/sh\nsync\n"
+		Expect(os.WriteFile(filepath.Join(scriptDir, "pre.sh"), []byte(script), 0o600)).To(Succeed())
+
+		origSourcePath := freezeHookVolumeSourcePath
+		freezeHookVolumeSourcePath = func(volume *v1.Volume) (string, error) {
+			Expect(volume.Name).To(Equal("hooks"))
+			return scriptDir, nil
+		}
+		DeferCleanup(func() { freezeHookVolumeSourcePath = origSourcePath })
+
+		vmi := newVMI(&v1.FreezeHooks{
+			PreFreeze: []v1.FreezeHook{{
+				Name: "script",
+				Script: &v1.FreezeHookScript{
+					VolumeName:  "hooks",
+					Key:         "pre.sh",
+					Interpreter: []string{"/bin/bash", "-e"},
+				},
+			}},
+		})
+		vmi.Spec.Volumes = []v1.Volume{{
+			Name: "hooks",
+			VolumeSource: v1.VolumeSource{
+				ConfigMap: &v1.ConfigMapVolumeSource{},
+			},
+		}}
+
+		gomock.InOrder(
+			expectFSFreezeStatus(expectedThawedOutput),
+			expectGuestExec(fmt.Sprintf(`"path":"/bin/bash","arg":["-e"],"input-data":"%s"`, base64.StdEncoding.EncodeToString([]byte(script)))),
+			expectGuestExecStatus(exitedOutput),
+			mockDomain.EXPECT().FSFreeze(nil, uint32(0)),
+		)
+
+		Expect(manager.FreezeVMI(vmi, 0)).To(Succeed())
+	})
+})
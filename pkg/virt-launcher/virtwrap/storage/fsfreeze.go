@@ -70,8 +70,14 @@ func (m *StorageManager) FreezeVMI(vmi *v1.VirtualMachineInstance, unfreezeTimeo
 	}
 	defer domain.Free()
 
+	if err := m.runPreFreezeHooks(vmi); err != nil {
+		m.resumeAfterFailedFreeze(vmi)
+		return err
+	}
+
 	if err := domain.FSFreeze(nil, 0); err != nil {
 		log.Log.Errorf("Failed to freeze vmi, %s", err.Error())
+		m.resumeAfterFailedFreeze(vmi)
 		return err
 	}
 
@@ -104,7 +110,15 @@ func (m *StorageManager) UnfreezeVMI(vmi *v1.VirtualMachineInstance) error {
 		log.Log.Errorf("Failed to unfreeze vmi, %s", err.Error())
 		return err
 	}
-	return nil
+	return m.runPostThawHooks(vmi)
+}
+
+// resumeAfterFailedFreeze runs the post-thaw hooks so the applications quiesced
+// by the pre-freeze hooks do not stay suspended when the freeze fails
+func (m *StorageManager) resumeAfterFailedFreeze(vmi *v1.VirtualMachineInstance) {
+	if err := m.runPostThawHooks(vmi); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to run post-thaw hooks after a failed freeze")
+	}
 }
 
 func (m *StorageManager) scheduleSafetyVMIUnfreeze(vmi *v1.VirtualMachineInstance, unfreezeTimeout time.Duration) {
@@ -33,6 +33,7 @@ type StorageManager struct {
 	virConn                  cli.Connection
 	metadataCache            *metadata.Cache
 	memoryDumpInProgress     chan struct{}
+	changedBlocksInProgress  chan struct{}
 	cancelSafetyUnfreezeChan chan struct{}
 }
 
@@ -41,6 +42,7 @@ func NewStorageManager(connection cli.Connection, metadataCache *metadata.Cache)
 		virConn:                  connection,
 		metadataCache:            metadataCache,
 		memoryDumpInProgress:     make(chan struct{}, MaxConcurrentMemoryDumps),
+		changedBlocksInProgress:  make(chan struct{}, 1),
 		cancelSafetyUnfreezeChan: make(chan struct{}),
 	}
 }
@@ -20,9 +20,11 @@
 package storage
 
 import (
+	"encoding/xml"
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -31,10 +33,13 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
 )
 
-func (m *StorageManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
+func (m *StorageManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string, format v1.MemoryDumpFormat) error {
 	select {
 	case m.memoryDumpInProgress <- struct{}{}:
 	default:
@@ -44,14 +49,14 @@ func (m *StorageManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath str
 
 	go func() {
 		defer func() { <-m.memoryDumpInProgress }()
-		if err := m.memoryDump(vmi, dumpPath); err != nil {
+		if err := m.memoryDump(vmi, dumpPath, format); err != nil {
 			log.Log.Object(vmi).Reason(err).Error(FailedDomainMemoryDump)
 		}
 	}()
 	return nil
 }
 
-func (m *StorageManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
+func (m *StorageManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPath string, format v1.MemoryDumpFormat) error {
 	logger := log.Log.Object(vmi)
 
 	if m.shouldSkipMemoryDump(dumpPath) {
@@ -66,12 +71,21 @@ func (m *StorageManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPath str
 	}
 	defer dom.Free()
 	// keep trying to do memory dump even if remove previous one failed
-	removePreviousMemoryDump(filepath.Dir(dumpPath))
+	if memorydump.IsCrashMemoryDumpFile(vmi.Name, filepath.Base(dumpPath)) {
+		maxDumps := memorydump.MaxCrashMemoryDumps(memorydump.CrashMemoryDump(vmi))
+		removeOldCrashMemoryDumps(filepath.Dir(dumpPath), vmi.Name, maxDumps-1)
+	} else {
+		removePreviousMemoryDump(filepath.Dir(dumpPath))
+	}
 
 	logger.Infof("Starting memory dump")
 	failed := false
 	reason := ""
-	err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	if memorydump.IsMemoryStateFile(dumpPath) {
+		err = saveMemoryState(dom, dumpPath)
+	} else {
+		err = dom.CoreDumpWithFormat(dumpPath, coreDumpFormat(format), libvirt.DUMP_MEMORY_ONLY)
+	}
 	if err != nil {
 		failed = true
 		reason = fmt.Sprintf("%s: %s", FailedDomainMemoryDump, err)
@@ -83,6 +97,75 @@ func (m *StorageManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPath str
 	return err
 }
 
+// coreDumpFormat returns the libvirt format of a memory dump,
+// the raw format of libvirt is the ELF format of qemu
+func coreDumpFormat(format v1.MemoryDumpFormat) libvirt.DomainCoreDumpFormat {
+	switch format {
+	case v1.MemoryDumpFormatKdumpZlib:
+		return libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_ZLIB
+	case v1.MemoryDumpFormatKdumpLzo:
+		return libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_LZO
+	case v1.MemoryDumpFormatKdumpSnappy:
+		return libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_SNAPPY
+	default:
+		return libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW
+	}
+}
+
+// saveMemoryState saves the memory and device state of the domain in a format
+// libvirt can restore a domain from. The domain is paused before the state is
+// saved and is left paused, so the disks can be snapshotted consistently with it.
+func saveMemoryState(dom cli.VirDomain, statePath string) error {
+	state, _, err := dom.GetState()
+	if err != nil {
+		return err
+	}
+	if state != libvirt.DOMAIN_PAUSED {
+		if err := dom.Suspend(); err != nil {
+			return fmt.Errorf("failed to pause domain: %v", err)
+		}
+	}
+
+	snapshotXML, err := memoryStateSnapshotXML(dom, statePath)
+	if err != nil {
+		return err
+	}
+	snapshot, err := dom.CreateSnapshotXML(snapshotXML, libvirt.DOMAIN_SNAPSHOT_CREATE_NO_METADATA)
+	if err != nil {
+		return err
+	}
+	if snapshot != nil {
+		defer snapshot.Free()
+	}
+	return nil
+}
+
+func memoryStateSnapshotXML(dom cli.VirDomain, statePath string) (string, error) {
+	domainDisks, err := util.GetAllDomainDisks(dom)
+	if err != nil {
+		return "", err
+	}
+	snapshot := api.DomainSnapshot{
+		Memory: &api.SnapshotMemory{
+			Snapshot: "external",
+			File:     statePath,
+		},
+		SnapshotDisks: &api.DomainSnapshotDisks{},
+	}
+	// the disks are snapshotted by the storage provider, only the memory is saved here
+	for _, disk := range domainDisks {
+		snapshot.SnapshotDisks.Disks = append(snapshot.SnapshotDisks.Disks, api.DomainSnapshotDisk{
+			Name:     disk.Target.Device,
+			Snapshot: "no",
+		})
+	}
+	snapshotXML, err := xml.Marshal(snapshot)
+	if err != nil {
+		return "", err
+	}
+	return string(snapshotXML), nil
+}
+
 func (m *StorageManager) shouldSkipMemoryDump(dumpPath string) bool {
 	memoryDumpMetadata, _ := m.metadataCache.MemoryDump.Load()
 	if memoryDumpMetadata.FileName == filepath.Base(dumpPath) {
@@ -135,3 +218,27 @@ func removePreviousMemoryDump(dir string) {
 		}
 	}
 }
+
+// removeOldCrashMemoryDumps removes the oldest crash memory dumps of the vmi
+// so only retain of them are left. The dump file names sort by their time.
+func removeOldCrashMemoryDumps(dir, vmiName string, retain int) {
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to remove older crash memory dumps")
+		return
+	}
+	var dumps []string
+	for _, file := range files {
+		if memorydump.IsCrashMemoryDumpFile(vmiName, file.Name()) {
+			dumps = append(dumps, file.Name())
+		}
+	}
+	sort.Strings(dumps)
+	for len(dumps) > max(retain, 0) {
+		err = os.Remove(filepath.Join(dir, dumps[0]))
+		if err != nil {
+			log.Log.Reason(err).Errorf("failed to remove older crash memory dumps")
+		}
+		dumps = dumps[1:]
+	}
+}
@@ -21,6 +21,8 @@ package storage
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -32,6 +34,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/metadata"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
 )
@@ -87,9 +90,9 @@ var _ = Describe("MemoryDump", func() {
 		mockDomain.EXPECT().CoreDumpWithFormat(testDumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).Return(nil)
 
 		vmi := newVMI(testNamespace, testVmName)
-		Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
+		Expect(manager.MemoryDump(vmi, testDumpPath, "")).To(Succeed())
 		// Expect extra call to memory dump not to impact
-		Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
+		Expect(manager.MemoryDump(vmi, testDumpPath, "")).To(Succeed())
 
 		Eventually(func() bool {
 			memoryDump, _ := metadataCache.MemoryDump.Load()
@@ -102,9 +105,9 @@ var _ = Describe("MemoryDump", func() {
 		mockDomain.EXPECT().CoreDumpWithFormat(testDumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).Times(1).Return(nil)
 
 		vmi := newVMI(testNamespace, testVmName)
-		Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
+		Expect(manager.MemoryDump(vmi, testDumpPath, "")).To(Succeed())
 		// Expect extra call to memory dump not to impact
-		Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
+		Expect(manager.MemoryDump(vmi, testDumpPath, "")).To(Succeed())
 
 		Eventually(func() bool {
 			memoryDump, _ := metadataCache.MemoryDump.Load()
@@ -112,7 +115,7 @@ var _ = Describe("MemoryDump", func() {
 		}, 5*time.Second, 2).Should(BeTrue())
 		// Expect extra call to memory dump after completion
 		// not to call core dump command again
-		Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
+		Expect(manager.MemoryDump(vmi, testDumpPath, "")).To(Succeed())
 	})
 
 	It("should update domain with memory dump info if memory dump failed", func() {
@@ -121,11 +124,130 @@ var _ = Describe("MemoryDump", func() {
 		mockDomain.EXPECT().CoreDumpWithFormat(testDumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).Return(dumpFailure)
 
 		vmi := newVMI(testNamespace, testVmName)
-		err := manager.MemoryDump(vmi, testDumpPath)
+		err := manager.MemoryDump(vmi, testDumpPath, "")
 		Expect(err).ToNot(HaveOccurred())
 		Eventually(func() bool {
 			memoryDump, _ := metadataCache.MemoryDump.Load()
 			return memoryDump.Failed
 		}, 5*time.Second).Should(BeTrue(), "failed memory dump result wasn't set")
 	})
+
+	DescribeTable("should dump the memory in the requested format", func(format v1.MemoryDumpFormat, expectedFormat libvirt.DomainCoreDumpFormat) {
+		mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+		mockDomain.EXPECT().CoreDumpWithFormat(testDumpPath, expectedFormat, libvirt.DUMP_MEMORY_ONLY).Return(nil)
+
+		vmi := newVMI(testNamespace, testVmName)
+		Expect(manager.MemoryDump(vmi, testDumpPath, format)).To(Succeed())
+
+		Eventually(func() bool {
+			memoryDump, _ := metadataCache.MemoryDump.Load()
+			return memoryDump.Completed
+		}, 5*time.Second, 2).Should(BeTrue())
+	},
+		Entry("default", v1.MemoryDumpFormat(""), libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW),
+		Entry("elf", v1.MemoryDumpFormatELF, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW),
+		Entry("kdump-zlib", v1.MemoryDumpFormatKdumpZlib, libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_ZLIB),
+		Entry("kdump-lzo", v1.MemoryDumpFormatKdumpLzo, libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_LZO),
+		Entry("kdump-snappy", v1.MemoryDumpFormatKdumpSnappy, libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_SNAPPY),
+	)
+
+	Context("memory state", func() {
+		const (
+			testStatePath = "/test/dump/path/testvmi-vol1.memory.state"
+			domainXML     = `<domain type='kvm'>
+				<devices>
+					<disk type='file' device='disk'>
+						<source file='/path/to/disk.qcow2'/>
+						<target dev='vda' bus='virtio'/>
+					</disk>
+				</devices>
+			</domain>`
+		)
+
+		expectedSnapshotXML := `<domainsnapshot><memory snapshot="external" file="` + testStatePath + `"></memory>` +
+			`<disks><disk name="vda" snapshot="no"></disk></disks></domainsnapshot>`
+
+		It("should pause the domain and save its memory state", func() {
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockDomain.EXPECT().Suspend().Return(nil)
+			mockDomain.EXPECT().GetXMLDesc(gomock.Any()).Return(domainXML, nil)
+			mockDomain.EXPECT().CreateSnapshotXML(expectedSnapshotXML, libvirt.DOMAIN_SNAPSHOT_CREATE_NO_METADATA).Return(nil, nil)
+
+			vmi := newVMI(testNamespace, testVmName)
+			Expect(manager.MemoryDump(vmi, testStatePath, "")).To(Succeed())
+			Eventually(func() bool {
+				memoryDump, _ := metadataCache.MemoryDump.Load()
+				return memoryDump.Completed && !memoryDump.Failed
+			}, 5*time.Second).Should(BeTrue())
+		})
+
+		It("should not pause an already paused domain", func() {
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_PAUSED, 1, nil)
+			mockDomain.EXPECT().Suspend().Times(0)
+			mockDomain.EXPECT().GetXMLDesc(gomock.Any()).Return(domainXML, nil)
+			mockDomain.EXPECT().CreateSnapshotXML(expectedSnapshotXML, libvirt.DOMAIN_SNAPSHOT_CREATE_NO_METADATA).Return(nil, nil)
+
+			vmi := newVMI(testNamespace, testVmName)
+			Expect(manager.MemoryDump(vmi, testStatePath, "")).To(Succeed())
+			Eventually(func() bool {
+				memoryDump, _ := metadataCache.MemoryDump.Load()
+				return memoryDump.Completed && !memoryDump.Failed
+			}, 5*time.Second).Should(BeTrue())
+		})
+
+		It("should report failure if the memory state could not be saved", func() {
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_RUNNING, 1, nil)
+			mockDomain.EXPECT().Suspend().Return(nil)
+			mockDomain.EXPECT().GetXMLDesc(gomock.Any()).Return(domainXML, nil)
+			mockDomain.EXPECT().CreateSnapshotXML(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("save failed"))
+
+			vmi := newVMI(testNamespace, testVmName)
+			Expect(manager.MemoryDump(vmi, testStatePath, "")).To(Succeed())
+			Eventually(func() bool {
+				memoryDump, _ := metadataCache.MemoryDump.Load()
+				return memoryDump.Failed
+			}, 5*time.Second).Should(BeTrue())
+		})
+	})
+
+	Context("crash memory dump", func() {
+		It("should keep only the most recent crash memory dumps of the vmi", func() {
+			dumpDir := GinkgoT().TempDir()
+			for _, name := range []string{
+				"testvmi-crash-20250101-101010.memory.dump",
+				"testvmi-crash-20250102-101010.memory.dump",
+				"testvmi-crash-20250103-101010.kdump-zlib.memory.dump",
+				"othervmi-crash-20250101-101010.memory.dump",
+			} {
+				Expect(os.WriteFile(filepath.Join(dumpDir, name), []byte{}, 0600)).To(Succeed())
+			}
+			dumpPath := filepath.Join(dumpDir, "testvmi-crash-20250104-101010.memory.dump")
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).Return(nil)
+
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.CrashPolicy = &v1.CrashPolicy{
+				MemoryDump: &v1.CrashMemoryDump{ClaimName: "crash-pvc", MaxDumps: pointer.P(int32(2))},
+			}
+			Expect(manager.MemoryDump(vmi, dumpPath, "")).To(Succeed())
+			Eventually(func() bool {
+				memoryDump, _ := metadataCache.MemoryDump.Load()
+				return memoryDump.Completed && !memoryDump.Failed
+			}, 5*time.Second).Should(BeTrue())
+
+			files, err := os.ReadDir(dumpDir)
+			Expect(err).ToNot(HaveOccurred())
+			var names []string
+			for _, file := range files {
+				names = append(names, file.Name())
+			}
+			Expect(names).To(ConsistOf(
+				"testvmi-crash-20250103-101010.kdump-zlib.memory.dump",
+				"othervmi-crash-20250101-101010.memory.dump",
+			))
+		})
+	})
 })
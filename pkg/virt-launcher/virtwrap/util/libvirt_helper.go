@@ -289,6 +289,46 @@ func (l LibvirtWrapper) StartVirtqemud(stopChan chan struct{}) {
 	}()
 }
 
+// StartVirtsecretd spawns the libvirt secret daemon, which holds the passphrases
+// of encrypted disks. It is only started if it is installed in the image.
+func (l LibvirtWrapper) StartVirtsecretd(stopChan chan struct{}) {
+	const virtsecretdPath = "/usr/sbin/virtsecretd"
+	if _, err := os.Stat(virtsecretdPath); err != nil {
+		log.Log.Reason(err).Warning("virtsecretd is not available, encrypted disks are not supported")
+		return
+	}
+
+	go func() {
+		for {
+			exitChan := make(chan struct{})
+			cmd := exec.Command(virtsecretdPath)
+
+			err := cmd.Start()
+			if err != nil {
+				log.Log.Reason(err).Error("failed to start virtsecretd")
+				return
+			}
+
+			go func() {
+				defer close(exitChan)
+				_ = cmd.Wait()
+			}()
+
+			select {
+			case <-stopChan:
+				_ = cmd.Process.Kill()
+				return
+			case <-exitChan:
+				log.Log.Errorf("virtsecretd exited, restarting")
+			}
+
+			// this sleep is to avoid consuming all resources in the
+			// event of a virtsecretd crash loop.
+			time.Sleep(time.Second)
+		}
+	}()
+}
+
 func startVirtlogdLogging(stopChan chan struct{}, domainName string, nonRoot bool) {
 	for {
 		cmd := exec.Command("/usr/sbin/virtlogd", "-f", "/etc/libvirt/virtlogd.conf")
@@ -88,8 +88,8 @@ const (
 
 	NAMESPACE = "kubevirt-test"
 
-	resourceCount = 89
-	patchCount    = 57
+	resourceCount = 94
+	patchCount    = 62
 	updateCount   = 33
 )
 
@@ -103,7 +103,9 @@ var (
 		components.NewVirtualMachineClusterInstancetypeCrd, components.NewVirtualMachinePoolCrd,
 		components.NewMigrationPolicyCrd, components.NewVirtualMachinePreferenceCrd,
 		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineCloneCrd,
-		components.NewVirtualMachineBackupTrackerCrd,
+		components.NewVirtualMachineBackupTrackerCrd, components.NewVirtualMachineBackupScheduleCrd,
+		components.NewVirtualMachineBackupRestoreCrd, components.NewVirtualMachineSnapshotScheduleCrd,
+		components.NewVirtualMachineSnapshotGroupCrd, components.NewVirtualMachineSnapshotGroupRestoreCrd,
 	}
 	numCRDs = len(crdFunctions)
 )
@@ -43,6 +43,9 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineSnapshot", NewVirtualMachineSnapshotCrd),
 		Entry("for VirtualMachineSnapshotContent", NewVirtualMachineSnapshotContentCrd),
 		Entry("for VirtualMachineRestore", NewVirtualMachineRestoreCrd),
+		Entry("for VirtualMachineSnapshotSchedule", NewVirtualMachineSnapshotScheduleCrd),
+		Entry("for VirtualMachineSnapshotGroup", NewVirtualMachineSnapshotGroupCrd),
+		Entry("for VirtualMachineSnapshotGroupRestore", NewVirtualMachineSnapshotGroupRestoreCrd),
 		Entry("for VirtualMachineExport", NewVirtualMachineExportCrd),
 		Entry("for VirtualMachineInstancetype", NewVirtualMachineInstancetypeCrd),
 		Entry("for VirtualMachineClusterInstancetype", NewVirtualMachineClusterInstancetypeCrd),
@@ -136,6 +139,9 @@ var _ = Describe("CRDs", func() {
 		Entry("for VirtualMachineSnapshot", NewVirtualMachineSnapshotCrd, "SourceKind", "SourceName", "Phase", "ReadyToUse", "CreationTime", "Error"),
 		Entry("for VirtualMachineSnapshotContent", NewVirtualMachineSnapshotContentCrd, "ReadyToUse", "CreationTime", "Error"),
 		Entry("for VirtualMachineRestore", NewVirtualMachineRestoreCrd, "TargetKind", "TargetName", "Complete", "RestoreTime"),
+		Entry("for VirtualMachineSnapshotSchedule", NewVirtualMachineSnapshotScheduleCrd, "Schedule", "Suspend", "LastSuccess", "LastSchedule"),
+		Entry("for VirtualMachineSnapshotGroup", NewVirtualMachineSnapshotGroupCrd, "Phase", "ReadyToUse", "CreationTime", "Error"),
+		Entry("for VirtualMachineSnapshotGroupRestore", NewVirtualMachineSnapshotGroupRestoreCrd, "SnapshotGroup", "Complete", "RestoreTime"),
 		Entry("for VirtualMachineExport", NewVirtualMachineExportCrd, "SourceKind", "SourceName", "Phase"),
 		Entry("for VirtualMachineInstancetype", NewVirtualMachineInstancetypeCrd),
 		Entry("for VirtualMachineClusterInstancetype", NewVirtualMachineClusterInstancetypeCrd),
@@ -302,6 +308,44 @@ var _ = Describe("CRDs", func() {
 			},
 			"VirtualMachine", "test-vm", "false", timestamp,
 		),
+		Entry("for VirtualMachineSnapshotSchedule", NewVirtualMachineSnapshotScheduleCrd,
+			snapshotv1beta1.VirtualMachineSnapshotSchedule{
+				Spec: snapshotv1beta1.VirtualMachineSnapshotScheduleSpec{
+					Schedule: "0 2 * * *",
+					Suspend:  true,
+				},
+				Status: &snapshotv1beta1.VirtualMachineSnapshotScheduleStatus{
+					LastSuccessTime:  pointer.P(createTime()),
+					LastScheduleTime: pointer.P(createTime()),
+				},
+			},
+			"0 2 * * *", "true", timestamp, timestamp,
+		),
+		Entry("for VirtualMachineSnapshotGroup", NewVirtualMachineSnapshotGroupCrd,
+			snapshotv1beta1.VirtualMachineSnapshotGroup{
+				Status: &snapshotv1beta1.VirtualMachineSnapshotGroupStatus{
+					Phase:        snapshotv1beta1.Succeeded,
+					ReadyToUse:   pointer.P(true),
+					CreationTime: pointer.P(createTime()),
+					Error: &snapshotv1beta1.Error{
+						Message: pointer.P("no error"),
+					},
+				},
+			},
+			"Succeeded", "true", timestamp, "no error",
+		),
+		Entry("for VirtualMachineSnapshotGroupRestore", NewVirtualMachineSnapshotGroupRestoreCrd,
+			snapshotv1beta1.VirtualMachineSnapshotGroupRestore{
+				Spec: snapshotv1beta1.VirtualMachineSnapshotGroupRestoreSpec{
+					VirtualMachineSnapshotGroupName: "test-group",
+				},
+				Status: &snapshotv1beta1.VirtualMachineSnapshotGroupRestoreStatus{
+					Complete:    pointer.P(false),
+					RestoreTime: pointer.P(createTime()),
+				},
+			},
+			"test-group", "false", timestamp,
+		),
 		Entry("for VirtualMachineExport", NewVirtualMachineExportCrd,
 			exportv1beta1.VirtualMachineExport{
 				Spec: exportv1beta1.VirtualMachineExportSpec{
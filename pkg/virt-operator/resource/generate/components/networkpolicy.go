@@ -39,6 +39,7 @@ const (
 	allowExportProxyCommunications    = "kubevirt-allow-virt-exportproxy-communications"
 	allowHandlerToHandler             = "kubevirt-allow-handler-to-handler"
 	allowHandlerToPrometheus          = "kubevirt-allow-handler-to-prometheus"
+	allowIngressToHandlerBackupNBD    = "kubevirt-allow-ingress-to-virt-handler-backup-nbd"
 )
 
 // NewKubeVirtNetworkPolicies returns the network policies required by kv to operate
@@ -54,6 +55,7 @@ func NewKubeVirtNetworkPolicies(namespace string) []*networkv1.NetworkPolicy {
 		newExportProxyNP(namespace),
 		newHandlerToHandlerNP(namespace),
 		newHandlerToPrometheusNP(namespace),
+		newHandlerBackupNBDNP(namespace),
 	}
 }
 
@@ -380,3 +382,26 @@ func newHandlerToPrometheusNP(namespace string) *networkv1.NetworkPolicy {
 		},
 	)
 }
+
+func newHandlerBackupNBDNP(namespace string) *networkv1.NetworkPolicy {
+	return newNetworkPolicy(
+		namespace,
+		allowIngressToHandlerBackupNBD,
+		&networkv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{kubevirtLabelKey: VirtHandlerName},
+			},
+			PolicyTypes: []networkv1.PolicyType{networkv1.PolicyTypeIngress},
+			Ingress: []networkv1.NetworkPolicyIngressRule{
+				{
+					Ports: []networkv1.NetworkPolicyPort{
+						{
+							Port:     pointer.P(intstr.FromInt32(10809)),
+							Protocol: pointer.P(k8sv1.ProtocolTCP),
+						},
+					},
+				},
+			},
+		},
+	)
+}
@@ -111,6 +111,7 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 				Verbs: []string{
 					"get",
 					"list",
+					"delete",
 				},
 			},
 			{
@@ -207,6 +208,17 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"snapshot.storage.k8s.io",
+				},
+				Resources: []string{
+					"volumesnapshots",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 			{
 				APIGroups: []string{
 					"backup.kubevirt.io",
@@ -299,6 +311,17 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					"nodes",
 				},
+				Verbs: []string{
+					"get", "list",
+				},
+			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"persistentvolumes",
+				},
 				Verbs: []string{
 					"get",
 				},
@@ -40,35 +40,42 @@ const (
 	defaultClusterRoleName          = "kubevirt.io:default"
 	instancetypeViewClusterRoleName = "instancetype.kubevirt.io:view"
 
-	apiVersion            = "version"
-	apiGuestFs            = "guestfs"
-	apiExpandVmSpec       = "expand-vm-spec"
-	apiKubevirts          = "kubevirts"
-	apiVM                 = "virtualmachines"
-	apiVMInstances        = "virtualmachineinstances"
-	apiVMIPresets         = "virtualmachineinstancepresets"
-	apiVMIReplicasets     = "virtualmachineinstancereplicasets"
-	apiVMIMigrations      = "virtualmachineinstancemigrations"
-	apiVMSnapshots        = "virtualmachinesnapshots"
-	apiVMSnapshotContents = "virtualmachinesnapshotcontents"
-	apiVMBackups          = "virtualmachinebackups"
-	apiVMBackupTrackers   = "virtualmachinebackuptrackers"
-	apiVMRestores         = "virtualmachinerestores"
-	apiVMExports          = "virtualmachineexports"
-	apiVMClones           = "virtualmachineclones"
-	apiVMPools            = "virtualmachinepools"
+	apiVersion             = "version"
+	apiGuestFs             = "guestfs"
+	apiExpandVmSpec        = "expand-vm-spec"
+	apiKubevirts           = "kubevirts"
+	apiVM                  = "virtualmachines"
+	apiVMInstances         = "virtualmachineinstances"
+	apiVMIPresets          = "virtualmachineinstancepresets"
+	apiVMIReplicasets      = "virtualmachineinstancereplicasets"
+	apiVMIMigrations       = "virtualmachineinstancemigrations"
+	apiVMSnapshots         = "virtualmachinesnapshots"
+	apiVMSnapshotContents  = "virtualmachinesnapshotcontents"
+	apiVMSnapshotSchedules = "virtualmachinesnapshotschedules"
+	apiVMSnapshotGroups    = "virtualmachinesnapshotgroups"
+	apiVMGroupRestores     = "virtualmachinesnapshotgrouprestores"
+	apiVMBackups           = "virtualmachinebackups"
+	apiVMBackupTrackers    = "virtualmachinebackuptrackers"
+	apiVMBackupSchedules   = "virtualmachinebackupschedules"
+	apiVMBackupRestores    = "virtualmachinebackuprestores"
+	apiVMRestores          = "virtualmachinerestores"
+	apiVMExports           = "virtualmachineexports"
+	apiVMClones            = "virtualmachineclones"
+	apiVMPools             = "virtualmachinepools"
 
-	apiVMExpandSpec     = "virtualmachines/expand-spec"
-	apiVMPortForward    = "virtualmachines/portforward"
-	apiVMStart          = "virtualmachines/start"
-	apiVMStop           = "virtualmachines/stop"
-	apiVMRestart        = "virtualmachines/restart"
-	apiVMAddVolume      = "virtualmachines/addvolume"
-	apiVMRemoveVolume   = "virtualmachines/removevolume"
-	apiVMMigrate        = "virtualmachines/migrate"
-	apiVMMemoryDump     = "virtualmachines/memorydump"
-	apiVMObjectGraph    = "virtualmachines/objectgraph"
-	apiVMEvacuateCancel = "virtualmachines/evacuate/cancel"
+	apiVMExpandSpec         = "virtualmachines/expand-spec"
+	apiVMPortForward        = "virtualmachines/portforward"
+	apiVMStart              = "virtualmachines/start"
+	apiVMStop               = "virtualmachines/stop"
+	apiVMRestart            = "virtualmachines/restart"
+	apiVMAddVolume          = "virtualmachines/addvolume"
+	apiVMRemoveVolume       = "virtualmachines/removevolume"
+	apiVMMigrate            = "virtualmachines/migrate"
+	apiVMMigrateCheck       = "virtualmachines/migrate-check"
+	apiVMMemoryDump         = "virtualmachines/memorydump"
+	apiVMObjectGraph        = "virtualmachines/objectgraph"
+	apiVMEvacuateCancel     = "virtualmachines/evacuate/cancel"
+	apiVMResetContainerDisk = "virtualmachines/resetcontainerdisk"
 
 	apiVMInstancesConsole                   = "virtualmachineinstances/console"
 	apiVMInstancesVNC                       = "virtualmachineinstances/vnc"
@@ -85,6 +92,7 @@ const (
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
 	apiVMInstancesUserList                  = "virtualmachineinstances/userlist"
+	apiVMInstancesChangedBlocks             = "virtualmachineinstances/changedblocks"
 	apiVMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	apiVMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
 	apiVMInstancesSEVSetupSession           = "virtualmachineinstances/sev/setupsession"
@@ -92,6 +100,8 @@ const (
 	apiVMInstancesUSBRedir                  = "virtualmachineinstances/usbredir"
 	apiVMInstancesObjectGraph               = "virtualmachineinstances/objectgraph"
 	apiVMInstancesEvacuateCancel            = "virtualmachineinstances/evacuate/cancel"
+
+	apiVMSnapshotsGuestfs = "virtualmachinesnapshots/guestfs"
 )
 
 func GetAllCluster() []runtime.Object {
@@ -207,6 +217,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesChangedBlocks,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
@@ -245,6 +256,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMExpandSpec,
 					apiVMPortForward,
+					apiVMSnapshotsGuestfs,
 				},
 				Verbs: []string{
 					"get",
@@ -262,6 +274,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
 					apiVMEvacuateCancel,
+					apiVMResetContainerDisk,
 				},
 				Verbs: []string{
 					"update",
@@ -310,7 +323,10 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMSnapshots,
 					apiVMSnapshotContents,
+					apiVMSnapshotSchedules,
+					apiVMSnapshotGroups,
 					apiVMRestores,
+					apiVMGroupRestores,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -322,6 +338,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					apiVMBackups,
+					apiVMBackupSchedules,
+					apiVMBackupRestores,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch", "deletecollection",
@@ -427,6 +445,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
 					apiVMInstancesUserList,
+					apiVMInstancesChangedBlocks,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
@@ -465,6 +484,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMExpandSpec,
 					apiVMPortForward,
+					apiVMSnapshotsGuestfs,
 				},
 				Verbs: []string{
 					"get",
@@ -482,6 +502,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
 					apiVMEvacuateCancel,
+					apiVMResetContainerDisk,
 				},
 				Verbs: []string{
 					"update",
@@ -530,7 +551,10 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMSnapshots,
 					apiVMSnapshotContents,
+					apiVMSnapshotSchedules,
+					apiVMSnapshotGroups,
 					apiVMRestores,
+					apiVMGroupRestores,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -542,6 +566,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					apiVMBackups,
+					apiVMBackupSchedules,
+					apiVMBackupRestores,
 				},
 				Verbs: []string{
 					"get", "delete", "create", "update", "patch", "list", "watch",
@@ -639,6 +665,7 @@ func newMigrateClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					apiVMMigrate,
+					apiVMMigrateCheck,
 				},
 				Verbs: []string{
 					"update",
@@ -735,7 +762,10 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMSnapshots,
 					apiVMSnapshotContents,
+					apiVMSnapshotSchedules,
+					apiVMSnapshotGroups,
 					apiVMRestores,
+					apiVMGroupRestores,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -747,6 +777,8 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					apiVMBackups,
+					apiVMBackupSchedules,
+					apiVMBackupRestores,
 				},
 				Verbs: []string{
 					"get", "list", "watch",
@@ -44,6 +44,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/migrations"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
@@ -107,6 +108,18 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 
 	}
 
+	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.MigrationConfiguration, newKV.Spec.Configuration.MigrationConfiguration) &&
+		newKV.Spec.Configuration.MigrationConfiguration != nil {
+		migrationConfiguration := newKV.Spec.Configuration.MigrationConfiguration
+		results = append(results,
+			migrations.ValidateCompression(field.NewPath("spec").Child("configuration", "migrations", "compression"), migrationConfiguration.Compression, migrationConfiguration.AllowPostCopy)...)
+	}
+
+	if !equality.Semantic.DeepEqual(currKV.Spec.WorkloadUpdateStrategy.MaintenanceWindows, newKV.Spec.WorkloadUpdateStrategy.MaintenanceWindows) {
+		results = append(results,
+			migrations.ValidateMaintenanceWindows(field.NewPath("spec").Child("workloadUpdateStrategy", "maintenanceWindows"), newKV.Spec.WorkloadUpdateStrategy.MaintenanceWindows)...)
+	}
+
 	if newKV.Spec.Infra != nil {
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
 	}
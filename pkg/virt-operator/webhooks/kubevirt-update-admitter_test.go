@@ -164,6 +164,69 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		)
 	})
 
+	Context("with migration compression", func() {
+		DescribeTable("should reject", func(migrationConfiguration *v1.MigrationConfiguration, expectedField string) {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			admitter := NewKubeVirtUpdateAdmitter(nil, clusterConfig)
+
+			oldKV := v1.KubeVirt{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
+			newKV := oldKV.DeepCopy()
+			newKV.Spec.Configuration.MigrationConfiguration = migrationConfiguration
+			oldBytes, err := json.Marshal(oldKV)
+			Expect(err).ToNot(HaveOccurred())
+			newBytes, err := json.Marshal(newKV)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := admitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource:  KubeVirtGroupVersionResource,
+					Object:    runtime.RawExtension{Raw: newBytes},
+					OldObject: runtime.RawExtension{Raw: oldBytes},
+					Operation: admissionv1.Update,
+				},
+			})
+			Expect(response.Allowed).To(BeFalse())
+			Expect(response.Result.Details.Causes).To(ConsistOf(HaveField("Field", expectedField)))
+		},
+			Entry("an invalid compression level", &v1.MigrationConfiguration{
+				Compression: &v1.MigrationCompression{Method: v1.MigrationCompressionZstd, Level: pointer.P(int32(21))},
+			}, "spec.configuration.migrations.compression.level"),
+			Entry("a parallel compression together with post-copy", &v1.MigrationConfiguration{
+				AllowPostCopy: pointer.P(true),
+				Compression:   &v1.MigrationCompression{Method: v1.MigrationCompressionZstd},
+			}, "spec.configuration.migrations.compression.method"),
+		)
+	})
+
+	Context("with workload update maintenance windows", func() {
+		It("should reject an invalid schedule", func() {
+			clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{})
+			admitter := NewKubeVirtUpdateAdmitter(nil, clusterConfig)
+
+			oldKV := v1.KubeVirt{ObjectMeta: metav1.ObjectMeta{Name: "test"}}
+			newKV := oldKV.DeepCopy()
+			newKV.Spec.WorkloadUpdateStrategy.MaintenanceWindows = []v1.MaintenanceWindow{
+				{Schedule: "* 0-5 * * *", TimeZone: pointer.P("Europe/Paris")},
+				{Schedule: "* 0-5 * *"},
+			}
+			oldBytes, err := json.Marshal(oldKV)
+			Expect(err).ToNot(HaveOccurred())
+			newBytes, err := json.Marshal(newKV)
+			Expect(err).ToNot(HaveOccurred())
+
+			response := admitter.Admit(context.Background(), &admissionv1.AdmissionReview{
+				Request: &admissionv1.AdmissionRequest{
+					Resource:  KubeVirtGroupVersionResource,
+					Object:    runtime.RawExtension{Raw: newBytes},
+					OldObject: runtime.RawExtension{Raw: oldBytes},
+					Operation: admissionv1.Update,
+				},
+			})
+			Expect(response.Allowed).To(BeFalse())
+			Expect(response.Result.Details.Causes).To(ConsistOf(HaveField("Field", "spec.workloadUpdateStrategy.maintenanceWindows[1]")))
+		})
+	})
+
 	Context("deprecations", func() {
 		var admitter *KubeVirtUpdateAdmitter
 
@@ -26,6 +26,7 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
@@ -40,6 +42,7 @@ import (
 
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/console"
@@ -66,6 +69,13 @@ const (
 	timeout           = 500 * time.Second
 )
 
+const (
+	// vmSnapshotPrefix selects a VirtualMachineSnapshot instead of a PVC as the guestfs target
+	vmSnapshotPrefix = "vmsnapshot/"
+	// snapshotLabel marks the temporary PVCs restored from a VirtualMachineSnapshot
+	snapshotLabel = "guestfs.kubevirt.io/vmsnapshot"
+)
+
 type guestfsCommand struct {
 	pvc        string
 	image      string
@@ -76,6 +86,17 @@ type guestfsCommand struct {
 	gid        string
 	pullPolicy string
 	vm         string
+	vmSnapshot string
+}
+
+// disk is a PVC attached to the libguestfs pod
+type disk struct {
+	volumeName string
+	claimName  string
+	isBlock    bool
+	readOnly   bool
+	// path is the device path for block PVCs and the mount directory otherwise
+	path string
 }
 
 // Following variables allow overriding the default functions (useful for unit testing)
@@ -83,6 +104,7 @@ var CreateClientFunc = CreateClient
 var CreateAttacherFunc = CreateAttacher
 var ImageSetFunc = SetImage
 var ImageInfoGetFunc = GetImageInfo
+var SnapshotVolumesGetFunc = GetSnapshotVolumes
 
 // NewGuestfsShellCommand returns a cobra.Command for starting libguestfs-tool pod and attach it to a pvc
 func NewGuestfsShellCommand() *cobra.Command {
@@ -90,7 +112,7 @@ func NewGuestfsShellCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "guestfs",
 		Short:   "Start a shell into the libguestfs pod",
-		Long:    `Create a pod with libguestfs-tools, mount the pvc and attach a shell to it. The pvc is mounted under the /disks directory inside the pod for filesystem-based pvcs, or as /dev/vda for block-based pvcs`,
+		Long:    `Create a pod with libguestfs-tools, mount the pvc and attach a shell to it. The pvc is mounted under the /disks directory inside the pod for filesystem-based pvcs, or as /dev/vda for block-based pvcs. With vmsnapshot/<name>, the volumes of the VirtualMachineSnapshot are restored to temporary pvcs, attached read-only and deleted on exit`,
 		Args:    cobra.ExactArgs(1),
 		Example: usage(),
 		RunE:    c.run,
@@ -110,12 +132,19 @@ func NewGuestfsShellCommand() *cobra.Command {
 
 func usage() string {
 	usage := `  # Create a pod with libguestfs-tools, mount the pvc and attach a shell to it:
-  {{ProgramName}} guestfs <pvc-name>`
+  {{ProgramName}} guestfs <pvc-name>
+
+  # Restore the volumes of a VirtualMachineSnapshot to temporary pvcs and attach them read-only:
+  {{ProgramName}} guestfs vmsnapshot/<snapshot-name>`
 	return usage
 }
 
 func (c *guestfsCommand) run(cmd *cobra.Command, args []string) error {
-	c.pvc = args[0]
+	if name, found := strings.CutPrefix(args[0], vmSnapshotPrefix); found {
+		c.vmSnapshot = name
+	} else {
+		c.pvc = args[0]
+	}
 
 	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
 	if err != nil {
@@ -139,6 +168,9 @@ func (c *guestfsCommand) run(cmd *cobra.Command, args []string) error {
 		}
 	}
 	fmt.Printf("Use image: %s \n", c.image)
+	if c.vmSnapshot != "" {
+		return c.runWithSnapshot(client, namespace)
+	}
 	exist, _ := client.existsPVC(c.pvc, namespace)
 	if !exist {
 		return fmt.Errorf("The PVC %s doesn't exist", c.pvc)
@@ -154,8 +186,78 @@ func (c *guestfsCommand) run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return err
 	}
-	defer client.removePod(namespace, genPodName(c.pvc))
-	return c.createInteractivePodWithPVC(client, namespace, "/entrypoint.sh", []string{}, isBlock)
+	d := disk{
+		volumeName: volume,
+		claimName:  c.pvc,
+		isBlock:    isBlock,
+		path:       diskDir,
+	}
+	if isBlock {
+		d.path = diskPath
+	}
+	podName := genPodName(c.pvc)
+	defer client.removePod(namespace, podName)
+	return c.createInteractivePodWithPVC(client, namespace, podName, "/entrypoint.sh", []string{}, []disk{d})
+}
+
+// runWithSnapshot restores the volumes of the VirtualMachineSnapshot to temporary PVCs,
+// attaches them read-only to the libguestfs pod and deletes them on exit.
+// The PVCs are owned by the pod, so that they are garbage collected with it
+// if virtctl exits without cleaning up.
+func (c *guestfsCommand) runWithSnapshot(client *K8sClient, namespace string) error {
+	info, err := SnapshotVolumesGetFunc(client.VirtClient, namespace, c.vmSnapshot)
+	if err != nil {
+		return fmt.Errorf("could not get the volumes of VirtualMachineSnapshot %s: %v", c.vmSnapshot, err)
+	}
+	if len(info.Volumes) == 0 {
+		return fmt.Errorf("The VirtualMachineSnapshot %s has no volumes to inspect", c.vmSnapshot)
+	}
+
+	var disks []disk
+	defer func() {
+		for _, d := range disks {
+			client.removePVC(namespace, d.claimName)
+		}
+	}()
+	for i, v := range info.Volumes {
+		pvc := v.PersistentVolumeClaim.DeepCopy()
+		if pvc.Labels == nil {
+			pvc.Labels = map[string]string{}
+		}
+		pvc.Labels[snapshotLabel] = c.vmSnapshot
+		pvc, err := client.Client.CoreV1().PersistentVolumeClaims(namespace).Create(context.TODO(), pvc, metav1.CreateOptions{})
+		if err != nil {
+			return err
+		}
+
+		d := disk{
+			volumeName: fmt.Sprintf("%s-%d", volume, i),
+			claimName:  pvc.Name,
+			readOnly:   true,
+			path:       fmt.Sprintf("%s/%s", diskDir, v.VolumeName),
+		}
+		if pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1.PersistentVolumeBlock {
+			d.isBlock = true
+			d.path = fmt.Sprintf("/dev/vd%c", 'a'+i)
+		}
+		disks = append(disks, d)
+	}
+
+	podName := genPodName(c.vmSnapshot)
+	defer client.removePod(namespace, podName)
+	pod, err := c.createLibguestfsPod(client, namespace, podName, "/entrypoint.sh", []string{}, disks)
+	if err != nil {
+		return err
+	}
+	for _, d := range disks {
+		if err := client.setPVCOwner(namespace, d.claimName, pod); err != nil {
+			return err
+		}
+	}
+	if err := client.waitForContainerRunning(podName, namespace, timeout); err != nil {
+		return err
+	}
+	return CreateAttacherFunc(client, pod, "/entrypoint.sh")
 }
 
 // K8sClient holds the information of the Kubernetes client
@@ -206,6 +308,11 @@ func GetImageInfo(virtClient kubecli.KubevirtClient) (*kubecli.GuestfsInfo, erro
 	return info, nil
 }
 
+// GetSnapshotVolumes gets the PVCs restoring the volumes of a VirtualMachineSnapshot
+func GetSnapshotVolumes(virtClient kubecli.KubevirtClient, namespace, name string) (*kubecli.GuestfsSnapshotInfo, error) {
+	return virtClient.GuestfsVersion().SnapshotVolumes(namespace, name)
+}
+
 func CreateClient(virtClient kubecli.KubevirtClient) (*K8sClient, error) {
 	client, err := kubernetes.NewForConfig(virtClient.Config())
 	if err != nil {
@@ -384,7 +491,7 @@ func (c *guestfsCommand) setGIDLibguestfs() (*int64, error) {
 	return nil, nil
 }
 
-func (c *guestfsCommand) createLibguestfsPod(client *K8sClient, ns, cmd string, args []string, isBlock bool) (*corev1.Pod, error) {
+func (c *guestfsCommand) createLibguestfsPod(client *K8sClient, ns, podName, cmd string, args []string, disks []disk) (*corev1.Pod, error) {
 	var (
 		resources    corev1.ResourceRequirements
 		tolerations  []corev1.Toleration
@@ -439,21 +546,12 @@ func (c *guestfsCommand) createLibguestfsPod(client *K8sClient, ns, cmd string,
 	}
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   genPodName(c.pvc),
+			Name:   podName,
 			Labels: labels,
 		},
 		Spec: corev1.PodSpec{
 			SecurityContext: securityContext,
 			Volumes: []corev1.Volume{
-				{
-					Name: volume,
-					VolumeSource: corev1.VolumeSource{
-						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
-							ClaimName: c.pvc,
-							ReadOnly:  false,
-						},
-					},
-				},
 				// Use emptyDir to store temporary files generated by libguestfs
 				{
 					Name: tmpDirVolumeName,
@@ -521,22 +619,32 @@ func (c *guestfsCommand) createLibguestfsPod(client *K8sClient, ns, cmd string,
 			NodeSelector:  nodeSelector,
 		},
 	}
-	if isBlock {
-		pod.Spec.Containers[0].VolumeDevices = append(pod.Spec.Containers[0].VolumeDevices, corev1.VolumeDevice{
-			Name:       volume,
-			DevicePath: diskPath,
-		})
-		fmt.Printf("The PVC has been mounted at %s \n", diskPath)
-	} else {
-		// PVC volume mode is filesystem
-		pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
-			Name:      volume,
-			ReadOnly:  false,
-			MountPath: diskDir,
+	for _, d := range disks {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: d.volumeName,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: d.claimName,
+					ReadOnly:  d.readOnly,
+				},
+			},
 		})
-
-		pod.Spec.Containers[0].WorkingDir = diskDir
-		fmt.Printf("The PVC has been mounted at %s \n", diskDir)
+		if d.isBlock {
+			pod.Spec.Containers[0].VolumeDevices = append(pod.Spec.Containers[0].VolumeDevices, corev1.VolumeDevice{
+				Name:       d.volumeName,
+				DevicePath: d.path,
+			})
+		} else {
+			// PVC volume mode is filesystem
+			pod.Spec.Containers[0].VolumeMounts = append(pod.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+				Name:      d.volumeName,
+				ReadOnly:  d.readOnly,
+				MountPath: d.path,
+			})
+
+			pod.Spec.Containers[0].WorkingDir = diskDir
+		}
+		fmt.Printf("The PVC %s has been mounted at %s \n", d.claimName, d.path)
 	}
 
 	p, err := client.Client.CoreV1().Pods(ns).Create(context.TODO(), pod, metav1.CreateOptions{})
@@ -583,12 +691,12 @@ func CreateAttacher(client *K8sClient, p *corev1.Pod, command string) error {
 		"If you don't see a command prompt, try pressing enter.", resChan)
 }
 
-func (c *guestfsCommand) createInteractivePodWithPVC(client *K8sClient, ns, command string, args []string, isblock bool) error {
-	pod, err := c.createLibguestfsPod(client, ns, command, args, isblock)
+func (c *guestfsCommand) createInteractivePodWithPVC(client *K8sClient, ns, podName, command string, args []string, disks []disk) error {
+	pod, err := c.createLibguestfsPod(client, ns, podName, command, args, disks)
 	if err != nil {
 		return err
 	}
-	err = client.waitForContainerRunning(genPodName(c.pvc), ns, timeout)
+	err = client.waitForContainerRunning(podName, ns, timeout)
 	if err != nil {
 		return err
 	}
@@ -599,6 +707,23 @@ func (client *K8sClient) removePod(ns, podName string) error {
 	return client.Client.CoreV1().Pods(ns).Delete(context.TODO(), podName, metav1.DeleteOptions{})
 }
 
+func (client *K8sClient) removePVC(ns, pvcName string) error {
+	return client.Client.CoreV1().PersistentVolumeClaims(ns).Delete(context.TODO(), pvcName, metav1.DeleteOptions{})
+}
+
+// setPVCOwner makes the pod the owner of the PVC
+func (client *K8sClient) setPVCOwner(ns, pvcName string, pod *corev1.Pod) error {
+	ownerReferences := []metav1.OwnerReference{
+		*metav1.NewControllerRef(pod, corev1.SchemeGroupVersion.WithKind("Pod")),
+	}
+	patchBytes, err := patch.New(patch.WithAdd("/metadata/ownerReferences", ownerReferences)).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	_, err = client.Client.CoreV1().PersistentVolumeClaims(ns).Patch(context.TODO(), pvcName, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
 func genPodName(pvc string) string {
 	return fmt.Sprintf("%s-%s", podNamePrefix, pvc)
 }
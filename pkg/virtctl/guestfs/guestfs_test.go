@@ -206,6 +206,123 @@ var _ = Describe("Guestfs shell", func() {
 		})
 	})
 
+	Context("attach to VirtualMachineSnapshot", func() {
+		const snapshotName = "test-snapshot"
+
+		var (
+			createdPVCs []string
+			ownedPVCs   []string
+			deletedPVCs []string
+		)
+
+		fakeGetSnapshotVolumes := func(_ kubecli.KubevirtClient, namespace, name string) (*kubecli.GuestfsSnapshotInfo, error) {
+			Expect(namespace).To(Equal(testNamespace))
+			Expect(name).To(Equal(snapshotName))
+			blockMode := v1.PersistentVolumeBlock
+			return &kubecli.GuestfsSnapshotInfo{
+				Volumes: []kubecli.GuestfsSnapshotVolume{
+					{
+						VolumeName: "rootdisk",
+						PersistentVolumeClaim: v1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{GenerateName: "guestfs-test-snapshot-rootdisk-", Namespace: testNamespace},
+							Spec:       v1.PersistentVolumeClaimSpec{VolumeMode: &mode},
+						},
+					},
+					{
+						VolumeName: "datadisk",
+						PersistentVolumeClaim: v1.PersistentVolumeClaim{
+							ObjectMeta: metav1.ObjectMeta{GenerateName: "guestfs-test-snapshot-datadisk-", Namespace: testNamespace},
+							Spec:       v1.PersistentVolumeClaimSpec{VolumeMode: &blockMode},
+						},
+					},
+				},
+			}, nil
+		}
+
+		fakeCreateClientSnapshot := func(_ kubecli.KubevirtClient) (*guestfs.K8sClient, error) {
+			kubeClient = fake.NewSimpleClientset()
+			kubeClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				claim := action.(k8stesting.CreateAction).GetObject().(*v1.PersistentVolumeClaim)
+				Expect(claim.Labels).To(HaveKeyWithValue("guestfs.kubevirt.io/vmsnapshot", snapshotName))
+				// the fake client does not generate names
+				claim.Name = claim.GenerateName + "abcde"
+				createdPVCs = append(createdPVCs, claim.Name)
+				return false, nil, nil
+			})
+			kubeClient.Fake.PrependReactor("patch", "persistentvolumeclaims", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				patched := action.(k8stesting.PatchAction)
+				Expect(string(patched.GetPatch())).To(ContainSubstring(`"kind":"Pod","name":"libguestfs-tools-` + snapshotName + `"`))
+				ownedPVCs = append(ownedPVCs, patched.GetName())
+				return false, nil, nil
+			})
+			kubeClient.Fake.PrependReactor("delete", "persistentvolumeclaims", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				deletedPVCs = append(deletedPVCs, action.(k8stesting.DeleteAction).GetName())
+				return false, nil, nil
+			})
+			kubeClient.Fake.PrependReactor("create", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				libguestfsPod = action.(k8stesting.CreateAction).GetObject().(*v1.Pod)
+				libguestfsPod.Status.Phase = v1.PodRunning
+				return false, libguestfsPod, nil
+			})
+			return &guestfs.K8sClient{Client: kubeClient, VirtClient: kubevirtClient}, nil
+		}
+
+		BeforeEach(func() {
+			createdPVCs = nil
+			ownedPVCs = nil
+			deletedPVCs = nil
+			guestfs.ImageSetFunc = fakeSetImage
+			guestfs.CreateAttacherFunc = fakeAttacherCreator
+			guestfs.SnapshotVolumesGetFunc = fakeGetSnapshotVolumes
+			guestfs.CreateClientFunc = fakeCreateClientSnapshot
+		})
+
+		AfterEach(func() {
+			guestfs.ImageSetFunc = guestfs.SetImage
+			guestfs.CreateAttacherFunc = guestfs.CreateAttacher
+			guestfs.CreateClientFunc = guestfs.CreateClient
+			guestfs.SnapshotVolumesGetFunc = guestfs.GetSnapshotVolumes
+		})
+
+		It("should attach the restored PVCs read-only, owned by the pod, and delete them on exit", func() {
+			Expect(testing.NewRepeatableVirtctlCommand(commandName, "vmsnapshot/"+snapshotName)()).To(Succeed())
+
+			Expect(createdPVCs).To(ConsistOf("guestfs-test-snapshot-rootdisk-abcde", "guestfs-test-snapshot-datadisk-abcde"))
+			Expect(ownedPVCs).To(ConsistOf(createdPVCs))
+			Expect(deletedPVCs).To(ConsistOf(createdPVCs))
+
+			Expect(libguestfsPod.Name).To(Equal("libguestfs-tools-" + snapshotName))
+			var claims []string
+			for _, volume := range libguestfsPod.Spec.Volumes {
+				if volume.PersistentVolumeClaim != nil {
+					Expect(volume.PersistentVolumeClaim.ReadOnly).To(BeTrue())
+					claims = append(claims, volume.PersistentVolumeClaim.ClaimName)
+				}
+			}
+			Expect(claims).To(ConsistOf(createdPVCs))
+
+			container := libguestfsPod.Spec.Containers[0]
+			Expect(container.VolumeMounts).To(ContainElement(v1.VolumeMount{
+				Name:      "volume-0",
+				ReadOnly:  true,
+				MountPath: "/disk/rootdisk",
+			}))
+			Expect(container.VolumeDevices).To(ConsistOf(v1.VolumeDevice{
+				Name:       "volume-1",
+				DevicePath: "/dev/vdb",
+			}))
+		})
+
+		It("should fail when the snapshot volumes cannot be retrieved", func() {
+			guestfs.SnapshotVolumesGetFunc = func(_ kubecli.KubevirtClient, _, _ string) (*kubecli.GuestfsSnapshotInfo, error) {
+				return nil, fmt.Errorf("snapshot is not ready to use")
+			}
+			err := testing.NewRepeatableVirtctlCommand(commandName, "vmsnapshot/"+snapshotName)()
+			Expect(err).To(MatchError(ContainSubstring("snapshot is not ready to use")))
+			Expect(createdPVCs).To(BeEmpty())
+		})
+	})
+
 	Context("URL authenticity", func() {
 		fakeGetImageInfoNoCustomURL := func(virtClient kubecli.KubevirtClient) (*kubecli.GuestfsInfo, error) {
 			info := &kubecli.GuestfsInfo{
@@ -51,6 +51,8 @@ const (
 	FormatFlag       = "format"
 	LocalPortFlag    = "local-port"
 	OutputFileFlag   = "output"
+	DumpFormatFlag   = "dump-format"
+	DeleteClaimFlag  = "delete-claim"
 
 	configName         = "config"
 	filesystemOverhead = v1.Percent("0.055")
@@ -69,6 +71,8 @@ var (
 	storageClass string
 	accessMode   string
 	outputFile   string
+	dumpFormat   string
+	deleteClaim  bool
 )
 
 type command struct{}
@@ -86,6 +90,9 @@ func usageMemoryDump() string {
   #Create and download memory dump to the given output file.
   {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --create-claim --output=memoryDump.dump.gz
 
+  #Dump memory in the kdump format with zlib compressed pages, download it decompressed and delete the pvc afterwards.
+  {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --create-claim --dump-format=kdump-zlib --output=memoryDump.kdump --format=raw --delete-claim
+
   #Dump memory again to the same virtual machine with an already associated pvc(existing memory dump on vm status).
   {{ProgramName}} memory-dump get myvm
 
@@ -117,6 +124,8 @@ func NewMemoryDumpCommand() *cobra.Command {
 	cmd.Flags().StringVar(&storageClass, StorageClassFlag, "", "The storage class for the PVC.")
 	cmd.Flags().StringVar(&accessMode, AccessModeFlag, "", "The access mode for the PVC.")
 	cmd.Flags().StringVar(&outputFile, OutputFileFlag, "", "Specifies the output path of the memory dump to be downloaded.")
+	cmd.Flags().StringVar(&dumpFormat, DumpFormatFlag, "", "Specifies the format the memory is dumped in (elf, kdump-zlib, kdump-lzo or kdump-snappy). Defaults to elf.")
+	cmd.Flags().BoolVar(&deleteClaim, DeleteClaimFlag, false, "Remove the memory dump association and delete the pvc once the memory dump is downloaded.")
 
 	return cmd
 }
@@ -265,6 +274,7 @@ func createPVCforMemoryDump(namespace, vmName, claimName string, virtClient kube
 func createMemoryDump(namespace, vmName, claimName string, virtClient kubecli.KubevirtClient) error {
 	memoryDumpRequest := &v1.VirtualMachineMemoryDumpRequest{
 		ClaimName: claimName,
+		Format:    v1.MemoryDumpFormat(dumpFormat),
 	}
 
 	err := virtClient.VirtualMachine(namespace).MemoryDump(context.Background(), vmName, memoryDumpRequest)
@@ -346,7 +356,27 @@ func downloadMemoryDump(namespace, vmName string, virtClient kubecli.KubevirtCli
 		return err
 	}
 	vmExportInfo.OutputWriter = output
-	return vmexport.DownloadVirtualMachineExport(virtClient, vmExportInfo)
+	if err := vmexport.DownloadVirtualMachineExport(virtClient, vmExportInfo); err != nil {
+		return err
+	}
+
+	if deleteClaim {
+		return deleteMemoryDumpClaim(namespace, vmName, claimName, virtClient)
+	}
+	return nil
+}
+
+// deleteMemoryDumpClaim dissociates the downloaded memory dump from the vm and deletes its pvc
+func deleteMemoryDumpClaim(namespace, vmName, claimName string, virtClient kubecli.KubevirtClient) error {
+	if err := removeMemoryDump(namespace, vmName, virtClient); err != nil {
+		return err
+	}
+	err := virtClient.CoreV1().PersistentVolumeClaims(namespace).Delete(context.Background(), claimName, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return fmt.Errorf("error deleting memory dump pvc %s/%s, %v", namespace, claimName, err)
+	}
+	fmt.Printf("PVC %s/%s deleted\n", namespace, claimName)
+	return nil
 }
 
 func WaitForMemoryDumpComplete(virtClient kubecli.KubevirtClient, namespace, vmName string, interval, timeout time.Duration) (string, error) {
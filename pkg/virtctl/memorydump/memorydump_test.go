@@ -37,6 +37,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -166,6 +167,21 @@ var _ = Describe("MemoryDump", func() {
 		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(HaveLen(1))
 	})
 
+	It("should call memory dump subresource with dump format", func() {
+		virtClient.PrependReactor("put", "virtualmachines/memorydump", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+			putAction, ok := action.(kvtesting.PutAction[*v1.VirtualMachineMemoryDumpRequest])
+			Expect(ok).To(BeTrue())
+			Expect(putAction.GetOptions().Format).To(Equal(v1.MemoryDumpFormatKdumpSnappy))
+			return true, nil, nil
+		})
+		err := runGetCmd(
+			setFlag(memorydump.ClaimNameFlag, pvcName),
+			setFlag(memorydump.DumpFormatFlag, string(v1.MemoryDumpFormatKdumpSnappy)),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(HaveLen(1))
+	})
+
 	It("should call memory dump subresource without claim-name no create", func() {
 		expectVMEndpointMemoryDump("")
 		Expect(runGetCmd()).To(Succeed())
@@ -463,6 +479,31 @@ var _ = Describe("MemoryDump", func() {
 			Expect(outputData).To(HaveLen(length))
 		})
 
+		It("should delete the memory dump pvc after the download", func() {
+			pvc := &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      pvcName,
+					Namespace: metav1.NamespaceDefault,
+				},
+			}
+			_, err := kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Create(context.Background(), pvc, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			virtClient.PrependReactor("put", "virtualmachines/removememorydump", func(_ k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+				return true, nil, nil
+			})
+			updateVMEStatusOnCreate()
+			err = runDownloadCmd(
+				setFlag(memorydump.OutputFileFlag, outputPath),
+				setFlag(memorydump.DeleteClaimFlag, "true"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "removememorydump")).To(HaveLen(1))
+
+			_, err = kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Get(context.Background(), pvcName, metav1.GetOptions{})
+			Expect(k8serrors.IsNotFound(err)).To(BeTrue())
+		})
+
 		It("should call download memory dump and decompress succesfully", func() {
 			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
 				_, err := w.Write([]byte{
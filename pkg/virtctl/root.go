@@ -39,6 +39,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/version"
 	"kubevirt.io/kubevirt/pkg/virtctl/vm"
 	"kubevirt.io/kubevirt/pkg/virtctl/vmexport"
+	"kubevirt.io/kubevirt/pkg/virtctl/vmimport"
 	"kubevirt.io/kubevirt/pkg/virtctl/vnc"
 )
 
@@ -121,6 +122,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		vm.NewRemoveVolumeCommand(),
 		vm.NewExpandCommand(),
 		vm.NewEvacuateCancelCommand(),
+		vm.NewVolumeMigrationCommand(),
 		memorydump.NewMemoryDumpCommand(),
 		pause.NewCommand(),
 		unpause.NewCommand(),
@@ -131,6 +133,7 @@ func NewVirtctlCommandFn() *cobra.Command {
 		imageupload.NewImageUploadCommand(),
 		guestfs.NewGuestfsShellCommand(),
 		vmexport.NewVirtualMachineExportCommand(),
+		vmimport.NewCommand(),
 		create.NewCommand(),
 		credentials.NewCommand(),
 		adm.NewCommand(),
@@ -22,6 +22,8 @@ package vm
 import (
 	"context"
 	"fmt"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
@@ -43,6 +45,7 @@ func NewMigrateCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "migrate (VM)",
 		Short:   "Migrate a virtual machine.",
+		Long:    "Migrate a virtual machine.\nWith --dry-run no migration is started, instead the nodes the virtual machine could be migrated to are listed, the most suitable first, along with the reasons why the remaining nodes were rejected.",
 		Example: usage(COMMAND_MIGRATE),
 		Args:    cobra.ExactArgs(1),
 		RunE:    c.migrateRun,
@@ -69,12 +72,59 @@ func (c *migrateCommand) migrateRun(cmd *cobra.Command, args []string) error {
 		AddedNodeSelector: c.addedNodeSelector,
 	}
 
-	err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmiName, options)
+	vmClient := virtClient.VirtualMachine(namespace)
+	err = vmClient.Migrate(context.Background(), vmiName, options)
 	if err != nil {
 		return fmt.Errorf("Error migrating VirtualMachine %v", err)
 	}
 
+	if dryRun {
+		result, err := vmClient.MigrateCheck(context.Background(), vmiName, options)
+		if err != nil {
+			return fmt.Errorf("Error checking migration of VirtualMachine %v", err)
+		}
+		return printMigrateCheckResult(cmd, vmiName, result)
+	}
+
 	fmt.Printf("VM %s was scheduled to %s\n", vmiName, c.command)
 
 	return nil
 }
+
+func printMigrateCheckResult(cmd *cobra.Command, vmName string, result *v1.MigrateCheckResult) error {
+	policy := "<none>"
+	if result.MigrationPolicyName != nil {
+		policy = *result.MigrationPolicyName
+	}
+	cmd.Printf("VM:               %s\n", vmName)
+	cmd.Printf("Migratable:       %t\n", result.Migratable)
+	cmd.Printf("Migration policy: %s\n", policy)
+	for _, reason := range result.Reasons {
+		cmd.Printf("  - %s\n", reason)
+	}
+	cmd.Println()
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "RANK\tNODE\tAVAILABLE MEMORY")
+	for i, node := range result.Nodes {
+		memory := "-"
+		if node.AvailableMemory != nil {
+			memory = node.AvailableMemory.String()
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", i+1, node.Name, memory)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(result.RejectedNodes) == 0 {
+		return nil
+	}
+	cmd.Println()
+	w = tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "REJECTED NODE\tREASONS")
+	for _, node := range result.RejectedNodes {
+		fmt.Fprintf(w, "%s\t%s\n", node.Name, strings.Join(node.Reasons, "; "))
+	}
+	return w.Flush()
+}
@@ -21,16 +21,19 @@ package vm_test
 
 import (
 	"context"
+	"fmt"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 
+	"k8s.io/apimachinery/pkg/api/resource"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virtctl/testing"
 )
 
@@ -58,6 +61,9 @@ var _ = Describe("Migrate command", func() {
 
 		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
 		vmInterface.EXPECT().Migrate(context.Background(), vm.Name, expectedMigrateOptions).Return(nil).Times(1)
+		if len(expectedMigrateOptions.DryRun) > 0 {
+			vmInterface.EXPECT().MigrateCheck(context.Background(), vm.Name, expectedMigrateOptions).Return(&v1.MigrateCheckResult{}, nil).Times(1)
+		}
 
 		args := []string{"migrate", vmName}
 		args = append(args, extraArgs...)
@@ -89,6 +95,60 @@ var _ = Describe("Migrate command", func() {
 			"--addedNodeSelector", "key1=value1", "--addedNodeSelector", "key2=value2"),
 	)
 
+	Context("with dry-run", func() {
+		BeforeEach(func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+			vmInterface.EXPECT().Migrate(context.Background(), vmName, gomock.Any()).Return(nil).Times(1)
+		})
+
+		It("should print the ranked nodes and the reasons of the rejected ones", func() {
+			memory := resource.MustParse("12Gi")
+			result := &v1.MigrateCheckResult{
+				Migratable:          true,
+				MigrationPolicyName: pointer.P("fast"),
+				Nodes: []v1.MigrateCheckNode{
+					{Name: "node02", AvailableMemory: &memory},
+					{Name: "node03"},
+				},
+				RejectedNodes: []v1.MigrateCheckNode{
+					{Name: "node01", Reasons: []string{"node is the migration source"}},
+					{Name: "node04", Reasons: []string{"node is cordoned", "node is missing CPU model Skylake"}},
+				},
+			}
+			vmInterface.EXPECT().MigrateCheck(context.Background(), vmName, gomock.Any()).Return(result, nil).Times(1)
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("migrate", vmName, "--dry-run")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("Migratable:       true"))
+			Expect(string(out)).To(ContainSubstring("Migration policy: fast"))
+			Expect(string(out)).To(MatchRegexp(`1\s+node02\s+12Gi`))
+			Expect(string(out)).To(MatchRegexp(`2\s+node03\s+-`))
+			Expect(string(out)).To(MatchRegexp(`node01\s+node is the migration source`))
+			Expect(string(out)).To(MatchRegexp(`node04\s+node is cordoned; node is missing CPU model Skylake`))
+		})
+
+		It("should print why the VM is not migratable", func() {
+			result := &v1.MigrateCheckResult{
+				Reasons: []string{"PVC disk0 does not have a shared access mode"},
+			}
+			vmInterface.EXPECT().MigrateCheck(context.Background(), vmName, gomock.Any()).Return(result, nil).Times(1)
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("migrate", vmName, "--dry-run")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("Migratable:       false"))
+			Expect(string(out)).To(ContainSubstring("Migration policy: <none>"))
+			Expect(string(out)).To(ContainSubstring("  - PVC disk0 does not have a shared access mode"))
+			Expect(string(out)).ToNot(ContainSubstring("REJECTED NODE"))
+		})
+
+		It("should fail when the check fails", func() {
+			vmInterface.EXPECT().MigrateCheck(context.Background(), vmName, gomock.Any()).Return(nil, fmt.Errorf("check error")).Times(1)
+
+			_, err := testing.NewRepeatableVirtctlCommandWithOut("migrate", vmName, "--dry-run")()
+			Expect(err).To(MatchError(ContainSubstring("check error")))
+		})
+	})
+
 	DescribeTable("should fail with badly formatted addedNodeSelector", func(extraArgs ...string) {
 		args := []string{"migrate", vmName}
 		args = append(args, extraArgs...)
@@ -0,0 +1,217 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_VOLUME_MIGRATION = "volume-migration"
+
+	volumeMigrationStatusCopying        = "Copying"
+	volumeMigrationStatusSynchronized   = "Synchronized"
+	volumeMigrationStatusCutoverPending = "CutoverPending"
+	volumeMigrationStatusWaiting        = "Waiting"
+)
+
+func NewVolumeMigrationCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_VOLUME_MIGRATION,
+		Short: "Inspect and control the volume migration of a virtual machine.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Print(cmd.UsageString())
+		},
+	}
+
+	cmd.AddCommand(
+		newVolumeMigrationStatusCommand(),
+		newVolumeMigrationCutoverCommand(),
+	)
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newVolumeMigrationStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status (VM)",
+		Short: "Show the progress of the volume migration of a virtual machine.",
+		Example: "  # Show the progress of the volume migration of the virtual machine 'myvm':\n" +
+			"  {{ProgramName}} volume-migration status myvm",
+		Args: cobra.ExactArgs(1),
+		RunE: volumeMigrationStatusRun,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newVolumeMigrationCutoverCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cutover (VM)",
+		Short: "Switch a virtual machine to the migrated volumes as soon as they are synchronized.",
+		Long: "Switch a virtual machine to the migrated volumes as soon as they are synchronized.\n" +
+			"The cut-over policy of the volume migration is set to Immediate, which releases a manual or a pending window cut-over.",
+		Example: "  # Release the cut-over of the volume migration of the virtual machine 'myvm':\n" +
+			"  {{ProgramName}} volume-migration cutover myvm",
+		Args: cobra.ExactArgs(1),
+		RunE: volumeMigrationCutoverRun,
+	}
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func volumeMigrationStatusRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(context.Background(), vmName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting VirtualMachine %s: %v", vmName, err)
+	}
+	if vm.Status.VolumeUpdateState == nil || vm.Status.VolumeUpdateState.VolumeMigrationState == nil {
+		return fmt.Errorf("no volume migration in progress for VirtualMachine %s", vmName)
+	}
+	state := vm.Status.VolumeUpdateState.VolumeMigrationState
+
+	cmd.Printf("Bandwidth:            %s\n", formatVolumeMigrationBandwidth(vm.Spec.VolumeMigration))
+	cmd.Printf("Cut-over policy:      %s\n", formatVolumeMigrationCutoverPolicy(vm.Spec.VolumeMigration))
+	cmd.Printf("Cut-over pending:     %t\n", state.CutoverPending)
+	if state.NextCutoverTime != nil {
+		cmd.Printf("Next cut-over window: %s\n", state.NextCutoverTime.UTC().Format(time.RFC3339))
+	}
+	cmd.Println()
+
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "VOLUME\tSOURCE\tDESTINATION\tCOPIED\tTOTAL\tRATE\tSTATE")
+	for _, vol := range state.MigratedVolumes {
+		var src, dst string
+		if vol.SourcePVCInfo != nil {
+			src = vol.SourcePVCInfo.ClaimName
+		}
+		if vol.DestinationPVCInfo != nil {
+			dst = vol.DestinationPVCInfo.ClaimName
+		}
+		copied, total, rate := "-", "-", "-"
+		if p := vol.Progress; p != nil {
+			copied = formatBytes(p.BytesCopied)
+			total = formatBytes(p.BytesTotal)
+			rate = formatBytes(p.BytesPerSecond) + "/s"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", vol.VolumeName, src, dst, copied, total, rate, volumeMigrationStatus(vol.Progress))
+	}
+	return w.Flush()
+}
+
+func volumeMigrationCutoverRun(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(context.Background(), vmName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting VirtualMachine %s: %v", vmName, err)
+	}
+	if vm.Status.VolumeUpdateState == nil || vm.Status.VolumeUpdateState.VolumeMigrationState == nil {
+		return fmt.Errorf("no volume migration in progress for VirtualMachine %s", vmName)
+	}
+
+	policy := &v1.VolumeMigrationCutoverPolicy{Mode: v1.VolumeMigrationCutoverImmediate}
+	var patchSet *patch.PatchSet
+	if vm.Spec.VolumeMigration == nil {
+		patchSet = patch.New(patch.WithAdd("/spec/volumeMigration", &v1.VolumeMigrationOptions{CutoverPolicy: policy}))
+	} else {
+		patchSet = patch.New(patch.WithAdd("/spec/volumeMigration/cutoverPolicy", policy))
+	}
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = virtClient.VirtualMachine(namespace).Patch(context.Background(), vmName, types.JSONPatchType, patchBytes,
+		metav1.PatchOptions{DryRun: setDryRunOption(dryRun)})
+	if err != nil {
+		return fmt.Errorf("error releasing the cut-over of VirtualMachine %s: %v", vmName, err)
+	}
+
+	cmd.Printf("The cut-over of the volume migration of VM %s was released\n", vmName)
+	return nil
+}
+
+func volumeMigrationStatus(progress *v1.VolumeMigrationProgress) string {
+	switch {
+	case progress == nil:
+		return volumeMigrationStatusWaiting
+	case progress.CutoverPending:
+		return volumeMigrationStatusCutoverPending
+	case progress.BytesTotal > 0 && progress.BytesCopied >= progress.BytesTotal:
+		return volumeMigrationStatusSynchronized
+	default:
+		return volumeMigrationStatusCopying
+	}
+}
+
+func formatVolumeMigrationBandwidth(opts *v1.VolumeMigrationOptions) string {
+	if opts == nil || opts.Bandwidth == nil {
+		return "unlimited"
+	}
+	return opts.Bandwidth.String() + "/s"
+}
+
+func formatVolumeMigrationCutoverPolicy(opts *v1.VolumeMigrationOptions) string {
+	if opts == nil || opts.CutoverPolicy == nil {
+		return string(v1.VolumeMigrationCutoverImmediate)
+	}
+	policy := opts.CutoverPolicy
+	if policy.Mode == v1.VolumeMigrationCutoverWindow && policy.Window != nil {
+		timeZone := "UTC"
+		if policy.Window.TimeZone != nil {
+			timeZone = *policy.Window.TimeZone
+		}
+		return fmt.Sprintf("%s (%q in %s)", policy.Mode, policy.Window.Schedule, timeZone)
+	}
+	return string(policy.Mode)
+}
+
+func formatBytes(bytes int64) string {
+	return resource.NewQuantity(bytes, resource.BinarySI).String()
+}
@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vm_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Volume migration command", func() {
+	var vmInterface *kubecli.MockVirtualMachineInterface
+	var ctrl *gomock.Controller
+	const vmName = "testvm"
+
+	newMigratingVM := func() *v1.VirtualMachine {
+		vm := kubecli.NewMinimalVM(vmName)
+		vm.Status.VolumeUpdateState = &v1.VolumeUpdateState{
+			VolumeMigrationState: &v1.VolumeMigrationState{
+				MigratedVolumes: []v1.StorageMigratedVolumeInfo{{
+					VolumeName:         "disk0",
+					SourcePVCInfo:      &v1.PersistentVolumeClaimInfo{ClaimName: "src-pvc"},
+					DestinationPVCInfo: &v1.PersistentVolumeClaimInfo{ClaimName: "dst-pvc"},
+					Progress: &v1.VolumeMigrationProgress{
+						BytesTotal:     1024 * 1024,
+						BytesCopied:    1024 * 1024,
+						BytesPerSecond: 1024,
+						CutoverPending: true,
+					},
+				}},
+				CutoverPending:  true,
+				NextCutoverTime: pointer.P(k8smetav1.NewTime(time.Date(2024, 1, 1, 22, 0, 0, 0, time.UTC))),
+			},
+		}
+		return vm
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+	})
+
+	DescribeTable("should fail with missing input parameters", func(subcommand string) {
+		err := testing.NewRepeatableVirtctlCommand("volume-migration", subcommand)()
+		Expect(err).To(MatchError("accepts 1 arg(s), received 0"))
+	},
+		Entry("status", "status"),
+		Entry("cutover", "cutover"),
+	)
+
+	DescribeTable("should fail without a volume migration in progress", func(subcommand string) {
+		vmInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(kubecli.NewMinimalVM(vmName), nil)
+		err := testing.NewRepeatableVirtctlCommand("volume-migration", subcommand, vmName)()
+		Expect(err).To(MatchError("no volume migration in progress for VirtualMachine testvm"))
+	},
+		Entry("status", "status"),
+		Entry("cutover", "cutover"),
+	)
+
+	It("should print the progress of the volume migration", func() {
+		vm := newMigratingVM()
+		vm.Spec.VolumeMigration = &v1.VolumeMigrationOptions{
+			Bandwidth: pointer.P(resource.MustParse("64Mi")),
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{
+				Mode: v1.VolumeMigrationCutoverWindow,
+				Window: &v1.MaintenanceWindow{
+					Schedule: "* 22-23 * * *",
+					TimeZone: pointer.P("Europe/Paris"),
+				},
+			},
+		}
+		vmInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(vm, nil)
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("volume-migration", "status", vmName)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("Bandwidth:            64Mi/s"))
+		Expect(string(out)).To(ContainSubstring(`Cut-over policy:      Window ("* 22-23 * * *" in Europe/Paris)`))
+		Expect(string(out)).To(ContainSubstring("Cut-over pending:     true"))
+		Expect(string(out)).To(ContainSubstring("Next cut-over window: 2024-01-01T22:00:00Z"))
+		Expect(string(out)).To(MatchRegexp(`disk0\s+src-pvc\s+dst-pvc\s+1Mi\s+1Mi\s+1Ki/s\s+CutoverPending`))
+	})
+
+	DescribeTable("should release the cut-over", func(opts *v1.VolumeMigrationOptions, expectedPatch string, dryRun []string, extraArgs ...string) {
+		vm := newMigratingVM()
+		vm.Spec.VolumeMigration = opts
+		vmInterface.EXPECT().Get(context.Background(), vmName, k8smetav1.GetOptions{}).Return(vm, nil)
+		vmInterface.EXPECT().Patch(context.Background(), vmName, types.JSONPatchType, []byte(expectedPatch),
+			k8smetav1.PatchOptions{DryRun: dryRun}).
+			Return(vm, nil)
+
+		args := append([]string{"volume-migration", "cutover", vmName}, extraArgs...)
+		Expect(testing.NewRepeatableVirtctlCommand(args...)()).To(Succeed())
+	},
+		Entry("without volume migration options", nil,
+			`[{"op":"add","path":"/spec/volumeMigration","value":{"cutoverPolicy":{"mode":"Immediate"}}}]`, nil),
+		Entry("with a manual cut-over", &v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{Mode: v1.VolumeMigrationCutoverManual},
+		}, `[{"op":"add","path":"/spec/volumeMigration/cutoverPolicy","value":{"mode":"Immediate"}}]`, nil),
+		Entry("with dry-run", &v1.VolumeMigrationOptions{
+			CutoverPolicy: &v1.VolumeMigrationCutoverPolicy{Mode: v1.VolumeMigrationCutoverManual},
+		}, `[{"op":"add","path":"/spec/volumeMigration/cutoverPolicy","value":{"mode":"Immediate"}}]`,
+			[]string{k8smetav1.DryRunAll}, "--dry-run"),
+	)
+})
@@ -24,6 +24,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log"
@@ -52,6 +53,7 @@ import (
 
 	virtwait "kubevirt.io/kubevirt/pkg/apimachinery/wait"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/storage/export/extents"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
@@ -88,10 +90,15 @@ const (
 	// Possible output format for manifests
 	OUTPUT_FORMAT_JSON = "json"
 	OUTPUT_FORMAT_YAML = "yaml"
+	OUTPUT_FORMAT_OVF  = "ovf"
 
 	// Possible output format for volumes
-	GZIP_FORMAT = "gzip"
-	RAW_FORMAT  = "raw"
+	GZIP_FORMAT       = "gzip"
+	RAW_FORMAT        = "raw"
+	RAW_SPARSE_FORMAT = "raw-sparse"
+	QCOW2_FORMAT      = "qcow2"
+	QCOW2_ZSTD_FORMAT = "qcow2.zst"
+	OVA_FORMAT        = "ova"
 
 	ACCEPT           = "Accept"
 	APPLICATION_YAML = "application/yaml"
@@ -157,6 +164,8 @@ type VMExportInfo struct {
 	IncludeSecret    bool
 	ExportManifest   bool
 	Decompress       bool
+	Sparse           bool
+	OVA              bool
 	PortForward      bool
 	LocalPort        string
 	OutputFile       string
@@ -165,6 +174,7 @@ type VMExportInfo struct {
 	Namespace        string
 	Name             string
 	OutputFormat     string
+	VolumeFormat     exportv1.ExportVolumeFormat
 	ServiceURL       string
 	ExportSource     k8sv1.TypedLocalObjectReference
 	TTL              metav1.Duration
@@ -174,6 +184,12 @@ type VMExportInfo struct {
 	Annotations      map[string]string
 }
 
+// sparseWriter is an output which can be written at arbitrary offsets, like a file
+type sparseWriter interface {
+	io.WriterAt
+	Truncate(size int64) error
+}
+
 type command struct {
 	cmd *cobra.Command
 }
@@ -212,6 +228,15 @@ func usage() string {
 	# Download a volume from an already existing VirtualMachineExport (--volume is optional when only one volume is available)
 	{{ProgramName}} vmexport download vm1-export --volume=volume1 --output=disk.img.gz
 
+	# Download a volume as a sparse RAW image, only transferring its allocated ranges
+	{{ProgramName}} vmexport download vm1-export --volume=volume1 --output=disk.img --format=raw-sparse
+
+	# Download a volume as a zstd compressed QCOW2 image
+	{{ProgramName}} vmexport download vm1-export --volume=volume1 --output=disk.qcow2.zst --format=qcow2.zst
+
+	# Download the VirtualMachine and all its volumes as an OVA package
+	{{ProgramName}} vmexport download vm1-export --vm=vm1 --output=vm1.ova --format=ova
+
 	# Download a volume as before but through local port 5410
 	{{ProgramName}} vmexport download vm1-export --volume=volume1 --output=disk.img.gz --port-forward --local-port=5410
 
@@ -221,6 +246,9 @@ func usage() string {
 	# Create a VirtualMachineExport and get the VirtualMachine manifest in Yaml format
 	{{ProgramName}} vmexport download vm1-export --vm=vm1 --manifest
 
+	# Get the OVF descriptor of the VirtualMachine from an existing VirtualMachineExport
+	{{ProgramName}} vmexport download existing-export --manifest --manifest-output-format=ovf
+
 	# Get the VirtualMachine manifest in Yaml format from an existing VirtualMachineExport including CDI header secret
 	{{ProgramName}} vmexport download existing-export --include-secret --manifest`
 	return usage
@@ -245,13 +273,13 @@ func NewVirtualMachineExportCommand() *cobra.Command {
 	cmd.MarkFlagsMutuallyExclusive("vm", "snapshot", "pvc")
 	cmd.Flags().StringVar(&outputFile, "output", "", "Specifies the output path of the volume to be downloaded.")
 	cmd.Flags().StringVar(&volumeName, "volume", "", "Specifies the volume to be downloaded.")
-	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. There's two options: gzip (default) and raw.")
+	cmd.Flags().StringVar(&format, "format", "", "Used to specify the format of the downloaded image. The options are gzip (default), raw, raw-sparse, qcow2, qcow2.zst and ova. The ova format downloads the VM definition along with all its volumes.")
 	cmd.Flags().BoolVar(&insecure, "insecure", false, "When used with the 'download' option, specifies that the http request should be insecure.")
 	cmd.Flags().BoolVar(&keepVme, "keep-vme", false, "When used with the 'download' option, specifies that the vmexport object should always be retained after the download finishes.")
 	cmd.Flags().BoolVar(&deleteVme, "delete-vme", false, "When used with the 'download' option, specifies that the vmexport object should always be deleted after the download finishes.")
 	cmd.MarkFlagsMutuallyExclusive("keep-vme", "delete-vme")
 	cmd.Flags().StringVar(&ttl, "ttl", "", "The time after the export was created that it is eligible to be automatically deleted, defaults to 2 hours by the server side if not specified")
-	cmd.Flags().StringVar(&manifestOutputFormat, "manifest-output-format", "", "Manifest output format, defaults to Yaml. Valid options are yaml or json")
+	cmd.Flags().StringVar(&manifestOutputFormat, "manifest-output-format", "", "Manifest output format, defaults to Yaml. Valid options are yaml, json or ovf")
 	cmd.Flags().StringVar(&serviceUrl, "service-url", "", "Specify service url to use in the returned manifest, instead of the external URL in the Virtual Machine export status. This is useful for NodePorts or if you don't have an external URL configured")
 	cmd.Flags().BoolVar(&portForward, "port-forward", false, "Configures port-forwarding on a random port. Useful to download without proper ingress/route configuration")
 	cmd.Flags().StringVar(&localPort, "local-port", "0", "Defines the specific port to be used in port-forward.")
@@ -350,9 +378,21 @@ func (c *command) initVMExportInfo(vmeInfo *VMExportInfo) error {
 			return fmt.Fprintf(os.Stderr, format, a...)
 		}
 	}
+	switch format {
 	// If raw format is specified, we'll attempt to download and decompress a gzipped volume
-	if format == RAW_FORMAT {
+	case RAW_FORMAT:
 		vmeInfo.Decompress = true
+	// Sparse downloads only fetch the allocated ranges of the raw volume
+	case RAW_SPARSE_FORMAT:
+		vmeInfo.VolumeFormat = exportv1.KubeVirtRaw
+		vmeInfo.Sparse = true
+	case QCOW2_FORMAT:
+		vmeInfo.VolumeFormat = exportv1.KubeVirtQcow2
+	case QCOW2_ZSTD_FORMAT:
+		vmeInfo.VolumeFormat = exportv1.KubeVirtQcow2Zstd
+	// The OVA packages the VM definition along with all its volumes
+	case OVA_FORMAT:
+		vmeInfo.OVA = true
 	}
 	vmeInfo.DownloadRetries = downloadRetries
 	vmeInfo.ShouldCreate = shouldCreate
@@ -537,6 +577,11 @@ func downloadVirtualMachineExport(client kubecli.KubevirtClient, vmeInfo *VMExpo
 		return getVirtualMachineManifest(client, vmexport, vmeInfo)
 	}
 
+	// Download the OVA package
+	if vmeInfo.OVA {
+		return downloadOVA(client, vmexport, vmeInfo)
+	}
+
 	// Download the exported volume
 	return downloadVolume(client, vmexport, vmeInfo)
 }
@@ -566,6 +611,13 @@ func getVirtualMachineManifest(client kubecli.KubevirtClient, vmexport *exportv1
 	if err != nil {
 		return false, err
 	}
+	if strings.ToLower(vmeInfo.OutputFormat) == OUTPUT_FORMAT_OVF {
+		ovfUrl, ok := manifestMap[exportv1.OVF]
+		if !ok {
+			return false, fmt.Errorf("unable to get the OVF descriptor URL from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+		}
+		return printRequestBody(client, vmexport, vmeInfo, ovfUrl, nil)
+	}
 	headers := make(map[string]string)
 	headers[ACCEPT] = APPLICATION_YAML
 	if strings.ToLower(vmeInfo.OutputFormat) == OUTPUT_FORMAT_JSON {
@@ -589,6 +641,10 @@ func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMac
 		return false, err
 	}
 
+	if vmeInfo.Sparse {
+		return downloadSparseVolume(client, vmexport, vmeInfo, downloadUrl)
+	}
+
 	resp, err := HandleHTTPGetRequestFn(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
 	if err != nil {
 		return false, err
@@ -611,6 +667,118 @@ func downloadVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMac
 	return true, nil
 }
 
+// downloadOVA downloads the OVA package of the VirtualMachine, containing its OVF descriptor and volumes
+func downloadOVA(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (bool, error) {
+	manifestMap, err := GetManifestUrlsFromVirtualMachineExport(vmexport, vmeInfo)
+	if err != nil {
+		return false, err
+	}
+	ovaUrl, ok := manifestMap[exportv1.OVA]
+	if !ok {
+		return false, fmt.Errorf("unable to get the OVA URL from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+	}
+
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, ovaUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		printToOutput("Bad status: %s\n", resp.Status)
+		return false, nil
+	}
+
+	if err := copyFileWithProgressBar(vmeInfo.OutputWriter, resp, false); err != nil {
+		return false, err
+	}
+
+	printToOutput("Download finished succesfully\n")
+
+	return true, nil
+}
+
+// downloadSparseVolume downloads the allocated ranges of the raw volume into the output file, leaving holes unallocated
+func downloadSparseVolume(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, downloadUrl string) (bool, error) {
+	output, ok := vmeInfo.OutputWriter.(sparseWriter)
+	if !ok {
+		return false, fmt.Errorf("%s=%s requires a file to be specified with %s", FORMAT_FLAG, RAW_SPARSE_FORMAT, OUTPUT_FLAG)
+	}
+
+	extentMapUrl, err := GetExtentMapUrlFromVirtualMachineExport(vmexport, vmeInfo)
+	if err != nil {
+		return false, err
+	}
+
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, extentMapUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		printToOutput("Bad status: %s\n", resp.Status)
+		return false, nil
+	}
+
+	var volumeExtents []extents.Extent
+	if err := json.NewDecoder(resp.Body).Decode(&volumeExtents); err != nil {
+		return false, err
+	}
+
+	// Start from an empty file, so that the holes read as zeros
+	if err := output.Truncate(0); err != nil {
+		return false, err
+	}
+	if err := output.Truncate(extents.Size(volumeExtents)); err != nil {
+		return false, err
+	}
+
+	var dataSize int64
+	for _, extent := range volumeExtents {
+		if extent.Data {
+			dataSize += extent.Length
+		}
+	}
+	bar := pb.Full.Start64(dataSize)
+	defer bar.Finish()
+
+	for _, extent := range volumeExtents {
+		if !extent.Data || extent.Length == 0 {
+			continue
+		}
+		headers := map[string]string{
+			"Range": fmt.Sprintf("bytes=%d-%d", extent.Start, extent.Start+extent.Length-1),
+		}
+		if done, err := downloadExtent(client, vmexport, vmeInfo, downloadUrl, headers, io.NewOffsetWriter(output, extent.Start), bar); !done || err != nil {
+			return done, err
+		}
+	}
+
+	printToOutput("Download finished succesfully\n")
+
+	return true, nil
+}
+
+// downloadExtent copies a single range of the volume to the output
+func downloadExtent(client kubecli.KubevirtClient, vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo, downloadUrl string, headers map[string]string, output io.Writer, bar *pb.ProgressBar) (bool, error) {
+	resp, err := HandleHTTPGetRequestFn(client, vmexport, downloadUrl, vmeInfo.Insecure, vmeInfo.ServiceURL, headers)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusPartialContent {
+		printToOutput("Bad status: %s\n", resp.Status)
+		return false, nil
+	}
+
+	if _, err := io.Copy(output, bar.NewProxyReader(resp.Body)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // shouldDeleteVMExport decides wether we should retain or delete a VMExport after a download. If delete/retain are not explicitly specified,
 // the vmexport will be deleted when is created in the same instance as the download, retained otherwise.
 func shouldDeleteVMExport(vmeInfo *VMExportInfo) bool {
@@ -635,36 +803,35 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 		downloadUrl string
 		err         error
 		format      exportv1.VirtualMachineExportVolumeFormat
-		links       *exportv1.VirtualMachineExportLink
 	)
 
-	if vmeInfo.ServiceURL == "" && vmexport.Status.Links != nil && vmexport.Status.Links.External != nil {
-		links = vmexport.Status.Links.External
-	} else if vmexport.Status.Links != nil && vmexport.Status.Links.Internal != nil {
-		links = vmexport.Status.Links.Internal
-	}
-	if links == nil || len(links.Volumes) <= 0 {
-		return "", fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
-	}
-	volumeNumber := len(links.Volumes)
-	if volumeNumber > 1 && vmeInfo.VolumeName == "" {
-		return "", fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
+	exportVolume, err := getVolumeFromVirtualMachineExport(vmexport, vmeInfo)
+	if err != nil {
+		return "", err
 	}
-	for _, exportVolume := range links.Volumes {
-		// Access the requested volume
-		if volumeNumber == 1 || exportVolume.Name == vmeInfo.VolumeName {
-			for _, format = range exportVolume.Formats {
-				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
+	if exportVolume != nil {
+		for _, format = range exportVolume.Formats {
+			// A specific format was requested, only its URL is valid
+			if vmeInfo.VolumeFormat != "" {
+				if format.Format == vmeInfo.VolumeFormat {
 					downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
 					if err != nil {
 						return "", err
 					}
-				}
-				// By default, we always attempt to find and get the compressed file URL,
-				// so we only break the loop when one is found.
-				if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz {
 					break
 				}
+				continue
+			}
+			if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz || format.Format == exportv1.KubeVirtRaw {
+				downloadUrl, err = replaceUrlWithServiceUrl(format.Url, vmeInfo)
+				if err != nil {
+					return "", err
+				}
+			}
+			// By default, we always attempt to find and get the compressed file URL,
+			// so we only break the loop when one is found.
+			if format.Format == exportv1.KubeVirtGz || format.Format == exportv1.ArchiveGz {
+				break
 			}
 		}
 	}
@@ -675,12 +842,52 @@ func GetUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vme
 	}
 
 	if downloadUrl == "" {
+		if vmeInfo.VolumeFormat != "" {
+			return "", fmt.Errorf("unable to get a valid %s URL from '%s/%s' VirtualMachineExport", vmeInfo.VolumeFormat, vmexport.Namespace, vmexport.Name)
+		}
 		return "", fmt.Errorf("unable to get a valid URL from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
 	}
 
 	return downloadUrl, nil
 }
 
+// GetExtentMapUrlFromVirtualMachineExport inspects the VirtualMachineExport status to fetch the extent map URL of the volume
+func GetExtentMapUrlFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (string, error) {
+	exportVolume, err := getVolumeFromVirtualMachineExport(vmexport, vmeInfo)
+	if err != nil {
+		return "", err
+	}
+	if exportVolume == nil || exportVolume.ExtentMapUrl == "" {
+		return "", fmt.Errorf("unable to get a valid extent map URL from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+	}
+	return replaceUrlWithServiceUrl(exportVolume.ExtentMapUrl, vmeInfo)
+}
+
+// getVolumeFromVirtualMachineExport returns the requested volume from the VirtualMachineExport status, nil if it isn't found
+func getVolumeFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (*exportv1.VirtualMachineExportVolume, error) {
+	var links *exportv1.VirtualMachineExportLink
+
+	if vmeInfo.ServiceURL == "" && vmexport.Status.Links != nil && vmexport.Status.Links.External != nil {
+		links = vmexport.Status.Links.External
+	} else if vmexport.Status.Links != nil && vmexport.Status.Links.Internal != nil {
+		links = vmexport.Status.Links.Internal
+	}
+	if links == nil || len(links.Volumes) <= 0 {
+		return nil, fmt.Errorf("unable to access the volume info from '%s/%s' VirtualMachineExport", vmexport.Namespace, vmexport.Name)
+	}
+	volumeNumber := len(links.Volumes)
+	if volumeNumber > 1 && vmeInfo.VolumeName == "" {
+		return nil, fmt.Errorf("detected more than one downloadable volume in '%s/%s' VirtualMachineExport: Select the expected volume using the --volume flag", vmexport.Namespace, vmexport.Name)
+	}
+	for i := range links.Volumes {
+		// Access the requested volume
+		if volumeNumber == 1 || links.Volumes[i].Name == vmeInfo.VolumeName {
+			return &links.Volumes[i], nil
+		}
+	}
+	return nil, nil
+}
+
 // GetManifestUrlsFromVirtualMachineExport retrieves the manifest URLs from VirtualMachineExport status
 func GetManifestUrlsFromVirtualMachineExport(vmexport *exportv1.VirtualMachineExport, vmeInfo *VMExportInfo) (map[exportv1.ExportManifestType]string, error) {
 	res := make(map[exportv1.ExportManifestType]string, 0)
@@ -1005,8 +1212,24 @@ func handleDownloadFlags() error {
 		}
 	}
 
-	if format != "" && format != GZIP_FORMAT && format != RAW_FORMAT {
-		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw")
+	switch format {
+	case "", GZIP_FORMAT, RAW_FORMAT, QCOW2_FORMAT, QCOW2_ZSTD_FORMAT:
+	case RAW_SPARSE_FORMAT:
+		if outputFile == "-" {
+			return fmt.Errorf("%s=%s requires a file to be specified with %s", FORMAT_FLAG, RAW_SPARSE_FORMAT, OUTPUT_FLAG)
+		}
+	case OVA_FORMAT:
+		if volumeName != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, VOLUME_FLAG, FORMAT_FLAG+"="+OVA_FORMAT)
+		}
+		if exportManifest {
+			return fmt.Errorf(ErrIncompatibleFlag, MANIFEST_FLAG, FORMAT_FLAG+"="+OVA_FORMAT)
+		}
+		if pvc != "" {
+			return fmt.Errorf(ErrIncompatibleFlag, PVC_FLAG, FORMAT_FLAG+"="+OVA_FORMAT)
+		}
+	default:
+		return fmt.Errorf(ErrInvalidValue, FORMAT_FLAG, "gzip/raw/raw-sparse/qcow2/qcow2.zst/ova")
 	}
 
 	if downloadRetries < 0 {
@@ -1019,8 +1242,14 @@ func handleDownloadFlags() error {
 		}
 
 		manifestOutputFormat = strings.ToLower(manifestOutputFormat)
-		if manifestOutputFormat != OUTPUT_FORMAT_JSON && manifestOutputFormat != OUTPUT_FORMAT_YAML && manifestOutputFormat != "" {
-			return fmt.Errorf(ErrInvalidValue, OUTPUT_FORMAT_FLAG, "json/yaml")
+		switch manifestOutputFormat {
+		case "", OUTPUT_FORMAT_JSON, OUTPUT_FORMAT_YAML:
+		case OUTPUT_FORMAT_OVF:
+			if includeSecret {
+				return fmt.Errorf(ErrIncompatibleFlag, INCLUDE_SECRET_FLAG, OUTPUT_FORMAT_FLAG+"="+OUTPUT_FORMAT_OVF)
+			}
+		default:
+			return fmt.Errorf(ErrInvalidValue, OUTPUT_FORMAT_FLAG, "json/yaml/ovf")
 		}
 
 		if pvc != "" {
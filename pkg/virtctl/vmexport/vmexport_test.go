@@ -20,8 +20,10 @@
 package vmexport_test
 
 import (
+	"bytes"
 	"context"
 	cryptorand "crypto/rand"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -49,6 +51,7 @@ import (
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
+	"kubevirt.io/kubevirt/pkg/storage/export/extents"
 	"kubevirt.io/kubevirt/pkg/virtctl/testing"
 	"kubevirt.io/kubevirt/pkg/virtctl/vmexport"
 )
@@ -381,9 +384,13 @@ var _ = Describe("vmexport", func() {
 			Entry("Using 'delete' with invalid flag", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.INSECURE_FLAG, vmexport.DELETE), runDeleteCmd, vmexport.INSECURE_FLAG),
 			Entry("Using 'manifest' with pvc flag", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.PVC_FLAG, vmexport.MANIFEST_FLAG), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.PVC_FLAG, "test")),
 			Entry("Using 'manifest' with volume type", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.VOLUME_FLAG, vmexport.MANIFEST_FLAG), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.VM_FLAG, "test"), setFlag(vmexport.VOLUME_FLAG, "volume")),
-			Entry("Using 'manifest' with invalid output_format_flag", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.OUTPUT_FORMAT_FLAG, "json/yaml"), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.OUTPUT_FORMAT_FLAG, "invalid")),
+			Entry("Using 'manifest' with invalid output_format_flag", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.OUTPUT_FORMAT_FLAG, "json/yaml/ovf"), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.OUTPUT_FORMAT_FLAG, "invalid")),
 			Entry("Using 'port-forward' with invalid port", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.LOCAL_PORT_FLAG, "valid port numbers"), runDownloadCmd, vmexport.PORT_FORWARD_FLAG, setFlag(vmexport.LOCAL_PORT_FLAG, "test")),
-			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'format' with invalid download format", fmt.Sprintf(vmexport.ErrInvalidValue, vmexport.FORMAT_FLAG, "gzip/raw/raw-sparse/qcow2/qcow2.zst/ova"), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, "test")),
+			Entry("Using 'format' raw-sparse with stdout output", fmt.Sprintf("%s=%s requires a file to be specified with %s", vmexport.FORMAT_FLAG, vmexport.RAW_SPARSE_FORMAT, vmexport.OUTPUT_FLAG), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_SPARSE_FORMAT), setFlag(vmexport.OUTPUT_FLAG, "-")),
+			Entry("Using 'format' ova with volume", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.VOLUME_FLAG, vmexport.FORMAT_FLAG+"="+vmexport.OVA_FORMAT), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, vmexport.OVA_FORMAT), setFlag(vmexport.VOLUME_FLAG, "volume")),
+			Entry("Using 'format' ova with pvc flag", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.PVC_FLAG, vmexport.FORMAT_FLAG+"="+vmexport.OVA_FORMAT), runDownloadCmd, setFlag(vmexport.FORMAT_FLAG, vmexport.OVA_FORMAT), setFlag(vmexport.PVC_FLAG, "test")),
+			Entry("Using 'manifest' ovf output format with include-secret", fmt.Sprintf(vmexport.ErrIncompatibleFlag, vmexport.INCLUDE_SECRET_FLAG, vmexport.OUTPUT_FORMAT_FLAG+"="+vmexport.OUTPUT_FORMAT_OVF), runDownloadCmd, vmexport.MANIFEST_FLAG, setFlag(vmexport.OUTPUT_FORMAT_FLAG, vmexport.OUTPUT_FORMAT_OVF), vmexport.INCLUDE_SECRET_FLAG),
 			Entry("Downloading volume without specifying output", fmt.Sprintf("warning: Binary output can mess up your terminal. Use '%s -' to output into stdout anyway or consider '%s <FILE>' to save to a file", vmexport.OUTPUT_FLAG, vmexport.OUTPUT_FLAG), runDownloadCmd),
 		)
 	})
@@ -603,6 +610,110 @@ var _ = Describe("vmexport", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("Succesfully download a VirtualMachineExport with 'raw-sparse' format", func() {
+			const size = 1024 * 1024
+			data := make([]byte, size)
+			_, err := cryptorand.Read(data[:4096])
+			Expect(err).ToNot(HaveOccurred())
+			_, err = cryptorand.Read(data[size-4096:])
+			Expect(err).ToNot(HaveOccurred())
+			volumeExtents := []extents.Extent{
+				{Start: 0, Length: 4096, Data: true},
+				{Start: 4096, Length: size - 8192},
+				{Start: size - 4096, Length: 4096, Data: true},
+			}
+
+			var ranges []string
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path == "/extents" {
+					Expect(json.NewEncoder(w).Encode(volumeExtents)).To(Succeed())
+					return
+				}
+				ranges = append(ranges, r.Header.Get("Range"))
+				http.ServeContent(w, r, "disk.img", time.Time{}, bytes.NewReader(data))
+			})
+
+			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+				Name: volumeName,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{{
+					Format: exportv1.KubeVirtRaw,
+					Url:    server.URL + "/disk.img",
+				}},
+				ExtentMapUrl: server.URL + "/extents",
+			}})
+			_, err = virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), secret, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = runDownloadCmd(
+				setFlag(vmexport.FORMAT_FLAG, vmexport.RAW_SPARSE_FORMAT),
+				setFlag(vmexport.VOLUME_FLAG, volumeName),
+				setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				vmexport.INSECURE_FLAG,
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			// Only the data extents are requested
+			Expect(ranges).To(ConsistOf(
+				fmt.Sprintf("bytes=0-%d", 4096-1),
+				fmt.Sprintf("bytes=%d-%d", size-4096, size-1),
+			))
+			outputData, err := os.ReadFile(outputPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(outputData).To(Equal(data))
+		})
+
+		It("Succesfully download a VirtualMachineExport with 'ova' format", func() {
+			const ova = "ova content"
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.Path).To(Equal("/manifests/ova"))
+				w.Write([]byte(ova))
+			})
+
+			vme.Status = vmeStatusReady(nil)
+			vme.Status.Links.External.Manifests = []exportv1.VirtualMachineExportManifest{{
+				Type: exportv1.OVA,
+				Url:  server.URL + "/manifests/ova",
+			}}
+			_, err := virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), secret, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = runDownloadCmd(
+				setFlag(vmexport.FORMAT_FLAG, vmexport.OVA_FORMAT),
+				setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				vmexport.INSECURE_FLAG,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			outputData, err := os.ReadFile(outputPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(outputData)).To(Equal(ova))
+		})
+
+		It("VirtualMachineExport download fails with 'ova' format when the OVA is not available", func() {
+			vme.Status = vmeStatusReady(nil)
+			vme.Status.Links.External.Manifests = []exportv1.VirtualMachineExportManifest{{
+				Type: exportv1.AllManifests,
+				Url:  server.URL + "/manifests/all",
+			}}
+			_, err := virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), secret, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = runDownloadCmd(
+				setFlag(vmexport.FORMAT_FLAG, vmexport.OVA_FORMAT),
+				setFlag(vmexport.OUTPUT_FLAG, outputPath),
+				vmexport.INSECURE_FLAG,
+			)
+			Expect(err).To(MatchError(fmt.Sprintf("unable to get the OVA URL from '%s/%s' VirtualMachineExport", metav1.NamespaceDefault, vmeName)))
+		})
+
 		It("VirtualMachineExport download succeeds when the volume has a different name than expected but there's only one volume", func() {
 			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
 				Name: "no-test-volume",
@@ -770,6 +881,41 @@ var _ = Describe("vmexport", func() {
 			Entry("with --include-secret", true),
 		)
 
+		It("should print the OVF descriptor with ovf output format", func() {
+			const (
+				ovfUrl        = "/test/ovf"
+				ovfDescriptor = "<Envelope></Envelope>"
+			)
+			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.URL.String()).To(Equal(ovfUrl))
+				w.Write([]byte(ovfDescriptor))
+			})
+
+			vme.Status = vmeStatusReady(nil)
+			vme.Status.Links.External.Manifests = append(vme.Status.Links.External.Manifests,
+				exportv1.VirtualMachineExportManifest{
+					Type: exportv1.AllManifests,
+					Url:  server.URL + manifestUrl,
+				},
+				exportv1.VirtualMachineExportManifest{
+					Type: exportv1.OVF,
+					Url:  server.URL + ovfUrl,
+				},
+			)
+			_, err := virtClient.ExportV1beta1().VirtualMachineExports(metav1.NamespaceDefault).Create(context.Background(), vme, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), secret, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("vmexport", vmexport.DOWNLOAD, vmeName,
+				vmexport.MANIFEST_FLAG,
+				setFlag(vmexport.OUTPUT_FORMAT_FLAG, vmexport.OUTPUT_FORMAT_OVF),
+			)()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(Equal(ovfDescriptor))
+		})
+
 		It("should error if http status is error", func() {
 			server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				Expect(r.URL.String()).To(BeElementOf(manifestUrl, secretUrl))
@@ -971,6 +1117,62 @@ var _ = Describe("vmexport", func() {
 			Expect(url).Should(Equal("raw"))
 		})
 
+		DescribeTable("Should get the URL of the requested format", func(volumeFormat exportv1.ExportVolumeFormat, expectedUrl string) {
+			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+				Name: volumeName,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{
+					{
+						Format: exportv1.KubeVirtRaw,
+						Url:    "raw",
+					},
+					{
+						Format: exportv1.KubeVirtGz,
+						Url:    "compressed",
+					},
+					{
+						Format: exportv1.KubeVirtQcow2,
+						Url:    "qcow2",
+					},
+					{
+						Format: exportv1.KubeVirtQcow2Zstd,
+						Url:    "qcow2.zst",
+					},
+				}},
+			})
+			vmeInfo := &vmexport.VMExportInfo{
+				Name:         vme.Name,
+				VolumeName:   volumeName,
+				VolumeFormat: volumeFormat,
+			}
+
+			url, err := vmexport.GetUrlFromVirtualMachineExport(vme, vmeInfo)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(url).Should(Equal(expectedUrl))
+		},
+			Entry("raw", exportv1.KubeVirtRaw, "raw"),
+			Entry("qcow2", exportv1.KubeVirtQcow2, "qcow2"),
+			Entry("zstd compressed qcow2", exportv1.KubeVirtQcow2Zstd, "qcow2.zst"),
+		)
+
+		It("Should not get any URL when the requested format isn't available", func() {
+			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
+				Name: volumeName,
+				Formats: []exportv1.VirtualMachineExportVolumeFormat{{
+					Format: exportv1.KubeVirtGz,
+					Url:    "compressed",
+				}}},
+			})
+			vmeInfo := &vmexport.VMExportInfo{
+				Name:         vme.Name,
+				VolumeName:   volumeName,
+				VolumeFormat: exportv1.KubeVirtQcow2,
+			}
+
+			url, err := vmexport.GetUrlFromVirtualMachineExport(vme, vmeInfo)
+			Expect(err).To(MatchError(ContainSubstring("unable to get a valid qcow2 URL")))
+			Expect(url).To(Equal(""))
+		})
+
 		It("Should not get any URL when there's no valid options", func() {
 			vme.Status = vmeStatusReady([]exportv1.VirtualMachineExportVolume{{
 				Name: volumeName,
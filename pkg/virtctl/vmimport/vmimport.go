@@ -0,0 +1,479 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmimport
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	URL_FLAG                   = "--url"
+	BUNDLE_FLAG                = "--bundle"
+	TOKEN_FLAG                 = "--token"
+	STORAGE_CLASS_MAPPING_FLAG = "--storage-class-mapping"
+	NETWORK_MAPPING_FLAG       = "--network-mapping"
+
+	exportTokenHeader = "x-kubevirt-export-token"
+	secretTokenKey    = "token"
+	// importedForAnnotation marks the objects created by the import of a VirtualMachine,
+	// so that an interrupted import can be run again
+	importedForAnnotation = "vmimport.kubevirt.io/virtual-machine"
+
+	// ErrRequiredSource is returned when neither or both of the manifest sources are specified
+	ErrRequiredSource = "exactly one of '--url' or '--bundle' must be specified"
+	// ErrRequiredToken is returned when the manifests are fetched from an export without a token
+	ErrRequiredToken = "'--token' is required when importing from '--url'"
+)
+
+// importWaitInterval is the time interval used to check the DataVolumes import progress
+var importWaitInterval = 5 * time.Second
+
+type command struct {
+	url                 string
+	bundle              string
+	token               string
+	caCert              string
+	insecure            bool
+	noWait              bool
+	timeout             time.Duration
+	storageClassMapping map[string]string
+	networkMapping      map[string]string
+}
+
+// manifests are the objects of a VirtualMachineExport needed to recreate the VM
+type manifests struct {
+	configMaps  []*k8sv1.ConfigMap
+	secrets     []*k8sv1.Secret
+	dataVolumes []*cdiv1.DataVolume
+	vm          *v1.VirtualMachine
+}
+
+// NewCommand returns a cobra.Command to import a VirtualMachine from a VirtualMachineExport
+func NewCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:     "vmimport [VM]",
+		Short:   "Recreate a VirtualMachine and its volumes from a VirtualMachineExport.",
+		Example: usage(),
+		Args:    cobra.MaximumNArgs(1),
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringVar(&c.url, "url", "", "The URL of the VirtualMachine manifests of a VirtualMachineExport, as found in its external links.")
+	cmd.Flags().StringVar(&c.bundle, "bundle", "", "A local file containing the manifests obtained with 'vmexport download --manifest'.")
+	cmd.Flags().StringVar(&c.token, "token", "", "The token of the VirtualMachineExport, used to fetch the manifests and to import the volumes.")
+	cmd.Flags().StringVar(&c.caCert, "ca-cert", "", "A file containing the CA certificate of the VirtualMachineExport, used when fetching the manifests from '--url'.")
+	cmd.Flags().BoolVar(&c.insecure, "insecure", false, "Skip the verification of the VirtualMachineExport certificate when fetching the manifests from '--url'.")
+	cmd.Flags().StringToStringVar(&c.storageClassMapping, "storage-class-mapping", nil, "Storage classes of the source cluster to replace, in the form source=destination.")
+	cmd.Flags().StringToStringVar(&c.networkMapping, "network-mapping", nil, "Multus networks of the source cluster to replace, in the form source=destination.")
+	cmd.Flags().BoolVar(&c.noWait, "no-wait", false, "Do not wait for the volumes to be imported.")
+	cmd.Flags().DurationVar(&c.timeout, "timeout", 0, "The maximum time to wait for the volumes to be imported, no limit by default.")
+	cmd.MarkFlagsMutuallyExclusive("url", "bundle")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+
+	return cmd
+}
+
+func usage() string {
+	return `  # Import the VirtualMachine of an export on another cluster, the URL and token are found in the VirtualMachineExport
+  {{ProgramName}} vmimport --url=https://vmexport-proxy.example.com/api/export.kubevirt.io/v1beta1/namespaces/ns/virtualmachineexports/vm1-export/external/manifests/all --token=abcdef
+
+  # Import the VirtualMachine under a new name, replacing the storage class and the network of the source cluster
+  {{ProgramName}} vmimport vm2 --url=https://vmexport-proxy.example.com/api/export.kubevirt.io/v1beta1/namespaces/ns/virtualmachineexports/vm1-export/external/manifests/all --token=abcdef --storage-class-mapping=ceph-rbd=local --network-mapping=ns/vlan10=ns/bridge
+
+  # Import the VirtualMachine from manifests saved with 'vmexport download vm1-export --manifest --include-secret', without waiting for the volumes
+  {{ProgramName}} vmimport --bundle=vm1.yaml --no-wait`
+}
+
+func (c *command) run(cmd *cobra.Command, args []string) error {
+	if (c.url == "") == (c.bundle == "") {
+		return errors.New(ErrRequiredSource)
+	}
+	if c.url != "" && c.token == "" {
+		return errors.New(ErrRequiredToken)
+	}
+
+	client, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	var data []byte
+	if c.url != "" {
+		data, err = c.fetchManifests()
+	} else {
+		data, err = os.ReadFile(c.bundle)
+	}
+	if err != nil {
+		return err
+	}
+
+	m, err := parseManifests(data)
+	if err != nil {
+		return err
+	}
+	if len(args) == 1 {
+		m.vm.Name = args[0]
+	}
+	if err := c.prepare(m, namespace); err != nil {
+		return err
+	}
+
+	if err := create(cmd, client, namespace, m); err != nil {
+		return err
+	}
+	cmd.Printf("VirtualMachine %s/%s created\n", namespace, m.vm.Name)
+
+	if c.noWait {
+		return nil
+	}
+	return c.waitForImports(cmd, client, namespace, m)
+}
+
+// fetchManifests retrieves the manifests from the export server
+func (c *command) fetchManifests() ([]byte, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: c.insecure}
+	if c.caCert != "" {
+		pem, err := os.ReadFile(c.caCert)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificate found in %s", c.caCert)
+		}
+	}
+	httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+
+	req, err := http.NewRequest(http.MethodGet, c.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(exportTokenHeader, c.token)
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch the manifests from %s: %s", c.url, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
+
+// parseManifests decodes the YAML or JSON documents returned by the export server, lists are flattened
+func parseManifests(data []byte) (*manifests, error) {
+	m := &manifests{}
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("unable to decode the manifests: %v", err)
+		}
+		if err := m.add(raw); err != nil {
+			return nil, err
+		}
+	}
+	if m.vm == nil {
+		return nil, errors.New("the manifests do not contain a VirtualMachine")
+	}
+	return m, nil
+}
+
+func (m *manifests) add(raw json.RawMessage) error {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+	typeMeta := metav1.TypeMeta{}
+	if err := json.Unmarshal(raw, &typeMeta); err != nil {
+		return err
+	}
+
+	var obj interface{}
+	switch typeMeta.Kind {
+	case "List":
+		list := &k8sv1.List{}
+		if err := json.Unmarshal(raw, list); err != nil {
+			return err
+		}
+		for _, item := range list.Items {
+			if err := m.add(item.Raw); err != nil {
+				return err
+			}
+		}
+		return nil
+	case "ConfigMap":
+		cm := &k8sv1.ConfigMap{}
+		m.configMaps = append(m.configMaps, cm)
+		obj = cm
+	case "Secret":
+		secret := &k8sv1.Secret{}
+		m.secrets = append(m.secrets, secret)
+		obj = secret
+	case "DataVolume":
+		dv := &cdiv1.DataVolume{}
+		m.dataVolumes = append(m.dataVolumes, dv)
+		obj = dv
+	case v1.VirtualMachineGroupVersionKind.Kind:
+		if m.vm != nil {
+			return errors.New("the manifests contain more than one VirtualMachine")
+		}
+		m.vm = &v1.VirtualMachine{}
+		obj = m.vm
+	default:
+		return fmt.Errorf("unexpected %s in the manifests", typeMeta.Kind)
+	}
+	return json.Unmarshal(raw, obj)
+}
+
+// prepare moves the objects to the destination namespace and applies the mappings
+func (c *command) prepare(m *manifests, namespace string) error {
+	for _, cm := range m.configMaps {
+		resetObjectMeta(&cm.ObjectMeta, namespace)
+	}
+	for _, secret := range m.secrets {
+		resetObjectMeta(&secret.ObjectMeta, namespace)
+	}
+	for _, dv := range m.dataVolumes {
+		resetObjectMeta(&dv.ObjectMeta, namespace)
+		setImportedFor(&dv.ObjectMeta, m.vm.Name)
+		if _, ok := dv.Labels[backendstorage.PVCPrefix]; ok {
+			// The backend-storage PVC is looked up by the name of its VM, follow a renamed VM
+			dv.Labels[backendstorage.PVCPrefix] = m.vm.Name
+		}
+		c.mapDataVolumeSpec(&dv.Spec)
+	}
+	resetObjectMeta(&m.vm.ObjectMeta, namespace)
+	setImportedFor(&m.vm.ObjectMeta, m.vm.Name)
+	for i := range m.vm.Spec.DataVolumeTemplates {
+		c.mapDataVolumeSpec(&m.vm.Spec.DataVolumeTemplates[i].Spec)
+	}
+	if m.vm.Spec.Template != nil {
+		for _, network := range m.vm.Spec.Template.Spec.Networks {
+			if network.Multus == nil {
+				continue
+			}
+			if name, ok := c.networkMapping[network.Multus.NetworkName]; ok {
+				network.Multus.NetworkName = name
+			}
+		}
+	}
+
+	if len(m.secrets) > 0 {
+		return nil
+	}
+	// The token secret is only part of the manifests when requested, create it from the token
+	secretName := headerSecretName(m)
+	if secretName == "" {
+		return nil
+	}
+	if c.token == "" {
+		return fmt.Errorf("the manifests do not contain the %s secret, specify the export token with '%s'", secretName, TOKEN_FLAG)
+	}
+	m.secrets = append(m.secrets, &k8sv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: namespace,
+		},
+		StringData: map[string]string{
+			secretTokenKey: fmt.Sprintf("%s:%s", exportTokenHeader, c.token),
+		},
+	})
+	return nil
+}
+
+func (c *command) mapDataVolumeSpec(spec *cdiv1.DataVolumeSpec) {
+	if spec.Storage != nil && spec.Storage.StorageClassName != nil {
+		if name, ok := c.storageClassMapping[*spec.Storage.StorageClassName]; ok {
+			spec.Storage.StorageClassName = &name
+		}
+	}
+	if spec.PVC != nil && spec.PVC.StorageClassName != nil {
+		if name, ok := c.storageClassMapping[*spec.PVC.StorageClassName]; ok {
+			spec.PVC.StorageClassName = &name
+		}
+	}
+}
+
+func resetObjectMeta(meta *metav1.ObjectMeta, namespace string) {
+	meta.Namespace = namespace
+	meta.ResourceVersion = ""
+	meta.UID = ""
+	meta.CreationTimestamp = metav1.Time{}
+	meta.OwnerReferences = nil
+}
+
+func setImportedFor(meta *metav1.ObjectMeta, vmName string) {
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations[importedForAnnotation] = vmName
+}
+
+// checkImportedFor returns an error unless the existing object was created by the import of the VirtualMachine
+func checkImportedFor(kind string, meta *metav1.ObjectMeta, vmName string) error {
+	if meta.Annotations[importedForAnnotation] != vmName {
+		return fmt.Errorf("%s %s/%s already exists and was not created by the import of VirtualMachine %s", kind, meta.Namespace, meta.Name, vmName)
+	}
+	return nil
+}
+
+// headerSecretName returns the name of the secret holding the export token header of the HTTP sources
+func headerSecretName(m *manifests) string {
+	sources := make([]*cdiv1.DataVolumeSource, 0, len(m.dataVolumes)+len(m.vm.Spec.DataVolumeTemplates))
+	for _, dv := range m.dataVolumes {
+		sources = append(sources, dv.Spec.Source)
+	}
+	for _, template := range m.vm.Spec.DataVolumeTemplates {
+		sources = append(sources, template.Spec.Source)
+	}
+	for _, source := range sources {
+		if source != nil && source.HTTP != nil && len(source.HTTP.SecretExtraHeaders) > 0 {
+			return source.HTTP.SecretExtraHeaders[0]
+		}
+	}
+	return ""
+}
+
+// create creates the objects, the CA ConfigMap and token Secret may be shared with a previous import of the same export.
+// The DataVolumes and the VirtualMachine left by an interrupted import of the same VirtualMachine are reused.
+func create(cmd *cobra.Command, client kubecli.KubevirtClient, namespace string, m *manifests) error {
+	ctx := cmd.Context()
+	for _, cm := range m.configMaps {
+		if _, err := client.CoreV1().ConfigMaps(namespace).Create(ctx, cm, metav1.CreateOptions{}); err != nil && !k8serrors.IsAlreadyExists(err) {
+			return fmt.Errorf("error creating ConfigMap %s: %v", cm.Name, err)
+		}
+	}
+	for _, secret := range m.secrets {
+		if _, err := client.CoreV1().Secrets(namespace).Create(ctx, secret, metav1.CreateOptions{}); err != nil && !k8serrors.IsAlreadyExists(err) {
+			return fmt.Errorf("error creating Secret %s: %v", secret.Name, err)
+		}
+	}
+	for _, dv := range m.dataVolumes {
+		_, err := client.CdiClient().CdiV1beta1().DataVolumes(namespace).Create(ctx, dv, metav1.CreateOptions{})
+		if k8serrors.IsAlreadyExists(err) {
+			existing, err := client.CdiClient().CdiV1beta1().DataVolumes(namespace).Get(ctx, dv.Name, metav1.GetOptions{})
+			if err != nil {
+				return fmt.Errorf("error getting DataVolume %s: %v", dv.Name, err)
+			}
+			if err := checkImportedFor("DataVolume", &existing.ObjectMeta, m.vm.Name); err != nil {
+				return err
+			}
+			cmd.Printf("DataVolume %s/%s already exists\n", namespace, dv.Name)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("error creating DataVolume %s: %v", dv.Name, err)
+		}
+		cmd.Printf("DataVolume %s/%s created\n", namespace, dv.Name)
+	}
+	_, err := client.VirtualMachine(namespace).Create(ctx, m.vm, metav1.CreateOptions{})
+	if k8serrors.IsAlreadyExists(err) {
+		existing, err := client.VirtualMachine(namespace).Get(ctx, m.vm.Name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("error getting VirtualMachine %s: %v", m.vm.Name, err)
+		}
+		return checkImportedFor("VirtualMachine", &existing.ObjectMeta, m.vm.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("error creating VirtualMachine %s: %v", m.vm.Name, err)
+	}
+	return nil
+}
+
+// waitForImports waits for the DataVolumes and the DataVolumeTemplates of the VM to be populated
+func (c *command) waitForImports(cmd *cobra.Command, client kubecli.KubevirtClient, namespace string, m *manifests) error {
+	pending := make(map[string]cdiv1.DataVolumePhase)
+	for _, dv := range m.dataVolumes {
+		pending[dv.Name] = cdiv1.PhaseUnset
+	}
+	for _, template := range m.vm.Spec.DataVolumeTemplates {
+		pending[template.Name] = cdiv1.PhaseUnset
+	}
+
+	ctx := cmd.Context()
+	if c.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.timeout)
+		defer cancel()
+	}
+
+	err := wait.PollUntilContextCancel(ctx, importWaitInterval, true, func(ctx context.Context) (bool, error) {
+		for name, lastPhase := range pending {
+			dv, err := client.CdiClient().CdiV1beta1().DataVolumes(namespace).Get(ctx, name, metav1.GetOptions{})
+			if k8serrors.IsNotFound(err) {
+				// DataVolumeTemplates are created by the VM controller
+				continue
+			}
+			if err != nil {
+				return false, err
+			}
+			switch dv.Status.Phase {
+			case cdiv1.Succeeded:
+				cmd.Printf("DataVolume %s/%s imported\n", namespace, name)
+				delete(pending, name)
+			case cdiv1.WaitForFirstConsumer, cdiv1.PendingPopulation:
+				cmd.Printf("DataVolume %s/%s will be imported when the VirtualMachine is started\n", namespace, name)
+				delete(pending, name)
+			case cdiv1.Failed:
+				return false, fmt.Errorf("import of DataVolume %s/%s failed", namespace, name)
+			default:
+				if dv.Status.Phase != lastPhase {
+					cmd.Printf("DataVolume %s/%s: %s %s\n", namespace, name, dv.Status.Phase, dv.Status.Progress)
+					pending[name] = dv.Status.Phase
+				}
+			}
+		}
+		return len(pending) == 0, nil
+	})
+	if err != nil {
+		return fmt.Errorf("error waiting for the volumes of VirtualMachine %s/%s to be imported: %v", namespace, m.vm.Name, err)
+	}
+	return nil
+}
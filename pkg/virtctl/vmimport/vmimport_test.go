@@ -0,0 +1,334 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package vmimport_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+	"kubevirt.io/kubevirt/pkg/virtctl/vmimport"
+)
+
+var _ = Describe("vmimport", func() {
+	const (
+		token        = "test-token"
+		secretName   = "header-secret-test-export"
+		caName       = "export-ca"
+		templateName = "rootdisk-dv"
+		dvName       = "datadisk-pvc"
+	)
+
+	var (
+		kubeClient *k8sfake.Clientset
+		cdiClient  *cdifake.Clientset
+		virtClient *kubevirtfake.Clientset
+	)
+
+	httpSource := func(url string) *cdiv1.DataVolumeSource {
+		return &cdiv1.DataVolumeSource{
+			HTTP: &cdiv1.DataVolumeSourceHTTP{
+				URL:                url,
+				CertConfigMap:      caName,
+				SecretExtraHeaders: []string{secretName},
+			},
+		}
+	}
+
+	// exportedResources returns the resources as served by the export server
+	exportedResources := func() []runtime.Object {
+		vm := libvmi.NewVirtualMachine(libvmi.New(
+			libvmi.WithNamespace("source"),
+			libvmi.WithDataVolume("rootdisk", templateName),
+			libvmi.WithPersistentVolumeClaim("datadisk", dvName),
+			libvmi.WithInterface(libvmi.InterfaceDeviceWithBridgeBinding("secondary")),
+			libvmi.WithNetwork(libvmi.MultusNetwork("secondary", "source/vlan10")),
+		))
+		vm.Name = "testvm"
+		vm.TypeMeta = metav1.TypeMeta{
+			Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+			APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+		}
+		vm.ResourceVersion = "1"
+		vm.Spec.DataVolumeTemplates = []v1.DataVolumeTemplateSpec{{
+			ObjectMeta: metav1.ObjectMeta{Name: templateName},
+			Spec: cdiv1.DataVolumeSpec{
+				Source: httpSource("https://export/volumes/rootdisk-dv/disk.img.gz"),
+				Storage: &cdiv1.StorageSpec{
+					StorageClassName: pointer.P("source-sc"),
+				},
+			},
+		}}
+		return []runtime.Object{
+			&k8sv1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{Kind: "ConfigMap", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: caName, Namespace: "source"},
+				Data:       map[string]string{"ca.pem": "cert data"},
+			},
+			vm,
+			&cdiv1.DataVolume{
+				TypeMeta:   metav1.TypeMeta{Kind: "DataVolume", APIVersion: "cdi.kubevirt.io/v1beta1"},
+				ObjectMeta: metav1.ObjectMeta{Name: dvName, Namespace: "source"},
+				Spec: cdiv1.DataVolumeSpec{
+					Source: httpSource("https://export/volumes/datadisk-pvc/disk.img.gz"),
+					PVC: &k8sv1.PersistentVolumeClaimSpec{
+						StorageClassName: pointer.P("other-sc"),
+					},
+				},
+			},
+		}
+	}
+
+	resourcesToJson := func(resources []runtime.Object) []byte {
+		list := k8sv1.List{TypeMeta: metav1.TypeMeta{Kind: "List", APIVersion: "v1"}}
+		for _, resource := range resources {
+			list.Items = append(list.Items, runtime.RawExtension{Object: resource})
+		}
+		data, err := json.Marshal(list)
+		Expect(err).ToNot(HaveOccurred())
+		return data
+	}
+
+	resourcesToYaml := func(resources []runtime.Object) []byte {
+		var data []byte
+		for _, resource := range resources {
+			resourceBytes, err := yaml.Marshal(resource)
+			Expect(err).ToNot(HaveOccurred())
+			data = append(data, resourceBytes...)
+			data = append(data, []byte("---\n")...)
+		}
+		return data
+	}
+
+	setDataVolumePhase := func(phase cdiv1.DataVolumePhase) {
+		cdiClient.PrependReactor("get", "datavolumes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			get := action.(k8stesting.GetAction)
+			return true, &cdiv1.DataVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: get.GetName(), Namespace: get.GetNamespace()},
+				Status:     cdiv1.DataVolumeStatus{Phase: phase},
+			}, nil
+		})
+	}
+
+	BeforeEach(func() {
+		kubeClient = k8sfake.NewSimpleClientset()
+		cdiClient = cdifake.NewSimpleClientset()
+		virtClient = kubevirtfake.NewSimpleClientset()
+
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+	})
+
+	DescribeTable("should fail with invalid flags", func(expected string, args ...string) {
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{"vmimport"}, args...)...)
+		Expect(cmd()).To(MatchError(ContainSubstring(expected)))
+	},
+		Entry("without a source", vmimport.ErrRequiredSource),
+		Entry("with both sources", "[bundle url] were all set", "--url=https://export", "--bundle=vm.yaml"),
+		Entry("with url and no token", vmimport.ErrRequiredToken, "--url=https://export"),
+		Entry("with more than one VM name", "accepts at most 1 arg(s), received 2", "vm1", "vm2"),
+	)
+
+	It("should import the VirtualMachine from the export server applying the mappings", func() {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Header.Get("x-kubevirt-export-token")).To(Equal(token))
+			Expect(r.Header.Get("Accept")).To(Equal("application/json"))
+			w.Write(resourcesToJson(exportedResources()))
+		}))
+		defer server.Close()
+
+		cmd := testing.NewRepeatableVirtctlCommand("vmimport",
+			vmimport.URL_FLAG+"="+server.URL+"/manifests/all",
+			vmimport.TOKEN_FLAG+"="+token,
+			"--insecure",
+			vmimport.STORAGE_CLASS_MAPPING_FLAG+"=source-sc=destination-sc",
+			vmimport.NETWORK_MAPPING_FLAG+"=source/vlan10=default/bridge",
+			"--no-wait",
+		)
+		Expect(cmd()).To(Succeed())
+
+		cm, err := kubeClient.CoreV1().ConfigMaps(metav1.NamespaceDefault).Get(context.Background(), caName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cm.Data).To(HaveKeyWithValue("ca.pem", "cert data"))
+
+		secret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), secretName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.StringData).To(HaveKeyWithValue("token", "x-kubevirt-export-token:"+token))
+
+		dv, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Get(context.Background(), dvName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dv.Spec.Source.HTTP.URL).To(Equal("https://export/volumes/datadisk-pvc/disk.img.gz"))
+		// Unmapped storage classes are kept
+		Expect(*dv.Spec.PVC.StorageClassName).To(Equal("other-sc"))
+
+		vm, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), "testvm", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(vm.ResourceVersion).To(BeEmpty())
+		Expect(*vm.Spec.DataVolumeTemplates[0].Spec.Storage.StorageClassName).To(Equal("destination-sc"))
+		Expect(vm.Spec.Template.Spec.Networks[0].Multus.NetworkName).To(Equal("default/bridge"))
+	})
+
+	It("should fail when the export server returns an error", func() {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+		defer server.Close()
+
+		cmd := testing.NewRepeatableVirtctlCommand("vmimport",
+			vmimport.URL_FLAG+"="+server.URL,
+			vmimport.TOKEN_FLAG+"="+token,
+			"--insecure",
+		)
+		Expect(cmd()).To(MatchError(ContainSubstring("401 Unauthorized")))
+	})
+
+	Context("from a bundle", func() {
+		var bundlePath string
+
+		writeBundle := func(resources ...runtime.Object) {
+			bundlePath = filepath.Join(GinkgoT().TempDir(), "vm.yaml")
+			Expect(os.WriteFile(bundlePath, resourcesToYaml(resources), 0600)).To(Succeed())
+		}
+
+		It("should import the VirtualMachine under a new name and wait for the volumes", func() {
+			secret := &k8sv1.Secret{
+				TypeMeta:   metav1.TypeMeta{Kind: "Secret", APIVersion: "v1"},
+				ObjectMeta: metav1.ObjectMeta{Name: secretName},
+				StringData: map[string]string{"token": "x-kubevirt-export-token:bundled"},
+			}
+			writeBundle(append(exportedResources(), secret)...)
+			setDataVolumePhase(cdiv1.Succeeded)
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("vmimport", "newvm", vmimport.BUNDLE_FLAG+"="+bundlePath)()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("DataVolume default/" + templateName + " imported"))
+			Expect(string(out)).To(ContainSubstring("DataVolume default/" + dvName + " imported"))
+
+			createdSecret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), secretName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(createdSecret.StringData).To(HaveKeyWithValue("token", "x-kubevirt-export-token:bundled"))
+			_, err = virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), "newvm", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should follow the new name in the backend-storage DataVolume label", func() {
+			backendDV := &cdiv1.DataVolume{
+				TypeMeta: metav1.TypeMeta{Kind: "DataVolume", APIVersion: "cdi.kubevirt.io/v1beta1"},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "persistent-state-for-testvm",
+					Namespace: "source",
+					Labels:    map[string]string{backendstorage.PVCPrefix: "testvm"},
+				},
+				Spec: cdiv1.DataVolumeSpec{
+					Source: httpSource("https://export/volumes/persistent-state-for-testvm/disk.img.gz"),
+				},
+			}
+			writeBundle(append(exportedResources(), backendDV)...)
+
+			cmd := testing.NewRepeatableVirtctlCommand("vmimport", "newvm", vmimport.BUNDLE_FLAG+"="+bundlePath, vmimport.TOKEN_FLAG+"="+token, "--no-wait")
+			Expect(cmd()).To(Succeed())
+
+			dv, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Get(context.Background(), backendDV.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dv.Labels).To(HaveKeyWithValue(backendstorage.PVCPrefix, "newvm"))
+		})
+
+		It("should reuse the objects of an interrupted import", func() {
+			writeBundle(exportedResources()...)
+			cmd := testing.NewRepeatableVirtctlCommand("vmimport", vmimport.BUNDLE_FLAG+"="+bundlePath, vmimport.TOKEN_FLAG+"="+token, "--no-wait")
+			Expect(cmd()).To(Succeed())
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("vmimport", vmimport.BUNDLE_FLAG+"="+bundlePath, vmimport.TOKEN_FLAG+"="+token, "--no-wait")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("DataVolume default/" + dvName + " already exists"))
+		})
+
+		It("should fail if a DataVolume was not created by the import", func() {
+			writeBundle(exportedResources()...)
+			_, err := cdiClient.CdiV1beta1().DataVolumes(metav1.NamespaceDefault).Create(context.Background(), &cdiv1.DataVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: dvName, Namespace: metav1.NamespaceDefault},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			cmd := testing.NewRepeatableVirtctlCommand("vmimport", vmimport.BUNDLE_FLAG+"="+bundlePath, vmimport.TOKEN_FLAG+"="+token, "--no-wait")
+			Expect(cmd()).To(MatchError("DataVolume default/" + dvName + " already exists and was not created by the import of VirtualMachine testvm"))
+		})
+
+		It("should fail if the VirtualMachine was not created by the import", func() {
+			writeBundle(exportedResources()...)
+			_, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.Background(), &v1.VirtualMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "testvm", Namespace: metav1.NamespaceDefault},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			cmd := testing.NewRepeatableVirtctlCommand("vmimport", vmimport.BUNDLE_FLAG+"="+bundlePath, vmimport.TOKEN_FLAG+"="+token, "--no-wait")
+			Expect(cmd()).To(MatchError("VirtualMachine default/testvm already exists and was not created by the import of VirtualMachine testvm"))
+		})
+
+		It("should require the token when the bundle has no secret", func() {
+			writeBundle(exportedResources()...)
+
+			cmd := testing.NewRepeatableVirtctlCommand("vmimport", vmimport.BUNDLE_FLAG+"="+bundlePath)
+			Expect(cmd()).To(MatchError(ContainSubstring("do not contain the " + secretName + " secret")))
+		})
+
+		It("should fail if the bundle has no VirtualMachine", func() {
+			writeBundle(exportedResources()[0])
+
+			cmd := testing.NewRepeatableVirtctlCommand("vmimport", vmimport.BUNDLE_FLAG+"="+bundlePath, vmimport.TOKEN_FLAG+"="+token)
+			Expect(cmd()).To(MatchError("the manifests do not contain a VirtualMachine"))
+		})
+
+		It("should fail if an import fails", func() {
+			writeBundle(exportedResources()...)
+			setDataVolumePhase(cdiv1.Failed)
+
+			cmd := testing.NewRepeatableVirtctlCommand("vmimport", vmimport.BUNDLE_FLAG+"="+bundlePath, vmimport.TOKEN_FLAG+"="+token)
+			Expect(cmd()).To(MatchError(ContainSubstring("failed")))
+		})
+	})
+})
@@ -21,9 +21,20 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
 )
 
+// BackupScheduleLabel is set on the backups created by a
+// VirtualMachineBackupSchedule to the name of the schedule
+const BackupScheduleLabel = "backup.kubevirt.io/schedule"
+
+// BackupRestoreLabel is set on the PVCs and the VM created by a
+// VirtualMachineBackupRestore to the name of the restore
+const BackupRestoreLabel = "backup.kubevirt.io/restore"
+
 // BackupMode is the const type for the backup possible modes
 type BackupMode string
 
@@ -31,6 +42,9 @@ const (
 	// PushMode defines backup which pushes the backup output
 	// to a provided PVC - this is the default behavior
 	PushMode BackupMode = "Push"
+	// PullMode defines backup which exposes the backup output
+	// as NBD exports that a backup application can pull from
+	PullMode BackupMode = "Pull"
 )
 
 type BackupCheckpoint struct {
@@ -54,6 +68,8 @@ type BackupCmd string
 
 const (
 	Start BackupCmd = "Start"
+	// Stop ends a running pull mode backup job and tears down its exports
+	Stop BackupCmd = "Stop"
 )
 
 // BackupOptions are options used to configure virtual machine backup job
@@ -65,6 +81,12 @@ type BackupOptions struct {
 	Incremental     *string      `json:"incremental,omitempty"`
 	PushPath        *string      `json:"pushPath,omitempty"`
 	SkipQuiesce     bool         `json:"skipQuiesce,omitempty"`
+	// ScratchPath is the directory holding the pull mode scratch files,
+	// when not set they are kept in the launcher pod
+	ScratchPath *string `json:"scratchPath,omitempty"`
+	// TokenHash is the hex encoded SHA-256 of the token a pull mode
+	// client has to present to access the exports
+	TokenHash *string `json:"tokenHash,omitempty"`
 }
 
 // VirtualMachineBackupTracker defines the way to track the latest checkpoint of
@@ -134,6 +156,7 @@ type VirtualMachineBackupList struct {
 // VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource
 // +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable after creation"
 // +kubebuilder:validation:XValidation:rule="(has(self.mode) && self.mode != 'Push') || (has(self.pvcName) && self.pvcName != \"\")",message="pvcName must be provided when mode is unset or Push"
+// +kubebuilder:validation:XValidation:rule="!has(self.ttlDuration) || (has(self.mode) && self.mode == 'Pull')",message="ttlDuration can only be set when mode is Pull"
 type VirtualMachineBackupSpec struct {
 	// Source specifies the backup source - either a VirtualMachine or a VirtualMachineBackupTracker.
 	// When Kind is VirtualMachine: performs a backup of the specified VM.
@@ -146,14 +169,21 @@ type VirtualMachineBackupSpec struct {
 	// +kubebuilder:validation:XValidation:rule="self.name != ''",message="name is required"
 	Source corev1.TypedLocalObjectReference `json:"source"`
 	// +optional
-	// +kubebuilder:validation:Enum=Push
+	// +kubebuilder:validation:Enum=Push;Pull
 	// Mode specifies the way the backup output will be recieved
 	Mode *BackupMode `json:"mode,omitempty"`
 	// +optional
 	// PvcName required in push mode. Specifies the name of the PVC
-	// where the backup output will be stored
+	// where the backup output will be stored.
+	// In pull mode it optionally specifies a PVC used for the scratch
+	// files holding the data overwritten by the guest during the backup
 	PvcName *string `json:"pvcName,omitempty"`
 	// +optional
+	// TTLDuration limits the lifetime of a pull mode backup export.
+	// When it expires the backup job is stopped and the backup fails.
+	// Defaults to 2 hours
+	TTLDuration *metav1.Duration `json:"ttlDuration,omitempty"`
+	// +optional
 	// SkipQuiesce indicates whether the VM's filesystem shoule not be quiesced before the backup
 	SkipQuiesce bool `json:"skipQuiesce,omitempty"`
 	// +optional
@@ -172,6 +202,244 @@ type VirtualMachineBackupStatus struct {
 	// +optional
 	// CheckpointName the name of the checkpoint created for the current backup
 	CheckpointName *string `json:"checkpointName,omitempty"`
+	// +optional
+	// Endpoint describes how to reach the exports of a pull mode backup
+	Endpoint *BackupEndpoint `json:"endpoint,omitempty"`
+	// +optional
+	// BaseCheckpointName is the checkpoint an incremental backup is based on
+	BaseCheckpointName *string `json:"baseCheckpointName,omitempty"`
+	// +optional
+	// VirtualMachine is the manifest of the backed up VM captured when the backup started
+	VirtualMachine *VirtualMachine `json:"virtualMachine,omitempty"`
+	// +optional
+	// +listType=atomic
+	// Volumes describes the backed up volumes
+	Volumes []BackupVolume `json:"volumes,omitempty"`
+}
+
+// VirtualMachine is the manifest of a backed up VM
+type VirtualMachine struct {
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +nullable
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// VirtualMachineSpec contains the VirtualMachine specification.
+	Spec v1.VirtualMachineSpec `json:"spec,omitempty" valid:"required"`
+}
+
+// BackupVolume describes a backed up volume and the storage it was backed up from
+type BackupVolume struct {
+	// VolumeName is the name of the volume in the VM spec
+	VolumeName string `json:"volumeName"`
+	// +optional
+	// Path is the location of the volume backup relative to the root of the
+	// backup PVC, it is only set for push mode backups
+	Path string `json:"path,omitempty"`
+	// +optional
+	// Capacity is the size of the backed up volume
+	Capacity *resource.Quantity `json:"capacity,omitempty"`
+	// +optional
+	// VolumeMode is the volume mode of the PVC the volume was backed up from
+	VolumeMode *corev1.PersistentVolumeMode `json:"volumeMode,omitempty"`
+	// +optional
+	// +listType=atomic
+	// AccessModes are the access modes of the PVC the volume was backed up from
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+	// +optional
+	// StorageClassName is the storage class of the PVC the volume was backed up from
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// BackupEndpoint describes the NBD server exposing a pull mode backup
+type BackupEndpoint struct {
+	// ServiceName is the name of the Service publishing the NBD server
+	ServiceName string `json:"serviceName"`
+	// Port is the Service port of the NBD server
+	Port int32 `json:"port"`
+	// TLSServerName is the name the NBD server certificate is issued for.
+	// Clients connect with TLS negotiated through NBD_OPT_STARTTLS
+	TLSServerName string `json:"tlsServerName"`
+	// +optional
+	// CACert is the PEM encoded CA bundle to verify the NBD server certificate
+	CACert string `json:"caCert,omitempty"`
+	// TokenSecretRef is the name of the Secret holding the access token.
+	// The token is presented by prefixing the export name with "<token>/"
+	TokenSecretRef string `json:"tokenSecretRef"`
+	// +optional
+	// +listType=atomic
+	// Exports lists the NBD exports, one per backed up volume
+	Exports []BackupExport `json:"exports,omitempty"`
+}
+
+// BackupExport describes a single NBD export of a pull mode backup
+type BackupExport struct {
+	// VolumeName is the name of the backed up volume
+	VolumeName string `json:"volumeName"`
+	// ExportName is the NBD export name of the volume
+	ExportName string `json:"exportName"`
+	// +optional
+	// DirtyBitmap is the NBD metadata context exposing the extents changed
+	// since the checkpoint the incremental backup is based on
+	DirtyBitmap *string `json:"dirtyBitmap,omitempty"`
+}
+
+// VirtualMachineBackupSchedule periodically backs up a VM through a
+// VirtualMachineBackupTracker and prunes the backups past their retention
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineBackupScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineBackupScheduleStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineBackupScheduleList is a list of VirtualMachineBackupSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +listType=atomic
+	Items []VirtualMachineBackupSchedule `json:"items"`
+}
+
+// VirtualMachineBackupScheduleSpec is the spec for a VirtualMachineBackupSchedule resource
+type VirtualMachineBackupScheduleSpec struct {
+	// Schedule is a cron expression in the standard five field format
+	// defining when backups are taken. It is evaluated in UTC
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+	// +optional
+	// Suspend stops the creation of new backups, the retention is still enforced
+	Suspend bool `json:"suspend,omitempty"`
+	// TrackerName is the name of the VirtualMachineBackupTracker the backups are
+	// taken through. It selects the source VM and keeps the backups incremental
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="trackerName is immutable"
+	TrackerName string `json:"trackerName"`
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// FullBackupInterval is the number of incremental backups taken between two
+	// full backups. When unset only the first backup of the tracker is a full backup
+	FullBackupInterval *int32 `json:"fullBackupInterval,omitempty"`
+	// PvcSpec is the spec of the PVC created for each backup to push its output to
+	PvcSpec corev1.PersistentVolumeClaimSpec `json:"pvcSpec"`
+	// +optional
+	// SkipQuiesce indicates whether the VM's filesystem should not be quiesced before the backups
+	SkipQuiesce bool `json:"skipQuiesce,omitempty"`
+	// +optional
+	// Retention selects the backups which are kept, the others are deleted
+	// along with their PVCs. When unset all backups are kept
+	Retention *BackupRetention `json:"retention,omitempty"`
+}
+
+// BackupRetention selects the backups kept by a VirtualMachineBackupSchedule.
+// A backup is kept when any of the rules selects it. The backups an incremental
+// backup is based on are kept for as long as the incremental backup is.
+type BackupRetention struct {
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// KeepLast keeps the given number of most recent backups
+	KeepLast *int32 `json:"keepLast,omitempty"`
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// KeepDaily keeps the most recent backup of each of the given number of most recent days
+	KeepDaily *int32 `json:"keepDaily,omitempty"`
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// KeepWeekly keeps the most recent backup of each of the given number of most recent weeks
+	KeepWeekly *int32 `json:"keepWeekly,omitempty"`
+}
+
+// VirtualMachineBackupScheduleStatus is the status for a VirtualMachineBackupSchedule resource
+type VirtualMachineBackupScheduleStatus struct {
+	// +optional
+	// LastScheduleTime is the time the most recent backup was scheduled at
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// +optional
+	// NextScheduleTime is the time the next backup is scheduled at
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+	// +optional
+	// LastBackupName is the name of the most recently created backup
+	LastBackupName *string `json:"lastBackupName,omitempty"`
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineBackupRestore restores a VM from push mode backups. The backed
+// up volumes are rebuilt into new PVCs by applying the backups in order, the
+// full backup first, and the VM is recreated from the manifest the backups captured
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineBackupRestoreSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineBackupRestoreStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineBackupRestoreList is a list of VirtualMachineBackupRestore resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineBackupRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	// +listType=atomic
+	Items []VirtualMachineBackupRestore `json:"items"`
+}
+
+// VirtualMachineBackupRestoreSpec is the spec for a VirtualMachineBackupRestore resource
+// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="spec is immutable after creation"
+type VirtualMachineBackupRestoreSpec struct {
+	// Source specifies the backup to restore - either a VirtualMachineBackup or a
+	// VirtualMachineBackupTracker, which restores the most recent backup of the tracker.
+	// An incremental backup is restored together with the backups it is based on,
+	// back to the last full backup.
+	// +kubebuilder:validation:XValidation:rule="has(self.apiGroup) && self.apiGroup == 'backup.kubevirt.io'",message="apiGroup must be backup.kubevirt.io"
+	// +kubebuilder:validation:XValidation:rule="self.kind == 'VirtualMachineBackup' || self.kind == 'VirtualMachineBackupTracker'",message="kind must be VirtualMachineBackup or VirtualMachineBackupTracker"
+	// +kubebuilder:validation:XValidation:rule="self.name != ''",message="name is required"
+	Source corev1.TypedLocalObjectReference `json:"source"`
+	// Target specifies the VirtualMachine to create, it must not exist
+	// +kubebuilder:validation:XValidation:rule="has(self.apiGroup) && self.apiGroup == 'kubevirt.io'",message="apiGroup must be kubevirt.io"
+	// +kubebuilder:validation:XValidation:rule="self.kind == 'VirtualMachine'",message="kind must be VirtualMachine"
+	// +kubebuilder:validation:XValidation:rule="self.name != ''",message="name is required"
+	Target corev1.TypedLocalObjectReference `json:"target"`
+	// +optional
+	// StorageClassName overrides the storage class of the restored PVCs
+	StorageClassName *string `json:"storageClassName,omitempty"`
+}
+
+// VirtualMachineBackupRestoreStatus is the status for a VirtualMachineBackupRestore resource
+type VirtualMachineBackupRestoreStatus struct {
+	// +optional
+	// +listType=atomic
+	// Backups are the names of the restored backups in the order they are applied
+	Backups []string `json:"backups,omitempty"`
+	// +optional
+	// +listType=atomic
+	// Restores lists the PVCs the volumes are restored to
+	Restores []VolumeRestore `json:"restores,omitempty"`
+	// +optional
+	// RestoreTime is the time the restore completed
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VolumeRestore is the PVC a backed up volume is restored to
+type VolumeRestore struct {
+	// VolumeName is the name of the volume in the VM spec
+	VolumeName string `json:"volumeName"`
+	// PersistentVolumeClaimName is the name of the restored PVC
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName"`
 }
 
 // ConditionType is the const type for Conditions
@@ -189,6 +457,10 @@ const (
 
 	// ConditionDeleting indicates the backup is deleteing
 	ConditionDeleting ConditionType = "Deleting"
+
+	// ConditionFailure indicates the backup schedule can not create backups,
+	// the restore failed or the pull mode backup expired before it was stopped
+	ConditionFailure ConditionType = "Failure"
 )
 
 // Condition defines conditions
@@ -8,7 +8,9 @@ func (BackupCheckpoint) SwaggerDoc() map[string]string {
 
 func (BackupOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "BackupOptions are options used to configure virtual machine backup job",
+		"":            "BackupOptions are options used to configure virtual machine backup job",
+		"scratchPath": "ScratchPath is the directory holding the pull mode scratch files,\nwhen not set they are kept in the launcher pod",
+		"tokenHash":   "TokenHash is the hex encoded SHA-256 of the token a pull mode\nclient has to present to access the exports",
 	}
 }
 
@@ -55,10 +57,11 @@ func (VirtualMachineBackupList) SwaggerDoc() map[string]string {
 
 func (VirtualMachineBackupSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                "VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource\n+kubebuilder:validation:XValidation:rule=\"self == oldSelf\",message=\"spec is immutable after creation\"\n+kubebuilder:validation:XValidation:rule=\"(has(self.mode) && self.mode != 'Push') || (has(self.pvcName) && self.pvcName != \\\"\\\")\",message=\"pvcName must be provided when mode is unset or Push\"",
+		"":                "VirtualMachineBackupSpec is the spec for a VirtualMachineBackup resource\n+kubebuilder:validation:XValidation:rule=\"self == oldSelf\",message=\"spec is immutable after creation\"\n+kubebuilder:validation:XValidation:rule=\"(has(self.mode) && self.mode != 'Push') || (has(self.pvcName) && self.pvcName != \\\"\\\")\",message=\"pvcName must be provided when mode is unset or Push\"\n+kubebuilder:validation:XValidation:rule=\"!has(self.ttlDuration) || (has(self.mode) && self.mode == 'Pull')\",message=\"ttlDuration can only be set when mode is Pull\"",
 		"source":          "Source specifies the backup source - either a VirtualMachine or a VirtualMachineBackupTracker.\nWhen Kind is VirtualMachine: performs a backup of the specified VM.\nWhen Kind is VirtualMachineBackupTracker: uses the tracker to get the source VM\nand the base checkpoint for incremental backup. The tracker will be updated\nwith the new checkpoint after backup completion.\n+kubebuilder:validation:XValidation:rule=\"has(self.apiGroup)\",message=\"apiGroup is required\"\n+kubebuilder:validation:XValidation:rule=\"!has(self.apiGroup) || self.apiGroup == 'kubevirt.io' || self.apiGroup == 'backup.kubevirt.io'\",message=\"apiGroup must be kubevirt.io or backup.kubevirt.io\"\n+kubebuilder:validation:XValidation:rule=\"!has(self.apiGroup) || (self.apiGroup == 'kubevirt.io' && self.kind == 'VirtualMachine') || (self.apiGroup == 'backup.kubevirt.io' && self.kind == 'VirtualMachineBackupTracker')\",message=\"kind must be VirtualMachine for kubevirt.io or VirtualMachineBackupTracker for backup.kubevirt.io\"\n+kubebuilder:validation:XValidation:rule=\"self.name != ''\",message=\"name is required\"",
-		"mode":            "+optional\n+kubebuilder:validation:Enum=Push\nMode specifies the way the backup output will be recieved",
-		"pvcName":         "+optional\nPvcName required in push mode. Specifies the name of the PVC\nwhere the backup output will be stored",
+		"mode":            "+optional\n+kubebuilder:validation:Enum=Push;Pull\nMode specifies the way the backup output will be recieved",
+		"pvcName":         "+optional\nPvcName required in push mode. Specifies the name of the PVC\nwhere the backup output will be stored.\nIn pull mode it optionally specifies a PVC used for the scratch\nfiles holding the data overwritten by the guest during the backup",
+		"ttlDuration":     "+optional\nTTLDuration limits the lifetime of a pull mode backup export.\nWhen it expires the backup job is stopped and the backup fails.\nDefaults to 2 hours",
 		"skipQuiesce":     "+optional\nSkipQuiesce indicates whether the VM's filesystem shoule not be quiesced before the backup",
 		"forceFullBackup": "+optional\nForceFullBackup indicates that a full backup is desired",
 	}
@@ -66,10 +69,141 @@ func (VirtualMachineBackupSpec) SwaggerDoc() map[string]string {
 
 func (VirtualMachineBackupStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":               "VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource",
-		"type":           "+optional\nType indicates if the backup was full or incremental",
-		"conditions":     "+optional\n+listType=atomic",
-		"checkpointName": "+optional\nCheckpointName the name of the checkpoint created for the current backup",
+		"":                   "VirtualMachineBackupStatus is the status for a VirtualMachineBackup resource",
+		"type":               "+optional\nType indicates if the backup was full or incremental",
+		"conditions":         "+optional\n+listType=atomic",
+		"checkpointName":     "+optional\nCheckpointName the name of the checkpoint created for the current backup",
+		"endpoint":           "+optional\nEndpoint describes how to reach the exports of a pull mode backup",
+		"baseCheckpointName": "+optional\nBaseCheckpointName is the checkpoint an incremental backup is based on",
+		"virtualMachine":     "+optional\nVirtualMachine is the manifest of the backed up VM captured when the backup started",
+		"volumes":            "+optional\n+listType=atomic\nVolumes describes the backed up volumes",
+	}
+}
+
+func (VirtualMachine) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtualMachine is the manifest of a backed up VM",
+		"spec": "VirtualMachineSpec contains the VirtualMachine specification.",
+	}
+}
+
+func (BackupVolume) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "BackupVolume describes a backed up volume and the storage it was backed up from",
+		"volumeName":       "VolumeName is the name of the volume in the VM spec",
+		"path":             "+optional\nPath is the location of the volume backup relative to the root of the\nbackup PVC, it is only set for push mode backups",
+		"capacity":         "+optional\nCapacity is the size of the backed up volume",
+		"volumeMode":       "+optional\nVolumeMode is the volume mode of the PVC the volume was backed up from",
+		"accessModes":      "+optional\n+listType=atomic\nAccessModes are the access modes of the PVC the volume was backed up from",
+		"storageClassName": "+optional\nStorageClassName is the storage class of the PVC the volume was backed up from",
+	}
+}
+
+func (BackupEndpoint) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "BackupEndpoint describes the NBD server exposing a pull mode backup",
+		"serviceName":    "ServiceName is the name of the Service publishing the NBD server",
+		"port":           "Port is the Service port of the NBD server",
+		"tlsServerName":  "TLSServerName is the name the NBD server certificate is issued for.\nClients connect with TLS negotiated through NBD_OPT_STARTTLS",
+		"caCert":         "+optional\nCACert is the PEM encoded CA bundle to verify the NBD server certificate",
+		"tokenSecretRef": "TokenSecretRef is the name of the Secret holding the access token.\nThe token is presented by prefixing the export name with \"<token>/\"",
+		"exports":        "+optional\n+listType=atomic\nExports lists the NBD exports, one per backed up volume",
+	}
+}
+
+func (BackupExport) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "BackupExport describes a single NBD export of a pull mode backup",
+		"volumeName":  "VolumeName is the name of the backed up volume",
+		"exportName":  "ExportName is the NBD export name of the volume",
+		"dirtyBitmap": "+optional\nDirtyBitmap is the NBD metadata context exposing the extents changed\nsince the checkpoint the incremental backup is based on",
+	}
+}
+
+func (VirtualMachineBackupSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineBackupSchedule periodically backs up a VM through a\nVirtualMachineBackupTracker and prunes the backups past their retention\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineBackupScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "VirtualMachineBackupScheduleList is a list of VirtualMachineBackupSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
+
+func (VirtualMachineBackupScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VirtualMachineBackupScheduleSpec is the spec for a VirtualMachineBackupSchedule resource",
+		"schedule":           "Schedule is a cron expression in the standard five field format\ndefining when backups are taken. It is evaluated in UTC\n+kubebuilder:validation:MinLength=1",
+		"suspend":            "+optional\nSuspend stops the creation of new backups, the retention is still enforced",
+		"trackerName":        "TrackerName is the name of the VirtualMachineBackupTracker the backups are\ntaken through. It selects the source VM and keeps the backups incremental\n+kubebuilder:validation:MinLength=1\n+kubebuilder:validation:XValidation:rule=\"self == oldSelf\",message=\"trackerName is immutable\"",
+		"fullBackupInterval": "+optional\n+kubebuilder:validation:Minimum=0\nFullBackupInterval is the number of incremental backups taken between two\nfull backups. When unset only the first backup of the tracker is a full backup",
+		"pvcSpec":            "PvcSpec is the spec of the PVC created for each backup to push its output to",
+		"skipQuiesce":        "+optional\nSkipQuiesce indicates whether the VM's filesystem should not be quiesced before the backups",
+		"retention":          "+optional\nRetention selects the backups which are kept, the others are deleted\nalong with their PVCs. When unset all backups are kept",
+	}
+}
+
+func (BackupRetention) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "BackupRetention selects the backups kept by a VirtualMachineBackupSchedule.\nA backup is kept when any of the rules selects it. The backups an incremental\nbackup is based on are kept for as long as the incremental backup is.",
+		"keepLast":   "+optional\n+kubebuilder:validation:Minimum=1\nKeepLast keeps the given number of most recent backups",
+		"keepDaily":  "+optional\n+kubebuilder:validation:Minimum=1\nKeepDaily keeps the most recent backup of each of the given number of most recent days",
+		"keepWeekly": "+optional\n+kubebuilder:validation:Minimum=1\nKeepWeekly keeps the most recent backup of each of the given number of most recent weeks",
+	}
+}
+
+func (VirtualMachineBackupScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineBackupScheduleStatus is the status for a VirtualMachineBackupSchedule resource",
+		"lastScheduleTime": "+optional\nLastScheduleTime is the time the most recent backup was scheduled at",
+		"nextScheduleTime": "+optional\nNextScheduleTime is the time the next backup is scheduled at",
+		"lastBackupName":   "+optional\nLastBackupName is the name of the most recently created backup",
+		"conditions":       "+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineBackupRestore) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineBackupRestore restores a VM from push mode backups. The backed\nup volumes are rebuilt into new PVCs by applying the backups in order, the\nfull backup first, and the VM is recreated from the manifest the backups captured\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineBackupRestoreList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "VirtualMachineBackupRestoreList is a list of VirtualMachineBackupRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"items": "+listType=atomic",
+	}
+}
+
+func (VirtualMachineBackupRestoreSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineBackupRestoreSpec is the spec for a VirtualMachineBackupRestore resource\n+kubebuilder:validation:XValidation:rule=\"self == oldSelf\",message=\"spec is immutable after creation\"",
+		"source":           "Source specifies the backup to restore - either a VirtualMachineBackup or a\nVirtualMachineBackupTracker, which restores the most recent backup of the tracker.\nAn incremental backup is restored together with the backups it is based on,\nback to the last full backup.\n+kubebuilder:validation:XValidation:rule=\"has(self.apiGroup) && self.apiGroup == 'backup.kubevirt.io'\",message=\"apiGroup must be backup.kubevirt.io\"\n+kubebuilder:validation:XValidation:rule=\"self.kind == 'VirtualMachineBackup' || self.kind == 'VirtualMachineBackupTracker'\",message=\"kind must be VirtualMachineBackup or VirtualMachineBackupTracker\"\n+kubebuilder:validation:XValidation:rule=\"self.name != ''\",message=\"name is required\"",
+		"target":           "Target specifies the VirtualMachine to create, it must not exist\n+kubebuilder:validation:XValidation:rule=\"has(self.apiGroup) && self.apiGroup == 'kubevirt.io'\",message=\"apiGroup must be kubevirt.io\"\n+kubebuilder:validation:XValidation:rule=\"self.kind == 'VirtualMachine'\",message=\"kind must be VirtualMachine\"\n+kubebuilder:validation:XValidation:rule=\"self.name != ''\",message=\"name is required\"",
+		"storageClassName": "+optional\nStorageClassName overrides the storage class of the restored PVCs",
+	}
+}
+
+func (VirtualMachineBackupRestoreStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineBackupRestoreStatus is the status for a VirtualMachineBackupRestore resource",
+		"backups":     "+optional\n+listType=atomic\nBackups are the names of the restored backups in the order they are applied",
+		"restores":    "+optional\n+listType=atomic\nRestores lists the PVCs the volumes are restored to",
+		"restoreTime": "+optional\nRestoreTime is the time the restore completed",
+		"conditions":  "+optional\n+listType=atomic",
+	}
+}
+
+func (VolumeRestore) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "VolumeRestore is the PVC a backed up volume is restored to",
+		"volumeName":                "VolumeName is the name of the volume in the VM spec",
+		"persistentVolumeClaimName": "PersistentVolumeClaimName is the name of the restored PVC",
 	}
 }
 
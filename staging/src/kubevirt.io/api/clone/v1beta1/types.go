@@ -66,6 +66,14 @@ type VirtualMachineCloneSpec struct {
 	// +optional
 	Target *corev1.TypedLocalObjectReference `json:"target,omitempty"`
 
+	// TargetNamespace is the namespace the target is created in. If not provided, the target is
+	// created in the namespace of the clone. When it differs from the clone's namespace, the requester
+	// must be allowed to create VirtualMachines in the target namespace and to clone DataVolumes from
+	// the clone's namespace. The volumes are copied by CDI with the same cross-namespace mechanism
+	// that is used for DataVolume clones.
+	// +optional
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+
 	// Example use: "!some/key*".
 	// For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.
 	// +optional
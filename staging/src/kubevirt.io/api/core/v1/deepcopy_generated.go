@@ -375,6 +375,22 @@ func (in *CertConfig) DeepCopy() *CertConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangedBlockExtent) DeepCopyInto(out *ChangedBlockExtent) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangedBlockExtent.
+func (in *ChangedBlockExtent) DeepCopy() *ChangedBlockExtent {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangedBlockExtent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ChangedBlockTrackingSelectors) DeepCopyInto(out *ChangedBlockTrackingSelectors) {
 	*out = *in
@@ -422,6 +438,45 @@ func (in *ChangedBlockTrackingStatus) DeepCopy() *ChangedBlockTrackingStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangedBlocksList) DeepCopyInto(out *ChangedBlocksList) {
+	*out = *in
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]DiskChangedBlocks, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangedBlocksList.
+func (in *ChangedBlocksList) DeepCopy() *ChangedBlocksList {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangedBlocksList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChangedBlocksOptions) DeepCopyInto(out *ChangedBlocksOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChangedBlocksOptions.
+func (in *ChangedBlocksOptions) DeepCopy() *ChangedBlocksOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ChangedBlocksOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chassis) DeepCopyInto(out *Chassis) {
 	*out = *in
@@ -741,9 +796,31 @@ func (in *ContainerDiskInfo) DeepCopy() *ContainerDiskInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ContainerDiskPersistentOverlay) DeepCopyInto(out *ContainerDiskPersistentOverlay) {
+	*out = *in
+	out.Size = in.Size.DeepCopy()
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ContainerDiskPersistentOverlay.
+func (in *ContainerDiskPersistentOverlay) DeepCopy() *ContainerDiskPersistentOverlay {
+	if in == nil {
+		return nil
+	}
+	out := new(ContainerDiskPersistentOverlay)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerDiskSource) DeepCopyInto(out *ContainerDiskSource) {
 	*out = *in
+	if in.PersistentOverlay != nil {
+		in, out := &in.PersistentOverlay, &out.PersistentOverlay
+		*out = new(ContainerDiskPersistentOverlay)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -773,6 +850,48 @@ func (in *ControllerRevisionRef) DeepCopy() *ControllerRevisionRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrashMemoryDump) DeepCopyInto(out *CrashMemoryDump) {
+	*out = *in
+	if in.MaxDumps != nil {
+		in, out := &in.MaxDumps, &out.MaxDumps
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrashMemoryDump.
+func (in *CrashMemoryDump) DeepCopy() *CrashMemoryDump {
+	if in == nil {
+		return nil
+	}
+	out := new(CrashMemoryDump)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CrashPolicy) DeepCopyInto(out *CrashPolicy) {
+	*out = *in
+	if in.MemoryDump != nil {
+		in, out := &in.MemoryDump, &out.MemoryDump
+		*out = new(CrashMemoryDump)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CrashPolicy.
+func (in *CrashPolicy) DeepCopy() *CrashPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CrashPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomBlockSize) DeepCopyInto(out *CustomBlockSize) {
 	*out = *in
@@ -1384,6 +1503,16 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(DiskEncryption)
+		**out = **in
+	}
 	return
 }
 
@@ -1397,6 +1526,27 @@ func (in *Disk) DeepCopy() *Disk {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskChangedBlocks) DeepCopyInto(out *DiskChangedBlocks) {
+	*out = *in
+	if in.Extents != nil {
+		in, out := &in.Extents, &out.Extents
+		*out = make([]ChangedBlockExtent, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskChangedBlocks.
+func (in *DiskChangedBlocks) DeepCopy() *DiskChangedBlocks {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskChangedBlocks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskDevice) DeepCopyInto(out *DiskDevice) {
 	*out = *in
@@ -1428,6 +1578,23 @@ func (in *DiskDevice) DeepCopy() *DiskDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskEncryption) DeepCopyInto(out *DiskEncryption) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskEncryption.
+func (in *DiskEncryption) DeepCopy() *DiskEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskIOThreads) DeepCopyInto(out *DiskIOThreads) {
 	*out = *in
@@ -1449,6 +1616,43 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(DiskIOTuneBurst)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTuneBurst) DeepCopyInto(out *DiskIOTuneBurst) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTuneBurst.
+func (in *DiskIOTuneBurst) DeepCopy() *DiskIOTuneBurst {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTuneBurst)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
@@ -2062,6 +2266,109 @@ func (in *Flags) DeepCopy() *Flags {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeHook) DeepCopyInto(out *FreezeHook) {
+	*out = *in
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(FreezeHookExec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Script != nil {
+		in, out := &in.Script, &out.Script
+		*out = new(FreezeHookScript)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeHook.
+func (in *FreezeHook) DeepCopy() *FreezeHook {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeHookExec) DeepCopyInto(out *FreezeHookExec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeHookExec.
+func (in *FreezeHookExec) DeepCopy() *FreezeHookExec {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeHookExec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeHookScript) DeepCopyInto(out *FreezeHookScript) {
+	*out = *in
+	if in.Interpreter != nil {
+		in, out := &in.Interpreter, &out.Interpreter
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeHookScript.
+func (in *FreezeHookScript) DeepCopy() *FreezeHookScript {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeHookScript)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeHooks) DeepCopyInto(out *FreezeHooks) {
+	*out = *in
+	if in.PreFreeze != nil {
+		in, out := &in.PreFreeze, &out.PreFreeze
+		*out = make([]FreezeHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PostThaw != nil {
+		in, out := &in.PostThaw, &out.PostThaw
+		*out = make([]FreezeHook, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeHooks.
+func (in *FreezeHooks) DeepCopy() *FreezeHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreezeUnfreezeTimeout) DeepCopyInto(out *FreezeUnfreezeTimeout) {
 	*out = *in
@@ -3174,6 +3481,18 @@ func (in *KubeVirtWorkloadUpdateStrategy) DeepCopyInto(out *KubeVirtWorkloadUpda
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowNodeDrainOutsideMaintenanceWindows != nil {
+		in, out := &in.AllowNodeDrainOutsideMaintenanceWindows, &out.AllowNodeDrainOutsideMaintenanceWindows
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -3299,6 +3618,27 @@ func (in *Machine) DeepCopy() *Machine {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
 	*out = *in
@@ -3427,6 +3767,86 @@ func (in *MemoryStatus) DeepCopy() *MemoryStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrateCheckNode) DeepCopyInto(out *MigrateCheckNode) {
+	*out = *in
+	if in.AvailableMemory != nil {
+		in, out := &in.AvailableMemory, &out.AvailableMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrateCheckNode.
+func (in *MigrateCheckNode) DeepCopy() *MigrateCheckNode {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrateCheckNode)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrateCheckResult) DeepCopyInto(out *MigrateCheckResult) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MigrationPolicyName != nil {
+		in, out := &in.MigrationPolicyName, &out.MigrationPolicyName
+		*out = new(string)
+		**out = **in
+	}
+	if in.MigrationConfiguration != nil {
+		in, out := &in.MigrationConfiguration, &out.MigrationConfiguration
+		*out = new(MigrationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]MigrateCheckNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RejectedNodes != nil {
+		in, out := &in.RejectedNodes, &out.RejectedNodes
+		*out = make([]MigrateCheckNode, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrateCheckResult.
+func (in *MigrateCheckResult) DeepCopy() *MigrateCheckResult {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrateCheckResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MigrateCheckResult) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrateOptions) DeepCopyInto(out *MigrateOptions) {
 	*out = *in
@@ -3456,6 +3876,32 @@ func (in *MigrateOptions) DeepCopy() *MigrateOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationCompression) DeepCopyInto(out *MigrationCompression) {
+	*out = *in
+	if in.Level != nil {
+		in, out := &in.Level, &out.Level
+		*out = new(int32)
+		**out = **in
+	}
+	if in.XBZRLECacheSize != nil {
+		in, out := &in.XBZRLECacheSize, &out.XBZRLECacheSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationCompression.
+func (in *MigrationCompression) DeepCopy() *MigrationCompression {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationCompression)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 	*out = *in
@@ -3529,6 +3975,11 @@ func (in *MigrationConfiguration) DeepCopyInto(out *MigrationConfiguration) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(MigrationCompression)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4276,6 +4727,27 @@ func (in *RemoveVolumeOptions) DeepCopy() *RemoveVolumeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResetContainerDiskOptions) DeepCopyInto(out *ResetContainerDiskOptions) {
+	*out = *in
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResetContainerDiskOptions.
+func (in *ResetContainerDiskOptions) DeepCopy() *ResetContainerDiskOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ResetContainerDiskOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceRequirements) DeepCopyInto(out *ResourceRequirements) {
 	*out = *in
@@ -4778,6 +5250,11 @@ func (in *StorageMigratedVolumeInfo) DeepCopyInto(out *StorageMigratedVolumeInfo
 		*out = new(PersistentVolumeClaimInfo)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(VolumeMigrationProgress)
+		**out = **in
+	}
 	return
 }
 
@@ -5316,6 +5793,27 @@ func (in *VirtualMachineInstance) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceBackupExport) DeepCopyInto(out *VirtualMachineInstanceBackupExport) {
+	*out = *in
+	if in.DirtyBitmap != nil {
+		in, out := &in.DirtyBitmap, &out.DirtyBitmap
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceBackupExport.
+func (in *VirtualMachineInstanceBackupExport) DeepCopy() *VirtualMachineInstanceBackupExport {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceBackupExport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceBackupStatus) DeepCopyInto(out *VirtualMachineInstanceBackupStatus) {
 	*out = *in
@@ -5337,6 +5835,18 @@ func (in *VirtualMachineInstanceBackupStatus) DeepCopyInto(out *VirtualMachineIn
 		*out = new(string)
 		**out = **in
 	}
+	if in.PullEndpoint != nil {
+		in, out := &in.PullEndpoint, &out.PullEndpoint
+		*out = new(string)
+		**out = **in
+	}
+	if in.Exports != nil {
+		in, out := &in.Exports, &out.Exports
+		*out = make([]VirtualMachineInstanceBackupExport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -6328,6 +6838,16 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FreezeHooks != nil {
+		in, out := &in.FreezeHooks, &out.FreezeHooks
+		*out = new(FreezeHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CrashPolicy != nil {
+		in, out := &in.CrashPolicy, &out.CrashPolicy
+		*out = new(CrashPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ResourceClaims != nil {
 		in, out := &in.ResourceClaims, &out.ResourceClaims
 		*out = make([]corev1.PodResourceClaim, len(*in))
@@ -6441,6 +6961,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.VolumeMigration != nil {
+		in, out := &in.VolumeMigration, &out.VolumeMigration
+		*out = new(VolumeMigrationOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DeviceStatus != nil {
 		in, out := &in.DeviceStatus, &out.DeviceStatus
 		*out = new(DeviceStatus)
@@ -6451,6 +6976,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(ChangedBlockTrackingStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CrashMemoryDump != nil {
+		in, out := &in.CrashMemoryDump, &out.CrashMemoryDump
+		*out = new(DomainMemoryDumpInfo)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6610,6 +7140,11 @@ func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 		*out = new(UpdateVolumesStrategy)
 		**out = **in
 	}
+	if in.VolumeMigration != nil {
+		in, out := &in.VolumeMigration, &out.VolumeMigration
+		*out = new(VolumeMigrationOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6730,6 +7265,13 @@ func (in *VirtualMachineStatus) DeepCopyInto(out *VirtualMachineStatus) {
 		*out = new(ChangedBlockTrackingStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CrashMemoryDumps != nil {
+		in, out := &in.CrashMemoryDumps, &out.CrashMemoryDumps
+		*out = make([]DomainMemoryDumpInfo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.InstancetypeRef != nil {
 		in, out := &in.InstancetypeRef, &out.InstancetypeRef
 		*out = new(InstancetypeStatusRef)
@@ -6796,6 +7338,74 @@ func (in *Volume) DeepCopy() *Volume {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeMigrationCutoverPolicy) DeepCopyInto(out *VolumeMigrationCutoverPolicy) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(MaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMigrationCutoverPolicy.
+func (in *VolumeMigrationCutoverPolicy) DeepCopy() *VolumeMigrationCutoverPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeMigrationCutoverPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeMigrationOptions) DeepCopyInto(out *VolumeMigrationOptions) {
+	*out = *in
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.CutoverPolicy != nil {
+		in, out := &in.CutoverPolicy, &out.CutoverPolicy
+		*out = new(VolumeMigrationCutoverPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BackendStorageClassName != nil {
+		in, out := &in.BackendStorageClassName, &out.BackendStorageClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMigrationOptions.
+func (in *VolumeMigrationOptions) DeepCopy() *VolumeMigrationOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeMigrationOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeMigrationProgress) DeepCopyInto(out *VolumeMigrationProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMigrationProgress.
+func (in *VolumeMigrationProgress) DeepCopy() *VolumeMigrationProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeMigrationProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VolumeMigrationState) DeepCopyInto(out *VolumeMigrationState) {
 	*out = *in
@@ -6806,6 +7416,10 @@ func (in *VolumeMigrationState) DeepCopyInto(out *VolumeMigrationState) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NextCutoverTime != nil {
+		in, out := &in.NextCutoverTime, &out.NextCutoverTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -6866,7 +7480,7 @@ func (in *VolumeSource) DeepCopyInto(out *VolumeSource) {
 	if in.ContainerDisk != nil {
 		in, out := &in.ContainerDisk, &out.ContainerDisk
 		*out = new(ContainerDiskSource)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Ephemeral != nil {
 		in, out := &in.Ephemeral, &out.Ephemeral
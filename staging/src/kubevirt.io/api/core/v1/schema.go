@@ -729,6 +729,85 @@ type Disk struct {
 	// Defaults to false.
 	// +optional
 	ChangedBlockTracking *bool `json:"changedBlockTracking,omitempty"`
+	// If specified, the I/O of the disk is throttled to the given limits.
+	// The limits can be changed on a running VMI.
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
+	// If specified, the volume is encrypted with LUKS and opened by QEMU.
+	// Volumes which already carry a LUKS header are used as they are,
+	// other volumes are only formatted if format is set.
+	// +optional
+	Encryption *DiskEncryption `json:"encryption,omitempty"`
+}
+
+// DiskEncryption defines the LUKS encryption of a disk.
+type DiskEncryption struct {
+	// SecretRef references a Secret in the namespace of the VMI holding
+	// the LUKS passphrase under the "passphrase" key.
+	SecretRef v1.LocalObjectReference `json:"secretRef"`
+	// Format allows formatting the volume with LUKS on first use, if it does
+	// not carry a LUKS header yet. Formatting destroys the content of the volume.
+	// Without it, a volume without LUKS header fails to start.
+	// +optional
+	Format bool `json:"format,omitempty"`
+}
+
+// DiskIOTune defines the I/O throttling limits of a disk.
+// A limit of 0 means unlimited. Total limits cannot be combined with
+// read or write limits of the same kind.
+type DiskIOTune struct {
+	// TotalBytesPerSec limits the total throughput in bytes per second.
+	// +optional
+	TotalBytesPerSec int64 `json:"totalBytesPerSec,omitempty"`
+	// ReadBytesPerSec limits the read throughput in bytes per second.
+	// +optional
+	ReadBytesPerSec int64 `json:"readBytesPerSec,omitempty"`
+	// WriteBytesPerSec limits the write throughput in bytes per second.
+	// +optional
+	WriteBytesPerSec int64 `json:"writeBytesPerSec,omitempty"`
+	// TotalIOPS limits the total I/O operations per second.
+	// +optional
+	TotalIOPS int64 `json:"totalIOPS,omitempty"`
+	// ReadIOPS limits the read I/O operations per second.
+	// +optional
+	ReadIOPS int64 `json:"readIOPS,omitempty"`
+	// WriteIOPS limits the write I/O operations per second.
+	// +optional
+	WriteIOPS int64 `json:"writeIOPS,omitempty"`
+	// Burst allows the disk to exceed its limits for a short time.
+	// +optional
+	Burst *DiskIOTuneBurst `json:"burst,omitempty"`
+	// GroupName makes all disks of the VMI with the same group name share
+	// the limits of the group instead of being throttled individually.
+	// +optional
+	GroupName string `json:"groupName,omitempty"`
+}
+
+// DiskIOTuneBurst defines the burst limits of a disk.
+// Each burst limit must be greater than or equal to the matching limit.
+type DiskIOTuneBurst struct {
+	// TotalBytesPerSec is the burst limit of the total throughput in bytes per second.
+	// +optional
+	TotalBytesPerSec int64 `json:"totalBytesPerSec,omitempty"`
+	// ReadBytesPerSec is the burst limit of the read throughput in bytes per second.
+	// +optional
+	ReadBytesPerSec int64 `json:"readBytesPerSec,omitempty"`
+	// WriteBytesPerSec is the burst limit of the write throughput in bytes per second.
+	// +optional
+	WriteBytesPerSec int64 `json:"writeBytesPerSec,omitempty"`
+	// TotalIOPS is the burst limit of the total I/O operations per second.
+	// +optional
+	TotalIOPS int64 `json:"totalIOPS,omitempty"`
+	// ReadIOPS is the burst limit of the read I/O operations per second.
+	// +optional
+	ReadIOPS int64 `json:"readIOPS,omitempty"`
+	// WriteIOPS is the burst limit of the write I/O operations per second.
+	// +optional
+	WriteIOPS int64 `json:"writeIOPS,omitempty"`
+	// LengthSeconds is how long the burst limits can be sustained.
+	// Defaults to 1 second.
+	// +optional
+	LengthSeconds int64 `json:"lengthSeconds,omitempty"`
 }
 
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
@@ -962,6 +1041,10 @@ type MemoryDumpVolumeSource struct {
 	// Directly attached to the virt launcher
 	// +optional
 	PersistentVolumeClaimVolumeSource `json:",inline"`
+	// Format is the format the memory is dumped in. Defaults to elf.
+	// +kubebuilder:validation:Enum=elf;kdump-zlib;kdump-lzo;kdump-snappy
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
 }
 
 type EphemeralVolumeSource struct {
@@ -993,6 +1076,17 @@ type ContainerDiskSource struct {
 	// More info: https://kubernetes.io/docs/concepts/containers/images#updating-images
 	// +optional
 	ImagePullPolicy v1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// PersistentOverlay, if set, stores the writable overlay of the container disk on a PVC
+	// instead of the virt-launcher pod filesystem, so that guest changes survive restarts and migrations.
+	// +optional
+	PersistentOverlay *ContainerDiskPersistentOverlay `json:"persistentOverlay,omitempty"`
+}
+
+// ContainerDiskPersistentOverlay defines the PVC backing the writable overlay of a container disk.
+type ContainerDiskPersistentOverlay struct {
+	// Size is the requested size of the PVC holding the overlay.
+	// It limits how much the guest can write on top of the container disk image.
+	Size resource.Quantity `json:"size"`
 }
 
 type UtilityVolumeType string
@@ -1003,6 +1097,9 @@ const (
 
 	// Backup represents a utility volume which will be used to collect backup output
 	Backup UtilityVolumeType = "Backup"
+
+	// MemoryState represents a utility volume which will be used to save the memory and device state of the vmi
+	MemoryState UtilityVolumeType = "MemoryState"
 )
 
 type UtilityVolume struct {
@@ -1633,6 +1730,83 @@ type AccessCredential struct {
 	UserPassword *UserPasswordAccessCredential `json:"userPassword,omitempty"`
 }
 
+// FreezeHooks are commands run in the guest through the guest agent around
+// the filesystem freeze of snapshots and backups, e.g. to flush the state of
+// an application to disk before the freeze and to resume it after the thaw.
+type FreezeHooks struct {
+	// PreFreeze hooks run in order before the guest filesystems are frozen.
+	// The filesystems are not frozen when one of them fails, snapshots and backups
+	// then proceed without the freeze.
+	// +listType=atomic
+	// +optional
+	PreFreeze []FreezeHook `json:"preFreeze,omitempty"`
+	// PostThaw hooks run in order after the guest filesystems are thawed.
+	// +listType=atomic
+	// +optional
+	PostThaw []FreezeHook `json:"postThaw,omitempty"`
+}
+
+// FreezeHook is a command run in the guest around the filesystem freeze.
+// Only one of Exec and Script may be specified.
+type FreezeHook struct {
+	// Name of the hook, used to report its failures. Must be unique within its list of hooks.
+	Name string `json:"name"`
+	// Exec runs a command in the guest
+	// +optional
+	Exec *FreezeHookExec `json:"exec,omitempty"`
+	// Script runs a script of a ConfigMap or Secret volume in the guest
+	// +optional
+	Script *FreezeHookScript `json:"script,omitempty"`
+	// TimeoutSeconds is the time the hook is given to complete. Defaults to 30 seconds.
+	// The timeouts of a list of hooks must not add up to more than 30 seconds.
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// FreezeHookExec runs a command in the guest through the guest agent
+type FreezeHookExec struct {
+	// Command is the path of the executable in the guest followed by its arguments.
+	// +listType=atomic
+	Command []string `json:"command"`
+}
+
+// FreezeHookScript runs a script stored in a ConfigMap or Secret volume of the VMI.
+// The script is passed to the interpreter on its standard input.
+type FreezeHookScript struct {
+	// VolumeName is the name of the ConfigMap or Secret volume holding the script
+	VolumeName string `json:"volumeName"`
+	// Key is the key of the script in the ConfigMap or Secret
+	Key string `json:"key"`
+	// Interpreter is the path of the executable in the guest reading the script,
+	// followed by its arguments. Defaults to /bin/sh.
+	// +listType=atomic
+	// +optional
+	Interpreter []string `json:"interpreter,omitempty"`
+}
+
+// CrashPolicy defines what is done when the guest reports a panic through a panic device
+type CrashPolicy struct {
+	// MemoryDump dumps the memory of the guest into a pvc when the guest panics,
+	// before the vmi is stopped.
+	// +optional
+	MemoryDump *CrashMemoryDump `json:"memoryDump,omitempty"`
+}
+
+// CrashMemoryDump defines where the memory of a panicked guest is dumped to
+type CrashMemoryDump struct {
+	// ClaimName is the name of the pvc the memory is dumped to.
+	// The vmi is only live migratable when the pvc has the ReadWriteMany access mode.
+	ClaimName string `json:"claimName"`
+	// Format is the format the memory is dumped in. Defaults to elf.
+	// +kubebuilder:validation:Enum=elf;kdump-zlib;kdump-lzo;kdump-snappy
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
+	// MaxDumps is the number of dumps retained in the pvc, older dumps are
+	// removed before a new one is taken. Defaults to 1.
+	// +optional
+	MaxDumps *int32 `json:"maxDumps,omitempty"`
+}
+
 // Network represents a network type and a resource that should be connected to the vm.
 type Network struct {
 	// Network name.
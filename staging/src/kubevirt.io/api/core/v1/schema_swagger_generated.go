@@ -377,6 +377,43 @@ func (Disk) SwaggerDoc() map[string]string {
 		"shareable":            "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
 		"errorPolicy":          "If specified, it can change the default error policy (stop) for the disk\n+optional",
 		"changedBlockTracking": "ChangedBlockTracking indicates this disk should have CBT option\nDefaults to false.\n+optional",
+		"ioTune":               "If specified, the I/O of the disk is throttled to the given limits.\nThe limits can be changed on a running VMI.\n+optional",
+		"encryption":           "If specified, the volume is encrypted with LUKS and opened by QEMU.\nVolumes which already carry a LUKS header are used as they are,\nother volumes are only formatted if format is set.\n+optional",
+	}
+}
+
+func (DiskEncryption) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "DiskEncryption defines the LUKS encryption of a disk.",
+		"secretRef": "SecretRef references a Secret in the namespace of the VMI holding\nthe LUKS passphrase under the \"passphrase\" key.",
+		"format":    "Format allows formatting the volume with LUKS on first use, if it does\nnot carry a LUKS header yet. Formatting destroys the content of the volume.\nWithout it, a volume without LUKS header fails to start.",
+	}
+}
+
+func (DiskIOTune) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "DiskIOTune defines the I/O throttling limits of a disk.\nA limit of 0 means unlimited. Total limits cannot be combined with\nread or write limits of the same kind.",
+		"totalBytesPerSec": "TotalBytesPerSec limits the total throughput in bytes per second.\n+optional",
+		"readBytesPerSec":  "ReadBytesPerSec limits the read throughput in bytes per second.\n+optional",
+		"writeBytesPerSec": "WriteBytesPerSec limits the write throughput in bytes per second.\n+optional",
+		"totalIOPS":        "TotalIOPS limits the total I/O operations per second.\n+optional",
+		"readIOPS":         "ReadIOPS limits the read I/O operations per second.\n+optional",
+		"writeIOPS":        "WriteIOPS limits the write I/O operations per second.\n+optional",
+		"burst":            "Burst allows the disk to exceed its limits for a short time.\n+optional",
+		"groupName":        "GroupName makes all disks of the VMI with the same group name share\nthe limits of the group instead of being throttled individually.\n+optional",
+	}
+}
+
+func (DiskIOTuneBurst) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "DiskIOTuneBurst defines the burst limits of a disk.\nEach burst limit must be greater than or equal to the matching limit.",
+		"totalBytesPerSec": "TotalBytesPerSec is the burst limit of the total throughput in bytes per second.\n+optional",
+		"readBytesPerSec":  "ReadBytesPerSec is the burst limit of the read throughput in bytes per second.\n+optional",
+		"writeBytesPerSec": "WriteBytesPerSec is the burst limit of the write throughput in bytes per second.\n+optional",
+		"totalIOPS":        "TotalIOPS is the burst limit of the total I/O operations per second.\n+optional",
+		"readIOPS":         "ReadIOPS is the burst limit of the read I/O operations per second.\n+optional",
+		"writeIOPS":        "WriteIOPS is the burst limit of the write I/O operations per second.\n+optional",
+		"lengthSeconds":    "LengthSeconds is how long the burst limits can be sustained.\nDefaults to 1 second.\n+optional",
 	}
 }
 
@@ -511,7 +548,9 @@ func (PersistentVolumeClaimVolumeSource) SwaggerDoc() map[string]string {
 }
 
 func (MemoryDumpVolumeSource) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"format": "Format is the format the memory is dumped in. Defaults to elf.\n+kubebuilder:validation:Enum=elf;kdump-zlib;kdump-lzo;kdump-snappy\n+optional",
+	}
 }
 
 func (EphemeralVolumeSource) SwaggerDoc() map[string]string {
@@ -529,11 +568,19 @@ func (EmptyDiskSource) SwaggerDoc() map[string]string {
 
 func (ContainerDiskSource) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":                "Represents a docker image with an embedded disk.",
-		"image":           "Image is the name of the image with the embedded disk.",
-		"imagePullSecret": "ImagePullSecret is the name of the Docker registry secret required to pull the image. The secret must already exist.",
-		"path":            "Path defines the path to disk file in the container",
-		"imagePullPolicy": "Image pull policy.\nOne of Always, Never, IfNotPresent.\nDefaults to Always if :latest tag is specified, or IfNotPresent otherwise.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/containers/images#updating-images\n+optional",
+		"":                  "Represents a docker image with an embedded disk.",
+		"image":             "Image is the name of the image with the embedded disk.",
+		"imagePullSecret":   "ImagePullSecret is the name of the Docker registry secret required to pull the image. The secret must already exist.",
+		"path":              "Path defines the path to disk file in the container",
+		"imagePullPolicy":   "Image pull policy.\nOne of Always, Never, IfNotPresent.\nDefaults to Always if :latest tag is specified, or IfNotPresent otherwise.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/containers/images#updating-images\n+optional",
+		"persistentOverlay": "PersistentOverlay, if set, stores the writable overlay of the container disk on a PVC\ninstead of the virt-launcher pod filesystem, so that guest changes survive restarts and migrations.\n+optional",
+	}
+}
+
+func (ContainerDiskPersistentOverlay) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "ContainerDiskPersistentOverlay defines the PVC backing the writable overlay of a container disk.",
+		"size": "Size is the requested size of the PVC holding the overlay.\nIt limits how much the guest can write on top of the container disk image.",
 	}
 }
 
@@ -896,6 +943,56 @@ func (AccessCredential) SwaggerDoc() map[string]string {
 	}
 }
 
+func (FreezeHooks) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "FreezeHooks are commands run in the guest through the guest agent around\nthe filesystem freeze of snapshots and backups, e.g. to flush the state of\nan application to disk before the freeze and to resume it after the thaw.",
+		"preFreeze": "PreFreeze hooks run in order before the guest filesystems are frozen.\nThe filesystems are not frozen when one of them fails, snapshots and backups\nthen proceed without the freeze.\n+listType=atomic\n+optional",
+		"postThaw":  "PostThaw hooks run in order after the guest filesystems are thawed.\n+listType=atomic\n+optional",
+	}
+}
+
+func (FreezeHook) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "FreezeHook is a command run in the guest around the filesystem freeze.\nOnly one of Exec and Script may be specified.",
+		"name":           "Name of the hook, used to report its failures. Must be unique within its list of hooks.",
+		"exec":           "Exec runs a command in the guest\n+optional",
+		"script":         "Script runs a script of a ConfigMap or Secret volume in the guest\n+optional",
+		"timeoutSeconds": "TimeoutSeconds is the time the hook is given to complete. Defaults to 30 seconds.\nThe timeouts of a list of hooks must not add up to more than 30 seconds.\n+optional",
+	}
+}
+
+func (FreezeHookExec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "FreezeHookExec runs a command in the guest through the guest agent",
+		"command": "Command is the path of the executable in the guest followed by its arguments.\n+listType=atomic",
+	}
+}
+
+func (FreezeHookScript) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "FreezeHookScript runs a script stored in a ConfigMap or Secret volume of the VMI.\nThe script is passed to the interpreter on its standard input.",
+		"volumeName":  "VolumeName is the name of the ConfigMap or Secret volume holding the script",
+		"key":         "Key is the key of the script in the ConfigMap or Secret",
+		"interpreter": "Interpreter is the path of the executable in the guest reading the script,\nfollowed by its arguments. Defaults to /bin/sh.\n+listType=atomic\n+optional",
+	}
+}
+
+func (CrashPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "CrashPolicy defines what is done when the guest reports a panic through a panic device",
+		"memoryDump": "MemoryDump dumps the memory of the guest into a pvc when the guest panics,\nbefore the vmi is stopped.\n+optional",
+	}
+}
+
+func (CrashMemoryDump) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "CrashMemoryDump defines where the memory of a panicked guest is dumped to",
+		"claimName": "ClaimName is the name of the pvc the memory is dumped to.\nThe vmi is only live migratable when the pvc has the ReadWriteMany access mode.",
+		"format":    "Format is the format the memory is dumped in. Defaults to elf.\n+kubebuilder:validation:Enum=elf;kdump-zlib;kdump-lzo;kdump-snappy\n+optional",
+		"maxDumps":  "MaxDumps is the number of dumps retained in the pvc, older dumps are\nremoved before a new one is taken. Defaults to 1.\n+optional",
+	}
+}
+
 func (Network) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":     "Network represents a network type and a resource that should be connected to the vm.",
@@ -169,6 +169,14 @@ type VirtualMachineInstanceSpec struct {
 	// +optional
 	// +kubebuilder:validation:MaxItems:=256
 	AccessCredentials []AccessCredential `json:"accessCredentials,omitempty"`
+	// FreezeHooks are commands run in the guest before the filesystems are frozen and
+	// after they are thawed by snapshots and backups. They require the guest agent.
+	// +optional
+	FreezeHooks *FreezeHooks `json:"freezeHooks,omitempty"`
+	// CrashPolicy defines what is done when the guest reports a panic.
+	// It requires a panic device.
+	// +optional
+	CrashPolicy *CrashPolicy `json:"crashPolicy,omitempty"`
 	// Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components
 	Architecture string `json:"architecture,omitempty"`
 	// ResourceClaims define which ResourceClaims must be allocated
@@ -324,6 +332,10 @@ type VirtualMachineInstanceStatus struct {
 	// +listType=atomic
 	// +optional
 	MigratedVolumes []StorageMigratedVolumeInfo `json:"migratedVolumes,omitempty"`
+
+	// VolumeMigration holds the options of the ongoing volume migration, as set on the VirtualMachine
+	// +optional
+	VolumeMigration *VolumeMigrationOptions `json:"volumeMigration,omitempty"`
 	// DeviceStatus reflects the state of devices requested in spec.domain.devices. This is an optional field available
 	// only when DRA feature gate is enabled
 	// This field will only be populated if one of the feature-gates GPUsWithDRA or HostDevicesWithDRA is enabled.
@@ -335,6 +347,11 @@ type VirtualMachineInstanceStatus struct {
 	// +nullable
 	// +optional
 	ChangedBlockTracking *ChangedBlockTrackingStatus `json:"changedBlockTracking,omitempty" optional:"true"`
+
+	// CrashMemoryDump represents the memory dump taken when the guest panicked
+	// +nullable
+	// +optional
+	CrashMemoryDump *DomainMemoryDumpInfo `json:"crashMemoryDump,omitempty"`
 }
 
 // DeviceStatus has the information of all devices allocated spec.domain.devices
@@ -390,6 +407,29 @@ type StorageMigratedVolumeInfo struct {
 	SourcePVCInfo *PersistentVolumeClaimInfo `json:"sourcePVCInfo,omitempty" valid:"required"`
 	// DestinationPVCInfo contains the information about the destination PVC
 	DestinationPVCInfo *PersistentVolumeClaimInfo `json:"destinationPVCInfo,omitempty" valid:"required"`
+	// Progress reports how far the copy of the volume has gone
+	// +optional
+	Progress *VolumeMigrationProgress `json:"progress,omitempty"`
+}
+
+// VolumeMigrationProgress reports the progress of the block copy of a migrated volume
+type VolumeMigrationProgress struct {
+	// BytesTotal is the amount of data to copy
+	// +optional
+	BytesTotal int64 `json:"bytesTotal,omitempty"`
+	// BytesCopied is the amount of data already copied
+	// +optional
+	BytesCopied int64 `json:"bytesCopied,omitempty"`
+	// BytesRemaining is the amount of data left to copy
+	// +optional
+	BytesRemaining int64 `json:"bytesRemaining,omitempty"`
+	// BytesPerSecond is the current copy rate
+	// +optional
+	BytesPerSecond int64 `json:"bytesPerSecond,omitempty"`
+	// CutoverPending is set when the volume is in sync with its destination and
+	// the cut-over is held by the cut-over policy
+	// +optional
+	CutoverPending bool `json:"cutoverPending,omitempty"`
 }
 
 // PersistentVolumeClaimInfo contains the relavant information virt-handler needs cached about a PVC
@@ -738,6 +778,9 @@ const (
 
 	// VirtualMachineInstanceEvictionRequested indicates that an eviction has been requested for the VMI
 	VirtualMachineInstanceEvictionRequested VirtualMachineInstanceConditionType = "EvictionRequested"
+
+	// VirtualMachineInstanceAutoMigrationDeferred indicates that an automatic migration of the VMI waits for a maintenance window
+	VirtualMachineInstanceAutoMigrationDeferred VirtualMachineInstanceConditionType = "AutoMigrationDeferred"
 )
 
 // These are valid reasons for VMI conditions.
@@ -778,7 +821,7 @@ const (
 	// Indicates that automatic migration is required due to a change made to a running VM
 	VirtualMachineInstanceReasonAutoMigrationDueToLiveUpdate = "AutoMigrationDueToLiveUpdate"
 
-	// Indicates that automatic migration is pending
+	// Indicates that automatic migration is pending, either on a live update or on a maintenance window
 	VirtualMachineInstanceReasonAutoMigrationPending = "AutoMigrationPending"
 
 	// Indicates that an eviction has been requested for the VMI
@@ -1359,6 +1402,10 @@ const (
 	// pvc name and the timestamp the memory dump was collected
 	PVCMemoryDumpAnnotation string = "kubevirt.io/memory-dump"
 
+	// MemoryStateRestoreAnnotation is the name of the pvc holding a saved memory state
+	// the vmi is resumed from instead of being booted
+	MemoryStateRestoreAnnotation string = "kubevirt.io/memory-state-restore"
+
 	// AllowPodBridgeNetworkLiveMigrationAnnotation allow to run live migration when the
 	// vm has the pod networking bind with a bridge
 	AllowPodBridgeNetworkLiveMigrationAnnotation string = "kubevirt.io/allow-pod-bridge-network-live-migration"
@@ -1952,6 +1999,44 @@ const (
 	UpdateVolumesStrategyReplacement UpdateVolumesStrategy = "Replacement"
 )
 
+// VolumeMigrationOptions configures how the volumes are migrated with the Migration update volumes strategy
+type VolumeMigrationOptions struct {
+	// Bandwidth limits the rate at which each volume is copied, per second.
+	// The copy is not throttled if not set.
+	// +optional
+	Bandwidth *resource.Quantity `json:"bandwidth,omitempty"`
+	// CutoverPolicy defines when the VirtualMachine switches to the destination volumes once they are in sync.
+	// Defaults to an immediate cut-over.
+	// +optional
+	CutoverPolicy *VolumeMigrationCutoverPolicy `json:"cutoverPolicy,omitempty"`
+	// BackendStorageClassName is the storage class of the backend-storage PVC, holding the persistent
+	// TPM and EFI state, on the migration target. When it differs from the class of the current PVC,
+	// the VirtualMachine is migrated to move the state to a new PVC of this class.
+	// +optional
+	BackendStorageClassName *string `json:"backendStorageClassName,omitempty"`
+}
+
+type VolumeMigrationCutoverMode string
+
+const (
+	// VolumeMigrationCutoverImmediate switches to the destination volumes as soon as they are in sync
+	VolumeMigrationCutoverImmediate VolumeMigrationCutoverMode = "Immediate"
+	// VolumeMigrationCutoverManual keeps the volumes in sync until the mode is changed to Immediate
+	VolumeMigrationCutoverManual VolumeMigrationCutoverMode = "Manual"
+	// VolumeMigrationCutoverWindow keeps the volumes in sync until the cut-over window opens
+	VolumeMigrationCutoverWindow VolumeMigrationCutoverMode = "Window"
+)
+
+// VolumeMigrationCutoverPolicy defines when the cut-over to the destination volumes happens
+type VolumeMigrationCutoverPolicy struct {
+	// Mode is the cut-over mode, one of Immediate, Manual or Window
+	// +kubebuilder:validation:Enum=Immediate;Manual;Window
+	Mode VolumeMigrationCutoverMode `json:"mode"`
+	// Window is the window in which the cut-over may happen. Required with the Window mode.
+	// +optional
+	Window *MaintenanceWindow `json:"window,omitempty"`
+}
+
 // VirtualMachineSpec describes how the proper VirtualMachine
 // should look like
 type VirtualMachineSpec struct {
@@ -1985,6 +2070,10 @@ type VirtualMachineSpec struct {
 
 	// UpdateVolumesStrategy is the strategy to apply on volumes updates
 	UpdateVolumesStrategy *UpdateVolumesStrategy `json:"updateVolumesStrategy,omitempty"`
+
+	// VolumeMigration configures the migration of the volumes with the Migration update volumes strategy
+	// +optional
+	VolumeMigration *VolumeMigrationOptions `json:"volumeMigration,omitempty"`
 }
 
 // StateChangeRequestType represents the existing state change requests that are possible
@@ -2120,6 +2209,12 @@ type VirtualMachineStatus struct {
 	// +optional
 	ChangedBlockTracking *ChangedBlockTrackingStatus `json:"changedBlockTracking,omitempty" optional:"true"`
 
+	// CrashMemoryDumps are the memory dumps retained in the pvc of the crash policy,
+	// the most recent one last
+	// +listType=atomic
+	// +optional
+	CrashMemoryDumps []DomainMemoryDumpInfo `json:"crashMemoryDumps,omitempty" optional:"true"`
+
 	// InstancetypeRef captures the state of any referenced instance type from the VirtualMachine
 	//+nullable
 	//+optional
@@ -2199,6 +2294,25 @@ type VirtualMachineInstanceBackupStatus struct {
 	// CheckpointName is the name of the checkpoint created for the backup
 	// +optional
 	CheckpointName *string `json:"checkpointName,omitempty"`
+	// PullEndpoint is the address of the NBD server exposing a pull mode backup
+	// +optional
+	PullEndpoint *string `json:"pullEndpoint,omitempty"`
+	// Exports lists the NBD exports of a pull mode backup
+	// +listType=atomic
+	// +optional
+	Exports []VirtualMachineInstanceBackupExport `json:"exports,omitempty"`
+}
+
+// VirtualMachineInstanceBackupExport describes the NBD export of a volume in a pull mode backup
+// +k8s:openapi-gen=true
+type VirtualMachineInstanceBackupExport struct {
+	// VolumeName is the name of the exported volume
+	VolumeName string `json:"volumeName"`
+	// ExportName is the NBD export name of the volume
+	ExportName string `json:"exportName"`
+	// DirtyBitmap is the NBD metadata context exposing the changed extents of an incremental backup
+	// +optional
+	DirtyBitmap *string `json:"dirtyBitmap,omitempty"`
 }
 
 // ChangedBlockTrackingStatus represents the status of ChangedBlockTracking for a VM
@@ -2222,6 +2336,13 @@ type VolumeMigrationState struct {
 	// +listType=atomic
 	// +optional
 	MigratedVolumes []StorageMigratedVolumeInfo `json:"migratedVolumes,omitempty"`
+	// CutoverPending is set when all the volumes are in sync and the cut-over is held by the cut-over policy
+	// +optional
+	CutoverPending bool `json:"cutoverPending,omitempty"`
+	// NextCutoverTime is the next time the cut-over window opens, if the cut-over is held until then
+	// +optional
+	// +nullable
+	NextCutoverTime *metav1.Time `json:"nextCutoverTime,omitempty"`
 }
 
 type VolumeSnapshotStatus struct {
@@ -2475,6 +2596,34 @@ type KubeVirtWorkloadUpdateStrategy struct {
 	//
 	// +optional
 	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
+
+	// MaintenanceWindows restricts the live migrations started by automated workload updates,
+	// including the migrations required by live updates of running VMs, to the given windows.
+	// The windows of a migration policy take precedence for the VMIs the policy applies to.
+	// An empty list allows these migrations at any time
+	//
+	// +listType=atomic
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// AllowNodeDrainOutsideMaintenanceWindows lets node drains migrate the VMIs immediately
+	// instead of waiting for the next maintenance window. The setting of a migration policy
+	// takes precedence for the VMIs the policy applies to.
+	//
+	// Defaults to true
+	//
+	// +optional
+	AllowNodeDrainOutsideMaintenanceWindows *bool `json:"allowNodeDrainOutsideMaintenanceWindows,omitempty"`
+}
+
+// MaintenanceWindow is a recurring period of time during which automatic live migrations may start
+type MaintenanceWindow struct {
+	// Schedule is a cron expression in the standard five fields format. Every minute matched by
+	// the expression belongs to the window, e.g. "* 0-5 * * *" opens the window from midnight to 6am
+	Schedule string `json:"schedule"`
+	// TimeZone is the IANA name of the time zone the schedule is evaluated in. Defaults to UTC
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty"`
 }
 
 type KubeVirtSpec struct {
@@ -2756,6 +2905,9 @@ type UnpauseOptions struct {
 const (
 	StartRequestDataPausedKey  string = "paused"
 	StartRequestDataPausedTrue string = "true"
+	// StartRequestDataMemoryStateKey holds the name of the pvc with the memory state
+	// the vmi should be resumed from
+	StartRequestDataMemoryStateKey string = "memoryState"
 )
 
 // StopOptions may be provided when deleting an API object.
@@ -2797,6 +2949,47 @@ type MigrateOptions struct {
 	AddedNodeSelector map[string]string `json:"addedNodeSelector,omitempty"`
 }
 
+// MigrateCheckResult is the result of a migration pre-flight check
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MigrateCheckResult struct {
+	metav1.TypeMeta `json:",inline"`
+	// Migratable is true when the VirtualMachineInstance can be migrated to at least one node
+	Migratable bool `json:"migratable"`
+	// Reasons are the reasons why the VirtualMachineInstance can not be migrated regardless of the target node
+	// +optional
+	// +listType=atomic
+	Reasons []string `json:"reasons,omitempty"`
+	// MigrationPolicyName is the name of the migration policy which would apply to the migration
+	// +optional
+	MigrationPolicyName *string `json:"migrationPolicyName,omitempty"`
+	// MigrationConfiguration is the configuration the migration would use
+	// +optional
+	MigrationConfiguration *MigrationConfiguration `json:"migrationConfiguration,omitempty"`
+	// Nodes are the nodes the VirtualMachineInstance can be migrated to, the most suitable first
+	// +optional
+	// +listType=atomic
+	Nodes []MigrateCheckNode `json:"nodes,omitempty"`
+	// RejectedNodes are the nodes the VirtualMachineInstance can not be migrated to
+	// +optional
+	// +listType=atomic
+	RejectedNodes []MigrateCheckNode `json:"rejectedNodes,omitempty"`
+}
+
+// MigrateCheckNode is a node evaluated by a migration pre-flight check
+type MigrateCheckNode struct {
+	// Name is the name of the node
+	Name string `json:"name"`
+	// AvailableMemory is the allocatable memory of the node which would be left
+	// once the migration target pod is scheduled
+	// +optional
+	AvailableMemory *resource.Quantity `json:"availableMemory,omitempty"`
+	// Reasons are the reasons why the node was rejected
+	// +optional
+	// +listType=atomic
+	Reasons []string `json:"reasons,omitempty"`
+}
+
 // EvacuateCancelOptions may be provided on evacuate cancel request.
 type EvacuateCancelOptions struct {
 	metav1.TypeMeta `json:",inline"`
@@ -2918,8 +3111,26 @@ type VirtualMachineMemoryDumpRequest struct {
 	// Message is a detailed message about failure of the memory dump
 	// +optional
 	Message string `json:"message,omitempty"`
+	// Format is the format the memory is dumped in. Defaults to elf.
+	// +kubebuilder:validation:Enum=elf;kdump-zlib;kdump-lzo;kdump-snappy
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
 }
 
+// MemoryDumpFormat is the format of a memory dump
+type MemoryDumpFormat string
+
+const (
+	// MemoryDumpFormatELF dumps the memory uncompressed in the ELF format
+	MemoryDumpFormatELF MemoryDumpFormat = "elf"
+	// MemoryDumpFormatKdumpZlib dumps the memory in the kdump format with zlib compressed pages
+	MemoryDumpFormatKdumpZlib MemoryDumpFormat = "kdump-zlib"
+	// MemoryDumpFormatKdumpLzo dumps the memory in the kdump format with lzo compressed pages
+	MemoryDumpFormatKdumpLzo MemoryDumpFormat = "kdump-lzo"
+	// MemoryDumpFormatKdumpSnappy dumps the memory in the kdump format with snappy compressed pages
+	MemoryDumpFormatKdumpSnappy MemoryDumpFormat = "kdump-snappy"
+)
+
 type MemoryDumpPhase string
 
 const (
@@ -2966,6 +3177,65 @@ type VSOCKOptions struct {
 	UseTLS     *bool  `json:"useTLS,omitempty"`
 }
 
+// ChangedBlocksFormat is the encoding of the changedblocks subresource response
+type ChangedBlocksFormat string
+
+const (
+	// ChangedBlocksFormatJSON returns a ChangedBlocksList
+	ChangedBlocksFormatJSON ChangedBlocksFormat = "json"
+	// ChangedBlocksFormatBinary returns the changed extents of a single disk
+	// as consecutive pairs of big-endian 64 bit offsets and lengths
+	ChangedBlocksFormatBinary ChangedBlocksFormat = "binary"
+)
+
+// ChangedBlocksOptions are the parameters of a changed blocks query
+type ChangedBlocksOptions struct {
+	// FromCheckpoint is the checkpoint the changes are reported from
+	FromCheckpoint string `json:"fromCheckpoint"`
+	// ToCheckpoint is a later checkpoint ending the range, the changes up to now
+	// are reported if unset
+	// +optional
+	ToCheckpoint string `json:"toCheckpoint,omitempty"`
+	// Disk limits the query to a single disk, all the disks with changed block
+	// tracking are reported if unset. Required by the binary format.
+	// +optional
+	Disk string `json:"disk,omitempty"`
+	// Format is the encoding of the response, json if unset
+	// +optional
+	Format ChangedBlocksFormat `json:"format,omitempty"`
+}
+
+// ChangedBlocksList lists the changed extents of the disks of a VirtualMachineInstance
+type ChangedBlocksList struct {
+	// FromCheckpoint is the checkpoint the changes are reported from
+	FromCheckpoint string `json:"fromCheckpoint"`
+	// ToCheckpoint is the checkpoint ending the range, if any
+	// +optional
+	ToCheckpoint string `json:"toCheckpoint,omitempty"`
+	// Disks are the changed extents of each disk
+	// +listType=atomic
+	Disks []DiskChangedBlocks `json:"disks"`
+}
+
+// DiskChangedBlocks are the changed extents of a disk
+type DiskChangedBlocks struct {
+	// Name is the name of the disk
+	Name string `json:"name"`
+	// Size is the virtual size of the disk in bytes
+	Size int64 `json:"size"`
+	// Extents are the changed ranges of the disk, ordered by offset
+	// +listType=atomic
+	Extents []ChangedBlockExtent `json:"extents"`
+}
+
+// ChangedBlockExtent is a changed range of a disk
+type ChangedBlockExtent struct {
+	// Offset is the offset of the range in bytes
+	Offset int64 `json:"offset"`
+	// Length is the length of the range in bytes
+	Length int64 `json:"length"`
+}
+
 // RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk
 type RemoveVolumeOptions struct {
 	// Name represents the name that maps to both the disk and volume that
@@ -2981,6 +3251,23 @@ type RemoveVolumeOptions struct {
 	DryRun []string `json:"dryRun,omitempty"`
 }
 
+// ResetContainerDiskOptions is provided when resetting the persistent overlay of container disks
+// back to the content of their image
+type ResetContainerDiskOptions struct {
+	// VolumeName is the name of the container disk volume to reset.
+	// All container disks with a persistent overlay are reset if empty.
+	// +optional
+	VolumeName string `json:"volumeName,omitempty"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
 type TokenBucketRateLimiter struct {
 	// QPS indicates the maximum QPS to the apiserver from this client.
 	// If it's zero, the component default will be used
@@ -3305,6 +3592,38 @@ type MigrationConfiguration struct {
 	// That will ensure the target virt-launcher doesn't share categories with another pod on the node.
 	// However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
 	MatchSELinuxLevelOnMigration *bool `json:"matchSELinuxLevelOnMigration,omitempty"`
+	// Compression configures compression of the guest memory transferred during live migrations.
+	// Defaults to no compression
+	// +optional
+	Compression *MigrationCompression `json:"compression,omitempty"`
+}
+
+// MigrationCompressionMethod is the algorithm used to compress guest memory during live migration
+type MigrationCompressionMethod string
+
+const (
+	// MigrationCompressionZstd compresses every page with zstd, requires parallel (multifd) migration
+	MigrationCompressionZstd MigrationCompressionMethod = "zstd"
+	// MigrationCompressionZlib compresses every page with zlib, requires parallel (multifd) migration
+	MigrationCompressionZlib MigrationCompressionMethod = "zlib"
+	// MigrationCompressionXBZRLE only transfers the delta of pages dirtied again during the migration
+	MigrationCompressionXBZRLE MigrationCompressionMethod = "xbzrle"
+)
+
+// MigrationCompression holds the compression settings of live migrations
+type MigrationCompression struct {
+	// Method is the compression algorithm. zstd and zlib require parallel (multifd) migrations,
+	// which are not used together with post-copy or when the VMI has a CPU limit.
+	// +kubebuilder:validation:Enum=zstd;zlib;xbzrle
+	Method MigrationCompressionMethod `json:"method"`
+	// Level is the compression level, between 1 and 9 for zlib and between 1 and 20 for zstd.
+	// It is not supported by xbzrle. Defaults to the hypervisor default
+	// +optional
+	Level *int32 `json:"level,omitempty"`
+	// XBZRLECacheSize is the size of the page cache used by xbzrle.
+	// It is only supported by xbzrle. Defaults to the hypervisor default
+	// +optional
+	XBZRLECacheSize *resource.Quantity `json:"xbzrleCacheSize,omitempty"`
 }
 
 // DiskVerification holds container disks verification limits
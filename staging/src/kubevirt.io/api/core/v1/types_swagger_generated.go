@@ -38,6 +38,8 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"dnsPolicy":                     "Set DNS policy for the pod.\nDefaults to \"ClusterFirst\".\nValid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.\nDNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy.\nTo have DNS options set along with hostNetwork, you have to specify DNS policy\nexplicitly to 'ClusterFirstWithHostNet'.\n+optional",
 		"dnsConfig":                     "Specifies the DNS parameters of a pod.\nParameters specified here will be merged to the generated DNS\nconfiguration based on DNSPolicy.\n+optional",
 		"accessCredentials":             "Specifies a set of public keys to inject into the vm guest\n+listType=atomic\n+optional\n+kubebuilder:validation:MaxItems:=256",
+		"freezeHooks":                   "FreezeHooks are commands run in the guest before the filesystems are frozen and\nafter they are thawed by snapshots and backups. They require the guest agent.\n+optional",
+		"crashPolicy":                   "CrashPolicy defines what is done when the guest reports a panic.\nIt requires a panic device.\n+optional",
 		"architecture":                  "Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components",
 		"resourceClaims":                "ResourceClaims define which ResourceClaims must be allocated\nand reserved before the VMI, hence virt-launcher pod is allowed to start. The resources\nwill be made available to the domain which consumes them\nby name.\n\nThis is an alpha field and requires enabling the\nDynamicResourceAllocation feature gate in kubernetes\n https://kubernetes.io/docs/concepts/scheduling-eviction/dynamic-resource-allocation/\nThis field should only be configured if one of the feature-gates GPUsWithDRA or HostDevicesWithDRA is enabled.\nThis feature is in alpha.\n\n+listType=map\n+listMapKey=name\n+optional",
 		"utilityVolumes":                "List of utility volumes that can be mounted to the vmi virt-launcher pod\nwithout having a matching disk in the domain.\nUsed to collect data for various operational workflows.\n+kubebuilder:validation:MaxItems:=256\n+listType=map\n+listMapKey=name\n+optional",
@@ -85,8 +87,10 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"currentCPUTopology":            "CurrentCPUTopology specifies the current CPU topology used by the VM workload.\nCurrent topology may differ from the desired topology in the spec while CPU hotplug\ntakes place.",
 		"memory":                        "Memory shows various informations about the VirtualMachine memory.\n+optional",
 		"migratedVolumes":               "MigratedVolumes lists the source and destination volumes during the volume migration\n+listType=atomic\n+optional",
+		"volumeMigration":               "VolumeMigration holds the options of the ongoing volume migration, as set on the VirtualMachine\n+optional",
 		"deviceStatus":                  "DeviceStatus reflects the state of devices requested in spec.domain.devices. This is an optional field available\nonly when DRA feature gate is enabled\nThis field will only be populated if one of the feature-gates GPUsWithDRA or HostDevicesWithDRA is enabled.\nThis feature is in alpha.\n+optional",
 		"changedBlockTracking":          "ChangedBlockTracking represents the status of the changedBlockTracking\n+nullable\n+optional",
+		"crashMemoryDump":               "CrashMemoryDump represents the memory dump taken when the guest panicked\n+nullable\n+optional",
 	}
 }
 
@@ -128,6 +132,18 @@ func (StorageMigratedVolumeInfo) SwaggerDoc() map[string]string {
 		"volumeName":         "VolumeName is the name of the volume that is being migrated",
 		"sourcePVCInfo":      "SourcePVCInfo contains the information about the source PVC",
 		"destinationPVCInfo": "DestinationPVCInfo contains the information about the destination PVC",
+		"progress":           "Progress reports how far the copy of the volume has gone\n+optional",
+	}
+}
+
+func (VolumeMigrationProgress) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "VolumeMigrationProgress reports the progress of the block copy of a migrated volume",
+		"bytesTotal":     "BytesTotal is the amount of data to copy\n+optional",
+		"bytesCopied":    "BytesCopied is the amount of data already copied\n+optional",
+		"bytesRemaining": "BytesRemaining is the amount of data left to copy\n+optional",
+		"bytesPerSecond": "BytesPerSecond is the current copy rate\n+optional",
+		"cutoverPending": "CutoverPending is set when the volume is in sync with its destination and\nthe cut-over is held by the cut-over policy\n+optional",
 	}
 }
 
@@ -462,6 +478,23 @@ func (VirtualMachineList) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VolumeMigrationOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                        "VolumeMigrationOptions configures how the volumes are migrated with the Migration update volumes strategy",
+		"bandwidth":               "Bandwidth limits the rate at which each volume is copied, per second.\nThe copy is not throttled if not set.\n+optional",
+		"cutoverPolicy":           "CutoverPolicy defines when the VirtualMachine switches to the destination volumes once they are in sync.\nDefaults to an immediate cut-over.\n+optional",
+		"backendStorageClassName": "BackendStorageClassName is the storage class of the backend-storage PVC, holding the persistent\nTPM and EFI state, on the migration target. When it differs from the class of the current PVC,\nthe VirtualMachine is migrated to move the state to a new PVC of this class.\n+optional",
+	}
+}
+
+func (VolumeMigrationCutoverPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VolumeMigrationCutoverPolicy defines when the cut-over to the destination volumes happens",
+		"mode":   "Mode is the cut-over mode, one of Immediate, Manual or Window\n+kubebuilder:validation:Enum=Immediate;Manual;Window",
+		"window": "Window is the window in which the cut-over may happen. Required with the Window mode.\n+optional",
+	}
+}
+
 func (VirtualMachineSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                      "VirtualMachineSpec describes how the proper VirtualMachine\nshould look like",
@@ -472,6 +505,7 @@ func (VirtualMachineSpec) SwaggerDoc() map[string]string {
 		"template":              "Template is the direct specification of VirtualMachineInstance",
 		"dataVolumeTemplates":   "dataVolumeTemplates is a list of dataVolumes that the VirtualMachineInstance template can reference.\nDataVolumes in this list are dynamically created for the VirtualMachine and are tied to the VirtualMachine's life-cycle.",
 		"updateVolumesStrategy": "UpdateVolumesStrategy is the strategy to apply on volumes updates",
+		"volumeMigration":       "VolumeMigration configures the migration of the volumes with the Migration update volumes strategy\n+optional",
 	}
 }
 
@@ -500,6 +534,7 @@ func (VirtualMachineStatus) SwaggerDoc() map[string]string {
 		"runStrategy":            "RunStrategy tracks the last recorded RunStrategy used by the VM.\nThis is needed to correctly process the next strategy (for now only the RerunOnFailure)",
 		"volumeUpdateState":      "VolumeUpdateState contains the information about the volumes set\nupdates related to the volumeUpdateStrategy",
 		"changedBlockTracking":   "ChangedBlockTracking represents the status of the changedBlockTracking\n+nullable\n+optional",
+		"crashMemoryDumps":       "CrashMemoryDumps are the memory dumps retained in the pvc of the crash policy,\nthe most recent one last\n+listType=atomic\n+optional",
 		"instancetypeRef":        "InstancetypeRef captures the state of any referenced instance type from the VirtualMachine\n+nullable\n+optional",
 		"preferenceRef":          "PreferenceRef captures the state of any referenced preference from the VirtualMachine\n+nullable\n+optional",
 	}
@@ -530,6 +565,17 @@ func (VirtualMachineInstanceBackupStatus) SwaggerDoc() map[string]string {
 		"completed":      "Completed indicates the backup completed",
 		"backupMsg":      "BackupMsg resturns any relevant information like failure reason\nunfreeze failed etc...\n+optional",
 		"checkpointName": "CheckpointName is the name of the checkpoint created for the backup\n+optional",
+		"pullEndpoint":   "PullEndpoint is the address of the NBD server exposing a pull mode backup\n+optional",
+		"exports":        "Exports lists the NBD exports of a pull mode backup\n+listType=atomic\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceBackupExport) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceBackupExport describes the NBD export of a volume in a pull mode backup\n+k8s:openapi-gen=true",
+		"volumeName":  "VolumeName is the name of the exported volume",
+		"exportName":  "ExportName is the NBD export name of the volume",
+		"dirtyBitmap": "DirtyBitmap is the NBD metadata context exposing the changed extents of an incremental backup\n+optional",
 	}
 }
 
@@ -550,6 +596,8 @@ func (VolumeUpdateState) SwaggerDoc() map[string]string {
 func (VolumeMigrationState) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"migratedVolumes": "MigratedVolumes lists the source and destination volumes during the volume migration\n+listType=atomic\n+optional",
+		"cutoverPending":  "CutoverPending is set when all the volumes are in sync and the cut-over is held by the cut-over policy\n+optional",
+		"nextCutoverTime": "NextCutoverTime is the next time the cut-over window opens, if the cut-over is held until then\n+optional\n+nullable",
 	}
 }
 
@@ -645,6 +693,16 @@ func (KubeVirtWorkloadUpdateStrategy) SwaggerDoc() map[string]string {
 		"workloadUpdateMethods": "WorkloadUpdateMethods defines the methods that can be used to disrupt workloads\nduring automated workload updates.\nWhen multiple methods are present, the least disruptive method takes\nprecedence over more disruptive methods. For example if both LiveMigrate and Shutdown\nmethods are listed, only VMs which are not live migratable will be restarted/shutdown\n\nAn empty list defaults to no automated workload updating\n\n+listType=atomic\n+optional",
 		"batchEvictionSize":     "BatchEvictionSize Represents the number of VMIs that can be forced updated per\nthe BatchShutdownInteral interval\n\nDefaults to 10\n\n+optional",
 		"batchEvictionInterval": "BatchEvictionInterval Represents the interval to wait before issuing the next\nbatch of shutdowns\n\nDefaults to 1 minute\n\n+optional",
+		"maintenanceWindows":    "MaintenanceWindows restricts the live migrations started by automated workload updates,\nincluding the migrations required by live updates of running VMs, to the given windows.\nThe windows of a migration policy take precedence for the VMIs the policy applies to.\nAn empty list allows these migrations at any time\n\n+listType=atomic\n+optional",
+		"allowNodeDrainOutsideMaintenanceWindows": "AllowNodeDrainOutsideMaintenanceWindows lets node drains migrate the VMIs immediately\ninstead of waiting for the next maintenance window. The setting of a migration policy\ntakes precedence for the VMIs the policy applies to.\n\nDefaults to true\n\n+optional",
+	}
+}
+
+func (MaintenanceWindow) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "MaintenanceWindow is a recurring period of time during which automatic live migrations may start",
+		"schedule": "Schedule is a cron expression in the standard five fields format. Every minute matched by\nthe expression belongs to the window, e.g. \"* 0-5 * * *\" opens the window from midnight to 6am",
+		"timeZone": "TimeZone is the IANA name of the time zone the schedule is evaluated in. Defaults to UTC\n+optional",
 	}
 }
 
@@ -763,6 +821,27 @@ func (MigrateOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (MigrateCheckResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "MigrateCheckResult is the result of a migration pre-flight check\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"migratable":             "Migratable is true when the VirtualMachineInstance can be migrated to at least one node",
+		"reasons":                "Reasons are the reasons why the VirtualMachineInstance can not be migrated regardless of the target node\n+optional\n+listType=atomic",
+		"migrationPolicyName":    "MigrationPolicyName is the name of the migration policy which would apply to the migration\n+optional",
+		"migrationConfiguration": "MigrationConfiguration is the configuration the migration would use\n+optional",
+		"nodes":                  "Nodes are the nodes the VirtualMachineInstance can be migrated to, the most suitable first\n+optional\n+listType=atomic",
+		"rejectedNodes":          "RejectedNodes are the nodes the VirtualMachineInstance can not be migrated to\n+optional\n+listType=atomic",
+	}
+}
+
+func (MigrateCheckNode) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "MigrateCheckNode is a node evaluated by a migration pre-flight check",
+		"name":            "Name is the name of the node",
+		"availableMemory": "AvailableMemory is the allocatable memory of the node which would be left\nonce the migration target pod is scheduled\n+optional",
+		"reasons":         "Reasons are the reasons why the node was rejected\n+optional\n+listType=atomic",
+	}
+}
+
 func (EvacuateCancelOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "EvacuateCancelOptions may be provided on evacuate cancel request.",
@@ -843,6 +922,7 @@ func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 		"endTimestamp":   "EndTimestamp represents the time the memory dump was completed\n+optional",
 		"fileName":       "FileName represents the name of the output file\n+optional",
 		"message":        "Message is a detailed message about failure of the memory dump\n+optional",
+		"format":         "Format is the format the memory is dumped in. Defaults to elf.\n+kubebuilder:validation:Enum=elf;kdump-zlib;kdump-lzo;kdump-snappy\n+optional",
 	}
 }
 
@@ -864,6 +944,42 @@ func (VSOCKOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (ChangedBlocksOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "ChangedBlocksOptions are the parameters of a changed blocks query",
+		"fromCheckpoint": "FromCheckpoint is the checkpoint the changes are reported from",
+		"toCheckpoint":   "ToCheckpoint is a later checkpoint ending the range, the changes up to now\nare reported if unset\n+optional",
+		"disk":           "Disk limits the query to a single disk, all the disks with changed block\ntracking are reported if unset. Required by the binary format.\n+optional",
+		"format":         "Format is the encoding of the response, json if unset\n+optional",
+	}
+}
+
+func (ChangedBlocksList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "ChangedBlocksList lists the changed extents of the disks of a VirtualMachineInstance",
+		"fromCheckpoint": "FromCheckpoint is the checkpoint the changes are reported from",
+		"toCheckpoint":   "ToCheckpoint is the checkpoint ending the range, if any\n+optional",
+		"disks":          "Disks are the changed extents of each disk\n+listType=atomic",
+	}
+}
+
+func (DiskChangedBlocks) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "DiskChangedBlocks are the changed extents of a disk",
+		"name":    "Name is the name of the disk",
+		"size":    "Size is the virtual size of the disk in bytes",
+		"extents": "Extents are the changed ranges of the disk, ordered by offset\n+listType=atomic",
+	}
+}
+
+func (ChangedBlockExtent) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "ChangedBlockExtent is a changed range of a disk",
+		"offset": "Offset is the offset of the range in bytes",
+		"length": "Length is the length of the range in bytes",
+	}
+}
+
 func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":       "RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk",
@@ -872,6 +988,14 @@ func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (ResetContainerDiskOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "ResetContainerDiskOptions is provided when resetting the persistent overlay of container disks\nback to the content of their image",
+		"volumeName": "VolumeName is the name of the container disk volume to reset.\nAll container disks with a persistent overlay are reset if empty.\n+optional",
+		"dryRun":     "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
 func (TokenBucketRateLimiter) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"qps":   "QPS indicates the maximum QPS to the apiserver from this client.\nIf it's zero, the component default will be used",
@@ -1018,6 +1142,16 @@ func (MigrationConfiguration) SwaggerDoc() map[string]string {
 		"disableTLS":                        "When set to true, DisableTLS will disable the additional layer of live migration encryption\nprovided by KubeVirt. This is usually a bad idea. Defaults to false",
 		"network":                           "Network is the name of the CNI network to use for live migrations. By default, migrations go\nthrough the pod network.",
 		"matchSELinuxLevelOnMigration":      "By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.\nWhen set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.\nThat will ensure the target virt-launcher doesn't share categories with another pod on the node.\nHowever, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.",
+		"compression":                       "Compression configures compression of the guest memory transferred during live migrations.\nDefaults to no compression\n+optional",
+	}
+}
+
+func (MigrationCompression) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "MigrationCompression holds the compression settings of live migrations",
+		"method":          "Method is the compression algorithm. zstd and zlib require parallel (multifd) migrations,\nwhich are not used together with post-copy or when the VMI has a CPU limit.\n+kubebuilder:validation:Enum=zstd;zlib;xbzrle",
+		"level":           "Level is the compression level, between 1 and 9 for zlib and between 1 and 20 for zstd.\nIt is not supported by xbzrle. Defaults to the hypervisor default\n+optional",
+		"xbzrleCacheSize": "XBZRLECacheSize is the size of the page cache used by xbzrle.\nIt is only supported by xbzrle. Defaults to the hypervisor default\n+optional",
 	}
 }
 
@@ -105,9 +105,9 @@ type VirtualMachineExportStatus struct {
 	ServiceName string `json:"serviceName,omitempty"`
 
 	// +optional
-	// VirtualMachineName shows the name of the source virtual machine if the source is either a VirtualMachine or
-	// a VirtualMachineSnapshot. This is mainly to easily identify the source VirtualMachine in case of a
-	// VirtualMachineSnapshot
+	// VirtualMachineName shows the name of the source virtual machine if the source is either a VirtualMachine,
+	// a VirtualMachineSnapshot or a VirtualMachineBackup. This is mainly to easily identify the source VirtualMachine
+	// in case of a VirtualMachineSnapshot or a VirtualMachineBackup
 	VirtualMachineName *string `json:"virtualMachineName,omitempty"`
 
 	// +optional
@@ -157,6 +157,12 @@ const (
 	AllManifests ExportManifestType = "all"
 	// AuthHeader returns a CDI compatible secret containing the token as an Auth header
 	AuthHeader ExportManifestType = "auth-header-secret"
+	// OVF returns the OVF descriptor of the VirtualMachine
+	OVF ExportManifestType = "ovf"
+	// OVA returns an OVA package of the VirtualMachine, a tar containing the OVF descriptor and the disk images
+	OVA ExportManifestType = "ova"
+	// BackupCheckpoint returns the checkpoint metadata of the exported VirtualMachineBackup
+	BackupCheckpoint ExportManifestType = "backup-checkpoint"
 )
 
 // VirtualMachineExportVolume contains the name and available formats for the exported volume
@@ -167,6 +173,15 @@ type VirtualMachineExportVolume struct {
 	// +listMapKey=format
 	// +optional
 	Formats []VirtualMachineExportVolumeFormat `json:"formats,omitempty"`
+	// ExtentMapUrl is the url of the map of the allocated and unallocated ranges of the volume
+	// in RAW format, which allows to download it sparsely
+	// +optional
+	ExtentMapUrl string `json:"extentMapUrl,omitempty"`
+	// ChangedExtentsUrl is the url of the list of ranges of the volume contained in the
+	// backup of a VirtualMachineBackup. For an incremental backup these are the ranges
+	// which changed since the base checkpoint
+	// +optional
+	ChangedExtentsUrl string `json:"changedExtentsUrl,omitempty"`
 }
 
 type ExportVolumeFormat string
@@ -180,6 +195,13 @@ const (
 	Dir ExportVolumeFormat = "dir"
 	// ArchiveGz is a tarred and gzipped version of the root of a PersistentVolumeClaim
 	ArchiveGz ExportVolumeFormat = "tar.gz"
+	// KubeVirtQcow2 is the volume in QCOW2 format, which only contains the allocated ranges of the volume
+	KubeVirtQcow2 ExportVolumeFormat = "qcow2"
+	// KubeVirtQcow2Zstd is the volume in zstd compressed QCOW2 format
+	KubeVirtQcow2Zstd ExportVolumeFormat = "qcow2.zst"
+	// BackupQcow2 is the QCOW2 file written by a VirtualMachineBackup, for an incremental
+	// backup it only contains the ranges which changed since the base checkpoint
+	BackupQcow2 ExportVolumeFormat = "backup-qcow2"
 )
 
 // VirtualMachineExportVolumeFormat contains the format type and URL to get the volume in that format
@@ -32,7 +32,7 @@ func (VirtualMachineExportStatus) SwaggerDoc() map[string]string {
 		"tokenSecretRef":     "+optional\nTokenSecretRef is the name of the secret that contains the token used by the export server pod",
 		"ttlExpirationTime":  "The time at which the VM Export will be completely removed according to specified TTL\nFormula is CreationTimestamp + TTL",
 		"serviceName":        "+optional\nServiceName is the name of the service created associated with the Virtual Machine export. It will be used to\ncreate the internal URLs for downloading the images",
-		"virtualMachineName": "+optional\nVirtualMachineName shows the name of the source virtual machine if the source is either a VirtualMachine or\na VirtualMachineSnapshot. This is mainly to easily identify the source VirtualMachine in case of a\nVirtualMachineSnapshot",
+		"virtualMachineName": "+optional\nVirtualMachineName shows the name of the source virtual machine if the source is either a VirtualMachine,\na VirtualMachineSnapshot or a VirtualMachineBackup. This is mainly to easily identify the source VirtualMachine\nin case of a VirtualMachineSnapshot or a VirtualMachineBackup",
 		"conditions":         "+optional\n+listType=atomic",
 	}
 }
@@ -64,9 +64,11 @@ func (VirtualMachineExportManifest) SwaggerDoc() map[string]string {
 
 func (VirtualMachineExportVolume) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":        "VirtualMachineExportVolume contains the name and available formats for the exported volume",
-		"name":    "Name is the name of the exported volume",
-		"formats": "+listType=map\n+listMapKey=format\n+optional",
+		"":                  "VirtualMachineExportVolume contains the name and available formats for the exported volume",
+		"name":              "Name is the name of the exported volume",
+		"formats":           "+listType=map\n+listMapKey=format\n+optional",
+		"extentMapUrl":      "ExtentMapUrl is the url of the map of the allocated and unallocated ranges of the volume\nin RAW format, which allows to download it sparsely\n+optional",
+		"changedExtentsUrl": "ChangedExtentsUrl is the url of the list of ranges of the volume contained in the\nbackup of a VirtualMachineBackup. For an incremental backup these are the ranges\nwhich changed since the base checkpoint\n+optional",
 	}
 }
 
@@ -200,6 +200,11 @@ func (in *DevicePreferences) DeepCopyInto(out *DevicePreferences) {
 		*out = new(v1.BlockSize)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PreferredDiskIOTune != nil {
+		in, out := &in.PreferredDiskIOTune, &out.PreferredDiskIOTune
+		*out = new(v1.DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PreferredRng != nil {
 		in, out := &in.PreferredRng, &out.PreferredRng
 		*out = new(v1.Rng)
@@ -668,6 +673,11 @@ func (in *VirtualMachineInstancetypeSpec) DeepCopyInto(out *VirtualMachineInstan
 		*out = new(v1.LaunchSecurity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DiskIOTune != nil {
+		in, out := &in.DiskIOTune, &out.DiskIOTune
+		*out = new(v1.DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Annotations != nil {
 		in, out := &in.Annotations, &out.Annotations
 		*out = make(map[string]string, len(*in))
@@ -121,6 +121,11 @@ type VirtualMachineInstancetypeSpec struct {
 	// +optional
 	LaunchSecurity *v1.LaunchSecurity `json:"launchSecurity,omitempty"`
 
+	// Optionally defines the I/O throttling limits applied to all Disk and LUN devices of the instancetype.
+	//
+	// +optional
+	DiskIOTune *v1.DiskIOTune `json:"diskIOTune,omitempty"`
+
 	// Optionally defines the required Annotations to be used by the instance type and applied to the VirtualMachineInstance
 	//
 	// +optional
@@ -499,6 +504,11 @@ type DevicePreferences struct {
 	// +optional
 	PreferredDiskBlockSize *v1.BlockSize `json:"preferredDiskBlockSize,omitempty"`
 
+	// PreferredDiskIOTune optionally defines the I/O throttling limits of Disk devices.
+	//
+	// +optional
+	PreferredDiskIOTune *v1.DiskIOTune `json:"preferredDiskIOTune,omitempty"`
+
 	// PreferredInterfaceModel optionally defines the preferred model to be used by Interface devices.
 	//
 	// +optional
@@ -40,6 +40,7 @@ func (VirtualMachineInstancetypeSpec) SwaggerDoc() map[string]string {
 		"ioThreadsPolicy": "Optionally defines the IOThreadsPolicy to be used by the instancetype.\n\n+optional",
 		"ioThreads":       "Optionally specifies the IOThreads options to be used by the instancetype.\n+optional",
 		"launchSecurity":  "Optionally defines the LaunchSecurity to be used by the instancetype.\n\n+optional",
+		"diskIOTune":      "Optionally defines the I/O throttling limits applied to all Disk and LUN devices of the instancetype.\n\n+optional",
 		"annotations":     "Optionally defines the required Annotations to be used by the instance type and applied to the VirtualMachineInstance\n\n+optional",
 	}
 }
@@ -157,6 +158,7 @@ func (DevicePreferences) SwaggerDoc() map[string]string {
 		"preferredDiskCache":                  "PreferredCache optionally defines the DriverCache to be used by Disk devices.\n\n+optional",
 		"preferredDiskIO":                     "PreferredIo optionally defines the QEMU disk IO mode to be used by Disk devices.\n\n+optional",
 		"preferredDiskBlockSize":              "PreferredBlockSize optionally defines the block size of Disk devices.\n\n+optional",
+		"preferredDiskIOTune":                 "PreferredDiskIOTune optionally defines the I/O throttling limits of Disk devices.\n\n+optional",
 		"preferredInterfaceModel":             "PreferredInterfaceModel optionally defines the preferred model to be used by Interface devices.\n\n+optional",
 		"preferredRng":                        "PreferredRng optionally defines the preferred rng device to be used.\n\n+optional",
 		"preferredBlockMultiQueue":            "PreferredBlockMultiQueue optionally enables the vhost multiqueue feature for virtio disks.\n\n+optional",
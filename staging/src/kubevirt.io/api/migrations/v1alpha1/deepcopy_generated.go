@@ -24,7 +24,9 @@ Copyright The KubeVirt Authors.
 package v1alpha1
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	corev1 "kubevirt.io/api/core/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -55,7 +57,7 @@ func (in *MigrationPolicy) DeepCopyInto(out *MigrationPolicy) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -110,6 +112,32 @@ func (in *MigrationPolicyList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationPolicyMigrationStatistics) DeepCopyInto(out *MigrationPolicyMigrationStatistics) {
+	*out = *in
+	if in.AverageSucceededDuration != nil {
+		in, out := &in.AverageSucceededDuration, &out.AverageSucceededDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.AverageFailedDuration != nil {
+		in, out := &in.AverageFailedDuration, &out.AverageFailedDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationPolicyMigrationStatistics.
+func (in *MigrationPolicyMigrationStatistics) DeepCopy() *MigrationPolicyMigrationStatistics {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationPolicyMigrationStatistics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationPolicySpec) DeepCopyInto(out *MigrationPolicySpec) {
 	*out = *in
@@ -143,6 +171,23 @@ func (in *MigrationPolicySpec) DeepCopyInto(out *MigrationPolicySpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = new(corev1.MigrationCompression)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]corev1.MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AllowNodeDrainOutsideMaintenanceWindows != nil {
+		in, out := &in.AllowNodeDrainOutsideMaintenanceWindows, &out.AllowNodeDrainOutsideMaintenanceWindows
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -159,6 +204,16 @@ func (in *MigrationPolicySpec) DeepCopy() *MigrationPolicySpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MigrationPolicyStatus) DeepCopyInto(out *MigrationPolicyStatus) {
 	*out = *in
+	if in.OverridingPolicies != nil {
+		in, out := &in.OverridingPolicies, &out.OverridingPolicies
+		*out = make([]OverridingMigrationPolicy, len(*in))
+		copy(*out, *in)
+	}
+	if in.Migrations != nil {
+		in, out := &in.Migrations, &out.Migrations
+		*out = new(MigrationPolicyMigrationStatistics)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -172,6 +227,22 @@ func (in *MigrationPolicyStatus) DeepCopy() *MigrationPolicyStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverridingMigrationPolicy) DeepCopyInto(out *OverridingMigrationPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverridingMigrationPolicy.
+func (in *OverridingMigrationPolicy) DeepCopy() *OverridingMigrationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OverridingMigrationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Selectors) DeepCopyInto(out *Selectors) {
 	*out = *in
@@ -53,6 +53,17 @@ type MigrationPolicySpec struct {
 	AllowPostCopy *bool `json:"allowPostCopy,omitempty"`
 	//+optional
 	AllowWorkloadDisruption *bool `json:"allowWorkloadDisruption,omitempty"`
+	//+optional
+	Compression *k6tv1.MigrationCompression `json:"compression,omitempty"`
+	// MaintenanceWindows restricts automatic live migrations, like workload updates and descheduler
+	// evictions, to the given windows. An empty list allows them at any time
+	//+optional
+	//+listType=atomic
+	MaintenanceWindows []k6tv1.MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+	// AllowNodeDrainOutsideMaintenanceWindows lets node drains migrate the VMIs immediately
+	// instead of waiting for the next maintenance window. Defaults to true
+	//+optional
+	AllowNodeDrainOutsideMaintenanceWindows *bool `json:"allowNodeDrainOutsideMaintenanceWindows,omitempty"`
 }
 
 type LabelSelector map[string]string
@@ -65,6 +76,43 @@ type Selectors struct {
 }
 
 type MigrationPolicyStatus struct {
+	// MatchedVirtualMachineInstances is the number of VirtualMachineInstances selected by the policy
+	//+optional
+	MatchedVirtualMachineInstances int32 `json:"matchedVirtualMachineInstances,omitempty"`
+	// AppliedVirtualMachineInstances is the number of VirtualMachineInstances the policy applies to,
+	// i.e. the selected VirtualMachineInstances not taken over by a more specific policy
+	//+optional
+	AppliedVirtualMachineInstances int32 `json:"appliedVirtualMachineInstances,omitempty"`
+	// OverridingPolicies are the policies which apply instead of this policy to some of the
+	// VirtualMachineInstances it selects
+	//+optional
+	//+listType=atomic
+	OverridingPolicies []OverridingMigrationPolicy `json:"overridingPolicies,omitempty"`
+	// Migrations holds statistics about the recent migrations performed under the policy
+	//+optional
+	Migrations *MigrationPolicyMigrationStatistics `json:"migrations,omitempty"`
+}
+
+type OverridingMigrationPolicy struct {
+	// Name is the name of the overriding policy
+	Name string `json:"name"`
+	// VirtualMachineInstances is the number of VirtualMachineInstances the overriding policy applies to
+	VirtualMachineInstances int32 `json:"virtualMachineInstances"`
+}
+
+type MigrationPolicyMigrationStatistics struct {
+	// Succeeded is the number of recent migrations which succeeded
+	//+optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+	// Failed is the number of recent migrations which failed
+	//+optional
+	Failed int32 `json:"failed,omitempty"`
+	// AverageSucceededDuration is the average duration of the recent migrations which succeeded
+	//+optional
+	AverageSucceededDuration *metav1.Duration `json:"averageSucceededDuration,omitempty"`
+	// AverageFailedDuration is the average duration of the recent migrations which failed
+	//+optional
+	AverageFailedDuration *metav1.Duration `json:"averageFailedDuration,omitempty"`
 }
 
 // MigrationPolicyList is a list of MigrationPolicy
@@ -110,5 +158,10 @@ func (m *MigrationPolicy) GetMigrationConfByPolicy(clusterMigrationConfiguration
 		*clusterMigrationConfigurations.AllowWorkloadDisruption = *policySpec.AllowPostCopy
 	}
 
+	if policySpec.Compression != nil {
+		changed = true
+		clusterMigrationConfigurations.Compression = policySpec.Compression.DeepCopy()
+	}
+
 	return changed, nil
 }
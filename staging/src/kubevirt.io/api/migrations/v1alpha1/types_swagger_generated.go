@@ -11,11 +11,14 @@ func (MigrationPolicy) SwaggerDoc() map[string]string {
 
 func (MigrationPolicySpec) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"allowAutoConverge":       "+optional",
-		"bandwidthPerMigration":   "+optional",
-		"completionTimeoutPerGiB": "+optional",
-		"allowPostCopy":           "+optional",
-		"allowWorkloadDisruption": "+optional",
+		"allowAutoConverge":                       "+optional",
+		"bandwidthPerMigration":                   "+optional",
+		"completionTimeoutPerGiB":                 "+optional",
+		"allowPostCopy":                           "+optional",
+		"allowWorkloadDisruption":                 "+optional",
+		"compression":                             "+optional",
+		"maintenanceWindows":                      "MaintenanceWindows restricts automatic live migrations, like workload updates and descheduler\nevictions, to the given windows. An empty list allows them at any time\n+optional\n+listType=atomic",
+		"allowNodeDrainOutsideMaintenanceWindows": "AllowNodeDrainOutsideMaintenanceWindows lets node drains migrate the VMIs immediately\ninstead of waiting for the next maintenance window. Defaults to true\n+optional",
 	}
 }
 
@@ -27,7 +30,28 @@ func (Selectors) SwaggerDoc() map[string]string {
 }
 
 func (MigrationPolicyStatus) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"matchedVirtualMachineInstances": "MatchedVirtualMachineInstances is the number of VirtualMachineInstances selected by the policy\n+optional",
+		"appliedVirtualMachineInstances": "AppliedVirtualMachineInstances is the number of VirtualMachineInstances the policy applies to,\ni.e. the selected VirtualMachineInstances not taken over by a more specific policy\n+optional",
+		"overridingPolicies":             "OverridingPolicies are the policies which apply instead of this policy to some of the\nVirtualMachineInstances it selects\n+optional\n+listType=atomic",
+		"migrations":                     "Migrations holds statistics about the recent migrations performed under the policy\n+optional",
+	}
+}
+
+func (OverridingMigrationPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"name":                    "Name is the name of the overriding policy",
+		"virtualMachineInstances": "VirtualMachineInstances is the number of VirtualMachineInstances the overriding policy applies to",
+	}
+}
+
+func (MigrationPolicyMigrationStatistics) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"succeeded":                "Succeeded is the number of recent migrations which succeeded\n+optional",
+		"failed":                   "Failed is the number of recent migrations which failed\n+optional",
+		"averageSucceededDuration": "AverageSucceededDuration is the average duration of the recent migrations which succeeded\n+optional",
+		"averageFailedDuration":    "AverageFailedDuration is the average duration of the recent migrations which failed\n+optional",
+	}
 }
 
 func (MigrationPolicyList) SwaggerDoc() map[string]string {
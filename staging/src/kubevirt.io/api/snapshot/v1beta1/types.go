@@ -72,17 +72,26 @@ type VirtualMachineSnapshotSpec struct {
 	// Defaults to DefaultFailureDeadline - 5min
 	// +optional
 	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+
+	// IncludeMemory saves the memory and device state of a running VM
+	// together with its volumes, so that a restore can resume the guest
+	// instead of booting it. Ignored when the VM is not running.
+	// +optional
+	IncludeMemory *bool `json:"includeMemory,omitempty"`
 }
 
 // Indication is a way to indicate the state of the vm when taking the snapshot
 type Indication string
 
 const (
-	VMSnapshotOnlineSnapshotIndication Indication = "Online"
-	VMSnapshotNoGuestAgentIndication   Indication = "NoGuestAgent"
-	VMSnapshotGuestAgentIndication     Indication = "GuestAgent"
-	VMSnapshotQuiesceFailedIndication  Indication = "QuiesceFailed"
-	VMSnapshotPausedIndication         Indication = "Paused"
+	VMSnapshotOnlineSnapshotIndication      Indication = "Online"
+	VMSnapshotNoGuestAgentIndication        Indication = "NoGuestAgent"
+	VMSnapshotGuestAgentIndication          Indication = "GuestAgent"
+	VMSnapshotQuiesceFailedIndication       Indication = "QuiesceFailed"
+	VMSnapshotPausedIndication              Indication = "Paused"
+	VMSnapshotMemoryIndication              Indication = "Memory"
+	VMSnapshotPreFreezeHookFailedIndication Indication = "PreFreezeHookFailed"
+	VMSnapshotPostThawHookFailedIndication  Indication = "PostThawHookFailed"
 )
 
 // SourceIndication provides an indication of the source VM with its description message
@@ -229,6 +238,9 @@ type VirtualMachineSnapshotContentSpec struct {
 	// +optional
 	// +listType=atomic
 	VolumeBackups []VolumeBackup `json:"volumeBackups,omitempty"`
+
+	// +optional
+	MemoryState *MemoryStateBackup `json:"memoryState,omitempty"`
 }
 
 type VirtualMachine struct {
@@ -271,6 +283,12 @@ type VolumeBackup struct {
 	VolumeSnapshotName *string `json:"volumeSnapshotName,omitempty"`
 }
 
+// MemoryStateBackup references the PVC holding the saved memory and
+// device state of the source VM
+type MemoryStateBackup struct {
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName"`
+}
+
 // VirtualMachineSnapshotContentStatus is the status for a VirtualMachineSnapshotStatus resource
 type VirtualMachineSnapshotContentStatus struct {
 	// +optional
@@ -286,6 +304,26 @@ type VirtualMachineSnapshotContentStatus struct {
 	// +optional
 	// +listType=atomic
 	VolumeSnapshotStatus []VolumeSnapshotStatus `json:"volumeSnapshotStatus,omitempty"`
+
+	// +optional
+	MemoryStateStatus *MemoryStateStatus `json:"memoryStateStatus,omitempty"`
+
+	// FreezeHookErrors are the failures of the guest freeze hooks run while taking the snapshot
+	// +optional
+	// +listType=atomic
+	FreezeHookErrors []Error `json:"freezeHookErrors,omitempty"`
+}
+
+// MemoryStateStatus is the status of saving the memory state of the source VM
+type MemoryStateStatus struct {
+	// +optional
+	// +nullable
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// SourcePaused is set when the source VM was paused by the snapshot
+	// in order to save its memory state, and has to be unpaused afterwards
+	// +optional
+	SourcePaused bool `json:"sourcePaused,omitempty"`
 }
 
 // VirtualMachineSnapshotContentList is a list of VirtualMachineSnapshot resources
@@ -404,6 +442,12 @@ type VirtualMachineRestoreSpec struct {
 	// +optional
 	// +listType=atomic
 	Patches []string `json:"patches,omitempty"`
+
+	// ResumeFromMemory starts the restored VM from the memory state saved in
+	// the snapshot, resuming the guest instead of booting it.
+	// Requires a snapshot taken with includeMemory.
+	// +optional
+	ResumeFromMemory *bool `json:"resumeFromMemory,omitempty"`
 }
 
 // VirtualMachineRestoreStatus is the status for a VirtualMachineRestore resource
@@ -458,3 +502,242 @@ type VirtualMachineRestoreList struct {
 
 	Items []VirtualMachineRestore `json:"items"`
 }
+
+// SnapshotScheduleLabel is set on the snapshots and snapshot contents created
+// by a VirtualMachineSnapshotSchedule to the name of the schedule
+const SnapshotScheduleLabel = "snapshot.kubevirt.io/schedule"
+
+// VirtualMachineSnapshotSchedule periodically snapshots the VMs matching a
+// label selector and prunes the snapshots past their retention
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotSchedule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotScheduleSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotScheduleStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleSpec struct {
+	// Schedule is a cron expression in the standard five field format
+	// defining when snapshots are taken. It is evaluated in UTC
+	// +kubebuilder:validation:MinLength=1
+	Schedule string `json:"schedule"`
+
+	// Suspend stops the creation of new snapshots, the retention is still enforced
+	// +optional
+	Suspend bool `json:"suspend,omitempty"`
+
+	// Selector selects the VirtualMachines in the namespace of the schedule
+	// which are snapshotted on each run
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// FailureDeadline is the failure deadline of the snapshots taken on each run.
+	// A run fails when one of its snapshots does not succeed in time.
+	// Defaults to DefaultFailureDeadline - 5min
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+
+	// Retention limits the snapshots which are kept, the others are deleted
+	// together with their content. When unset all snapshots are kept
+	// +optional
+	Retention *SnapshotRetention `json:"retention,omitempty"`
+}
+
+// SnapshotRetention limits the snapshots kept by a VirtualMachineSnapshotSchedule.
+// Failed snapshots are deleted once a later snapshot of the same VM succeeded.
+type SnapshotRetention struct {
+	// MaxCount is the number of succeeded snapshots kept for each VM
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxCount *int32 `json:"maxCount,omitempty"`
+
+	// MaxAge is the age after which snapshots are deleted
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource
+type VirtualMachineSnapshotScheduleStatus struct {
+	// LastScheduleTime is the time the most recent run was scheduled at
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// NextScheduleTime is the time the next run is scheduled at
+	// +optional
+	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
+
+	// LastSuccessTime is the schedule time of the most recent run
+	// whose snapshots all succeeded
+	// +optional
+	LastSuccessTime *metav1.Time `json:"lastSuccessTime,omitempty"`
+
+	// LastFailureTime is the schedule time of the most recent run
+	// with a failed snapshot
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotScheduleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotSchedule `json:"items"`
+}
+
+// SnapshotGroupLabel is set on the snapshots and snapshot contents taken by a
+// VirtualMachineSnapshotGroup to the name of the group
+const SnapshotGroupLabel = "snapshot.kubevirt.io/snapshot-group"
+
+// SnapshotGroupRestoreLabel is set on the restores created by a
+// VirtualMachineSnapshotGroupRestore to the name of the group restore
+const SnapshotGroupRestoreLabel = "snapshot.kubevirt.io/snapshot-group-restore"
+
+// VirtualMachineSnapshotGroup snapshots the VMs matching a label selector at one
+// point in time. The filesystems of all VMs are frozen before any of their
+// volumes are snapshotted and thawed once all volume snapshots are taken.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotGroupSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotGroupStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource
+type VirtualMachineSnapshotGroupSpec struct {
+	// Selector selects the VirtualMachines in the namespace of the group
+	// which are snapshotted together
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// DeletionPolicy is the deletion policy of the snapshots taken of the VMs
+	// +optional
+	DeletionPolicy *DeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// This time represents the number of seconds we permit the snapshots of the
+	// group to take. In case we pass this deadline the group is marked as failed.
+	// It is also the time after which a frozen VM is thawed by its guest agent.
+	// Defaults to DefaultFailureDeadline - 5min
+	// +optional
+	FailureDeadline *metav1.Duration `json:"failureDeadline,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource
+type VirtualMachineSnapshotGroupStatus struct {
+	// +optional
+	Phase VirtualMachineSnapshotPhase `json:"phase,omitempty"`
+
+	// CreationTime is the point in time of the snapshots, the time at
+	// which all VMs of the group were frozen
+	// +optional
+	// +nullable
+	CreationTime *metav1.Time `json:"creationTime,omitempty"`
+
+	// ThawTime is the time at which the VMs of the group were thawed
+	// +optional
+	// +nullable
+	ThawTime *metav1.Time `json:"thawTime,omitempty"`
+
+	// +optional
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+
+	// Snapshots are the snapshots taken of the VMs of the group
+	// +optional
+	// +listType=atomic
+	Snapshots []SnapshotGroupMember `json:"snapshots,omitempty"`
+
+	// +optional
+	Error *Error `json:"error,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// SnapshotGroupMember is the snapshot taken of a VM of a VirtualMachineSnapshotGroup
+type SnapshotGroupMember struct {
+	VirtualMachineName         string `json:"virtualMachineName"`
+	VirtualMachineSnapshotName string `json:"virtualMachineSnapshotName"`
+}
+
+// VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotGroup `json:"items"`
+}
+
+// VirtualMachineSnapshotGroupRestore restores all VMs of a VirtualMachineSnapshotGroup
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroupRestore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec VirtualMachineSnapshotGroupRestoreSpec `json:"spec"`
+
+	// +optional
+	Status *VirtualMachineSnapshotGroupRestoreStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupRestoreSpec is the spec for a VirtualMachineSnapshotGroupRestore resource
+type VirtualMachineSnapshotGroupRestoreSpec struct {
+	VirtualMachineSnapshotGroupName string `json:"virtualMachineSnapshotGroupName"`
+
+	// TargetReadinessPolicy is passed to the restore of each VM
+	// +optional
+	TargetReadinessPolicy *TargetReadinessPolicy `json:"targetReadinessPolicy,omitempty"`
+
+	// VolumeRestorePolicy is passed to the restore of each VM
+	// +optional
+	VolumeRestorePolicy *VolumeRestorePolicy `json:"volumeRestorePolicy,omitempty"`
+}
+
+// VirtualMachineSnapshotGroupRestoreStatus is the status for a VirtualMachineSnapshotGroupRestore resource
+type VirtualMachineSnapshotGroupRestoreStatus struct {
+	// Restores are the restores of the VMs of the group
+	// +optional
+	// +listType=atomic
+	Restores []SnapshotGroupRestoreMember `json:"restores,omitempty"`
+
+	// +optional
+	RestoreTime *metav1.Time `json:"restoreTime,omitempty"`
+
+	// +optional
+	Complete *bool `json:"complete,omitempty"`
+
+	// +optional
+	// +listType=atomic
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// SnapshotGroupRestoreMember is the restore of a VM of a VirtualMachineSnapshotGroup
+type SnapshotGroupRestoreMember struct {
+	VirtualMachineName        string `json:"virtualMachineName"`
+	VirtualMachineRestoreName string `json:"virtualMachineRestoreName"`
+}
+
+// VirtualMachineSnapshotGroupRestoreList is a list of VirtualMachineSnapshotGroupRestore resources
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineSnapshotGroupRestoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []VirtualMachineSnapshotGroupRestore `json:"items"`
+}
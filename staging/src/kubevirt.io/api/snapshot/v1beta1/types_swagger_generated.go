@@ -14,6 +14,7 @@ func (VirtualMachineSnapshotSpec) SwaggerDoc() map[string]string {
 		"":                "VirtualMachineSnapshotSpec is the spec for a VirtualMachineSnapshot resource",
 		"deletionPolicy":  "+optional",
 		"failureDeadline": "This time represents the number of seconds we permit the vm snapshot\nto take. In case we pass this deadline we mark this snapshot\nas failed.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+		"includeMemory":   "IncludeMemory saves the memory and device state of a running VM\ntogether with its volumes, so that a restore can resume the guest\ninstead of booting it. Ignored when the VM is not running.\n+optional",
 	}
 }
 
@@ -84,6 +85,7 @@ func (VirtualMachineSnapshotContentSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":              "VirtualMachineSnapshotContentSpec is the spec for a VirtualMachineSnapshotContent resource",
 		"volumeBackups": "+optional\n+listType=atomic",
+		"memoryState":   "+optional",
 	}
 }
 
@@ -114,6 +116,12 @@ func (VolumeBackup) SwaggerDoc() map[string]string {
 	}
 }
 
+func (MemoryStateBackup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "MemoryStateBackup references the PVC holding the saved memory and\ndevice state of the source VM",
+	}
+}
+
 func (VirtualMachineSnapshotContentStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                     "VirtualMachineSnapshotContentStatus is the status for a VirtualMachineSnapshotStatus resource",
@@ -121,6 +129,16 @@ func (VirtualMachineSnapshotContentStatus) SwaggerDoc() map[string]string {
 		"readyToUse":           "+optional",
 		"error":                "+optional",
 		"volumeSnapshotStatus": "+optional\n+listType=atomic",
+		"memoryStateStatus":    "+optional",
+		"freezeHookErrors":     "FreezeHookErrors are the failures of the guest freeze hooks run while taking the snapshot\n+optional\n+listType=atomic",
+	}
+}
+
+func (MemoryStateStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "MemoryStateStatus is the status of saving the memory state of the source VM",
+		"creationTime": "+optional\n+nullable",
+		"sourcePaused": "SourcePaused is set when the source VM was paused by the snapshot\nin order to save its memory state, and has to be unpaused afterwards\n+optional",
 	}
 }
 
@@ -155,6 +173,7 @@ func (VirtualMachineRestoreSpec) SwaggerDoc() map[string]string {
 		"volumeOwnershipPolicy":  "+optional",
 		"volumeRestoreOverrides": "VolumeRestoreOverrides gives the option to change properties of each restored volume\nFor example, specifying the name of the restored volume, or adding labels/annotations to it\n+optional\n+listType=atomic",
 		"patches":                "If the target for the restore does not exist, it will be created. Patches holds JSON patches that would be\napplied to the target manifest before it's created. Patches should fit the target's Kind.\n\nExample for a patch: {\"op\": \"replace\", \"path\": \"/metadata/name\", \"value\": \"new-vm-name\"}\n\n+optional\n+listType=atomic",
+		"resumeFromMemory":       "ResumeFromMemory starts the restored VM from the memory state saved in\nthe snapshot, resuming the guest instead of booting it.\nRequires a snapshot taken with includeMemory.\n+optional",
 	}
 }
 
@@ -190,3 +209,124 @@ func (VirtualMachineRestoreList) SwaggerDoc() map[string]string {
 		"": "VirtualMachineRestoreList is a list of VirtualMachineRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
 	}
 }
+
+func (VirtualMachineSnapshotSchedule) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotSchedule periodically snapshots the VMs matching a\nlabel selector and prunes the snapshots past their retention\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotScheduleSpec is the spec for a VirtualMachineSnapshotSchedule resource",
+		"schedule":        "Schedule is a cron expression in the standard five field format\ndefining when snapshots are taken. It is evaluated in UTC\n+kubebuilder:validation:MinLength=1",
+		"suspend":         "Suspend stops the creation of new snapshots, the retention is still enforced\n+optional",
+		"selector":        "Selector selects the VirtualMachines in the namespace of the schedule\nwhich are snapshotted on each run",
+		"failureDeadline": "FailureDeadline is the failure deadline of the snapshots taken on each run.\nA run fails when one of its snapshots does not succeed in time.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+		"retention":       "Retention limits the snapshots which are kept, the others are deleted\ntogether with their content. When unset all snapshots are kept\n+optional",
+	}
+}
+
+func (SnapshotRetention) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "SnapshotRetention limits the snapshots kept by a VirtualMachineSnapshotSchedule.\nFailed snapshots are deleted once a later snapshot of the same VM succeeded.",
+		"maxCount": "MaxCount is the number of succeeded snapshots kept for each VM\n+optional\n+kubebuilder:validation:Minimum=1",
+		"maxAge":   "MaxAge is the age after which snapshots are deleted\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "VirtualMachineSnapshotScheduleStatus is the status for a VirtualMachineSnapshotSchedule resource",
+		"lastScheduleTime": "LastScheduleTime is the time the most recent run was scheduled at\n+optional",
+		"nextScheduleTime": "NextScheduleTime is the time the next run is scheduled at\n+optional",
+		"lastSuccessTime":  "LastSuccessTime is the schedule time of the most recent run\nwhose snapshots all succeeded\n+optional",
+		"lastFailureTime":  "LastFailureTime is the schedule time of the most recent run\nwith a failed snapshot\n+optional",
+		"conditions":       "+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineSnapshotScheduleList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotScheduleList is a list of VirtualMachineSnapshotSchedule resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineSnapshotGroup) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotGroup snapshots the VMs matching a label selector at one\npoint in time. The filesystems of all VMs are frozen before any of their\nvolumes are snapshotted and thawed once all volume snapshots are taken.\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "VirtualMachineSnapshotGroupSpec is the spec for a VirtualMachineSnapshotGroup resource",
+		"selector":        "Selector selects the VirtualMachines in the namespace of the group\nwhich are snapshotted together",
+		"deletionPolicy":  "DeletionPolicy is the deletion policy of the snapshots taken of the VMs\n+optional",
+		"failureDeadline": "This time represents the number of seconds we permit the snapshots of the\ngroup to take. In case we pass this deadline the group is marked as failed.\nIt is also the time after which a frozen VM is thawed by its guest agent.\nDefaults to DefaultFailureDeadline - 5min\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "VirtualMachineSnapshotGroupStatus is the status for a VirtualMachineSnapshotGroup resource",
+		"phase":        "+optional",
+		"creationTime": "CreationTime is the point in time of the snapshots, the time at\nwhich all VMs of the group were frozen\n+optional\n+nullable",
+		"thawTime":     "ThawTime is the time at which the VMs of the group were thawed\n+optional\n+nullable",
+		"readyToUse":   "+optional",
+		"snapshots":    "Snapshots are the snapshots taken of the VMs of the group\n+optional\n+listType=atomic",
+		"error":        "+optional",
+		"conditions":   "+optional\n+listType=atomic",
+	}
+}
+
+func (SnapshotGroupMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "SnapshotGroupMember is the snapshot taken of a VM of a VirtualMachineSnapshotGroup",
+	}
+}
+
+func (VirtualMachineSnapshotGroupList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGroupList is a list of VirtualMachineSnapshotGroup resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineSnapshotGroupRestore) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineSnapshotGroupRestore restores all VMs of a VirtualMachineSnapshotGroup\n+genclient\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupRestoreSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                      "VirtualMachineSnapshotGroupRestoreSpec is the spec for a VirtualMachineSnapshotGroupRestore resource",
+		"targetReadinessPolicy": "TargetReadinessPolicy is passed to the restore of each VM\n+optional",
+		"volumeRestorePolicy":   "VolumeRestorePolicy is passed to the restore of each VM\n+optional",
+	}
+}
+
+func (VirtualMachineSnapshotGroupRestoreStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineSnapshotGroupRestoreStatus is the status for a VirtualMachineSnapshotGroupRestore resource",
+		"restores":    "Restores are the restores of the VMs of the group\n+optional\n+listType=atomic",
+		"restoreTime": "+optional",
+		"complete":    "+optional",
+		"conditions":  "+optional\n+listType=atomic",
+	}
+}
+
+func (SnapshotGroupRestoreMember) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "SnapshotGroupRestoreMember is the restore of a VM of a VirtualMachineSnapshotGroup",
+	}
+}
+
+func (VirtualMachineSnapshotGroupRestoreList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineSnapshotGroupRestoreList is a list of VirtualMachineSnapshotGroupRestore resources\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
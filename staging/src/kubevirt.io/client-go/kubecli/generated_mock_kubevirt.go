@@ -1229,6 +1229,34 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineBackup(namespace any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineBackup", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineBackup), namespace)
 }
 
+// VirtualMachineBackupRestore mocks base method.
+func (m *MockKubevirtClient) VirtualMachineBackupRestore(namespace string) v1alpha19.VirtualMachineBackupRestoreInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineBackupRestore", namespace)
+	ret0, _ := ret[0].(v1alpha19.VirtualMachineBackupRestoreInterface)
+	return ret0
+}
+
+// VirtualMachineBackupRestore indicates an expected call of VirtualMachineBackupRestore.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineBackupRestore(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineBackupRestore", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineBackupRestore), namespace)
+}
+
+// VirtualMachineBackupSchedule mocks base method.
+func (m *MockKubevirtClient) VirtualMachineBackupSchedule(namespace string) v1alpha19.VirtualMachineBackupScheduleInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineBackupSchedule", namespace)
+	ret0, _ := ret[0].(v1alpha19.VirtualMachineBackupScheduleInterface)
+	return ret0
+}
+
+// VirtualMachineBackupSchedule indicates an expected call of VirtualMachineBackupSchedule.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineBackupSchedule(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineBackupSchedule", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineBackupSchedule), namespace)
+}
+
 // VirtualMachineBackupTracker mocks base method.
 func (m *MockKubevirtClient) VirtualMachineBackupTracker(namespace string) v1alpha19.VirtualMachineBackupTrackerInterface {
 	m.ctrl.T.Helper()
@@ -1425,6 +1453,48 @@ func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotContent(namespac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotContent", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotContent), namespace)
 }
 
+// VirtualMachineSnapshotGroup mocks base method.
+func (m *MockKubevirtClient) VirtualMachineSnapshotGroup(namespace string) v1beta121.VirtualMachineSnapshotGroupInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineSnapshotGroup", namespace)
+	ret0, _ := ret[0].(v1beta121.VirtualMachineSnapshotGroupInterface)
+	return ret0
+}
+
+// VirtualMachineSnapshotGroup indicates an expected call of VirtualMachineSnapshotGroup.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotGroup(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotGroup", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotGroup), namespace)
+}
+
+// VirtualMachineSnapshotGroupRestore mocks base method.
+func (m *MockKubevirtClient) VirtualMachineSnapshotGroupRestore(namespace string) v1beta121.VirtualMachineSnapshotGroupRestoreInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineSnapshotGroupRestore", namespace)
+	ret0, _ := ret[0].(v1beta121.VirtualMachineSnapshotGroupRestoreInterface)
+	return ret0
+}
+
+// VirtualMachineSnapshotGroupRestore indicates an expected call of VirtualMachineSnapshotGroupRestore.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotGroupRestore(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotGroupRestore", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotGroupRestore), namespace)
+}
+
+// VirtualMachineSnapshotSchedule mocks base method.
+func (m *MockKubevirtClient) VirtualMachineSnapshotSchedule(namespace string) v1beta121.VirtualMachineSnapshotScheduleInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VirtualMachineSnapshotSchedule", namespace)
+	ret0, _ := ret[0].(v1beta121.VirtualMachineSnapshotScheduleInterface)
+	return ret0
+}
+
+// VirtualMachineSnapshotSchedule indicates an expected call of VirtualMachineSnapshotSchedule.
+func (mr *MockKubevirtClientMockRecorder) VirtualMachineSnapshotSchedule(namespace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VirtualMachineSnapshotSchedule", reflect.TypeOf((*MockKubevirtClient)(nil).VirtualMachineSnapshotSchedule), namespace)
+}
+
 // MockVirtualMachineInstanceInterface is a mock of VirtualMachineInstanceInterface interface.
 type MockVirtualMachineInstanceInterface struct {
 	ctrl     *gomock.Controller
@@ -1477,6 +1547,21 @@ func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) Backup(ctx, name, bac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).Backup), ctx, name, backupOptions)
 }
 
+// ChangedBlocks mocks base method.
+func (m *MockVirtualMachineInstanceInterface) ChangedBlocks(ctx context.Context, name string, options *v122.ChangedBlocksOptions) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangedBlocks", ctx, name, options)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangedBlocks indicates an expected call of ChangedBlocks.
+func (mr *MockVirtualMachineInstanceInterfaceMockRecorder) ChangedBlocks(ctx, name, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangedBlocks", reflect.TypeOf((*MockVirtualMachineInstanceInterface)(nil).ChangedBlocks), ctx, name, options)
+}
+
 // Create mocks base method.
 func (m *MockVirtualMachineInstanceInterface) Create(ctx context.Context, virtualMachineInstance *v122.VirtualMachineInstance, opts v12.CreateOptions) (*v122.VirtualMachineInstance, error) {
 	m.ctrl.T.Helper()
@@ -2442,6 +2527,21 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) Migrate(ctx, name, migrateOpt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Migrate", reflect.TypeOf((*MockVirtualMachineInterface)(nil).Migrate), ctx, name, migrateOptions)
 }
 
+// MigrateCheck mocks base method.
+func (m *MockVirtualMachineInterface) MigrateCheck(ctx context.Context, name string, migrateOptions *v122.MigrateOptions) (*v122.MigrateCheckResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateCheck", ctx, name, migrateOptions)
+	ret0, _ := ret[0].(*v122.MigrateCheckResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateCheck indicates an expected call of MigrateCheck.
+func (mr *MockVirtualMachineInterfaceMockRecorder) MigrateCheck(ctx, name, migrateOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateCheck", reflect.TypeOf((*MockVirtualMachineInterface)(nil).MigrateCheck), ctx, name, migrateOptions)
+}
+
 // ObjectGraph mocks base method.
 func (m *MockVirtualMachineInterface) ObjectGraph(ctx context.Context, name string, objectGraphOptions *v122.ObjectGraphOptions) (v122.ObjectGraphNode, error) {
 	m.ctrl.T.Helper()
@@ -2535,6 +2635,20 @@ func (mr *MockVirtualMachineInterfaceMockRecorder) RemoveVolume(ctx, name, remov
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVolume", reflect.TypeOf((*MockVirtualMachineInterface)(nil).RemoveVolume), ctx, name, removeVolumeOptions)
 }
 
+// ResetContainerDisk mocks base method.
+func (m *MockVirtualMachineInterface) ResetContainerDisk(ctx context.Context, name string, resetContainerDiskOptions *v122.ResetContainerDiskOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetContainerDisk", ctx, name, resetContainerDiskOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResetContainerDisk indicates an expected call of ResetContainerDisk.
+func (mr *MockVirtualMachineInterfaceMockRecorder) ResetContainerDisk(ctx, name, resetContainerDiskOptions any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetContainerDisk", reflect.TypeOf((*MockVirtualMachineInterface)(nil).ResetContainerDisk), ctx, name, resetContainerDiskOptions)
+}
+
 // Restart mocks base method.
 func (m *MockVirtualMachineInterface) Restart(ctx context.Context, name string, restartOptions *v122.RestartOptions) error {
 	m.ctrl.T.Helper()
@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"net/url"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 )
@@ -38,6 +39,17 @@ type GuestfsInfo struct {
 	GsImage     string `json:"gsImage"`
 }
 
+// GuestfsSnapshotVolume is a claim restoring one volume of a VirtualMachineSnapshot
+type GuestfsSnapshotVolume struct {
+	VolumeName            string                       `json:"volumeName"`
+	PersistentVolumeClaim corev1.PersistentVolumeClaim `json:"persistentVolumeClaim"`
+}
+
+// GuestfsSnapshotInfo lists the claims restoring the volumes of a VirtualMachineSnapshot
+type GuestfsSnapshotInfo struct {
+	Volumes []GuestfsSnapshotVolume `json:"volumes"`
+}
+
 func (k *kubevirtClient) GuestfsVersion() *GuestfsVersion {
 	return &GuestfsVersion{
 		restClient: k.restClient,
@@ -51,37 +63,55 @@ type GuestfsVersion struct {
 }
 
 func (v *GuestfsVersion) Get() (*GuestfsInfo, error) {
-	var group metav1.APIGroup
-	// First, find out which version to query
-	uri := ApiGroupName
-	result := v.restClient.Get().AbsPath(uri).Do(context.Background())
-	if data, err := result.Raw(); err != nil {
-		connErr, isConnectionErr := err.(*url.Error)
+	groupVersion, err := v.preferredVersion()
+	if err != nil {
+		return nil, err
+	}
 
-		if isConnectionErr {
-			return nil, connErr.Err
-		}
+	var info GuestfsInfo
+	uri := fmt.Sprintf("/apis/%s/guestfs", groupVersion)
+	if err := v.get(uri, &info); err != nil {
+		return nil, err
+	}
+	return &info, nil
+}
 
+// SnapshotVolumes returns the claims to materialize for inspecting the
+// volumes of a VirtualMachineSnapshot with libguestfs
+func (v *GuestfsVersion) SnapshotVolumes(namespace, name string) (*GuestfsSnapshotInfo, error) {
+	groupVersion, err := v.preferredVersion()
+	if err != nil {
 		return nil, err
-	} else if err = json.Unmarshal(data, &group); err != nil {
+	}
+
+	var info GuestfsSnapshotInfo
+	uri := fmt.Sprintf("/apis/%s/namespaces/%s/virtualmachinesnapshots/%s/guestfs", groupVersion, namespace, name)
+	if err := v.get(uri, &info); err != nil {
 		return nil, err
 	}
+	return &info, nil
+}
 
-	// Now, query the preferred version
-	uri = fmt.Sprintf("/apis/%s/guestfs", group.PreferredVersion.GroupVersion)
-	var info GuestfsInfo
+// preferredVersion finds out which version of the subresource group to query
+func (v *GuestfsVersion) preferredVersion() (string, error) {
+	var group metav1.APIGroup
+	if err := v.get(ApiGroupName, &group); err != nil {
+		return "", err
+	}
+	return group.PreferredVersion.GroupVersion, nil
+}
 
-	result = v.restClient.Get().AbsPath(uri).Do(context.Background())
-	if data, err := result.Raw(); err != nil {
+func (v *GuestfsVersion) get(uri string, into interface{}) error {
+	result := v.restClient.Get().AbsPath(uri).Do(context.Background())
+	data, err := result.Raw()
+	if err != nil {
 		connErr, isConnectionErr := err.(*url.Error)
 
 		if isConnectionErr {
-			return nil, connErr.Err
+			return connErr.Err
 		}
 
-		return nil, err
-	} else if err = json.Unmarshal(data, &info); err != nil {
-		return nil, err
+		return err
 	}
-	return &info, nil
+	return json.Unmarshal(data, into)
 }
@@ -27,6 +27,7 @@ const (
 	pauseTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pause"
 	unpauseTemplateURI        = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unpause"
 	backupTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/backup"
+	changedBlocksTemplateURI  = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/changedblocks"
 	freezeTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/freeze"
 	unfreezeTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unfreeze"
 	resetTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/reset"
@@ -79,6 +80,7 @@ type VirtHandlerConn interface {
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	BackupURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	ChangedBlocksURI(vmi *virtv1.VirtualMachineInstance, options *virtv1.ChangedBlocksOptions) (string, error)
 }
 
 type virtHandler struct {
@@ -220,6 +222,27 @@ func (v *virtHandlerConn) BackupURI(vmi *virtv1.VirtualMachineInstance) (string,
 	return v.formatURI(backupTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) ChangedBlocksURI(vmi *virtv1.VirtualMachineInstance, options *virtv1.ChangedBlocksOptions) (string, error) {
+	baseURI, err := v.formatURI(changedBlocksTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	u, err := url.Parse(baseURI)
+	if err != nil {
+		return "", err
+	}
+	queryParams := url.Values{}
+	queryParams.Add("fromCheckpoint", options.FromCheckpoint)
+	if options.ToCheckpoint != "" {
+		queryParams.Add("toCheckpoint", options.ToCheckpoint)
+	}
+	if options.Disk != "" {
+		queryParams.Add("disk", options.Disk)
+	}
+	u.RawQuery = queryParams.Encode()
+	return u.String(), nil
+}
+
 func (v *virtHandlerConn) FreezeURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(freezeTemplateURI, vmi)
 }
@@ -65,8 +65,13 @@ type KubevirtClient interface {
 	VirtualMachineInstancePreset(namespace string) VirtualMachineInstancePresetInterface
 	VirtualMachineBackup(namespace string) backupv1.VirtualMachineBackupInterface
 	VirtualMachineBackupTracker(namespace string) backupv1.VirtualMachineBackupTrackerInterface
+	VirtualMachineBackupSchedule(namespace string) backupv1.VirtualMachineBackupScheduleInterface
+	VirtualMachineBackupRestore(namespace string) backupv1.VirtualMachineBackupRestoreInterface
 	VirtualMachineSnapshot(namespace string) snapshotv1.VirtualMachineSnapshotInterface
 	VirtualMachineSnapshotContent(namespace string) snapshotv1.VirtualMachineSnapshotContentInterface
+	VirtualMachineSnapshotSchedule(namespace string) snapshotv1.VirtualMachineSnapshotScheduleInterface
+	VirtualMachineSnapshotGroup(namespace string) snapshotv1.VirtualMachineSnapshotGroupInterface
+	VirtualMachineSnapshotGroupRestore(namespace string) snapshotv1.VirtualMachineSnapshotGroupRestoreInterface
 	VirtualMachineRestore(namespace string) snapshotv1.VirtualMachineRestoreInterface
 	VirtualMachineExport(namespace string) exportv1.VirtualMachineExportInterface
 	VirtualMachineInstancetype(namespace string) instancetypev1beta1.VirtualMachineInstancetypeInterface
@@ -182,6 +187,14 @@ func (k kubevirtClient) VirtualMachineBackupTracker(namespace string) backupv1.V
 	return k.generatedKubeVirtClient.BackupV1alpha1().VirtualMachineBackupTrackers(namespace)
 }
 
+func (k kubevirtClient) VirtualMachineBackupSchedule(namespace string) backupv1.VirtualMachineBackupScheduleInterface {
+	return k.generatedKubeVirtClient.BackupV1alpha1().VirtualMachineBackupSchedules(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineBackupRestore(namespace string) backupv1.VirtualMachineBackupRestoreInterface {
+	return k.generatedKubeVirtClient.BackupV1alpha1().VirtualMachineBackupRestores(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineSnapshot(namespace string) snapshotv1.VirtualMachineSnapshotInterface {
 	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshots(namespace)
 }
@@ -190,6 +203,18 @@ func (k kubevirtClient) VirtualMachineSnapshotContent(namespace string) snapshot
 	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotContents(namespace)
 }
 
+func (k kubevirtClient) VirtualMachineSnapshotSchedule(namespace string) snapshotv1.VirtualMachineSnapshotScheduleInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotSchedules(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineSnapshotGroup(namespace string) snapshotv1.VirtualMachineSnapshotGroupInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroups(namespace)
+}
+
+func (k kubevirtClient) VirtualMachineSnapshotGroupRestore(namespace string) snapshotv1.VirtualMachineSnapshotGroupRestoreInterface {
+	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineSnapshotGroupRestores(namespace)
+}
+
 func (k kubevirtClient) VirtualMachineRestore(namespace string) snapshotv1.VirtualMachineRestoreInterface {
 	return k.generatedKubeVirtClient.SnapshotV1beta1().VirtualMachineRestores(namespace)
 }
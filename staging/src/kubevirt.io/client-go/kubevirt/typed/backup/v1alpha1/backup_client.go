@@ -31,6 +31,8 @@ import (
 type BackupV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	VirtualMachineBackupsGetter
+	VirtualMachineBackupRestoresGetter
+	VirtualMachineBackupSchedulesGetter
 	VirtualMachineBackupTrackersGetter
 }
 
@@ -43,6 +45,14 @@ func (c *BackupV1alpha1Client) VirtualMachineBackups(namespace string) VirtualMa
 	return newVirtualMachineBackups(c, namespace)
 }
 
+func (c *BackupV1alpha1Client) VirtualMachineBackupRestores(namespace string) VirtualMachineBackupRestoreInterface {
+	return newVirtualMachineBackupRestores(c, namespace)
+}
+
+func (c *BackupV1alpha1Client) VirtualMachineBackupSchedules(namespace string) VirtualMachineBackupScheduleInterface {
+	return newVirtualMachineBackupSchedules(c, namespace)
+}
+
 func (c *BackupV1alpha1Client) VirtualMachineBackupTrackers(namespace string) VirtualMachineBackupTrackerInterface {
 	return newVirtualMachineBackupTrackers(c, namespace)
 }
@@ -22,4 +22,8 @@ package v1alpha1
 
 type VirtualMachineBackupExpansion interface{}
 
+type VirtualMachineBackupRestoreExpansion interface{}
+
+type VirtualMachineBackupScheduleExpansion interface{}
+
 type VirtualMachineBackupTrackerExpansion interface{}
@@ -73,6 +73,16 @@ func (c *fakeVirtualMachines) Migrate(ctx context.Context, name string, migrateO
 	return err
 }
 
+func (c *fakeVirtualMachines) MigrateCheck(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) (*v1.MigrateCheckResult, error) {
+	obj, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "migrate-check", name, migrateOptions), &v1.MigrateCheckResult{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1.MigrateCheckResult), err
+}
+
 func (c *fakeVirtualMachines) MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "memorydump", name, memoryDumpRequest), nil)
@@ -118,3 +128,10 @@ func (c *fakeVirtualMachines) EvacuateCancel(ctx context.Context, name string, e
 
 	return err
 }
+
+func (c *fakeVirtualMachines) ResetContainerDisk(ctx context.Context, name string, resetContainerDiskOptions *v1.ResetContainerDiskOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(c.Resource(), c.Namespace(), "resetcontainerdisk", name, resetContainerDiskOptions), nil)
+
+	return err
+}
@@ -181,3 +181,7 @@ func (c *fakeVirtualMachineInstances) Backup(ctx context.Context, name string, b
 
 	return err
 }
+
+func (c *fakeVirtualMachineInstances) ChangedBlocks(ctx context.Context, name string, options *v1.ChangedBlocksOptions) ([]byte, error) {
+	return nil, nil
+}
@@ -41,6 +41,7 @@ type VirtualMachineExpansion interface {
 	Start(ctx context.Context, name string, startOptions *v1.StartOptions) error
 	Stop(ctx context.Context, name string, stopOptions *v1.StopOptions) error
 	Migrate(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) error
+	MigrateCheck(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) (*v1.MigrateCheckResult, error)
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
@@ -48,6 +49,7 @@ type VirtualMachineExpansion interface {
 	RemoveMemoryDump(ctx context.Context, name string) error
 	ObjectGraph(ctx context.Context, name string, objectGraphOptions *v1.ObjectGraphOptions) (v1.ObjectGraphNode, error)
 	EvacuateCancel(ctx context.Context, name string, evacuateCancelOptions *v1.EvacuateCancelOptions) error
+	ResetContainerDisk(ctx context.Context, name string, resetContainerDiskOptions *v1.ResetContainerDiskOptions) error
 }
 
 func (c *virtualMachines) GetWithExpandedSpec(ctx context.Context, name string) (*v1.VirtualMachine, error) {
@@ -132,6 +134,26 @@ func (c *virtualMachines) Migrate(ctx context.Context, name string, migrateOptio
 		Error()
 }
 
+func (c *virtualMachines) MigrateCheck(ctx context.Context, name string, migrateOptions *v1.MigrateOptions) (*v1.MigrateCheckResult, error) {
+	result := &v1.MigrateCheckResult{}
+
+	optsJson, err := json.Marshal(migrateOptions)
+	if err != nil {
+		return nil, err
+	}
+	err = c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("migrate-check").
+		Body(optsJson).
+		Do(ctx).
+		Into(result)
+
+	return result, err
+}
+
 func (c *virtualMachines) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	body, err := json.Marshal(addVolumeOptions)
 	if err != nil {
@@ -237,3 +259,20 @@ func (c *virtualMachines) EvacuateCancel(ctx context.Context, name string, evacu
 		Do(ctx).
 		Error()
 }
+
+func (c *virtualMachines) ResetContainerDisk(ctx context.Context, name string, resetContainerDiskOptions *v1.ResetContainerDiskOptions) error {
+	body, err := json.Marshal(resetContainerDiskOptions)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("resetcontainerdisk").
+		Body(body).
+		Do(ctx).
+		Error()
+}
@@ -45,6 +45,7 @@ type VirtualMachineInstanceExpansion interface {
 	Screenshot(ctx context.Context, name string, options *v1.ScreenshotOptions) ([]byte, error)
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	Backup(ctx context.Context, name string, backupOptions *backupv1.BackupOptions) error
+	ChangedBlocks(ctx context.Context, name string, options *v1.ChangedBlocksOptions) ([]byte, error)
 	Pause(ctx context.Context, name string, pauseOptions *v1.PauseOptions) error
 	Unpause(ctx context.Context, name string, unpauseOptions *v1.UnpauseOptions) error
 	Freeze(ctx context.Context, name string, unfreezeTimeout time.Duration) error
@@ -129,6 +130,33 @@ func (c *virtualMachineInstances) Backup(ctx context.Context, name string, backu
 		Error()
 }
 
+func (c *virtualMachineInstances) ChangedBlocks(ctx context.Context, name string, options *v1.ChangedBlocksOptions) ([]byte, error) {
+	req := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("changedblocks").
+		Param("fromCheckpoint", options.FromCheckpoint)
+	if options.ToCheckpoint != "" {
+		req = req.Param("toCheckpoint", options.ToCheckpoint)
+	}
+	if options.Disk != "" {
+		req = req.Param("disk", options.Disk)
+	}
+	if options.Format != "" {
+		req = req.Param("format", string(options.Format))
+	}
+	res := req.Do(ctx)
+
+	raw, err := res.Raw()
+	if err != nil {
+		return nil, res.Error()
+	}
+
+	return raw, nil
+}
+
 func (c *virtualMachineInstances) Pause(ctx context.Context, name string, pauseOptions *v1.PauseOptions) error {
 	body, err := json.Marshal(pauseOptions)
 	if err != nil {
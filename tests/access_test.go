@@ -310,6 +310,33 @@ var _ = Describe("[rfe_id:500][crit:high][vendor:cnv-qe@redhat.com][level:compon
 				denyModificationsFor("view"),
 				denyAllFor("instancetype:view"),
 				denyAllFor("default")),
+			Entry("[test_id:TODO]given a vmsnapshotschedule",
+				snapshotv1.SchemeGroupVersion.Group,
+				"virtualmachinesnapshotschedules",
+				false,
+				allowAllFor("admin"),
+				denyDeleteCollectionFor("edit"),
+				denyModificationsFor("view"),
+				denyAllFor("instancetype:view"),
+				denyAllFor("default")),
+			Entry("[test_id:TODO]given a vmsnapshotgroup",
+				snapshotv1.SchemeGroupVersion.Group,
+				"virtualmachinesnapshotgroups",
+				false,
+				allowAllFor("admin"),
+				denyDeleteCollectionFor("edit"),
+				denyModificationsFor("view"),
+				denyAllFor("instancetype:view"),
+				denyAllFor("default")),
+			Entry("[test_id:TODO]given a vmsnapshotgrouprestore",
+				snapshotv1.SchemeGroupVersion.Group,
+				"virtualmachinesnapshotgrouprestores",
+				false,
+				allowAllFor("admin"),
+				denyDeleteCollectionFor("edit"),
+				denyModificationsFor("view"),
+				denyAllFor("instancetype:view"),
+				denyAllFor("default")),
 			Entry("[test_id:TODO]given a virtualmachineinstancetype",
 				instancetypeapi.GroupName,
 				instancetypeapi.PluralResourceName,
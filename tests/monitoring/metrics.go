@@ -67,6 +67,14 @@ var _ = Describe("[sig-monitoring]Metrics", decorators.SigMonitoring, func() {
 
 			// needs a snapshot - ignoring since already tested in - VM Monitoring, VM snapshot metrics
 			"kubevirt_vmsnapshot_succeeded_timestamp_seconds": true,
+			// needs a snapshot schedule
+			"kubevirt_vmsnapshot_schedule_overdue": true,
+
+			// needs a migration policy
+			"kubevirt_migration_policy_matched_vmis":                       true,
+			"kubevirt_migration_policy_applied_vmis":                       true,
+			"kubevirt_migration_policy_migrations_total":                   true,
+			"kubevirt_migration_policy_migration_average_duration_seconds": true,
 
 			// needs a machines variable - ignoring since already tested in - tests/infrastructure/prometheus
 			"kubevirt_node_deprecated_machine_types": true,
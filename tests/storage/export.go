@@ -1383,42 +1383,49 @@ var _ = Describe(SIG("Export", func() {
 		Expect(vmExport.Status.Links).ToNot(BeNil())
 		Expect(vmExport.Status.Links.Internal).NotTo(BeNil())
 		Expect(vmExport.Status.Links.Internal.Cert).NotTo(BeEmpty())
-		Expect(vmExport.Status.Links.Internal.Volumes).To(HaveLen(len(expectedVolumeFormats) / 2))
+		Expect(vmExport.Status.Links.Internal.Volumes).ToNot(BeEmpty())
+		formatCount := 0
 		for _, volume := range vmExport.Status.Links.Internal.Volumes {
-			Expect(volume.Formats).To(HaveLen(2))
+			formatCount += len(volume.Formats)
 			Expect(expectedVolumeFormats).To(ContainElements(volume.Formats))
 		}
+		Expect(formatCount).To(Equal(len(expectedVolumeFormats)))
+	}
+
+	kubevirtVolumeFormats := func(exportName, namespace, volumeName string) []exportv1.VirtualMachineExportVolumeFormat {
+		baseUrl := fmt.Sprintf("https://%s.%s.svc/volumes/%s", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName)
+		return []exportv1.VirtualMachineExportVolumeFormat{
+			{
+				Format: exportv1.KubeVirtRaw,
+				Url:    baseUrl + "/disk.img",
+			},
+			{
+				Format: exportv1.KubeVirtGz,
+				Url:    baseUrl + "/disk.img.gz",
+			},
+			{
+				Format: exportv1.KubeVirtQcow2,
+				Url:    baseUrl + "/disk.qcow2",
+			},
+			{
+				Format: exportv1.KubeVirtQcow2Zstd,
+				Url:    baseUrl + "/disk.qcow2.zst",
+			},
+		}
 	}
 
 	verifyMultiKubevirtInternal := func(vmExport *exportv1.VirtualMachineExport, exportName, namespace string, volumeNames ...string) {
 		var volumeFormats []exportv1.VirtualMachineExportVolumeFormat
 
 		for _, volumeName := range volumeNames {
-			volumeFormats = append(volumeFormats,
-				exportv1.VirtualMachineExportVolumeFormat{
-					Format: exportv1.KubeVirtRaw,
-					Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
-				},
-				exportv1.VirtualMachineExportVolumeFormat{
-					Format: exportv1.KubeVirtGz,
-					Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
-				},
-			)
+			volumeFormats = append(volumeFormats, kubevirtVolumeFormats(exportName, namespace, volumeName)...)
 		}
 
 		verifyLinksInternal(vmExport, volumeFormats...)
 	}
 
 	verifyKubevirtInternal := func(vmExport *exportv1.VirtualMachineExport, exportName, namespace, volumeName string) {
-		verifyLinksInternal(vmExport,
-			exportv1.VirtualMachineExportVolumeFormat{
-				Format: exportv1.KubeVirtRaw,
-				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
-			},
-			exportv1.VirtualMachineExportVolumeFormat{
-				Format: exportv1.KubeVirtGz,
-				Url:    fmt.Sprintf("https://%s.%s.svc/volumes/%s/disk.img.gz", fmt.Sprintf("%s-%s", exportPrefix, exportName), namespace, volumeName),
-			})
+		verifyLinksInternal(vmExport, kubevirtVolumeFormats(exportName, namespace, volumeName)...)
 	}
 
 	It("should create export from VMSnapshot", decorators.RequiresSnapshotStorageClass, func() {
@@ -1923,6 +1930,8 @@ var _ = Describe(SIG("Export", func() {
 		Expect(export.Status.Links.Internal).ToNot(BeNil())
 		Expect(getManifestUrl(export.Status.Links.Internal.Manifests, exportv1.AllManifests)).To(Equal(fmt.Sprintf("https://%s.%s.svc/internal/manifests/all", fmt.Sprintf("virt-export-%s", export.Name), export.Namespace)))
 		Expect(getManifestUrl(export.Status.Links.Internal.Manifests, exportv1.AuthHeader)).To(Equal(fmt.Sprintf("https://%s.%s.svc/internal/manifests/secret", fmt.Sprintf("virt-export-%s", export.Name), export.Namespace)))
+		Expect(getManifestUrl(export.Status.Links.Internal.Manifests, exportv1.OVF)).To(Equal(fmt.Sprintf("https://%s.%s.svc/internal/manifests/ovf", fmt.Sprintf("virt-export-%s", export.Name), export.Namespace)))
+		Expect(getManifestUrl(export.Status.Links.Internal.Manifests, exportv1.OVA)).To(Equal(fmt.Sprintf("https://%s.%s.svc/internal/manifests/ova", fmt.Sprintf("virt-export-%s", export.Name), export.Namespace)))
 		Expect(err).ToNot(HaveOccurred())
 		caConfigMap := createCaConfigMapInternal("export-cacerts", vm.Namespace, export)
 		Expect(caConfigMap).ToNot(BeNil())
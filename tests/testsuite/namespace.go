@@ -261,6 +261,13 @@ func CleanNamespaces() {
 			Expect(virtCli.VirtualMachineClone(namespace).Delete(context.Background(), clone.Name, metav1.DeleteOptions{})).To(Succeed())
 		}
 
+		// Remove vm snapshot schedules before the snapshots they take
+		Expect(virtCli.VirtualMachineSnapshotSchedule(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{})).To(Succeed())
+
+		// Remove vm snapshot groups and their restores before the snapshots and restores they own
+		Expect(virtCli.VirtualMachineSnapshotGroupRestore(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{})).To(Succeed())
+		Expect(virtCli.VirtualMachineSnapshotGroup(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{})).To(Succeed())
+
 		// Remove vm snapshots
 		Expect(virtCli.VirtualMachineSnapshot(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{})).To(Succeed())
 		Expect(virtCli.VirtualMachineSnapshotContent(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{})).To(Succeed())
@@ -270,6 +277,20 @@ func CleanNamespaces() {
 		// Remove events
 		Expect(virtCli.CoreV1().Events(namespace).DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{})).To(Succeed())
 
+		// Remove vmbackuprestores
+		vmbackuprestoreList, err := virtCli.VirtualMachineBackupRestore(namespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		for _, restore := range vmbackuprestoreList.Items {
+			Expect(virtCli.VirtualMachineBackupRestore(namespace).Delete(context.Background(), restore.Name, metav1.DeleteOptions{})).To(Succeed())
+		}
+
+		// Remove vmbackupschedules
+		vmbackupscheduleList, err := virtCli.VirtualMachineBackupSchedule(namespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		for _, schedule := range vmbackupscheduleList.Items {
+			Expect(virtCli.VirtualMachineBackupSchedule(namespace).Delete(context.Background(), schedule.Name, metav1.DeleteOptions{})).To(Succeed())
+		}
+
 		// Remove vmbackups
 		vmbackupList, err := virtCli.VirtualMachineBackup(namespace).List(context.Background(), metav1.ListOptions{})
 		Expect(err).ToNot(HaveOccurred())